@@ -0,0 +1,302 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"strconv"
+	"strings"
+)
+
+// Keyed is the configuration shared by the sections of the matrix whose
+// benchmarks dispatch on a key looked up with the selector rather than on
+// the selector itself. The input strategies are names of the matrix's input
+// strategies and the index modes default to the matrix's.
+type Keyed struct {
+	InputStrategies    []string `json:"inputStrategies"`
+	IndexModes         []string `json:"indexModes,omitempty"`
+	DispatchStrategies []string `json:"dispatchStrategies"`
+
+	patterns []InputStrategy
+}
+
+// Patterns returns the input strategies of the section.
+func (k *Keyed) Patterns() []InputStrategy {
+	return k.patterns
+}
+
+// Modes returns the index modes of the section.
+func (k *Keyed) Modes() []string {
+	return k.IndexModes
+}
+
+func (k *Keyed) validate(m *Matrix, strategies map[string]bool) error {
+	if len(k.InputStrategies) == 0 {
+		return fmt.Errorf("inputStrategies is empty")
+	}
+	k.patterns = nil
+	for _, name := range k.InputStrategies {
+		var in *InputStrategy
+		for i := range m.InputStrategies {
+			if m.InputStrategies[i].Name == name {
+				in = &m.InputStrategies[i]
+			}
+		}
+		if in == nil {
+			return fmt.Errorf("unknown input strategy %q", name)
+		}
+		if in.Sweep != nil {
+			return fmt.Errorf("input strategy %s has a sweep", name)
+		}
+		k.patterns = append(k.patterns, *in)
+	}
+
+	if len(k.IndexModes) == 0 {
+		k.IndexModes = m.IndexModes
+	}
+	for _, name := range k.IndexModes {
+		if !contains(m.IndexModes, name) {
+			return fmt.Errorf("index mode %q is not one of the matrix's index modes", name)
+		}
+	}
+
+	if len(k.DispatchStrategies) == 0 {
+		return fmt.Errorf("dispatchStrategies is empty")
+	}
+	for _, d := range k.DispatchStrategies {
+		if !strategies[d] {
+			return fmt.Errorf("unknown dispatch strategy %q", d)
+		}
+	}
+
+	return nil
+}
+
+// StringKeys describes the string-keyed benchmarks. Each dispatch strategy
+// produces one top-level benchmark with a sub-benchmark for every function
+// kind, key shape, input strategy and branch count. The selector chosen by
+// the input strategy picks the key to dispatch on.
+type StringKeys struct {
+	Shapes []KeyShape `json:"shapes"`
+	Keyed
+}
+
+// KeyShape is a key length in bytes and the lengths of the prefix shared by
+// every key. Each prefix length is a separate set of keys. Keys of the same
+// set differ in the first byte after the prefix.
+type KeyShape struct {
+	Length   int   `json:"length"`
+	Prefixes []int `json:"prefixes"`
+}
+
+// stringSuffix is the number of bytes after the prefix that distinguish the
+// keys, which allows up to 26*26 branches.
+const stringSuffix = 2
+
+// stringDispatchStrategies are the string dispatch strategies the benchmark
+// template knows how to emit. StringSwitch switches over the keys as string
+// constants, StringMap looks up a map[string]func(int) int and StringSearch
+// binary searches a sorted key slice with sort.Search.
+var stringDispatchStrategies = map[string]bool{
+	"StringSwitch": true,
+	"StringMap":    true,
+	"StringSearch": true,
+}
+
+func (k *StringKeys) validate(m *Matrix) error {
+	if len(k.Shapes) == 0 {
+		return fmt.Errorf("shapes is empty")
+	}
+	if max := m.MaxBranchCount(); max > 26*26 {
+		return fmt.Errorf("branch count %d is more than %d", max, 26*26)
+	}
+	seen := make(map[int]bool)
+	for _, s := range k.Shapes {
+		if seen[s.Length] {
+			return fmt.Errorf("duplicate key length %d", s.Length)
+		}
+		seen[s.Length] = true
+		if len(s.Prefixes) == 0 {
+			return fmt.Errorf("key length %d has no prefixes", s.Length)
+		}
+		for _, p := range s.Prefixes {
+			if p < 0 || p+stringSuffix > s.Length {
+				return fmt.Errorf("prefix %d does not leave %d bytes of key length %d", p, stringSuffix, s.Length)
+			}
+		}
+	}
+
+	return k.Keyed.validate(m, stringDispatchStrategies)
+}
+
+// stringKey returns the key of branch k for keys of length bytes that share
+// a prefix of prefix bytes. The two bytes after the prefix are k in base 26,
+// least significant first, so that keys differ right after the prefix. The
+// remaining bytes are filler.
+func stringKey(length, prefix, k int) string {
+	b := make([]byte, length)
+	for i := 0; i < prefix; i++ {
+		b[i] = 'a' + byte(i%26)
+	}
+	b[prefix] = 'a' + byte(k%26)
+	b[prefix+1] = 'a' + byte(k/26%26)
+	for i := prefix + stringSuffix; i < length; i++ {
+		b[i] = 'a' + byte((k+i)%26)
+	}
+	return string(b)
+}
+
+// stringKeyLiterals returns the keys of the first n branches as Go string
+// literals.
+func stringKeyLiterals(length, prefix, n int) []string {
+	keys := make([]string, n)
+	for k := range keys {
+		keys[k] = strconv.Quote(stringKey(length, prefix, k))
+	}
+	return keys
+}
+
+// KeyLayouts describes the benchmarks of int keys that are not the branch
+// index. Each dispatch strategy produces one top-level benchmark with a
+// sub-benchmark for every function kind, layout, input strategy and branch
+// count. The selector chosen by the input strategy picks the key to dispatch
+// on, so only the layout of the keys differs from the int benchmarks.
+type KeyLayouts struct {
+	Layouts []KeyLayout `json:"layouts"`
+	Keyed
+}
+
+// KeyLayout is a named set of int keys. Name is used as the layout= element
+// of sub-benchmark names and Keys is the layout spec, see layoutKeys.
+type KeyLayout struct {
+	Name string `json:"name"`
+	Keys string `json:"keys"`
+
+	keys []int
+}
+
+// Key returns the key of branch k.
+func (l KeyLayout) Key(k int) int {
+	return l.keys[k]
+}
+
+// Literals returns the keys of the first n branches as Go literals.
+func (l KeyLayout) Literals(n int) []string {
+	keys := make([]string, n)
+	for k := range keys {
+		keys[k] = strconv.Itoa(l.keys[k])
+	}
+	return keys
+}
+
+// layoutDispatchStrategies are the key layout dispatch strategies the
+// benchmark template knows how to emit. LayoutSwitch switches over the keys
+// as int constants and LayoutMap looks up a map[int]func(int) int.
+var layoutDispatchStrategies = map[string]bool{
+	"LayoutSwitch": true,
+	"LayoutMap":    true,
+}
+
+// sparseSeed seeds the keys of the sparse layout so that the generated files
+// are reproducible.
+const sparseSeed = 1
+
+// clusterStride is the distance between the first keys of consecutive
+// clusters of the clustered layout.
+const clusterStride = 1 << 16
+
+// layoutKeys returns the first n keys of the layout spec, which is one of
+//
+//	dense         0, 1, 2, ...
+//	strided(s)    0, s, 2s, ...
+//	sparse        distinct pseudo-random int32 values, negative ones included
+//	clustered(c)  runs of c consecutive keys, 65536 apart
+//
+// Every key fits in an int32.
+func layoutKeys(spec string, n int) ([]int, error) {
+	name, arg, hasArg := spec, "", false
+	if i := strings.IndexByte(spec, '('); i >= 0 {
+		name, arg, hasArg = spec[:i], spec[i+1:], true
+	}
+	var param int
+	if hasArg {
+		if !strings.HasSuffix(arg, ")") {
+			return nil, fmt.Errorf("layout %q: missing )", spec)
+		}
+		var err error
+		param, err = strconv.Atoi(strings.TrimSuffix(arg, ")"))
+		if err != nil || param < 1 {
+			return nil, fmt.Errorf("layout %q: argument must be a positive integer", spec)
+		}
+	}
+	if hasArg != (name == "strided" || name == "clustered") {
+		if hasArg {
+			return nil, fmt.Errorf("layout %s takes no argument", name)
+		}
+		return nil, fmt.Errorf("layout %s requires an argument", name)
+	}
+
+	keys := make([]int, n)
+	switch name {
+	case "dense":
+		for k := range keys {
+			keys[k] = k
+		}
+	case "strided":
+		if (n-1)*param > math.MaxInt32 {
+			return nil, fmt.Errorf("layout %q: %d keys overflow int32", spec, n)
+		}
+		for k := range keys {
+			keys[k] = k * param
+		}
+	case "sparse":
+		rng := rand.New(rand.NewSource(sparseSeed))
+		seen := make(map[int]bool, n)
+		for k := range keys {
+			key := int(int32(rng.Uint32()))
+			for seen[key] {
+				key = int(int32(rng.Uint32()))
+			}
+			seen[key] = true
+			keys[k] = key
+		}
+	case "clustered":
+		if param > clusterStride {
+			return nil, fmt.Errorf("layout %q: clusters are more than %d keys", spec, clusterStride)
+		}
+		if (n-1)/param*clusterStride > math.MaxInt32 {
+			return nil, fmt.Errorf("layout %q: %d keys overflow int32", spec, n)
+		}
+		for k := range keys {
+			keys[k] = k/param*clusterStride + k%param
+		}
+	default:
+		return nil, fmt.Errorf("unknown layout %q", name)
+	}
+	return keys, nil
+}
+
+func (k *KeyLayouts) validate(m *Matrix) error {
+	if len(k.Layouts) == 0 {
+		return fmt.Errorf("layouts is empty")
+	}
+	seen := make(map[string]bool)
+	for i := range k.Layouts {
+		l := &k.Layouts[i]
+		if !validName(l.Name) {
+			return fmt.Errorf("layout %d has invalid name %q", i, l.Name)
+		}
+		if seen[l.Name] {
+			return fmt.Errorf("duplicate layout %s", l.Name)
+		}
+		seen[l.Name] = true
+
+		keys, err := layoutKeys(l.Keys, m.MaxBranchCount())
+		if err != nil {
+			return err
+		}
+		l.keys = keys
+	}
+
+	return k.Keyed.validate(m, layoutDispatchStrategies)
+}
@@ -0,0 +1,170 @@
+// Command genbench generates funcs.go, bench_test.go, strings.go,
+// strings_test.go, layouts.go and layouts_test.go from the dimension matrix in
+// matrix.json. It is run by go generate in the repository root.
+//
+// With -check it writes nothing and exits non-zero if any generated file
+// differs from what the matrix would produce.
+package main
+
+import (
+	"bytes"
+	"embed"
+	"flag"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+)
+
+//go:embed templates/*.tmpl
+var templateFS embed.FS
+
+// outputs maps each generated file to the template that produces it.
+var outputs = []struct {
+	file     string
+	template string
+}{
+	{"funcs.go", "funcs.go.tmpl"},
+	{"bench_test.go", "bench_test.go.tmpl"},
+	{"strings.go", "strings.go.tmpl"},
+	{"strings_test.go", "strings_test.go.tmpl"},
+	{"layouts.go", "layouts.go.tmpl"},
+	{"layouts_test.go", "layouts_test.go.tmpl"},
+}
+
+func main() {
+	matrixPath := flag.String("matrix", "matrix.json", "path to the dimension matrix")
+	dir := flag.String("dir", ".", "directory to write the generated files to")
+	check := flag.Bool("check", false, "check that the generated files are up to date instead of writing them")
+	flag.Parse()
+
+	if err := run(*matrixPath, *dir, *check); err != nil {
+		fmt.Fprintf(os.Stderr, "genbench: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(matrixPath, dir string, check bool) error {
+	m, err := readMatrix(matrixPath)
+	if err != nil {
+		return err
+	}
+
+	files, err := generate(m)
+	if err != nil {
+		return err
+	}
+
+	stale := 0
+	for _, o := range outputs {
+		path := filepath.Join(dir, o.file)
+		if check {
+			current, err := os.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			if !bytes.Equal(current, files[o.file]) {
+				fmt.Fprintf(os.Stderr, "genbench: %s is out of date\n", path)
+				stale++
+			}
+			continue
+		}
+
+		if err := os.WriteFile(path, files[o.file], 0644); err != nil {
+			return err
+		}
+	}
+
+	if stale > 0 {
+		return fmt.Errorf("%d generated files are out of date; run go generate", stale)
+	}
+
+	return nil
+}
+
+// loopArgs are the arguments of the dispatch template. The keyed strategies
+// switch on KeySet[Selector] and have a case for each of Keys, which are Go
+// literals.
+type loopArgs struct {
+	Dispatch string
+	Kind     string
+	N        int
+	Selector string
+	KeySet   string
+	Keys     []string
+}
+
+// funcArgs are the arguments of the computedFunc and lookupFunc templates.
+// Mode is the index mode of a lookup function.
+type funcArgs struct {
+	Name string
+	Mode string
+	Loop loopArgs
+}
+
+// generate renders every output file for m and returns the gofmt'd source
+// keyed by file name.
+func generate(m *Matrix) (map[string][]byte, error) {
+	tmpl, err := template.New("").Funcs(template.FuncMap{
+		"seq": func(n int) []int {
+			s := make([]int, n)
+			for i := range s {
+				s[i] = i
+			}
+			return s
+		},
+		"directive": func(kind string) string {
+			return funcKinds[kind].Directive
+		},
+		"inline": func(kind string) bool {
+			return funcKinds[kind].Inline
+		},
+		"sub": func(a, b int) int {
+			return a - b
+		},
+		"export": func(s string) string {
+			return strings.ToUpper(s[:1]) + s[1:]
+		},
+		"loop": func(dispatch, kind string, n int, selector string) loopArgs {
+			return loopArgs{Dispatch: dispatch, Kind: kind, N: n, Selector: selector}
+		},
+		"keyed": func(a loopArgs, keySet string, keys []string) loopArgs {
+			a.KeySet, a.Keys = keySet, keys
+			return a
+		},
+		"selector": func(a loopArgs, selector string) loopArgs {
+			a.Selector = selector
+			return a
+		},
+		"fn": func(name, mode string, loop loopArgs) funcArgs {
+			return funcArgs{Name: name, Mode: mode, Loop: loop}
+		},
+		"stringKey":  stringKey,
+		"stringKeys": stringKeyLiterals,
+		"indexMode": func(name string) IndexMode {
+			return indexModes[name]
+		},
+		"formatValue": formatValue,
+	}).ParseFS(templateFS, "templates/*.tmpl")
+	if err != nil {
+		return nil, err
+	}
+
+	files := make(map[string][]byte, len(outputs))
+	for _, o := range outputs {
+		var buf bytes.Buffer
+		if err := tmpl.ExecuteTemplate(&buf, o.template, m); err != nil {
+			return nil, err
+		}
+
+		src, err := format.Source(buf.Bytes())
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", o.file, err)
+		}
+		files[o.file] = src
+	}
+
+	return files, nil
+}
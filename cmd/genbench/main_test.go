@@ -0,0 +1,133 @@
+package main
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestGeneratedFilesUpToDate(t *testing.T) {
+	if err := run("../../matrix.json", "../..", true); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMatrixValidate(t *testing.T) {
+	tests := []struct {
+		name string
+		m    Matrix
+		want string
+	}{
+		{"no branch counts", Matrix{FuncKinds: []string{"Inline"}, InputStrategies: []InputStrategy{{Name: "a", Selector: "i"}}, DispatchStrategies: []string{"Switch"}}, `branchCounts is empty`},
+		{"unknown func kind", Matrix{BranchCounts: []int{4}, FuncKinds: []string{"Bogus"}, InputStrategies: []InputStrategy{{Name: "a", Selector: "i"}}, DispatchStrategies: []string{"Switch"}}, `unknown function kind "Bogus"`},
+		{"bad selector", Matrix{BranchCounts: []int{4}, FuncKinds: []string{"Inline"}, InputStrategies: []InputStrategy{{Name: "a", Selector: "{{.N"}}, DispatchStrategies: []string{"Switch"}}, `unclosed action`},
+		{"bad input strategy name", Matrix{BranchCounts: []int{4}, FuncKinds: []string{"Inline"}, InputStrategies: []InputStrategy{{Name: "Random", Selector: "i"}}, DispatchStrategies: []string{"Switch"}}, `invalid name "Random"`},
+		{"selector and distribution", Matrix{BranchCounts: []int{4}, FuncKinds: []string{"Inline"}, InputStrategies: []InputStrategy{{Name: "a", Selector: "i", Distribution: "uniform"}}, DispatchStrategies: []string{"Switch"}}, `exactly one of selector and distribution`},
+		{"bad distribution", Matrix{BranchCounts: []int{4}, FuncKinds: []string{"Inline"}, InputStrategies: []InputStrategy{{Name: "a", Distribution: "zipf(0.5)"}}, DispatchStrategies: []string{"Switch"}}, `exponent must be > 1`},
+		{"duplicate input strategy", Matrix{BranchCounts: []int{4}, FuncKinds: []string{"Inline"}, InputStrategies: []InputStrategy{{Name: "a", Selector: "i"}, {Name: "a", Distribution: "uniform"}}, DispatchStrategies: []string{"Switch"}}, `duplicate input strategy a`},
+		{"sweep without distribution", Matrix{BranchCounts: []int{4}, FuncKinds: []string{"Inline"}, InputStrategies: []InputStrategy{{Name: "a", Selector: "i", Sweep: &Sweep{Param: "p", Values: []float64{0.5}}}}, DispatchStrategies: []string{"Switch"}}, `sweep requires a distribution`},
+		{"bad sweep value", Matrix{BranchCounts: []int{4}, FuncKinds: []string{"Inline"}, InputStrategies: []InputStrategy{{Name: "a", Distribution: "mix", Sweep: &Sweep{Param: "p", Values: []float64{2}}}}, DispatchStrategies: []string{"Switch"}}, `probability must be in [0, 1]`},
+		{"no input lengths", Matrix{BranchCounts: []int{4}, FuncKinds: []string{"Inline"}, InputStrategies: []InputStrategy{{Name: "a", Distribution: "uniform"}}, DispatchStrategies: []string{"Switch"}}, `inputLengths is empty`},
+		{"bad input length", Matrix{BranchCounts: []int{4}, FuncKinds: []string{"Inline"}, InputStrategies: []InputStrategy{{Name: "a", Distribution: "uniform"}}, InputLengths: []int{0}, DispatchStrategies: []string{"Switch"}}, `input length 0 is less than 1`},
+		{"no index modes", Matrix{BranchCounts: []int{4}, FuncKinds: []string{"Inline"}, InputStrategies: []InputStrategy{{Name: "a", Distribution: "uniform"}}, InputLengths: []int{64}, DispatchStrategies: []string{"Switch"}}, `indexModes is empty`},
+		{"unknown index mode", Matrix{BranchCounts: []int{4}, FuncKinds: []string{"Inline"}, InputStrategies: []InputStrategy{{Name: "a", Distribution: "uniform"}}, InputLengths: []int{64}, IndexModes: []string{"bogus"}, DispatchStrategies: []string{"Switch"}}, `unknown index mode "bogus"`},
+		{"mask with odd input length", Matrix{BranchCounts: []int{4}, FuncKinds: []string{"Inline"}, InputStrategies: []InputStrategy{{Name: "a", Distribution: "uniform"}}, InputLengths: []int{100}, IndexModes: []string{"mask"}, DispatchStrategies: []string{"Switch"}}, `requires power of two input lengths`},
+		{"masked selector with odd branch count", Matrix{BranchCounts: []int{4, 6}, FuncKinds: []string{"Inline"}, InputStrategies: []InputStrategy{{Name: "masked", Selector: "i & ({{.N}} - 1)"}}, DispatchStrategies: []string{"Switch"}}, `input strategy masked masks its selector and requires power of two branch counts, got 6`},
+		{"array with odd branch count", Matrix{BranchCounts: []int{6}, FuncKinds: []string{"Inline"}, InputStrategies: []InputStrategy{{Name: "a", Selector: "i"}}, DispatchStrategies: []string{"Array"}}, `requires power of two branch counts`},
+		{"prefix too long", Matrix{BranchCounts: []int{4}, FuncKinds: []string{"Inline"}, InputStrategies: []InputStrategy{{Name: "a", Selector: "i"}}, DispatchStrategies: []string{"Switch"}, StringKeys: &StringKeys{Shapes: []KeyShape{{Length: 8, Prefixes: []int{7}}}, Keyed: Keyed{InputStrategies: []string{"a"}, DispatchStrategies: []string{"StringMap"}}}}, `prefix 7 does not leave 2 bytes`},
+		{"unknown string input strategy", Matrix{BranchCounts: []int{4}, FuncKinds: []string{"Inline"}, InputStrategies: []InputStrategy{{Name: "a", Selector: "i"}}, DispatchStrategies: []string{"Switch"}, StringKeys: &StringKeys{Shapes: []KeyShape{{Length: 8, Prefixes: []int{0}}}, Keyed: Keyed{InputStrategies: []string{"b"}, DispatchStrategies: []string{"StringMap"}}}}, `stringKeys: unknown input strategy "b"`},
+		{"unknown string dispatch strategy", Matrix{BranchCounts: []int{4}, FuncKinds: []string{"Inline"}, InputStrategies: []InputStrategy{{Name: "a", Selector: "i"}}, DispatchStrategies: []string{"Switch"}, StringKeys: &StringKeys{Shapes: []KeyShape{{Length: 8, Prefixes: []int{0}}}, Keyed: Keyed{InputStrategies: []string{"a"}, DispatchStrategies: []string{"Switch"}}}}, `stringKeys: unknown dispatch strategy "Switch"`},
+		{"unknown key layout", Matrix{BranchCounts: []int{4}, FuncKinds: []string{"Inline"}, InputStrategies: []InputStrategy{{Name: "a", Selector: "i"}}, DispatchStrategies: []string{"Switch"}, KeyLayouts: &KeyLayouts{Layouts: []KeyLayout{{Name: "x", Keys: "bogus"}}, Keyed: Keyed{InputStrategies: []string{"a"}, DispatchStrategies: []string{"LayoutMap"}}}}, `keyLayouts: unknown layout "bogus"`},
+		{"key layout missing argument", Matrix{BranchCounts: []int{4}, FuncKinds: []string{"Inline"}, InputStrategies: []InputStrategy{{Name: "a", Selector: "i"}}, DispatchStrategies: []string{"Switch"}, KeyLayouts: &KeyLayouts{Layouts: []KeyLayout{{Name: "x", Keys: "strided"}}, Keyed: Keyed{InputStrategies: []string{"a"}, DispatchStrategies: []string{"LayoutMap"}}}}, `requires an argument`},
+		{"key layout overflows int32", Matrix{BranchCounts: []int{4}, FuncKinds: []string{"Inline"}, InputStrategies: []InputStrategy{{Name: "a", Selector: "i"}}, DispatchStrategies: []string{"Switch"}, KeyLayouts: &KeyLayouts{Layouts: []KeyLayout{{Name: "x", Keys: "strided(1073741824)"}}, Keyed: Keyed{InputStrategies: []string{"a"}, DispatchStrategies: []string{"LayoutMap"}}}}, `overflow int32`},
+		{"duplicate key layout", Matrix{BranchCounts: []int{4}, FuncKinds: []string{"Inline"}, InputStrategies: []InputStrategy{{Name: "a", Selector: "i"}}, DispatchStrategies: []string{"Switch"}, KeyLayouts: &KeyLayouts{Layouts: []KeyLayout{{Name: "x", Keys: "dense"}, {Name: "x", Keys: "sparse"}}, Keyed: Keyed{InputStrategies: []string{"a"}, DispatchStrategies: []string{"LayoutMap"}}}}, `duplicate layout x`},
+		{"unknown key layout index mode", Matrix{BranchCounts: []int{4}, FuncKinds: []string{"Inline"}, InputStrategies: []InputStrategy{{Name: "a", Selector: "i"}}, DispatchStrategies: []string{"Switch"}, KeyLayouts: &KeyLayouts{Layouts: []KeyLayout{{Name: "x", Keys: "dense"}}, Keyed: Keyed{InputStrategies: []string{"a"}, IndexModes: []string{"range"}, DispatchStrategies: []string{"LayoutMap"}}}}, `is not one of the matrix's index modes`},
+		{"unknown key layout dispatch strategy", Matrix{BranchCounts: []int{4}, FuncKinds: []string{"Inline"}, InputStrategies: []InputStrategy{{Name: "a", Selector: "i"}}, DispatchStrategies: []string{"Switch"}, KeyLayouts: &KeyLayouts{Layouts: []KeyLayout{{Name: "x", Keys: "dense"}}, Keyed: Keyed{InputStrategies: []string{"a"}, DispatchStrategies: []string{"StringMap"}}}}, `keyLayouts: unknown dispatch strategy "StringMap"`},
+		{"unknown dispatch strategy", Matrix{BranchCounts: []int{4}, FuncKinds: []string{"Inline"}, InputStrategies: []InputStrategy{{Name: "a", Selector: "i"}}, DispatchStrategies: []string{"Bogus"}}, `unknown dispatch strategy "Bogus"`},
+	}
+
+	for _, tt := range tests {
+		if err := tt.m.validate(); err == nil {
+			t.Errorf("%s: expected error", tt.name)
+		} else if !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("%s: error %q does not contain %q", tt.name, err, tt.want)
+		}
+	}
+}
+
+func TestMatrixImports(t *testing.T) {
+	m := Matrix{DispatchStrategies: []string{"TypeMap", "Switch", "ArrayUnsafe", "TypeMap"}}
+	if got, want := m.Imports(), []string{"reflect", "unsafe"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Imports() = %v, want %v", got, want)
+	}
+}
+
+func TestStringKey(t *testing.T) {
+	for _, shape := range []struct{ length, prefix int }{{2, 0}, {8, 0}, {32, 24}, {32, 30}} {
+		seen := make(map[string]bool)
+		for k := 0; k < 26*26; k++ {
+			key := stringKey(shape.length, shape.prefix, k)
+			if len(key) != shape.length {
+				t.Fatalf("key %d of %v has length %d", k, shape, len(key))
+			}
+			if seen[key] {
+				t.Fatalf("key %d of %v is a duplicate: %q", k, shape, key)
+			}
+			seen[key] = true
+		}
+
+		// Every key shares exactly the prefix with key 0.
+		first := stringKey(shape.length, shape.prefix, 0)
+		for k := 1; k < 26*26; k++ {
+			key := stringKey(shape.length, shape.prefix, k)
+			if key[:shape.prefix] != first[:shape.prefix] {
+				t.Fatalf("key %d of %v does not share the prefix: %q", k, shape, key)
+			}
+		}
+		if key := stringKey(shape.length, shape.prefix, 1); key[shape.prefix] == first[shape.prefix] {
+			t.Errorf("keys 0 and 1 of %v share more than the prefix", shape)
+		}
+	}
+}
+
+func TestLayoutKeys(t *testing.T) {
+	tests := []struct {
+		spec  string
+		first []int
+	}{
+		{"dense", []int{0, 1, 2, 3}},
+		{"strided(16)", []int{0, 16, 32, 48}},
+		{"clustered(2)", []int{0, 1, 65536, 65537}},
+		{"sparse", nil},
+	}
+	for _, tt := range tests {
+		keys, err := layoutKeys(tt.spec, 512)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.spec, err)
+		}
+		if len(keys) != 512 {
+			t.Fatalf("%s: got %d keys, want 512", tt.spec, len(keys))
+		}
+		if tt.first != nil && !reflect.DeepEqual(keys[:len(tt.first)], tt.first) {
+			t.Errorf("%s: first keys = %v, want %v", tt.spec, keys[:len(tt.first)], tt.first)
+		}
+
+		seen := make(map[int]bool)
+		for k, key := range keys {
+			if seen[key] {
+				t.Fatalf("%s: key %d is a duplicate: %d", tt.spec, k, key)
+			}
+			seen[key] = true
+			if key != int(int32(key)) {
+				t.Fatalf("%s: key %d does not fit in an int32: %d", tt.spec, k, key)
+			}
+		}
+	}
+
+	// The sparse keys must not depend on the branch count.
+	short, _ := layoutKeys("sparse", 4)
+	long, _ := layoutKeys("sparse", 512)
+	if !reflect.DeepEqual(short, long[:4]) {
+		t.Errorf("sparse keys = %v, want prefix of %v", short, long[:4])
+	}
+}
@@ -0,0 +1,380 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/jackc/go_map_vs_switch/internal/dist"
+)
+
+// Matrix is the declarative description of the generated benchmarks. Each
+// dispatch strategy produces one top-level benchmark, and every combination
+// of function kind, input strategy and branch count produces one
+// sub-benchmark beneath it. Input strategies with a distribution are also
+// run at each input length, the number of selectors in the pre-generated
+// sequence, and with each index mode.
+type Matrix struct {
+	BranchCounts       []int           `json:"branchCounts"`
+	FuncKinds          []string        `json:"funcKinds"`
+	InputStrategies    []InputStrategy `json:"inputStrategies"`
+	InputLengths       []int           `json:"inputLengths"`
+	IndexModes         []string        `json:"indexModes"`
+	DispatchStrategies []string        `json:"dispatchStrategies"`
+	StringKeys         *StringKeys     `json:"stringKeys,omitempty"`
+	KeyLayouts         *KeyLayouts     `json:"keyLayouts,omitempty"`
+}
+
+// InputStrategy chooses the branch to take on each benchmark iteration.
+// Name is used as the pattern= element of sub-benchmark names and must be
+// lower case letters and digits.
+//
+// Exactly one of Selector and Distribution must be set. Selector is a
+// text/template for a Go expression that computes a value in [0, N) inside
+// the benchmark loop. The loop index is available as i and the branch count
+// as {{.N}}. A Selector that masks with &, e.g. i & ({{.N}} - 1), only stays
+// in range for power of two branch counts. Distribution is a dist.Parse
+// spec; the benchmark loop looks up each branch in a sequence of selectors
+// pre-generated from it.
+//
+// A Distribution without arguments may have a Sweep, in which case one
+// sub-benchmark level is added per sweep value, each using the distribution
+// with that value as its argument.
+type InputStrategy struct {
+	Name         string `json:"name"`
+	Selector     string `json:"selector,omitempty"`
+	Distribution string `json:"distribution,omitempty"`
+	Sweep        *Sweep `json:"sweep,omitempty"`
+
+	selector *template.Template
+}
+
+// Sweep is a list of values of a distribution parameter. Param is used as
+// the key of the sub-benchmark level, e.g. p=0.25.
+type Sweep struct {
+	Param  string    `json:"param"`
+	Values []float64 `json:"values"`
+}
+
+// Spec returns the distribution spec with the sweep value v applied.
+func (s InputStrategy) Spec(v float64) string {
+	return fmt.Sprintf("%s(%s)", s.Distribution, formatValue(v))
+}
+
+// formatValue formats a sweep value for a spec or sub-benchmark name.
+func formatValue(v float64) string {
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+// IndexMode describes how the benchmark loop reads the selector for
+// iteration i from the pre-generated inputs.
+type IndexMode struct {
+	// Setup is a statement run before the timed loop, if any.
+	Setup string
+
+	// Selector is the expression for the selector of iteration i.
+	Selector string
+
+	// Range is whether the loop ranges over inputs, wrapping around at the
+	// end, instead of indexing it with i. Selector is then the range value s.
+	Range bool
+
+	// PowerOfTwo is whether the mode requires every input length to be a
+	// power of two.
+	PowerOfTwo bool
+}
+
+// indexModes are the index modes the generator knows how to emit. mod is
+// the straightforward loop, mask replaces the modulo with a mask and range
+// avoids both the modulo and the bounds check.
+var indexModes = map[string]IndexMode{
+	"mod":   {Selector: "inputs[i%len(inputs)]"},
+	"mask":  {Setup: "mask := len(inputs) - 1", Selector: "inputs[i&mask]", PowerOfTwo: true},
+	"range": {Selector: "s", Range: true},
+}
+
+// FuncKind describes how the generated functions of one kind are declared.
+// Every kind shares the same body so that only the declaration differs
+// between kinds.
+type FuncKind struct {
+	// Directive is a compiler directive placed above each function, if any.
+	Directive string
+
+	// Inline is whether the compiler is expected to inline the functions. It
+	// is used as the inline= element of sub-benchmark names.
+	Inline bool
+}
+
+// funcKinds are the function kinds the generator knows how to emit.
+var funcKinds = map[string]FuncKind{
+	"Inline":   {Inline: true},
+	"NoInline": {Directive: "//go:noinline"},
+}
+
+// DispatchStrategy describes what the benchmarks of a dispatch strategy
+// need from the matrix and the generated file.
+type DispatchStrategy struct {
+	// Import is a package the generated benchmarks use, if any.
+	Import string
+
+	// PowerOfTwo is whether the strategy requires every branch count to be a
+	// power of two.
+	PowerOfTwo bool
+}
+
+// dispatchStrategies are the dispatch strategies the benchmark template
+// knows how to emit.
+//
+// IfChain is an if/else if ladder comparing the selector with each case in
+// turn. Array indexes an [N]func(int) int with the selector masked by N-1,
+// which lets the compiler drop the bounds check, and ArrayUnsafe indexes the
+// same array with pointer arithmetic. Interface calls the Handle method of a
+// []Handler, one concrete type per branch.
+//
+// The Type strategies dispatch on the dynamic type of a []interface{} holding the
+// same Handler types: TypeSwitch with a type switch, TypeMap by looking up
+// a handler in a map[reflect.Type]func(interface{}, int) int and passing it the
+// value, and TypeAssert by asserting the value to Handler and calling Handle.
+//
+// None does no dispatch at all; it only adds the selector to the result so
+// that the cost of the loop and the selector can be subtracted from the
+// other strategies.
+var dispatchStrategies = map[string]DispatchStrategy{
+	"Switch":      {},
+	"IfChain":     {},
+	"Slice":       {},
+	"Array":       {PowerOfTwo: true},
+	"ArrayUnsafe": {Import: "unsafe"},
+	"Interface":   {},
+	"Map":         {},
+	"TypeSwitch":  {},
+	"TypeMap":     {Import: "reflect"},
+	"TypeAssert":  {},
+	"None":        {},
+}
+
+func readMatrix(path string) (*Matrix, error) {
+	buf, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var m Matrix
+	dec := json.NewDecoder(bytes.NewReader(buf))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&m); err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+
+	if err := m.validate(); err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+
+	return &m, nil
+}
+
+func (m *Matrix) validate() error {
+	if len(m.BranchCounts) == 0 {
+		return fmt.Errorf("branchCounts is empty")
+	}
+	for _, n := range m.BranchCounts {
+		if n < 1 {
+			return fmt.Errorf("branch count %d is less than 1", n)
+		}
+	}
+
+	if len(m.FuncKinds) == 0 {
+		return fmt.Errorf("funcKinds is empty")
+	}
+	for _, k := range m.FuncKinds {
+		if _, ok := funcKinds[k]; !ok {
+			return fmt.Errorf("unknown function kind %q", k)
+		}
+	}
+
+	if len(m.InputStrategies) == 0 {
+		return fmt.Errorf("inputStrategies is empty")
+	}
+	for i := range m.InputStrategies {
+		s := &m.InputStrategies[i]
+		if !validName(s.Name) {
+			return fmt.Errorf("input strategy %d has invalid name %q", i, s.Name)
+		}
+		if (s.Selector == "") == (s.Distribution == "") {
+			return fmt.Errorf("input strategy %s: exactly one of selector and distribution is required", s.Name)
+		}
+		if s.Sweep != nil {
+			if s.Distribution == "" {
+				return fmt.Errorf("input strategy %s: sweep requires a distribution", s.Name)
+			}
+			if !validName(s.Sweep.Param) || len(s.Sweep.Values) == 0 {
+				return fmt.Errorf("input strategy %s: sweep needs a valid param and values", s.Name)
+			}
+			for _, v := range s.Sweep.Values {
+				if _, err := dist.Parse(s.Spec(v)); err != nil {
+					return fmt.Errorf("input strategy %s: %v", s.Name, err)
+				}
+			}
+			continue
+		}
+		if s.Distribution != "" {
+			if _, err := dist.Parse(s.Distribution); err != nil {
+				return fmt.Errorf("input strategy %s: %v", s.Name, err)
+			}
+			continue
+		}
+		t, err := template.New(s.Name).Option("missingkey=error").Parse(s.Selector)
+		if err != nil {
+			return fmt.Errorf("input strategy %s: %v", s.Name, err)
+		}
+		s.selector = t
+		if !strings.Contains(s.Selector, "&") {
+			continue
+		}
+		for _, n := range m.BranchCounts {
+			if n&(n-1) != 0 {
+				return fmt.Errorf("input strategy %s masks its selector and requires power of two branch counts, got %d", s.Name, n)
+			}
+		}
+	}
+	seen := make(map[string]bool)
+	for _, s := range m.InputStrategies {
+		if seen[s.Name] {
+			return fmt.Errorf("duplicate input strategy %s", s.Name)
+		}
+		seen[s.Name] = true
+	}
+
+	if m.HasDistributions() && len(m.InputLengths) == 0 {
+		return fmt.Errorf("inputLengths is empty")
+	}
+	for _, l := range m.InputLengths {
+		if l < 1 {
+			return fmt.Errorf("input length %d is less than 1", l)
+		}
+	}
+
+	if m.HasDistributions() && len(m.IndexModes) == 0 {
+		return fmt.Errorf("indexModes is empty")
+	}
+	for _, name := range m.IndexModes {
+		mode, ok := indexModes[name]
+		if !ok {
+			return fmt.Errorf("unknown index mode %q", name)
+		}
+		if !mode.PowerOfTwo {
+			continue
+		}
+		for _, l := range m.InputLengths {
+			if l&(l-1) != 0 {
+				return fmt.Errorf("index mode %s requires power of two input lengths, got %d", name, l)
+			}
+		}
+	}
+
+	if len(m.DispatchStrategies) == 0 {
+		return fmt.Errorf("dispatchStrategies is empty")
+	}
+	for _, d := range m.DispatchStrategies {
+		ds, ok := dispatchStrategies[d]
+		if !ok {
+			return fmt.Errorf("unknown dispatch strategy %q", d)
+		}
+		if !ds.PowerOfTwo {
+			continue
+		}
+		for _, n := range m.BranchCounts {
+			if n&(n-1) != 0 {
+				return fmt.Errorf("dispatch strategy %s requires power of two branch counts, got %d", d, n)
+			}
+		}
+	}
+
+	if m.StringKeys != nil {
+		if err := m.StringKeys.validate(m); err != nil {
+			return fmt.Errorf("stringKeys: %v", err)
+		}
+	}
+
+	if m.KeyLayouts != nil {
+		if err := m.KeyLayouts.validate(m); err != nil {
+			return fmt.Errorf("keyLayouts: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// validName reports whether s can be used both in a sub-benchmark name and,
+// capitalized, in a Go identifier.
+func validName(s string) bool {
+	if s == "" || s[0] < 'a' || s[0] > 'z' {
+		return false
+	}
+	for _, r := range s {
+		if !('a' <= r && r <= 'z' || '0' <= r && r <= '9') {
+			return false
+		}
+	}
+	return true
+}
+
+// MaxBranchCount is the number of functions that must be generated for each
+// function kind.
+func (m *Matrix) MaxBranchCount() int {
+	max := 0
+	for _, n := range m.BranchCounts {
+		if n > max {
+			max = n
+		}
+	}
+	return max
+}
+
+// HasDistributions reports whether any input strategy uses a distribution,
+// and so whether the shared lookup benchmarks are needed.
+func (m *Matrix) HasDistributions() bool {
+	for _, s := range m.InputStrategies {
+		if s.Distribution != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// Imports returns the packages the benchmarks of the dispatch strategies
+// use other than testing, in sorted order.
+func (m *Matrix) Imports() []string {
+	var imports []string
+	for _, d := range m.DispatchStrategies {
+		if p := dispatchStrategies[d].Import; p != "" && !contains(imports, p) {
+			imports = append(imports, p)
+		}
+	}
+	sort.Strings(imports)
+	return imports
+}
+
+func contains(s []string, v string) bool {
+	for _, e := range s {
+		if e == v {
+			return true
+		}
+	}
+	return false
+}
+
+// Select renders the selector expression for n branches. It must only be
+// called for input strategies with a Selector.
+func (s InputStrategy) Select(n int) (string, error) {
+	var buf bytes.Buffer
+	if err := s.selector.Execute(&buf, struct{ N int }{n}); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
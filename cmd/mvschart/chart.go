@@ -0,0 +1,184 @@
+package main
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Chart is a line chart of ns/op, one line per strategy.
+type Chart struct {
+	Title string
+	Lines []Line
+
+	// XLabel labels the x axis. If LogX is set, the x axis is log2 scaled
+	// with a tick at every x value, as is used for branch counts. Otherwise
+	// it is linear from 0.
+	XLabel string
+	LogX   bool
+}
+
+// Line is the median ns/op of one strategy at each x value.
+type Line struct {
+	Strategy string
+	Points   []Point
+}
+
+// Point is one x value and its median ns/op.
+type Point struct {
+	X       float64
+	NsPerOp float64
+}
+
+// Chart geometry in SVG user units.
+const (
+	width        = 640
+	height       = 400
+	marginLeft   = 64
+	marginRight  = 120
+	marginTop    = 40
+	marginBottom = 56
+	plotWidth    = width - marginLeft - marginRight
+	plotHeight   = height - marginTop - marginBottom
+)
+
+// palette is the line colors, assigned to strategies in sorted order. It
+// has a color for every dispatch strategy in matrix.json, so that no two
+// lines of a chart share one.
+var palette = []string{
+	"#1f77b4", "#d62728", "#2ca02c", "#ff7f0e", "#9467bd", "#8c564b", "#e377c2", "#7f7f7f",
+	"#bcbd22", "#17becf", "#393b79", "#637939", "#8c6d31", "#843c39", "#7b4173", "#000000",
+}
+
+// WriteSVG writes c to w as a standalone SVG document with ns/op on the y
+// axis starting at 0.
+func (c *Chart) WriteSVG(w io.Writer) error {
+	var xs []float64
+	seen := make(map[float64]bool)
+	maxY := 0.0
+	for _, l := range c.Lines {
+		for _, p := range l.Points {
+			if !seen[p.X] {
+				seen[p.X] = true
+				xs = append(xs, p.X)
+			}
+			maxY = math.Max(maxY, p.NsPerOp)
+		}
+	}
+	if len(xs) == 0 {
+		return fmt.Errorf("chart %q has no points", c.Title)
+	}
+	if len(c.Lines) > len(palette) {
+		return fmt.Errorf("chart %q has %d lines but only %d colors", c.Title, len(c.Lines), len(palette))
+	}
+	sort.Float64s(xs)
+
+	scaleX := func(v float64) float64 { return v }
+	var xTicks []float64
+	var minX, maxX float64
+	xDecimals := 0
+	if c.LogX {
+		scaleX = math.Log2
+		xTicks = xs
+		minX, maxX = math.Log2(xs[0]), math.Log2(xs[len(xs)-1])
+	} else {
+		xStep := niceStep(xs[len(xs)-1] / 5)
+		if xStep == 0 {
+			xStep = 1
+		}
+		maxX = math.Ceil(xs[len(xs)-1]/xStep) * xStep
+		for i := 0; float64(i)*xStep <= maxX+xStep/2; i++ {
+			xTicks = append(xTicks, float64(i)*xStep)
+		}
+		xDecimals = decimals(xStep)
+	}
+	if minX == maxX {
+		minX, maxX = minX-1, maxX+1
+	}
+
+	step := niceStep(maxY / 5)
+	if step == 0 {
+		step = 1
+	}
+	maxY = math.Ceil(maxY/step) * step
+
+	x := func(v float64) float64 {
+		return marginLeft + (scaleX(v)-minX)/(maxX-minX)*plotWidth
+	}
+	y := func(v float64) float64 {
+		return marginTop + plotHeight - v/maxY*plotHeight
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" font-family="sans-serif" font-size="12">`+"\n", width, height, width, height)
+	fmt.Fprintf(&sb, `<rect width="%d" height="%d" fill="white"/>`+"\n", width, height)
+	fmt.Fprintf(&sb, `<text x="%d" y="%d" text-anchor="middle" font-size="14">%s</text>`+"\n", marginLeft+plotWidth/2, marginTop/2+5, html.EscapeString(c.Title))
+
+	// Grid lines and y axis labels.
+	yDecimals := decimals(step)
+	for i := 0; float64(i)*step <= maxY+step/2; i++ {
+		v := float64(i) * step
+		fmt.Fprintf(&sb, `<line x1="%d" y1="%.1f" x2="%d" y2="%.1f" stroke="#ddd"/>`+"\n", marginLeft, y(v), marginLeft+plotWidth, y(v))
+		fmt.Fprintf(&sb, `<text x="%d" y="%.1f" text-anchor="end">%s</text>`+"\n", marginLeft-6, y(v)+4, strconv.FormatFloat(v, 'f', yDecimals, 64))
+	}
+
+	for _, v := range xTicks {
+		fmt.Fprintf(&sb, `<line x1="%.1f" y1="%d" x2="%.1f" y2="%d" stroke="black"/>`+"\n", x(v), marginTop+plotHeight, x(v), marginTop+plotHeight+4)
+		fmt.Fprintf(&sb, `<text x="%.1f" y="%d" text-anchor="middle">%s</text>`+"\n", x(v), marginTop+plotHeight+18, strconv.FormatFloat(v, 'f', xDecimals, 64))
+	}
+
+	fmt.Fprintf(&sb, `<line x1="%d" y1="%d" x2="%d" y2="%d" stroke="black"/>`+"\n", marginLeft, marginTop+plotHeight, marginLeft+plotWidth, marginTop+plotHeight)
+	fmt.Fprintf(&sb, `<line x1="%d" y1="%d" x2="%d" y2="%d" stroke="black"/>`+"\n", marginLeft, marginTop, marginLeft, marginTop+plotHeight)
+	fmt.Fprintf(&sb, `<text x="%d" y="%d" text-anchor="middle">%s</text>`+"\n", marginLeft+plotWidth/2, height-16, html.EscapeString(c.XLabel))
+	fmt.Fprintf(&sb, `<text transform="translate(16 %d) rotate(-90)" text-anchor="middle">median ns/op</text>`+"\n", marginTop+plotHeight/2)
+
+	lines := append([]Line(nil), c.Lines...)
+	sort.Slice(lines, func(i, j int) bool { return lines[i].Strategy < lines[j].Strategy })
+	for i, l := range lines {
+		color := palette[i]
+
+		points := append([]Point(nil), l.Points...)
+		sort.Slice(points, func(i, j int) bool { return points[i].X < points[j].X })
+		coords := make([]string, len(points))
+		for j, p := range points {
+			coords[j] = fmt.Sprintf("%.1f,%.1f", x(p.X), y(p.NsPerOp))
+		}
+		fmt.Fprintf(&sb, `<polyline fill="none" stroke="%s" stroke-width="2" points="%s"/>`+"\n", color, strings.Join(coords, " "))
+		for _, p := range points {
+			fmt.Fprintf(&sb, `<circle cx="%.1f" cy="%.1f" r="3" fill="%s"/>`+"\n", x(p.X), y(p.NsPerOp), color)
+		}
+
+		ly := marginTop + 10 + i*18
+		fmt.Fprintf(&sb, `<line x1="%d" y1="%d" x2="%d" y2="%d" stroke="%s" stroke-width="2"/>`+"\n", width-marginRight+12, ly, width-marginRight+32, ly, color)
+		fmt.Fprintf(&sb, `<text x="%d" y="%d">%s</text>`+"\n", width-marginRight+38, ly+4, html.EscapeString(l.Strategy))
+	}
+
+	sb.WriteString("</svg>\n")
+
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+// niceStep rounds raw up to 1, 2 or 5 times a power of 10.
+func niceStep(raw float64) float64 {
+	if raw <= 0 {
+		return 0
+	}
+	pow := math.Pow(10, math.Floor(math.Log10(raw)))
+	for _, m := range []float64{1, 2, 5, 10} {
+		if raw <= m*pow {
+			return m * pow
+		}
+	}
+	return 10 * pow
+}
+
+// decimals returns the number of decimal places needed to print multiples of
+// step.
+func decimals(step float64) int {
+	return int(math.Max(0, -math.Floor(math.Log10(step))))
+}
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestChartWriteSVG(t *testing.T) {
+	c := &Chart{
+		Title:  "inline=false/pattern=random",
+		XLabel: "branches (N, log2 scale)",
+		LogX:   true,
+		Lines: []Line{
+			{Strategy: "switch", Points: []Point{{4, 14.2}, {64, 26.8}, {512, 39.3}}},
+			{Strategy: "map", Points: []Point{{512, 22.7}, {4, 19}, {64, 22.1}}},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := c.WriteSVG(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	counts := make(map[string]int)
+	dec := xml.NewDecoder(&buf)
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			t.Fatalf("invalid SVG: %v", err)
+		}
+		if se, ok := tok.(xml.StartElement); ok {
+			counts[se.Name.Local]++
+		}
+	}
+
+	if counts["svg"] != 1 || counts["polyline"] != 2 || counts["circle"] != 6 {
+		t.Errorf("unexpected element counts %v", counts)
+	}
+
+	c.LogX = false
+	if err := c.WriteSVG(io.Discard); err != nil {
+		t.Errorf("linear x axis: %v", err)
+	}
+}
+
+func TestChartWriteSVGEmpty(t *testing.T) {
+	c := &Chart{Title: "empty"}
+	if err := c.WriteSVG(io.Discard); err == nil || !strings.Contains(err.Error(), "no points") {
+		t.Errorf("expected no points error, got %v", err)
+	}
+}
+
+func TestChartWriteSVGTooManyLines(t *testing.T) {
+	c := &Chart{Title: "crowded"}
+	for i := 0; i <= len(palette); i++ {
+		c.Lines = append(c.Lines, Line{Strategy: strconv.Itoa(i), Points: []Point{{4, 1}}})
+	}
+	if err := c.WriteSVG(io.Discard); err == nil || !strings.Contains(err.Error(), "colors") {
+		t.Errorf("expected too many lines error, got %v", err)
+	}
+}
+
+// TestPaletteCoversMatrix checks that a chart can have a line for every
+// dispatch strategy in the matrix without two lines sharing a color.
+func TestPaletteCoversMatrix(t *testing.T) {
+	data, err := os.ReadFile("../../matrix.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	type keyed struct {
+		DispatchStrategies []string `json:"dispatchStrategies"`
+	}
+	var m struct {
+		keyed
+		StringKeys *keyed `json:"stringKeys"`
+		KeyLayouts *keyed `json:"keyLayouts"`
+	}
+	if err := json.Unmarshal(data, &m); err != nil {
+		t.Fatal(err)
+	}
+
+	strategies := len(m.DispatchStrategies)
+	for _, k := range []*keyed{m.StringKeys, m.KeyLayouts} {
+		if k != nil {
+			strategies += len(k.DispatchStrategies)
+		}
+	}
+	if strategies > len(palette) {
+		t.Errorf("matrix.json has %d dispatch strategies but the palette has %d colors", strategies, len(palette))
+	}
+}
+
+func TestNiceStep(t *testing.T) {
+	tests := []struct{ raw, want float64 }{
+		{0.7, 1},
+		{3, 5},
+		{7.9, 10},
+		{12, 20},
+		{45, 50},
+	}
+	for _, tt := range tests {
+		if got := niceStep(tt.raw); got != tt.want {
+			t.Errorf("niceStep(%v) = %v, want %v", tt.raw, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,151 @@
+// Command mvschart draws SVG line charts of median ns/op against branch
+// count, or against a reported metric, from a results dataset.
+//
+// One chart is written per panel, i.e. per combination of the dimensions
+// other than strategy and those varied along the x axis, such as
+// inline=false/pattern=random. Each chart has one line per dispatch
+// strategy.
+//
+//	mvschart -out charts results.json
+//
+// With -x set to a metric, the x axis is that metric instead of the branch
+// count and -over names the dimensions that vary along it. For example, to
+// chart ns/op against measured entropy across the mix sweep:
+//
+//	mvschart -x entropy-bits -over p -out charts results.json
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/jackc/go_map_vs_switch/internal/compare"
+	"github.com/jackc/go_map_vs_switch/internal/results"
+)
+
+// panelExclude are the dimensions that are never part of a panel. The
+// derived dimensions are excluded as they add nothing to the pattern.
+var panelExclude = []string{results.DimStrategy, results.DimPredictability, results.DimSelection}
+
+func main() {
+	out := flag.String("out", "charts", "directory to write the charts to")
+	x := flag.String("x", results.DimN, "x axis: n for the branch count or the unit of a reported metric")
+	over := flag.String("over", "", "comma separated dimensions that vary along the x axis (default n when -x is n)")
+	flag.Parse()
+
+	if flag.NArg() != 1 {
+		fmt.Fprintln(os.Stderr, "usage: mvschart [flags] results.json")
+		flag.PrintDefaults()
+		os.Exit(2)
+	}
+
+	var overDims []string
+	if *over != "" {
+		overDims = strings.Split(*over, ",")
+	} else if *x == results.DimN {
+		overDims = []string{results.DimN}
+	}
+
+	if err := run(flag.Arg(0), *out, *x, overDims); err != nil {
+		fmt.Fprintf(os.Stderr, "mvschart: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(path, out, x string, over []string) error {
+	d, err := results.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	charts := buildCharts(d, x, over)
+	if len(charts) == 0 {
+		return fmt.Errorf("%s: no results with %s", path, x)
+	}
+
+	if err := os.MkdirAll(out, 0755); err != nil {
+		return err
+	}
+
+	for _, c := range charts {
+		f, err := os.Create(filepath.Join(out, chartFileName(c.Title)))
+		if err != nil {
+			return err
+		}
+		if err := c.WriteSVG(f); err != nil {
+			f.Close()
+			return err
+		}
+		if err := f.Close(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// buildCharts returns one chart per panel of d sorted by title. The x value
+// of each point is the branch count if x is n, otherwise the metric with
+// unit x. Results without an x value are skipped.
+func buildCharts(d *results.Dataset, x string, over []string) []*Chart {
+	exclude := append(append([]string(nil), panelExclude...), over...)
+
+	byTitle := make(map[string]*Chart)
+	lineIndex := make(map[string]map[string]int)
+	var charts []*Chart
+
+	for _, s := range compare.Summarize(d, 0.95) {
+		r := results.Result{Dims: s.Dims}
+
+		var xv float64
+		if x == results.DimN {
+			xv = float64(r.N())
+			if xv == 0 {
+				continue
+			}
+		} else {
+			v, ok := s.Metrics[x]
+			if !ok {
+				continue
+			}
+			xv = v
+		}
+
+		title := r.Key(exclude...)
+		c, ok := byTitle[title]
+		if !ok {
+			c = &Chart{Title: title, XLabel: x, LogX: x == results.DimN}
+			if c.LogX {
+				c.XLabel = "branches (N, log2 scale)"
+			}
+			byTitle[title] = c
+			lineIndex[title] = make(map[string]int)
+			charts = append(charts, c)
+		}
+
+		strategy := s.Dims[results.DimStrategy]
+		i, ok := lineIndex[title][strategy]
+		if !ok {
+			i = len(c.Lines)
+			c.Lines = append(c.Lines, Line{Strategy: strategy})
+			lineIndex[title][strategy] = i
+		}
+		c.Lines[i].Points = append(c.Lines[i].Points, Point{X: xv, NsPerOp: s.Median})
+	}
+
+	sort.Slice(charts, func(i, j int) bool { return charts[i].Title < charts[j].Title })
+	return charts
+}
+
+// chartFileName returns the file name for the chart titled title, e.g.
+// inline-false_pattern-random.svg for inline=false/pattern=random.
+func chartFileName(title string) string {
+	if title == "" {
+		return "chart.svg"
+	}
+	return strings.NewReplacer("/", "_", "=", "-").Replace(title) + ".svg"
+}
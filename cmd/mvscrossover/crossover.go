@@ -0,0 +1,153 @@
+package main
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+
+	"github.com/jackc/go_map_vs_switch/internal/results"
+	"github.com/jackc/go_map_vs_switch/internal/stats"
+)
+
+// groupExclude are the dimensions that vary within a group. The derived
+// dimensions are excluded too as they add nothing to the pattern.
+var groupExclude = []string{results.DimStrategy, results.DimN, results.DimPredictability, results.DimSelection}
+
+// samples holds the ns/op measurements of one strategy by branch count.
+type samples map[int][]float64
+
+// group is the results that differ only in strategy and branch count.
+type group struct {
+	key        string
+	strategies map[string]samples
+}
+
+// groupResults groups the results of d and returns the groups sorted by key.
+func groupResults(d *results.Dataset) []*group {
+	byKey := make(map[string]*group)
+	var groups []*group
+	for i := range d.Results {
+		r := &d.Results[i]
+		n := r.N()
+		if n == 0 {
+			continue
+		}
+
+		key := r.Key(groupExclude...)
+		g, ok := byKey[key]
+		if !ok {
+			g = &group{key: key, strategies: make(map[string]samples)}
+			byKey[key] = g
+			groups = append(groups, g)
+		}
+
+		strategy := r.Dims[results.DimStrategy]
+		s, ok := g.strategies[strategy]
+		if !ok {
+			s = make(samples)
+			g.strategies[strategy] = s
+		}
+		s[n] = append(s[n], r.NsPerOp)
+	}
+
+	sort.Slice(groups, func(i, j int) bool { return groups[i].key < groups[j].key })
+	return groups
+}
+
+// commonNs returns the sorted branch counts measured for both a and b.
+func commonNs(a, b samples) []int {
+	var ns []int
+	for n := range a {
+		if _, ok := b[n]; ok {
+			ns = append(ns, n)
+		}
+	}
+	sort.Ints(ns)
+	return ns
+}
+
+// Crossover describes where a table strategy's ns/op crosses the baseline's.
+type Crossover struct {
+	Group    string
+	Strategy string
+
+	// Found is whether the medians cross between two measured branch counts.
+	// If not, TableFaster reports which side was faster at every N.
+	Found bool
+
+	// N is the crossover branch count, interpolated linearly in log2(N).
+	N float64
+
+	// TableFaster is whether the table is faster above N, or at every N if
+	// the medians never cross.
+	TableFaster bool
+
+	// Lo and Hi are the bootstrap confidence bounds of N. Support is the
+	// fraction of bootstrap resamples that crossed in the same direction.
+	Lo, Hi  float64
+	Support float64
+}
+
+// crossover finds the first sign change of baseline - table medians over ns
+// and returns the interpolated N and whether the table is faster above it.
+// If there is no sign change, found is false and tableFaster reports whether
+// the table was faster throughout.
+func crossover(ns []int, baseline, table func(n int) float64) (x float64, tableFaster, found bool) {
+	prev := baseline(ns[0]) - table(ns[0])
+	for i := 1; i < len(ns); i++ {
+		d := baseline(ns[i]) - table(ns[i])
+		if (prev > 0) != (d > 0) {
+			x0, x1 := math.Log2(float64(ns[i-1])), math.Log2(float64(ns[i]))
+			t := prev / (prev - d)
+			return math.Exp2(x0 + t*(x1-x0)), d > 0, true
+		}
+		prev = d
+	}
+	return 0, prev > 0, false
+}
+
+// findCrossover computes the crossover of table against baseline, with
+// confidence bounds from bootstrap resamples of the repeated runs at each N.
+func findCrossover(baseline, table samples, bootstrap int, confidence float64, rng *rand.Rand) (c Crossover, ok bool) {
+	ns := commonNs(baseline, table)
+	if len(ns) == 0 {
+		return c, false
+	}
+
+	median := func(s samples) func(int) float64 {
+		return func(n int) float64 { return stats.Median(s[n]) }
+	}
+	c.N, c.TableFaster, c.Found = crossover(ns, median(baseline), median(table))
+	if !c.Found {
+		return c, true
+	}
+
+	var xs []float64
+	for i := 0; i < bootstrap; i++ {
+		resample := func(s samples) func(int) float64 {
+			return func(n int) float64 {
+				r := make([]float64, len(s[n]))
+				for j := range r {
+					r[j] = s[n][rng.Intn(len(s[n]))]
+				}
+				return stats.Median(r)
+			}
+		}
+		x, tableFaster, found := crossover(ns, resample(baseline), resample(table))
+		if found && tableFaster == c.TableFaster {
+			xs = append(xs, x)
+		}
+	}
+
+	c.Lo, c.Hi = math.NaN(), math.NaN()
+	if len(xs) > 0 {
+		alpha := (1 - confidence) / 2
+		c.Lo = stats.Quantile(xs, alpha)
+		c.Hi = stats.Quantile(xs, 1-alpha)
+	}
+	if bootstrap > 0 {
+		c.Support = float64(len(xs)) / float64(bootstrap)
+	}
+
+	return c, true
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestCrossover(t *testing.T) {
+	ns := []int{4, 8, 16, 32}
+	baseline := map[int]float64{4: 10, 8: 12, 16: 16, 32: 24}
+
+	tests := []struct {
+		name        string
+		table       map[int]float64
+		x           float64
+		tableFaster bool
+		found       bool
+	}{
+		{"table faster above midpoint", map[int]float64{4: 14, 8: 14, 16: 14, 32: 14}, math.Exp2(3.5), true, true},
+		{"table faster below", map[int]float64{4: 8, 8: 14, 16: 18, 32: 26}, math.Exp2(2.5), false, true},
+		{"table always faster", map[int]float64{4: 5, 8: 5, 16: 5, 32: 5}, 0, true, false},
+		{"switch always faster", map[int]float64{4: 50, 8: 50, 16: 50, 32: 50}, 0, false, false},
+	}
+
+	for _, tt := range tests {
+		x, tableFaster, found := crossover(ns, func(n int) float64 { return baseline[n] }, func(n int) float64 { return tt.table[n] })
+		if found != tt.found || tableFaster != tt.tableFaster || math.Abs(x-tt.x) > 1e-9 {
+			t.Errorf("%s: got (%v, %v, %v), want (%v, %v, %v)", tt.name, x, tableFaster, found, tt.x, tt.tableFaster, tt.found)
+		}
+	}
+}
+
+func TestFindCrossoverBounds(t *testing.T) {
+	baseline := samples{4: {10, 10.5, 9.5}, 8: {12, 12.5, 11.5}, 16: {16, 16.5, 15.5}, 32: {24, 24.5, 23.5}}
+	table := samples{4: {14, 14.5, 13.5}, 8: {14, 14.5, 13.5}, 16: {14, 14.5, 13.5}, 32: {14, 14.5, 13.5}}
+
+	c, ok := findCrossover(baseline, table, 200, 0.95, rand.New(rand.NewSource(1)))
+	if !ok || !c.Found || !c.TableFaster {
+		t.Fatalf("unexpected crossover %+v", c)
+	}
+	if !(8 <= c.Lo && c.Lo <= c.N && c.N <= c.Hi && c.Hi <= 16) {
+		t.Errorf("bounds [%v, %v] do not bracket %v within [8, 16]", c.Lo, c.Hi, c.N)
+	}
+	if c.Support != 1 {
+		t.Errorf("Support = %v, want 1", c.Support)
+	}
+}
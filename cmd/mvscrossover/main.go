@@ -0,0 +1,113 @@
+// Command mvscrossover reports the branch count at which each table strategy
+// overtakes the switch.
+//
+// For every group of results that differ only in strategy and branch count
+// (e.g. inline=false/pattern=random), it compares the median ns/op of each
+// strategy with the baseline at every N. The first point where the
+// difference changes sign is interpolated linearly in log2(N). Confidence
+// bounds come from bootstrap resamples of repeated runs, so the dataset
+// should be collected with go test -count greater than 1.
+//
+//	mvscrossover results.json
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"math"
+	"math/rand"
+	"os"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/jackc/go_map_vs_switch/internal/results"
+)
+
+func main() {
+	baseline := flag.String("baseline", "switch", "strategy the other strategies are compared with")
+	bootstrap := flag.Int("bootstrap", 1000, "number of bootstrap resamples")
+	confidence := flag.Float64("confidence", 0.95, "confidence level of the bounds")
+	seed := flag.Int64("seed", 1, "seed for bootstrap resampling")
+	flag.Parse()
+
+	if flag.NArg() != 1 {
+		fmt.Fprintln(os.Stderr, "usage: mvscrossover [flags] results.json")
+		flag.PrintDefaults()
+		os.Exit(2)
+	}
+
+	d, err := results.ReadFile(flag.Arg(0))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "mvscrossover: %v\n", err)
+		os.Exit(1)
+	}
+
+	crossovers := findCrossovers(d, *baseline, *bootstrap, *confidence, rand.New(rand.NewSource(*seed)))
+	if len(crossovers) == 0 {
+		fmt.Fprintf(os.Stderr, "mvscrossover: no strategies to compare with %s\n", *baseline)
+		os.Exit(1)
+	}
+
+	if err := writeReport(os.Stdout, crossovers, *baseline, *confidence); err != nil {
+		fmt.Fprintf(os.Stderr, "mvscrossover: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func findCrossovers(d *results.Dataset, baseline string, bootstrap int, confidence float64, rng *rand.Rand) []Crossover {
+	var crossovers []Crossover
+	for _, g := range groupResults(d) {
+		base, ok := g.strategies[baseline]
+		if !ok {
+			continue
+		}
+
+		var names []string
+		for name := range g.strategies {
+			if name != baseline {
+				names = append(names, name)
+			}
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			c, ok := findCrossover(base, g.strategies[name], bootstrap, confidence, rng)
+			if !ok {
+				continue
+			}
+			c.Group = g.key
+			c.Strategy = name
+			crossovers = append(crossovers, c)
+		}
+	}
+	return crossovers
+}
+
+func writeReport(w io.Writer, crossovers []Crossover, baseline string, confidence float64) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintf(tw, "group\tstrategy\tcrossover N\t%g%% CI\tsupport\tresult\n", confidence*100)
+
+	for _, c := range crossovers {
+		if !c.Found {
+			faster := baseline
+			if c.TableFaster {
+				faster = c.Strategy
+			}
+			fmt.Fprintf(tw, "%s\t%s\t-\t-\t-\t%s faster at every N\n", c.Group, c.Strategy, faster)
+			continue
+		}
+
+		ci := "-"
+		if !math.IsNaN(c.Lo) {
+			ci = fmt.Sprintf("[%.1f, %.1f]", c.Lo, c.Hi)
+		}
+		direction := "above"
+		if !c.TableFaster {
+			direction = "below"
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%.1f\t%s\t%.0f%%\t%s faster %s crossover\n", c.Group, c.Strategy, c.N, ci, c.Support*100, c.Strategy, direction)
+	}
+
+	return tw.Flush()
+}
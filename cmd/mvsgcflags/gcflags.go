@@ -0,0 +1,52 @@
+package main
+
+import (
+	"github.com/jackc/go_map_vs_switch/internal/compare"
+	"github.com/jackc/go_map_vs_switch/internal/results"
+	"github.com/jackc/go_map_vs_switch/internal/runner"
+)
+
+// gcflagsConfigs maps each configuration name to its -gcflags value.
+var gcflagsConfigs = map[string]string{
+	"default":  "",
+	"noinline": "-l",
+	"nobce":    "-B",
+	"noopt":    "-N -l",
+}
+
+// gcflagsConfig returns c changed to build the benchmark package with the
+// configuration name.
+func gcflagsConfig(c runner.Config, name string) runner.Config {
+	c.Args = append([]string(nil), c.Args...)
+	if flags := gcflagsConfigs[name]; flags != "" {
+		c.Args = append(c.Args, "-gcflags="+flags)
+	}
+	return c
+}
+
+// configRows pairs the summaries of the default configuration with those of
+// every other configuration at the same point and returns a row per
+// benchmark with a cell per other configuration, and those configurations in
+// the order of configs.
+func configRows(sums []*compare.Summary, configs []string, alpha float64) ([]*compare.Row, []string) {
+	var others []string
+	for _, c := range configs {
+		if c != "default" {
+			others = append(others, c)
+		}
+	}
+	rows := compare.Rows(compare.PairsBy(sums, results.DimGCFlags, "default", alpha),
+		func(p *compare.Pair) string { return p.Baseline.Name },
+		func(p *compare.Pair) string { return p.Other.Dims[results.DimGCFlags] })
+	return rows, others
+}
+
+// strategyRows pairs the summaries of the baseline strategy with those of
+// every other strategy at the same point, which includes the configuration,
+// and returns a row per benchmark of the other strategies with a cell per
+// configuration.
+func strategyRows(sums []*compare.Summary, baseline string, alpha float64) []*compare.Row {
+	return compare.Rows(compare.Pairs(sums, baseline, alpha),
+		func(p *compare.Pair) string { return p.Other.Name },
+		func(p *compare.Pair) string { return p.Baseline.Dims[results.DimGCFlags] })
+}
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/jackc/go_map_vs_switch/internal/compare"
+	"github.com/jackc/go_map_vs_switch/internal/results"
+	"github.com/jackc/go_map_vs_switch/internal/runner"
+)
+
+const (
+	switchName = "BenchmarkSwitch/inline=true/pattern=random/n=4"
+	mapName    = "BenchmarkMap/inline=true/pattern=random/n=4"
+)
+
+func TestGCFlagsConfig(t *testing.T) {
+	base := runner.Config{Dir: "bench", Args: []string{"-tags=x"}}
+
+	for name, want := range map[string][]string{
+		"default":  {"-tags=x"},
+		"noinline": {"-tags=x", "-gcflags=-l"},
+		"nobce":    {"-tags=x", "-gcflags=-B"},
+		"noopt":    {"-tags=x", "-gcflags=-N -l"},
+	} {
+		if c := gcflagsConfig(base, name); !reflect.DeepEqual(c.Args, want) {
+			t.Errorf("%s: Args = %q, want %q", name, c.Args, want)
+		}
+	}
+
+	if !reflect.DeepEqual(base.Args, []string{"-tags=x"}) {
+		t.Errorf("base Args changed to %q", base.Args)
+	}
+}
+
+func TestRunConfigs(t *testing.T) {
+	var args [][]string
+	run := func(ctx context.Context, c runner.Config) (*results.Dataset, error) {
+		args = append(args, c.Args)
+		d := &results.Dataset{SchemaVersion: results.SchemaVersion, Date: "2026-10-18"}
+		d.Host.GoVersion = "go1.22.1"
+		for _, name := range []string{switchName, mapName} {
+			d.Results = append(d.Results, results.Result{Name: name, Dims: results.ParseName(name), NsPerOp: 10})
+		}
+		return d, nil
+	}
+
+	d, err := runConfigs(context.Background(), runner.Config{}, []string{"default", "noopt"}, run)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := [][]string{nil, {"-gcflags=-N -l"}}; !reflect.DeepEqual(args, want) {
+		t.Errorf("run Args = %q, want %q", args, want)
+	}
+	var tags []string
+	for _, r := range d.Results {
+		tags = append(tags, r.Dims[results.DimGCFlags])
+	}
+	if want := []string{"default", "default", "noopt", "noopt"}; !reflect.DeepEqual(tags, want) {
+		t.Errorf("gcflags dimensions = %q, want %q", tags, want)
+	}
+	if d.Date != "2026-10-18" || d.Host.GoVersion != "go1.22.1" {
+		t.Errorf("Date = %q, GoVersion = %q", d.Date, d.Host.GoVersion)
+	}
+}
+
+func TestRows(t *testing.T) {
+	d := &results.Dataset{}
+	add := func(name, config string, ns ...float64) {
+		for _, v := range ns {
+			dims := results.ParseName(name)
+			dims[results.DimGCFlags] = config
+			d.Results = append(d.Results, results.Result{Name: name, Dims: dims, NsPerOp: v})
+		}
+	}
+	add(switchName, "default", 10, 10, 10, 10)
+	add(mapName, "default", 15, 15, 15, 15)
+	add(switchName, "noinline", 20, 20, 20, 20)
+	add(mapName, "noinline", 15, 15, 15, 15)
+	add(switchName, "nobce", 9, 9, 9, 9)
+
+	configs := d.DimValues(results.DimGCFlags)
+	if want := []string{"default", "noinline", "nobce"}; !reflect.DeepEqual(configs, want) {
+		t.Fatalf("configs = %q, want %q", configs, want)
+	}
+	sums := compare.Summarize(d, 0.95)
+
+	rows, others := configRows(sums, configs, 0.05)
+	if want := []string{"noinline", "nobce"}; !reflect.DeepEqual(others, want) {
+		t.Errorf("configRows columns = %q, want %q", others, want)
+	}
+	var buf bytes.Buffer
+	if err := compare.WriteRows(&buf, rows, others); err != nil {
+		t.Fatal(err)
+	}
+	checkTable(t, "configRows", buf.String(), []string{
+		"benchmark                                       noinline               nobce",
+		"BenchmarkSwitch/inline=true/pattern=random/n=4  10.00 / 20.00 +100.0%  10.00 / 9.00 -10.0%",
+		"BenchmarkMap/inline=true/pattern=random/n=4     15.00 / 15.00 +0.0% ~  -",
+	})
+
+	rows = strategyRows(sums, "switch", 0.05)
+	buf.Reset()
+	if err := compare.WriteRows(&buf, rows, configs); err != nil {
+		t.Fatal(err)
+	}
+	checkTable(t, "strategyRows", buf.String(), []string{
+		"benchmark                                    default               noinline              nobce",
+		"BenchmarkMap/inline=true/pattern=random/n=4  10.00 / 15.00 +50.0%  20.00 / 15.00 -25.0%  -",
+	})
+}
+
+func checkTable(t *testing.T, name, got string, want []string) {
+	t.Helper()
+	lines := strings.Split(strings.TrimRight(got, "\n"), "\n")
+	if !reflect.DeepEqual(lines, want) {
+		t.Errorf("%s table =\n%s\nwant\n%s", name, got, strings.Join(want, "\n"))
+	}
+}
@@ -0,0 +1,145 @@
+// Command mvsgcflags runs the benchmark suite built with several compiler
+// flag configurations, to separate how much of each strategy's speed comes
+// from inlining, from bounds-check elimination and from optimization as a
+// whole.
+//
+// The configurations are named by -configs:
+//
+//	default   no -gcflags
+//	noinline  -gcflags=-l, inlining off
+//	nobce     -gcflags=-B, bounds checks off
+//	noopt     -gcflags='-N -l', optimizations and inlining off
+//
+// The flags apply to the benchmark package only. Every result is tagged
+// with the gcflags dimension, the name of its configuration.
+//
+//	mvsgcflags -count 5 -bench '/pattern=random/' -json results.json
+//
+// The report has a row per benchmark and a column per configuration other
+// than default, giving the change in ns/op from default. It is followed by
+// each strategy compared with -baseline under every configuration. Changes
+// that are not significant by a Mann-Whitney U test are marked with ~.
+//
+// With -in, mvsgcflags reports on an existing dataset instead of running
+// the suite.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/jackc/go_map_vs_switch/internal/compare"
+	"github.com/jackc/go_map_vs_switch/internal/results"
+	"github.com/jackc/go_map_vs_switch/internal/runner"
+)
+
+type options struct {
+	runner runner.Config
+
+	configs  []string
+	in       string
+	jsonPath string
+	baseline string
+	alpha    float64
+}
+
+func main() {
+	var o options
+	flag.StringVar(&o.runner.Dir, "dir", ".", "directory of the benchmark package")
+	flag.StringVar(&o.runner.Bench, "bench", ".", "run only benchmarks matching `regexp`")
+	flag.IntVar(&o.runner.Count, "count", 5, "run each benchmark `n` times per configuration")
+	flag.StringVar(&o.runner.Benchtime, "benchtime", "", "go test -benchtime value")
+	flag.StringVar(&o.runner.Seed, "seed", "", "seed for generating benchmark inputs (default $MVS_SEED or 1)")
+	configs := flag.String("configs", "default,noinline,nobce,noopt", "comma-separated `names` of the compiler configurations to run")
+	flag.StringVar(&o.in, "in", "", "report on an existing dataset `file` instead of running the suite")
+	flag.StringVar(&o.jsonPath, "json", "", "write the dataset as JSON to `file`")
+	flag.StringVar(&o.baseline, "baseline", "switch", "strategy the other strategies are compared with")
+	flag.Float64Var(&o.alpha, "alpha", 0.05, "significance level of the Mann-Whitney U test")
+	flag.Parse()
+
+	o.configs = strings.Split(*configs, ",")
+	for _, c := range o.configs {
+		if _, ok := gcflagsConfigs[c]; !ok {
+			fmt.Fprintf(os.Stderr, "mvsgcflags: unknown configuration %q\n", c)
+			os.Exit(2)
+		}
+	}
+
+	if err := run(context.Background(), o); err != nil {
+		fmt.Fprintf(os.Stderr, "mvsgcflags: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, o options) error {
+	var d *results.Dataset
+	var err error
+	if o.in != "" {
+		d, err = results.ReadFile(o.in)
+	} else {
+		if o.runner.SelectsAll() {
+			fmt.Fprintln(os.Stderr, "mvsgcflags: warning: running every benchmark takes many hours per count; use -bench to select a subset")
+		}
+		d, err = runConfigs(ctx, o.runner, o.configs, runner.Run)
+	}
+	if err != nil {
+		return err
+	}
+
+	if o.jsonPath != "" {
+		if err := results.WriteFile(o.jsonPath, d.WriteJSON); err != nil {
+			return err
+		}
+	}
+
+	configs := d.DimValues(results.DimGCFlags)
+	if len(configs) == 0 {
+		return fmt.Errorf("no results have a %s dimension", results.DimGCFlags)
+	}
+	sums := compare.Summarize(d, 0.95)
+
+	rows, others := configRows(sums, configs, o.alpha)
+	if len(rows) > 0 {
+		fmt.Println("default vs each configuration")
+		if err := compare.WriteRows(os.Stdout, rows, others); err != nil {
+			return err
+		}
+		fmt.Println()
+	}
+
+	rows = strategyRows(sums, o.baseline, o.alpha)
+	if len(rows) == 0 {
+		return nil
+	}
+	fmt.Printf("%s vs each strategy\n", o.baseline)
+	return compare.WriteRows(os.Stdout, rows, configs)
+}
+
+// runConfigs runs the suite described by c built with each configuration
+// using run, which is runner.Run outside tests, and returns the merged
+// results tagged with the configuration name.
+func runConfigs(ctx context.Context, c runner.Config, configs []string, run func(context.Context, runner.Config) (*results.Dataset, error)) (*results.Dataset, error) {
+	merged := &results.Dataset{SchemaVersion: results.SchemaVersion}
+	for _, name := range configs {
+		fmt.Fprintf(os.Stderr, "mvsgcflags: running %s\n", name)
+		d, err := run(ctx, gcflagsConfig(c, name))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", name, err)
+		}
+		for i := range d.Results {
+			d.Results[i].Dims[results.DimGCFlags] = name
+		}
+
+		if merged.Date == "" {
+			merged.Date = d.Date
+			merged.Host.GoVersion = d.Host.GoVersion
+			merged.Host.Hostname = d.Host.Hostname
+		}
+		merged.Merge(d)
+	}
+
+	return merged, nil
+}
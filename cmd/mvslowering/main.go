@@ -0,0 +1,84 @@
+// Command mvslowering reports how the compiler lowered the switch of each
+// generated switch benchmark: to a jump table, a binary search or a linear
+// sequence of comparisons.
+//
+// It builds the benchmark package's test binary, disassembles the generated
+// benchmark functions with go tool objdump and classifies each one. Only
+// amd64 binaries are understood.
+//
+//	mvslowering
+//
+// With -in, mvslowering also records the lowering of every result of an
+// existing dataset that measured one of the functions and writes the
+// dataset to -json, so that a change in ns/op can be read next to a change
+// in lowering. mvsrun -lowering does the same for the results it collects.
+//
+//	mvslowering -in results.json -json results.json
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/jackc/go_map_vs_switch/internal/lowering"
+	"github.com/jackc/go_map_vs_switch/internal/results"
+	"github.com/jackc/go_map_vs_switch/internal/runner"
+)
+
+func main() {
+	var c runner.Config
+	flag.StringVar(&c.Dir, "dir", ".", "directory of the benchmark package")
+	funcs := flag.String("funcs", lowering.DefaultFuncs, "inspect only functions whose symbol names match `regexp`")
+	in := flag.String("in", "", "record the lowering in the results of the dataset `file`")
+	jsonPath := flag.String("json", "", "write the dataset read with -in as JSON to `file`")
+	flag.Parse()
+
+	if (*in == "") != (*jsonPath == "") {
+		fmt.Fprintln(os.Stderr, "mvslowering: -in and -json must be used together")
+		os.Exit(2)
+	}
+
+	if err := run(context.Background(), c, *funcs, *in, *jsonPath); err != nil {
+		fmt.Fprintf(os.Stderr, "mvslowering: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, c runner.Config, funcs, in, jsonPath string) error {
+	var d *results.Dataset
+	if in != "" {
+		var err error
+		d, err = results.ReadFile(in)
+		if err != nil {
+			return err
+		}
+	}
+
+	fs, err := lowering.Inspect(ctx, c, funcs)
+	if err != nil {
+		return err
+	}
+	if len(fs) == 0 {
+		return fmt.Errorf("no functions match %s", funcs)
+	}
+
+	tw := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintf(tw, "function\tlowering\tjump tables\tordered\tequality\n")
+	for _, f := range fs {
+		fmt.Fprintf(tw, "%s\t%s\t%d\t%d\t%d\n", f.Name, f.Shape, f.JumpTables, f.Ordered, f.Equality)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	if d == nil {
+		return nil
+	}
+	n := lowering.Annotate(d, fs)
+	fmt.Fprintf(os.Stderr, "mvslowering: recorded the lowering of %d of %d results\n", n, len(d.Results))
+
+	return results.WriteFile(jsonPath, d.WriteJSON)
+}
@@ -0,0 +1,201 @@
+// Command mvspgo measures the effect of profile-guided optimization on the
+// dispatch strategies.
+//
+// It runs in two phases. First it collects a CPU profile, built without PGO,
+// of the int benchmarks of one input pattern, -pattern, with 4096 inputs and
+// the mod index mode: one benchmark per strategy, function kind and branch
+// count. A pattern with a parameter is given with it, e.g. mix/p=0.5. Set
+// -profilebench to profile a different set of benchmarks, e.g. those of the
+// computed patterns, which have no input length. Then it runs the suite
+// twice, built with -pgo=off and with -pgo set to the profile, and tags every
+// result with the pgo dimension, off or on.
+//
+//	mvspgo -count 10 -pattern random -bench '/inline=false/' -json results.json
+//
+// The report gives the change from off to on for every benchmark, followed
+// by each strategy compared with -baseline both without and with PGO, which
+// shows whether PGO makes a table strategy competitive with the switch.
+// Changes that are not significant by a Mann-Whitney U test are marked
+// with ~.
+//
+// With -in, mvspgo reports on an existing dataset instead of running the
+// suite.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/jackc/go_map_vs_switch/internal/compare"
+	"github.com/jackc/go_map_vs_switch/internal/results"
+	"github.com/jackc/go_map_vs_switch/internal/runner"
+)
+
+type options struct {
+	runner runner.Config
+
+	pattern      string
+	profileBench string
+	profile      string
+	in           string
+	jsonPath     string
+	baseline     string
+	alpha        float64
+}
+
+func main() {
+	var o options
+	flag.StringVar(&o.runner.Dir, "dir", ".", "directory of the benchmark package")
+	flag.StringVar(&o.runner.Bench, "bench", ".", "run only benchmarks matching `regexp`")
+	flag.IntVar(&o.runner.Count, "count", 5, "run each benchmark `n` times with and without PGO")
+	flag.StringVar(&o.runner.Benchtime, "benchtime", "", "go test -benchtime value")
+	flag.StringVar(&o.runner.Seed, "seed", "", "seed for generating benchmark inputs (default $MVS_SEED or 1)")
+	flag.StringVar(&o.pattern, "pattern", "random", "input `pattern` of the benchmarks the profile is collected from, e.g. mix/p=0.5")
+	flag.StringVar(&o.profileBench, "profilebench", "", "collect the profile from the benchmarks matching `regexp` instead of those of -pattern")
+	flag.StringVar(&o.profile, "profile", "", "keep the CPU profile in `file` (default a temporary file)")
+	flag.StringVar(&o.in, "in", "", "report on an existing dataset `file` instead of running the suite")
+	flag.StringVar(&o.jsonPath, "json", "", "write the dataset as JSON to `file`")
+	flag.StringVar(&o.baseline, "baseline", "switch", "strategy the other strategies are compared with")
+	flag.Float64Var(&o.alpha, "alpha", 0.05, "significance level of the Mann-Whitney U test")
+	flag.Parse()
+
+	if err := run(context.Background(), o); err != nil {
+		fmt.Fprintf(os.Stderr, "mvspgo: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, o options) error {
+	var d *results.Dataset
+	var err error
+	if o.in != "" {
+		d, err = results.ReadFile(o.in)
+	} else {
+		d, err = runPGO(ctx, o)
+	}
+	if err != nil {
+		return err
+	}
+
+	if o.jsonPath != "" {
+		if err := results.WriteFile(o.jsonPath, d.WriteJSON); err != nil {
+			return err
+		}
+	}
+
+	sums := compare.Summarize(d, 0.95)
+	deltas := compare.PairsBy(sums, results.DimPGO, "off", o.alpha)
+	if len(deltas) == 0 {
+		return fmt.Errorf("no benchmarks were run both with and without PGO")
+	}
+	fmt.Println("PGO on vs off")
+	if err := compare.WritePairs(os.Stdout, deltas); err != nil {
+		return err
+	}
+
+	for _, pgo := range []string{"off", "on"} {
+		pairs := compare.Pairs(withDim(sums, results.DimPGO, pgo), o.baseline, o.alpha)
+		if len(pairs) == 0 {
+			continue
+		}
+		fmt.Printf("\nstrategies vs %s with PGO %s\n", o.baseline, pgo)
+		if err := compare.WritePairs(os.Stdout, pairs); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// runPGO collects the profile and runs the suite with and without it.
+func runPGO(ctx context.Context, o options) (*results.Dataset, error) {
+	dir, err := os.MkdirTemp("", "mvspgo")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	profile := o.profile
+	if profile == "" {
+		profile = filepath.Join(dir, "default.pgo")
+	}
+	if profile, err = filepath.Abs(profile); err != nil {
+		return nil, err
+	}
+
+	pc := o.runner
+	pc.Bench = o.profileBench
+	if pc.Bench == "" {
+		pc.Bench = profileBench(o.pattern)
+	}
+	pc.Count = 1
+	pc.Args = append(append([]string(nil), o.runner.Args...), "-pgo=off", "-cpuprofile="+profile, "-o="+filepath.Join(dir, "profile.test"))
+	fmt.Fprintf(os.Stderr, "mvspgo: collecting a profile of %s\n", pc.Bench)
+	pd, err := runner.Run(ctx, pc)
+	if err != nil {
+		return nil, err
+	}
+	if len(pd.Results) == 0 {
+		return nil, fmt.Errorf("no benchmarks match %s to collect a profile from", pc.Bench)
+	}
+
+	if o.runner.SelectsAll() {
+		fmt.Fprintln(os.Stderr, "mvspgo: warning: running every benchmark takes many hours per count; use -bench to select a subset")
+	}
+	merged := &results.Dataset{SchemaVersion: results.SchemaVersion}
+	for _, pgo := range []struct{ value, flag string }{
+		{"off", "-pgo=off"},
+		{"on", "-pgo=" + profile},
+	} {
+		c := o.runner
+		c.Args = append(append([]string(nil), o.runner.Args...), pgo.flag)
+		fmt.Fprintf(os.Stderr, "mvspgo: running with PGO %s\n", pgo.value)
+		d, err := runner.Run(ctx, c)
+		if err != nil {
+			return nil, err
+		}
+		for i := range d.Results {
+			d.Results[i].Dims[results.DimPGO] = pgo.value
+		}
+
+		if merged.Date == "" {
+			merged.Date = d.Date
+			merged.Host.GoVersion = d.Host.GoVersion
+			merged.Host.Hostname = d.Host.Hostname
+		}
+		merged.Merge(d)
+	}
+
+	return merged, nil
+}
+
+// profileBench returns the -bench regexp of the int benchmarks of pattern
+// with 4096 inputs and the mod index mode, whose names are
+// Benchmark<strategy>/inline=<bool>/pattern=<pattern>/len=4096/index=mod/n=<n>.
+// Each level of pattern, e.g. mix/p=0.5, is matched in full.
+func profileBench(pattern string) string {
+	bench := "/./"
+	for i, level := range strings.Split("pattern="+pattern, "/") {
+		if i > 0 {
+			bench += "/"
+		}
+		bench += "^" + regexp.QuoteMeta(level) + "$"
+	}
+	return bench + "/^len=4096$/^index=mod$"
+}
+
+// withDim returns the summaries of sums whose dimension dim is value.
+func withDim(sums []*compare.Summary, dim, value string) []*compare.Summary {
+	var filtered []*compare.Summary
+	for _, s := range sums {
+		if s.Dims[dim] == value {
+			filtered = append(filtered, s)
+		}
+	}
+	return filtered
+}
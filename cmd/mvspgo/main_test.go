@@ -0,0 +1,111 @@
+package main
+
+import (
+	"regexp"
+	"testing"
+)
+
+// benchNames are sub-benchmark names of each shape the suite generates.
+var benchNames = []string{
+	"BenchmarkSwitch/inline=true/pattern=random/len=4096/index=mod/n=8",
+	"BenchmarkMap/inline=false/pattern=random/len=4096/index=mod/n=512",
+	"BenchmarkSwitch/inline=true/pattern=random/len=64/index=mod/n=8",
+	"BenchmarkSwitch/inline=true/pattern=random/len=4096/index=mask/n=8",
+	"BenchmarkSwitch/inline=true/pattern=zipf/len=4096/index=mod/n=8",
+	"BenchmarkSwitch/inline=true/pattern=mix/p=0.5/len=4096/index=mod/n=8",
+	"BenchmarkSwitch/inline=true/pattern=mix/p=0.05/len=4096/index=mod/n=8",
+	"BenchmarkSwitch/inline=true/pattern=computed/n=8",
+	"BenchmarkStringSwitch/inline=true/keylen=32/prefix=24/pattern=random/len=4096/index=mod/n=8",
+	"BenchmarkLayoutSwitch/inline=false/layout=sparse/pattern=random/len=4096/index=mod/n=4",
+}
+
+func TestProfileBench(t *testing.T) {
+	tests := []struct {
+		pattern string
+		want    []int // indexes into benchNames
+	}{
+		{"random", []int{0, 1}},
+		{"zipf", []int{4}},
+		{"mix/p=0.5", []int{5}},
+		{"mix", nil},
+		{"rand", nil},
+	}
+	for _, tt := range tests {
+		bench := profileBench(tt.pattern)
+		var got []int
+		for i, name := range benchNames {
+			if benchMatches(t, bench, name) {
+				got = append(got, i)
+			}
+		}
+		if !equalInts(got, tt.want) {
+			t.Errorf("profileBench(%q) = %q matches %v, want %v", tt.pattern, bench, got, tt.want)
+		}
+	}
+}
+
+// benchMatches reports whether go test -bench bench reports the benchmark
+// name: each level of bench, split at slashes outside brackets and
+// parentheses, must match the level of name at the same depth.
+func benchMatches(t *testing.T, bench, name string) bool {
+	t.Helper()
+	levels := splitLevels(bench)
+	names := splitLevels(name)
+	if len(names) < len(levels) {
+		return false
+	}
+	for i, level := range levels {
+		re, err := regexp.Compile(level)
+		if err != nil {
+			t.Fatalf("-bench %q: %v", bench, err)
+		}
+		if !re.MatchString(names[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// splitLevels splits s as package testing splits a -bench regexp.
+func splitLevels(s string) []string {
+	var levels []string
+	brackets, parens, start := 0, 0, 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '[':
+			brackets++
+		case ']':
+			if brackets > 0 {
+				brackets--
+			}
+		case '(':
+			if brackets == 0 {
+				parens++
+			}
+		case ')':
+			if brackets == 0 {
+				parens--
+			}
+		case '\\':
+			i++
+		case '/':
+			if brackets == 0 && parens == 0 {
+				levels = append(levels, s[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(levels, s[start:])
+}
+
+func equalInts(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
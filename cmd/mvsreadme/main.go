@@ -0,0 +1,98 @@
+// Command mvsreadme rewrites the results section of README.md.
+//
+// The region between the begin and end markers is replaced with a table of
+// median ns/op per strategy along with the host CPU, Go version and date of
+// the results, or the dataset's host description if it has one. The results
+// either come from a dataset file or from a fresh run of the suite.
+//
+//	mvsreadme -in results/go1.5.1-i7-4790K.json
+//	mvsreadme -run -count 5 -bench '/pattern=random/len=4096/' -json results/new.json
+//
+// With -check, mvsreadme writes nothing and exits non-zero if the README
+// does not match the dataset given by -in.
+package main
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/jackc/go_map_vs_switch/internal/results"
+	"github.com/jackc/go_map_vs_switch/internal/runner"
+)
+
+type options struct {
+	runner runner.Config
+
+	readme   string
+	in       string
+	run      bool
+	jsonPath string
+	check    bool
+}
+
+func main() {
+	var o options
+	flag.StringVar(&o.readme, "readme", "README.md", "README `file` to rewrite")
+	flag.StringVar(&o.in, "in", "", "render the results from dataset `file`")
+	flag.BoolVar(&o.run, "run", false, "run the suite and render its results")
+	flag.StringVar(&o.jsonPath, "json", "", "with -run, also write the dataset as JSON to `file`")
+	flag.BoolVar(&o.check, "check", false, "check that the README matches -in instead of rewriting it")
+	flag.StringVar(&o.runner.Dir, "dir", ".", "with -run, directory of the benchmark package")
+	flag.StringVar(&o.runner.Bench, "bench", ".", "with -run, run only benchmarks matching `regexp`")
+	flag.IntVar(&o.runner.Count, "count", 1, "with -run, run each benchmark `n` times")
+	flag.StringVar(&o.runner.Benchtime, "benchtime", "", "with -run, go test -benchtime value")
+	flag.StringVar(&o.runner.Seed, "seed", "", "with -run, seed for generating benchmark inputs (default $MVS_SEED or 1)")
+	flag.Parse()
+
+	if err := run(context.Background(), o); err != nil {
+		fmt.Fprintf(os.Stderr, "mvsreadme: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, o options) error {
+	if (o.in == "") == !o.run {
+		return fmt.Errorf("exactly one of -in and -run is required")
+	}
+	if o.check && o.run {
+		return fmt.Errorf("-check requires -in")
+	}
+
+	readme, err := os.ReadFile(o.readme)
+	if err != nil {
+		return err
+	}
+
+	var d *results.Dataset
+	if o.run {
+		if o.runner.SelectsAll() {
+			fmt.Fprintln(os.Stderr, "mvsreadme: warning: running every benchmark takes many hours per count; use -bench to select a subset")
+		}
+		d, err = runner.Run(ctx, o.runner)
+		if err == nil && o.jsonPath != "" {
+			err = results.WriteFile(o.jsonPath, d.WriteJSON)
+		}
+	} else {
+		d, err = results.ReadFile(o.in)
+	}
+	if err != nil {
+		return err
+	}
+
+	updated, err := replaceRegion(readme, renderResults(d))
+	if err != nil {
+		return fmt.Errorf("%s: %v", o.readme, err)
+	}
+
+	if o.check {
+		if !bytes.Equal(readme, updated) {
+			return fmt.Errorf("%s is out of date with %s; run mvsreadme -in %s", o.readme, o.in, o.in)
+		}
+		return nil
+	}
+
+	return os.WriteFile(o.readme, updated, 0644)
+}
@@ -0,0 +1,29 @@
+package main
+
+import (
+	"context"
+	"testing"
+)
+
+func TestREADMEUpToDate(t *testing.T) {
+	o := options{readme: "../../README.md", in: "../../results/go1.5.1-i7-4790K.json", check: true}
+	if err := run(context.Background(), o); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReplaceRegion(t *testing.T) {
+	readme := "# Title\n\n" + beginMarker + "\nold\n" + endMarker + "\ntrailer\n"
+	got, err := replaceRegion([]byte(readme), []byte("new\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "# Title\n\n" + beginMarker + "\nnew\n" + endMarker + "\ntrailer\n"
+	if string(got) != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+
+	if _, err := replaceRegion([]byte("no markers"), nil); err == nil {
+		t.Error("expected error for missing markers")
+	}
+}
@@ -0,0 +1,181 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/jackc/go_map_vs_switch/internal/compare"
+	"github.com/jackc/go_map_vs_switch/internal/results"
+)
+
+// The generated region of the README is between these markers.
+const (
+	beginMarker = "<!-- mvsreadme:begin -->"
+	endMarker   = "<!-- mvsreadme:end -->"
+)
+
+// rowExclude are the dimensions that do not get their own column. Strategy
+// is the column group and the derived dimensions add nothing to the pattern.
+var rowExclude = []string{results.DimStrategy, results.DimPredictability, results.DimSelection}
+
+// leadingColumns are the dimension columns that come first, in this order.
+var leadingColumns = []string{results.DimInline, results.DimPattern, results.DimN}
+
+// replaceRegion returns readme with the text between the markers replaced by
+// content.
+func replaceRegion(readme, content []byte) ([]byte, error) {
+	begin := bytes.Index(readme, []byte(beginMarker))
+	if begin < 0 {
+		return nil, fmt.Errorf("missing %s", beginMarker)
+	}
+	begin += len(beginMarker)
+
+	end := bytes.Index(readme[begin:], []byte(endMarker))
+	if end < 0 {
+		return nil, fmt.Errorf("missing %s", endMarker)
+	}
+	end += begin
+
+	var buf bytes.Buffer
+	buf.Write(readme[:begin])
+	buf.WriteString("\n")
+	buf.Write(content)
+	buf.Write(readme[end:])
+	return buf.Bytes(), nil
+}
+
+// renderResults renders d as Markdown: a description of the host followed by
+// a table with a row per point in the matrix and a column of median ns/op
+// per strategy.
+func renderResults(d *results.Dataset) []byte {
+	var buf bytes.Buffer
+
+	if d.Host.Description != "" {
+		fmt.Fprintf(&buf, "The following results were produced from %s.", d.Host.Description)
+	} else {
+		host := d.Host.CPU
+		if host == "" {
+			host = "an unknown CPU"
+		}
+		platform := d.Host.GoVersion
+		if d.Host.GOOS != "" {
+			platform += " " + d.Host.GOOS + "/" + d.Host.GOARCH
+		}
+		fmt.Fprintf(&buf, "The following results were produced on %s running `%s`", host, strings.TrimSpace(platform))
+		if d.Date != "" {
+			fmt.Fprintf(&buf, " on %s", d.Date)
+		}
+		buf.WriteString(".")
+	}
+
+	sums := compare.Summarize(d, 0.95)
+	if runs := maxRuns(sums); runs > 1 {
+		fmt.Fprintf(&buf, " Each value is the median ns/op of %d runs.", runs)
+	} else {
+		buf.WriteString(" Values are ns/op.")
+	}
+	buf.WriteString("\n\n")
+
+	// Collect the rows in the order they first appear and the strategies in
+	// sorted order with the switch first.
+	type row struct {
+		dims   map[string]string
+		values map[string]float64
+	}
+	var rows []*row
+	byKey := make(map[string]*row)
+	var strategies []string
+	seen := make(map[string]bool)
+	for _, s := range sums {
+		r := results.Result{Dims: s.Dims}
+		key := r.Key(rowExclude...)
+		rw, ok := byKey[key]
+		if !ok {
+			rw = &row{dims: s.Dims, values: make(map[string]float64)}
+			byKey[key] = rw
+			rows = append(rows, rw)
+		}
+
+		strategy := s.Dims[results.DimStrategy]
+		rw.values[strategy] = s.Median
+		if !seen[strategy] {
+			seen[strategy] = true
+			strategies = append(strategies, strategy)
+		}
+	}
+	sort.Slice(strategies, func(i, j int) bool {
+		if (strategies[i] == "switch") != (strategies[j] == "switch") {
+			return strategies[i] == "switch"
+		}
+		return strategies[i] < strategies[j]
+	})
+
+	names := d.DimNames()
+	var columns []string
+	for _, k := range leadingColumns {
+		if contains(names, k) {
+			columns = append(columns, k)
+		}
+	}
+	for _, k := range names {
+		if !contains(leadingColumns, k) && !contains(rowExclude, k) {
+			columns = append(columns, k)
+		}
+	}
+
+	buf.WriteString("|")
+	for _, c := range columns {
+		fmt.Fprintf(&buf, " %s |", c)
+	}
+	for _, s := range strategies {
+		fmt.Fprintf(&buf, " %s |", s)
+	}
+	buf.WriteString("\n|")
+	for range columns {
+		buf.WriteString(" --- |")
+	}
+	for range strategies {
+		buf.WriteString(" ---: |")
+	}
+	buf.WriteString("\n")
+
+	for _, rw := range rows {
+		buf.WriteString("|")
+		for _, c := range columns {
+			fmt.Fprintf(&buf, " %s |", rw.dims[c])
+		}
+		for _, s := range strategies {
+			v, ok := rw.values[s]
+			if !ok {
+				buf.WriteString(" |")
+				continue
+			}
+			fmt.Fprintf(&buf, " %s |", strconv.FormatFloat(v, 'f', 2, 64))
+		}
+		buf.WriteString("\n")
+	}
+
+	return buf.Bytes()
+}
+
+func maxRuns(sums []*compare.Summary) int {
+	max := 0
+	for _, s := range sums {
+		if len(s.Samples) > max {
+			max = len(s.Samples)
+		}
+	}
+	return max
+}
+
+func contains(s []string, v string) bool {
+	for _, e := range s {
+		if e == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,99 @@
+// Command mvsresults converts go test -bench output into a results dataset.
+//
+// It reads plain text or go test -json output from the named files, or from
+// standard input if there are none, splits each benchmark name into its
+// dimensions and writes the dataset as JSON and/or CSV.
+//
+//	go test -bench=. -count=5 | mvsresults -json results.json -csv results.csv
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"time"
+
+	"github.com/jackc/go_map_vs_switch/internal/results"
+)
+
+func main() {
+	jsonPath := flag.String("json", "", "write the dataset as JSON to `file` (- for standard output)")
+	csvPath := flag.String("csv", "", "write the dataset as CSV to `file` (- for standard output)")
+	goVersion := flag.String("goversion", runtime.Version(), "Go version to record in the host metadata")
+	hostname := flag.String("hostname", "", "hostname to record in the host metadata (default os.Hostname)")
+	date := flag.String("date", time.Now().Format("2006-01-02"), "day the results were collected")
+	flag.Parse()
+
+	if err := run(flag.Args(), *jsonPath, *csvPath, *goVersion, *hostname, *date); err != nil {
+		fmt.Fprintf(os.Stderr, "mvsresults: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(inputs []string, jsonPath, csvPath, goVersion, hostname, date string) error {
+	if jsonPath == "" && csvPath == "" {
+		jsonPath = "-"
+	}
+
+	d := &results.Dataset{SchemaVersion: results.SchemaVersion}
+	if len(inputs) == 0 {
+		fd, err := results.Parse(os.Stdin)
+		if err != nil {
+			return fmt.Errorf("stdin: %v", err)
+		}
+		d.Merge(fd)
+	}
+	for _, path := range inputs {
+		fd, err := parseFile(path)
+		if err != nil {
+			return fmt.Errorf("%s: %v", path, err)
+		}
+		d.Merge(fd)
+	}
+
+	if len(d.Results) == 0 {
+		return fmt.Errorf("no benchmark results found")
+	}
+
+	d.Date = date
+	d.Host.GoVersion = goVersion
+	d.Host.Hostname = hostname
+	if d.Host.Hostname == "" {
+		d.Host.Hostname, _ = os.Hostname()
+	}
+
+	if jsonPath != "" {
+		if err := output(jsonPath, d.WriteJSON); err != nil {
+			return err
+		}
+	}
+	if csvPath != "" {
+		if err := output(csvPath, d.WriteCSV); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func parseFile(path string) (*results.Dataset, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return results.Parse(f)
+}
+
+// output calls write with path opened for writing, or with standard output if
+// path is "-".
+func output(path string, write func(io.Writer) error) error {
+	if path == "-" {
+		return write(os.Stdout)
+	}
+
+	return results.WriteFile(path, write)
+}
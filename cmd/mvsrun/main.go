@@ -0,0 +1,153 @@
+// Command mvsrun runs the benchmark suite repeatedly and reports the
+// variance of each benchmark and the significance of each strategy's
+// difference from the switch.
+//
+// Every benchmark is run -count times. The full matrix takes many hours per
+// count, so select a subset with -bench. For each benchmark mvsrun reports
+// the median, interquartile range and confidence interval of the median.
+// Too few runs for -confidence give the [min, max] interval, which is
+// followed by its actual coverage, e.g. 93.75% for the default 5 runs.
+// Each other strategy is then paired with the baseline at the same inline
+// mode, pattern and branch count and compared with a Mann-Whitney U test.
+// Pairs whose difference is not significant are marked with ~ rather than
+// given a winner.
+//
+//	mvsrun -count 10 -bench '/pattern=random/len=4096/' -json results.json
+//
+// With -in, mvsrun reports on an existing dataset instead of running the
+// suite.
+//
+// If the results include the none strategy, which only loads the selector,
+// its median is subtracted from every strategy at the same point so that the
+// report reflects the cost of dispatch alone. Benchmarks with no none
+// result at their point, such as the string key and key layout benchmarks,
+// are reported gross, and the report marks which is which. The deltas
+// between strategies are always computed from the gross ns/op. Set
+// -subtract to "" to report the gross ns/op throughout.
+//
+// With -lowering, mvsrun also inspects the disassembly of the switch
+// benchmarks, as mvslowering does, and records whether each switch was
+// lowered to a jump table, a binary search or a linear sequence of
+// comparisons next to its results.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/jackc/go_map_vs_switch/internal/compare"
+	"github.com/jackc/go_map_vs_switch/internal/lowering"
+	"github.com/jackc/go_map_vs_switch/internal/results"
+	"github.com/jackc/go_map_vs_switch/internal/runner"
+)
+
+type options struct {
+	runner runner.Config
+
+	in         string
+	jsonPath   string
+	rawPath    string
+	baseline   string
+	subtract   string
+	alpha      float64
+	confidence float64
+	lowering   bool
+}
+
+func main() {
+	var o options
+	flag.StringVar(&o.runner.Dir, "dir", ".", "directory of the benchmark package")
+	flag.StringVar(&o.runner.Bench, "bench", ".", "run only benchmarks matching `regexp`")
+	flag.IntVar(&o.runner.Count, "count", 5, "run each benchmark `n` times")
+	flag.StringVar(&o.runner.Benchtime, "benchtime", "", "go test -benchtime value")
+	flag.StringVar(&o.runner.Seed, "seed", "", "seed for generating benchmark inputs (default $MVS_SEED or 1)")
+	flag.StringVar(&o.in, "in", "", "report on an existing dataset `file` instead of running the suite")
+	flag.StringVar(&o.jsonPath, "json", "", "write the dataset as JSON to `file`")
+	flag.StringVar(&o.rawPath, "raw", "", "write the raw go test output to `file`")
+	flag.StringVar(&o.baseline, "baseline", "switch", "strategy the other strategies are compared with")
+	flag.StringVar(&o.subtract, "subtract", "none", "strategy whose median is subtracted from the others, if present")
+	flag.Float64Var(&o.alpha, "alpha", 0.05, "significance level of the Mann-Whitney U test")
+	flag.Float64Var(&o.confidence, "confidence", 0.95, "confidence level of the median interval")
+	flag.BoolVar(&o.lowering, "lowering", false, "record how the compiler lowered each switch")
+	flag.Parse()
+
+	if err := run(context.Background(), o); err != nil {
+		fmt.Fprintf(os.Stderr, "mvsrun: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, o options) error {
+	var d *results.Dataset
+	var err error
+	if o.in != "" {
+		d, err = results.ReadFile(o.in)
+	} else {
+		d, err = runSuite(ctx, o)
+	}
+	if err != nil {
+		return err
+	}
+
+	if len(d.Results) == 0 {
+		return fmt.Errorf("no benchmark results")
+	}
+
+	if o.jsonPath != "" {
+		if err := results.WriteFile(o.jsonPath, d.WriteJSON); err != nil {
+			return err
+		}
+	}
+
+	sums := compare.Summarize(d, o.confidence)
+	if o.subtract != "" {
+		sums = compare.Subtract(sums, o.subtract)
+		for _, s := range sums {
+			if s.Net {
+				fmt.Printf("ns/op marked net are net of the %s strategy; deltas are of the gross ns/op\n\n", o.subtract)
+				break
+			}
+		}
+	}
+	if err := compare.WriteSummaries(os.Stdout, sums, o.confidence); err != nil {
+		return err
+	}
+
+	pairs := compare.Pairs(sums, o.baseline, o.alpha)
+	if len(pairs) == 0 {
+		return nil
+	}
+	fmt.Println()
+	return compare.WritePairs(os.Stdout, pairs)
+}
+
+func runSuite(ctx context.Context, o options) (*results.Dataset, error) {
+	if o.rawPath != "" {
+		f, err := os.Create(o.rawPath)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		o.runner.Output = f
+	}
+
+	if o.runner.SelectsAll() {
+		fmt.Fprintln(os.Stderr, "mvsrun: warning: running every benchmark takes many hours per count; use -bench to select a subset")
+	}
+	d, err := runner.Run(ctx, o.runner)
+	if err != nil {
+		return nil, err
+	}
+
+	if o.lowering {
+		funcs, err := lowering.Inspect(ctx, o.runner, lowering.DefaultFuncs)
+		if err != nil {
+			return nil, err
+		}
+		lowering.Annotate(d, funcs)
+	}
+
+	return d, nil
+}
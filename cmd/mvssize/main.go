@@ -0,0 +1,263 @@
+// Command mvssize reports the code size and compile time of each dispatch
+// strategy, to weigh against its speed when picking one.
+//
+// For each strategy and branch count it generates a small copy of the
+// benchmark package from matrix.json restricted to that strategy, that
+// branch count, one input pattern, -pattern, and the mod index mode, and
+// builds its test binary with go test -c. The copy is written to a
+// temporary directory under -dir, which go ignores when matching packages.
+//
+//	mvssize -strategies switch,map,slice -n 8,64,512
+//
+// It writes two tables. The first gives the size in bytes of every
+// generated benchmark function of the strategy, the dispatch site, as listed
+// in the binary's symbol table by go tool nm. The second gives the total
+// size of the binary's text symbols and the median wall time of -count
+// builds, which includes linking. Every build misses the build cache for
+// the benchmark package but not for its dependencies.
+//
+// A string key or key layout strategy is built alongside the None strategy,
+// since the matrix needs an int strategy. Its sizes and times are best read
+// next to those of None.
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/jackc/go_map_vs_switch/internal/runner"
+	"github.com/jackc/go_map_vs_switch/internal/stats"
+)
+
+type options struct {
+	runner runner.Config
+
+	matrix     string
+	strategies string
+	counts     string
+	pattern    string
+	count      int
+}
+
+// build is the outcome of building the package of one strategy and branch
+// count.
+type build struct {
+	strategy string
+	n        int
+	sites    []symbol
+	text     int64
+	time     time.Duration
+}
+
+func main() {
+	var o options
+	flag.StringVar(&o.runner.Dir, "dir", ".", "directory of the benchmark package")
+	flag.StringVar(&o.matrix, "matrix", "matrix.json", "dimension matrix `file`, relative to -dir")
+	flag.StringVar(&o.strategies, "strategies", "", "comma-separated dispatch `strategies` to build (default all of the matrix's)")
+	flag.StringVar(&o.counts, "n", "", "comma-separated branch `counts` to build (default the matrix's)")
+	flag.StringVar(&o.pattern, "pattern", "random", "input `pattern` of the generated benchmarks")
+	flag.IntVar(&o.count, "count", 1, "build each package `n` times and report the median time")
+	flag.Parse()
+
+	if err := run(context.Background(), o); err != nil {
+		fmt.Fprintf(os.Stderr, "mvssize: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, o options) error {
+	m, err := readMatrix(filepath.Join(o.runner.Dir, o.matrix))
+	if err != nil {
+		return err
+	}
+	strategies, err := selectStrategies(m, o.strategies)
+	if err != nil {
+		return err
+	}
+	counts, err := branchCounts(m, o.counts)
+	if err != nil {
+		return err
+	}
+	var kinds []string
+	if err := m.get("funcKinds", &kinds); err != nil {
+		return err
+	}
+
+	var builds []*build
+	for _, s := range strategies {
+		for _, n := range counts {
+			fmt.Fprintf(os.Stderr, "mvssize: building %s with %d branches\n", s, n)
+			r, err := m.restrict(s, n, o.pattern)
+			if err != nil {
+				return err
+			}
+			b, err := buildPackage(ctx, o, r, siteFuncs(s, kinds))
+			if err != nil {
+				return fmt.Errorf("%s n=%d: %v", s, n, err)
+			}
+			b.strategy, b.n = s, n
+			builds = append(builds, b)
+		}
+	}
+
+	tw := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintf(tw, "strategy\tn\tfunction\tbytes\n")
+	for _, b := range builds {
+		for _, s := range b.sites {
+			fmt.Fprintf(tw, "%s\t%d\t%s\t%d\n", b.strategy, b.n, s.name, s.size)
+		}
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Println()
+	fmt.Fprintf(tw, "strategy\tn\ttext bytes\tbuild\n")
+	for _, b := range builds {
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%s\n", b.strategy, b.n, b.text, b.time.Round(time.Millisecond))
+	}
+	return tw.Flush()
+}
+
+// buildPackage generates the benchmark package of the restricted matrix r
+// in a temporary directory, builds its test binary o.count times and
+// measures the symbols matching funcs.
+func buildPackage(ctx context.Context, o options, r matrix, funcs *regexp.Regexp) (*build, error) {
+	dir, err := os.MkdirTemp(o.runner.Dir, "_mvssize")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+	if dir, err = filepath.Abs(dir); err != nil {
+		return nil, err
+	}
+
+	if err := copySources(o.runner.Dir, dir); err != nil {
+		return nil, err
+	}
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	matrixPath := filepath.Join(dir, "matrix.json")
+	if err := os.WriteFile(matrixPath, data, 0644); err != nil {
+		return nil, err
+	}
+	if err := runner.Generate(ctx, o.runner, matrixPath, dir); err != nil {
+		return nil, err
+	}
+
+	c := o.runner
+	c.Dir = dir
+	bin := filepath.Join(dir, "size.test")
+	var times []float64
+	for i := 0; i == 0 || i < o.count; i++ {
+		// A new constant each time makes the build miss the cache.
+		nonce := fmt.Sprintf("package go_map_vs_switch\n\nconst mvssizeNonce = %d\n", time.Now().UnixNano())
+		if err := os.WriteFile(filepath.Join(dir, "nonce.go"), []byte(nonce), 0644); err != nil {
+			return nil, err
+		}
+		start := time.Now()
+		if err := runner.BuildTest(ctx, c, bin); err != nil {
+			return nil, err
+		}
+		times = append(times, time.Since(start).Seconds())
+	}
+
+	out, err := runner.Nm(ctx, c, bin)
+	if err != nil {
+		return nil, err
+	}
+	syms, err := parseNm(bytes.NewReader(out))
+	if err != nil {
+		return nil, err
+	}
+	b := &build{time: time.Duration(stats.Median(times) * float64(time.Second))}
+	b.sites, b.text = sites(syms, funcs)
+	if len(b.sites) == 0 {
+		return nil, fmt.Errorf("no symbols match %s", funcs)
+	}
+	return b, nil
+}
+
+// generatedHeader marks the files written by cmd/genbench.
+var generatedHeader = regexp.MustCompile(`(?m)^// Code generated .* DO NOT EDIT\.$`)
+
+// copySources copies the Go files of the package in src that are not
+// generated to dst.
+func copySources(src, dst string) error {
+	paths, err := filepath.Glob(filepath.Join(src, "*.go"))
+	if err != nil {
+		return err
+	}
+	for _, p := range paths {
+		data, err := os.ReadFile(p)
+		if err != nil {
+			return err
+		}
+		if generatedHeader.Match(data) {
+			continue
+		}
+		if err := os.WriteFile(filepath.Join(dst, filepath.Base(p)), data, 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// selectStrategies returns the strategies of the comma-separated list s,
+// matched without regard to case against those of m, or all of m's if s is
+// empty.
+func selectStrategies(m matrix, s string) ([]string, error) {
+	all, err := m.strategies()
+	if err != nil {
+		return nil, err
+	}
+	if s == "" {
+		return all, nil
+	}
+
+	var selected []string
+	for _, name := range strings.Split(s, ",") {
+		found := false
+		for _, st := range all {
+			if strings.EqualFold(st, name) {
+				selected = append(selected, st)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("unknown dispatch strategy %q", name)
+		}
+	}
+	return selected, nil
+}
+
+// branchCounts returns the branch counts of the comma-separated list s, or
+// those of m if s is empty.
+func branchCounts(m matrix, s string) ([]int, error) {
+	var counts []int
+	if s == "" {
+		err := m.get("branchCounts", &counts)
+		return counts, err
+	}
+	for _, f := range strings.Split(s, ",") {
+		n, err := strconv.Atoi(f)
+		if err != nil || n < 1 {
+			return nil, fmt.Errorf("bad branch count %q", f)
+		}
+		counts = append(counts, n)
+	}
+	return counts, nil
+}
@@ -0,0 +1,220 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// indexMode is the only index mode of the restricted matrices. Every
+// section supports it and it compiles to the same loop as the others.
+const indexMode = "mod"
+
+// sections are the matrix sections with dispatch strategies of their own.
+var sections = []string{"stringKeys", "keyLayouts"}
+
+// matrix is matrix.json decoded only as far as mvssize changes it, so that
+// everything else is written back unchanged.
+type matrix map[string]json.RawMessage
+
+func readMatrix(path string) (matrix, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var m matrix
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	return m, nil
+}
+
+func (m matrix) get(key string, v interface{}) error {
+	raw, ok := m[key]
+	if !ok {
+		return fmt.Errorf("%s is missing", key)
+	}
+	if err := json.Unmarshal(raw, v); err != nil {
+		return fmt.Errorf("%s: %v", key, err)
+	}
+	return nil
+}
+
+func (m matrix) set(key string, v interface{}) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	m[key] = b
+}
+
+// section returns the section name of m, or nil if m has none.
+func (m matrix) section(name string) (matrix, []string, error) {
+	if _, ok := m[name]; !ok {
+		return nil, nil, nil
+	}
+	var s matrix
+	if err := m.get(name, &s); err != nil {
+		return nil, nil, err
+	}
+	var strategies []string
+	if err := s.get("dispatchStrategies", &strategies); err != nil {
+		return nil, nil, fmt.Errorf("%s: %v", name, err)
+	}
+	return s, strategies, nil
+}
+
+// strategies returns the int dispatch strategies of m followed by those of
+// its sections.
+func (m matrix) strategies() ([]string, error) {
+	var all []string
+	if err := m.get("dispatchStrategies", &all); err != nil {
+		return nil, err
+	}
+	for _, name := range sections {
+		_, strategies, err := m.section(name)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, strategies...)
+	}
+	return all, nil
+}
+
+// restrict returns a copy of m that generates only the benchmarks of
+// strategy with n branches, input pattern and the mod index mode, so that
+// the package built from it has one dispatch site per function kind, and per
+// shape or layout for a section's strategy. The matrix needs an int
+// strategy, so a section's strategy is generated alongside None.
+func (m matrix) restrict(strategy string, n int, pattern string) (matrix, error) {
+	r := make(matrix, len(m))
+	for k, v := range m {
+		r[k] = v
+	}
+	r.set("branchCounts", []int{n})
+	r.set("indexModes", []string{indexMode})
+
+	var inputs []json.RawMessage
+	if err := m.get("inputStrategies", &inputs); err != nil {
+		return nil, err
+	}
+	var kept []json.RawMessage
+	for _, in := range inputs {
+		var s struct {
+			Name string `json:"name"`
+		}
+		if err := json.Unmarshal(in, &s); err != nil {
+			return nil, fmt.Errorf("inputStrategies: %v", err)
+		}
+		if s.Name == pattern {
+			kept = append(kept, in)
+		}
+	}
+	if len(kept) == 0 {
+		return nil, fmt.Errorf("unknown input strategy %q", pattern)
+	}
+	r.set("inputStrategies", kept)
+
+	if _, ok := m["inputLengths"]; ok {
+		var lengths []int
+		if err := m.get("inputLengths", &lengths); err != nil {
+			return nil, err
+		}
+		if len(lengths) > 1 {
+			r.set("inputLengths", lengths[:1])
+		}
+	}
+
+	var strategies []string
+	if err := m.get("dispatchStrategies", &strategies); err != nil {
+		return nil, err
+	}
+	found := contains(strategies, strategy)
+	if found {
+		r.set("dispatchStrategies", []string{strategy})
+	} else {
+		r.set("dispatchStrategies", []string{"None"})
+	}
+
+	for _, name := range sections {
+		delete(r, name)
+		s, strategies, err := m.section(name)
+		if err != nil {
+			return nil, err
+		}
+		if found || !contains(strategies, strategy) {
+			continue
+		}
+		s.set("dispatchStrategies", []string{strategy})
+		s.set("inputStrategies", []string{pattern})
+		s.set("indexModes", []string{indexMode})
+		r.set(name, s)
+		found = true
+	}
+	if !found {
+		return nil, fmt.Errorf("unknown dispatch strategy %q", strategy)
+	}
+
+	return r, nil
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// symbol is a text symbol of a binary.
+type symbol struct {
+	name string
+	size int64
+}
+
+// parseNm returns the text symbols listed in go tool nm -size output.
+func parseNm(r io.Reader) ([]symbol, error) {
+	var syms []symbol
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		f := strings.Fields(sc.Text())
+		if len(f) < 4 || (f[2] != "T" && f[2] != "t") {
+			continue
+		}
+		size, err := strconv.ParseInt(f[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("bad go tool nm line %q", sc.Text())
+		}
+		syms = append(syms, symbol{strings.Join(f[3:], " "), size})
+	}
+	return syms, sc.Err()
+}
+
+// siteFuncs returns a regexp matching the symbols of the generated
+// benchmark functions of strategy, bench<strategy><kind>..., and their
+// closures. Submatch 1 is the name without the package path.
+func siteFuncs(strategy string, kinds []string) *regexp.Regexp {
+	quoted := make([]string, len(kinds))
+	for i, k := range kinds {
+		quoted[i] = regexp.QuoteMeta(k)
+	}
+	return regexp.MustCompile(`\.(bench` + regexp.QuoteMeta(strategy) + `(?:` + strings.Join(quoted, "|") + `)[A-Z0-9].*)$`)
+}
+
+// sites returns the symbols of syms that match funcs, named without their
+// package path, and the total size of all of syms.
+func sites(syms []symbol, funcs *regexp.Regexp) (matched []symbol, text int64) {
+	for _, s := range syms {
+		text += s.size
+		if m := funcs.FindStringSubmatch(s.name); m != nil {
+			matched = append(matched, symbol{m[1], s.size})
+		}
+	}
+	return matched, text
+}
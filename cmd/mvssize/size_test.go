@@ -0,0 +1,143 @@
+package main
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testMatrix = `{
+  "branchCounts": [4, 8, 16],
+  "funcKinds": ["Inline", "NoInline"],
+  "inputStrategies": [
+    {"name": "computed", "selector": "i % {{.N}}"},
+    {"name": "random", "distribution": "uniform"}
+  ],
+  "inputLengths": [64, 4096],
+  "indexModes": ["mod", "range"],
+  "dispatchStrategies": ["Switch", "Map", "None"],
+  "stringKeys": {
+    "shapes": [{"length": 8, "prefixes": [0]}],
+    "inputStrategies": ["computed", "random"],
+    "dispatchStrategies": ["StringSwitch", "StringMap"]
+  }
+}`
+
+func parseTestMatrix(t *testing.T) matrix {
+	t.Helper()
+	var m matrix
+	if err := json.Unmarshal([]byte(testMatrix), &m); err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
+
+func TestStrategies(t *testing.T) {
+	got, err := parseTestMatrix(t).strategies()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"Switch", "Map", "None", "StringSwitch", "StringMap"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("strategies = %q, want %q", got, want)
+	}
+}
+
+func TestRestrict(t *testing.T) {
+	m := parseTestMatrix(t)
+
+	r, err := m.restrict("Map", 8, "random")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ints struct {
+		BranchCounts       []int             `json:"branchCounts"`
+		InputStrategies    []json.RawMessage `json:"inputStrategies"`
+		InputLengths       []int             `json:"inputLengths"`
+		IndexModes         []string          `json:"indexModes"`
+		DispatchStrategies []string          `json:"dispatchStrategies"`
+		StringKeys         json.RawMessage   `json:"stringKeys"`
+	}
+	remarshal(t, r, &ints)
+	if !reflect.DeepEqual(ints.BranchCounts, []int{8}) ||
+		len(ints.InputStrategies) != 1 || !strings.Contains(string(ints.InputStrategies[0]), `"random"`) ||
+		!reflect.DeepEqual(ints.InputLengths, []int{64}) ||
+		!reflect.DeepEqual(ints.IndexModes, []string{"mod"}) ||
+		!reflect.DeepEqual(ints.DispatchStrategies, []string{"Map"}) ||
+		ints.StringKeys != nil {
+		t.Errorf("restrict Map = %s", marshal(t, r))
+	}
+
+	r, err = m.restrict("StringSwitch", 16, "computed")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var strs struct {
+		DispatchStrategies []string `json:"dispatchStrategies"`
+		StringKeys         struct {
+			Shapes             []json.RawMessage `json:"shapes"`
+			InputStrategies    []string          `json:"inputStrategies"`
+			IndexModes         []string          `json:"indexModes"`
+			DispatchStrategies []string          `json:"dispatchStrategies"`
+		} `json:"stringKeys"`
+	}
+	remarshal(t, r, &strs)
+	if !reflect.DeepEqual(strs.DispatchStrategies, []string{"None"}) ||
+		len(strs.StringKeys.Shapes) != 1 ||
+		!reflect.DeepEqual(strs.StringKeys.InputStrategies, []string{"computed"}) ||
+		!reflect.DeepEqual(strs.StringKeys.IndexModes, []string{"mod"}) ||
+		!reflect.DeepEqual(strs.StringKeys.DispatchStrategies, []string{"StringSwitch"}) {
+		t.Errorf("restrict StringSwitch = %s", marshal(t, r))
+	}
+
+	if _, err := m.restrict("Slice", 8, "random"); err == nil {
+		t.Error("restrict Slice: no error")
+	}
+	if _, err := m.restrict("Map", 8, "zipf"); err == nil {
+		t.Error("restrict with pattern zipf: no error")
+	}
+}
+
+func TestSites(t *testing.T) {
+	const nm = `  4a1c80        312 T github.com/jackc/go_map_vs_switch/_mvssize1.benchSwitchInlineLookupMod8
+  4a1dc0        540 T github.com/jackc/go_map_vs_switch/_mvssize1.benchSwitchNoInlineLookupMod8
+  4a2000        216 T github.com/jackc/go_map_vs_switch/_mvssize1.benchNoneInlineLookupMod8
+  4a2100         96 t github.com/jackc/go_map_vs_switch/_mvssize1.benchSwitchNoInlineLookupMod8.func1
+  4a2200         40 T github.com/jackc/go_map_vs_switch/_mvssize1.benchTypeSwitchInlineLookupMod8
+  5b0000        128 D runtime.buildVersion
+  5c0000          8 R go:string.*
+`
+	syms, err := parseNm(strings.NewReader(nm))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, text := sites(syms, siteFuncs("Switch", []string{"Inline", "NoInline"}))
+	want := []symbol{
+		{"benchSwitchInlineLookupMod8", 312},
+		{"benchSwitchNoInlineLookupMod8", 540},
+		{"benchSwitchNoInlineLookupMod8.func1", 96},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("sites = %v, want %v", got, want)
+	}
+	if text != 312+540+216+96+40 {
+		t.Errorf("text = %d, want %d", text, 312+540+216+96+40)
+	}
+}
+
+func remarshal(t *testing.T, m matrix, v interface{}) {
+	t.Helper()
+	if err := json.Unmarshal(marshal(t, m), v); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func marshal(t *testing.T, m matrix) []byte {
+	t.Helper()
+	b, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
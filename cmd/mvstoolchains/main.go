@@ -0,0 +1,177 @@
+// Command mvstoolchains runs the benchmark suite under several Go toolchains
+// on the same machine and compares the dispatch strategies across releases.
+//
+// Each argument is a toolchain: either the root of a locally installed Go
+// (a GOROOT) or a toolchain name such as go1.22.1, which is selected with
+// GOTOOLCHAIN. Toolchains selected by name are downloaded into the module
+// cache if they are not already there; set GOPROXY=off to only use the
+// cached ones. GOTOOLCHAIN needs go1.21 or later, both for the go command
+// on the PATH and for the named toolchain, so older releases must be given
+// as a GOROOT. The suite builds with go1.17 or later; mvstoolchains rejects
+// an older toolchain before running any.
+//
+//	mvstoolchains -count 5 -json results.json /usr/local/go1.17 /usr/local/go1.19 go1.23.0
+//
+// Every result is tagged with the toolchain dimension, the go version of the
+// toolchain that produced it. The dataset's host go version is left empty.
+//
+// For each pair of strategies in -pairs, mvstoolchains then writes a table
+// with a row per benchmark of the baseline strategy and a column per
+// toolchain. Each cell gives the median ns/op of both strategies and the
+// change from the baseline to the other strategy. Changes that are not
+// significant by a Mann-Whitney U test are marked with ~.
+//
+// With -in, mvstoolchains reports on an existing dataset instead of running
+// the suite.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/jackc/go_map_vs_switch/internal/compare"
+	"github.com/jackc/go_map_vs_switch/internal/results"
+	"github.com/jackc/go_map_vs_switch/internal/runner"
+)
+
+type options struct {
+	runner runner.Config
+
+	toolchains []string
+	in         string
+	jsonPath   string
+	pairs      string
+	alpha      float64
+}
+
+func main() {
+	var o options
+	flag.StringVar(&o.runner.Dir, "dir", ".", "directory of the benchmark package")
+	flag.StringVar(&o.runner.Bench, "bench", ".", "run only benchmarks matching `regexp`")
+	flag.IntVar(&o.runner.Count, "count", 5, "run each benchmark `n` times per toolchain")
+	flag.StringVar(&o.runner.Benchtime, "benchtime", "", "go test -benchtime value")
+	flag.StringVar(&o.runner.Seed, "seed", "", "seed for generating benchmark inputs (default $MVS_SEED or 1)")
+	flag.StringVar(&o.in, "in", "", "report on an existing dataset `file` instead of running the suite")
+	flag.StringVar(&o.jsonPath, "json", "", "write the dataset as JSON to `file`")
+	flag.StringVar(&o.pairs, "pairs", defaultPairs, "comma-separated `baseline:other` strategy pairs to compare")
+	flag.Float64Var(&o.alpha, "alpha", 0.05, "significance level of the Mann-Whitney U test")
+	flag.Parse()
+	o.toolchains = flag.Args()
+
+	if (o.in == "") == (len(o.toolchains) == 0) {
+		fmt.Fprintln(os.Stderr, "usage: mvstoolchains [flags] toolchain... | mvstoolchains [flags] -in results.json")
+		flag.PrintDefaults()
+		os.Exit(2)
+	}
+
+	if err := run(context.Background(), o); err != nil {
+		fmt.Fprintf(os.Stderr, "mvstoolchains: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, o options) error {
+	pairs, err := parsePairs(o.pairs)
+	if err != nil {
+		return err
+	}
+
+	var d *results.Dataset
+	if o.in != "" {
+		d, err = results.ReadFile(o.in)
+	} else {
+		d, err = runToolchains(ctx, o.runner, o.toolchains)
+	}
+	if err != nil {
+		return err
+	}
+
+	if o.jsonPath != "" {
+		if err := results.WriteFile(o.jsonPath, d.WriteJSON); err != nil {
+			return err
+		}
+	}
+
+	versions := d.DimValues(results.DimToolchain)
+	if len(versions) == 0 {
+		return fmt.Errorf("no results have a %s dimension", results.DimToolchain)
+	}
+
+	sums := compare.Summarize(d, 0.95)
+
+	written := false
+	for _, p := range pairs {
+		rows := comparisonRows(sums, p, o.alpha)
+		if len(rows) == 0 {
+			continue
+		}
+		if written {
+			fmt.Println()
+		}
+		written = true
+		fmt.Printf("%s vs %s\n", p.other, p.baseline)
+		if err := compare.WriteRows(os.Stdout, rows, versions); err != nil {
+			return err
+		}
+	}
+	if !written {
+		return fmt.Errorf("no results of the strategy pairs %s", o.pairs)
+	}
+
+	return nil
+}
+
+// runToolchains runs the suite described by c under each toolchain and
+// returns the merged results tagged with the toolchain version. It checks
+// every toolchain before running any.
+func runToolchains(ctx context.Context, c runner.Config, specs []string) (*results.Dataset, error) {
+	configs := make([]runner.Config, len(specs))
+	versions := make([]string, len(specs))
+	seen := make(map[string]string)
+	for i, spec := range specs {
+		t := parseToolchain(spec)
+		if err := t.checkName(); err != nil {
+			return nil, fmt.Errorf("%s: %v", spec, err)
+		}
+		configs[i] = t.config(c)
+
+		version, err := runner.GoVersion(ctx, configs[i])
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", spec, err)
+		}
+		if err := t.checkVersion(version); err != nil {
+			return nil, fmt.Errorf("%s: %v", spec, err)
+		}
+		if prev, ok := seen[version]; ok {
+			return nil, fmt.Errorf("%s and %s are both %s", prev, spec, version)
+		}
+		seen[version] = spec
+		versions[i] = version
+	}
+
+	if c.SelectsAll() {
+		fmt.Fprintln(os.Stderr, "mvstoolchains: warning: running every benchmark takes many hours per count; use -bench to select a subset")
+	}
+	merged := &results.Dataset{SchemaVersion: results.SchemaVersion}
+	for i, spec := range specs {
+		version := versions[i]
+		fmt.Fprintf(os.Stderr, "mvstoolchains: running %s\n", version)
+		d, err := runner.Run(ctx, configs[i])
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", spec, err)
+		}
+		for j := range d.Results {
+			d.Results[j].Dims[results.DimToolchain] = version
+		}
+
+		if merged.Date == "" {
+			merged.Date = d.Date
+			merged.Host.Hostname = d.Host.Hostname
+		}
+		merged.Merge(d)
+	}
+
+	return merged, nil
+}
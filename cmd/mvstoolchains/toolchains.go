@@ -0,0 +1,139 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/jackc/go_map_vs_switch/internal/compare"
+	"github.com/jackc/go_map_vs_switch/internal/results"
+	"github.com/jackc/go_map_vs_switch/internal/runner"
+)
+
+// defaultPairs compares the map with the switch for int keys, string keys
+// and key layouts.
+const defaultPairs = "switch:map,stringswitch:stringmap,layoutswitch:layoutmap"
+
+// oldestRelease is the oldest Go 1 minor release the benchmark suite builds
+// with, that of the go line of go.mod.
+const oldestRelease = 17
+
+// oldestNamedRelease is the oldest release that can be selected by name: the
+// go command only knows GOTOOLCHAIN from go1.21 on.
+const oldestNamedRelease = 21
+
+// toolchain is a Go toolchain to run the suite under. Exactly one of goroot
+// and name is set.
+type toolchain struct {
+	goroot string
+	name   string
+}
+
+// parseToolchain returns the toolchain of spec, which is a toolchain name
+// such as go1.22.1 if it starts with "go" and has no path separator, and a
+// GOROOT otherwise.
+func parseToolchain(spec string) toolchain {
+	if strings.HasPrefix(spec, "go") && filepath.Base(spec) == spec {
+		return toolchain{name: spec}
+	}
+	return toolchain{goroot: spec}
+}
+
+// config returns c changed to run the go command of t.
+func (t toolchain) config(c runner.Config) runner.Config {
+	c.Env = append([]string(nil), c.Env...)
+	if t.goroot != "" {
+		c.Go = filepath.Join(t.goroot, "bin", "go")
+		c.Env = append(c.Env, "GOROOT="+t.goroot)
+	} else {
+		c.Env = append(c.Env, "GOTOOLCHAIN="+t.name)
+	}
+	return c
+}
+
+// checkName returns an error if t is selected by a name that GOTOOLCHAIN
+// cannot select.
+func (t toolchain) checkName() error {
+	if t.name == "" {
+		return nil
+	}
+	minor, err := minorRelease(t.name)
+	if err != nil {
+		return err
+	}
+	if minor < oldestNamedRelease {
+		return fmt.Errorf("GOTOOLCHAIN only selects go1.%d or later; give the GOROOT of a %s installation instead", oldestNamedRelease, t.name)
+	}
+	return nil
+}
+
+// checkVersion returns an error if version, the go version reported by the
+// go command of t, is not one the suite can run under.
+func (t toolchain) checkVersion(version string) error {
+	if t.name != "" && version != t.name {
+		return fmt.Errorf("GOTOOLCHAIN=%s selected %s; the go command selects toolchains by name from go1.%d on", t.name, version, oldestNamedRelease)
+	}
+	if version == "" {
+		// go env GOVERSION prints nothing before go1.16.
+		return fmt.Errorf("no go version, so older than go1.%d, the oldest release the suite builds with", oldestRelease)
+	}
+	minor, err := minorRelease(version)
+	if err != nil {
+		return err
+	}
+	if minor < oldestRelease {
+		return fmt.Errorf("%s is older than go1.%d, the oldest release the suite builds with", version, oldestRelease)
+	}
+	return nil
+}
+
+// minorRelease returns the minor release N of a Go version go1.N, go1.N.P or
+// go1.NrcP, or of a development version "devel go1.N-...".
+func minorRelease(version string) (int, error) {
+	v := strings.TrimPrefix(strings.TrimPrefix(version, "devel "), "go1.")
+	i := 0
+	for i < len(v) && '0' <= v[i] && v[i] <= '9' {
+		i++
+	}
+	minor, err := strconv.Atoi(v[:i])
+	if err != nil || len(v) == len(version) {
+		return 0, fmt.Errorf("unrecognized go version %q", version)
+	}
+	return minor, nil
+}
+
+// strategyPair is a baseline strategy and the strategy compared with it.
+type strategyPair struct {
+	baseline string
+	other    string
+}
+
+// parsePairs parses a comma-separated list of baseline:other pairs.
+func parsePairs(s string) ([]strategyPair, error) {
+	var pairs []strategyPair
+	for _, p := range strings.Split(s, ",") {
+		f := strings.SplitN(p, ":", 2)
+		if len(f) != 2 || f[0] == "" || f[1] == "" {
+			return nil, fmt.Errorf("bad strategy pair %q", p)
+		}
+		pairs = append(pairs, strategyPair{f[0], f[1]})
+	}
+	return pairs, nil
+}
+
+// comparisonRows pairs the summaries of p.baseline with those of p.other at
+// the same point, which includes the toolchain, and returns a row per
+// baseline benchmark with a cell per toolchain.
+func comparisonRows(sums []*compare.Summary, p strategyPair, alpha float64) []*compare.Row {
+	var filtered []*compare.Summary
+	for _, s := range sums {
+		if st := s.Dims[results.DimStrategy]; st == p.baseline || st == p.other {
+			filtered = append(filtered, s)
+		}
+	}
+
+	return compare.Rows(compare.Pairs(filtered, p.baseline, alpha),
+		func(p *compare.Pair) string { return p.Baseline.Name },
+		func(p *compare.Pair) string { return p.Baseline.Dims[results.DimToolchain] })
+}
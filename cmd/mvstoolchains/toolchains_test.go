@@ -0,0 +1,100 @@
+package main
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/jackc/go_map_vs_switch/internal/compare"
+	"github.com/jackc/go_map_vs_switch/internal/results"
+	"github.com/jackc/go_map_vs_switch/internal/runner"
+)
+
+func TestToolchainConfig(t *testing.T) {
+	base := runner.Config{Dir: "bench", Env: []string{"MVS_SEED=2"}}
+
+	c := parseToolchain("go1.22.1").config(base)
+	if c.Go != "" || !reflect.DeepEqual(c.Env, []string{"MVS_SEED=2", "GOTOOLCHAIN=go1.22.1"}) {
+		t.Errorf("go1.22.1: Go = %q, Env = %q", c.Go, c.Env)
+	}
+
+	c = parseToolchain("/usr/local/go").config(base)
+	if c.Go != "/usr/local/go/bin/go" || !reflect.DeepEqual(c.Env, []string{"MVS_SEED=2", "GOROOT=/usr/local/go"}) {
+		t.Errorf("/usr/local/go: Go = %q, Env = %q", c.Go, c.Env)
+	}
+
+	if !reflect.DeepEqual(base.Env, []string{"MVS_SEED=2"}) {
+		t.Errorf("base Env changed to %q", base.Env)
+	}
+}
+
+func TestToolchainCheck(t *testing.T) {
+	tests := []struct {
+		spec    string
+		version string // as reported by go env GOVERSION, if checkName passes
+		want    string // error text, or "" for none
+	}{
+		{"go1.22.1", "go1.22.1", ""},
+		{"go1.21rc2", "go1.21rc2", ""},
+		{"go1.19", "", "GOTOOLCHAIN only selects go1.21 or later"},
+		{"go1.23.0", "go1.20.3", "GOTOOLCHAIN=go1.23.0 selected go1.20.3"},
+		{"gotip", "", `unrecognized go version "gotip"`},
+		{"/usr/local/go1.17", "go1.17.13", ""},
+		{"/usr/local/go", "devel go1.24-4a1c7e5 Tue Jun 4 2024", ""},
+		{"/usr/local/go1.16", "go1.16.15", "go1.16.15 is older than go1.17"},
+		{"/usr/local/go1.4", "go1.4", "go1.4 is older than go1.17"},
+		{"/usr/local/go1.15", "", "no go version, so older than go1.17"},
+	}
+	for _, tt := range tests {
+		tc := parseToolchain(tt.spec)
+		err := tc.checkName()
+		if err == nil {
+			err = tc.checkVersion(tt.version)
+		}
+		switch {
+		case tt.want == "" && err != nil:
+			t.Errorf("%s (%s): %v", tt.spec, tt.version, err)
+		case tt.want != "" && err == nil:
+			t.Errorf("%s (%s): no error, want %q", tt.spec, tt.version, tt.want)
+		case tt.want != "" && !strings.Contains(err.Error(), tt.want):
+			t.Errorf("%s (%s): error %q, want %q", tt.spec, tt.version, err, tt.want)
+		}
+	}
+}
+
+func TestComparisonTable(t *testing.T) {
+	d := &results.Dataset{}
+	add := func(name, version string, ns ...float64) {
+		for _, v := range ns {
+			dims := results.ParseName(name)
+			dims[results.DimToolchain] = version
+			d.Results = append(d.Results, results.Result{Name: name, Dims: dims, NsPerOp: v})
+		}
+	}
+	add("BenchmarkSwitch/inline=true/pattern=random/n=4", "go1.21.0", 10, 10, 10, 10)
+	add("BenchmarkMap/inline=true/pattern=random/n=4", "go1.21.0", 20, 20, 20, 20)
+	add("BenchmarkSwitch/inline=true/pattern=random/n=4", "go1.22.0", 10, 11, 10, 11)
+	add("BenchmarkMap/inline=true/pattern=random/n=4", "go1.22.0", 10, 11, 11, 10)
+	add("BenchmarkSlice/inline=true/pattern=random/n=4", "go1.22.0", 5, 5, 5, 5)
+
+	versions := d.DimValues(results.DimToolchain)
+	if want := []string{"go1.21.0", "go1.22.0"}; !reflect.DeepEqual(versions, want) {
+		t.Fatalf("versions = %q, want %q", versions, want)
+	}
+
+	rows := comparisonRows(compare.Summarize(d, 0.95), strategyPair{"switch", "map"}, 0.05)
+	if len(rows) != 1 || len(rows[0].Cells) != 2 {
+		t.Fatalf("got rows %+v, want one row with two toolchains", rows)
+	}
+
+	var buf bytes.Buffer
+	if err := compare.WriteRows(&buf, rows, versions); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	want := "BenchmarkSwitch/inline=true/pattern=random/n=4  10.00 / 20.00 +100.0%  10.50 / 10.50 +0.0% ~"
+	if len(lines) != 2 || lines[1] != want {
+		t.Errorf("table =\n%s\nwant row %q", buf.String(), want)
+	}
+}
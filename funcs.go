@@ -1,5 +1,17 @@
+// Code generated by genbench from matrix.json. DO NOT EDIT.
+
 package go_map_vs_switch
 
+import (
+	"reflect"
+)
+
+// Handler is implemented by one type per generated function, e.g.
+// InlineHandler3, whose Handle method has the same body as the function.
+type Handler interface {
+	Handle(n int) int
+}
+
 var InlineFuncs []func(int) int
 
 func Inline0(n int) int {
@@ -4100,5126 +4112,21580 @@ func Inline511(n int) int {
 
 var NoInlineFuncs []func(int) int
 
+//go:noinline
 func NoInline0(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline1(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline2(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline3(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline4(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline5(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline6(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline7(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline8(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline9(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline10(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline11(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline12(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline13(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline14(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline15(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline16(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline17(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline18(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline19(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline20(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline21(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline22(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline23(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline24(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline25(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline26(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline27(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline28(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline29(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline30(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline31(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline32(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline33(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline34(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline35(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline36(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline37(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline38(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline39(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline40(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline41(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline42(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline43(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline44(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline45(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline46(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline47(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline48(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline49(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline50(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline51(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline52(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline53(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline54(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline55(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline56(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline57(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline58(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline59(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline60(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline61(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline62(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline63(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline64(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline65(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline66(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline67(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline68(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline69(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline70(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline71(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline72(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline73(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline74(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline75(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline76(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline77(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline78(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline79(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline80(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline81(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline82(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline83(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline84(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline85(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline86(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline87(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline88(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline89(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline90(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline91(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline92(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline93(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline94(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline95(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline96(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline97(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline98(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline99(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline100(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline101(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline102(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline103(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline104(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline105(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline106(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline107(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline108(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline109(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline110(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline111(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline112(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline113(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline114(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline115(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline116(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline117(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline118(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline119(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline120(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline121(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline122(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline123(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline124(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline125(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline126(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline127(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline128(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline129(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline130(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline131(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline132(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline133(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline134(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline135(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline136(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline137(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline138(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline139(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline140(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline141(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline142(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline143(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline144(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline145(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline146(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline147(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline148(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline149(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline150(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline151(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline152(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline153(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline154(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline155(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline156(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline157(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline158(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline159(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline160(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline161(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline162(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline163(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline164(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline165(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline166(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline167(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline168(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline169(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline170(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline171(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline172(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline173(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline174(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline175(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline176(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline177(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline178(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline179(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline180(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline181(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline182(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline183(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline184(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline185(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline186(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline187(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline188(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline189(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline190(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline191(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline192(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline193(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline194(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline195(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline196(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline197(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline198(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline199(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline200(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline201(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline202(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline203(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline204(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline205(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline206(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline207(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline208(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline209(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline210(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline211(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline212(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline213(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline214(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline215(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline216(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline217(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline218(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline219(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline220(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline221(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline222(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline223(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline224(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline225(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline226(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline227(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline228(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline229(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline230(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline231(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline232(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline233(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline234(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline235(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline236(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline237(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline238(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline239(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline240(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline241(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline242(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline243(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline244(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline245(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline246(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline247(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline248(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline249(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline250(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline251(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline252(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline253(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline254(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline255(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline256(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline257(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline258(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline259(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline260(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline261(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline262(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline263(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline264(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline265(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline266(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline267(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline268(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline269(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline270(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline271(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline272(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline273(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline274(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline275(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline276(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline277(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline278(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline279(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline280(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline281(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline282(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline283(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline284(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline285(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline286(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline287(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline288(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline289(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline290(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline291(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline292(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline293(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline294(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline295(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline296(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline297(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline298(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline299(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline300(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline301(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline302(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline303(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline304(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline305(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline306(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline307(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline308(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline309(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline310(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline311(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline312(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline313(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline314(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline315(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline316(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline317(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline318(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline319(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline320(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline321(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline322(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline323(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline324(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline325(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline326(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline327(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline328(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline329(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline330(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline331(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline332(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline333(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline334(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline335(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline336(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline337(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline338(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline339(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline340(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline341(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline342(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline343(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline344(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline345(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline346(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline347(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline348(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline349(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline350(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline351(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline352(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline353(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline354(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline355(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline356(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline357(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline358(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline359(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline360(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline361(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline362(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline363(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline364(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline365(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline366(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline367(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline368(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline369(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline370(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline371(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline372(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline373(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline374(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline375(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline376(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline377(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline378(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline379(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline380(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline381(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline382(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline383(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline384(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline385(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline386(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline387(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline388(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline389(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline390(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline391(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline392(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline393(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline394(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline395(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline396(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline397(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline398(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline399(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline400(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline401(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline402(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline403(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline404(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline405(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline406(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline407(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline408(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline409(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline410(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline411(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline412(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline413(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline414(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline415(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline416(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline417(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline418(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline419(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline420(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline421(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline422(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline423(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline424(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline425(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline426(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline427(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline428(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline429(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline430(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline431(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline432(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline433(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline434(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline435(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline436(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline437(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline438(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline439(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline440(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline441(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline442(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline443(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline444(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline445(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline446(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline447(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline448(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline449(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline450(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline451(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline452(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline453(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline454(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline455(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline456(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline457(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline458(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline459(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline460(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline461(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline462(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline463(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline464(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline465(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline466(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline467(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline468(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline469(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline470(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline471(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline472(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline473(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline474(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline475(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline476(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline477(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline478(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline479(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline480(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline481(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline482(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline483(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline484(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline485(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline486(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline487(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline488(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline489(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline490(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline491(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline492(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline493(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline494(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline495(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline496(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline497(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline498(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline499(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline500(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline501(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline502(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline503(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline504(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline505(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline506(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline507(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+//go:noinline
 func NoInline508(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+//go:noinline
+func NoInline509(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+//go:noinline
+func NoInline510(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+//go:noinline
+func NoInline511(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+var InlineHandlers []Handler
+
+// InlineMessages holds the same values as InlineHandlers as interface{} values.
+var InlineMessages []interface{}
+
+// InlineTypeHandlers holds the TypeHandler of each message.
+var InlineTypeHandlers []func(interface{}, int) int
+
+type InlineHandler0 struct{}
+
+func (InlineHandler0) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler0 handles a InlineHandler0 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler0(m interface{}, n int) int {
+	return m.(InlineHandler0).Handle(n)
+}
+
+type InlineHandler1 struct{}
+
+func (InlineHandler1) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler1 handles a InlineHandler1 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler1(m interface{}, n int) int {
+	return m.(InlineHandler1).Handle(n)
+}
+
+type InlineHandler2 struct{}
+
+func (InlineHandler2) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler2 handles a InlineHandler2 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler2(m interface{}, n int) int {
+	return m.(InlineHandler2).Handle(n)
+}
+
+type InlineHandler3 struct{}
+
+func (InlineHandler3) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler3 handles a InlineHandler3 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler3(m interface{}, n int) int {
+	return m.(InlineHandler3).Handle(n)
+}
+
+type InlineHandler4 struct{}
+
+func (InlineHandler4) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler4 handles a InlineHandler4 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler4(m interface{}, n int) int {
+	return m.(InlineHandler4).Handle(n)
+}
+
+type InlineHandler5 struct{}
+
+func (InlineHandler5) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler5 handles a InlineHandler5 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler5(m interface{}, n int) int {
+	return m.(InlineHandler5).Handle(n)
+}
+
+type InlineHandler6 struct{}
+
+func (InlineHandler6) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler6 handles a InlineHandler6 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler6(m interface{}, n int) int {
+	return m.(InlineHandler6).Handle(n)
+}
+
+type InlineHandler7 struct{}
+
+func (InlineHandler7) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler7 handles a InlineHandler7 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler7(m interface{}, n int) int {
+	return m.(InlineHandler7).Handle(n)
+}
+
+type InlineHandler8 struct{}
+
+func (InlineHandler8) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler8 handles a InlineHandler8 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler8(m interface{}, n int) int {
+	return m.(InlineHandler8).Handle(n)
+}
+
+type InlineHandler9 struct{}
+
+func (InlineHandler9) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler9 handles a InlineHandler9 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler9(m interface{}, n int) int {
+	return m.(InlineHandler9).Handle(n)
+}
+
+type InlineHandler10 struct{}
+
+func (InlineHandler10) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler10 handles a InlineHandler10 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler10(m interface{}, n int) int {
+	return m.(InlineHandler10).Handle(n)
+}
+
+type InlineHandler11 struct{}
+
+func (InlineHandler11) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler11 handles a InlineHandler11 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler11(m interface{}, n int) int {
+	return m.(InlineHandler11).Handle(n)
+}
+
+type InlineHandler12 struct{}
+
+func (InlineHandler12) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler12 handles a InlineHandler12 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler12(m interface{}, n int) int {
+	return m.(InlineHandler12).Handle(n)
+}
+
+type InlineHandler13 struct{}
+
+func (InlineHandler13) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler13 handles a InlineHandler13 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler13(m interface{}, n int) int {
+	return m.(InlineHandler13).Handle(n)
+}
+
+type InlineHandler14 struct{}
+
+func (InlineHandler14) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler14 handles a InlineHandler14 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler14(m interface{}, n int) int {
+	return m.(InlineHandler14).Handle(n)
+}
+
+type InlineHandler15 struct{}
+
+func (InlineHandler15) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler15 handles a InlineHandler15 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler15(m interface{}, n int) int {
+	return m.(InlineHandler15).Handle(n)
+}
+
+type InlineHandler16 struct{}
+
+func (InlineHandler16) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler16 handles a InlineHandler16 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler16(m interface{}, n int) int {
+	return m.(InlineHandler16).Handle(n)
+}
+
+type InlineHandler17 struct{}
+
+func (InlineHandler17) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler17 handles a InlineHandler17 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler17(m interface{}, n int) int {
+	return m.(InlineHandler17).Handle(n)
+}
+
+type InlineHandler18 struct{}
+
+func (InlineHandler18) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler18 handles a InlineHandler18 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler18(m interface{}, n int) int {
+	return m.(InlineHandler18).Handle(n)
+}
+
+type InlineHandler19 struct{}
+
+func (InlineHandler19) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler19 handles a InlineHandler19 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler19(m interface{}, n int) int {
+	return m.(InlineHandler19).Handle(n)
+}
+
+type InlineHandler20 struct{}
+
+func (InlineHandler20) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler20 handles a InlineHandler20 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler20(m interface{}, n int) int {
+	return m.(InlineHandler20).Handle(n)
+}
+
+type InlineHandler21 struct{}
+
+func (InlineHandler21) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler21 handles a InlineHandler21 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler21(m interface{}, n int) int {
+	return m.(InlineHandler21).Handle(n)
+}
+
+type InlineHandler22 struct{}
+
+func (InlineHandler22) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler22 handles a InlineHandler22 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler22(m interface{}, n int) int {
+	return m.(InlineHandler22).Handle(n)
+}
+
+type InlineHandler23 struct{}
+
+func (InlineHandler23) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler23 handles a InlineHandler23 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler23(m interface{}, n int) int {
+	return m.(InlineHandler23).Handle(n)
+}
+
+type InlineHandler24 struct{}
+
+func (InlineHandler24) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler24 handles a InlineHandler24 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler24(m interface{}, n int) int {
+	return m.(InlineHandler24).Handle(n)
+}
+
+type InlineHandler25 struct{}
+
+func (InlineHandler25) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler25 handles a InlineHandler25 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler25(m interface{}, n int) int {
+	return m.(InlineHandler25).Handle(n)
+}
+
+type InlineHandler26 struct{}
+
+func (InlineHandler26) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler26 handles a InlineHandler26 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler26(m interface{}, n int) int {
+	return m.(InlineHandler26).Handle(n)
+}
+
+type InlineHandler27 struct{}
+
+func (InlineHandler27) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler27 handles a InlineHandler27 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler27(m interface{}, n int) int {
+	return m.(InlineHandler27).Handle(n)
+}
+
+type InlineHandler28 struct{}
+
+func (InlineHandler28) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler28 handles a InlineHandler28 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler28(m interface{}, n int) int {
+	return m.(InlineHandler28).Handle(n)
+}
+
+type InlineHandler29 struct{}
+
+func (InlineHandler29) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler29 handles a InlineHandler29 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler29(m interface{}, n int) int {
+	return m.(InlineHandler29).Handle(n)
+}
+
+type InlineHandler30 struct{}
+
+func (InlineHandler30) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler30 handles a InlineHandler30 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler30(m interface{}, n int) int {
+	return m.(InlineHandler30).Handle(n)
+}
+
+type InlineHandler31 struct{}
+
+func (InlineHandler31) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler31 handles a InlineHandler31 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler31(m interface{}, n int) int {
+	return m.(InlineHandler31).Handle(n)
+}
+
+type InlineHandler32 struct{}
+
+func (InlineHandler32) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler32 handles a InlineHandler32 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler32(m interface{}, n int) int {
+	return m.(InlineHandler32).Handle(n)
+}
+
+type InlineHandler33 struct{}
+
+func (InlineHandler33) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler33 handles a InlineHandler33 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler33(m interface{}, n int) int {
+	return m.(InlineHandler33).Handle(n)
+}
+
+type InlineHandler34 struct{}
+
+func (InlineHandler34) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler34 handles a InlineHandler34 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler34(m interface{}, n int) int {
+	return m.(InlineHandler34).Handle(n)
+}
+
+type InlineHandler35 struct{}
+
+func (InlineHandler35) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler35 handles a InlineHandler35 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler35(m interface{}, n int) int {
+	return m.(InlineHandler35).Handle(n)
+}
+
+type InlineHandler36 struct{}
+
+func (InlineHandler36) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler36 handles a InlineHandler36 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler36(m interface{}, n int) int {
+	return m.(InlineHandler36).Handle(n)
+}
+
+type InlineHandler37 struct{}
+
+func (InlineHandler37) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler37 handles a InlineHandler37 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler37(m interface{}, n int) int {
+	return m.(InlineHandler37).Handle(n)
+}
+
+type InlineHandler38 struct{}
+
+func (InlineHandler38) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler38 handles a InlineHandler38 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler38(m interface{}, n int) int {
+	return m.(InlineHandler38).Handle(n)
+}
+
+type InlineHandler39 struct{}
+
+func (InlineHandler39) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler39 handles a InlineHandler39 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler39(m interface{}, n int) int {
+	return m.(InlineHandler39).Handle(n)
+}
+
+type InlineHandler40 struct{}
+
+func (InlineHandler40) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler40 handles a InlineHandler40 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler40(m interface{}, n int) int {
+	return m.(InlineHandler40).Handle(n)
+}
+
+type InlineHandler41 struct{}
+
+func (InlineHandler41) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler41 handles a InlineHandler41 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler41(m interface{}, n int) int {
+	return m.(InlineHandler41).Handle(n)
+}
+
+type InlineHandler42 struct{}
+
+func (InlineHandler42) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler42 handles a InlineHandler42 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler42(m interface{}, n int) int {
+	return m.(InlineHandler42).Handle(n)
+}
+
+type InlineHandler43 struct{}
+
+func (InlineHandler43) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler43 handles a InlineHandler43 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler43(m interface{}, n int) int {
+	return m.(InlineHandler43).Handle(n)
+}
+
+type InlineHandler44 struct{}
+
+func (InlineHandler44) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler44 handles a InlineHandler44 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler44(m interface{}, n int) int {
+	return m.(InlineHandler44).Handle(n)
+}
+
+type InlineHandler45 struct{}
+
+func (InlineHandler45) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler45 handles a InlineHandler45 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler45(m interface{}, n int) int {
+	return m.(InlineHandler45).Handle(n)
+}
+
+type InlineHandler46 struct{}
+
+func (InlineHandler46) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler46 handles a InlineHandler46 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler46(m interface{}, n int) int {
+	return m.(InlineHandler46).Handle(n)
+}
+
+type InlineHandler47 struct{}
+
+func (InlineHandler47) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler47 handles a InlineHandler47 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler47(m interface{}, n int) int {
+	return m.(InlineHandler47).Handle(n)
+}
+
+type InlineHandler48 struct{}
+
+func (InlineHandler48) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler48 handles a InlineHandler48 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler48(m interface{}, n int) int {
+	return m.(InlineHandler48).Handle(n)
+}
+
+type InlineHandler49 struct{}
+
+func (InlineHandler49) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler49 handles a InlineHandler49 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler49(m interface{}, n int) int {
+	return m.(InlineHandler49).Handle(n)
+}
+
+type InlineHandler50 struct{}
+
+func (InlineHandler50) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler50 handles a InlineHandler50 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler50(m interface{}, n int) int {
+	return m.(InlineHandler50).Handle(n)
+}
+
+type InlineHandler51 struct{}
+
+func (InlineHandler51) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler51 handles a InlineHandler51 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler51(m interface{}, n int) int {
+	return m.(InlineHandler51).Handle(n)
+}
+
+type InlineHandler52 struct{}
+
+func (InlineHandler52) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler52 handles a InlineHandler52 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler52(m interface{}, n int) int {
+	return m.(InlineHandler52).Handle(n)
+}
+
+type InlineHandler53 struct{}
+
+func (InlineHandler53) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler53 handles a InlineHandler53 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler53(m interface{}, n int) int {
+	return m.(InlineHandler53).Handle(n)
+}
+
+type InlineHandler54 struct{}
+
+func (InlineHandler54) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler54 handles a InlineHandler54 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler54(m interface{}, n int) int {
+	return m.(InlineHandler54).Handle(n)
+}
+
+type InlineHandler55 struct{}
+
+func (InlineHandler55) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler55 handles a InlineHandler55 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler55(m interface{}, n int) int {
+	return m.(InlineHandler55).Handle(n)
+}
+
+type InlineHandler56 struct{}
+
+func (InlineHandler56) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler56 handles a InlineHandler56 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler56(m interface{}, n int) int {
+	return m.(InlineHandler56).Handle(n)
+}
+
+type InlineHandler57 struct{}
+
+func (InlineHandler57) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler57 handles a InlineHandler57 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler57(m interface{}, n int) int {
+	return m.(InlineHandler57).Handle(n)
+}
+
+type InlineHandler58 struct{}
+
+func (InlineHandler58) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler58 handles a InlineHandler58 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler58(m interface{}, n int) int {
+	return m.(InlineHandler58).Handle(n)
+}
+
+type InlineHandler59 struct{}
+
+func (InlineHandler59) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler59 handles a InlineHandler59 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler59(m interface{}, n int) int {
+	return m.(InlineHandler59).Handle(n)
+}
+
+type InlineHandler60 struct{}
+
+func (InlineHandler60) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler60 handles a InlineHandler60 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler60(m interface{}, n int) int {
+	return m.(InlineHandler60).Handle(n)
+}
+
+type InlineHandler61 struct{}
+
+func (InlineHandler61) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler61 handles a InlineHandler61 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler61(m interface{}, n int) int {
+	return m.(InlineHandler61).Handle(n)
+}
+
+type InlineHandler62 struct{}
+
+func (InlineHandler62) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler62 handles a InlineHandler62 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler62(m interface{}, n int) int {
+	return m.(InlineHandler62).Handle(n)
+}
+
+type InlineHandler63 struct{}
+
+func (InlineHandler63) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler63 handles a InlineHandler63 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler63(m interface{}, n int) int {
+	return m.(InlineHandler63).Handle(n)
+}
+
+type InlineHandler64 struct{}
+
+func (InlineHandler64) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler64 handles a InlineHandler64 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler64(m interface{}, n int) int {
+	return m.(InlineHandler64).Handle(n)
+}
+
+type InlineHandler65 struct{}
+
+func (InlineHandler65) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler65 handles a InlineHandler65 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler65(m interface{}, n int) int {
+	return m.(InlineHandler65).Handle(n)
+}
+
+type InlineHandler66 struct{}
+
+func (InlineHandler66) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler66 handles a InlineHandler66 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler66(m interface{}, n int) int {
+	return m.(InlineHandler66).Handle(n)
+}
+
+type InlineHandler67 struct{}
+
+func (InlineHandler67) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler67 handles a InlineHandler67 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler67(m interface{}, n int) int {
+	return m.(InlineHandler67).Handle(n)
+}
+
+type InlineHandler68 struct{}
+
+func (InlineHandler68) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler68 handles a InlineHandler68 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler68(m interface{}, n int) int {
+	return m.(InlineHandler68).Handle(n)
+}
+
+type InlineHandler69 struct{}
+
+func (InlineHandler69) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler69 handles a InlineHandler69 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler69(m interface{}, n int) int {
+	return m.(InlineHandler69).Handle(n)
+}
+
+type InlineHandler70 struct{}
+
+func (InlineHandler70) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler70 handles a InlineHandler70 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler70(m interface{}, n int) int {
+	return m.(InlineHandler70).Handle(n)
+}
+
+type InlineHandler71 struct{}
+
+func (InlineHandler71) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler71 handles a InlineHandler71 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler71(m interface{}, n int) int {
+	return m.(InlineHandler71).Handle(n)
+}
+
+type InlineHandler72 struct{}
+
+func (InlineHandler72) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler72 handles a InlineHandler72 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler72(m interface{}, n int) int {
+	return m.(InlineHandler72).Handle(n)
+}
+
+type InlineHandler73 struct{}
+
+func (InlineHandler73) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler73 handles a InlineHandler73 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler73(m interface{}, n int) int {
+	return m.(InlineHandler73).Handle(n)
+}
+
+type InlineHandler74 struct{}
+
+func (InlineHandler74) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler74 handles a InlineHandler74 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler74(m interface{}, n int) int {
+	return m.(InlineHandler74).Handle(n)
+}
+
+type InlineHandler75 struct{}
+
+func (InlineHandler75) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler75 handles a InlineHandler75 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler75(m interface{}, n int) int {
+	return m.(InlineHandler75).Handle(n)
+}
+
+type InlineHandler76 struct{}
+
+func (InlineHandler76) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler76 handles a InlineHandler76 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler76(m interface{}, n int) int {
+	return m.(InlineHandler76).Handle(n)
+}
+
+type InlineHandler77 struct{}
+
+func (InlineHandler77) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler77 handles a InlineHandler77 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler77(m interface{}, n int) int {
+	return m.(InlineHandler77).Handle(n)
+}
+
+type InlineHandler78 struct{}
+
+func (InlineHandler78) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler78 handles a InlineHandler78 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler78(m interface{}, n int) int {
+	return m.(InlineHandler78).Handle(n)
+}
+
+type InlineHandler79 struct{}
+
+func (InlineHandler79) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler79 handles a InlineHandler79 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler79(m interface{}, n int) int {
+	return m.(InlineHandler79).Handle(n)
+}
+
+type InlineHandler80 struct{}
+
+func (InlineHandler80) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler80 handles a InlineHandler80 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler80(m interface{}, n int) int {
+	return m.(InlineHandler80).Handle(n)
+}
+
+type InlineHandler81 struct{}
+
+func (InlineHandler81) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler81 handles a InlineHandler81 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler81(m interface{}, n int) int {
+	return m.(InlineHandler81).Handle(n)
+}
+
+type InlineHandler82 struct{}
+
+func (InlineHandler82) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler82 handles a InlineHandler82 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler82(m interface{}, n int) int {
+	return m.(InlineHandler82).Handle(n)
+}
+
+type InlineHandler83 struct{}
+
+func (InlineHandler83) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler83 handles a InlineHandler83 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler83(m interface{}, n int) int {
+	return m.(InlineHandler83).Handle(n)
+}
+
+type InlineHandler84 struct{}
+
+func (InlineHandler84) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler84 handles a InlineHandler84 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler84(m interface{}, n int) int {
+	return m.(InlineHandler84).Handle(n)
+}
+
+type InlineHandler85 struct{}
+
+func (InlineHandler85) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler85 handles a InlineHandler85 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler85(m interface{}, n int) int {
+	return m.(InlineHandler85).Handle(n)
+}
+
+type InlineHandler86 struct{}
+
+func (InlineHandler86) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler86 handles a InlineHandler86 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler86(m interface{}, n int) int {
+	return m.(InlineHandler86).Handle(n)
+}
+
+type InlineHandler87 struct{}
+
+func (InlineHandler87) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler87 handles a InlineHandler87 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler87(m interface{}, n int) int {
+	return m.(InlineHandler87).Handle(n)
+}
+
+type InlineHandler88 struct{}
+
+func (InlineHandler88) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler88 handles a InlineHandler88 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler88(m interface{}, n int) int {
+	return m.(InlineHandler88).Handle(n)
+}
+
+type InlineHandler89 struct{}
+
+func (InlineHandler89) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler89 handles a InlineHandler89 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler89(m interface{}, n int) int {
+	return m.(InlineHandler89).Handle(n)
+}
+
+type InlineHandler90 struct{}
+
+func (InlineHandler90) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler90 handles a InlineHandler90 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler90(m interface{}, n int) int {
+	return m.(InlineHandler90).Handle(n)
+}
+
+type InlineHandler91 struct{}
+
+func (InlineHandler91) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler91 handles a InlineHandler91 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler91(m interface{}, n int) int {
+	return m.(InlineHandler91).Handle(n)
+}
+
+type InlineHandler92 struct{}
+
+func (InlineHandler92) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler92 handles a InlineHandler92 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler92(m interface{}, n int) int {
+	return m.(InlineHandler92).Handle(n)
+}
+
+type InlineHandler93 struct{}
+
+func (InlineHandler93) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler93 handles a InlineHandler93 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler93(m interface{}, n int) int {
+	return m.(InlineHandler93).Handle(n)
+}
+
+type InlineHandler94 struct{}
+
+func (InlineHandler94) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler94 handles a InlineHandler94 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler94(m interface{}, n int) int {
+	return m.(InlineHandler94).Handle(n)
+}
+
+type InlineHandler95 struct{}
+
+func (InlineHandler95) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler95 handles a InlineHandler95 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler95(m interface{}, n int) int {
+	return m.(InlineHandler95).Handle(n)
+}
+
+type InlineHandler96 struct{}
+
+func (InlineHandler96) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler96 handles a InlineHandler96 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler96(m interface{}, n int) int {
+	return m.(InlineHandler96).Handle(n)
+}
+
+type InlineHandler97 struct{}
+
+func (InlineHandler97) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler97 handles a InlineHandler97 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler97(m interface{}, n int) int {
+	return m.(InlineHandler97).Handle(n)
+}
+
+type InlineHandler98 struct{}
+
+func (InlineHandler98) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler98 handles a InlineHandler98 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler98(m interface{}, n int) int {
+	return m.(InlineHandler98).Handle(n)
+}
+
+type InlineHandler99 struct{}
+
+func (InlineHandler99) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler99 handles a InlineHandler99 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler99(m interface{}, n int) int {
+	return m.(InlineHandler99).Handle(n)
+}
+
+type InlineHandler100 struct{}
+
+func (InlineHandler100) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler100 handles a InlineHandler100 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler100(m interface{}, n int) int {
+	return m.(InlineHandler100).Handle(n)
+}
+
+type InlineHandler101 struct{}
+
+func (InlineHandler101) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler101 handles a InlineHandler101 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler101(m interface{}, n int) int {
+	return m.(InlineHandler101).Handle(n)
+}
+
+type InlineHandler102 struct{}
+
+func (InlineHandler102) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler102 handles a InlineHandler102 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler102(m interface{}, n int) int {
+	return m.(InlineHandler102).Handle(n)
+}
+
+type InlineHandler103 struct{}
+
+func (InlineHandler103) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler103 handles a InlineHandler103 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler103(m interface{}, n int) int {
+	return m.(InlineHandler103).Handle(n)
+}
+
+type InlineHandler104 struct{}
+
+func (InlineHandler104) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler104 handles a InlineHandler104 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler104(m interface{}, n int) int {
+	return m.(InlineHandler104).Handle(n)
+}
+
+type InlineHandler105 struct{}
+
+func (InlineHandler105) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler105 handles a InlineHandler105 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler105(m interface{}, n int) int {
+	return m.(InlineHandler105).Handle(n)
+}
+
+type InlineHandler106 struct{}
+
+func (InlineHandler106) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler106 handles a InlineHandler106 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler106(m interface{}, n int) int {
+	return m.(InlineHandler106).Handle(n)
+}
+
+type InlineHandler107 struct{}
+
+func (InlineHandler107) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler107 handles a InlineHandler107 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler107(m interface{}, n int) int {
+	return m.(InlineHandler107).Handle(n)
+}
+
+type InlineHandler108 struct{}
+
+func (InlineHandler108) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler108 handles a InlineHandler108 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler108(m interface{}, n int) int {
+	return m.(InlineHandler108).Handle(n)
+}
+
+type InlineHandler109 struct{}
+
+func (InlineHandler109) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler109 handles a InlineHandler109 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler109(m interface{}, n int) int {
+	return m.(InlineHandler109).Handle(n)
+}
+
+type InlineHandler110 struct{}
+
+func (InlineHandler110) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler110 handles a InlineHandler110 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler110(m interface{}, n int) int {
+	return m.(InlineHandler110).Handle(n)
+}
+
+type InlineHandler111 struct{}
+
+func (InlineHandler111) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler111 handles a InlineHandler111 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler111(m interface{}, n int) int {
+	return m.(InlineHandler111).Handle(n)
+}
+
+type InlineHandler112 struct{}
+
+func (InlineHandler112) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler112 handles a InlineHandler112 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler112(m interface{}, n int) int {
+	return m.(InlineHandler112).Handle(n)
+}
+
+type InlineHandler113 struct{}
+
+func (InlineHandler113) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler113 handles a InlineHandler113 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler113(m interface{}, n int) int {
+	return m.(InlineHandler113).Handle(n)
+}
+
+type InlineHandler114 struct{}
+
+func (InlineHandler114) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler114 handles a InlineHandler114 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler114(m interface{}, n int) int {
+	return m.(InlineHandler114).Handle(n)
+}
+
+type InlineHandler115 struct{}
+
+func (InlineHandler115) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler115 handles a InlineHandler115 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler115(m interface{}, n int) int {
+	return m.(InlineHandler115).Handle(n)
+}
+
+type InlineHandler116 struct{}
+
+func (InlineHandler116) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler116 handles a InlineHandler116 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler116(m interface{}, n int) int {
+	return m.(InlineHandler116).Handle(n)
+}
+
+type InlineHandler117 struct{}
+
+func (InlineHandler117) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler117 handles a InlineHandler117 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler117(m interface{}, n int) int {
+	return m.(InlineHandler117).Handle(n)
+}
+
+type InlineHandler118 struct{}
+
+func (InlineHandler118) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler118 handles a InlineHandler118 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler118(m interface{}, n int) int {
+	return m.(InlineHandler118).Handle(n)
+}
+
+type InlineHandler119 struct{}
+
+func (InlineHandler119) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler119 handles a InlineHandler119 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler119(m interface{}, n int) int {
+	return m.(InlineHandler119).Handle(n)
+}
+
+type InlineHandler120 struct{}
+
+func (InlineHandler120) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler120 handles a InlineHandler120 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler120(m interface{}, n int) int {
+	return m.(InlineHandler120).Handle(n)
+}
+
+type InlineHandler121 struct{}
+
+func (InlineHandler121) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler121 handles a InlineHandler121 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler121(m interface{}, n int) int {
+	return m.(InlineHandler121).Handle(n)
+}
+
+type InlineHandler122 struct{}
+
+func (InlineHandler122) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler122 handles a InlineHandler122 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler122(m interface{}, n int) int {
+	return m.(InlineHandler122).Handle(n)
+}
+
+type InlineHandler123 struct{}
+
+func (InlineHandler123) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler123 handles a InlineHandler123 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler123(m interface{}, n int) int {
+	return m.(InlineHandler123).Handle(n)
+}
+
+type InlineHandler124 struct{}
+
+func (InlineHandler124) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler124 handles a InlineHandler124 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler124(m interface{}, n int) int {
+	return m.(InlineHandler124).Handle(n)
+}
+
+type InlineHandler125 struct{}
+
+func (InlineHandler125) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler125 handles a InlineHandler125 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler125(m interface{}, n int) int {
+	return m.(InlineHandler125).Handle(n)
+}
+
+type InlineHandler126 struct{}
+
+func (InlineHandler126) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler126 handles a InlineHandler126 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler126(m interface{}, n int) int {
+	return m.(InlineHandler126).Handle(n)
+}
+
+type InlineHandler127 struct{}
+
+func (InlineHandler127) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler127 handles a InlineHandler127 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler127(m interface{}, n int) int {
+	return m.(InlineHandler127).Handle(n)
+}
+
+type InlineHandler128 struct{}
+
+func (InlineHandler128) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler128 handles a InlineHandler128 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler128(m interface{}, n int) int {
+	return m.(InlineHandler128).Handle(n)
+}
+
+type InlineHandler129 struct{}
+
+func (InlineHandler129) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler129 handles a InlineHandler129 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler129(m interface{}, n int) int {
+	return m.(InlineHandler129).Handle(n)
+}
+
+type InlineHandler130 struct{}
+
+func (InlineHandler130) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler130 handles a InlineHandler130 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler130(m interface{}, n int) int {
+	return m.(InlineHandler130).Handle(n)
+}
+
+type InlineHandler131 struct{}
+
+func (InlineHandler131) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler131 handles a InlineHandler131 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler131(m interface{}, n int) int {
+	return m.(InlineHandler131).Handle(n)
+}
+
+type InlineHandler132 struct{}
+
+func (InlineHandler132) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler132 handles a InlineHandler132 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler132(m interface{}, n int) int {
+	return m.(InlineHandler132).Handle(n)
+}
+
+type InlineHandler133 struct{}
+
+func (InlineHandler133) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler133 handles a InlineHandler133 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler133(m interface{}, n int) int {
+	return m.(InlineHandler133).Handle(n)
+}
+
+type InlineHandler134 struct{}
+
+func (InlineHandler134) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler134 handles a InlineHandler134 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler134(m interface{}, n int) int {
+	return m.(InlineHandler134).Handle(n)
+}
+
+type InlineHandler135 struct{}
+
+func (InlineHandler135) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler135 handles a InlineHandler135 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler135(m interface{}, n int) int {
+	return m.(InlineHandler135).Handle(n)
+}
+
+type InlineHandler136 struct{}
+
+func (InlineHandler136) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler136 handles a InlineHandler136 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler136(m interface{}, n int) int {
+	return m.(InlineHandler136).Handle(n)
+}
+
+type InlineHandler137 struct{}
+
+func (InlineHandler137) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler137 handles a InlineHandler137 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler137(m interface{}, n int) int {
+	return m.(InlineHandler137).Handle(n)
+}
+
+type InlineHandler138 struct{}
+
+func (InlineHandler138) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler138 handles a InlineHandler138 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler138(m interface{}, n int) int {
+	return m.(InlineHandler138).Handle(n)
+}
+
+type InlineHandler139 struct{}
+
+func (InlineHandler139) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler139 handles a InlineHandler139 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler139(m interface{}, n int) int {
+	return m.(InlineHandler139).Handle(n)
+}
+
+type InlineHandler140 struct{}
+
+func (InlineHandler140) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler140 handles a InlineHandler140 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler140(m interface{}, n int) int {
+	return m.(InlineHandler140).Handle(n)
+}
+
+type InlineHandler141 struct{}
+
+func (InlineHandler141) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler141 handles a InlineHandler141 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler141(m interface{}, n int) int {
+	return m.(InlineHandler141).Handle(n)
+}
+
+type InlineHandler142 struct{}
+
+func (InlineHandler142) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler142 handles a InlineHandler142 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler142(m interface{}, n int) int {
+	return m.(InlineHandler142).Handle(n)
+}
+
+type InlineHandler143 struct{}
+
+func (InlineHandler143) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler143 handles a InlineHandler143 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler143(m interface{}, n int) int {
+	return m.(InlineHandler143).Handle(n)
+}
+
+type InlineHandler144 struct{}
+
+func (InlineHandler144) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler144 handles a InlineHandler144 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler144(m interface{}, n int) int {
+	return m.(InlineHandler144).Handle(n)
+}
+
+type InlineHandler145 struct{}
+
+func (InlineHandler145) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler145 handles a InlineHandler145 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler145(m interface{}, n int) int {
+	return m.(InlineHandler145).Handle(n)
+}
+
+type InlineHandler146 struct{}
+
+func (InlineHandler146) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler146 handles a InlineHandler146 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler146(m interface{}, n int) int {
+	return m.(InlineHandler146).Handle(n)
+}
+
+type InlineHandler147 struct{}
+
+func (InlineHandler147) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler147 handles a InlineHandler147 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler147(m interface{}, n int) int {
+	return m.(InlineHandler147).Handle(n)
+}
+
+type InlineHandler148 struct{}
+
+func (InlineHandler148) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler148 handles a InlineHandler148 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler148(m interface{}, n int) int {
+	return m.(InlineHandler148).Handle(n)
+}
+
+type InlineHandler149 struct{}
+
+func (InlineHandler149) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler149 handles a InlineHandler149 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler149(m interface{}, n int) int {
+	return m.(InlineHandler149).Handle(n)
+}
+
+type InlineHandler150 struct{}
+
+func (InlineHandler150) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler150 handles a InlineHandler150 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler150(m interface{}, n int) int {
+	return m.(InlineHandler150).Handle(n)
+}
+
+type InlineHandler151 struct{}
+
+func (InlineHandler151) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler151 handles a InlineHandler151 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler151(m interface{}, n int) int {
+	return m.(InlineHandler151).Handle(n)
+}
+
+type InlineHandler152 struct{}
+
+func (InlineHandler152) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler152 handles a InlineHandler152 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler152(m interface{}, n int) int {
+	return m.(InlineHandler152).Handle(n)
+}
+
+type InlineHandler153 struct{}
+
+func (InlineHandler153) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler153 handles a InlineHandler153 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler153(m interface{}, n int) int {
+	return m.(InlineHandler153).Handle(n)
+}
+
+type InlineHandler154 struct{}
+
+func (InlineHandler154) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler154 handles a InlineHandler154 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler154(m interface{}, n int) int {
+	return m.(InlineHandler154).Handle(n)
+}
+
+type InlineHandler155 struct{}
+
+func (InlineHandler155) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler155 handles a InlineHandler155 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler155(m interface{}, n int) int {
+	return m.(InlineHandler155).Handle(n)
+}
+
+type InlineHandler156 struct{}
+
+func (InlineHandler156) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler156 handles a InlineHandler156 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler156(m interface{}, n int) int {
+	return m.(InlineHandler156).Handle(n)
+}
+
+type InlineHandler157 struct{}
+
+func (InlineHandler157) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler157 handles a InlineHandler157 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler157(m interface{}, n int) int {
+	return m.(InlineHandler157).Handle(n)
+}
+
+type InlineHandler158 struct{}
+
+func (InlineHandler158) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler158 handles a InlineHandler158 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler158(m interface{}, n int) int {
+	return m.(InlineHandler158).Handle(n)
+}
+
+type InlineHandler159 struct{}
+
+func (InlineHandler159) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler159 handles a InlineHandler159 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler159(m interface{}, n int) int {
+	return m.(InlineHandler159).Handle(n)
+}
+
+type InlineHandler160 struct{}
+
+func (InlineHandler160) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler160 handles a InlineHandler160 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler160(m interface{}, n int) int {
+	return m.(InlineHandler160).Handle(n)
+}
+
+type InlineHandler161 struct{}
+
+func (InlineHandler161) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler161 handles a InlineHandler161 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler161(m interface{}, n int) int {
+	return m.(InlineHandler161).Handle(n)
+}
+
+type InlineHandler162 struct{}
+
+func (InlineHandler162) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler162 handles a InlineHandler162 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler162(m interface{}, n int) int {
+	return m.(InlineHandler162).Handle(n)
+}
+
+type InlineHandler163 struct{}
+
+func (InlineHandler163) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler163 handles a InlineHandler163 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler163(m interface{}, n int) int {
+	return m.(InlineHandler163).Handle(n)
+}
+
+type InlineHandler164 struct{}
+
+func (InlineHandler164) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler164 handles a InlineHandler164 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler164(m interface{}, n int) int {
+	return m.(InlineHandler164).Handle(n)
+}
+
+type InlineHandler165 struct{}
+
+func (InlineHandler165) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler165 handles a InlineHandler165 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler165(m interface{}, n int) int {
+	return m.(InlineHandler165).Handle(n)
+}
+
+type InlineHandler166 struct{}
+
+func (InlineHandler166) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler166 handles a InlineHandler166 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler166(m interface{}, n int) int {
+	return m.(InlineHandler166).Handle(n)
+}
+
+type InlineHandler167 struct{}
+
+func (InlineHandler167) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler167 handles a InlineHandler167 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler167(m interface{}, n int) int {
+	return m.(InlineHandler167).Handle(n)
+}
+
+type InlineHandler168 struct{}
+
+func (InlineHandler168) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler168 handles a InlineHandler168 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler168(m interface{}, n int) int {
+	return m.(InlineHandler168).Handle(n)
+}
+
+type InlineHandler169 struct{}
+
+func (InlineHandler169) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler169 handles a InlineHandler169 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler169(m interface{}, n int) int {
+	return m.(InlineHandler169).Handle(n)
+}
+
+type InlineHandler170 struct{}
+
+func (InlineHandler170) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler170 handles a InlineHandler170 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler170(m interface{}, n int) int {
+	return m.(InlineHandler170).Handle(n)
+}
+
+type InlineHandler171 struct{}
+
+func (InlineHandler171) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler171 handles a InlineHandler171 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler171(m interface{}, n int) int {
+	return m.(InlineHandler171).Handle(n)
+}
+
+type InlineHandler172 struct{}
+
+func (InlineHandler172) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler172 handles a InlineHandler172 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler172(m interface{}, n int) int {
+	return m.(InlineHandler172).Handle(n)
+}
+
+type InlineHandler173 struct{}
+
+func (InlineHandler173) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler173 handles a InlineHandler173 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler173(m interface{}, n int) int {
+	return m.(InlineHandler173).Handle(n)
+}
+
+type InlineHandler174 struct{}
+
+func (InlineHandler174) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler174 handles a InlineHandler174 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler174(m interface{}, n int) int {
+	return m.(InlineHandler174).Handle(n)
+}
+
+type InlineHandler175 struct{}
+
+func (InlineHandler175) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler175 handles a InlineHandler175 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler175(m interface{}, n int) int {
+	return m.(InlineHandler175).Handle(n)
+}
+
+type InlineHandler176 struct{}
+
+func (InlineHandler176) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler176 handles a InlineHandler176 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler176(m interface{}, n int) int {
+	return m.(InlineHandler176).Handle(n)
+}
+
+type InlineHandler177 struct{}
+
+func (InlineHandler177) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler177 handles a InlineHandler177 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler177(m interface{}, n int) int {
+	return m.(InlineHandler177).Handle(n)
+}
+
+type InlineHandler178 struct{}
+
+func (InlineHandler178) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler178 handles a InlineHandler178 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler178(m interface{}, n int) int {
+	return m.(InlineHandler178).Handle(n)
+}
+
+type InlineHandler179 struct{}
+
+func (InlineHandler179) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler179 handles a InlineHandler179 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler179(m interface{}, n int) int {
+	return m.(InlineHandler179).Handle(n)
+}
+
+type InlineHandler180 struct{}
+
+func (InlineHandler180) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler180 handles a InlineHandler180 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler180(m interface{}, n int) int {
+	return m.(InlineHandler180).Handle(n)
+}
+
+type InlineHandler181 struct{}
+
+func (InlineHandler181) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler181 handles a InlineHandler181 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler181(m interface{}, n int) int {
+	return m.(InlineHandler181).Handle(n)
+}
+
+type InlineHandler182 struct{}
+
+func (InlineHandler182) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler182 handles a InlineHandler182 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler182(m interface{}, n int) int {
+	return m.(InlineHandler182).Handle(n)
+}
+
+type InlineHandler183 struct{}
+
+func (InlineHandler183) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler183 handles a InlineHandler183 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler183(m interface{}, n int) int {
+	return m.(InlineHandler183).Handle(n)
+}
+
+type InlineHandler184 struct{}
+
+func (InlineHandler184) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler184 handles a InlineHandler184 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler184(m interface{}, n int) int {
+	return m.(InlineHandler184).Handle(n)
+}
+
+type InlineHandler185 struct{}
+
+func (InlineHandler185) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler185 handles a InlineHandler185 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler185(m interface{}, n int) int {
+	return m.(InlineHandler185).Handle(n)
+}
+
+type InlineHandler186 struct{}
+
+func (InlineHandler186) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler186 handles a InlineHandler186 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler186(m interface{}, n int) int {
+	return m.(InlineHandler186).Handle(n)
+}
+
+type InlineHandler187 struct{}
+
+func (InlineHandler187) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler187 handles a InlineHandler187 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler187(m interface{}, n int) int {
+	return m.(InlineHandler187).Handle(n)
+}
+
+type InlineHandler188 struct{}
+
+func (InlineHandler188) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler188 handles a InlineHandler188 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler188(m interface{}, n int) int {
+	return m.(InlineHandler188).Handle(n)
+}
+
+type InlineHandler189 struct{}
+
+func (InlineHandler189) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler189 handles a InlineHandler189 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler189(m interface{}, n int) int {
+	return m.(InlineHandler189).Handle(n)
+}
+
+type InlineHandler190 struct{}
+
+func (InlineHandler190) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler190 handles a InlineHandler190 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler190(m interface{}, n int) int {
+	return m.(InlineHandler190).Handle(n)
+}
+
+type InlineHandler191 struct{}
+
+func (InlineHandler191) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler191 handles a InlineHandler191 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler191(m interface{}, n int) int {
+	return m.(InlineHandler191).Handle(n)
+}
+
+type InlineHandler192 struct{}
+
+func (InlineHandler192) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler192 handles a InlineHandler192 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler192(m interface{}, n int) int {
+	return m.(InlineHandler192).Handle(n)
+}
+
+type InlineHandler193 struct{}
+
+func (InlineHandler193) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler193 handles a InlineHandler193 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler193(m interface{}, n int) int {
+	return m.(InlineHandler193).Handle(n)
+}
+
+type InlineHandler194 struct{}
+
+func (InlineHandler194) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler194 handles a InlineHandler194 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler194(m interface{}, n int) int {
+	return m.(InlineHandler194).Handle(n)
+}
+
+type InlineHandler195 struct{}
+
+func (InlineHandler195) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler195 handles a InlineHandler195 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler195(m interface{}, n int) int {
+	return m.(InlineHandler195).Handle(n)
+}
+
+type InlineHandler196 struct{}
+
+func (InlineHandler196) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler196 handles a InlineHandler196 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler196(m interface{}, n int) int {
+	return m.(InlineHandler196).Handle(n)
+}
+
+type InlineHandler197 struct{}
+
+func (InlineHandler197) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler197 handles a InlineHandler197 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler197(m interface{}, n int) int {
+	return m.(InlineHandler197).Handle(n)
+}
+
+type InlineHandler198 struct{}
+
+func (InlineHandler198) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler198 handles a InlineHandler198 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler198(m interface{}, n int) int {
+	return m.(InlineHandler198).Handle(n)
+}
+
+type InlineHandler199 struct{}
+
+func (InlineHandler199) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler199 handles a InlineHandler199 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler199(m interface{}, n int) int {
+	return m.(InlineHandler199).Handle(n)
+}
+
+type InlineHandler200 struct{}
+
+func (InlineHandler200) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler200 handles a InlineHandler200 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler200(m interface{}, n int) int {
+	return m.(InlineHandler200).Handle(n)
+}
+
+type InlineHandler201 struct{}
+
+func (InlineHandler201) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler201 handles a InlineHandler201 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler201(m interface{}, n int) int {
+	return m.(InlineHandler201).Handle(n)
+}
+
+type InlineHandler202 struct{}
+
+func (InlineHandler202) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler202 handles a InlineHandler202 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler202(m interface{}, n int) int {
+	return m.(InlineHandler202).Handle(n)
+}
+
+type InlineHandler203 struct{}
+
+func (InlineHandler203) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler203 handles a InlineHandler203 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler203(m interface{}, n int) int {
+	return m.(InlineHandler203).Handle(n)
+}
+
+type InlineHandler204 struct{}
+
+func (InlineHandler204) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler204 handles a InlineHandler204 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler204(m interface{}, n int) int {
+	return m.(InlineHandler204).Handle(n)
+}
+
+type InlineHandler205 struct{}
+
+func (InlineHandler205) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler205 handles a InlineHandler205 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler205(m interface{}, n int) int {
+	return m.(InlineHandler205).Handle(n)
+}
+
+type InlineHandler206 struct{}
+
+func (InlineHandler206) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler206 handles a InlineHandler206 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler206(m interface{}, n int) int {
+	return m.(InlineHandler206).Handle(n)
+}
+
+type InlineHandler207 struct{}
+
+func (InlineHandler207) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler207 handles a InlineHandler207 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler207(m interface{}, n int) int {
+	return m.(InlineHandler207).Handle(n)
+}
+
+type InlineHandler208 struct{}
+
+func (InlineHandler208) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler208 handles a InlineHandler208 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler208(m interface{}, n int) int {
+	return m.(InlineHandler208).Handle(n)
+}
+
+type InlineHandler209 struct{}
+
+func (InlineHandler209) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler209 handles a InlineHandler209 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler209(m interface{}, n int) int {
+	return m.(InlineHandler209).Handle(n)
+}
+
+type InlineHandler210 struct{}
+
+func (InlineHandler210) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler210 handles a InlineHandler210 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler210(m interface{}, n int) int {
+	return m.(InlineHandler210).Handle(n)
+}
+
+type InlineHandler211 struct{}
+
+func (InlineHandler211) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler211 handles a InlineHandler211 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler211(m interface{}, n int) int {
+	return m.(InlineHandler211).Handle(n)
+}
+
+type InlineHandler212 struct{}
+
+func (InlineHandler212) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler212 handles a InlineHandler212 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler212(m interface{}, n int) int {
+	return m.(InlineHandler212).Handle(n)
+}
+
+type InlineHandler213 struct{}
+
+func (InlineHandler213) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler213 handles a InlineHandler213 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler213(m interface{}, n int) int {
+	return m.(InlineHandler213).Handle(n)
+}
+
+type InlineHandler214 struct{}
+
+func (InlineHandler214) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler214 handles a InlineHandler214 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler214(m interface{}, n int) int {
+	return m.(InlineHandler214).Handle(n)
+}
+
+type InlineHandler215 struct{}
+
+func (InlineHandler215) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler215 handles a InlineHandler215 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler215(m interface{}, n int) int {
+	return m.(InlineHandler215).Handle(n)
+}
+
+type InlineHandler216 struct{}
+
+func (InlineHandler216) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler216 handles a InlineHandler216 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler216(m interface{}, n int) int {
+	return m.(InlineHandler216).Handle(n)
+}
+
+type InlineHandler217 struct{}
+
+func (InlineHandler217) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler217 handles a InlineHandler217 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler217(m interface{}, n int) int {
+	return m.(InlineHandler217).Handle(n)
+}
+
+type InlineHandler218 struct{}
+
+func (InlineHandler218) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler218 handles a InlineHandler218 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler218(m interface{}, n int) int {
+	return m.(InlineHandler218).Handle(n)
+}
+
+type InlineHandler219 struct{}
+
+func (InlineHandler219) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler219 handles a InlineHandler219 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler219(m interface{}, n int) int {
+	return m.(InlineHandler219).Handle(n)
+}
+
+type InlineHandler220 struct{}
+
+func (InlineHandler220) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler220 handles a InlineHandler220 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler220(m interface{}, n int) int {
+	return m.(InlineHandler220).Handle(n)
+}
+
+type InlineHandler221 struct{}
+
+func (InlineHandler221) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler221 handles a InlineHandler221 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler221(m interface{}, n int) int {
+	return m.(InlineHandler221).Handle(n)
+}
+
+type InlineHandler222 struct{}
+
+func (InlineHandler222) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler222 handles a InlineHandler222 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler222(m interface{}, n int) int {
+	return m.(InlineHandler222).Handle(n)
+}
+
+type InlineHandler223 struct{}
+
+func (InlineHandler223) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler223 handles a InlineHandler223 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler223(m interface{}, n int) int {
+	return m.(InlineHandler223).Handle(n)
+}
+
+type InlineHandler224 struct{}
+
+func (InlineHandler224) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler224 handles a InlineHandler224 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler224(m interface{}, n int) int {
+	return m.(InlineHandler224).Handle(n)
+}
+
+type InlineHandler225 struct{}
+
+func (InlineHandler225) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler225 handles a InlineHandler225 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler225(m interface{}, n int) int {
+	return m.(InlineHandler225).Handle(n)
+}
+
+type InlineHandler226 struct{}
+
+func (InlineHandler226) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler226 handles a InlineHandler226 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler226(m interface{}, n int) int {
+	return m.(InlineHandler226).Handle(n)
+}
+
+type InlineHandler227 struct{}
+
+func (InlineHandler227) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler227 handles a InlineHandler227 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler227(m interface{}, n int) int {
+	return m.(InlineHandler227).Handle(n)
+}
+
+type InlineHandler228 struct{}
+
+func (InlineHandler228) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler228 handles a InlineHandler228 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler228(m interface{}, n int) int {
+	return m.(InlineHandler228).Handle(n)
+}
+
+type InlineHandler229 struct{}
+
+func (InlineHandler229) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler229 handles a InlineHandler229 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler229(m interface{}, n int) int {
+	return m.(InlineHandler229).Handle(n)
+}
+
+type InlineHandler230 struct{}
+
+func (InlineHandler230) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler230 handles a InlineHandler230 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler230(m interface{}, n int) int {
+	return m.(InlineHandler230).Handle(n)
+}
+
+type InlineHandler231 struct{}
+
+func (InlineHandler231) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler231 handles a InlineHandler231 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler231(m interface{}, n int) int {
+	return m.(InlineHandler231).Handle(n)
+}
+
+type InlineHandler232 struct{}
+
+func (InlineHandler232) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler232 handles a InlineHandler232 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler232(m interface{}, n int) int {
+	return m.(InlineHandler232).Handle(n)
+}
+
+type InlineHandler233 struct{}
+
+func (InlineHandler233) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler233 handles a InlineHandler233 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler233(m interface{}, n int) int {
+	return m.(InlineHandler233).Handle(n)
+}
+
+type InlineHandler234 struct{}
+
+func (InlineHandler234) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler234 handles a InlineHandler234 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler234(m interface{}, n int) int {
+	return m.(InlineHandler234).Handle(n)
+}
+
+type InlineHandler235 struct{}
+
+func (InlineHandler235) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler235 handles a InlineHandler235 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler235(m interface{}, n int) int {
+	return m.(InlineHandler235).Handle(n)
+}
+
+type InlineHandler236 struct{}
+
+func (InlineHandler236) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler236 handles a InlineHandler236 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler236(m interface{}, n int) int {
+	return m.(InlineHandler236).Handle(n)
+}
+
+type InlineHandler237 struct{}
+
+func (InlineHandler237) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler237 handles a InlineHandler237 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler237(m interface{}, n int) int {
+	return m.(InlineHandler237).Handle(n)
+}
+
+type InlineHandler238 struct{}
+
+func (InlineHandler238) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler238 handles a InlineHandler238 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler238(m interface{}, n int) int {
+	return m.(InlineHandler238).Handle(n)
+}
+
+type InlineHandler239 struct{}
+
+func (InlineHandler239) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler239 handles a InlineHandler239 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler239(m interface{}, n int) int {
+	return m.(InlineHandler239).Handle(n)
+}
+
+type InlineHandler240 struct{}
+
+func (InlineHandler240) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler240 handles a InlineHandler240 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler240(m interface{}, n int) int {
+	return m.(InlineHandler240).Handle(n)
+}
+
+type InlineHandler241 struct{}
+
+func (InlineHandler241) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler241 handles a InlineHandler241 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler241(m interface{}, n int) int {
+	return m.(InlineHandler241).Handle(n)
+}
+
+type InlineHandler242 struct{}
+
+func (InlineHandler242) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler242 handles a InlineHandler242 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler242(m interface{}, n int) int {
+	return m.(InlineHandler242).Handle(n)
+}
+
+type InlineHandler243 struct{}
+
+func (InlineHandler243) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler243 handles a InlineHandler243 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler243(m interface{}, n int) int {
+	return m.(InlineHandler243).Handle(n)
+}
+
+type InlineHandler244 struct{}
+
+func (InlineHandler244) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler244 handles a InlineHandler244 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler244(m interface{}, n int) int {
+	return m.(InlineHandler244).Handle(n)
+}
+
+type InlineHandler245 struct{}
+
+func (InlineHandler245) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler245 handles a InlineHandler245 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler245(m interface{}, n int) int {
+	return m.(InlineHandler245).Handle(n)
+}
+
+type InlineHandler246 struct{}
+
+func (InlineHandler246) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler246 handles a InlineHandler246 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler246(m interface{}, n int) int {
+	return m.(InlineHandler246).Handle(n)
+}
+
+type InlineHandler247 struct{}
+
+func (InlineHandler247) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler247 handles a InlineHandler247 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler247(m interface{}, n int) int {
+	return m.(InlineHandler247).Handle(n)
+}
+
+type InlineHandler248 struct{}
+
+func (InlineHandler248) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler248 handles a InlineHandler248 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler248(m interface{}, n int) int {
+	return m.(InlineHandler248).Handle(n)
+}
+
+type InlineHandler249 struct{}
+
+func (InlineHandler249) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler249 handles a InlineHandler249 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler249(m interface{}, n int) int {
+	return m.(InlineHandler249).Handle(n)
+}
+
+type InlineHandler250 struct{}
+
+func (InlineHandler250) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler250 handles a InlineHandler250 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler250(m interface{}, n int) int {
+	return m.(InlineHandler250).Handle(n)
+}
+
+type InlineHandler251 struct{}
+
+func (InlineHandler251) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler251 handles a InlineHandler251 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler251(m interface{}, n int) int {
+	return m.(InlineHandler251).Handle(n)
+}
+
+type InlineHandler252 struct{}
+
+func (InlineHandler252) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler252 handles a InlineHandler252 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler252(m interface{}, n int) int {
+	return m.(InlineHandler252).Handle(n)
+}
+
+type InlineHandler253 struct{}
+
+func (InlineHandler253) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler253 handles a InlineHandler253 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler253(m interface{}, n int) int {
+	return m.(InlineHandler253).Handle(n)
+}
+
+type InlineHandler254 struct{}
+
+func (InlineHandler254) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler254 handles a InlineHandler254 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler254(m interface{}, n int) int {
+	return m.(InlineHandler254).Handle(n)
+}
+
+type InlineHandler255 struct{}
+
+func (InlineHandler255) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler255 handles a InlineHandler255 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler255(m interface{}, n int) int {
+	return m.(InlineHandler255).Handle(n)
+}
+
+type InlineHandler256 struct{}
+
+func (InlineHandler256) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler256 handles a InlineHandler256 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler256(m interface{}, n int) int {
+	return m.(InlineHandler256).Handle(n)
+}
+
+type InlineHandler257 struct{}
+
+func (InlineHandler257) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler257 handles a InlineHandler257 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler257(m interface{}, n int) int {
+	return m.(InlineHandler257).Handle(n)
+}
+
+type InlineHandler258 struct{}
+
+func (InlineHandler258) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler258 handles a InlineHandler258 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler258(m interface{}, n int) int {
+	return m.(InlineHandler258).Handle(n)
+}
+
+type InlineHandler259 struct{}
+
+func (InlineHandler259) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler259 handles a InlineHandler259 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler259(m interface{}, n int) int {
+	return m.(InlineHandler259).Handle(n)
+}
+
+type InlineHandler260 struct{}
+
+func (InlineHandler260) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler260 handles a InlineHandler260 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler260(m interface{}, n int) int {
+	return m.(InlineHandler260).Handle(n)
+}
+
+type InlineHandler261 struct{}
+
+func (InlineHandler261) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler261 handles a InlineHandler261 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler261(m interface{}, n int) int {
+	return m.(InlineHandler261).Handle(n)
+}
+
+type InlineHandler262 struct{}
+
+func (InlineHandler262) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler262 handles a InlineHandler262 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler262(m interface{}, n int) int {
+	return m.(InlineHandler262).Handle(n)
+}
+
+type InlineHandler263 struct{}
+
+func (InlineHandler263) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler263 handles a InlineHandler263 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler263(m interface{}, n int) int {
+	return m.(InlineHandler263).Handle(n)
+}
+
+type InlineHandler264 struct{}
+
+func (InlineHandler264) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler264 handles a InlineHandler264 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler264(m interface{}, n int) int {
+	return m.(InlineHandler264).Handle(n)
+}
+
+type InlineHandler265 struct{}
+
+func (InlineHandler265) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler265 handles a InlineHandler265 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler265(m interface{}, n int) int {
+	return m.(InlineHandler265).Handle(n)
+}
+
+type InlineHandler266 struct{}
+
+func (InlineHandler266) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler266 handles a InlineHandler266 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler266(m interface{}, n int) int {
+	return m.(InlineHandler266).Handle(n)
+}
+
+type InlineHandler267 struct{}
+
+func (InlineHandler267) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler267 handles a InlineHandler267 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler267(m interface{}, n int) int {
+	return m.(InlineHandler267).Handle(n)
+}
+
+type InlineHandler268 struct{}
+
+func (InlineHandler268) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler268 handles a InlineHandler268 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler268(m interface{}, n int) int {
+	return m.(InlineHandler268).Handle(n)
+}
+
+type InlineHandler269 struct{}
+
+func (InlineHandler269) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler269 handles a InlineHandler269 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler269(m interface{}, n int) int {
+	return m.(InlineHandler269).Handle(n)
+}
+
+type InlineHandler270 struct{}
+
+func (InlineHandler270) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler270 handles a InlineHandler270 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler270(m interface{}, n int) int {
+	return m.(InlineHandler270).Handle(n)
+}
+
+type InlineHandler271 struct{}
+
+func (InlineHandler271) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler271 handles a InlineHandler271 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler271(m interface{}, n int) int {
+	return m.(InlineHandler271).Handle(n)
+}
+
+type InlineHandler272 struct{}
+
+func (InlineHandler272) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler272 handles a InlineHandler272 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler272(m interface{}, n int) int {
+	return m.(InlineHandler272).Handle(n)
+}
+
+type InlineHandler273 struct{}
+
+func (InlineHandler273) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler273 handles a InlineHandler273 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler273(m interface{}, n int) int {
+	return m.(InlineHandler273).Handle(n)
+}
+
+type InlineHandler274 struct{}
+
+func (InlineHandler274) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler274 handles a InlineHandler274 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler274(m interface{}, n int) int {
+	return m.(InlineHandler274).Handle(n)
+}
+
+type InlineHandler275 struct{}
+
+func (InlineHandler275) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler275 handles a InlineHandler275 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler275(m interface{}, n int) int {
+	return m.(InlineHandler275).Handle(n)
+}
+
+type InlineHandler276 struct{}
+
+func (InlineHandler276) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler276 handles a InlineHandler276 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler276(m interface{}, n int) int {
+	return m.(InlineHandler276).Handle(n)
+}
+
+type InlineHandler277 struct{}
+
+func (InlineHandler277) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler277 handles a InlineHandler277 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler277(m interface{}, n int) int {
+	return m.(InlineHandler277).Handle(n)
+}
+
+type InlineHandler278 struct{}
+
+func (InlineHandler278) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler278 handles a InlineHandler278 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler278(m interface{}, n int) int {
+	return m.(InlineHandler278).Handle(n)
+}
+
+type InlineHandler279 struct{}
+
+func (InlineHandler279) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler279 handles a InlineHandler279 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler279(m interface{}, n int) int {
+	return m.(InlineHandler279).Handle(n)
+}
+
+type InlineHandler280 struct{}
+
+func (InlineHandler280) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler280 handles a InlineHandler280 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler280(m interface{}, n int) int {
+	return m.(InlineHandler280).Handle(n)
+}
+
+type InlineHandler281 struct{}
+
+func (InlineHandler281) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler281 handles a InlineHandler281 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler281(m interface{}, n int) int {
+	return m.(InlineHandler281).Handle(n)
+}
+
+type InlineHandler282 struct{}
+
+func (InlineHandler282) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler282 handles a InlineHandler282 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler282(m interface{}, n int) int {
+	return m.(InlineHandler282).Handle(n)
+}
+
+type InlineHandler283 struct{}
+
+func (InlineHandler283) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler283 handles a InlineHandler283 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler283(m interface{}, n int) int {
+	return m.(InlineHandler283).Handle(n)
+}
+
+type InlineHandler284 struct{}
+
+func (InlineHandler284) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler284 handles a InlineHandler284 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler284(m interface{}, n int) int {
+	return m.(InlineHandler284).Handle(n)
+}
+
+type InlineHandler285 struct{}
+
+func (InlineHandler285) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler285 handles a InlineHandler285 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler285(m interface{}, n int) int {
+	return m.(InlineHandler285).Handle(n)
+}
+
+type InlineHandler286 struct{}
+
+func (InlineHandler286) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler286 handles a InlineHandler286 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler286(m interface{}, n int) int {
+	return m.(InlineHandler286).Handle(n)
+}
+
+type InlineHandler287 struct{}
+
+func (InlineHandler287) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler287 handles a InlineHandler287 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler287(m interface{}, n int) int {
+	return m.(InlineHandler287).Handle(n)
+}
+
+type InlineHandler288 struct{}
+
+func (InlineHandler288) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler288 handles a InlineHandler288 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler288(m interface{}, n int) int {
+	return m.(InlineHandler288).Handle(n)
+}
+
+type InlineHandler289 struct{}
+
+func (InlineHandler289) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler289 handles a InlineHandler289 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler289(m interface{}, n int) int {
+	return m.(InlineHandler289).Handle(n)
+}
+
+type InlineHandler290 struct{}
+
+func (InlineHandler290) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler290 handles a InlineHandler290 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler290(m interface{}, n int) int {
+	return m.(InlineHandler290).Handle(n)
+}
+
+type InlineHandler291 struct{}
+
+func (InlineHandler291) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler291 handles a InlineHandler291 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler291(m interface{}, n int) int {
+	return m.(InlineHandler291).Handle(n)
+}
+
+type InlineHandler292 struct{}
+
+func (InlineHandler292) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler292 handles a InlineHandler292 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler292(m interface{}, n int) int {
+	return m.(InlineHandler292).Handle(n)
+}
+
+type InlineHandler293 struct{}
+
+func (InlineHandler293) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler293 handles a InlineHandler293 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler293(m interface{}, n int) int {
+	return m.(InlineHandler293).Handle(n)
+}
+
+type InlineHandler294 struct{}
+
+func (InlineHandler294) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler294 handles a InlineHandler294 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler294(m interface{}, n int) int {
+	return m.(InlineHandler294).Handle(n)
+}
+
+type InlineHandler295 struct{}
+
+func (InlineHandler295) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler295 handles a InlineHandler295 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler295(m interface{}, n int) int {
+	return m.(InlineHandler295).Handle(n)
+}
+
+type InlineHandler296 struct{}
+
+func (InlineHandler296) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler296 handles a InlineHandler296 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler296(m interface{}, n int) int {
+	return m.(InlineHandler296).Handle(n)
+}
+
+type InlineHandler297 struct{}
+
+func (InlineHandler297) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler297 handles a InlineHandler297 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler297(m interface{}, n int) int {
+	return m.(InlineHandler297).Handle(n)
+}
+
+type InlineHandler298 struct{}
+
+func (InlineHandler298) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler298 handles a InlineHandler298 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler298(m interface{}, n int) int {
+	return m.(InlineHandler298).Handle(n)
+}
+
+type InlineHandler299 struct{}
+
+func (InlineHandler299) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler299 handles a InlineHandler299 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler299(m interface{}, n int) int {
+	return m.(InlineHandler299).Handle(n)
+}
+
+type InlineHandler300 struct{}
+
+func (InlineHandler300) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler300 handles a InlineHandler300 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler300(m interface{}, n int) int {
+	return m.(InlineHandler300).Handle(n)
+}
+
+type InlineHandler301 struct{}
+
+func (InlineHandler301) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler301 handles a InlineHandler301 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler301(m interface{}, n int) int {
+	return m.(InlineHandler301).Handle(n)
+}
+
+type InlineHandler302 struct{}
+
+func (InlineHandler302) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler302 handles a InlineHandler302 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler302(m interface{}, n int) int {
+	return m.(InlineHandler302).Handle(n)
+}
+
+type InlineHandler303 struct{}
+
+func (InlineHandler303) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler303 handles a InlineHandler303 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler303(m interface{}, n int) int {
+	return m.(InlineHandler303).Handle(n)
+}
+
+type InlineHandler304 struct{}
+
+func (InlineHandler304) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler304 handles a InlineHandler304 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler304(m interface{}, n int) int {
+	return m.(InlineHandler304).Handle(n)
+}
+
+type InlineHandler305 struct{}
+
+func (InlineHandler305) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler305 handles a InlineHandler305 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler305(m interface{}, n int) int {
+	return m.(InlineHandler305).Handle(n)
+}
+
+type InlineHandler306 struct{}
+
+func (InlineHandler306) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler306 handles a InlineHandler306 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler306(m interface{}, n int) int {
+	return m.(InlineHandler306).Handle(n)
+}
+
+type InlineHandler307 struct{}
+
+func (InlineHandler307) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler307 handles a InlineHandler307 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler307(m interface{}, n int) int {
+	return m.(InlineHandler307).Handle(n)
+}
+
+type InlineHandler308 struct{}
+
+func (InlineHandler308) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler308 handles a InlineHandler308 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler308(m interface{}, n int) int {
+	return m.(InlineHandler308).Handle(n)
+}
+
+type InlineHandler309 struct{}
+
+func (InlineHandler309) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler309 handles a InlineHandler309 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler309(m interface{}, n int) int {
+	return m.(InlineHandler309).Handle(n)
+}
+
+type InlineHandler310 struct{}
+
+func (InlineHandler310) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler310 handles a InlineHandler310 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler310(m interface{}, n int) int {
+	return m.(InlineHandler310).Handle(n)
+}
+
+type InlineHandler311 struct{}
+
+func (InlineHandler311) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler311 handles a InlineHandler311 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler311(m interface{}, n int) int {
+	return m.(InlineHandler311).Handle(n)
+}
+
+type InlineHandler312 struct{}
+
+func (InlineHandler312) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler312 handles a InlineHandler312 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler312(m interface{}, n int) int {
+	return m.(InlineHandler312).Handle(n)
+}
+
+type InlineHandler313 struct{}
+
+func (InlineHandler313) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler313 handles a InlineHandler313 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler313(m interface{}, n int) int {
+	return m.(InlineHandler313).Handle(n)
+}
+
+type InlineHandler314 struct{}
+
+func (InlineHandler314) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler314 handles a InlineHandler314 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler314(m interface{}, n int) int {
+	return m.(InlineHandler314).Handle(n)
+}
+
+type InlineHandler315 struct{}
+
+func (InlineHandler315) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler315 handles a InlineHandler315 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler315(m interface{}, n int) int {
+	return m.(InlineHandler315).Handle(n)
+}
+
+type InlineHandler316 struct{}
+
+func (InlineHandler316) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler316 handles a InlineHandler316 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler316(m interface{}, n int) int {
+	return m.(InlineHandler316).Handle(n)
+}
+
+type InlineHandler317 struct{}
+
+func (InlineHandler317) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler317 handles a InlineHandler317 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler317(m interface{}, n int) int {
+	return m.(InlineHandler317).Handle(n)
+}
+
+type InlineHandler318 struct{}
+
+func (InlineHandler318) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler318 handles a InlineHandler318 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler318(m interface{}, n int) int {
+	return m.(InlineHandler318).Handle(n)
+}
+
+type InlineHandler319 struct{}
+
+func (InlineHandler319) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler319 handles a InlineHandler319 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler319(m interface{}, n int) int {
+	return m.(InlineHandler319).Handle(n)
+}
+
+type InlineHandler320 struct{}
+
+func (InlineHandler320) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler320 handles a InlineHandler320 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler320(m interface{}, n int) int {
+	return m.(InlineHandler320).Handle(n)
+}
+
+type InlineHandler321 struct{}
+
+func (InlineHandler321) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler321 handles a InlineHandler321 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler321(m interface{}, n int) int {
+	return m.(InlineHandler321).Handle(n)
+}
+
+type InlineHandler322 struct{}
+
+func (InlineHandler322) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler322 handles a InlineHandler322 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler322(m interface{}, n int) int {
+	return m.(InlineHandler322).Handle(n)
+}
+
+type InlineHandler323 struct{}
+
+func (InlineHandler323) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler323 handles a InlineHandler323 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler323(m interface{}, n int) int {
+	return m.(InlineHandler323).Handle(n)
+}
+
+type InlineHandler324 struct{}
+
+func (InlineHandler324) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler324 handles a InlineHandler324 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler324(m interface{}, n int) int {
+	return m.(InlineHandler324).Handle(n)
+}
+
+type InlineHandler325 struct{}
+
+func (InlineHandler325) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler325 handles a InlineHandler325 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler325(m interface{}, n int) int {
+	return m.(InlineHandler325).Handle(n)
+}
+
+type InlineHandler326 struct{}
+
+func (InlineHandler326) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler326 handles a InlineHandler326 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler326(m interface{}, n int) int {
+	return m.(InlineHandler326).Handle(n)
+}
+
+type InlineHandler327 struct{}
+
+func (InlineHandler327) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler327 handles a InlineHandler327 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler327(m interface{}, n int) int {
+	return m.(InlineHandler327).Handle(n)
+}
+
+type InlineHandler328 struct{}
+
+func (InlineHandler328) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler328 handles a InlineHandler328 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler328(m interface{}, n int) int {
+	return m.(InlineHandler328).Handle(n)
+}
+
+type InlineHandler329 struct{}
+
+func (InlineHandler329) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler329 handles a InlineHandler329 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler329(m interface{}, n int) int {
+	return m.(InlineHandler329).Handle(n)
+}
+
+type InlineHandler330 struct{}
+
+func (InlineHandler330) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler330 handles a InlineHandler330 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler330(m interface{}, n int) int {
+	return m.(InlineHandler330).Handle(n)
+}
+
+type InlineHandler331 struct{}
+
+func (InlineHandler331) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler331 handles a InlineHandler331 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler331(m interface{}, n int) int {
+	return m.(InlineHandler331).Handle(n)
+}
+
+type InlineHandler332 struct{}
+
+func (InlineHandler332) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler332 handles a InlineHandler332 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler332(m interface{}, n int) int {
+	return m.(InlineHandler332).Handle(n)
+}
+
+type InlineHandler333 struct{}
+
+func (InlineHandler333) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler333 handles a InlineHandler333 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler333(m interface{}, n int) int {
+	return m.(InlineHandler333).Handle(n)
+}
+
+type InlineHandler334 struct{}
+
+func (InlineHandler334) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler334 handles a InlineHandler334 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler334(m interface{}, n int) int {
+	return m.(InlineHandler334).Handle(n)
+}
+
+type InlineHandler335 struct{}
+
+func (InlineHandler335) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler335 handles a InlineHandler335 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler335(m interface{}, n int) int {
+	return m.(InlineHandler335).Handle(n)
+}
+
+type InlineHandler336 struct{}
+
+func (InlineHandler336) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler336 handles a InlineHandler336 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler336(m interface{}, n int) int {
+	return m.(InlineHandler336).Handle(n)
+}
+
+type InlineHandler337 struct{}
+
+func (InlineHandler337) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler337 handles a InlineHandler337 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler337(m interface{}, n int) int {
+	return m.(InlineHandler337).Handle(n)
+}
+
+type InlineHandler338 struct{}
+
+func (InlineHandler338) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler338 handles a InlineHandler338 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler338(m interface{}, n int) int {
+	return m.(InlineHandler338).Handle(n)
+}
+
+type InlineHandler339 struct{}
+
+func (InlineHandler339) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler339 handles a InlineHandler339 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler339(m interface{}, n int) int {
+	return m.(InlineHandler339).Handle(n)
+}
+
+type InlineHandler340 struct{}
+
+func (InlineHandler340) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler340 handles a InlineHandler340 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler340(m interface{}, n int) int {
+	return m.(InlineHandler340).Handle(n)
+}
+
+type InlineHandler341 struct{}
+
+func (InlineHandler341) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler341 handles a InlineHandler341 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler341(m interface{}, n int) int {
+	return m.(InlineHandler341).Handle(n)
+}
+
+type InlineHandler342 struct{}
+
+func (InlineHandler342) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler342 handles a InlineHandler342 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler342(m interface{}, n int) int {
+	return m.(InlineHandler342).Handle(n)
+}
+
+type InlineHandler343 struct{}
+
+func (InlineHandler343) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler343 handles a InlineHandler343 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler343(m interface{}, n int) int {
+	return m.(InlineHandler343).Handle(n)
+}
+
+type InlineHandler344 struct{}
+
+func (InlineHandler344) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler344 handles a InlineHandler344 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler344(m interface{}, n int) int {
+	return m.(InlineHandler344).Handle(n)
+}
+
+type InlineHandler345 struct{}
+
+func (InlineHandler345) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler345 handles a InlineHandler345 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler345(m interface{}, n int) int {
+	return m.(InlineHandler345).Handle(n)
+}
+
+type InlineHandler346 struct{}
+
+func (InlineHandler346) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler346 handles a InlineHandler346 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler346(m interface{}, n int) int {
+	return m.(InlineHandler346).Handle(n)
+}
+
+type InlineHandler347 struct{}
+
+func (InlineHandler347) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler347 handles a InlineHandler347 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler347(m interface{}, n int) int {
+	return m.(InlineHandler347).Handle(n)
+}
+
+type InlineHandler348 struct{}
+
+func (InlineHandler348) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler348 handles a InlineHandler348 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler348(m interface{}, n int) int {
+	return m.(InlineHandler348).Handle(n)
+}
+
+type InlineHandler349 struct{}
+
+func (InlineHandler349) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler349 handles a InlineHandler349 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler349(m interface{}, n int) int {
+	return m.(InlineHandler349).Handle(n)
+}
+
+type InlineHandler350 struct{}
+
+func (InlineHandler350) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler350 handles a InlineHandler350 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler350(m interface{}, n int) int {
+	return m.(InlineHandler350).Handle(n)
+}
+
+type InlineHandler351 struct{}
+
+func (InlineHandler351) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler351 handles a InlineHandler351 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler351(m interface{}, n int) int {
+	return m.(InlineHandler351).Handle(n)
+}
+
+type InlineHandler352 struct{}
+
+func (InlineHandler352) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler352 handles a InlineHandler352 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler352(m interface{}, n int) int {
+	return m.(InlineHandler352).Handle(n)
+}
+
+type InlineHandler353 struct{}
+
+func (InlineHandler353) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler353 handles a InlineHandler353 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler353(m interface{}, n int) int {
+	return m.(InlineHandler353).Handle(n)
+}
+
+type InlineHandler354 struct{}
+
+func (InlineHandler354) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler354 handles a InlineHandler354 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler354(m interface{}, n int) int {
+	return m.(InlineHandler354).Handle(n)
+}
+
+type InlineHandler355 struct{}
+
+func (InlineHandler355) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler355 handles a InlineHandler355 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler355(m interface{}, n int) int {
+	return m.(InlineHandler355).Handle(n)
+}
+
+type InlineHandler356 struct{}
+
+func (InlineHandler356) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler356 handles a InlineHandler356 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler356(m interface{}, n int) int {
+	return m.(InlineHandler356).Handle(n)
+}
+
+type InlineHandler357 struct{}
+
+func (InlineHandler357) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler357 handles a InlineHandler357 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler357(m interface{}, n int) int {
+	return m.(InlineHandler357).Handle(n)
+}
+
+type InlineHandler358 struct{}
+
+func (InlineHandler358) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler358 handles a InlineHandler358 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler358(m interface{}, n int) int {
+	return m.(InlineHandler358).Handle(n)
+}
+
+type InlineHandler359 struct{}
+
+func (InlineHandler359) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler359 handles a InlineHandler359 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler359(m interface{}, n int) int {
+	return m.(InlineHandler359).Handle(n)
+}
+
+type InlineHandler360 struct{}
+
+func (InlineHandler360) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler360 handles a InlineHandler360 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler360(m interface{}, n int) int {
+	return m.(InlineHandler360).Handle(n)
+}
+
+type InlineHandler361 struct{}
+
+func (InlineHandler361) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler361 handles a InlineHandler361 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler361(m interface{}, n int) int {
+	return m.(InlineHandler361).Handle(n)
+}
+
+type InlineHandler362 struct{}
+
+func (InlineHandler362) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler362 handles a InlineHandler362 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler362(m interface{}, n int) int {
+	return m.(InlineHandler362).Handle(n)
+}
+
+type InlineHandler363 struct{}
+
+func (InlineHandler363) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler363 handles a InlineHandler363 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler363(m interface{}, n int) int {
+	return m.(InlineHandler363).Handle(n)
+}
+
+type InlineHandler364 struct{}
+
+func (InlineHandler364) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler364 handles a InlineHandler364 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler364(m interface{}, n int) int {
+	return m.(InlineHandler364).Handle(n)
+}
+
+type InlineHandler365 struct{}
+
+func (InlineHandler365) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler365 handles a InlineHandler365 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler365(m interface{}, n int) int {
+	return m.(InlineHandler365).Handle(n)
+}
+
+type InlineHandler366 struct{}
+
+func (InlineHandler366) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler366 handles a InlineHandler366 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler366(m interface{}, n int) int {
+	return m.(InlineHandler366).Handle(n)
+}
+
+type InlineHandler367 struct{}
+
+func (InlineHandler367) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler367 handles a InlineHandler367 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler367(m interface{}, n int) int {
+	return m.(InlineHandler367).Handle(n)
+}
+
+type InlineHandler368 struct{}
+
+func (InlineHandler368) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler368 handles a InlineHandler368 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler368(m interface{}, n int) int {
+	return m.(InlineHandler368).Handle(n)
+}
+
+type InlineHandler369 struct{}
+
+func (InlineHandler369) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler369 handles a InlineHandler369 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler369(m interface{}, n int) int {
+	return m.(InlineHandler369).Handle(n)
+}
+
+type InlineHandler370 struct{}
+
+func (InlineHandler370) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler370 handles a InlineHandler370 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler370(m interface{}, n int) int {
+	return m.(InlineHandler370).Handle(n)
+}
+
+type InlineHandler371 struct{}
+
+func (InlineHandler371) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler371 handles a InlineHandler371 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler371(m interface{}, n int) int {
+	return m.(InlineHandler371).Handle(n)
+}
+
+type InlineHandler372 struct{}
+
+func (InlineHandler372) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler372 handles a InlineHandler372 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler372(m interface{}, n int) int {
+	return m.(InlineHandler372).Handle(n)
+}
+
+type InlineHandler373 struct{}
+
+func (InlineHandler373) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler373 handles a InlineHandler373 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler373(m interface{}, n int) int {
+	return m.(InlineHandler373).Handle(n)
+}
+
+type InlineHandler374 struct{}
+
+func (InlineHandler374) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler374 handles a InlineHandler374 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler374(m interface{}, n int) int {
+	return m.(InlineHandler374).Handle(n)
+}
+
+type InlineHandler375 struct{}
+
+func (InlineHandler375) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler375 handles a InlineHandler375 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler375(m interface{}, n int) int {
+	return m.(InlineHandler375).Handle(n)
+}
+
+type InlineHandler376 struct{}
+
+func (InlineHandler376) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler376 handles a InlineHandler376 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler376(m interface{}, n int) int {
+	return m.(InlineHandler376).Handle(n)
+}
+
+type InlineHandler377 struct{}
+
+func (InlineHandler377) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler377 handles a InlineHandler377 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler377(m interface{}, n int) int {
+	return m.(InlineHandler377).Handle(n)
+}
+
+type InlineHandler378 struct{}
+
+func (InlineHandler378) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler378 handles a InlineHandler378 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler378(m interface{}, n int) int {
+	return m.(InlineHandler378).Handle(n)
+}
+
+type InlineHandler379 struct{}
+
+func (InlineHandler379) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler379 handles a InlineHandler379 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler379(m interface{}, n int) int {
+	return m.(InlineHandler379).Handle(n)
+}
+
+type InlineHandler380 struct{}
+
+func (InlineHandler380) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler380 handles a InlineHandler380 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler380(m interface{}, n int) int {
+	return m.(InlineHandler380).Handle(n)
+}
+
+type InlineHandler381 struct{}
+
+func (InlineHandler381) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler381 handles a InlineHandler381 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler381(m interface{}, n int) int {
+	return m.(InlineHandler381).Handle(n)
+}
+
+type InlineHandler382 struct{}
+
+func (InlineHandler382) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler382 handles a InlineHandler382 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler382(m interface{}, n int) int {
+	return m.(InlineHandler382).Handle(n)
+}
+
+type InlineHandler383 struct{}
+
+func (InlineHandler383) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler383 handles a InlineHandler383 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler383(m interface{}, n int) int {
+	return m.(InlineHandler383).Handle(n)
+}
+
+type InlineHandler384 struct{}
+
+func (InlineHandler384) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler384 handles a InlineHandler384 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler384(m interface{}, n int) int {
+	return m.(InlineHandler384).Handle(n)
+}
+
+type InlineHandler385 struct{}
+
+func (InlineHandler385) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler385 handles a InlineHandler385 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler385(m interface{}, n int) int {
+	return m.(InlineHandler385).Handle(n)
+}
+
+type InlineHandler386 struct{}
+
+func (InlineHandler386) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler386 handles a InlineHandler386 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler386(m interface{}, n int) int {
+	return m.(InlineHandler386).Handle(n)
+}
+
+type InlineHandler387 struct{}
+
+func (InlineHandler387) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler387 handles a InlineHandler387 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler387(m interface{}, n int) int {
+	return m.(InlineHandler387).Handle(n)
+}
+
+type InlineHandler388 struct{}
+
+func (InlineHandler388) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler388 handles a InlineHandler388 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler388(m interface{}, n int) int {
+	return m.(InlineHandler388).Handle(n)
+}
+
+type InlineHandler389 struct{}
+
+func (InlineHandler389) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler389 handles a InlineHandler389 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler389(m interface{}, n int) int {
+	return m.(InlineHandler389).Handle(n)
+}
+
+type InlineHandler390 struct{}
+
+func (InlineHandler390) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler390 handles a InlineHandler390 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler390(m interface{}, n int) int {
+	return m.(InlineHandler390).Handle(n)
+}
+
+type InlineHandler391 struct{}
+
+func (InlineHandler391) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler391 handles a InlineHandler391 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler391(m interface{}, n int) int {
+	return m.(InlineHandler391).Handle(n)
+}
+
+type InlineHandler392 struct{}
+
+func (InlineHandler392) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler392 handles a InlineHandler392 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler392(m interface{}, n int) int {
+	return m.(InlineHandler392).Handle(n)
+}
+
+type InlineHandler393 struct{}
+
+func (InlineHandler393) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler393 handles a InlineHandler393 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler393(m interface{}, n int) int {
+	return m.(InlineHandler393).Handle(n)
+}
+
+type InlineHandler394 struct{}
+
+func (InlineHandler394) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler394 handles a InlineHandler394 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler394(m interface{}, n int) int {
+	return m.(InlineHandler394).Handle(n)
+}
+
+type InlineHandler395 struct{}
+
+func (InlineHandler395) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler395 handles a InlineHandler395 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler395(m interface{}, n int) int {
+	return m.(InlineHandler395).Handle(n)
+}
+
+type InlineHandler396 struct{}
+
+func (InlineHandler396) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler396 handles a InlineHandler396 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler396(m interface{}, n int) int {
+	return m.(InlineHandler396).Handle(n)
+}
+
+type InlineHandler397 struct{}
+
+func (InlineHandler397) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler397 handles a InlineHandler397 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler397(m interface{}, n int) int {
+	return m.(InlineHandler397).Handle(n)
+}
+
+type InlineHandler398 struct{}
+
+func (InlineHandler398) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler398 handles a InlineHandler398 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler398(m interface{}, n int) int {
+	return m.(InlineHandler398).Handle(n)
+}
+
+type InlineHandler399 struct{}
+
+func (InlineHandler399) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler399 handles a InlineHandler399 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler399(m interface{}, n int) int {
+	return m.(InlineHandler399).Handle(n)
+}
+
+type InlineHandler400 struct{}
+
+func (InlineHandler400) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler400 handles a InlineHandler400 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler400(m interface{}, n int) int {
+	return m.(InlineHandler400).Handle(n)
+}
+
+type InlineHandler401 struct{}
+
+func (InlineHandler401) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler401 handles a InlineHandler401 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler401(m interface{}, n int) int {
+	return m.(InlineHandler401).Handle(n)
+}
+
+type InlineHandler402 struct{}
+
+func (InlineHandler402) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler402 handles a InlineHandler402 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler402(m interface{}, n int) int {
+	return m.(InlineHandler402).Handle(n)
+}
+
+type InlineHandler403 struct{}
+
+func (InlineHandler403) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler403 handles a InlineHandler403 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler403(m interface{}, n int) int {
+	return m.(InlineHandler403).Handle(n)
+}
+
+type InlineHandler404 struct{}
+
+func (InlineHandler404) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler404 handles a InlineHandler404 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler404(m interface{}, n int) int {
+	return m.(InlineHandler404).Handle(n)
+}
+
+type InlineHandler405 struct{}
+
+func (InlineHandler405) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler405 handles a InlineHandler405 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler405(m interface{}, n int) int {
+	return m.(InlineHandler405).Handle(n)
+}
+
+type InlineHandler406 struct{}
+
+func (InlineHandler406) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler406 handles a InlineHandler406 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler406(m interface{}, n int) int {
+	return m.(InlineHandler406).Handle(n)
+}
+
+type InlineHandler407 struct{}
+
+func (InlineHandler407) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler407 handles a InlineHandler407 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler407(m interface{}, n int) int {
+	return m.(InlineHandler407).Handle(n)
+}
+
+type InlineHandler408 struct{}
+
+func (InlineHandler408) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler408 handles a InlineHandler408 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler408(m interface{}, n int) int {
+	return m.(InlineHandler408).Handle(n)
+}
+
+type InlineHandler409 struct{}
+
+func (InlineHandler409) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler409 handles a InlineHandler409 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler409(m interface{}, n int) int {
+	return m.(InlineHandler409).Handle(n)
+}
+
+type InlineHandler410 struct{}
+
+func (InlineHandler410) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler410 handles a InlineHandler410 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler410(m interface{}, n int) int {
+	return m.(InlineHandler410).Handle(n)
+}
+
+type InlineHandler411 struct{}
+
+func (InlineHandler411) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler411 handles a InlineHandler411 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler411(m interface{}, n int) int {
+	return m.(InlineHandler411).Handle(n)
+}
+
+type InlineHandler412 struct{}
+
+func (InlineHandler412) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler412 handles a InlineHandler412 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler412(m interface{}, n int) int {
+	return m.(InlineHandler412).Handle(n)
+}
+
+type InlineHandler413 struct{}
+
+func (InlineHandler413) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler413 handles a InlineHandler413 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler413(m interface{}, n int) int {
+	return m.(InlineHandler413).Handle(n)
+}
+
+type InlineHandler414 struct{}
+
+func (InlineHandler414) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler414 handles a InlineHandler414 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler414(m interface{}, n int) int {
+	return m.(InlineHandler414).Handle(n)
+}
+
+type InlineHandler415 struct{}
+
+func (InlineHandler415) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler415 handles a InlineHandler415 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler415(m interface{}, n int) int {
+	return m.(InlineHandler415).Handle(n)
+}
+
+type InlineHandler416 struct{}
+
+func (InlineHandler416) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler416 handles a InlineHandler416 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler416(m interface{}, n int) int {
+	return m.(InlineHandler416).Handle(n)
+}
+
+type InlineHandler417 struct{}
+
+func (InlineHandler417) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler417 handles a InlineHandler417 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler417(m interface{}, n int) int {
+	return m.(InlineHandler417).Handle(n)
+}
+
+type InlineHandler418 struct{}
+
+func (InlineHandler418) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler418 handles a InlineHandler418 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler418(m interface{}, n int) int {
+	return m.(InlineHandler418).Handle(n)
+}
+
+type InlineHandler419 struct{}
+
+func (InlineHandler419) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler419 handles a InlineHandler419 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler419(m interface{}, n int) int {
+	return m.(InlineHandler419).Handle(n)
+}
+
+type InlineHandler420 struct{}
+
+func (InlineHandler420) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler420 handles a InlineHandler420 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler420(m interface{}, n int) int {
+	return m.(InlineHandler420).Handle(n)
+}
+
+type InlineHandler421 struct{}
+
+func (InlineHandler421) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler421 handles a InlineHandler421 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler421(m interface{}, n int) int {
+	return m.(InlineHandler421).Handle(n)
+}
+
+type InlineHandler422 struct{}
+
+func (InlineHandler422) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler422 handles a InlineHandler422 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler422(m interface{}, n int) int {
+	return m.(InlineHandler422).Handle(n)
+}
+
+type InlineHandler423 struct{}
+
+func (InlineHandler423) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler423 handles a InlineHandler423 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler423(m interface{}, n int) int {
+	return m.(InlineHandler423).Handle(n)
+}
+
+type InlineHandler424 struct{}
+
+func (InlineHandler424) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler424 handles a InlineHandler424 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler424(m interface{}, n int) int {
+	return m.(InlineHandler424).Handle(n)
+}
+
+type InlineHandler425 struct{}
+
+func (InlineHandler425) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler425 handles a InlineHandler425 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler425(m interface{}, n int) int {
+	return m.(InlineHandler425).Handle(n)
+}
+
+type InlineHandler426 struct{}
+
+func (InlineHandler426) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler426 handles a InlineHandler426 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler426(m interface{}, n int) int {
+	return m.(InlineHandler426).Handle(n)
+}
+
+type InlineHandler427 struct{}
+
+func (InlineHandler427) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler427 handles a InlineHandler427 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler427(m interface{}, n int) int {
+	return m.(InlineHandler427).Handle(n)
+}
+
+type InlineHandler428 struct{}
+
+func (InlineHandler428) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler428 handles a InlineHandler428 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler428(m interface{}, n int) int {
+	return m.(InlineHandler428).Handle(n)
+}
+
+type InlineHandler429 struct{}
+
+func (InlineHandler429) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler429 handles a InlineHandler429 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler429(m interface{}, n int) int {
+	return m.(InlineHandler429).Handle(n)
+}
+
+type InlineHandler430 struct{}
+
+func (InlineHandler430) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler430 handles a InlineHandler430 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler430(m interface{}, n int) int {
+	return m.(InlineHandler430).Handle(n)
+}
+
+type InlineHandler431 struct{}
+
+func (InlineHandler431) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler431 handles a InlineHandler431 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler431(m interface{}, n int) int {
+	return m.(InlineHandler431).Handle(n)
+}
+
+type InlineHandler432 struct{}
+
+func (InlineHandler432) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler432 handles a InlineHandler432 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler432(m interface{}, n int) int {
+	return m.(InlineHandler432).Handle(n)
+}
+
+type InlineHandler433 struct{}
+
+func (InlineHandler433) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler433 handles a InlineHandler433 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler433(m interface{}, n int) int {
+	return m.(InlineHandler433).Handle(n)
+}
+
+type InlineHandler434 struct{}
+
+func (InlineHandler434) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler434 handles a InlineHandler434 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler434(m interface{}, n int) int {
+	return m.(InlineHandler434).Handle(n)
+}
+
+type InlineHandler435 struct{}
+
+func (InlineHandler435) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler435 handles a InlineHandler435 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler435(m interface{}, n int) int {
+	return m.(InlineHandler435).Handle(n)
+}
+
+type InlineHandler436 struct{}
+
+func (InlineHandler436) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler436 handles a InlineHandler436 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler436(m interface{}, n int) int {
+	return m.(InlineHandler436).Handle(n)
+}
+
+type InlineHandler437 struct{}
+
+func (InlineHandler437) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler437 handles a InlineHandler437 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler437(m interface{}, n int) int {
+	return m.(InlineHandler437).Handle(n)
+}
+
+type InlineHandler438 struct{}
+
+func (InlineHandler438) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler438 handles a InlineHandler438 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler438(m interface{}, n int) int {
+	return m.(InlineHandler438).Handle(n)
+}
+
+type InlineHandler439 struct{}
+
+func (InlineHandler439) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler439 handles a InlineHandler439 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler439(m interface{}, n int) int {
+	return m.(InlineHandler439).Handle(n)
+}
+
+type InlineHandler440 struct{}
+
+func (InlineHandler440) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler440 handles a InlineHandler440 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler440(m interface{}, n int) int {
+	return m.(InlineHandler440).Handle(n)
+}
+
+type InlineHandler441 struct{}
+
+func (InlineHandler441) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler441 handles a InlineHandler441 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler441(m interface{}, n int) int {
+	return m.(InlineHandler441).Handle(n)
+}
+
+type InlineHandler442 struct{}
+
+func (InlineHandler442) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler442 handles a InlineHandler442 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler442(m interface{}, n int) int {
+	return m.(InlineHandler442).Handle(n)
+}
+
+type InlineHandler443 struct{}
+
+func (InlineHandler443) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler443 handles a InlineHandler443 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler443(m interface{}, n int) int {
+	return m.(InlineHandler443).Handle(n)
+}
+
+type InlineHandler444 struct{}
+
+func (InlineHandler444) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler444 handles a InlineHandler444 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler444(m interface{}, n int) int {
+	return m.(InlineHandler444).Handle(n)
+}
+
+type InlineHandler445 struct{}
+
+func (InlineHandler445) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler445 handles a InlineHandler445 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler445(m interface{}, n int) int {
+	return m.(InlineHandler445).Handle(n)
+}
+
+type InlineHandler446 struct{}
+
+func (InlineHandler446) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler446 handles a InlineHandler446 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler446(m interface{}, n int) int {
+	return m.(InlineHandler446).Handle(n)
+}
+
+type InlineHandler447 struct{}
+
+func (InlineHandler447) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler447 handles a InlineHandler447 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler447(m interface{}, n int) int {
+	return m.(InlineHandler447).Handle(n)
+}
+
+type InlineHandler448 struct{}
+
+func (InlineHandler448) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler448 handles a InlineHandler448 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler448(m interface{}, n int) int {
+	return m.(InlineHandler448).Handle(n)
+}
+
+type InlineHandler449 struct{}
+
+func (InlineHandler449) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler449 handles a InlineHandler449 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler449(m interface{}, n int) int {
+	return m.(InlineHandler449).Handle(n)
+}
+
+type InlineHandler450 struct{}
+
+func (InlineHandler450) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler450 handles a InlineHandler450 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler450(m interface{}, n int) int {
+	return m.(InlineHandler450).Handle(n)
+}
+
+type InlineHandler451 struct{}
+
+func (InlineHandler451) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler451 handles a InlineHandler451 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler451(m interface{}, n int) int {
+	return m.(InlineHandler451).Handle(n)
+}
+
+type InlineHandler452 struct{}
+
+func (InlineHandler452) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler452 handles a InlineHandler452 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler452(m interface{}, n int) int {
+	return m.(InlineHandler452).Handle(n)
+}
+
+type InlineHandler453 struct{}
+
+func (InlineHandler453) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler453 handles a InlineHandler453 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler453(m interface{}, n int) int {
+	return m.(InlineHandler453).Handle(n)
+}
+
+type InlineHandler454 struct{}
+
+func (InlineHandler454) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler454 handles a InlineHandler454 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler454(m interface{}, n int) int {
+	return m.(InlineHandler454).Handle(n)
+}
+
+type InlineHandler455 struct{}
+
+func (InlineHandler455) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler455 handles a InlineHandler455 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler455(m interface{}, n int) int {
+	return m.(InlineHandler455).Handle(n)
+}
+
+type InlineHandler456 struct{}
+
+func (InlineHandler456) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler456 handles a InlineHandler456 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler456(m interface{}, n int) int {
+	return m.(InlineHandler456).Handle(n)
+}
+
+type InlineHandler457 struct{}
+
+func (InlineHandler457) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler457 handles a InlineHandler457 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler457(m interface{}, n int) int {
+	return m.(InlineHandler457).Handle(n)
+}
+
+type InlineHandler458 struct{}
+
+func (InlineHandler458) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler458 handles a InlineHandler458 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler458(m interface{}, n int) int {
+	return m.(InlineHandler458).Handle(n)
+}
+
+type InlineHandler459 struct{}
+
+func (InlineHandler459) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler459 handles a InlineHandler459 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler459(m interface{}, n int) int {
+	return m.(InlineHandler459).Handle(n)
+}
+
+type InlineHandler460 struct{}
+
+func (InlineHandler460) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler460 handles a InlineHandler460 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler460(m interface{}, n int) int {
+	return m.(InlineHandler460).Handle(n)
+}
+
+type InlineHandler461 struct{}
+
+func (InlineHandler461) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler461 handles a InlineHandler461 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler461(m interface{}, n int) int {
+	return m.(InlineHandler461).Handle(n)
+}
+
+type InlineHandler462 struct{}
+
+func (InlineHandler462) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler462 handles a InlineHandler462 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler462(m interface{}, n int) int {
+	return m.(InlineHandler462).Handle(n)
+}
+
+type InlineHandler463 struct{}
+
+func (InlineHandler463) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler463 handles a InlineHandler463 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler463(m interface{}, n int) int {
+	return m.(InlineHandler463).Handle(n)
+}
+
+type InlineHandler464 struct{}
+
+func (InlineHandler464) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler464 handles a InlineHandler464 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler464(m interface{}, n int) int {
+	return m.(InlineHandler464).Handle(n)
+}
+
+type InlineHandler465 struct{}
+
+func (InlineHandler465) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler465 handles a InlineHandler465 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler465(m interface{}, n int) int {
+	return m.(InlineHandler465).Handle(n)
+}
+
+type InlineHandler466 struct{}
+
+func (InlineHandler466) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler466 handles a InlineHandler466 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler466(m interface{}, n int) int {
+	return m.(InlineHandler466).Handle(n)
+}
+
+type InlineHandler467 struct{}
+
+func (InlineHandler467) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler467 handles a InlineHandler467 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler467(m interface{}, n int) int {
+	return m.(InlineHandler467).Handle(n)
+}
+
+type InlineHandler468 struct{}
+
+func (InlineHandler468) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler468 handles a InlineHandler468 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler468(m interface{}, n int) int {
+	return m.(InlineHandler468).Handle(n)
+}
+
+type InlineHandler469 struct{}
+
+func (InlineHandler469) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler469 handles a InlineHandler469 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler469(m interface{}, n int) int {
+	return m.(InlineHandler469).Handle(n)
+}
+
+type InlineHandler470 struct{}
+
+func (InlineHandler470) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler470 handles a InlineHandler470 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler470(m interface{}, n int) int {
+	return m.(InlineHandler470).Handle(n)
+}
+
+type InlineHandler471 struct{}
+
+func (InlineHandler471) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler471 handles a InlineHandler471 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler471(m interface{}, n int) int {
+	return m.(InlineHandler471).Handle(n)
+}
+
+type InlineHandler472 struct{}
+
+func (InlineHandler472) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler472 handles a InlineHandler472 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler472(m interface{}, n int) int {
+	return m.(InlineHandler472).Handle(n)
+}
+
+type InlineHandler473 struct{}
+
+func (InlineHandler473) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler473 handles a InlineHandler473 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler473(m interface{}, n int) int {
+	return m.(InlineHandler473).Handle(n)
+}
+
+type InlineHandler474 struct{}
+
+func (InlineHandler474) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler474 handles a InlineHandler474 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler474(m interface{}, n int) int {
+	return m.(InlineHandler474).Handle(n)
+}
+
+type InlineHandler475 struct{}
+
+func (InlineHandler475) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler475 handles a InlineHandler475 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler475(m interface{}, n int) int {
+	return m.(InlineHandler475).Handle(n)
+}
+
+type InlineHandler476 struct{}
+
+func (InlineHandler476) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler476 handles a InlineHandler476 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler476(m interface{}, n int) int {
+	return m.(InlineHandler476).Handle(n)
+}
+
+type InlineHandler477 struct{}
+
+func (InlineHandler477) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler477 handles a InlineHandler477 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler477(m interface{}, n int) int {
+	return m.(InlineHandler477).Handle(n)
+}
+
+type InlineHandler478 struct{}
+
+func (InlineHandler478) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler478 handles a InlineHandler478 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler478(m interface{}, n int) int {
+	return m.(InlineHandler478).Handle(n)
+}
+
+type InlineHandler479 struct{}
+
+func (InlineHandler479) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler479 handles a InlineHandler479 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler479(m interface{}, n int) int {
+	return m.(InlineHandler479).Handle(n)
+}
+
+type InlineHandler480 struct{}
+
+func (InlineHandler480) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler480 handles a InlineHandler480 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler480(m interface{}, n int) int {
+	return m.(InlineHandler480).Handle(n)
+}
+
+type InlineHandler481 struct{}
+
+func (InlineHandler481) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler481 handles a InlineHandler481 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler481(m interface{}, n int) int {
+	return m.(InlineHandler481).Handle(n)
+}
+
+type InlineHandler482 struct{}
+
+func (InlineHandler482) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler482 handles a InlineHandler482 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler482(m interface{}, n int) int {
+	return m.(InlineHandler482).Handle(n)
+}
+
+type InlineHandler483 struct{}
+
+func (InlineHandler483) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler483 handles a InlineHandler483 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler483(m interface{}, n int) int {
+	return m.(InlineHandler483).Handle(n)
+}
+
+type InlineHandler484 struct{}
+
+func (InlineHandler484) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler484 handles a InlineHandler484 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler484(m interface{}, n int) int {
+	return m.(InlineHandler484).Handle(n)
+}
+
+type InlineHandler485 struct{}
+
+func (InlineHandler485) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler485 handles a InlineHandler485 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler485(m interface{}, n int) int {
+	return m.(InlineHandler485).Handle(n)
+}
+
+type InlineHandler486 struct{}
+
+func (InlineHandler486) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler486 handles a InlineHandler486 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler486(m interface{}, n int) int {
+	return m.(InlineHandler486).Handle(n)
+}
+
+type InlineHandler487 struct{}
+
+func (InlineHandler487) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler487 handles a InlineHandler487 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler487(m interface{}, n int) int {
+	return m.(InlineHandler487).Handle(n)
+}
+
+type InlineHandler488 struct{}
+
+func (InlineHandler488) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler488 handles a InlineHandler488 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler488(m interface{}, n int) int {
+	return m.(InlineHandler488).Handle(n)
+}
+
+type InlineHandler489 struct{}
+
+func (InlineHandler489) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler489 handles a InlineHandler489 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler489(m interface{}, n int) int {
+	return m.(InlineHandler489).Handle(n)
+}
+
+type InlineHandler490 struct{}
+
+func (InlineHandler490) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler490 handles a InlineHandler490 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler490(m interface{}, n int) int {
+	return m.(InlineHandler490).Handle(n)
+}
+
+type InlineHandler491 struct{}
+
+func (InlineHandler491) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler491 handles a InlineHandler491 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler491(m interface{}, n int) int {
+	return m.(InlineHandler491).Handle(n)
+}
+
+type InlineHandler492 struct{}
+
+func (InlineHandler492) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler492 handles a InlineHandler492 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler492(m interface{}, n int) int {
+	return m.(InlineHandler492).Handle(n)
+}
+
+type InlineHandler493 struct{}
+
+func (InlineHandler493) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler493 handles a InlineHandler493 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler493(m interface{}, n int) int {
+	return m.(InlineHandler493).Handle(n)
+}
+
+type InlineHandler494 struct{}
+
+func (InlineHandler494) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler494 handles a InlineHandler494 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler494(m interface{}, n int) int {
+	return m.(InlineHandler494).Handle(n)
+}
+
+type InlineHandler495 struct{}
+
+func (InlineHandler495) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler495 handles a InlineHandler495 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler495(m interface{}, n int) int {
+	return m.(InlineHandler495).Handle(n)
+}
+
+type InlineHandler496 struct{}
+
+func (InlineHandler496) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler496 handles a InlineHandler496 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler496(m interface{}, n int) int {
+	return m.(InlineHandler496).Handle(n)
+}
+
+type InlineHandler497 struct{}
+
+func (InlineHandler497) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler497 handles a InlineHandler497 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler497(m interface{}, n int) int {
+	return m.(InlineHandler497).Handle(n)
+}
+
+type InlineHandler498 struct{}
+
+func (InlineHandler498) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler498 handles a InlineHandler498 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler498(m interface{}, n int) int {
+	return m.(InlineHandler498).Handle(n)
+}
+
+type InlineHandler499 struct{}
+
+func (InlineHandler499) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler499 handles a InlineHandler499 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler499(m interface{}, n int) int {
+	return m.(InlineHandler499).Handle(n)
+}
+
+type InlineHandler500 struct{}
+
+func (InlineHandler500) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler500 handles a InlineHandler500 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler500(m interface{}, n int) int {
+	return m.(InlineHandler500).Handle(n)
+}
+
+type InlineHandler501 struct{}
+
+func (InlineHandler501) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler501 handles a InlineHandler501 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler501(m interface{}, n int) int {
+	return m.(InlineHandler501).Handle(n)
+}
+
+type InlineHandler502 struct{}
+
+func (InlineHandler502) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler502 handles a InlineHandler502 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler502(m interface{}, n int) int {
+	return m.(InlineHandler502).Handle(n)
+}
+
+type InlineHandler503 struct{}
+
+func (InlineHandler503) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler503 handles a InlineHandler503 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler503(m interface{}, n int) int {
+	return m.(InlineHandler503).Handle(n)
+}
+
+type InlineHandler504 struct{}
+
+func (InlineHandler504) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler504 handles a InlineHandler504 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler504(m interface{}, n int) int {
+	return m.(InlineHandler504).Handle(n)
+}
+
+type InlineHandler505 struct{}
+
+func (InlineHandler505) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler505 handles a InlineHandler505 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler505(m interface{}, n int) int {
+	return m.(InlineHandler505).Handle(n)
+}
+
+type InlineHandler506 struct{}
+
+func (InlineHandler506) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler506 handles a InlineHandler506 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler506(m interface{}, n int) int {
+	return m.(InlineHandler506).Handle(n)
+}
+
+type InlineHandler507 struct{}
+
+func (InlineHandler507) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler507 handles a InlineHandler507 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler507(m interface{}, n int) int {
+	return m.(InlineHandler507).Handle(n)
+}
+
+type InlineHandler508 struct{}
+
+func (InlineHandler508) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler508 handles a InlineHandler508 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler508(m interface{}, n int) int {
+	return m.(InlineHandler508).Handle(n)
+}
+
+type InlineHandler509 struct{}
+
+func (InlineHandler509) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler509 handles a InlineHandler509 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler509(m interface{}, n int) int {
+	return m.(InlineHandler509).Handle(n)
+}
+
+type InlineHandler510 struct{}
+
+func (InlineHandler510) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler510 handles a InlineHandler510 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler510(m interface{}, n int) int {
+	return m.(InlineHandler510).Handle(n)
+}
+
+type InlineHandler511 struct{}
+
+func (InlineHandler511) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// InlineTypeHandler511 handles a InlineHandler511 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func InlineTypeHandler511(m interface{}, n int) int {
+	return m.(InlineHandler511).Handle(n)
+}
+
+var NoInlineHandlers []Handler
+
+// NoInlineMessages holds the same values as NoInlineHandlers as interface{} values.
+var NoInlineMessages []interface{}
+
+// NoInlineTypeHandlers holds the TypeHandler of each message.
+var NoInlineTypeHandlers []func(interface{}, int) int
+
+type NoInlineHandler0 struct{}
+
+//go:noinline
+func (NoInlineHandler0) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler0 handles a NoInlineHandler0 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler0(m interface{}, n int) int {
+	return m.(NoInlineHandler0).Handle(n)
+}
+
+type NoInlineHandler1 struct{}
+
+//go:noinline
+func (NoInlineHandler1) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler1 handles a NoInlineHandler1 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler1(m interface{}, n int) int {
+	return m.(NoInlineHandler1).Handle(n)
+}
+
+type NoInlineHandler2 struct{}
+
+//go:noinline
+func (NoInlineHandler2) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler2 handles a NoInlineHandler2 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler2(m interface{}, n int) int {
+	return m.(NoInlineHandler2).Handle(n)
+}
+
+type NoInlineHandler3 struct{}
+
+//go:noinline
+func (NoInlineHandler3) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler3 handles a NoInlineHandler3 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler3(m interface{}, n int) int {
+	return m.(NoInlineHandler3).Handle(n)
+}
+
+type NoInlineHandler4 struct{}
+
+//go:noinline
+func (NoInlineHandler4) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler4 handles a NoInlineHandler4 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler4(m interface{}, n int) int {
+	return m.(NoInlineHandler4).Handle(n)
+}
+
+type NoInlineHandler5 struct{}
+
+//go:noinline
+func (NoInlineHandler5) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler5 handles a NoInlineHandler5 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler5(m interface{}, n int) int {
+	return m.(NoInlineHandler5).Handle(n)
+}
+
+type NoInlineHandler6 struct{}
+
+//go:noinline
+func (NoInlineHandler6) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler6 handles a NoInlineHandler6 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler6(m interface{}, n int) int {
+	return m.(NoInlineHandler6).Handle(n)
+}
+
+type NoInlineHandler7 struct{}
+
+//go:noinline
+func (NoInlineHandler7) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler7 handles a NoInlineHandler7 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler7(m interface{}, n int) int {
+	return m.(NoInlineHandler7).Handle(n)
+}
+
+type NoInlineHandler8 struct{}
+
+//go:noinline
+func (NoInlineHandler8) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler8 handles a NoInlineHandler8 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler8(m interface{}, n int) int {
+	return m.(NoInlineHandler8).Handle(n)
+}
+
+type NoInlineHandler9 struct{}
+
+//go:noinline
+func (NoInlineHandler9) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler9 handles a NoInlineHandler9 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler9(m interface{}, n int) int {
+	return m.(NoInlineHandler9).Handle(n)
+}
+
+type NoInlineHandler10 struct{}
+
+//go:noinline
+func (NoInlineHandler10) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler10 handles a NoInlineHandler10 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler10(m interface{}, n int) int {
+	return m.(NoInlineHandler10).Handle(n)
+}
+
+type NoInlineHandler11 struct{}
+
+//go:noinline
+func (NoInlineHandler11) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler11 handles a NoInlineHandler11 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler11(m interface{}, n int) int {
+	return m.(NoInlineHandler11).Handle(n)
+}
+
+type NoInlineHandler12 struct{}
+
+//go:noinline
+func (NoInlineHandler12) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler12 handles a NoInlineHandler12 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler12(m interface{}, n int) int {
+	return m.(NoInlineHandler12).Handle(n)
+}
+
+type NoInlineHandler13 struct{}
+
+//go:noinline
+func (NoInlineHandler13) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler13 handles a NoInlineHandler13 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler13(m interface{}, n int) int {
+	return m.(NoInlineHandler13).Handle(n)
+}
+
+type NoInlineHandler14 struct{}
+
+//go:noinline
+func (NoInlineHandler14) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler14 handles a NoInlineHandler14 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler14(m interface{}, n int) int {
+	return m.(NoInlineHandler14).Handle(n)
+}
+
+type NoInlineHandler15 struct{}
+
+//go:noinline
+func (NoInlineHandler15) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler15 handles a NoInlineHandler15 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler15(m interface{}, n int) int {
+	return m.(NoInlineHandler15).Handle(n)
+}
+
+type NoInlineHandler16 struct{}
+
+//go:noinline
+func (NoInlineHandler16) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler16 handles a NoInlineHandler16 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler16(m interface{}, n int) int {
+	return m.(NoInlineHandler16).Handle(n)
+}
+
+type NoInlineHandler17 struct{}
+
+//go:noinline
+func (NoInlineHandler17) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler17 handles a NoInlineHandler17 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler17(m interface{}, n int) int {
+	return m.(NoInlineHandler17).Handle(n)
+}
+
+type NoInlineHandler18 struct{}
+
+//go:noinline
+func (NoInlineHandler18) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler18 handles a NoInlineHandler18 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler18(m interface{}, n int) int {
+	return m.(NoInlineHandler18).Handle(n)
+}
+
+type NoInlineHandler19 struct{}
+
+//go:noinline
+func (NoInlineHandler19) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler19 handles a NoInlineHandler19 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler19(m interface{}, n int) int {
+	return m.(NoInlineHandler19).Handle(n)
+}
+
+type NoInlineHandler20 struct{}
+
+//go:noinline
+func (NoInlineHandler20) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler20 handles a NoInlineHandler20 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler20(m interface{}, n int) int {
+	return m.(NoInlineHandler20).Handle(n)
+}
+
+type NoInlineHandler21 struct{}
+
+//go:noinline
+func (NoInlineHandler21) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler21 handles a NoInlineHandler21 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler21(m interface{}, n int) int {
+	return m.(NoInlineHandler21).Handle(n)
+}
+
+type NoInlineHandler22 struct{}
+
+//go:noinline
+func (NoInlineHandler22) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler22 handles a NoInlineHandler22 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler22(m interface{}, n int) int {
+	return m.(NoInlineHandler22).Handle(n)
+}
+
+type NoInlineHandler23 struct{}
+
+//go:noinline
+func (NoInlineHandler23) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler23 handles a NoInlineHandler23 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler23(m interface{}, n int) int {
+	return m.(NoInlineHandler23).Handle(n)
+}
+
+type NoInlineHandler24 struct{}
+
+//go:noinline
+func (NoInlineHandler24) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler24 handles a NoInlineHandler24 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler24(m interface{}, n int) int {
+	return m.(NoInlineHandler24).Handle(n)
+}
+
+type NoInlineHandler25 struct{}
+
+//go:noinline
+func (NoInlineHandler25) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler25 handles a NoInlineHandler25 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler25(m interface{}, n int) int {
+	return m.(NoInlineHandler25).Handle(n)
+}
+
+type NoInlineHandler26 struct{}
+
+//go:noinline
+func (NoInlineHandler26) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler26 handles a NoInlineHandler26 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler26(m interface{}, n int) int {
+	return m.(NoInlineHandler26).Handle(n)
+}
+
+type NoInlineHandler27 struct{}
+
+//go:noinline
+func (NoInlineHandler27) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler27 handles a NoInlineHandler27 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler27(m interface{}, n int) int {
+	return m.(NoInlineHandler27).Handle(n)
+}
+
+type NoInlineHandler28 struct{}
+
+//go:noinline
+func (NoInlineHandler28) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler28 handles a NoInlineHandler28 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler28(m interface{}, n int) int {
+	return m.(NoInlineHandler28).Handle(n)
+}
+
+type NoInlineHandler29 struct{}
+
+//go:noinline
+func (NoInlineHandler29) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler29 handles a NoInlineHandler29 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler29(m interface{}, n int) int {
+	return m.(NoInlineHandler29).Handle(n)
+}
+
+type NoInlineHandler30 struct{}
+
+//go:noinline
+func (NoInlineHandler30) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler30 handles a NoInlineHandler30 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler30(m interface{}, n int) int {
+	return m.(NoInlineHandler30).Handle(n)
+}
+
+type NoInlineHandler31 struct{}
+
+//go:noinline
+func (NoInlineHandler31) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler31 handles a NoInlineHandler31 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler31(m interface{}, n int) int {
+	return m.(NoInlineHandler31).Handle(n)
+}
+
+type NoInlineHandler32 struct{}
+
+//go:noinline
+func (NoInlineHandler32) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler32 handles a NoInlineHandler32 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler32(m interface{}, n int) int {
+	return m.(NoInlineHandler32).Handle(n)
+}
+
+type NoInlineHandler33 struct{}
+
+//go:noinline
+func (NoInlineHandler33) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler33 handles a NoInlineHandler33 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler33(m interface{}, n int) int {
+	return m.(NoInlineHandler33).Handle(n)
+}
+
+type NoInlineHandler34 struct{}
+
+//go:noinline
+func (NoInlineHandler34) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler34 handles a NoInlineHandler34 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler34(m interface{}, n int) int {
+	return m.(NoInlineHandler34).Handle(n)
+}
+
+type NoInlineHandler35 struct{}
+
+//go:noinline
+func (NoInlineHandler35) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler35 handles a NoInlineHandler35 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler35(m interface{}, n int) int {
+	return m.(NoInlineHandler35).Handle(n)
+}
+
+type NoInlineHandler36 struct{}
+
+//go:noinline
+func (NoInlineHandler36) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler36 handles a NoInlineHandler36 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler36(m interface{}, n int) int {
+	return m.(NoInlineHandler36).Handle(n)
+}
+
+type NoInlineHandler37 struct{}
+
+//go:noinline
+func (NoInlineHandler37) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler37 handles a NoInlineHandler37 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler37(m interface{}, n int) int {
+	return m.(NoInlineHandler37).Handle(n)
+}
+
+type NoInlineHandler38 struct{}
+
+//go:noinline
+func (NoInlineHandler38) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler38 handles a NoInlineHandler38 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler38(m interface{}, n int) int {
+	return m.(NoInlineHandler38).Handle(n)
+}
+
+type NoInlineHandler39 struct{}
+
+//go:noinline
+func (NoInlineHandler39) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler39 handles a NoInlineHandler39 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler39(m interface{}, n int) int {
+	return m.(NoInlineHandler39).Handle(n)
+}
+
+type NoInlineHandler40 struct{}
+
+//go:noinline
+func (NoInlineHandler40) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler40 handles a NoInlineHandler40 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler40(m interface{}, n int) int {
+	return m.(NoInlineHandler40).Handle(n)
+}
+
+type NoInlineHandler41 struct{}
+
+//go:noinline
+func (NoInlineHandler41) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler41 handles a NoInlineHandler41 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler41(m interface{}, n int) int {
+	return m.(NoInlineHandler41).Handle(n)
+}
+
+type NoInlineHandler42 struct{}
+
+//go:noinline
+func (NoInlineHandler42) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler42 handles a NoInlineHandler42 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler42(m interface{}, n int) int {
+	return m.(NoInlineHandler42).Handle(n)
+}
+
+type NoInlineHandler43 struct{}
+
+//go:noinline
+func (NoInlineHandler43) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler43 handles a NoInlineHandler43 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler43(m interface{}, n int) int {
+	return m.(NoInlineHandler43).Handle(n)
+}
+
+type NoInlineHandler44 struct{}
+
+//go:noinline
+func (NoInlineHandler44) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler44 handles a NoInlineHandler44 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler44(m interface{}, n int) int {
+	return m.(NoInlineHandler44).Handle(n)
+}
+
+type NoInlineHandler45 struct{}
+
+//go:noinline
+func (NoInlineHandler45) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler45 handles a NoInlineHandler45 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler45(m interface{}, n int) int {
+	return m.(NoInlineHandler45).Handle(n)
+}
+
+type NoInlineHandler46 struct{}
+
+//go:noinline
+func (NoInlineHandler46) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler46 handles a NoInlineHandler46 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler46(m interface{}, n int) int {
+	return m.(NoInlineHandler46).Handle(n)
+}
+
+type NoInlineHandler47 struct{}
+
+//go:noinline
+func (NoInlineHandler47) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler47 handles a NoInlineHandler47 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler47(m interface{}, n int) int {
+	return m.(NoInlineHandler47).Handle(n)
+}
+
+type NoInlineHandler48 struct{}
+
+//go:noinline
+func (NoInlineHandler48) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler48 handles a NoInlineHandler48 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler48(m interface{}, n int) int {
+	return m.(NoInlineHandler48).Handle(n)
+}
+
+type NoInlineHandler49 struct{}
+
+//go:noinline
+func (NoInlineHandler49) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler49 handles a NoInlineHandler49 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler49(m interface{}, n int) int {
+	return m.(NoInlineHandler49).Handle(n)
+}
+
+type NoInlineHandler50 struct{}
+
+//go:noinline
+func (NoInlineHandler50) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler50 handles a NoInlineHandler50 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler50(m interface{}, n int) int {
+	return m.(NoInlineHandler50).Handle(n)
+}
+
+type NoInlineHandler51 struct{}
+
+//go:noinline
+func (NoInlineHandler51) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler51 handles a NoInlineHandler51 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler51(m interface{}, n int) int {
+	return m.(NoInlineHandler51).Handle(n)
+}
+
+type NoInlineHandler52 struct{}
+
+//go:noinline
+func (NoInlineHandler52) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler52 handles a NoInlineHandler52 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler52(m interface{}, n int) int {
+	return m.(NoInlineHandler52).Handle(n)
+}
+
+type NoInlineHandler53 struct{}
+
+//go:noinline
+func (NoInlineHandler53) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler53 handles a NoInlineHandler53 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler53(m interface{}, n int) int {
+	return m.(NoInlineHandler53).Handle(n)
+}
+
+type NoInlineHandler54 struct{}
+
+//go:noinline
+func (NoInlineHandler54) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler54 handles a NoInlineHandler54 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler54(m interface{}, n int) int {
+	return m.(NoInlineHandler54).Handle(n)
+}
+
+type NoInlineHandler55 struct{}
+
+//go:noinline
+func (NoInlineHandler55) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler55 handles a NoInlineHandler55 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler55(m interface{}, n int) int {
+	return m.(NoInlineHandler55).Handle(n)
+}
+
+type NoInlineHandler56 struct{}
+
+//go:noinline
+func (NoInlineHandler56) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler56 handles a NoInlineHandler56 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler56(m interface{}, n int) int {
+	return m.(NoInlineHandler56).Handle(n)
+}
+
+type NoInlineHandler57 struct{}
+
+//go:noinline
+func (NoInlineHandler57) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler57 handles a NoInlineHandler57 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler57(m interface{}, n int) int {
+	return m.(NoInlineHandler57).Handle(n)
+}
+
+type NoInlineHandler58 struct{}
+
+//go:noinline
+func (NoInlineHandler58) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler58 handles a NoInlineHandler58 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler58(m interface{}, n int) int {
+	return m.(NoInlineHandler58).Handle(n)
+}
+
+type NoInlineHandler59 struct{}
+
+//go:noinline
+func (NoInlineHandler59) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler59 handles a NoInlineHandler59 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler59(m interface{}, n int) int {
+	return m.(NoInlineHandler59).Handle(n)
+}
+
+type NoInlineHandler60 struct{}
+
+//go:noinline
+func (NoInlineHandler60) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler60 handles a NoInlineHandler60 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler60(m interface{}, n int) int {
+	return m.(NoInlineHandler60).Handle(n)
+}
+
+type NoInlineHandler61 struct{}
+
+//go:noinline
+func (NoInlineHandler61) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler61 handles a NoInlineHandler61 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler61(m interface{}, n int) int {
+	return m.(NoInlineHandler61).Handle(n)
+}
+
+type NoInlineHandler62 struct{}
+
+//go:noinline
+func (NoInlineHandler62) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler62 handles a NoInlineHandler62 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler62(m interface{}, n int) int {
+	return m.(NoInlineHandler62).Handle(n)
+}
+
+type NoInlineHandler63 struct{}
+
+//go:noinline
+func (NoInlineHandler63) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler63 handles a NoInlineHandler63 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler63(m interface{}, n int) int {
+	return m.(NoInlineHandler63).Handle(n)
+}
+
+type NoInlineHandler64 struct{}
+
+//go:noinline
+func (NoInlineHandler64) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler64 handles a NoInlineHandler64 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler64(m interface{}, n int) int {
+	return m.(NoInlineHandler64).Handle(n)
+}
+
+type NoInlineHandler65 struct{}
+
+//go:noinline
+func (NoInlineHandler65) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler65 handles a NoInlineHandler65 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler65(m interface{}, n int) int {
+	return m.(NoInlineHandler65).Handle(n)
+}
+
+type NoInlineHandler66 struct{}
+
+//go:noinline
+func (NoInlineHandler66) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler66 handles a NoInlineHandler66 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler66(m interface{}, n int) int {
+	return m.(NoInlineHandler66).Handle(n)
+}
+
+type NoInlineHandler67 struct{}
+
+//go:noinline
+func (NoInlineHandler67) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler67 handles a NoInlineHandler67 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler67(m interface{}, n int) int {
+	return m.(NoInlineHandler67).Handle(n)
+}
+
+type NoInlineHandler68 struct{}
+
+//go:noinline
+func (NoInlineHandler68) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler68 handles a NoInlineHandler68 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler68(m interface{}, n int) int {
+	return m.(NoInlineHandler68).Handle(n)
+}
+
+type NoInlineHandler69 struct{}
+
+//go:noinline
+func (NoInlineHandler69) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler69 handles a NoInlineHandler69 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler69(m interface{}, n int) int {
+	return m.(NoInlineHandler69).Handle(n)
+}
+
+type NoInlineHandler70 struct{}
+
+//go:noinline
+func (NoInlineHandler70) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler70 handles a NoInlineHandler70 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler70(m interface{}, n int) int {
+	return m.(NoInlineHandler70).Handle(n)
+}
+
+type NoInlineHandler71 struct{}
+
+//go:noinline
+func (NoInlineHandler71) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler71 handles a NoInlineHandler71 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler71(m interface{}, n int) int {
+	return m.(NoInlineHandler71).Handle(n)
+}
+
+type NoInlineHandler72 struct{}
+
+//go:noinline
+func (NoInlineHandler72) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler72 handles a NoInlineHandler72 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler72(m interface{}, n int) int {
+	return m.(NoInlineHandler72).Handle(n)
+}
+
+type NoInlineHandler73 struct{}
+
+//go:noinline
+func (NoInlineHandler73) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler73 handles a NoInlineHandler73 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler73(m interface{}, n int) int {
+	return m.(NoInlineHandler73).Handle(n)
+}
+
+type NoInlineHandler74 struct{}
+
+//go:noinline
+func (NoInlineHandler74) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler74 handles a NoInlineHandler74 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler74(m interface{}, n int) int {
+	return m.(NoInlineHandler74).Handle(n)
+}
+
+type NoInlineHandler75 struct{}
+
+//go:noinline
+func (NoInlineHandler75) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler75 handles a NoInlineHandler75 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler75(m interface{}, n int) int {
+	return m.(NoInlineHandler75).Handle(n)
+}
+
+type NoInlineHandler76 struct{}
+
+//go:noinline
+func (NoInlineHandler76) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler76 handles a NoInlineHandler76 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler76(m interface{}, n int) int {
+	return m.(NoInlineHandler76).Handle(n)
+}
+
+type NoInlineHandler77 struct{}
+
+//go:noinline
+func (NoInlineHandler77) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler77 handles a NoInlineHandler77 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler77(m interface{}, n int) int {
+	return m.(NoInlineHandler77).Handle(n)
+}
+
+type NoInlineHandler78 struct{}
+
+//go:noinline
+func (NoInlineHandler78) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler78 handles a NoInlineHandler78 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler78(m interface{}, n int) int {
+	return m.(NoInlineHandler78).Handle(n)
+}
+
+type NoInlineHandler79 struct{}
+
+//go:noinline
+func (NoInlineHandler79) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler79 handles a NoInlineHandler79 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler79(m interface{}, n int) int {
+	return m.(NoInlineHandler79).Handle(n)
+}
+
+type NoInlineHandler80 struct{}
+
+//go:noinline
+func (NoInlineHandler80) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler80 handles a NoInlineHandler80 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler80(m interface{}, n int) int {
+	return m.(NoInlineHandler80).Handle(n)
+}
+
+type NoInlineHandler81 struct{}
+
+//go:noinline
+func (NoInlineHandler81) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler81 handles a NoInlineHandler81 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler81(m interface{}, n int) int {
+	return m.(NoInlineHandler81).Handle(n)
+}
+
+type NoInlineHandler82 struct{}
+
+//go:noinline
+func (NoInlineHandler82) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler82 handles a NoInlineHandler82 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler82(m interface{}, n int) int {
+	return m.(NoInlineHandler82).Handle(n)
+}
+
+type NoInlineHandler83 struct{}
+
+//go:noinline
+func (NoInlineHandler83) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler83 handles a NoInlineHandler83 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler83(m interface{}, n int) int {
+	return m.(NoInlineHandler83).Handle(n)
+}
+
+type NoInlineHandler84 struct{}
+
+//go:noinline
+func (NoInlineHandler84) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler84 handles a NoInlineHandler84 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler84(m interface{}, n int) int {
+	return m.(NoInlineHandler84).Handle(n)
+}
+
+type NoInlineHandler85 struct{}
+
+//go:noinline
+func (NoInlineHandler85) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler85 handles a NoInlineHandler85 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler85(m interface{}, n int) int {
+	return m.(NoInlineHandler85).Handle(n)
+}
+
+type NoInlineHandler86 struct{}
+
+//go:noinline
+func (NoInlineHandler86) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler86 handles a NoInlineHandler86 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler86(m interface{}, n int) int {
+	return m.(NoInlineHandler86).Handle(n)
+}
+
+type NoInlineHandler87 struct{}
+
+//go:noinline
+func (NoInlineHandler87) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler87 handles a NoInlineHandler87 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler87(m interface{}, n int) int {
+	return m.(NoInlineHandler87).Handle(n)
+}
+
+type NoInlineHandler88 struct{}
+
+//go:noinline
+func (NoInlineHandler88) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler88 handles a NoInlineHandler88 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler88(m interface{}, n int) int {
+	return m.(NoInlineHandler88).Handle(n)
+}
+
+type NoInlineHandler89 struct{}
+
+//go:noinline
+func (NoInlineHandler89) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler89 handles a NoInlineHandler89 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler89(m interface{}, n int) int {
+	return m.(NoInlineHandler89).Handle(n)
+}
+
+type NoInlineHandler90 struct{}
+
+//go:noinline
+func (NoInlineHandler90) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler90 handles a NoInlineHandler90 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler90(m interface{}, n int) int {
+	return m.(NoInlineHandler90).Handle(n)
+}
+
+type NoInlineHandler91 struct{}
+
+//go:noinline
+func (NoInlineHandler91) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler91 handles a NoInlineHandler91 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler91(m interface{}, n int) int {
+	return m.(NoInlineHandler91).Handle(n)
+}
+
+type NoInlineHandler92 struct{}
+
+//go:noinline
+func (NoInlineHandler92) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler92 handles a NoInlineHandler92 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler92(m interface{}, n int) int {
+	return m.(NoInlineHandler92).Handle(n)
+}
+
+type NoInlineHandler93 struct{}
+
+//go:noinline
+func (NoInlineHandler93) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler93 handles a NoInlineHandler93 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler93(m interface{}, n int) int {
+	return m.(NoInlineHandler93).Handle(n)
+}
+
+type NoInlineHandler94 struct{}
+
+//go:noinline
+func (NoInlineHandler94) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler94 handles a NoInlineHandler94 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler94(m interface{}, n int) int {
+	return m.(NoInlineHandler94).Handle(n)
+}
+
+type NoInlineHandler95 struct{}
+
+//go:noinline
+func (NoInlineHandler95) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler95 handles a NoInlineHandler95 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler95(m interface{}, n int) int {
+	return m.(NoInlineHandler95).Handle(n)
+}
+
+type NoInlineHandler96 struct{}
+
+//go:noinline
+func (NoInlineHandler96) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler96 handles a NoInlineHandler96 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler96(m interface{}, n int) int {
+	return m.(NoInlineHandler96).Handle(n)
+}
+
+type NoInlineHandler97 struct{}
+
+//go:noinline
+func (NoInlineHandler97) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler97 handles a NoInlineHandler97 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler97(m interface{}, n int) int {
+	return m.(NoInlineHandler97).Handle(n)
+}
+
+type NoInlineHandler98 struct{}
+
+//go:noinline
+func (NoInlineHandler98) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler98 handles a NoInlineHandler98 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler98(m interface{}, n int) int {
+	return m.(NoInlineHandler98).Handle(n)
+}
+
+type NoInlineHandler99 struct{}
+
+//go:noinline
+func (NoInlineHandler99) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler99 handles a NoInlineHandler99 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler99(m interface{}, n int) int {
+	return m.(NoInlineHandler99).Handle(n)
+}
+
+type NoInlineHandler100 struct{}
+
+//go:noinline
+func (NoInlineHandler100) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler100 handles a NoInlineHandler100 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler100(m interface{}, n int) int {
+	return m.(NoInlineHandler100).Handle(n)
+}
+
+type NoInlineHandler101 struct{}
+
+//go:noinline
+func (NoInlineHandler101) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler101 handles a NoInlineHandler101 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler101(m interface{}, n int) int {
+	return m.(NoInlineHandler101).Handle(n)
+}
+
+type NoInlineHandler102 struct{}
+
+//go:noinline
+func (NoInlineHandler102) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler102 handles a NoInlineHandler102 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler102(m interface{}, n int) int {
+	return m.(NoInlineHandler102).Handle(n)
+}
+
+type NoInlineHandler103 struct{}
+
+//go:noinline
+func (NoInlineHandler103) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler103 handles a NoInlineHandler103 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler103(m interface{}, n int) int {
+	return m.(NoInlineHandler103).Handle(n)
+}
+
+type NoInlineHandler104 struct{}
+
+//go:noinline
+func (NoInlineHandler104) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler104 handles a NoInlineHandler104 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler104(m interface{}, n int) int {
+	return m.(NoInlineHandler104).Handle(n)
+}
+
+type NoInlineHandler105 struct{}
+
+//go:noinline
+func (NoInlineHandler105) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler105 handles a NoInlineHandler105 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler105(m interface{}, n int) int {
+	return m.(NoInlineHandler105).Handle(n)
+}
+
+type NoInlineHandler106 struct{}
+
+//go:noinline
+func (NoInlineHandler106) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler106 handles a NoInlineHandler106 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler106(m interface{}, n int) int {
+	return m.(NoInlineHandler106).Handle(n)
+}
+
+type NoInlineHandler107 struct{}
+
+//go:noinline
+func (NoInlineHandler107) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler107 handles a NoInlineHandler107 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler107(m interface{}, n int) int {
+	return m.(NoInlineHandler107).Handle(n)
+}
+
+type NoInlineHandler108 struct{}
+
+//go:noinline
+func (NoInlineHandler108) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler108 handles a NoInlineHandler108 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler108(m interface{}, n int) int {
+	return m.(NoInlineHandler108).Handle(n)
+}
+
+type NoInlineHandler109 struct{}
+
+//go:noinline
+func (NoInlineHandler109) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler109 handles a NoInlineHandler109 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler109(m interface{}, n int) int {
+	return m.(NoInlineHandler109).Handle(n)
+}
+
+type NoInlineHandler110 struct{}
+
+//go:noinline
+func (NoInlineHandler110) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler110 handles a NoInlineHandler110 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler110(m interface{}, n int) int {
+	return m.(NoInlineHandler110).Handle(n)
+}
+
+type NoInlineHandler111 struct{}
+
+//go:noinline
+func (NoInlineHandler111) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler111 handles a NoInlineHandler111 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler111(m interface{}, n int) int {
+	return m.(NoInlineHandler111).Handle(n)
+}
+
+type NoInlineHandler112 struct{}
+
+//go:noinline
+func (NoInlineHandler112) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler112 handles a NoInlineHandler112 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler112(m interface{}, n int) int {
+	return m.(NoInlineHandler112).Handle(n)
+}
+
+type NoInlineHandler113 struct{}
+
+//go:noinline
+func (NoInlineHandler113) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler113 handles a NoInlineHandler113 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler113(m interface{}, n int) int {
+	return m.(NoInlineHandler113).Handle(n)
+}
+
+type NoInlineHandler114 struct{}
+
+//go:noinline
+func (NoInlineHandler114) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler114 handles a NoInlineHandler114 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler114(m interface{}, n int) int {
+	return m.(NoInlineHandler114).Handle(n)
+}
+
+type NoInlineHandler115 struct{}
+
+//go:noinline
+func (NoInlineHandler115) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler115 handles a NoInlineHandler115 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler115(m interface{}, n int) int {
+	return m.(NoInlineHandler115).Handle(n)
+}
+
+type NoInlineHandler116 struct{}
+
+//go:noinline
+func (NoInlineHandler116) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler116 handles a NoInlineHandler116 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler116(m interface{}, n int) int {
+	return m.(NoInlineHandler116).Handle(n)
+}
+
+type NoInlineHandler117 struct{}
+
+//go:noinline
+func (NoInlineHandler117) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler117 handles a NoInlineHandler117 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler117(m interface{}, n int) int {
+	return m.(NoInlineHandler117).Handle(n)
+}
+
+type NoInlineHandler118 struct{}
+
+//go:noinline
+func (NoInlineHandler118) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler118 handles a NoInlineHandler118 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler118(m interface{}, n int) int {
+	return m.(NoInlineHandler118).Handle(n)
+}
+
+type NoInlineHandler119 struct{}
+
+//go:noinline
+func (NoInlineHandler119) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler119 handles a NoInlineHandler119 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler119(m interface{}, n int) int {
+	return m.(NoInlineHandler119).Handle(n)
+}
+
+type NoInlineHandler120 struct{}
+
+//go:noinline
+func (NoInlineHandler120) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler120 handles a NoInlineHandler120 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler120(m interface{}, n int) int {
+	return m.(NoInlineHandler120).Handle(n)
+}
+
+type NoInlineHandler121 struct{}
+
+//go:noinline
+func (NoInlineHandler121) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler121 handles a NoInlineHandler121 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler121(m interface{}, n int) int {
+	return m.(NoInlineHandler121).Handle(n)
+}
+
+type NoInlineHandler122 struct{}
+
+//go:noinline
+func (NoInlineHandler122) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler122 handles a NoInlineHandler122 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler122(m interface{}, n int) int {
+	return m.(NoInlineHandler122).Handle(n)
+}
+
+type NoInlineHandler123 struct{}
+
+//go:noinline
+func (NoInlineHandler123) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler123 handles a NoInlineHandler123 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler123(m interface{}, n int) int {
+	return m.(NoInlineHandler123).Handle(n)
+}
+
+type NoInlineHandler124 struct{}
+
+//go:noinline
+func (NoInlineHandler124) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler124 handles a NoInlineHandler124 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler124(m interface{}, n int) int {
+	return m.(NoInlineHandler124).Handle(n)
+}
+
+type NoInlineHandler125 struct{}
+
+//go:noinline
+func (NoInlineHandler125) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler125 handles a NoInlineHandler125 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler125(m interface{}, n int) int {
+	return m.(NoInlineHandler125).Handle(n)
+}
+
+type NoInlineHandler126 struct{}
+
+//go:noinline
+func (NoInlineHandler126) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler126 handles a NoInlineHandler126 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler126(m interface{}, n int) int {
+	return m.(NoInlineHandler126).Handle(n)
+}
+
+type NoInlineHandler127 struct{}
+
+//go:noinline
+func (NoInlineHandler127) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler127 handles a NoInlineHandler127 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler127(m interface{}, n int) int {
+	return m.(NoInlineHandler127).Handle(n)
+}
+
+type NoInlineHandler128 struct{}
+
+//go:noinline
+func (NoInlineHandler128) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler128 handles a NoInlineHandler128 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler128(m interface{}, n int) int {
+	return m.(NoInlineHandler128).Handle(n)
+}
+
+type NoInlineHandler129 struct{}
+
+//go:noinline
+func (NoInlineHandler129) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler129 handles a NoInlineHandler129 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler129(m interface{}, n int) int {
+	return m.(NoInlineHandler129).Handle(n)
+}
+
+type NoInlineHandler130 struct{}
+
+//go:noinline
+func (NoInlineHandler130) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler130 handles a NoInlineHandler130 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler130(m interface{}, n int) int {
+	return m.(NoInlineHandler130).Handle(n)
+}
+
+type NoInlineHandler131 struct{}
+
+//go:noinline
+func (NoInlineHandler131) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler131 handles a NoInlineHandler131 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler131(m interface{}, n int) int {
+	return m.(NoInlineHandler131).Handle(n)
+}
+
+type NoInlineHandler132 struct{}
+
+//go:noinline
+func (NoInlineHandler132) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler132 handles a NoInlineHandler132 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler132(m interface{}, n int) int {
+	return m.(NoInlineHandler132).Handle(n)
+}
+
+type NoInlineHandler133 struct{}
+
+//go:noinline
+func (NoInlineHandler133) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler133 handles a NoInlineHandler133 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler133(m interface{}, n int) int {
+	return m.(NoInlineHandler133).Handle(n)
+}
+
+type NoInlineHandler134 struct{}
+
+//go:noinline
+func (NoInlineHandler134) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler134 handles a NoInlineHandler134 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler134(m interface{}, n int) int {
+	return m.(NoInlineHandler134).Handle(n)
+}
+
+type NoInlineHandler135 struct{}
+
+//go:noinline
+func (NoInlineHandler135) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler135 handles a NoInlineHandler135 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler135(m interface{}, n int) int {
+	return m.(NoInlineHandler135).Handle(n)
+}
+
+type NoInlineHandler136 struct{}
+
+//go:noinline
+func (NoInlineHandler136) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler136 handles a NoInlineHandler136 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler136(m interface{}, n int) int {
+	return m.(NoInlineHandler136).Handle(n)
+}
+
+type NoInlineHandler137 struct{}
+
+//go:noinline
+func (NoInlineHandler137) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler137 handles a NoInlineHandler137 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler137(m interface{}, n int) int {
+	return m.(NoInlineHandler137).Handle(n)
+}
+
+type NoInlineHandler138 struct{}
+
+//go:noinline
+func (NoInlineHandler138) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler138 handles a NoInlineHandler138 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler138(m interface{}, n int) int {
+	return m.(NoInlineHandler138).Handle(n)
+}
+
+type NoInlineHandler139 struct{}
+
+//go:noinline
+func (NoInlineHandler139) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler139 handles a NoInlineHandler139 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler139(m interface{}, n int) int {
+	return m.(NoInlineHandler139).Handle(n)
+}
+
+type NoInlineHandler140 struct{}
+
+//go:noinline
+func (NoInlineHandler140) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler140 handles a NoInlineHandler140 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler140(m interface{}, n int) int {
+	return m.(NoInlineHandler140).Handle(n)
+}
+
+type NoInlineHandler141 struct{}
+
+//go:noinline
+func (NoInlineHandler141) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler141 handles a NoInlineHandler141 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler141(m interface{}, n int) int {
+	return m.(NoInlineHandler141).Handle(n)
+}
+
+type NoInlineHandler142 struct{}
+
+//go:noinline
+func (NoInlineHandler142) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler142 handles a NoInlineHandler142 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler142(m interface{}, n int) int {
+	return m.(NoInlineHandler142).Handle(n)
+}
+
+type NoInlineHandler143 struct{}
+
+//go:noinline
+func (NoInlineHandler143) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler143 handles a NoInlineHandler143 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler143(m interface{}, n int) int {
+	return m.(NoInlineHandler143).Handle(n)
+}
+
+type NoInlineHandler144 struct{}
+
+//go:noinline
+func (NoInlineHandler144) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler144 handles a NoInlineHandler144 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler144(m interface{}, n int) int {
+	return m.(NoInlineHandler144).Handle(n)
+}
+
+type NoInlineHandler145 struct{}
+
+//go:noinline
+func (NoInlineHandler145) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler145 handles a NoInlineHandler145 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler145(m interface{}, n int) int {
+	return m.(NoInlineHandler145).Handle(n)
+}
+
+type NoInlineHandler146 struct{}
+
+//go:noinline
+func (NoInlineHandler146) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler146 handles a NoInlineHandler146 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler146(m interface{}, n int) int {
+	return m.(NoInlineHandler146).Handle(n)
+}
+
+type NoInlineHandler147 struct{}
+
+//go:noinline
+func (NoInlineHandler147) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler147 handles a NoInlineHandler147 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler147(m interface{}, n int) int {
+	return m.(NoInlineHandler147).Handle(n)
+}
+
+type NoInlineHandler148 struct{}
+
+//go:noinline
+func (NoInlineHandler148) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler148 handles a NoInlineHandler148 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler148(m interface{}, n int) int {
+	return m.(NoInlineHandler148).Handle(n)
+}
+
+type NoInlineHandler149 struct{}
+
+//go:noinline
+func (NoInlineHandler149) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler149 handles a NoInlineHandler149 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler149(m interface{}, n int) int {
+	return m.(NoInlineHandler149).Handle(n)
+}
+
+type NoInlineHandler150 struct{}
+
+//go:noinline
+func (NoInlineHandler150) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler150 handles a NoInlineHandler150 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler150(m interface{}, n int) int {
+	return m.(NoInlineHandler150).Handle(n)
+}
+
+type NoInlineHandler151 struct{}
+
+//go:noinline
+func (NoInlineHandler151) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler151 handles a NoInlineHandler151 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler151(m interface{}, n int) int {
+	return m.(NoInlineHandler151).Handle(n)
+}
+
+type NoInlineHandler152 struct{}
+
+//go:noinline
+func (NoInlineHandler152) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler152 handles a NoInlineHandler152 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler152(m interface{}, n int) int {
+	return m.(NoInlineHandler152).Handle(n)
+}
+
+type NoInlineHandler153 struct{}
+
+//go:noinline
+func (NoInlineHandler153) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler153 handles a NoInlineHandler153 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler153(m interface{}, n int) int {
+	return m.(NoInlineHandler153).Handle(n)
+}
+
+type NoInlineHandler154 struct{}
+
+//go:noinline
+func (NoInlineHandler154) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler154 handles a NoInlineHandler154 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler154(m interface{}, n int) int {
+	return m.(NoInlineHandler154).Handle(n)
+}
+
+type NoInlineHandler155 struct{}
+
+//go:noinline
+func (NoInlineHandler155) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler155 handles a NoInlineHandler155 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler155(m interface{}, n int) int {
+	return m.(NoInlineHandler155).Handle(n)
+}
+
+type NoInlineHandler156 struct{}
+
+//go:noinline
+func (NoInlineHandler156) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler156 handles a NoInlineHandler156 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler156(m interface{}, n int) int {
+	return m.(NoInlineHandler156).Handle(n)
+}
+
+type NoInlineHandler157 struct{}
+
+//go:noinline
+func (NoInlineHandler157) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler157 handles a NoInlineHandler157 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler157(m interface{}, n int) int {
+	return m.(NoInlineHandler157).Handle(n)
+}
+
+type NoInlineHandler158 struct{}
+
+//go:noinline
+func (NoInlineHandler158) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler158 handles a NoInlineHandler158 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler158(m interface{}, n int) int {
+	return m.(NoInlineHandler158).Handle(n)
+}
+
+type NoInlineHandler159 struct{}
+
+//go:noinline
+func (NoInlineHandler159) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler159 handles a NoInlineHandler159 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler159(m interface{}, n int) int {
+	return m.(NoInlineHandler159).Handle(n)
+}
+
+type NoInlineHandler160 struct{}
+
+//go:noinline
+func (NoInlineHandler160) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler160 handles a NoInlineHandler160 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler160(m interface{}, n int) int {
+	return m.(NoInlineHandler160).Handle(n)
+}
+
+type NoInlineHandler161 struct{}
+
+//go:noinline
+func (NoInlineHandler161) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler161 handles a NoInlineHandler161 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler161(m interface{}, n int) int {
+	return m.(NoInlineHandler161).Handle(n)
+}
+
+type NoInlineHandler162 struct{}
+
+//go:noinline
+func (NoInlineHandler162) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler162 handles a NoInlineHandler162 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler162(m interface{}, n int) int {
+	return m.(NoInlineHandler162).Handle(n)
+}
+
+type NoInlineHandler163 struct{}
+
+//go:noinline
+func (NoInlineHandler163) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler163 handles a NoInlineHandler163 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler163(m interface{}, n int) int {
+	return m.(NoInlineHandler163).Handle(n)
+}
+
+type NoInlineHandler164 struct{}
+
+//go:noinline
+func (NoInlineHandler164) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler164 handles a NoInlineHandler164 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler164(m interface{}, n int) int {
+	return m.(NoInlineHandler164).Handle(n)
+}
+
+type NoInlineHandler165 struct{}
+
+//go:noinline
+func (NoInlineHandler165) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler165 handles a NoInlineHandler165 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler165(m interface{}, n int) int {
+	return m.(NoInlineHandler165).Handle(n)
+}
+
+type NoInlineHandler166 struct{}
+
+//go:noinline
+func (NoInlineHandler166) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler166 handles a NoInlineHandler166 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler166(m interface{}, n int) int {
+	return m.(NoInlineHandler166).Handle(n)
+}
+
+type NoInlineHandler167 struct{}
+
+//go:noinline
+func (NoInlineHandler167) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler167 handles a NoInlineHandler167 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler167(m interface{}, n int) int {
+	return m.(NoInlineHandler167).Handle(n)
+}
+
+type NoInlineHandler168 struct{}
+
+//go:noinline
+func (NoInlineHandler168) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler168 handles a NoInlineHandler168 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler168(m interface{}, n int) int {
+	return m.(NoInlineHandler168).Handle(n)
+}
+
+type NoInlineHandler169 struct{}
+
+//go:noinline
+func (NoInlineHandler169) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler169 handles a NoInlineHandler169 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler169(m interface{}, n int) int {
+	return m.(NoInlineHandler169).Handle(n)
+}
+
+type NoInlineHandler170 struct{}
+
+//go:noinline
+func (NoInlineHandler170) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler170 handles a NoInlineHandler170 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler170(m interface{}, n int) int {
+	return m.(NoInlineHandler170).Handle(n)
+}
+
+type NoInlineHandler171 struct{}
+
+//go:noinline
+func (NoInlineHandler171) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler171 handles a NoInlineHandler171 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler171(m interface{}, n int) int {
+	return m.(NoInlineHandler171).Handle(n)
+}
+
+type NoInlineHandler172 struct{}
+
+//go:noinline
+func (NoInlineHandler172) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler172 handles a NoInlineHandler172 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler172(m interface{}, n int) int {
+	return m.(NoInlineHandler172).Handle(n)
+}
+
+type NoInlineHandler173 struct{}
+
+//go:noinline
+func (NoInlineHandler173) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler173 handles a NoInlineHandler173 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler173(m interface{}, n int) int {
+	return m.(NoInlineHandler173).Handle(n)
+}
+
+type NoInlineHandler174 struct{}
+
+//go:noinline
+func (NoInlineHandler174) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler174 handles a NoInlineHandler174 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler174(m interface{}, n int) int {
+	return m.(NoInlineHandler174).Handle(n)
+}
+
+type NoInlineHandler175 struct{}
+
+//go:noinline
+func (NoInlineHandler175) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler175 handles a NoInlineHandler175 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler175(m interface{}, n int) int {
+	return m.(NoInlineHandler175).Handle(n)
+}
+
+type NoInlineHandler176 struct{}
+
+//go:noinline
+func (NoInlineHandler176) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler176 handles a NoInlineHandler176 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler176(m interface{}, n int) int {
+	return m.(NoInlineHandler176).Handle(n)
+}
+
+type NoInlineHandler177 struct{}
+
+//go:noinline
+func (NoInlineHandler177) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler177 handles a NoInlineHandler177 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler177(m interface{}, n int) int {
+	return m.(NoInlineHandler177).Handle(n)
+}
+
+type NoInlineHandler178 struct{}
+
+//go:noinline
+func (NoInlineHandler178) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler178 handles a NoInlineHandler178 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler178(m interface{}, n int) int {
+	return m.(NoInlineHandler178).Handle(n)
+}
+
+type NoInlineHandler179 struct{}
+
+//go:noinline
+func (NoInlineHandler179) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler179 handles a NoInlineHandler179 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler179(m interface{}, n int) int {
+	return m.(NoInlineHandler179).Handle(n)
+}
+
+type NoInlineHandler180 struct{}
+
+//go:noinline
+func (NoInlineHandler180) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler180 handles a NoInlineHandler180 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler180(m interface{}, n int) int {
+	return m.(NoInlineHandler180).Handle(n)
+}
+
+type NoInlineHandler181 struct{}
+
+//go:noinline
+func (NoInlineHandler181) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler181 handles a NoInlineHandler181 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler181(m interface{}, n int) int {
+	return m.(NoInlineHandler181).Handle(n)
+}
+
+type NoInlineHandler182 struct{}
+
+//go:noinline
+func (NoInlineHandler182) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler182 handles a NoInlineHandler182 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler182(m interface{}, n int) int {
+	return m.(NoInlineHandler182).Handle(n)
+}
+
+type NoInlineHandler183 struct{}
+
+//go:noinline
+func (NoInlineHandler183) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler183 handles a NoInlineHandler183 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler183(m interface{}, n int) int {
+	return m.(NoInlineHandler183).Handle(n)
+}
+
+type NoInlineHandler184 struct{}
+
+//go:noinline
+func (NoInlineHandler184) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler184 handles a NoInlineHandler184 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler184(m interface{}, n int) int {
+	return m.(NoInlineHandler184).Handle(n)
+}
+
+type NoInlineHandler185 struct{}
+
+//go:noinline
+func (NoInlineHandler185) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler185 handles a NoInlineHandler185 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler185(m interface{}, n int) int {
+	return m.(NoInlineHandler185).Handle(n)
+}
+
+type NoInlineHandler186 struct{}
+
+//go:noinline
+func (NoInlineHandler186) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler186 handles a NoInlineHandler186 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler186(m interface{}, n int) int {
+	return m.(NoInlineHandler186).Handle(n)
+}
+
+type NoInlineHandler187 struct{}
+
+//go:noinline
+func (NoInlineHandler187) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler187 handles a NoInlineHandler187 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler187(m interface{}, n int) int {
+	return m.(NoInlineHandler187).Handle(n)
+}
+
+type NoInlineHandler188 struct{}
+
+//go:noinline
+func (NoInlineHandler188) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler188 handles a NoInlineHandler188 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler188(m interface{}, n int) int {
+	return m.(NoInlineHandler188).Handle(n)
+}
+
+type NoInlineHandler189 struct{}
+
+//go:noinline
+func (NoInlineHandler189) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler189 handles a NoInlineHandler189 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler189(m interface{}, n int) int {
+	return m.(NoInlineHandler189).Handle(n)
+}
+
+type NoInlineHandler190 struct{}
+
+//go:noinline
+func (NoInlineHandler190) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler190 handles a NoInlineHandler190 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler190(m interface{}, n int) int {
+	return m.(NoInlineHandler190).Handle(n)
+}
+
+type NoInlineHandler191 struct{}
+
+//go:noinline
+func (NoInlineHandler191) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler191 handles a NoInlineHandler191 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler191(m interface{}, n int) int {
+	return m.(NoInlineHandler191).Handle(n)
+}
+
+type NoInlineHandler192 struct{}
+
+//go:noinline
+func (NoInlineHandler192) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler192 handles a NoInlineHandler192 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler192(m interface{}, n int) int {
+	return m.(NoInlineHandler192).Handle(n)
+}
+
+type NoInlineHandler193 struct{}
+
+//go:noinline
+func (NoInlineHandler193) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler193 handles a NoInlineHandler193 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler193(m interface{}, n int) int {
+	return m.(NoInlineHandler193).Handle(n)
+}
+
+type NoInlineHandler194 struct{}
+
+//go:noinline
+func (NoInlineHandler194) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler194 handles a NoInlineHandler194 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler194(m interface{}, n int) int {
+	return m.(NoInlineHandler194).Handle(n)
+}
+
+type NoInlineHandler195 struct{}
+
+//go:noinline
+func (NoInlineHandler195) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler195 handles a NoInlineHandler195 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler195(m interface{}, n int) int {
+	return m.(NoInlineHandler195).Handle(n)
+}
+
+type NoInlineHandler196 struct{}
+
+//go:noinline
+func (NoInlineHandler196) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler196 handles a NoInlineHandler196 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler196(m interface{}, n int) int {
+	return m.(NoInlineHandler196).Handle(n)
+}
+
+type NoInlineHandler197 struct{}
+
+//go:noinline
+func (NoInlineHandler197) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler197 handles a NoInlineHandler197 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler197(m interface{}, n int) int {
+	return m.(NoInlineHandler197).Handle(n)
+}
+
+type NoInlineHandler198 struct{}
+
+//go:noinline
+func (NoInlineHandler198) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler198 handles a NoInlineHandler198 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler198(m interface{}, n int) int {
+	return m.(NoInlineHandler198).Handle(n)
+}
+
+type NoInlineHandler199 struct{}
+
+//go:noinline
+func (NoInlineHandler199) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler199 handles a NoInlineHandler199 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler199(m interface{}, n int) int {
+	return m.(NoInlineHandler199).Handle(n)
+}
+
+type NoInlineHandler200 struct{}
+
+//go:noinline
+func (NoInlineHandler200) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler200 handles a NoInlineHandler200 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler200(m interface{}, n int) int {
+	return m.(NoInlineHandler200).Handle(n)
+}
+
+type NoInlineHandler201 struct{}
+
+//go:noinline
+func (NoInlineHandler201) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler201 handles a NoInlineHandler201 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler201(m interface{}, n int) int {
+	return m.(NoInlineHandler201).Handle(n)
+}
+
+type NoInlineHandler202 struct{}
+
+//go:noinline
+func (NoInlineHandler202) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler202 handles a NoInlineHandler202 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler202(m interface{}, n int) int {
+	return m.(NoInlineHandler202).Handle(n)
+}
+
+type NoInlineHandler203 struct{}
+
+//go:noinline
+func (NoInlineHandler203) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler203 handles a NoInlineHandler203 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler203(m interface{}, n int) int {
+	return m.(NoInlineHandler203).Handle(n)
+}
+
+type NoInlineHandler204 struct{}
+
+//go:noinline
+func (NoInlineHandler204) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler204 handles a NoInlineHandler204 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler204(m interface{}, n int) int {
+	return m.(NoInlineHandler204).Handle(n)
+}
+
+type NoInlineHandler205 struct{}
+
+//go:noinline
+func (NoInlineHandler205) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler205 handles a NoInlineHandler205 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler205(m interface{}, n int) int {
+	return m.(NoInlineHandler205).Handle(n)
+}
+
+type NoInlineHandler206 struct{}
+
+//go:noinline
+func (NoInlineHandler206) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler206 handles a NoInlineHandler206 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler206(m interface{}, n int) int {
+	return m.(NoInlineHandler206).Handle(n)
+}
+
+type NoInlineHandler207 struct{}
+
+//go:noinline
+func (NoInlineHandler207) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler207 handles a NoInlineHandler207 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler207(m interface{}, n int) int {
+	return m.(NoInlineHandler207).Handle(n)
+}
+
+type NoInlineHandler208 struct{}
+
+//go:noinline
+func (NoInlineHandler208) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler208 handles a NoInlineHandler208 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler208(m interface{}, n int) int {
+	return m.(NoInlineHandler208).Handle(n)
+}
+
+type NoInlineHandler209 struct{}
+
+//go:noinline
+func (NoInlineHandler209) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler209 handles a NoInlineHandler209 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler209(m interface{}, n int) int {
+	return m.(NoInlineHandler209).Handle(n)
+}
+
+type NoInlineHandler210 struct{}
+
+//go:noinline
+func (NoInlineHandler210) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler210 handles a NoInlineHandler210 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler210(m interface{}, n int) int {
+	return m.(NoInlineHandler210).Handle(n)
+}
+
+type NoInlineHandler211 struct{}
+
+//go:noinline
+func (NoInlineHandler211) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler211 handles a NoInlineHandler211 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler211(m interface{}, n int) int {
+	return m.(NoInlineHandler211).Handle(n)
+}
+
+type NoInlineHandler212 struct{}
+
+//go:noinline
+func (NoInlineHandler212) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler212 handles a NoInlineHandler212 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler212(m interface{}, n int) int {
+	return m.(NoInlineHandler212).Handle(n)
+}
+
+type NoInlineHandler213 struct{}
+
+//go:noinline
+func (NoInlineHandler213) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler213 handles a NoInlineHandler213 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler213(m interface{}, n int) int {
+	return m.(NoInlineHandler213).Handle(n)
+}
+
+type NoInlineHandler214 struct{}
+
+//go:noinline
+func (NoInlineHandler214) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler214 handles a NoInlineHandler214 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler214(m interface{}, n int) int {
+	return m.(NoInlineHandler214).Handle(n)
+}
+
+type NoInlineHandler215 struct{}
+
+//go:noinline
+func (NoInlineHandler215) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler215 handles a NoInlineHandler215 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler215(m interface{}, n int) int {
+	return m.(NoInlineHandler215).Handle(n)
+}
+
+type NoInlineHandler216 struct{}
+
+//go:noinline
+func (NoInlineHandler216) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler216 handles a NoInlineHandler216 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler216(m interface{}, n int) int {
+	return m.(NoInlineHandler216).Handle(n)
+}
+
+type NoInlineHandler217 struct{}
+
+//go:noinline
+func (NoInlineHandler217) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler217 handles a NoInlineHandler217 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler217(m interface{}, n int) int {
+	return m.(NoInlineHandler217).Handle(n)
+}
+
+type NoInlineHandler218 struct{}
+
+//go:noinline
+func (NoInlineHandler218) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler218 handles a NoInlineHandler218 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler218(m interface{}, n int) int {
+	return m.(NoInlineHandler218).Handle(n)
+}
+
+type NoInlineHandler219 struct{}
+
+//go:noinline
+func (NoInlineHandler219) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler219 handles a NoInlineHandler219 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler219(m interface{}, n int) int {
+	return m.(NoInlineHandler219).Handle(n)
+}
+
+type NoInlineHandler220 struct{}
+
+//go:noinline
+func (NoInlineHandler220) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler220 handles a NoInlineHandler220 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler220(m interface{}, n int) int {
+	return m.(NoInlineHandler220).Handle(n)
+}
+
+type NoInlineHandler221 struct{}
+
+//go:noinline
+func (NoInlineHandler221) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler221 handles a NoInlineHandler221 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler221(m interface{}, n int) int {
+	return m.(NoInlineHandler221).Handle(n)
+}
+
+type NoInlineHandler222 struct{}
+
+//go:noinline
+func (NoInlineHandler222) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler222 handles a NoInlineHandler222 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler222(m interface{}, n int) int {
+	return m.(NoInlineHandler222).Handle(n)
+}
+
+type NoInlineHandler223 struct{}
+
+//go:noinline
+func (NoInlineHandler223) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler223 handles a NoInlineHandler223 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler223(m interface{}, n int) int {
+	return m.(NoInlineHandler223).Handle(n)
+}
+
+type NoInlineHandler224 struct{}
+
+//go:noinline
+func (NoInlineHandler224) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler224 handles a NoInlineHandler224 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler224(m interface{}, n int) int {
+	return m.(NoInlineHandler224).Handle(n)
+}
+
+type NoInlineHandler225 struct{}
+
+//go:noinline
+func (NoInlineHandler225) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler225 handles a NoInlineHandler225 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler225(m interface{}, n int) int {
+	return m.(NoInlineHandler225).Handle(n)
+}
+
+type NoInlineHandler226 struct{}
+
+//go:noinline
+func (NoInlineHandler226) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler226 handles a NoInlineHandler226 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler226(m interface{}, n int) int {
+	return m.(NoInlineHandler226).Handle(n)
+}
+
+type NoInlineHandler227 struct{}
+
+//go:noinline
+func (NoInlineHandler227) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler227 handles a NoInlineHandler227 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler227(m interface{}, n int) int {
+	return m.(NoInlineHandler227).Handle(n)
+}
+
+type NoInlineHandler228 struct{}
+
+//go:noinline
+func (NoInlineHandler228) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler228 handles a NoInlineHandler228 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler228(m interface{}, n int) int {
+	return m.(NoInlineHandler228).Handle(n)
+}
+
+type NoInlineHandler229 struct{}
+
+//go:noinline
+func (NoInlineHandler229) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler229 handles a NoInlineHandler229 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler229(m interface{}, n int) int {
+	return m.(NoInlineHandler229).Handle(n)
+}
+
+type NoInlineHandler230 struct{}
+
+//go:noinline
+func (NoInlineHandler230) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler230 handles a NoInlineHandler230 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler230(m interface{}, n int) int {
+	return m.(NoInlineHandler230).Handle(n)
+}
+
+type NoInlineHandler231 struct{}
+
+//go:noinline
+func (NoInlineHandler231) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler231 handles a NoInlineHandler231 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler231(m interface{}, n int) int {
+	return m.(NoInlineHandler231).Handle(n)
+}
+
+type NoInlineHandler232 struct{}
+
+//go:noinline
+func (NoInlineHandler232) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler232 handles a NoInlineHandler232 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler232(m interface{}, n int) int {
+	return m.(NoInlineHandler232).Handle(n)
+}
+
+type NoInlineHandler233 struct{}
+
+//go:noinline
+func (NoInlineHandler233) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler233 handles a NoInlineHandler233 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler233(m interface{}, n int) int {
+	return m.(NoInlineHandler233).Handle(n)
+}
+
+type NoInlineHandler234 struct{}
+
+//go:noinline
+func (NoInlineHandler234) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler234 handles a NoInlineHandler234 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler234(m interface{}, n int) int {
+	return m.(NoInlineHandler234).Handle(n)
+}
+
+type NoInlineHandler235 struct{}
+
+//go:noinline
+func (NoInlineHandler235) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler235 handles a NoInlineHandler235 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler235(m interface{}, n int) int {
+	return m.(NoInlineHandler235).Handle(n)
+}
+
+type NoInlineHandler236 struct{}
+
+//go:noinline
+func (NoInlineHandler236) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler236 handles a NoInlineHandler236 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler236(m interface{}, n int) int {
+	return m.(NoInlineHandler236).Handle(n)
+}
+
+type NoInlineHandler237 struct{}
+
+//go:noinline
+func (NoInlineHandler237) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler237 handles a NoInlineHandler237 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler237(m interface{}, n int) int {
+	return m.(NoInlineHandler237).Handle(n)
+}
+
+type NoInlineHandler238 struct{}
+
+//go:noinline
+func (NoInlineHandler238) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler238 handles a NoInlineHandler238 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler238(m interface{}, n int) int {
+	return m.(NoInlineHandler238).Handle(n)
+}
+
+type NoInlineHandler239 struct{}
+
+//go:noinline
+func (NoInlineHandler239) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler239 handles a NoInlineHandler239 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler239(m interface{}, n int) int {
+	return m.(NoInlineHandler239).Handle(n)
+}
+
+type NoInlineHandler240 struct{}
+
+//go:noinline
+func (NoInlineHandler240) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler240 handles a NoInlineHandler240 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler240(m interface{}, n int) int {
+	return m.(NoInlineHandler240).Handle(n)
+}
+
+type NoInlineHandler241 struct{}
+
+//go:noinline
+func (NoInlineHandler241) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler241 handles a NoInlineHandler241 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler241(m interface{}, n int) int {
+	return m.(NoInlineHandler241).Handle(n)
+}
+
+type NoInlineHandler242 struct{}
+
+//go:noinline
+func (NoInlineHandler242) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler242 handles a NoInlineHandler242 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler242(m interface{}, n int) int {
+	return m.(NoInlineHandler242).Handle(n)
+}
+
+type NoInlineHandler243 struct{}
+
+//go:noinline
+func (NoInlineHandler243) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler243 handles a NoInlineHandler243 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler243(m interface{}, n int) int {
+	return m.(NoInlineHandler243).Handle(n)
+}
+
+type NoInlineHandler244 struct{}
+
+//go:noinline
+func (NoInlineHandler244) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler244 handles a NoInlineHandler244 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler244(m interface{}, n int) int {
+	return m.(NoInlineHandler244).Handle(n)
+}
+
+type NoInlineHandler245 struct{}
+
+//go:noinline
+func (NoInlineHandler245) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler245 handles a NoInlineHandler245 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler245(m interface{}, n int) int {
+	return m.(NoInlineHandler245).Handle(n)
+}
+
+type NoInlineHandler246 struct{}
+
+//go:noinline
+func (NoInlineHandler246) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler246 handles a NoInlineHandler246 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler246(m interface{}, n int) int {
+	return m.(NoInlineHandler246).Handle(n)
+}
+
+type NoInlineHandler247 struct{}
+
+//go:noinline
+func (NoInlineHandler247) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler247 handles a NoInlineHandler247 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler247(m interface{}, n int) int {
+	return m.(NoInlineHandler247).Handle(n)
+}
+
+type NoInlineHandler248 struct{}
+
+//go:noinline
+func (NoInlineHandler248) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler248 handles a NoInlineHandler248 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler248(m interface{}, n int) int {
+	return m.(NoInlineHandler248).Handle(n)
+}
+
+type NoInlineHandler249 struct{}
+
+//go:noinline
+func (NoInlineHandler249) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler249 handles a NoInlineHandler249 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler249(m interface{}, n int) int {
+	return m.(NoInlineHandler249).Handle(n)
+}
+
+type NoInlineHandler250 struct{}
+
+//go:noinline
+func (NoInlineHandler250) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler250 handles a NoInlineHandler250 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler250(m interface{}, n int) int {
+	return m.(NoInlineHandler250).Handle(n)
+}
+
+type NoInlineHandler251 struct{}
+
+//go:noinline
+func (NoInlineHandler251) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler251 handles a NoInlineHandler251 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler251(m interface{}, n int) int {
+	return m.(NoInlineHandler251).Handle(n)
+}
+
+type NoInlineHandler252 struct{}
+
+//go:noinline
+func (NoInlineHandler252) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler252 handles a NoInlineHandler252 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler252(m interface{}, n int) int {
+	return m.(NoInlineHandler252).Handle(n)
+}
+
+type NoInlineHandler253 struct{}
+
+//go:noinline
+func (NoInlineHandler253) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler253 handles a NoInlineHandler253 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler253(m interface{}, n int) int {
+	return m.(NoInlineHandler253).Handle(n)
+}
+
+type NoInlineHandler254 struct{}
+
+//go:noinline
+func (NoInlineHandler254) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler254 handles a NoInlineHandler254 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler254(m interface{}, n int) int {
+	return m.(NoInlineHandler254).Handle(n)
+}
+
+type NoInlineHandler255 struct{}
+
+//go:noinline
+func (NoInlineHandler255) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler255 handles a NoInlineHandler255 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler255(m interface{}, n int) int {
+	return m.(NoInlineHandler255).Handle(n)
+}
+
+type NoInlineHandler256 struct{}
+
+//go:noinline
+func (NoInlineHandler256) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler256 handles a NoInlineHandler256 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler256(m interface{}, n int) int {
+	return m.(NoInlineHandler256).Handle(n)
+}
+
+type NoInlineHandler257 struct{}
+
+//go:noinline
+func (NoInlineHandler257) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler257 handles a NoInlineHandler257 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler257(m interface{}, n int) int {
+	return m.(NoInlineHandler257).Handle(n)
+}
+
+type NoInlineHandler258 struct{}
+
+//go:noinline
+func (NoInlineHandler258) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler258 handles a NoInlineHandler258 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler258(m interface{}, n int) int {
+	return m.(NoInlineHandler258).Handle(n)
+}
+
+type NoInlineHandler259 struct{}
+
+//go:noinline
+func (NoInlineHandler259) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler259 handles a NoInlineHandler259 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler259(m interface{}, n int) int {
+	return m.(NoInlineHandler259).Handle(n)
+}
+
+type NoInlineHandler260 struct{}
+
+//go:noinline
+func (NoInlineHandler260) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler260 handles a NoInlineHandler260 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler260(m interface{}, n int) int {
+	return m.(NoInlineHandler260).Handle(n)
+}
+
+type NoInlineHandler261 struct{}
+
+//go:noinline
+func (NoInlineHandler261) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler261 handles a NoInlineHandler261 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler261(m interface{}, n int) int {
+	return m.(NoInlineHandler261).Handle(n)
+}
+
+type NoInlineHandler262 struct{}
+
+//go:noinline
+func (NoInlineHandler262) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler262 handles a NoInlineHandler262 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler262(m interface{}, n int) int {
+	return m.(NoInlineHandler262).Handle(n)
+}
+
+type NoInlineHandler263 struct{}
+
+//go:noinline
+func (NoInlineHandler263) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler263 handles a NoInlineHandler263 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler263(m interface{}, n int) int {
+	return m.(NoInlineHandler263).Handle(n)
+}
+
+type NoInlineHandler264 struct{}
+
+//go:noinline
+func (NoInlineHandler264) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler264 handles a NoInlineHandler264 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler264(m interface{}, n int) int {
+	return m.(NoInlineHandler264).Handle(n)
+}
+
+type NoInlineHandler265 struct{}
+
+//go:noinline
+func (NoInlineHandler265) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler265 handles a NoInlineHandler265 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler265(m interface{}, n int) int {
+	return m.(NoInlineHandler265).Handle(n)
+}
+
+type NoInlineHandler266 struct{}
+
+//go:noinline
+func (NoInlineHandler266) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler266 handles a NoInlineHandler266 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler266(m interface{}, n int) int {
+	return m.(NoInlineHandler266).Handle(n)
+}
+
+type NoInlineHandler267 struct{}
+
+//go:noinline
+func (NoInlineHandler267) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler267 handles a NoInlineHandler267 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler267(m interface{}, n int) int {
+	return m.(NoInlineHandler267).Handle(n)
+}
+
+type NoInlineHandler268 struct{}
+
+//go:noinline
+func (NoInlineHandler268) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler268 handles a NoInlineHandler268 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler268(m interface{}, n int) int {
+	return m.(NoInlineHandler268).Handle(n)
+}
+
+type NoInlineHandler269 struct{}
+
+//go:noinline
+func (NoInlineHandler269) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler269 handles a NoInlineHandler269 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler269(m interface{}, n int) int {
+	return m.(NoInlineHandler269).Handle(n)
+}
+
+type NoInlineHandler270 struct{}
+
+//go:noinline
+func (NoInlineHandler270) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler270 handles a NoInlineHandler270 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler270(m interface{}, n int) int {
+	return m.(NoInlineHandler270).Handle(n)
+}
+
+type NoInlineHandler271 struct{}
+
+//go:noinline
+func (NoInlineHandler271) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler271 handles a NoInlineHandler271 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler271(m interface{}, n int) int {
+	return m.(NoInlineHandler271).Handle(n)
+}
+
+type NoInlineHandler272 struct{}
+
+//go:noinline
+func (NoInlineHandler272) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler272 handles a NoInlineHandler272 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler272(m interface{}, n int) int {
+	return m.(NoInlineHandler272).Handle(n)
+}
+
+type NoInlineHandler273 struct{}
+
+//go:noinline
+func (NoInlineHandler273) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler273 handles a NoInlineHandler273 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler273(m interface{}, n int) int {
+	return m.(NoInlineHandler273).Handle(n)
+}
+
+type NoInlineHandler274 struct{}
+
+//go:noinline
+func (NoInlineHandler274) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler274 handles a NoInlineHandler274 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler274(m interface{}, n int) int {
+	return m.(NoInlineHandler274).Handle(n)
+}
+
+type NoInlineHandler275 struct{}
+
+//go:noinline
+func (NoInlineHandler275) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler275 handles a NoInlineHandler275 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler275(m interface{}, n int) int {
+	return m.(NoInlineHandler275).Handle(n)
+}
+
+type NoInlineHandler276 struct{}
+
+//go:noinline
+func (NoInlineHandler276) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler276 handles a NoInlineHandler276 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler276(m interface{}, n int) int {
+	return m.(NoInlineHandler276).Handle(n)
+}
+
+type NoInlineHandler277 struct{}
+
+//go:noinline
+func (NoInlineHandler277) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler277 handles a NoInlineHandler277 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler277(m interface{}, n int) int {
+	return m.(NoInlineHandler277).Handle(n)
+}
+
+type NoInlineHandler278 struct{}
+
+//go:noinline
+func (NoInlineHandler278) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler278 handles a NoInlineHandler278 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler278(m interface{}, n int) int {
+	return m.(NoInlineHandler278).Handle(n)
+}
+
+type NoInlineHandler279 struct{}
+
+//go:noinline
+func (NoInlineHandler279) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler279 handles a NoInlineHandler279 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler279(m interface{}, n int) int {
+	return m.(NoInlineHandler279).Handle(n)
+}
+
+type NoInlineHandler280 struct{}
+
+//go:noinline
+func (NoInlineHandler280) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler280 handles a NoInlineHandler280 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler280(m interface{}, n int) int {
+	return m.(NoInlineHandler280).Handle(n)
+}
+
+type NoInlineHandler281 struct{}
+
+//go:noinline
+func (NoInlineHandler281) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler281 handles a NoInlineHandler281 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler281(m interface{}, n int) int {
+	return m.(NoInlineHandler281).Handle(n)
+}
+
+type NoInlineHandler282 struct{}
+
+//go:noinline
+func (NoInlineHandler282) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler282 handles a NoInlineHandler282 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler282(m interface{}, n int) int {
+	return m.(NoInlineHandler282).Handle(n)
+}
+
+type NoInlineHandler283 struct{}
+
+//go:noinline
+func (NoInlineHandler283) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler283 handles a NoInlineHandler283 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler283(m interface{}, n int) int {
+	return m.(NoInlineHandler283).Handle(n)
+}
+
+type NoInlineHandler284 struct{}
+
+//go:noinline
+func (NoInlineHandler284) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler284 handles a NoInlineHandler284 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler284(m interface{}, n int) int {
+	return m.(NoInlineHandler284).Handle(n)
+}
+
+type NoInlineHandler285 struct{}
+
+//go:noinline
+func (NoInlineHandler285) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler285 handles a NoInlineHandler285 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler285(m interface{}, n int) int {
+	return m.(NoInlineHandler285).Handle(n)
+}
+
+type NoInlineHandler286 struct{}
+
+//go:noinline
+func (NoInlineHandler286) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler286 handles a NoInlineHandler286 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler286(m interface{}, n int) int {
+	return m.(NoInlineHandler286).Handle(n)
+}
+
+type NoInlineHandler287 struct{}
+
+//go:noinline
+func (NoInlineHandler287) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler287 handles a NoInlineHandler287 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler287(m interface{}, n int) int {
+	return m.(NoInlineHandler287).Handle(n)
+}
+
+type NoInlineHandler288 struct{}
+
+//go:noinline
+func (NoInlineHandler288) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler288 handles a NoInlineHandler288 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler288(m interface{}, n int) int {
+	return m.(NoInlineHandler288).Handle(n)
+}
+
+type NoInlineHandler289 struct{}
+
+//go:noinline
+func (NoInlineHandler289) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler289 handles a NoInlineHandler289 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler289(m interface{}, n int) int {
+	return m.(NoInlineHandler289).Handle(n)
+}
+
+type NoInlineHandler290 struct{}
+
+//go:noinline
+func (NoInlineHandler290) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler290 handles a NoInlineHandler290 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler290(m interface{}, n int) int {
+	return m.(NoInlineHandler290).Handle(n)
+}
+
+type NoInlineHandler291 struct{}
+
+//go:noinline
+func (NoInlineHandler291) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler291 handles a NoInlineHandler291 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler291(m interface{}, n int) int {
+	return m.(NoInlineHandler291).Handle(n)
+}
+
+type NoInlineHandler292 struct{}
+
+//go:noinline
+func (NoInlineHandler292) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler292 handles a NoInlineHandler292 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler292(m interface{}, n int) int {
+	return m.(NoInlineHandler292).Handle(n)
+}
+
+type NoInlineHandler293 struct{}
+
+//go:noinline
+func (NoInlineHandler293) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler293 handles a NoInlineHandler293 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler293(m interface{}, n int) int {
+	return m.(NoInlineHandler293).Handle(n)
+}
+
+type NoInlineHandler294 struct{}
+
+//go:noinline
+func (NoInlineHandler294) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler294 handles a NoInlineHandler294 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler294(m interface{}, n int) int {
+	return m.(NoInlineHandler294).Handle(n)
+}
+
+type NoInlineHandler295 struct{}
+
+//go:noinline
+func (NoInlineHandler295) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler295 handles a NoInlineHandler295 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler295(m interface{}, n int) int {
+	return m.(NoInlineHandler295).Handle(n)
+}
+
+type NoInlineHandler296 struct{}
+
+//go:noinline
+func (NoInlineHandler296) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler296 handles a NoInlineHandler296 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler296(m interface{}, n int) int {
+	return m.(NoInlineHandler296).Handle(n)
+}
+
+type NoInlineHandler297 struct{}
+
+//go:noinline
+func (NoInlineHandler297) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler297 handles a NoInlineHandler297 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler297(m interface{}, n int) int {
+	return m.(NoInlineHandler297).Handle(n)
+}
+
+type NoInlineHandler298 struct{}
+
+//go:noinline
+func (NoInlineHandler298) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler298 handles a NoInlineHandler298 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler298(m interface{}, n int) int {
+	return m.(NoInlineHandler298).Handle(n)
+}
+
+type NoInlineHandler299 struct{}
+
+//go:noinline
+func (NoInlineHandler299) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler299 handles a NoInlineHandler299 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler299(m interface{}, n int) int {
+	return m.(NoInlineHandler299).Handle(n)
+}
+
+type NoInlineHandler300 struct{}
+
+//go:noinline
+func (NoInlineHandler300) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler300 handles a NoInlineHandler300 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler300(m interface{}, n int) int {
+	return m.(NoInlineHandler300).Handle(n)
+}
+
+type NoInlineHandler301 struct{}
+
+//go:noinline
+func (NoInlineHandler301) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler301 handles a NoInlineHandler301 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler301(m interface{}, n int) int {
+	return m.(NoInlineHandler301).Handle(n)
+}
+
+type NoInlineHandler302 struct{}
+
+//go:noinline
+func (NoInlineHandler302) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler302 handles a NoInlineHandler302 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler302(m interface{}, n int) int {
+	return m.(NoInlineHandler302).Handle(n)
+}
+
+type NoInlineHandler303 struct{}
+
+//go:noinline
+func (NoInlineHandler303) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler303 handles a NoInlineHandler303 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler303(m interface{}, n int) int {
+	return m.(NoInlineHandler303).Handle(n)
+}
+
+type NoInlineHandler304 struct{}
+
+//go:noinline
+func (NoInlineHandler304) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler304 handles a NoInlineHandler304 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler304(m interface{}, n int) int {
+	return m.(NoInlineHandler304).Handle(n)
+}
+
+type NoInlineHandler305 struct{}
+
+//go:noinline
+func (NoInlineHandler305) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler305 handles a NoInlineHandler305 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler305(m interface{}, n int) int {
+	return m.(NoInlineHandler305).Handle(n)
+}
+
+type NoInlineHandler306 struct{}
+
+//go:noinline
+func (NoInlineHandler306) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler306 handles a NoInlineHandler306 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler306(m interface{}, n int) int {
+	return m.(NoInlineHandler306).Handle(n)
+}
+
+type NoInlineHandler307 struct{}
+
+//go:noinline
+func (NoInlineHandler307) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler307 handles a NoInlineHandler307 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler307(m interface{}, n int) int {
+	return m.(NoInlineHandler307).Handle(n)
+}
+
+type NoInlineHandler308 struct{}
+
+//go:noinline
+func (NoInlineHandler308) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler308 handles a NoInlineHandler308 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler308(m interface{}, n int) int {
+	return m.(NoInlineHandler308).Handle(n)
+}
+
+type NoInlineHandler309 struct{}
+
+//go:noinline
+func (NoInlineHandler309) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler309 handles a NoInlineHandler309 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler309(m interface{}, n int) int {
+	return m.(NoInlineHandler309).Handle(n)
+}
+
+type NoInlineHandler310 struct{}
+
+//go:noinline
+func (NoInlineHandler310) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler310 handles a NoInlineHandler310 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler310(m interface{}, n int) int {
+	return m.(NoInlineHandler310).Handle(n)
+}
+
+type NoInlineHandler311 struct{}
+
+//go:noinline
+func (NoInlineHandler311) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler311 handles a NoInlineHandler311 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler311(m interface{}, n int) int {
+	return m.(NoInlineHandler311).Handle(n)
+}
+
+type NoInlineHandler312 struct{}
+
+//go:noinline
+func (NoInlineHandler312) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler312 handles a NoInlineHandler312 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler312(m interface{}, n int) int {
+	return m.(NoInlineHandler312).Handle(n)
+}
+
+type NoInlineHandler313 struct{}
+
+//go:noinline
+func (NoInlineHandler313) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler313 handles a NoInlineHandler313 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler313(m interface{}, n int) int {
+	return m.(NoInlineHandler313).Handle(n)
+}
+
+type NoInlineHandler314 struct{}
+
+//go:noinline
+func (NoInlineHandler314) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler314 handles a NoInlineHandler314 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler314(m interface{}, n int) int {
+	return m.(NoInlineHandler314).Handle(n)
+}
+
+type NoInlineHandler315 struct{}
+
+//go:noinline
+func (NoInlineHandler315) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler315 handles a NoInlineHandler315 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler315(m interface{}, n int) int {
+	return m.(NoInlineHandler315).Handle(n)
+}
+
+type NoInlineHandler316 struct{}
+
+//go:noinline
+func (NoInlineHandler316) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler316 handles a NoInlineHandler316 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler316(m interface{}, n int) int {
+	return m.(NoInlineHandler316).Handle(n)
+}
+
+type NoInlineHandler317 struct{}
+
+//go:noinline
+func (NoInlineHandler317) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler317 handles a NoInlineHandler317 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler317(m interface{}, n int) int {
+	return m.(NoInlineHandler317).Handle(n)
+}
+
+type NoInlineHandler318 struct{}
+
+//go:noinline
+func (NoInlineHandler318) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler318 handles a NoInlineHandler318 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler318(m interface{}, n int) int {
+	return m.(NoInlineHandler318).Handle(n)
+}
+
+type NoInlineHandler319 struct{}
+
+//go:noinline
+func (NoInlineHandler319) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler319 handles a NoInlineHandler319 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler319(m interface{}, n int) int {
+	return m.(NoInlineHandler319).Handle(n)
+}
+
+type NoInlineHandler320 struct{}
+
+//go:noinline
+func (NoInlineHandler320) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler320 handles a NoInlineHandler320 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler320(m interface{}, n int) int {
+	return m.(NoInlineHandler320).Handle(n)
+}
+
+type NoInlineHandler321 struct{}
+
+//go:noinline
+func (NoInlineHandler321) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler321 handles a NoInlineHandler321 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler321(m interface{}, n int) int {
+	return m.(NoInlineHandler321).Handle(n)
+}
+
+type NoInlineHandler322 struct{}
+
+//go:noinline
+func (NoInlineHandler322) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler322 handles a NoInlineHandler322 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler322(m interface{}, n int) int {
+	return m.(NoInlineHandler322).Handle(n)
+}
+
+type NoInlineHandler323 struct{}
+
+//go:noinline
+func (NoInlineHandler323) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler323 handles a NoInlineHandler323 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler323(m interface{}, n int) int {
+	return m.(NoInlineHandler323).Handle(n)
+}
+
+type NoInlineHandler324 struct{}
+
+//go:noinline
+func (NoInlineHandler324) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler324 handles a NoInlineHandler324 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler324(m interface{}, n int) int {
+	return m.(NoInlineHandler324).Handle(n)
+}
+
+type NoInlineHandler325 struct{}
+
+//go:noinline
+func (NoInlineHandler325) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler325 handles a NoInlineHandler325 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler325(m interface{}, n int) int {
+	return m.(NoInlineHandler325).Handle(n)
+}
+
+type NoInlineHandler326 struct{}
+
+//go:noinline
+func (NoInlineHandler326) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler326 handles a NoInlineHandler326 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler326(m interface{}, n int) int {
+	return m.(NoInlineHandler326).Handle(n)
+}
+
+type NoInlineHandler327 struct{}
+
+//go:noinline
+func (NoInlineHandler327) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler327 handles a NoInlineHandler327 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler327(m interface{}, n int) int {
+	return m.(NoInlineHandler327).Handle(n)
+}
+
+type NoInlineHandler328 struct{}
+
+//go:noinline
+func (NoInlineHandler328) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler328 handles a NoInlineHandler328 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler328(m interface{}, n int) int {
+	return m.(NoInlineHandler328).Handle(n)
+}
+
+type NoInlineHandler329 struct{}
+
+//go:noinline
+func (NoInlineHandler329) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler329 handles a NoInlineHandler329 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler329(m interface{}, n int) int {
+	return m.(NoInlineHandler329).Handle(n)
+}
+
+type NoInlineHandler330 struct{}
+
+//go:noinline
+func (NoInlineHandler330) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler330 handles a NoInlineHandler330 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler330(m interface{}, n int) int {
+	return m.(NoInlineHandler330).Handle(n)
+}
+
+type NoInlineHandler331 struct{}
+
+//go:noinline
+func (NoInlineHandler331) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler331 handles a NoInlineHandler331 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler331(m interface{}, n int) int {
+	return m.(NoInlineHandler331).Handle(n)
+}
+
+type NoInlineHandler332 struct{}
+
+//go:noinline
+func (NoInlineHandler332) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler332 handles a NoInlineHandler332 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler332(m interface{}, n int) int {
+	return m.(NoInlineHandler332).Handle(n)
+}
+
+type NoInlineHandler333 struct{}
+
+//go:noinline
+func (NoInlineHandler333) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler333 handles a NoInlineHandler333 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler333(m interface{}, n int) int {
+	return m.(NoInlineHandler333).Handle(n)
+}
+
+type NoInlineHandler334 struct{}
+
+//go:noinline
+func (NoInlineHandler334) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler334 handles a NoInlineHandler334 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler334(m interface{}, n int) int {
+	return m.(NoInlineHandler334).Handle(n)
+}
+
+type NoInlineHandler335 struct{}
+
+//go:noinline
+func (NoInlineHandler335) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler335 handles a NoInlineHandler335 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler335(m interface{}, n int) int {
+	return m.(NoInlineHandler335).Handle(n)
+}
+
+type NoInlineHandler336 struct{}
+
+//go:noinline
+func (NoInlineHandler336) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler336 handles a NoInlineHandler336 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler336(m interface{}, n int) int {
+	return m.(NoInlineHandler336).Handle(n)
+}
+
+type NoInlineHandler337 struct{}
+
+//go:noinline
+func (NoInlineHandler337) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler337 handles a NoInlineHandler337 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler337(m interface{}, n int) int {
+	return m.(NoInlineHandler337).Handle(n)
+}
+
+type NoInlineHandler338 struct{}
+
+//go:noinline
+func (NoInlineHandler338) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler338 handles a NoInlineHandler338 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler338(m interface{}, n int) int {
+	return m.(NoInlineHandler338).Handle(n)
+}
+
+type NoInlineHandler339 struct{}
+
+//go:noinline
+func (NoInlineHandler339) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler339 handles a NoInlineHandler339 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler339(m interface{}, n int) int {
+	return m.(NoInlineHandler339).Handle(n)
+}
+
+type NoInlineHandler340 struct{}
+
+//go:noinline
+func (NoInlineHandler340) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler340 handles a NoInlineHandler340 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler340(m interface{}, n int) int {
+	return m.(NoInlineHandler340).Handle(n)
+}
+
+type NoInlineHandler341 struct{}
+
+//go:noinline
+func (NoInlineHandler341) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler341 handles a NoInlineHandler341 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler341(m interface{}, n int) int {
+	return m.(NoInlineHandler341).Handle(n)
+}
+
+type NoInlineHandler342 struct{}
+
+//go:noinline
+func (NoInlineHandler342) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler342 handles a NoInlineHandler342 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler342(m interface{}, n int) int {
+	return m.(NoInlineHandler342).Handle(n)
+}
+
+type NoInlineHandler343 struct{}
+
+//go:noinline
+func (NoInlineHandler343) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler343 handles a NoInlineHandler343 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler343(m interface{}, n int) int {
+	return m.(NoInlineHandler343).Handle(n)
+}
+
+type NoInlineHandler344 struct{}
+
+//go:noinline
+func (NoInlineHandler344) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler344 handles a NoInlineHandler344 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler344(m interface{}, n int) int {
+	return m.(NoInlineHandler344).Handle(n)
+}
+
+type NoInlineHandler345 struct{}
+
+//go:noinline
+func (NoInlineHandler345) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler345 handles a NoInlineHandler345 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler345(m interface{}, n int) int {
+	return m.(NoInlineHandler345).Handle(n)
+}
+
+type NoInlineHandler346 struct{}
+
+//go:noinline
+func (NoInlineHandler346) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler346 handles a NoInlineHandler346 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler346(m interface{}, n int) int {
+	return m.(NoInlineHandler346).Handle(n)
+}
+
+type NoInlineHandler347 struct{}
+
+//go:noinline
+func (NoInlineHandler347) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler347 handles a NoInlineHandler347 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler347(m interface{}, n int) int {
+	return m.(NoInlineHandler347).Handle(n)
+}
+
+type NoInlineHandler348 struct{}
+
+//go:noinline
+func (NoInlineHandler348) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler348 handles a NoInlineHandler348 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler348(m interface{}, n int) int {
+	return m.(NoInlineHandler348).Handle(n)
+}
+
+type NoInlineHandler349 struct{}
+
+//go:noinline
+func (NoInlineHandler349) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler349 handles a NoInlineHandler349 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler349(m interface{}, n int) int {
+	return m.(NoInlineHandler349).Handle(n)
+}
+
+type NoInlineHandler350 struct{}
+
+//go:noinline
+func (NoInlineHandler350) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler350 handles a NoInlineHandler350 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler350(m interface{}, n int) int {
+	return m.(NoInlineHandler350).Handle(n)
+}
+
+type NoInlineHandler351 struct{}
+
+//go:noinline
+func (NoInlineHandler351) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler351 handles a NoInlineHandler351 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler351(m interface{}, n int) int {
+	return m.(NoInlineHandler351).Handle(n)
+}
+
+type NoInlineHandler352 struct{}
+
+//go:noinline
+func (NoInlineHandler352) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler352 handles a NoInlineHandler352 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler352(m interface{}, n int) int {
+	return m.(NoInlineHandler352).Handle(n)
+}
+
+type NoInlineHandler353 struct{}
+
+//go:noinline
+func (NoInlineHandler353) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler353 handles a NoInlineHandler353 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler353(m interface{}, n int) int {
+	return m.(NoInlineHandler353).Handle(n)
+}
+
+type NoInlineHandler354 struct{}
+
+//go:noinline
+func (NoInlineHandler354) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler354 handles a NoInlineHandler354 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler354(m interface{}, n int) int {
+	return m.(NoInlineHandler354).Handle(n)
+}
+
+type NoInlineHandler355 struct{}
+
+//go:noinline
+func (NoInlineHandler355) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler355 handles a NoInlineHandler355 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler355(m interface{}, n int) int {
+	return m.(NoInlineHandler355).Handle(n)
+}
+
+type NoInlineHandler356 struct{}
+
+//go:noinline
+func (NoInlineHandler356) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler356 handles a NoInlineHandler356 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler356(m interface{}, n int) int {
+	return m.(NoInlineHandler356).Handle(n)
+}
+
+type NoInlineHandler357 struct{}
+
+//go:noinline
+func (NoInlineHandler357) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler357 handles a NoInlineHandler357 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler357(m interface{}, n int) int {
+	return m.(NoInlineHandler357).Handle(n)
+}
+
+type NoInlineHandler358 struct{}
+
+//go:noinline
+func (NoInlineHandler358) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler358 handles a NoInlineHandler358 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler358(m interface{}, n int) int {
+	return m.(NoInlineHandler358).Handle(n)
+}
+
+type NoInlineHandler359 struct{}
+
+//go:noinline
+func (NoInlineHandler359) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler359 handles a NoInlineHandler359 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler359(m interface{}, n int) int {
+	return m.(NoInlineHandler359).Handle(n)
+}
+
+type NoInlineHandler360 struct{}
+
+//go:noinline
+func (NoInlineHandler360) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler360 handles a NoInlineHandler360 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler360(m interface{}, n int) int {
+	return m.(NoInlineHandler360).Handle(n)
+}
+
+type NoInlineHandler361 struct{}
+
+//go:noinline
+func (NoInlineHandler361) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler361 handles a NoInlineHandler361 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler361(m interface{}, n int) int {
+	return m.(NoInlineHandler361).Handle(n)
+}
+
+type NoInlineHandler362 struct{}
+
+//go:noinline
+func (NoInlineHandler362) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler362 handles a NoInlineHandler362 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler362(m interface{}, n int) int {
+	return m.(NoInlineHandler362).Handle(n)
+}
+
+type NoInlineHandler363 struct{}
+
+//go:noinline
+func (NoInlineHandler363) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler363 handles a NoInlineHandler363 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler363(m interface{}, n int) int {
+	return m.(NoInlineHandler363).Handle(n)
+}
+
+type NoInlineHandler364 struct{}
+
+//go:noinline
+func (NoInlineHandler364) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler364 handles a NoInlineHandler364 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler364(m interface{}, n int) int {
+	return m.(NoInlineHandler364).Handle(n)
+}
+
+type NoInlineHandler365 struct{}
+
+//go:noinline
+func (NoInlineHandler365) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler365 handles a NoInlineHandler365 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler365(m interface{}, n int) int {
+	return m.(NoInlineHandler365).Handle(n)
+}
+
+type NoInlineHandler366 struct{}
+
+//go:noinline
+func (NoInlineHandler366) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler366 handles a NoInlineHandler366 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler366(m interface{}, n int) int {
+	return m.(NoInlineHandler366).Handle(n)
+}
+
+type NoInlineHandler367 struct{}
+
+//go:noinline
+func (NoInlineHandler367) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler367 handles a NoInlineHandler367 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler367(m interface{}, n int) int {
+	return m.(NoInlineHandler367).Handle(n)
+}
+
+type NoInlineHandler368 struct{}
+
+//go:noinline
+func (NoInlineHandler368) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler368 handles a NoInlineHandler368 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler368(m interface{}, n int) int {
+	return m.(NoInlineHandler368).Handle(n)
+}
+
+type NoInlineHandler369 struct{}
+
+//go:noinline
+func (NoInlineHandler369) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler369 handles a NoInlineHandler369 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler369(m interface{}, n int) int {
+	return m.(NoInlineHandler369).Handle(n)
+}
+
+type NoInlineHandler370 struct{}
+
+//go:noinline
+func (NoInlineHandler370) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler370 handles a NoInlineHandler370 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler370(m interface{}, n int) int {
+	return m.(NoInlineHandler370).Handle(n)
+}
+
+type NoInlineHandler371 struct{}
+
+//go:noinline
+func (NoInlineHandler371) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler371 handles a NoInlineHandler371 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler371(m interface{}, n int) int {
+	return m.(NoInlineHandler371).Handle(n)
+}
+
+type NoInlineHandler372 struct{}
+
+//go:noinline
+func (NoInlineHandler372) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler372 handles a NoInlineHandler372 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler372(m interface{}, n int) int {
+	return m.(NoInlineHandler372).Handle(n)
+}
+
+type NoInlineHandler373 struct{}
+
+//go:noinline
+func (NoInlineHandler373) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler373 handles a NoInlineHandler373 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler373(m interface{}, n int) int {
+	return m.(NoInlineHandler373).Handle(n)
+}
+
+type NoInlineHandler374 struct{}
+
+//go:noinline
+func (NoInlineHandler374) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler374 handles a NoInlineHandler374 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler374(m interface{}, n int) int {
+	return m.(NoInlineHandler374).Handle(n)
+}
+
+type NoInlineHandler375 struct{}
+
+//go:noinline
+func (NoInlineHandler375) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler375 handles a NoInlineHandler375 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler375(m interface{}, n int) int {
+	return m.(NoInlineHandler375).Handle(n)
+}
+
+type NoInlineHandler376 struct{}
+
+//go:noinline
+func (NoInlineHandler376) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler376 handles a NoInlineHandler376 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler376(m interface{}, n int) int {
+	return m.(NoInlineHandler376).Handle(n)
+}
+
+type NoInlineHandler377 struct{}
+
+//go:noinline
+func (NoInlineHandler377) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler377 handles a NoInlineHandler377 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler377(m interface{}, n int) int {
+	return m.(NoInlineHandler377).Handle(n)
+}
+
+type NoInlineHandler378 struct{}
+
+//go:noinline
+func (NoInlineHandler378) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler378 handles a NoInlineHandler378 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler378(m interface{}, n int) int {
+	return m.(NoInlineHandler378).Handle(n)
+}
+
+type NoInlineHandler379 struct{}
+
+//go:noinline
+func (NoInlineHandler379) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler379 handles a NoInlineHandler379 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler379(m interface{}, n int) int {
+	return m.(NoInlineHandler379).Handle(n)
+}
+
+type NoInlineHandler380 struct{}
+
+//go:noinline
+func (NoInlineHandler380) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler380 handles a NoInlineHandler380 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler380(m interface{}, n int) int {
+	return m.(NoInlineHandler380).Handle(n)
+}
+
+type NoInlineHandler381 struct{}
+
+//go:noinline
+func (NoInlineHandler381) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler381 handles a NoInlineHandler381 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler381(m interface{}, n int) int {
+	return m.(NoInlineHandler381).Handle(n)
+}
+
+type NoInlineHandler382 struct{}
+
+//go:noinline
+func (NoInlineHandler382) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler382 handles a NoInlineHandler382 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler382(m interface{}, n int) int {
+	return m.(NoInlineHandler382).Handle(n)
+}
+
+type NoInlineHandler383 struct{}
+
+//go:noinline
+func (NoInlineHandler383) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler383 handles a NoInlineHandler383 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler383(m interface{}, n int) int {
+	return m.(NoInlineHandler383).Handle(n)
+}
+
+type NoInlineHandler384 struct{}
+
+//go:noinline
+func (NoInlineHandler384) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler384 handles a NoInlineHandler384 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler384(m interface{}, n int) int {
+	return m.(NoInlineHandler384).Handle(n)
+}
+
+type NoInlineHandler385 struct{}
+
+//go:noinline
+func (NoInlineHandler385) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler385 handles a NoInlineHandler385 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler385(m interface{}, n int) int {
+	return m.(NoInlineHandler385).Handle(n)
+}
+
+type NoInlineHandler386 struct{}
+
+//go:noinline
+func (NoInlineHandler386) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler386 handles a NoInlineHandler386 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler386(m interface{}, n int) int {
+	return m.(NoInlineHandler386).Handle(n)
+}
+
+type NoInlineHandler387 struct{}
+
+//go:noinline
+func (NoInlineHandler387) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler387 handles a NoInlineHandler387 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler387(m interface{}, n int) int {
+	return m.(NoInlineHandler387).Handle(n)
+}
+
+type NoInlineHandler388 struct{}
+
+//go:noinline
+func (NoInlineHandler388) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler388 handles a NoInlineHandler388 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler388(m interface{}, n int) int {
+	return m.(NoInlineHandler388).Handle(n)
+}
+
+type NoInlineHandler389 struct{}
+
+//go:noinline
+func (NoInlineHandler389) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler389 handles a NoInlineHandler389 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler389(m interface{}, n int) int {
+	return m.(NoInlineHandler389).Handle(n)
+}
+
+type NoInlineHandler390 struct{}
+
+//go:noinline
+func (NoInlineHandler390) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler390 handles a NoInlineHandler390 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler390(m interface{}, n int) int {
+	return m.(NoInlineHandler390).Handle(n)
+}
+
+type NoInlineHandler391 struct{}
+
+//go:noinline
+func (NoInlineHandler391) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler391 handles a NoInlineHandler391 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler391(m interface{}, n int) int {
+	return m.(NoInlineHandler391).Handle(n)
+}
+
+type NoInlineHandler392 struct{}
+
+//go:noinline
+func (NoInlineHandler392) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler392 handles a NoInlineHandler392 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler392(m interface{}, n int) int {
+	return m.(NoInlineHandler392).Handle(n)
+}
+
+type NoInlineHandler393 struct{}
+
+//go:noinline
+func (NoInlineHandler393) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler393 handles a NoInlineHandler393 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler393(m interface{}, n int) int {
+	return m.(NoInlineHandler393).Handle(n)
+}
+
+type NoInlineHandler394 struct{}
+
+//go:noinline
+func (NoInlineHandler394) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler394 handles a NoInlineHandler394 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler394(m interface{}, n int) int {
+	return m.(NoInlineHandler394).Handle(n)
+}
+
+type NoInlineHandler395 struct{}
+
+//go:noinline
+func (NoInlineHandler395) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler395 handles a NoInlineHandler395 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler395(m interface{}, n int) int {
+	return m.(NoInlineHandler395).Handle(n)
+}
+
+type NoInlineHandler396 struct{}
+
+//go:noinline
+func (NoInlineHandler396) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler396 handles a NoInlineHandler396 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler396(m interface{}, n int) int {
+	return m.(NoInlineHandler396).Handle(n)
+}
+
+type NoInlineHandler397 struct{}
+
+//go:noinline
+func (NoInlineHandler397) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler397 handles a NoInlineHandler397 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler397(m interface{}, n int) int {
+	return m.(NoInlineHandler397).Handle(n)
+}
+
+type NoInlineHandler398 struct{}
+
+//go:noinline
+func (NoInlineHandler398) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler398 handles a NoInlineHandler398 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler398(m interface{}, n int) int {
+	return m.(NoInlineHandler398).Handle(n)
+}
+
+type NoInlineHandler399 struct{}
+
+//go:noinline
+func (NoInlineHandler399) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler399 handles a NoInlineHandler399 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler399(m interface{}, n int) int {
+	return m.(NoInlineHandler399).Handle(n)
+}
+
+type NoInlineHandler400 struct{}
+
+//go:noinline
+func (NoInlineHandler400) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler400 handles a NoInlineHandler400 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler400(m interface{}, n int) int {
+	return m.(NoInlineHandler400).Handle(n)
+}
+
+type NoInlineHandler401 struct{}
+
+//go:noinline
+func (NoInlineHandler401) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler401 handles a NoInlineHandler401 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler401(m interface{}, n int) int {
+	return m.(NoInlineHandler401).Handle(n)
+}
+
+type NoInlineHandler402 struct{}
+
+//go:noinline
+func (NoInlineHandler402) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler402 handles a NoInlineHandler402 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler402(m interface{}, n int) int {
+	return m.(NoInlineHandler402).Handle(n)
+}
+
+type NoInlineHandler403 struct{}
+
+//go:noinline
+func (NoInlineHandler403) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler403 handles a NoInlineHandler403 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler403(m interface{}, n int) int {
+	return m.(NoInlineHandler403).Handle(n)
+}
+
+type NoInlineHandler404 struct{}
+
+//go:noinline
+func (NoInlineHandler404) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler404 handles a NoInlineHandler404 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler404(m interface{}, n int) int {
+	return m.(NoInlineHandler404).Handle(n)
+}
+
+type NoInlineHandler405 struct{}
+
+//go:noinline
+func (NoInlineHandler405) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler405 handles a NoInlineHandler405 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler405(m interface{}, n int) int {
+	return m.(NoInlineHandler405).Handle(n)
+}
+
+type NoInlineHandler406 struct{}
+
+//go:noinline
+func (NoInlineHandler406) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler406 handles a NoInlineHandler406 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler406(m interface{}, n int) int {
+	return m.(NoInlineHandler406).Handle(n)
+}
+
+type NoInlineHandler407 struct{}
+
+//go:noinline
+func (NoInlineHandler407) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler407 handles a NoInlineHandler407 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler407(m interface{}, n int) int {
+	return m.(NoInlineHandler407).Handle(n)
+}
+
+type NoInlineHandler408 struct{}
+
+//go:noinline
+func (NoInlineHandler408) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler408 handles a NoInlineHandler408 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler408(m interface{}, n int) int {
+	return m.(NoInlineHandler408).Handle(n)
+}
+
+type NoInlineHandler409 struct{}
+
+//go:noinline
+func (NoInlineHandler409) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler409 handles a NoInlineHandler409 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler409(m interface{}, n int) int {
+	return m.(NoInlineHandler409).Handle(n)
+}
+
+type NoInlineHandler410 struct{}
+
+//go:noinline
+func (NoInlineHandler410) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler410 handles a NoInlineHandler410 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler410(m interface{}, n int) int {
+	return m.(NoInlineHandler410).Handle(n)
+}
+
+type NoInlineHandler411 struct{}
+
+//go:noinline
+func (NoInlineHandler411) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler411 handles a NoInlineHandler411 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler411(m interface{}, n int) int {
+	return m.(NoInlineHandler411).Handle(n)
+}
+
+type NoInlineHandler412 struct{}
+
+//go:noinline
+func (NoInlineHandler412) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler412 handles a NoInlineHandler412 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler412(m interface{}, n int) int {
+	return m.(NoInlineHandler412).Handle(n)
+}
+
+type NoInlineHandler413 struct{}
+
+//go:noinline
+func (NoInlineHandler413) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler413 handles a NoInlineHandler413 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler413(m interface{}, n int) int {
+	return m.(NoInlineHandler413).Handle(n)
+}
+
+type NoInlineHandler414 struct{}
+
+//go:noinline
+func (NoInlineHandler414) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler414 handles a NoInlineHandler414 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler414(m interface{}, n int) int {
+	return m.(NoInlineHandler414).Handle(n)
+}
+
+type NoInlineHandler415 struct{}
+
+//go:noinline
+func (NoInlineHandler415) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler415 handles a NoInlineHandler415 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler415(m interface{}, n int) int {
+	return m.(NoInlineHandler415).Handle(n)
+}
+
+type NoInlineHandler416 struct{}
+
+//go:noinline
+func (NoInlineHandler416) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler416 handles a NoInlineHandler416 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler416(m interface{}, n int) int {
+	return m.(NoInlineHandler416).Handle(n)
+}
+
+type NoInlineHandler417 struct{}
+
+//go:noinline
+func (NoInlineHandler417) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler417 handles a NoInlineHandler417 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler417(m interface{}, n int) int {
+	return m.(NoInlineHandler417).Handle(n)
+}
+
+type NoInlineHandler418 struct{}
+
+//go:noinline
+func (NoInlineHandler418) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler418 handles a NoInlineHandler418 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler418(m interface{}, n int) int {
+	return m.(NoInlineHandler418).Handle(n)
+}
+
+type NoInlineHandler419 struct{}
+
+//go:noinline
+func (NoInlineHandler419) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler419 handles a NoInlineHandler419 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler419(m interface{}, n int) int {
+	return m.(NoInlineHandler419).Handle(n)
+}
+
+type NoInlineHandler420 struct{}
+
+//go:noinline
+func (NoInlineHandler420) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler420 handles a NoInlineHandler420 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler420(m interface{}, n int) int {
+	return m.(NoInlineHandler420).Handle(n)
+}
+
+type NoInlineHandler421 struct{}
+
+//go:noinline
+func (NoInlineHandler421) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler421 handles a NoInlineHandler421 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler421(m interface{}, n int) int {
+	return m.(NoInlineHandler421).Handle(n)
+}
+
+type NoInlineHandler422 struct{}
+
+//go:noinline
+func (NoInlineHandler422) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler422 handles a NoInlineHandler422 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler422(m interface{}, n int) int {
+	return m.(NoInlineHandler422).Handle(n)
+}
+
+type NoInlineHandler423 struct{}
+
+//go:noinline
+func (NoInlineHandler423) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler423 handles a NoInlineHandler423 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler423(m interface{}, n int) int {
+	return m.(NoInlineHandler423).Handle(n)
+}
+
+type NoInlineHandler424 struct{}
+
+//go:noinline
+func (NoInlineHandler424) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler424 handles a NoInlineHandler424 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler424(m interface{}, n int) int {
+	return m.(NoInlineHandler424).Handle(n)
+}
+
+type NoInlineHandler425 struct{}
+
+//go:noinline
+func (NoInlineHandler425) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler425 handles a NoInlineHandler425 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler425(m interface{}, n int) int {
+	return m.(NoInlineHandler425).Handle(n)
+}
+
+type NoInlineHandler426 struct{}
+
+//go:noinline
+func (NoInlineHandler426) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler426 handles a NoInlineHandler426 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler426(m interface{}, n int) int {
+	return m.(NoInlineHandler426).Handle(n)
+}
+
+type NoInlineHandler427 struct{}
+
+//go:noinline
+func (NoInlineHandler427) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler427 handles a NoInlineHandler427 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler427(m interface{}, n int) int {
+	return m.(NoInlineHandler427).Handle(n)
+}
+
+type NoInlineHandler428 struct{}
+
+//go:noinline
+func (NoInlineHandler428) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler428 handles a NoInlineHandler428 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler428(m interface{}, n int) int {
+	return m.(NoInlineHandler428).Handle(n)
+}
+
+type NoInlineHandler429 struct{}
+
+//go:noinline
+func (NoInlineHandler429) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler429 handles a NoInlineHandler429 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler429(m interface{}, n int) int {
+	return m.(NoInlineHandler429).Handle(n)
+}
+
+type NoInlineHandler430 struct{}
+
+//go:noinline
+func (NoInlineHandler430) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler430 handles a NoInlineHandler430 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler430(m interface{}, n int) int {
+	return m.(NoInlineHandler430).Handle(n)
+}
+
+type NoInlineHandler431 struct{}
+
+//go:noinline
+func (NoInlineHandler431) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler431 handles a NoInlineHandler431 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler431(m interface{}, n int) int {
+	return m.(NoInlineHandler431).Handle(n)
+}
+
+type NoInlineHandler432 struct{}
+
+//go:noinline
+func (NoInlineHandler432) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler432 handles a NoInlineHandler432 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler432(m interface{}, n int) int {
+	return m.(NoInlineHandler432).Handle(n)
+}
+
+type NoInlineHandler433 struct{}
+
+//go:noinline
+func (NoInlineHandler433) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler433 handles a NoInlineHandler433 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler433(m interface{}, n int) int {
+	return m.(NoInlineHandler433).Handle(n)
+}
+
+type NoInlineHandler434 struct{}
+
+//go:noinline
+func (NoInlineHandler434) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler434 handles a NoInlineHandler434 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler434(m interface{}, n int) int {
+	return m.(NoInlineHandler434).Handle(n)
+}
+
+type NoInlineHandler435 struct{}
+
+//go:noinline
+func (NoInlineHandler435) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler435 handles a NoInlineHandler435 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler435(m interface{}, n int) int {
+	return m.(NoInlineHandler435).Handle(n)
+}
+
+type NoInlineHandler436 struct{}
+
+//go:noinline
+func (NoInlineHandler436) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler436 handles a NoInlineHandler436 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler436(m interface{}, n int) int {
+	return m.(NoInlineHandler436).Handle(n)
+}
+
+type NoInlineHandler437 struct{}
+
+//go:noinline
+func (NoInlineHandler437) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler437 handles a NoInlineHandler437 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler437(m interface{}, n int) int {
+	return m.(NoInlineHandler437).Handle(n)
+}
+
+type NoInlineHandler438 struct{}
+
+//go:noinline
+func (NoInlineHandler438) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler438 handles a NoInlineHandler438 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler438(m interface{}, n int) int {
+	return m.(NoInlineHandler438).Handle(n)
+}
+
+type NoInlineHandler439 struct{}
+
+//go:noinline
+func (NoInlineHandler439) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler439 handles a NoInlineHandler439 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler439(m interface{}, n int) int {
+	return m.(NoInlineHandler439).Handle(n)
+}
+
+type NoInlineHandler440 struct{}
+
+//go:noinline
+func (NoInlineHandler440) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler440 handles a NoInlineHandler440 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler440(m interface{}, n int) int {
+	return m.(NoInlineHandler440).Handle(n)
+}
+
+type NoInlineHandler441 struct{}
+
+//go:noinline
+func (NoInlineHandler441) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler441 handles a NoInlineHandler441 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler441(m interface{}, n int) int {
+	return m.(NoInlineHandler441).Handle(n)
+}
+
+type NoInlineHandler442 struct{}
+
+//go:noinline
+func (NoInlineHandler442) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler442 handles a NoInlineHandler442 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler442(m interface{}, n int) int {
+	return m.(NoInlineHandler442).Handle(n)
+}
+
+type NoInlineHandler443 struct{}
+
+//go:noinline
+func (NoInlineHandler443) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler443 handles a NoInlineHandler443 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler443(m interface{}, n int) int {
+	return m.(NoInlineHandler443).Handle(n)
+}
+
+type NoInlineHandler444 struct{}
+
+//go:noinline
+func (NoInlineHandler444) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler444 handles a NoInlineHandler444 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler444(m interface{}, n int) int {
+	return m.(NoInlineHandler444).Handle(n)
+}
+
+type NoInlineHandler445 struct{}
+
+//go:noinline
+func (NoInlineHandler445) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler445 handles a NoInlineHandler445 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler445(m interface{}, n int) int {
+	return m.(NoInlineHandler445).Handle(n)
+}
+
+type NoInlineHandler446 struct{}
+
+//go:noinline
+func (NoInlineHandler446) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler446 handles a NoInlineHandler446 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler446(m interface{}, n int) int {
+	return m.(NoInlineHandler446).Handle(n)
+}
+
+type NoInlineHandler447 struct{}
+
+//go:noinline
+func (NoInlineHandler447) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler447 handles a NoInlineHandler447 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler447(m interface{}, n int) int {
+	return m.(NoInlineHandler447).Handle(n)
+}
+
+type NoInlineHandler448 struct{}
+
+//go:noinline
+func (NoInlineHandler448) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler448 handles a NoInlineHandler448 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler448(m interface{}, n int) int {
+	return m.(NoInlineHandler448).Handle(n)
+}
+
+type NoInlineHandler449 struct{}
+
+//go:noinline
+func (NoInlineHandler449) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler449 handles a NoInlineHandler449 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler449(m interface{}, n int) int {
+	return m.(NoInlineHandler449).Handle(n)
+}
+
+type NoInlineHandler450 struct{}
+
+//go:noinline
+func (NoInlineHandler450) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler450 handles a NoInlineHandler450 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler450(m interface{}, n int) int {
+	return m.(NoInlineHandler450).Handle(n)
+}
+
+type NoInlineHandler451 struct{}
+
+//go:noinline
+func (NoInlineHandler451) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler451 handles a NoInlineHandler451 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler451(m interface{}, n int) int {
+	return m.(NoInlineHandler451).Handle(n)
+}
+
+type NoInlineHandler452 struct{}
+
+//go:noinline
+func (NoInlineHandler452) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler452 handles a NoInlineHandler452 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler452(m interface{}, n int) int {
+	return m.(NoInlineHandler452).Handle(n)
+}
+
+type NoInlineHandler453 struct{}
+
+//go:noinline
+func (NoInlineHandler453) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler453 handles a NoInlineHandler453 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler453(m interface{}, n int) int {
+	return m.(NoInlineHandler453).Handle(n)
+}
+
+type NoInlineHandler454 struct{}
+
+//go:noinline
+func (NoInlineHandler454) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler454 handles a NoInlineHandler454 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler454(m interface{}, n int) int {
+	return m.(NoInlineHandler454).Handle(n)
+}
+
+type NoInlineHandler455 struct{}
+
+//go:noinline
+func (NoInlineHandler455) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler455 handles a NoInlineHandler455 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler455(m interface{}, n int) int {
+	return m.(NoInlineHandler455).Handle(n)
+}
+
+type NoInlineHandler456 struct{}
+
+//go:noinline
+func (NoInlineHandler456) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler456 handles a NoInlineHandler456 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler456(m interface{}, n int) int {
+	return m.(NoInlineHandler456).Handle(n)
+}
+
+type NoInlineHandler457 struct{}
+
+//go:noinline
+func (NoInlineHandler457) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler457 handles a NoInlineHandler457 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler457(m interface{}, n int) int {
+	return m.(NoInlineHandler457).Handle(n)
+}
+
+type NoInlineHandler458 struct{}
+
+//go:noinline
+func (NoInlineHandler458) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler458 handles a NoInlineHandler458 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler458(m interface{}, n int) int {
+	return m.(NoInlineHandler458).Handle(n)
+}
+
+type NoInlineHandler459 struct{}
+
+//go:noinline
+func (NoInlineHandler459) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler459 handles a NoInlineHandler459 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler459(m interface{}, n int) int {
+	return m.(NoInlineHandler459).Handle(n)
+}
+
+type NoInlineHandler460 struct{}
+
+//go:noinline
+func (NoInlineHandler460) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler460 handles a NoInlineHandler460 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler460(m interface{}, n int) int {
+	return m.(NoInlineHandler460).Handle(n)
+}
+
+type NoInlineHandler461 struct{}
+
+//go:noinline
+func (NoInlineHandler461) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler461 handles a NoInlineHandler461 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler461(m interface{}, n int) int {
+	return m.(NoInlineHandler461).Handle(n)
+}
+
+type NoInlineHandler462 struct{}
+
+//go:noinline
+func (NoInlineHandler462) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler462 handles a NoInlineHandler462 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler462(m interface{}, n int) int {
+	return m.(NoInlineHandler462).Handle(n)
+}
+
+type NoInlineHandler463 struct{}
+
+//go:noinline
+func (NoInlineHandler463) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler463 handles a NoInlineHandler463 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler463(m interface{}, n int) int {
+	return m.(NoInlineHandler463).Handle(n)
+}
+
+type NoInlineHandler464 struct{}
+
+//go:noinline
+func (NoInlineHandler464) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler464 handles a NoInlineHandler464 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler464(m interface{}, n int) int {
+	return m.(NoInlineHandler464).Handle(n)
+}
+
+type NoInlineHandler465 struct{}
+
+//go:noinline
+func (NoInlineHandler465) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler465 handles a NoInlineHandler465 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler465(m interface{}, n int) int {
+	return m.(NoInlineHandler465).Handle(n)
+}
+
+type NoInlineHandler466 struct{}
+
+//go:noinline
+func (NoInlineHandler466) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler466 handles a NoInlineHandler466 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler466(m interface{}, n int) int {
+	return m.(NoInlineHandler466).Handle(n)
+}
+
+type NoInlineHandler467 struct{}
+
+//go:noinline
+func (NoInlineHandler467) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler467 handles a NoInlineHandler467 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler467(m interface{}, n int) int {
+	return m.(NoInlineHandler467).Handle(n)
+}
+
+type NoInlineHandler468 struct{}
+
+//go:noinline
+func (NoInlineHandler468) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler468 handles a NoInlineHandler468 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler468(m interface{}, n int) int {
+	return m.(NoInlineHandler468).Handle(n)
+}
+
+type NoInlineHandler469 struct{}
+
+//go:noinline
+func (NoInlineHandler469) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler469 handles a NoInlineHandler469 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler469(m interface{}, n int) int {
+	return m.(NoInlineHandler469).Handle(n)
+}
+
+type NoInlineHandler470 struct{}
+
+//go:noinline
+func (NoInlineHandler470) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler470 handles a NoInlineHandler470 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler470(m interface{}, n int) int {
+	return m.(NoInlineHandler470).Handle(n)
+}
+
+type NoInlineHandler471 struct{}
+
+//go:noinline
+func (NoInlineHandler471) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler471 handles a NoInlineHandler471 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler471(m interface{}, n int) int {
+	return m.(NoInlineHandler471).Handle(n)
+}
+
+type NoInlineHandler472 struct{}
+
+//go:noinline
+func (NoInlineHandler472) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler472 handles a NoInlineHandler472 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler472(m interface{}, n int) int {
+	return m.(NoInlineHandler472).Handle(n)
+}
+
+type NoInlineHandler473 struct{}
+
+//go:noinline
+func (NoInlineHandler473) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler473 handles a NoInlineHandler473 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler473(m interface{}, n int) int {
+	return m.(NoInlineHandler473).Handle(n)
+}
+
+type NoInlineHandler474 struct{}
+
+//go:noinline
+func (NoInlineHandler474) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler474 handles a NoInlineHandler474 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler474(m interface{}, n int) int {
+	return m.(NoInlineHandler474).Handle(n)
+}
+
+type NoInlineHandler475 struct{}
+
+//go:noinline
+func (NoInlineHandler475) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler475 handles a NoInlineHandler475 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler475(m interface{}, n int) int {
+	return m.(NoInlineHandler475).Handle(n)
+}
+
+type NoInlineHandler476 struct{}
+
+//go:noinline
+func (NoInlineHandler476) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler476 handles a NoInlineHandler476 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler476(m interface{}, n int) int {
+	return m.(NoInlineHandler476).Handle(n)
+}
+
+type NoInlineHandler477 struct{}
+
+//go:noinline
+func (NoInlineHandler477) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler477 handles a NoInlineHandler477 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler477(m interface{}, n int) int {
+	return m.(NoInlineHandler477).Handle(n)
+}
+
+type NoInlineHandler478 struct{}
+
+//go:noinline
+func (NoInlineHandler478) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler478 handles a NoInlineHandler478 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler478(m interface{}, n int) int {
+	return m.(NoInlineHandler478).Handle(n)
+}
+
+type NoInlineHandler479 struct{}
+
+//go:noinline
+func (NoInlineHandler479) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler479 handles a NoInlineHandler479 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler479(m interface{}, n int) int {
+	return m.(NoInlineHandler479).Handle(n)
+}
+
+type NoInlineHandler480 struct{}
+
+//go:noinline
+func (NoInlineHandler480) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler480 handles a NoInlineHandler480 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler480(m interface{}, n int) int {
+	return m.(NoInlineHandler480).Handle(n)
+}
+
+type NoInlineHandler481 struct{}
+
+//go:noinline
+func (NoInlineHandler481) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler481 handles a NoInlineHandler481 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler481(m interface{}, n int) int {
+	return m.(NoInlineHandler481).Handle(n)
+}
+
+type NoInlineHandler482 struct{}
+
+//go:noinline
+func (NoInlineHandler482) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler482 handles a NoInlineHandler482 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler482(m interface{}, n int) int {
+	return m.(NoInlineHandler482).Handle(n)
+}
+
+type NoInlineHandler483 struct{}
+
+//go:noinline
+func (NoInlineHandler483) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler483 handles a NoInlineHandler483 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler483(m interface{}, n int) int {
+	return m.(NoInlineHandler483).Handle(n)
+}
+
+type NoInlineHandler484 struct{}
+
+//go:noinline
+func (NoInlineHandler484) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler484 handles a NoInlineHandler484 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler484(m interface{}, n int) int {
+	return m.(NoInlineHandler484).Handle(n)
+}
+
+type NoInlineHandler485 struct{}
+
+//go:noinline
+func (NoInlineHandler485) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler485 handles a NoInlineHandler485 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler485(m interface{}, n int) int {
+	return m.(NoInlineHandler485).Handle(n)
+}
+
+type NoInlineHandler486 struct{}
+
+//go:noinline
+func (NoInlineHandler486) Handle(n int) int {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
-func NoInline509(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+// NoInlineTypeHandler486 handles a NoInlineHandler486 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler486(m interface{}, n int) int {
+	return m.(NoInlineHandler486).Handle(n)
+}
+
+type NoInlineHandler487 struct{}
+
+//go:noinline
+func (NoInlineHandler487) Handle(n int) int {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
-func NoInline510(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+// NoInlineTypeHandler487 handles a NoInlineHandler487 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler487(m interface{}, n int) int {
+	return m.(NoInlineHandler487).Handle(n)
+}
+
+type NoInlineHandler488 struct{}
+
+//go:noinline
+func (NoInlineHandler488) Handle(n int) int {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
-func NoInline511(n int) int {
-	if n < 0 {
-		panic("can't happen - but should ensure this function is not inlined")
-	} else if n%2 == 0 {
+// NoInlineTypeHandler488 handles a NoInlineHandler488 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler488(m interface{}, n int) int {
+	return m.(NoInlineHandler488).Handle(n)
+}
+
+type NoInlineHandler489 struct{}
+
+//go:noinline
+func (NoInlineHandler489) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler489 handles a NoInlineHandler489 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler489(m interface{}, n int) int {
+	return m.(NoInlineHandler489).Handle(n)
+}
+
+type NoInlineHandler490 struct{}
+
+//go:noinline
+func (NoInlineHandler490) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler490 handles a NoInlineHandler490 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler490(m interface{}, n int) int {
+	return m.(NoInlineHandler490).Handle(n)
+}
+
+type NoInlineHandler491 struct{}
+
+//go:noinline
+func (NoInlineHandler491) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler491 handles a NoInlineHandler491 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler491(m interface{}, n int) int {
+	return m.(NoInlineHandler491).Handle(n)
+}
+
+type NoInlineHandler492 struct{}
+
+//go:noinline
+func (NoInlineHandler492) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler492 handles a NoInlineHandler492 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler492(m interface{}, n int) int {
+	return m.(NoInlineHandler492).Handle(n)
+}
+
+type NoInlineHandler493 struct{}
+
+//go:noinline
+func (NoInlineHandler493) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler493 handles a NoInlineHandler493 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler493(m interface{}, n int) int {
+	return m.(NoInlineHandler493).Handle(n)
+}
+
+type NoInlineHandler494 struct{}
+
+//go:noinline
+func (NoInlineHandler494) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler494 handles a NoInlineHandler494 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler494(m interface{}, n int) int {
+	return m.(NoInlineHandler494).Handle(n)
+}
+
+type NoInlineHandler495 struct{}
+
+//go:noinline
+func (NoInlineHandler495) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler495 handles a NoInlineHandler495 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler495(m interface{}, n int) int {
+	return m.(NoInlineHandler495).Handle(n)
+}
+
+type NoInlineHandler496 struct{}
+
+//go:noinline
+func (NoInlineHandler496) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler496 handles a NoInlineHandler496 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler496(m interface{}, n int) int {
+	return m.(NoInlineHandler496).Handle(n)
+}
+
+type NoInlineHandler497 struct{}
+
+//go:noinline
+func (NoInlineHandler497) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler497 handles a NoInlineHandler497 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler497(m interface{}, n int) int {
+	return m.(NoInlineHandler497).Handle(n)
+}
+
+type NoInlineHandler498 struct{}
+
+//go:noinline
+func (NoInlineHandler498) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler498 handles a NoInlineHandler498 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler498(m interface{}, n int) int {
+	return m.(NoInlineHandler498).Handle(n)
+}
+
+type NoInlineHandler499 struct{}
+
+//go:noinline
+func (NoInlineHandler499) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler499 handles a NoInlineHandler499 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler499(m interface{}, n int) int {
+	return m.(NoInlineHandler499).Handle(n)
+}
+
+type NoInlineHandler500 struct{}
+
+//go:noinline
+func (NoInlineHandler500) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler500 handles a NoInlineHandler500 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler500(m interface{}, n int) int {
+	return m.(NoInlineHandler500).Handle(n)
+}
+
+type NoInlineHandler501 struct{}
+
+//go:noinline
+func (NoInlineHandler501) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler501 handles a NoInlineHandler501 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler501(m interface{}, n int) int {
+	return m.(NoInlineHandler501).Handle(n)
+}
+
+type NoInlineHandler502 struct{}
+
+//go:noinline
+func (NoInlineHandler502) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler502 handles a NoInlineHandler502 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler502(m interface{}, n int) int {
+	return m.(NoInlineHandler502).Handle(n)
+}
+
+type NoInlineHandler503 struct{}
+
+//go:noinline
+func (NoInlineHandler503) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler503 handles a NoInlineHandler503 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler503(m interface{}, n int) int {
+	return m.(NoInlineHandler503).Handle(n)
+}
+
+type NoInlineHandler504 struct{}
+
+//go:noinline
+func (NoInlineHandler504) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler504 handles a NoInlineHandler504 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler504(m interface{}, n int) int {
+	return m.(NoInlineHandler504).Handle(n)
+}
+
+type NoInlineHandler505 struct{}
+
+//go:noinline
+func (NoInlineHandler505) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler505 handles a NoInlineHandler505 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler505(m interface{}, n int) int {
+	return m.(NoInlineHandler505).Handle(n)
+}
+
+type NoInlineHandler506 struct{}
+
+//go:noinline
+func (NoInlineHandler506) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler506 handles a NoInlineHandler506 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler506(m interface{}, n int) int {
+	return m.(NoInlineHandler506).Handle(n)
+}
+
+type NoInlineHandler507 struct{}
+
+//go:noinline
+func (NoInlineHandler507) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler507 handles a NoInlineHandler507 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler507(m interface{}, n int) int {
+	return m.(NoInlineHandler507).Handle(n)
+}
+
+type NoInlineHandler508 struct{}
+
+//go:noinline
+func (NoInlineHandler508) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler508 handles a NoInlineHandler508 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler508(m interface{}, n int) int {
+	return m.(NoInlineHandler508).Handle(n)
+}
+
+type NoInlineHandler509 struct{}
+
+//go:noinline
+func (NoInlineHandler509) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler509 handles a NoInlineHandler509 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler509(m interface{}, n int) int {
+	return m.(NoInlineHandler509).Handle(n)
+}
+
+type NoInlineHandler510 struct{}
+
+//go:noinline
+func (NoInlineHandler510) Handle(n int) int {
+	if n%2 == 0 {
+		return n
+	} else {
+		return 0
+	}
+}
+
+// NoInlineTypeHandler510 handles a NoInlineHandler510 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler510(m interface{}, n int) int {
+	return m.(NoInlineHandler510).Handle(n)
+}
+
+type NoInlineHandler511 struct{}
+
+//go:noinline
+func (NoInlineHandler511) Handle(n int) int {
+	if n%2 == 0 {
 		return n
 	} else {
 		return 0
 	}
 }
 
+// NoInlineTypeHandler511 handles a NoInlineHandler511 message the way
+// an event handler does: it asserts the message to its type and handles it.
+func NoInlineTypeHandler511(m interface{}, n int) int {
+	return m.(NoInlineHandler511).Handle(n)
+}
+
+var InlineFuncMap4 map[int]func(int) int
+var InlineFuncMap8 map[int]func(int) int
+var InlineFuncMap16 map[int]func(int) int
+var InlineFuncMap32 map[int]func(int) int
+var InlineFuncMap64 map[int]func(int) int
+var InlineFuncMap128 map[int]func(int) int
+var InlineFuncMap256 map[int]func(int) int
+var InlineFuncMap512 map[int]func(int) int
+var NoInlineFuncMap4 map[int]func(int) int
+var NoInlineFuncMap8 map[int]func(int) int
+var NoInlineFuncMap16 map[int]func(int) int
+var NoInlineFuncMap32 map[int]func(int) int
+var NoInlineFuncMap64 map[int]func(int) int
+var NoInlineFuncMap128 map[int]func(int) int
+var NoInlineFuncMap256 map[int]func(int) int
+var NoInlineFuncMap512 map[int]func(int) int
+
+var InlineFuncArray4 [4]func(int) int
+var InlineFuncArray8 [8]func(int) int
+var InlineFuncArray16 [16]func(int) int
+var InlineFuncArray32 [32]func(int) int
+var InlineFuncArray64 [64]func(int) int
+var InlineFuncArray128 [128]func(int) int
+var InlineFuncArray256 [256]func(int) int
+var InlineFuncArray512 [512]func(int) int
+var NoInlineFuncArray4 [4]func(int) int
+var NoInlineFuncArray8 [8]func(int) int
+var NoInlineFuncArray16 [16]func(int) int
+var NoInlineFuncArray32 [32]func(int) int
+var NoInlineFuncArray64 [64]func(int) int
+var NoInlineFuncArray128 [128]func(int) int
+var NoInlineFuncArray256 [256]func(int) int
+var NoInlineFuncArray512 [512]func(int) int
+
+// The TypeMap maps hold the TypeHandler of each message keyed by the type of
+// the message. The handler receives the message along with the argument.
+var InlineTypeMap4 map[reflect.Type]func(interface{}, int) int
+var InlineTypeMap8 map[reflect.Type]func(interface{}, int) int
+var InlineTypeMap16 map[reflect.Type]func(interface{}, int) int
+var InlineTypeMap32 map[reflect.Type]func(interface{}, int) int
+var InlineTypeMap64 map[reflect.Type]func(interface{}, int) int
+var InlineTypeMap128 map[reflect.Type]func(interface{}, int) int
+var InlineTypeMap256 map[reflect.Type]func(interface{}, int) int
+var InlineTypeMap512 map[reflect.Type]func(interface{}, int) int
+
+var NoInlineTypeMap4 map[reflect.Type]func(interface{}, int) int
+var NoInlineTypeMap8 map[reflect.Type]func(interface{}, int) int
+var NoInlineTypeMap16 map[reflect.Type]func(interface{}, int) int
+var NoInlineTypeMap32 map[reflect.Type]func(interface{}, int) int
+var NoInlineTypeMap64 map[reflect.Type]func(interface{}, int) int
+var NoInlineTypeMap128 map[reflect.Type]func(interface{}, int) int
+var NoInlineTypeMap256 map[reflect.Type]func(interface{}, int) int
+var NoInlineTypeMap512 map[reflect.Type]func(interface{}, int) int
+
 func init() {
 	InlineFuncs = append(InlineFuncs, Inline0)
 	InlineFuncs = append(InlineFuncs, Inline1)
@@ -10246,4 +26712,2242 @@ func init() {
 	NoInlineFuncs = append(NoInlineFuncs, NoInline509)
 	NoInlineFuncs = append(NoInlineFuncs, NoInline510)
 	NoInlineFuncs = append(NoInlineFuncs, NoInline511)
+
+	InlineFuncMap4 = make(map[int]func(int) int, 4)
+	for i, f := range InlineFuncs[:4] {
+		InlineFuncMap4[i] = f
+	}
+
+	InlineFuncMap8 = make(map[int]func(int) int, 8)
+	for i, f := range InlineFuncs[:8] {
+		InlineFuncMap8[i] = f
+	}
+
+	InlineFuncMap16 = make(map[int]func(int) int, 16)
+	for i, f := range InlineFuncs[:16] {
+		InlineFuncMap16[i] = f
+	}
+
+	InlineFuncMap32 = make(map[int]func(int) int, 32)
+	for i, f := range InlineFuncs[:32] {
+		InlineFuncMap32[i] = f
+	}
+
+	InlineFuncMap64 = make(map[int]func(int) int, 64)
+	for i, f := range InlineFuncs[:64] {
+		InlineFuncMap64[i] = f
+	}
+
+	InlineFuncMap128 = make(map[int]func(int) int, 128)
+	for i, f := range InlineFuncs[:128] {
+		InlineFuncMap128[i] = f
+	}
+
+	InlineFuncMap256 = make(map[int]func(int) int, 256)
+	for i, f := range InlineFuncs[:256] {
+		InlineFuncMap256[i] = f
+	}
+
+	InlineFuncMap512 = make(map[int]func(int) int, 512)
+	for i, f := range InlineFuncs[:512] {
+		InlineFuncMap512[i] = f
+	}
+
+	NoInlineFuncMap4 = make(map[int]func(int) int, 4)
+	for i, f := range NoInlineFuncs[:4] {
+		NoInlineFuncMap4[i] = f
+	}
+
+	NoInlineFuncMap8 = make(map[int]func(int) int, 8)
+	for i, f := range NoInlineFuncs[:8] {
+		NoInlineFuncMap8[i] = f
+	}
+
+	NoInlineFuncMap16 = make(map[int]func(int) int, 16)
+	for i, f := range NoInlineFuncs[:16] {
+		NoInlineFuncMap16[i] = f
+	}
+
+	NoInlineFuncMap32 = make(map[int]func(int) int, 32)
+	for i, f := range NoInlineFuncs[:32] {
+		NoInlineFuncMap32[i] = f
+	}
+
+	NoInlineFuncMap64 = make(map[int]func(int) int, 64)
+	for i, f := range NoInlineFuncs[:64] {
+		NoInlineFuncMap64[i] = f
+	}
+
+	NoInlineFuncMap128 = make(map[int]func(int) int, 128)
+	for i, f := range NoInlineFuncs[:128] {
+		NoInlineFuncMap128[i] = f
+	}
+
+	NoInlineFuncMap256 = make(map[int]func(int) int, 256)
+	for i, f := range NoInlineFuncs[:256] {
+		NoInlineFuncMap256[i] = f
+	}
+
+	NoInlineFuncMap512 = make(map[int]func(int) int, 512)
+	for i, f := range NoInlineFuncs[:512] {
+		NoInlineFuncMap512[i] = f
+	}
+
+	InlineHandlers = append(InlineHandlers, InlineHandler0{})
+	InlineHandlers = append(InlineHandlers, InlineHandler1{})
+	InlineHandlers = append(InlineHandlers, InlineHandler2{})
+	InlineHandlers = append(InlineHandlers, InlineHandler3{})
+	InlineHandlers = append(InlineHandlers, InlineHandler4{})
+	InlineHandlers = append(InlineHandlers, InlineHandler5{})
+	InlineHandlers = append(InlineHandlers, InlineHandler6{})
+	InlineHandlers = append(InlineHandlers, InlineHandler7{})
+	InlineHandlers = append(InlineHandlers, InlineHandler8{})
+	InlineHandlers = append(InlineHandlers, InlineHandler9{})
+	InlineHandlers = append(InlineHandlers, InlineHandler10{})
+	InlineHandlers = append(InlineHandlers, InlineHandler11{})
+	InlineHandlers = append(InlineHandlers, InlineHandler12{})
+	InlineHandlers = append(InlineHandlers, InlineHandler13{})
+	InlineHandlers = append(InlineHandlers, InlineHandler14{})
+	InlineHandlers = append(InlineHandlers, InlineHandler15{})
+	InlineHandlers = append(InlineHandlers, InlineHandler16{})
+	InlineHandlers = append(InlineHandlers, InlineHandler17{})
+	InlineHandlers = append(InlineHandlers, InlineHandler18{})
+	InlineHandlers = append(InlineHandlers, InlineHandler19{})
+	InlineHandlers = append(InlineHandlers, InlineHandler20{})
+	InlineHandlers = append(InlineHandlers, InlineHandler21{})
+	InlineHandlers = append(InlineHandlers, InlineHandler22{})
+	InlineHandlers = append(InlineHandlers, InlineHandler23{})
+	InlineHandlers = append(InlineHandlers, InlineHandler24{})
+	InlineHandlers = append(InlineHandlers, InlineHandler25{})
+	InlineHandlers = append(InlineHandlers, InlineHandler26{})
+	InlineHandlers = append(InlineHandlers, InlineHandler27{})
+	InlineHandlers = append(InlineHandlers, InlineHandler28{})
+	InlineHandlers = append(InlineHandlers, InlineHandler29{})
+	InlineHandlers = append(InlineHandlers, InlineHandler30{})
+	InlineHandlers = append(InlineHandlers, InlineHandler31{})
+	InlineHandlers = append(InlineHandlers, InlineHandler32{})
+	InlineHandlers = append(InlineHandlers, InlineHandler33{})
+	InlineHandlers = append(InlineHandlers, InlineHandler34{})
+	InlineHandlers = append(InlineHandlers, InlineHandler35{})
+	InlineHandlers = append(InlineHandlers, InlineHandler36{})
+	InlineHandlers = append(InlineHandlers, InlineHandler37{})
+	InlineHandlers = append(InlineHandlers, InlineHandler38{})
+	InlineHandlers = append(InlineHandlers, InlineHandler39{})
+	InlineHandlers = append(InlineHandlers, InlineHandler40{})
+	InlineHandlers = append(InlineHandlers, InlineHandler41{})
+	InlineHandlers = append(InlineHandlers, InlineHandler42{})
+	InlineHandlers = append(InlineHandlers, InlineHandler43{})
+	InlineHandlers = append(InlineHandlers, InlineHandler44{})
+	InlineHandlers = append(InlineHandlers, InlineHandler45{})
+	InlineHandlers = append(InlineHandlers, InlineHandler46{})
+	InlineHandlers = append(InlineHandlers, InlineHandler47{})
+	InlineHandlers = append(InlineHandlers, InlineHandler48{})
+	InlineHandlers = append(InlineHandlers, InlineHandler49{})
+	InlineHandlers = append(InlineHandlers, InlineHandler50{})
+	InlineHandlers = append(InlineHandlers, InlineHandler51{})
+	InlineHandlers = append(InlineHandlers, InlineHandler52{})
+	InlineHandlers = append(InlineHandlers, InlineHandler53{})
+	InlineHandlers = append(InlineHandlers, InlineHandler54{})
+	InlineHandlers = append(InlineHandlers, InlineHandler55{})
+	InlineHandlers = append(InlineHandlers, InlineHandler56{})
+	InlineHandlers = append(InlineHandlers, InlineHandler57{})
+	InlineHandlers = append(InlineHandlers, InlineHandler58{})
+	InlineHandlers = append(InlineHandlers, InlineHandler59{})
+	InlineHandlers = append(InlineHandlers, InlineHandler60{})
+	InlineHandlers = append(InlineHandlers, InlineHandler61{})
+	InlineHandlers = append(InlineHandlers, InlineHandler62{})
+	InlineHandlers = append(InlineHandlers, InlineHandler63{})
+	InlineHandlers = append(InlineHandlers, InlineHandler64{})
+	InlineHandlers = append(InlineHandlers, InlineHandler65{})
+	InlineHandlers = append(InlineHandlers, InlineHandler66{})
+	InlineHandlers = append(InlineHandlers, InlineHandler67{})
+	InlineHandlers = append(InlineHandlers, InlineHandler68{})
+	InlineHandlers = append(InlineHandlers, InlineHandler69{})
+	InlineHandlers = append(InlineHandlers, InlineHandler70{})
+	InlineHandlers = append(InlineHandlers, InlineHandler71{})
+	InlineHandlers = append(InlineHandlers, InlineHandler72{})
+	InlineHandlers = append(InlineHandlers, InlineHandler73{})
+	InlineHandlers = append(InlineHandlers, InlineHandler74{})
+	InlineHandlers = append(InlineHandlers, InlineHandler75{})
+	InlineHandlers = append(InlineHandlers, InlineHandler76{})
+	InlineHandlers = append(InlineHandlers, InlineHandler77{})
+	InlineHandlers = append(InlineHandlers, InlineHandler78{})
+	InlineHandlers = append(InlineHandlers, InlineHandler79{})
+	InlineHandlers = append(InlineHandlers, InlineHandler80{})
+	InlineHandlers = append(InlineHandlers, InlineHandler81{})
+	InlineHandlers = append(InlineHandlers, InlineHandler82{})
+	InlineHandlers = append(InlineHandlers, InlineHandler83{})
+	InlineHandlers = append(InlineHandlers, InlineHandler84{})
+	InlineHandlers = append(InlineHandlers, InlineHandler85{})
+	InlineHandlers = append(InlineHandlers, InlineHandler86{})
+	InlineHandlers = append(InlineHandlers, InlineHandler87{})
+	InlineHandlers = append(InlineHandlers, InlineHandler88{})
+	InlineHandlers = append(InlineHandlers, InlineHandler89{})
+	InlineHandlers = append(InlineHandlers, InlineHandler90{})
+	InlineHandlers = append(InlineHandlers, InlineHandler91{})
+	InlineHandlers = append(InlineHandlers, InlineHandler92{})
+	InlineHandlers = append(InlineHandlers, InlineHandler93{})
+	InlineHandlers = append(InlineHandlers, InlineHandler94{})
+	InlineHandlers = append(InlineHandlers, InlineHandler95{})
+	InlineHandlers = append(InlineHandlers, InlineHandler96{})
+	InlineHandlers = append(InlineHandlers, InlineHandler97{})
+	InlineHandlers = append(InlineHandlers, InlineHandler98{})
+	InlineHandlers = append(InlineHandlers, InlineHandler99{})
+	InlineHandlers = append(InlineHandlers, InlineHandler100{})
+	InlineHandlers = append(InlineHandlers, InlineHandler101{})
+	InlineHandlers = append(InlineHandlers, InlineHandler102{})
+	InlineHandlers = append(InlineHandlers, InlineHandler103{})
+	InlineHandlers = append(InlineHandlers, InlineHandler104{})
+	InlineHandlers = append(InlineHandlers, InlineHandler105{})
+	InlineHandlers = append(InlineHandlers, InlineHandler106{})
+	InlineHandlers = append(InlineHandlers, InlineHandler107{})
+	InlineHandlers = append(InlineHandlers, InlineHandler108{})
+	InlineHandlers = append(InlineHandlers, InlineHandler109{})
+	InlineHandlers = append(InlineHandlers, InlineHandler110{})
+	InlineHandlers = append(InlineHandlers, InlineHandler111{})
+	InlineHandlers = append(InlineHandlers, InlineHandler112{})
+	InlineHandlers = append(InlineHandlers, InlineHandler113{})
+	InlineHandlers = append(InlineHandlers, InlineHandler114{})
+	InlineHandlers = append(InlineHandlers, InlineHandler115{})
+	InlineHandlers = append(InlineHandlers, InlineHandler116{})
+	InlineHandlers = append(InlineHandlers, InlineHandler117{})
+	InlineHandlers = append(InlineHandlers, InlineHandler118{})
+	InlineHandlers = append(InlineHandlers, InlineHandler119{})
+	InlineHandlers = append(InlineHandlers, InlineHandler120{})
+	InlineHandlers = append(InlineHandlers, InlineHandler121{})
+	InlineHandlers = append(InlineHandlers, InlineHandler122{})
+	InlineHandlers = append(InlineHandlers, InlineHandler123{})
+	InlineHandlers = append(InlineHandlers, InlineHandler124{})
+	InlineHandlers = append(InlineHandlers, InlineHandler125{})
+	InlineHandlers = append(InlineHandlers, InlineHandler126{})
+	InlineHandlers = append(InlineHandlers, InlineHandler127{})
+	InlineHandlers = append(InlineHandlers, InlineHandler128{})
+	InlineHandlers = append(InlineHandlers, InlineHandler129{})
+	InlineHandlers = append(InlineHandlers, InlineHandler130{})
+	InlineHandlers = append(InlineHandlers, InlineHandler131{})
+	InlineHandlers = append(InlineHandlers, InlineHandler132{})
+	InlineHandlers = append(InlineHandlers, InlineHandler133{})
+	InlineHandlers = append(InlineHandlers, InlineHandler134{})
+	InlineHandlers = append(InlineHandlers, InlineHandler135{})
+	InlineHandlers = append(InlineHandlers, InlineHandler136{})
+	InlineHandlers = append(InlineHandlers, InlineHandler137{})
+	InlineHandlers = append(InlineHandlers, InlineHandler138{})
+	InlineHandlers = append(InlineHandlers, InlineHandler139{})
+	InlineHandlers = append(InlineHandlers, InlineHandler140{})
+	InlineHandlers = append(InlineHandlers, InlineHandler141{})
+	InlineHandlers = append(InlineHandlers, InlineHandler142{})
+	InlineHandlers = append(InlineHandlers, InlineHandler143{})
+	InlineHandlers = append(InlineHandlers, InlineHandler144{})
+	InlineHandlers = append(InlineHandlers, InlineHandler145{})
+	InlineHandlers = append(InlineHandlers, InlineHandler146{})
+	InlineHandlers = append(InlineHandlers, InlineHandler147{})
+	InlineHandlers = append(InlineHandlers, InlineHandler148{})
+	InlineHandlers = append(InlineHandlers, InlineHandler149{})
+	InlineHandlers = append(InlineHandlers, InlineHandler150{})
+	InlineHandlers = append(InlineHandlers, InlineHandler151{})
+	InlineHandlers = append(InlineHandlers, InlineHandler152{})
+	InlineHandlers = append(InlineHandlers, InlineHandler153{})
+	InlineHandlers = append(InlineHandlers, InlineHandler154{})
+	InlineHandlers = append(InlineHandlers, InlineHandler155{})
+	InlineHandlers = append(InlineHandlers, InlineHandler156{})
+	InlineHandlers = append(InlineHandlers, InlineHandler157{})
+	InlineHandlers = append(InlineHandlers, InlineHandler158{})
+	InlineHandlers = append(InlineHandlers, InlineHandler159{})
+	InlineHandlers = append(InlineHandlers, InlineHandler160{})
+	InlineHandlers = append(InlineHandlers, InlineHandler161{})
+	InlineHandlers = append(InlineHandlers, InlineHandler162{})
+	InlineHandlers = append(InlineHandlers, InlineHandler163{})
+	InlineHandlers = append(InlineHandlers, InlineHandler164{})
+	InlineHandlers = append(InlineHandlers, InlineHandler165{})
+	InlineHandlers = append(InlineHandlers, InlineHandler166{})
+	InlineHandlers = append(InlineHandlers, InlineHandler167{})
+	InlineHandlers = append(InlineHandlers, InlineHandler168{})
+	InlineHandlers = append(InlineHandlers, InlineHandler169{})
+	InlineHandlers = append(InlineHandlers, InlineHandler170{})
+	InlineHandlers = append(InlineHandlers, InlineHandler171{})
+	InlineHandlers = append(InlineHandlers, InlineHandler172{})
+	InlineHandlers = append(InlineHandlers, InlineHandler173{})
+	InlineHandlers = append(InlineHandlers, InlineHandler174{})
+	InlineHandlers = append(InlineHandlers, InlineHandler175{})
+	InlineHandlers = append(InlineHandlers, InlineHandler176{})
+	InlineHandlers = append(InlineHandlers, InlineHandler177{})
+	InlineHandlers = append(InlineHandlers, InlineHandler178{})
+	InlineHandlers = append(InlineHandlers, InlineHandler179{})
+	InlineHandlers = append(InlineHandlers, InlineHandler180{})
+	InlineHandlers = append(InlineHandlers, InlineHandler181{})
+	InlineHandlers = append(InlineHandlers, InlineHandler182{})
+	InlineHandlers = append(InlineHandlers, InlineHandler183{})
+	InlineHandlers = append(InlineHandlers, InlineHandler184{})
+	InlineHandlers = append(InlineHandlers, InlineHandler185{})
+	InlineHandlers = append(InlineHandlers, InlineHandler186{})
+	InlineHandlers = append(InlineHandlers, InlineHandler187{})
+	InlineHandlers = append(InlineHandlers, InlineHandler188{})
+	InlineHandlers = append(InlineHandlers, InlineHandler189{})
+	InlineHandlers = append(InlineHandlers, InlineHandler190{})
+	InlineHandlers = append(InlineHandlers, InlineHandler191{})
+	InlineHandlers = append(InlineHandlers, InlineHandler192{})
+	InlineHandlers = append(InlineHandlers, InlineHandler193{})
+	InlineHandlers = append(InlineHandlers, InlineHandler194{})
+	InlineHandlers = append(InlineHandlers, InlineHandler195{})
+	InlineHandlers = append(InlineHandlers, InlineHandler196{})
+	InlineHandlers = append(InlineHandlers, InlineHandler197{})
+	InlineHandlers = append(InlineHandlers, InlineHandler198{})
+	InlineHandlers = append(InlineHandlers, InlineHandler199{})
+	InlineHandlers = append(InlineHandlers, InlineHandler200{})
+	InlineHandlers = append(InlineHandlers, InlineHandler201{})
+	InlineHandlers = append(InlineHandlers, InlineHandler202{})
+	InlineHandlers = append(InlineHandlers, InlineHandler203{})
+	InlineHandlers = append(InlineHandlers, InlineHandler204{})
+	InlineHandlers = append(InlineHandlers, InlineHandler205{})
+	InlineHandlers = append(InlineHandlers, InlineHandler206{})
+	InlineHandlers = append(InlineHandlers, InlineHandler207{})
+	InlineHandlers = append(InlineHandlers, InlineHandler208{})
+	InlineHandlers = append(InlineHandlers, InlineHandler209{})
+	InlineHandlers = append(InlineHandlers, InlineHandler210{})
+	InlineHandlers = append(InlineHandlers, InlineHandler211{})
+	InlineHandlers = append(InlineHandlers, InlineHandler212{})
+	InlineHandlers = append(InlineHandlers, InlineHandler213{})
+	InlineHandlers = append(InlineHandlers, InlineHandler214{})
+	InlineHandlers = append(InlineHandlers, InlineHandler215{})
+	InlineHandlers = append(InlineHandlers, InlineHandler216{})
+	InlineHandlers = append(InlineHandlers, InlineHandler217{})
+	InlineHandlers = append(InlineHandlers, InlineHandler218{})
+	InlineHandlers = append(InlineHandlers, InlineHandler219{})
+	InlineHandlers = append(InlineHandlers, InlineHandler220{})
+	InlineHandlers = append(InlineHandlers, InlineHandler221{})
+	InlineHandlers = append(InlineHandlers, InlineHandler222{})
+	InlineHandlers = append(InlineHandlers, InlineHandler223{})
+	InlineHandlers = append(InlineHandlers, InlineHandler224{})
+	InlineHandlers = append(InlineHandlers, InlineHandler225{})
+	InlineHandlers = append(InlineHandlers, InlineHandler226{})
+	InlineHandlers = append(InlineHandlers, InlineHandler227{})
+	InlineHandlers = append(InlineHandlers, InlineHandler228{})
+	InlineHandlers = append(InlineHandlers, InlineHandler229{})
+	InlineHandlers = append(InlineHandlers, InlineHandler230{})
+	InlineHandlers = append(InlineHandlers, InlineHandler231{})
+	InlineHandlers = append(InlineHandlers, InlineHandler232{})
+	InlineHandlers = append(InlineHandlers, InlineHandler233{})
+	InlineHandlers = append(InlineHandlers, InlineHandler234{})
+	InlineHandlers = append(InlineHandlers, InlineHandler235{})
+	InlineHandlers = append(InlineHandlers, InlineHandler236{})
+	InlineHandlers = append(InlineHandlers, InlineHandler237{})
+	InlineHandlers = append(InlineHandlers, InlineHandler238{})
+	InlineHandlers = append(InlineHandlers, InlineHandler239{})
+	InlineHandlers = append(InlineHandlers, InlineHandler240{})
+	InlineHandlers = append(InlineHandlers, InlineHandler241{})
+	InlineHandlers = append(InlineHandlers, InlineHandler242{})
+	InlineHandlers = append(InlineHandlers, InlineHandler243{})
+	InlineHandlers = append(InlineHandlers, InlineHandler244{})
+	InlineHandlers = append(InlineHandlers, InlineHandler245{})
+	InlineHandlers = append(InlineHandlers, InlineHandler246{})
+	InlineHandlers = append(InlineHandlers, InlineHandler247{})
+	InlineHandlers = append(InlineHandlers, InlineHandler248{})
+	InlineHandlers = append(InlineHandlers, InlineHandler249{})
+	InlineHandlers = append(InlineHandlers, InlineHandler250{})
+	InlineHandlers = append(InlineHandlers, InlineHandler251{})
+	InlineHandlers = append(InlineHandlers, InlineHandler252{})
+	InlineHandlers = append(InlineHandlers, InlineHandler253{})
+	InlineHandlers = append(InlineHandlers, InlineHandler254{})
+	InlineHandlers = append(InlineHandlers, InlineHandler255{})
+	InlineHandlers = append(InlineHandlers, InlineHandler256{})
+	InlineHandlers = append(InlineHandlers, InlineHandler257{})
+	InlineHandlers = append(InlineHandlers, InlineHandler258{})
+	InlineHandlers = append(InlineHandlers, InlineHandler259{})
+	InlineHandlers = append(InlineHandlers, InlineHandler260{})
+	InlineHandlers = append(InlineHandlers, InlineHandler261{})
+	InlineHandlers = append(InlineHandlers, InlineHandler262{})
+	InlineHandlers = append(InlineHandlers, InlineHandler263{})
+	InlineHandlers = append(InlineHandlers, InlineHandler264{})
+	InlineHandlers = append(InlineHandlers, InlineHandler265{})
+	InlineHandlers = append(InlineHandlers, InlineHandler266{})
+	InlineHandlers = append(InlineHandlers, InlineHandler267{})
+	InlineHandlers = append(InlineHandlers, InlineHandler268{})
+	InlineHandlers = append(InlineHandlers, InlineHandler269{})
+	InlineHandlers = append(InlineHandlers, InlineHandler270{})
+	InlineHandlers = append(InlineHandlers, InlineHandler271{})
+	InlineHandlers = append(InlineHandlers, InlineHandler272{})
+	InlineHandlers = append(InlineHandlers, InlineHandler273{})
+	InlineHandlers = append(InlineHandlers, InlineHandler274{})
+	InlineHandlers = append(InlineHandlers, InlineHandler275{})
+	InlineHandlers = append(InlineHandlers, InlineHandler276{})
+	InlineHandlers = append(InlineHandlers, InlineHandler277{})
+	InlineHandlers = append(InlineHandlers, InlineHandler278{})
+	InlineHandlers = append(InlineHandlers, InlineHandler279{})
+	InlineHandlers = append(InlineHandlers, InlineHandler280{})
+	InlineHandlers = append(InlineHandlers, InlineHandler281{})
+	InlineHandlers = append(InlineHandlers, InlineHandler282{})
+	InlineHandlers = append(InlineHandlers, InlineHandler283{})
+	InlineHandlers = append(InlineHandlers, InlineHandler284{})
+	InlineHandlers = append(InlineHandlers, InlineHandler285{})
+	InlineHandlers = append(InlineHandlers, InlineHandler286{})
+	InlineHandlers = append(InlineHandlers, InlineHandler287{})
+	InlineHandlers = append(InlineHandlers, InlineHandler288{})
+	InlineHandlers = append(InlineHandlers, InlineHandler289{})
+	InlineHandlers = append(InlineHandlers, InlineHandler290{})
+	InlineHandlers = append(InlineHandlers, InlineHandler291{})
+	InlineHandlers = append(InlineHandlers, InlineHandler292{})
+	InlineHandlers = append(InlineHandlers, InlineHandler293{})
+	InlineHandlers = append(InlineHandlers, InlineHandler294{})
+	InlineHandlers = append(InlineHandlers, InlineHandler295{})
+	InlineHandlers = append(InlineHandlers, InlineHandler296{})
+	InlineHandlers = append(InlineHandlers, InlineHandler297{})
+	InlineHandlers = append(InlineHandlers, InlineHandler298{})
+	InlineHandlers = append(InlineHandlers, InlineHandler299{})
+	InlineHandlers = append(InlineHandlers, InlineHandler300{})
+	InlineHandlers = append(InlineHandlers, InlineHandler301{})
+	InlineHandlers = append(InlineHandlers, InlineHandler302{})
+	InlineHandlers = append(InlineHandlers, InlineHandler303{})
+	InlineHandlers = append(InlineHandlers, InlineHandler304{})
+	InlineHandlers = append(InlineHandlers, InlineHandler305{})
+	InlineHandlers = append(InlineHandlers, InlineHandler306{})
+	InlineHandlers = append(InlineHandlers, InlineHandler307{})
+	InlineHandlers = append(InlineHandlers, InlineHandler308{})
+	InlineHandlers = append(InlineHandlers, InlineHandler309{})
+	InlineHandlers = append(InlineHandlers, InlineHandler310{})
+	InlineHandlers = append(InlineHandlers, InlineHandler311{})
+	InlineHandlers = append(InlineHandlers, InlineHandler312{})
+	InlineHandlers = append(InlineHandlers, InlineHandler313{})
+	InlineHandlers = append(InlineHandlers, InlineHandler314{})
+	InlineHandlers = append(InlineHandlers, InlineHandler315{})
+	InlineHandlers = append(InlineHandlers, InlineHandler316{})
+	InlineHandlers = append(InlineHandlers, InlineHandler317{})
+	InlineHandlers = append(InlineHandlers, InlineHandler318{})
+	InlineHandlers = append(InlineHandlers, InlineHandler319{})
+	InlineHandlers = append(InlineHandlers, InlineHandler320{})
+	InlineHandlers = append(InlineHandlers, InlineHandler321{})
+	InlineHandlers = append(InlineHandlers, InlineHandler322{})
+	InlineHandlers = append(InlineHandlers, InlineHandler323{})
+	InlineHandlers = append(InlineHandlers, InlineHandler324{})
+	InlineHandlers = append(InlineHandlers, InlineHandler325{})
+	InlineHandlers = append(InlineHandlers, InlineHandler326{})
+	InlineHandlers = append(InlineHandlers, InlineHandler327{})
+	InlineHandlers = append(InlineHandlers, InlineHandler328{})
+	InlineHandlers = append(InlineHandlers, InlineHandler329{})
+	InlineHandlers = append(InlineHandlers, InlineHandler330{})
+	InlineHandlers = append(InlineHandlers, InlineHandler331{})
+	InlineHandlers = append(InlineHandlers, InlineHandler332{})
+	InlineHandlers = append(InlineHandlers, InlineHandler333{})
+	InlineHandlers = append(InlineHandlers, InlineHandler334{})
+	InlineHandlers = append(InlineHandlers, InlineHandler335{})
+	InlineHandlers = append(InlineHandlers, InlineHandler336{})
+	InlineHandlers = append(InlineHandlers, InlineHandler337{})
+	InlineHandlers = append(InlineHandlers, InlineHandler338{})
+	InlineHandlers = append(InlineHandlers, InlineHandler339{})
+	InlineHandlers = append(InlineHandlers, InlineHandler340{})
+	InlineHandlers = append(InlineHandlers, InlineHandler341{})
+	InlineHandlers = append(InlineHandlers, InlineHandler342{})
+	InlineHandlers = append(InlineHandlers, InlineHandler343{})
+	InlineHandlers = append(InlineHandlers, InlineHandler344{})
+	InlineHandlers = append(InlineHandlers, InlineHandler345{})
+	InlineHandlers = append(InlineHandlers, InlineHandler346{})
+	InlineHandlers = append(InlineHandlers, InlineHandler347{})
+	InlineHandlers = append(InlineHandlers, InlineHandler348{})
+	InlineHandlers = append(InlineHandlers, InlineHandler349{})
+	InlineHandlers = append(InlineHandlers, InlineHandler350{})
+	InlineHandlers = append(InlineHandlers, InlineHandler351{})
+	InlineHandlers = append(InlineHandlers, InlineHandler352{})
+	InlineHandlers = append(InlineHandlers, InlineHandler353{})
+	InlineHandlers = append(InlineHandlers, InlineHandler354{})
+	InlineHandlers = append(InlineHandlers, InlineHandler355{})
+	InlineHandlers = append(InlineHandlers, InlineHandler356{})
+	InlineHandlers = append(InlineHandlers, InlineHandler357{})
+	InlineHandlers = append(InlineHandlers, InlineHandler358{})
+	InlineHandlers = append(InlineHandlers, InlineHandler359{})
+	InlineHandlers = append(InlineHandlers, InlineHandler360{})
+	InlineHandlers = append(InlineHandlers, InlineHandler361{})
+	InlineHandlers = append(InlineHandlers, InlineHandler362{})
+	InlineHandlers = append(InlineHandlers, InlineHandler363{})
+	InlineHandlers = append(InlineHandlers, InlineHandler364{})
+	InlineHandlers = append(InlineHandlers, InlineHandler365{})
+	InlineHandlers = append(InlineHandlers, InlineHandler366{})
+	InlineHandlers = append(InlineHandlers, InlineHandler367{})
+	InlineHandlers = append(InlineHandlers, InlineHandler368{})
+	InlineHandlers = append(InlineHandlers, InlineHandler369{})
+	InlineHandlers = append(InlineHandlers, InlineHandler370{})
+	InlineHandlers = append(InlineHandlers, InlineHandler371{})
+	InlineHandlers = append(InlineHandlers, InlineHandler372{})
+	InlineHandlers = append(InlineHandlers, InlineHandler373{})
+	InlineHandlers = append(InlineHandlers, InlineHandler374{})
+	InlineHandlers = append(InlineHandlers, InlineHandler375{})
+	InlineHandlers = append(InlineHandlers, InlineHandler376{})
+	InlineHandlers = append(InlineHandlers, InlineHandler377{})
+	InlineHandlers = append(InlineHandlers, InlineHandler378{})
+	InlineHandlers = append(InlineHandlers, InlineHandler379{})
+	InlineHandlers = append(InlineHandlers, InlineHandler380{})
+	InlineHandlers = append(InlineHandlers, InlineHandler381{})
+	InlineHandlers = append(InlineHandlers, InlineHandler382{})
+	InlineHandlers = append(InlineHandlers, InlineHandler383{})
+	InlineHandlers = append(InlineHandlers, InlineHandler384{})
+	InlineHandlers = append(InlineHandlers, InlineHandler385{})
+	InlineHandlers = append(InlineHandlers, InlineHandler386{})
+	InlineHandlers = append(InlineHandlers, InlineHandler387{})
+	InlineHandlers = append(InlineHandlers, InlineHandler388{})
+	InlineHandlers = append(InlineHandlers, InlineHandler389{})
+	InlineHandlers = append(InlineHandlers, InlineHandler390{})
+	InlineHandlers = append(InlineHandlers, InlineHandler391{})
+	InlineHandlers = append(InlineHandlers, InlineHandler392{})
+	InlineHandlers = append(InlineHandlers, InlineHandler393{})
+	InlineHandlers = append(InlineHandlers, InlineHandler394{})
+	InlineHandlers = append(InlineHandlers, InlineHandler395{})
+	InlineHandlers = append(InlineHandlers, InlineHandler396{})
+	InlineHandlers = append(InlineHandlers, InlineHandler397{})
+	InlineHandlers = append(InlineHandlers, InlineHandler398{})
+	InlineHandlers = append(InlineHandlers, InlineHandler399{})
+	InlineHandlers = append(InlineHandlers, InlineHandler400{})
+	InlineHandlers = append(InlineHandlers, InlineHandler401{})
+	InlineHandlers = append(InlineHandlers, InlineHandler402{})
+	InlineHandlers = append(InlineHandlers, InlineHandler403{})
+	InlineHandlers = append(InlineHandlers, InlineHandler404{})
+	InlineHandlers = append(InlineHandlers, InlineHandler405{})
+	InlineHandlers = append(InlineHandlers, InlineHandler406{})
+	InlineHandlers = append(InlineHandlers, InlineHandler407{})
+	InlineHandlers = append(InlineHandlers, InlineHandler408{})
+	InlineHandlers = append(InlineHandlers, InlineHandler409{})
+	InlineHandlers = append(InlineHandlers, InlineHandler410{})
+	InlineHandlers = append(InlineHandlers, InlineHandler411{})
+	InlineHandlers = append(InlineHandlers, InlineHandler412{})
+	InlineHandlers = append(InlineHandlers, InlineHandler413{})
+	InlineHandlers = append(InlineHandlers, InlineHandler414{})
+	InlineHandlers = append(InlineHandlers, InlineHandler415{})
+	InlineHandlers = append(InlineHandlers, InlineHandler416{})
+	InlineHandlers = append(InlineHandlers, InlineHandler417{})
+	InlineHandlers = append(InlineHandlers, InlineHandler418{})
+	InlineHandlers = append(InlineHandlers, InlineHandler419{})
+	InlineHandlers = append(InlineHandlers, InlineHandler420{})
+	InlineHandlers = append(InlineHandlers, InlineHandler421{})
+	InlineHandlers = append(InlineHandlers, InlineHandler422{})
+	InlineHandlers = append(InlineHandlers, InlineHandler423{})
+	InlineHandlers = append(InlineHandlers, InlineHandler424{})
+	InlineHandlers = append(InlineHandlers, InlineHandler425{})
+	InlineHandlers = append(InlineHandlers, InlineHandler426{})
+	InlineHandlers = append(InlineHandlers, InlineHandler427{})
+	InlineHandlers = append(InlineHandlers, InlineHandler428{})
+	InlineHandlers = append(InlineHandlers, InlineHandler429{})
+	InlineHandlers = append(InlineHandlers, InlineHandler430{})
+	InlineHandlers = append(InlineHandlers, InlineHandler431{})
+	InlineHandlers = append(InlineHandlers, InlineHandler432{})
+	InlineHandlers = append(InlineHandlers, InlineHandler433{})
+	InlineHandlers = append(InlineHandlers, InlineHandler434{})
+	InlineHandlers = append(InlineHandlers, InlineHandler435{})
+	InlineHandlers = append(InlineHandlers, InlineHandler436{})
+	InlineHandlers = append(InlineHandlers, InlineHandler437{})
+	InlineHandlers = append(InlineHandlers, InlineHandler438{})
+	InlineHandlers = append(InlineHandlers, InlineHandler439{})
+	InlineHandlers = append(InlineHandlers, InlineHandler440{})
+	InlineHandlers = append(InlineHandlers, InlineHandler441{})
+	InlineHandlers = append(InlineHandlers, InlineHandler442{})
+	InlineHandlers = append(InlineHandlers, InlineHandler443{})
+	InlineHandlers = append(InlineHandlers, InlineHandler444{})
+	InlineHandlers = append(InlineHandlers, InlineHandler445{})
+	InlineHandlers = append(InlineHandlers, InlineHandler446{})
+	InlineHandlers = append(InlineHandlers, InlineHandler447{})
+	InlineHandlers = append(InlineHandlers, InlineHandler448{})
+	InlineHandlers = append(InlineHandlers, InlineHandler449{})
+	InlineHandlers = append(InlineHandlers, InlineHandler450{})
+	InlineHandlers = append(InlineHandlers, InlineHandler451{})
+	InlineHandlers = append(InlineHandlers, InlineHandler452{})
+	InlineHandlers = append(InlineHandlers, InlineHandler453{})
+	InlineHandlers = append(InlineHandlers, InlineHandler454{})
+	InlineHandlers = append(InlineHandlers, InlineHandler455{})
+	InlineHandlers = append(InlineHandlers, InlineHandler456{})
+	InlineHandlers = append(InlineHandlers, InlineHandler457{})
+	InlineHandlers = append(InlineHandlers, InlineHandler458{})
+	InlineHandlers = append(InlineHandlers, InlineHandler459{})
+	InlineHandlers = append(InlineHandlers, InlineHandler460{})
+	InlineHandlers = append(InlineHandlers, InlineHandler461{})
+	InlineHandlers = append(InlineHandlers, InlineHandler462{})
+	InlineHandlers = append(InlineHandlers, InlineHandler463{})
+	InlineHandlers = append(InlineHandlers, InlineHandler464{})
+	InlineHandlers = append(InlineHandlers, InlineHandler465{})
+	InlineHandlers = append(InlineHandlers, InlineHandler466{})
+	InlineHandlers = append(InlineHandlers, InlineHandler467{})
+	InlineHandlers = append(InlineHandlers, InlineHandler468{})
+	InlineHandlers = append(InlineHandlers, InlineHandler469{})
+	InlineHandlers = append(InlineHandlers, InlineHandler470{})
+	InlineHandlers = append(InlineHandlers, InlineHandler471{})
+	InlineHandlers = append(InlineHandlers, InlineHandler472{})
+	InlineHandlers = append(InlineHandlers, InlineHandler473{})
+	InlineHandlers = append(InlineHandlers, InlineHandler474{})
+	InlineHandlers = append(InlineHandlers, InlineHandler475{})
+	InlineHandlers = append(InlineHandlers, InlineHandler476{})
+	InlineHandlers = append(InlineHandlers, InlineHandler477{})
+	InlineHandlers = append(InlineHandlers, InlineHandler478{})
+	InlineHandlers = append(InlineHandlers, InlineHandler479{})
+	InlineHandlers = append(InlineHandlers, InlineHandler480{})
+	InlineHandlers = append(InlineHandlers, InlineHandler481{})
+	InlineHandlers = append(InlineHandlers, InlineHandler482{})
+	InlineHandlers = append(InlineHandlers, InlineHandler483{})
+	InlineHandlers = append(InlineHandlers, InlineHandler484{})
+	InlineHandlers = append(InlineHandlers, InlineHandler485{})
+	InlineHandlers = append(InlineHandlers, InlineHandler486{})
+	InlineHandlers = append(InlineHandlers, InlineHandler487{})
+	InlineHandlers = append(InlineHandlers, InlineHandler488{})
+	InlineHandlers = append(InlineHandlers, InlineHandler489{})
+	InlineHandlers = append(InlineHandlers, InlineHandler490{})
+	InlineHandlers = append(InlineHandlers, InlineHandler491{})
+	InlineHandlers = append(InlineHandlers, InlineHandler492{})
+	InlineHandlers = append(InlineHandlers, InlineHandler493{})
+	InlineHandlers = append(InlineHandlers, InlineHandler494{})
+	InlineHandlers = append(InlineHandlers, InlineHandler495{})
+	InlineHandlers = append(InlineHandlers, InlineHandler496{})
+	InlineHandlers = append(InlineHandlers, InlineHandler497{})
+	InlineHandlers = append(InlineHandlers, InlineHandler498{})
+	InlineHandlers = append(InlineHandlers, InlineHandler499{})
+	InlineHandlers = append(InlineHandlers, InlineHandler500{})
+	InlineHandlers = append(InlineHandlers, InlineHandler501{})
+	InlineHandlers = append(InlineHandlers, InlineHandler502{})
+	InlineHandlers = append(InlineHandlers, InlineHandler503{})
+	InlineHandlers = append(InlineHandlers, InlineHandler504{})
+	InlineHandlers = append(InlineHandlers, InlineHandler505{})
+	InlineHandlers = append(InlineHandlers, InlineHandler506{})
+	InlineHandlers = append(InlineHandlers, InlineHandler507{})
+	InlineHandlers = append(InlineHandlers, InlineHandler508{})
+	InlineHandlers = append(InlineHandlers, InlineHandler509{})
+	InlineHandlers = append(InlineHandlers, InlineHandler510{})
+	InlineHandlers = append(InlineHandlers, InlineHandler511{})
+
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler0{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler1{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler2{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler3{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler4{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler5{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler6{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler7{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler8{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler9{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler10{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler11{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler12{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler13{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler14{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler15{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler16{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler17{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler18{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler19{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler20{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler21{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler22{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler23{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler24{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler25{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler26{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler27{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler28{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler29{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler30{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler31{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler32{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler33{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler34{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler35{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler36{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler37{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler38{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler39{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler40{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler41{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler42{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler43{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler44{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler45{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler46{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler47{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler48{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler49{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler50{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler51{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler52{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler53{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler54{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler55{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler56{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler57{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler58{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler59{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler60{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler61{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler62{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler63{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler64{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler65{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler66{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler67{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler68{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler69{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler70{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler71{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler72{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler73{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler74{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler75{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler76{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler77{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler78{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler79{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler80{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler81{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler82{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler83{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler84{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler85{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler86{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler87{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler88{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler89{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler90{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler91{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler92{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler93{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler94{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler95{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler96{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler97{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler98{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler99{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler100{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler101{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler102{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler103{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler104{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler105{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler106{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler107{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler108{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler109{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler110{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler111{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler112{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler113{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler114{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler115{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler116{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler117{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler118{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler119{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler120{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler121{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler122{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler123{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler124{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler125{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler126{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler127{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler128{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler129{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler130{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler131{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler132{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler133{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler134{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler135{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler136{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler137{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler138{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler139{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler140{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler141{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler142{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler143{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler144{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler145{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler146{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler147{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler148{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler149{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler150{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler151{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler152{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler153{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler154{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler155{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler156{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler157{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler158{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler159{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler160{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler161{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler162{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler163{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler164{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler165{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler166{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler167{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler168{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler169{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler170{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler171{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler172{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler173{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler174{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler175{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler176{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler177{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler178{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler179{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler180{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler181{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler182{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler183{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler184{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler185{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler186{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler187{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler188{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler189{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler190{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler191{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler192{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler193{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler194{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler195{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler196{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler197{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler198{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler199{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler200{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler201{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler202{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler203{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler204{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler205{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler206{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler207{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler208{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler209{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler210{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler211{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler212{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler213{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler214{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler215{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler216{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler217{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler218{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler219{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler220{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler221{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler222{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler223{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler224{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler225{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler226{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler227{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler228{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler229{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler230{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler231{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler232{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler233{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler234{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler235{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler236{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler237{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler238{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler239{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler240{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler241{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler242{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler243{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler244{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler245{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler246{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler247{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler248{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler249{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler250{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler251{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler252{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler253{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler254{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler255{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler256{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler257{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler258{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler259{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler260{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler261{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler262{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler263{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler264{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler265{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler266{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler267{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler268{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler269{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler270{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler271{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler272{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler273{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler274{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler275{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler276{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler277{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler278{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler279{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler280{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler281{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler282{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler283{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler284{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler285{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler286{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler287{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler288{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler289{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler290{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler291{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler292{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler293{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler294{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler295{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler296{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler297{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler298{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler299{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler300{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler301{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler302{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler303{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler304{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler305{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler306{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler307{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler308{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler309{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler310{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler311{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler312{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler313{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler314{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler315{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler316{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler317{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler318{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler319{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler320{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler321{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler322{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler323{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler324{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler325{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler326{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler327{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler328{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler329{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler330{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler331{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler332{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler333{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler334{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler335{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler336{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler337{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler338{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler339{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler340{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler341{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler342{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler343{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler344{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler345{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler346{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler347{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler348{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler349{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler350{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler351{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler352{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler353{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler354{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler355{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler356{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler357{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler358{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler359{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler360{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler361{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler362{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler363{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler364{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler365{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler366{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler367{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler368{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler369{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler370{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler371{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler372{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler373{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler374{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler375{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler376{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler377{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler378{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler379{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler380{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler381{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler382{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler383{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler384{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler385{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler386{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler387{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler388{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler389{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler390{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler391{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler392{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler393{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler394{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler395{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler396{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler397{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler398{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler399{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler400{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler401{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler402{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler403{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler404{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler405{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler406{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler407{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler408{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler409{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler410{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler411{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler412{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler413{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler414{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler415{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler416{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler417{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler418{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler419{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler420{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler421{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler422{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler423{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler424{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler425{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler426{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler427{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler428{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler429{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler430{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler431{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler432{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler433{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler434{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler435{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler436{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler437{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler438{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler439{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler440{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler441{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler442{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler443{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler444{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler445{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler446{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler447{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler448{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler449{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler450{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler451{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler452{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler453{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler454{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler455{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler456{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler457{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler458{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler459{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler460{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler461{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler462{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler463{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler464{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler465{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler466{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler467{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler468{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler469{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler470{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler471{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler472{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler473{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler474{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler475{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler476{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler477{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler478{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler479{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler480{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler481{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler482{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler483{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler484{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler485{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler486{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler487{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler488{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler489{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler490{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler491{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler492{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler493{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler494{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler495{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler496{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler497{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler498{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler499{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler500{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler501{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler502{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler503{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler504{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler505{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler506{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler507{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler508{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler509{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler510{})
+	NoInlineHandlers = append(NoInlineHandlers, NoInlineHandler511{})
+
+	for _, h := range InlineHandlers {
+		InlineMessages = append(InlineMessages, h)
+	}
+
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler0)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler1)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler2)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler3)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler4)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler5)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler6)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler7)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler8)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler9)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler10)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler11)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler12)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler13)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler14)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler15)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler16)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler17)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler18)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler19)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler20)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler21)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler22)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler23)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler24)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler25)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler26)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler27)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler28)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler29)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler30)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler31)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler32)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler33)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler34)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler35)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler36)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler37)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler38)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler39)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler40)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler41)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler42)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler43)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler44)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler45)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler46)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler47)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler48)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler49)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler50)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler51)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler52)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler53)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler54)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler55)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler56)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler57)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler58)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler59)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler60)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler61)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler62)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler63)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler64)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler65)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler66)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler67)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler68)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler69)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler70)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler71)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler72)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler73)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler74)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler75)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler76)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler77)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler78)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler79)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler80)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler81)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler82)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler83)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler84)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler85)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler86)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler87)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler88)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler89)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler90)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler91)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler92)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler93)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler94)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler95)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler96)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler97)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler98)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler99)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler100)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler101)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler102)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler103)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler104)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler105)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler106)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler107)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler108)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler109)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler110)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler111)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler112)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler113)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler114)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler115)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler116)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler117)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler118)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler119)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler120)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler121)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler122)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler123)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler124)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler125)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler126)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler127)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler128)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler129)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler130)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler131)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler132)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler133)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler134)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler135)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler136)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler137)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler138)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler139)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler140)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler141)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler142)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler143)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler144)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler145)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler146)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler147)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler148)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler149)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler150)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler151)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler152)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler153)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler154)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler155)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler156)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler157)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler158)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler159)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler160)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler161)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler162)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler163)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler164)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler165)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler166)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler167)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler168)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler169)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler170)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler171)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler172)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler173)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler174)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler175)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler176)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler177)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler178)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler179)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler180)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler181)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler182)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler183)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler184)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler185)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler186)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler187)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler188)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler189)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler190)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler191)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler192)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler193)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler194)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler195)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler196)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler197)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler198)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler199)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler200)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler201)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler202)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler203)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler204)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler205)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler206)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler207)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler208)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler209)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler210)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler211)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler212)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler213)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler214)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler215)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler216)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler217)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler218)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler219)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler220)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler221)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler222)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler223)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler224)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler225)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler226)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler227)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler228)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler229)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler230)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler231)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler232)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler233)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler234)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler235)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler236)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler237)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler238)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler239)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler240)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler241)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler242)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler243)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler244)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler245)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler246)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler247)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler248)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler249)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler250)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler251)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler252)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler253)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler254)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler255)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler256)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler257)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler258)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler259)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler260)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler261)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler262)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler263)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler264)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler265)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler266)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler267)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler268)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler269)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler270)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler271)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler272)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler273)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler274)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler275)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler276)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler277)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler278)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler279)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler280)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler281)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler282)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler283)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler284)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler285)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler286)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler287)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler288)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler289)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler290)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler291)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler292)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler293)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler294)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler295)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler296)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler297)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler298)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler299)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler300)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler301)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler302)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler303)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler304)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler305)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler306)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler307)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler308)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler309)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler310)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler311)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler312)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler313)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler314)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler315)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler316)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler317)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler318)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler319)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler320)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler321)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler322)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler323)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler324)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler325)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler326)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler327)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler328)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler329)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler330)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler331)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler332)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler333)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler334)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler335)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler336)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler337)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler338)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler339)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler340)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler341)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler342)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler343)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler344)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler345)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler346)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler347)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler348)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler349)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler350)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler351)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler352)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler353)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler354)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler355)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler356)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler357)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler358)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler359)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler360)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler361)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler362)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler363)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler364)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler365)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler366)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler367)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler368)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler369)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler370)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler371)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler372)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler373)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler374)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler375)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler376)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler377)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler378)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler379)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler380)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler381)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler382)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler383)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler384)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler385)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler386)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler387)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler388)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler389)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler390)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler391)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler392)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler393)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler394)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler395)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler396)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler397)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler398)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler399)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler400)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler401)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler402)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler403)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler404)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler405)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler406)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler407)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler408)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler409)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler410)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler411)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler412)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler413)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler414)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler415)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler416)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler417)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler418)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler419)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler420)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler421)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler422)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler423)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler424)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler425)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler426)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler427)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler428)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler429)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler430)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler431)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler432)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler433)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler434)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler435)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler436)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler437)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler438)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler439)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler440)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler441)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler442)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler443)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler444)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler445)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler446)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler447)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler448)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler449)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler450)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler451)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler452)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler453)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler454)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler455)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler456)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler457)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler458)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler459)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler460)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler461)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler462)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler463)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler464)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler465)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler466)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler467)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler468)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler469)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler470)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler471)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler472)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler473)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler474)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler475)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler476)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler477)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler478)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler479)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler480)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler481)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler482)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler483)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler484)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler485)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler486)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler487)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler488)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler489)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler490)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler491)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler492)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler493)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler494)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler495)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler496)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler497)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler498)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler499)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler500)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler501)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler502)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler503)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler504)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler505)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler506)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler507)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler508)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler509)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler510)
+	InlineTypeHandlers = append(InlineTypeHandlers, InlineTypeHandler511)
+
+	InlineTypeMap4 = make(map[reflect.Type]func(interface{}, int) int, 4)
+	for i, m := range InlineMessages[:4] {
+		InlineTypeMap4[reflect.TypeOf(m)] = InlineTypeHandlers[i]
+	}
+
+	InlineTypeMap8 = make(map[reflect.Type]func(interface{}, int) int, 8)
+	for i, m := range InlineMessages[:8] {
+		InlineTypeMap8[reflect.TypeOf(m)] = InlineTypeHandlers[i]
+	}
+
+	InlineTypeMap16 = make(map[reflect.Type]func(interface{}, int) int, 16)
+	for i, m := range InlineMessages[:16] {
+		InlineTypeMap16[reflect.TypeOf(m)] = InlineTypeHandlers[i]
+	}
+
+	InlineTypeMap32 = make(map[reflect.Type]func(interface{}, int) int, 32)
+	for i, m := range InlineMessages[:32] {
+		InlineTypeMap32[reflect.TypeOf(m)] = InlineTypeHandlers[i]
+	}
+
+	InlineTypeMap64 = make(map[reflect.Type]func(interface{}, int) int, 64)
+	for i, m := range InlineMessages[:64] {
+		InlineTypeMap64[reflect.TypeOf(m)] = InlineTypeHandlers[i]
+	}
+
+	InlineTypeMap128 = make(map[reflect.Type]func(interface{}, int) int, 128)
+	for i, m := range InlineMessages[:128] {
+		InlineTypeMap128[reflect.TypeOf(m)] = InlineTypeHandlers[i]
+	}
+
+	InlineTypeMap256 = make(map[reflect.Type]func(interface{}, int) int, 256)
+	for i, m := range InlineMessages[:256] {
+		InlineTypeMap256[reflect.TypeOf(m)] = InlineTypeHandlers[i]
+	}
+
+	InlineTypeMap512 = make(map[reflect.Type]func(interface{}, int) int, 512)
+	for i, m := range InlineMessages[:512] {
+		InlineTypeMap512[reflect.TypeOf(m)] = InlineTypeHandlers[i]
+	}
+
+	for _, h := range NoInlineHandlers {
+		NoInlineMessages = append(NoInlineMessages, h)
+	}
+
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler0)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler1)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler2)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler3)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler4)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler5)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler6)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler7)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler8)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler9)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler10)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler11)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler12)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler13)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler14)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler15)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler16)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler17)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler18)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler19)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler20)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler21)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler22)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler23)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler24)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler25)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler26)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler27)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler28)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler29)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler30)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler31)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler32)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler33)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler34)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler35)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler36)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler37)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler38)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler39)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler40)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler41)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler42)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler43)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler44)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler45)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler46)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler47)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler48)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler49)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler50)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler51)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler52)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler53)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler54)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler55)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler56)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler57)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler58)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler59)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler60)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler61)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler62)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler63)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler64)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler65)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler66)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler67)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler68)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler69)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler70)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler71)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler72)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler73)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler74)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler75)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler76)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler77)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler78)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler79)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler80)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler81)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler82)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler83)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler84)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler85)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler86)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler87)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler88)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler89)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler90)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler91)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler92)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler93)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler94)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler95)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler96)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler97)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler98)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler99)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler100)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler101)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler102)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler103)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler104)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler105)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler106)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler107)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler108)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler109)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler110)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler111)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler112)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler113)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler114)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler115)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler116)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler117)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler118)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler119)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler120)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler121)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler122)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler123)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler124)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler125)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler126)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler127)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler128)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler129)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler130)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler131)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler132)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler133)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler134)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler135)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler136)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler137)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler138)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler139)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler140)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler141)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler142)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler143)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler144)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler145)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler146)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler147)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler148)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler149)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler150)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler151)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler152)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler153)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler154)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler155)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler156)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler157)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler158)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler159)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler160)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler161)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler162)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler163)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler164)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler165)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler166)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler167)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler168)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler169)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler170)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler171)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler172)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler173)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler174)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler175)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler176)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler177)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler178)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler179)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler180)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler181)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler182)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler183)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler184)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler185)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler186)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler187)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler188)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler189)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler190)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler191)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler192)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler193)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler194)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler195)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler196)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler197)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler198)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler199)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler200)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler201)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler202)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler203)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler204)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler205)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler206)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler207)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler208)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler209)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler210)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler211)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler212)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler213)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler214)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler215)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler216)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler217)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler218)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler219)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler220)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler221)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler222)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler223)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler224)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler225)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler226)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler227)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler228)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler229)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler230)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler231)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler232)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler233)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler234)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler235)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler236)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler237)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler238)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler239)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler240)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler241)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler242)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler243)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler244)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler245)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler246)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler247)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler248)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler249)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler250)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler251)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler252)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler253)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler254)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler255)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler256)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler257)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler258)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler259)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler260)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler261)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler262)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler263)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler264)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler265)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler266)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler267)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler268)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler269)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler270)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler271)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler272)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler273)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler274)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler275)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler276)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler277)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler278)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler279)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler280)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler281)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler282)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler283)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler284)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler285)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler286)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler287)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler288)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler289)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler290)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler291)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler292)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler293)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler294)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler295)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler296)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler297)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler298)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler299)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler300)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler301)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler302)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler303)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler304)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler305)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler306)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler307)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler308)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler309)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler310)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler311)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler312)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler313)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler314)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler315)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler316)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler317)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler318)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler319)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler320)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler321)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler322)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler323)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler324)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler325)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler326)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler327)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler328)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler329)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler330)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler331)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler332)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler333)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler334)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler335)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler336)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler337)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler338)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler339)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler340)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler341)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler342)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler343)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler344)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler345)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler346)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler347)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler348)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler349)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler350)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler351)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler352)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler353)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler354)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler355)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler356)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler357)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler358)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler359)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler360)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler361)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler362)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler363)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler364)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler365)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler366)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler367)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler368)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler369)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler370)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler371)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler372)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler373)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler374)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler375)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler376)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler377)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler378)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler379)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler380)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler381)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler382)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler383)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler384)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler385)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler386)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler387)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler388)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler389)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler390)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler391)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler392)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler393)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler394)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler395)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler396)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler397)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler398)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler399)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler400)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler401)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler402)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler403)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler404)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler405)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler406)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler407)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler408)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler409)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler410)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler411)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler412)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler413)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler414)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler415)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler416)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler417)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler418)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler419)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler420)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler421)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler422)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler423)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler424)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler425)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler426)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler427)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler428)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler429)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler430)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler431)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler432)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler433)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler434)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler435)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler436)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler437)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler438)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler439)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler440)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler441)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler442)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler443)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler444)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler445)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler446)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler447)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler448)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler449)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler450)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler451)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler452)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler453)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler454)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler455)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler456)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler457)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler458)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler459)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler460)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler461)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler462)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler463)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler464)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler465)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler466)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler467)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler468)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler469)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler470)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler471)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler472)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler473)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler474)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler475)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler476)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler477)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler478)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler479)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler480)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler481)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler482)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler483)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler484)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler485)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler486)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler487)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler488)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler489)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler490)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler491)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler492)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler493)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler494)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler495)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler496)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler497)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler498)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler499)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler500)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler501)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler502)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler503)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler504)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler505)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler506)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler507)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler508)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler509)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler510)
+	NoInlineTypeHandlers = append(NoInlineTypeHandlers, NoInlineTypeHandler511)
+
+	NoInlineTypeMap4 = make(map[reflect.Type]func(interface{}, int) int, 4)
+	for i, m := range NoInlineMessages[:4] {
+		NoInlineTypeMap4[reflect.TypeOf(m)] = NoInlineTypeHandlers[i]
+	}
+
+	NoInlineTypeMap8 = make(map[reflect.Type]func(interface{}, int) int, 8)
+	for i, m := range NoInlineMessages[:8] {
+		NoInlineTypeMap8[reflect.TypeOf(m)] = NoInlineTypeHandlers[i]
+	}
+
+	NoInlineTypeMap16 = make(map[reflect.Type]func(interface{}, int) int, 16)
+	for i, m := range NoInlineMessages[:16] {
+		NoInlineTypeMap16[reflect.TypeOf(m)] = NoInlineTypeHandlers[i]
+	}
+
+	NoInlineTypeMap32 = make(map[reflect.Type]func(interface{}, int) int, 32)
+	for i, m := range NoInlineMessages[:32] {
+		NoInlineTypeMap32[reflect.TypeOf(m)] = NoInlineTypeHandlers[i]
+	}
+
+	NoInlineTypeMap64 = make(map[reflect.Type]func(interface{}, int) int, 64)
+	for i, m := range NoInlineMessages[:64] {
+		NoInlineTypeMap64[reflect.TypeOf(m)] = NoInlineTypeHandlers[i]
+	}
+
+	NoInlineTypeMap128 = make(map[reflect.Type]func(interface{}, int) int, 128)
+	for i, m := range NoInlineMessages[:128] {
+		NoInlineTypeMap128[reflect.TypeOf(m)] = NoInlineTypeHandlers[i]
+	}
+
+	NoInlineTypeMap256 = make(map[reflect.Type]func(interface{}, int) int, 256)
+	for i, m := range NoInlineMessages[:256] {
+		NoInlineTypeMap256[reflect.TypeOf(m)] = NoInlineTypeHandlers[i]
+	}
+
+	NoInlineTypeMap512 = make(map[reflect.Type]func(interface{}, int) int, 512)
+	for i, m := range NoInlineMessages[:512] {
+		NoInlineTypeMap512[reflect.TypeOf(m)] = NoInlineTypeHandlers[i]
+	}
+
+	copy(InlineFuncArray4[:], InlineFuncs)
+	copy(InlineFuncArray8[:], InlineFuncs)
+	copy(InlineFuncArray16[:], InlineFuncs)
+	copy(InlineFuncArray32[:], InlineFuncs)
+	copy(InlineFuncArray64[:], InlineFuncs)
+	copy(InlineFuncArray128[:], InlineFuncs)
+	copy(InlineFuncArray256[:], InlineFuncs)
+	copy(InlineFuncArray512[:], InlineFuncs)
+
+	copy(NoInlineFuncArray4[:], NoInlineFuncs)
+	copy(NoInlineFuncArray8[:], NoInlineFuncs)
+	copy(NoInlineFuncArray16[:], NoInlineFuncs)
+	copy(NoInlineFuncArray32[:], NoInlineFuncs)
+	copy(NoInlineFuncArray64[:], NoInlineFuncs)
+	copy(NoInlineFuncArray128[:], NoInlineFuncs)
+	copy(NoInlineFuncArray256[:], NoInlineFuncs)
+	copy(NoInlineFuncArray512[:], NoInlineFuncs)
 }
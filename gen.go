@@ -0,0 +1,8 @@
+// Package go_map_vs_switch benchmarks branching with a switch against
+// dispatching through tables of functions.
+//
+// funcs.go, bench_test.go, strings.go, strings_test.go, layouts.go and
+// layouts_test.go are generated from matrix.json by cmd/genbench.
+package go_map_vs_switch
+
+//go:generate go run ./cmd/genbench
@@ -0,0 +1,3 @@
+module github.com/jackc/go_map_vs_switch
+
+go 1.17
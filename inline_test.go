@@ -0,0 +1,54 @@
+package go_map_vs_switch
+
+import (
+	"flag"
+	"fmt"
+	"os/exec"
+	"regexp"
+	"testing"
+)
+
+var canInlineRegexp = regexp.MustCompile(`(?m): can inline ((?:No)?Inline(?:\d+|Handler\d+\.Handle))\b`)
+
+// TestInlining verifies that the compiler makes the inlining decisions the
+// Inline/NoInline benchmark dimension depends on. It compiles the whole
+// package, which takes minutes, so it is skipped when benchmarks are run.
+func TestInlining(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping compiler invocation in short mode")
+	}
+	if f := flag.Lookup("test.bench"); f != nil && f.Value.String() != "" {
+		t.Skip("skipping compiler invocation when running benchmarks")
+	}
+
+	goBin, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go command not found")
+	}
+
+	out, err := exec.Command(goBin, "build", "-gcflags=-m", ".").CombinedOutput()
+	if err != nil {
+		t.Fatalf("go build -gcflags=-m failed: %v\n%s", err, out)
+	}
+
+	inlined := make(map[string]bool)
+	for _, m := range canInlineRegexp.FindAllSubmatch(out, -1) {
+		inlined[string(m[1])] = true
+	}
+
+	for i := range InlineFuncs {
+		for _, name := range []string{fmt.Sprintf("Inline%d", i), fmt.Sprintf("InlineHandler%d.Handle", i)} {
+			if !inlined[name] {
+				t.Errorf("%s is not inlinable", name)
+			}
+		}
+	}
+
+	for i := range NoInlineFuncs {
+		for _, name := range []string{fmt.Sprintf("NoInline%d", i), fmt.Sprintf("NoInlineHandler%d.Handle", i)} {
+			if inlined[name] {
+				t.Errorf("%s is inlinable", name)
+			}
+		}
+	}
+}
@@ -0,0 +1,163 @@
+package go_map_vs_switch
+
+import (
+	"flag"
+	"fmt"
+	"hash/fnv"
+	"math/rand"
+	"os"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/jackc/go_map_vs_switch/internal/dist"
+)
+
+// seedEnv is the environment variable that sets the input seed when the
+// -mvs.seed flag is not given.
+const seedEnv = "MVS_SEED"
+
+// defaultSeed is the input seed used when neither -mvs.seed nor MVS_SEED is
+// set, so that runs are reproducible by default.
+const defaultSeed = 1
+
+var seedFlag = flag.String("mvs.seed", "", "seed for generating benchmark inputs (default $"+seedEnv+" or 1)")
+
+// maxCachedLength is the longest input sequence kept for the whole run.
+// Only the most recently used longer sequence is kept, so that the largest
+// lengths do not hold hundreds of megabytes.
+const maxCachedLength = 1 << 16
+
+// seed is the input seed chosen by TestMain.
+var seed int64
+
+var inputsMu sync.Mutex
+var inputsCache = make(map[string]*inputs)
+var lastLong string
+
+func TestMain(m *testing.M) {
+	flag.Parse()
+
+	var err error
+	seed, err = inputSeed()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	// Record the seed as a benchmark configuration line so that it ends up in
+	// the results metadata.
+	if f := flag.Lookup("test.bench"); f != nil && f.Value.String() != "" {
+		fmt.Printf("mvs.seed: %d\n", seed)
+	}
+
+	os.Exit(m.Run())
+}
+
+// inputs is a generated sequence of selectors.
+type inputs struct {
+	selectors []int
+
+	// entropy is the measured entropy of each selector given the previous one
+	// in bits.
+	entropy float64
+}
+
+// benchInputs returns a sequence of length selectors for n branches drawn
+// from the distribution spec (see dist.Parse). Each sequence is generated
+// from the input seed, spec and n, so it does not depend on which other
+// benchmarks run, and a shorter sequence is a prefix of a longer one.
+func benchInputs(b *testing.B, spec string, n, length int) *inputs {
+	seedKey := fmt.Sprintf("%s/%d", spec, n)
+	key := fmt.Sprintf("%s/%d", seedKey, length)
+
+	inputsMu.Lock()
+	defer inputsMu.Unlock()
+
+	if in, ok := inputsCache[key]; ok {
+		return in
+	}
+
+	g, err := dist.Parse(spec)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	h := fnv.New64a()
+	h.Write([]byte(seedKey))
+	rng := rand.New(rand.NewSource(seed ^ int64(h.Sum64())))
+
+	selectors := g.Generate(rng, n, length)
+	in := &inputs{selectors: selectors, entropy: dist.ConditionalEntropy(selectors)}
+	if length > maxCachedLength {
+		delete(inputsCache, lastLong)
+		lastLong = key
+	}
+	inputsCache[key] = in
+	return in
+}
+
+// lookupBench is a generated benchmark of one dispatch strategy and function
+// kind over pre-generated inputs.
+type lookupBench struct {
+	index string
+	n     int
+	bench func(*testing.B, []int)
+}
+
+// runLookups runs each of benches over selectors drawn from the
+// distribution spec at every input length, as sub-benchmarks named by input
+// length, index mode and branch count. benches must be grouped by index mode;
+// the modes run in the order of their groups.
+func runLookups(b *testing.B, spec string, benches []lookupBench) {
+	var modes []string
+	for _, l := range benches {
+		if len(modes) == 0 || modes[len(modes)-1] != l.index {
+			modes = append(modes, l.index)
+		}
+	}
+
+	for _, length := range inputLengths {
+		b.Run(fmt.Sprintf("len=%d", length), func(b *testing.B) {
+			for _, index := range modes {
+				b.Run("index="+index, func(b *testing.B) {
+					for _, l := range benches {
+						if l.index != index {
+							continue
+						}
+						b.Run(fmt.Sprintf("n=%d", l.n), func(b *testing.B) {
+							benchLookup(b, spec, l.n, length, l.bench)
+						})
+					}
+				})
+			}
+		})
+	}
+}
+
+// benchLookup runs bench with length selectors for n branches drawn from the
+// distribution spec and reports their measured entropy as the entropy-bits
+// metric.
+func benchLookup(b *testing.B, spec string, n, length int, bench func(*testing.B, []int)) {
+	in := benchInputs(b, spec, n, length)
+	bench(b, in.selectors)
+	b.ReportMetric(in.entropy, "entropy-bits")
+}
+
+// inputSeed returns the seed from -mvs.seed, MVS_SEED or the default, in
+// that order of precedence.
+func inputSeed() (int64, error) {
+	s, source := *seedFlag, "-mvs.seed"
+	if s == "" {
+		s, source = os.Getenv(seedEnv), seedEnv
+	}
+	if s == "" {
+		return defaultSeed, nil
+	}
+
+	seed, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q: %v", source, s, err)
+	}
+	return seed, nil
+}
@@ -0,0 +1,327 @@
+// Package compare summarizes repeated benchmark runs and compares each
+// strategy with a baseline strategy at the same point in the matrix.
+package compare
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/jackc/go_map_vs_switch/internal/results"
+	"github.com/jackc/go_map_vs_switch/internal/stats"
+)
+
+// Summary is the summary statistics of every run of one benchmark.
+type Summary struct {
+	Name    string
+	Dims    map[string]string
+	Samples []float64
+
+	Median float64
+	IQR    float64
+
+	// Lo and Hi are the confidence interval of the median, and Coverage is
+	// its actual coverage, which is below the requested confidence if there
+	// are too few samples.
+	Lo, Hi   float64
+	Coverage float64
+
+	// Metrics is the median of each other reported metric by unit.
+	Metrics map[string]float64
+
+	// Lowering is the lowering of the benchmarked switch, if known.
+	Lowering string
+
+	// Net is set by Subtract if the median of a baseline strategy has been
+	// subtracted from the summary, and Gross is then the median before.
+	Net   bool
+	Gross float64
+}
+
+// GrossMedian returns the median of s before any subtraction.
+func (s *Summary) GrossMedian() float64 {
+	if s.Net {
+		return s.Gross
+	}
+	return s.Median
+}
+
+// Summarize groups the results of d by benchmark name and dimensions and
+// summarizes each group. Results of the same benchmark differ in their
+// dimensions when they were tagged by the runner, e.g. with the toolchain.
+// The summaries are in the order each group first appears.
+func Summarize(d *results.Dataset, confidence float64) []*Summary {
+	byName := make(map[string]*Summary)
+	metrics := make(map[*Summary]map[string][]float64)
+	var sums []*Summary
+	for _, r := range d.Results {
+		k := r.Name + " " + r.Key()
+		s, ok := byName[k]
+		if !ok {
+			s = &Summary{Name: r.Name, Dims: r.Dims, Lowering: r.Lowering}
+			byName[k] = s
+			metrics[s] = make(map[string][]float64)
+			sums = append(sums, s)
+		}
+		s.Samples = append(s.Samples, r.NsPerOp)
+		for k, v := range r.Metrics {
+			metrics[s][k] = append(metrics[s][k], v)
+		}
+	}
+
+	for _, s := range sums {
+		s.Median = stats.Median(s.Samples)
+		s.IQR = stats.IQR(s.Samples)
+		s.Lo, s.Hi, s.Coverage = stats.MedianCI(s.Samples, confidence)
+		for k, vs := range metrics[s] {
+			if s.Metrics == nil {
+				s.Metrics = make(map[string]float64)
+			}
+			s.Metrics[k] = stats.Median(vs)
+		}
+	}
+
+	return sums
+}
+
+// Subtract returns sums with the median of baseline at the same point in the
+// matrix subtracted from their samples, median and confidence interval, so
+// that they reflect only the cost over the baseline. Such summaries are
+// marked Net. Summaries with no matching baseline, e.g. those of benchmarks
+// the baseline strategy does not cover, are returned unchanged. As every
+// strategy at a point is shifted by the same amount, their comparison by
+// Pairs is unchanged.
+func Subtract(sums []*Summary, baseline string) []*Summary {
+	key := func(s *Summary) string {
+		r := results.Result{Dims: s.Dims}
+		return r.Key(results.DimStrategy)
+	}
+
+	base := make(map[string]float64)
+	for _, s := range sums {
+		if s.Dims[results.DimStrategy] == baseline {
+			base[key(s)] = s.Median
+		}
+	}
+
+	net := make([]*Summary, 0, len(sums))
+	for _, s := range sums {
+		b, ok := base[key(s)]
+		if !ok {
+			net = append(net, s)
+			continue
+		}
+
+		n := *s
+		n.Net = true
+		n.Gross = s.Median
+		n.Samples = make([]float64, len(s.Samples))
+		for i, v := range s.Samples {
+			n.Samples[i] = v - b
+		}
+		n.Median -= b
+		n.Lo -= b
+		n.Hi -= b
+		net = append(net, &n)
+	}
+	return net
+}
+
+// Pair compares a strategy with the baseline strategy at the same point in
+// the matrix, e.g. BenchmarkSwitch/inline=true/pattern=random/n=64 with
+// BenchmarkMap/inline=true/pattern=random/n=64. More generally it compares
+// two summaries that differ only in the dimension Dim.
+type Pair struct {
+	Dim      string
+	Baseline *Summary
+	Other    *Summary
+
+	// Delta is the change in median from Baseline to Other as a fraction of
+	// the Baseline median. It is computed from the gross medians, since a
+	// net median can be close to zero or negative.
+	Delta float64
+
+	// P is the Mann-Whitney U test p-value. The difference is significant if
+	// P < alpha.
+	P           float64
+	Significant bool
+}
+
+// Verdict describes the outcome of p, or "~" if the difference is not
+// significant. The faster side is named by its strategy, or by dim=value if
+// p does not compare strategies.
+func (p *Pair) Verdict() string {
+	if !p.Significant {
+		return "~"
+	}
+	if p.Other.Median < p.Baseline.Median {
+		return p.label(p.Other) + " faster"
+	}
+	return p.label(p.Baseline) + " faster"
+}
+
+func (p *Pair) label(s *Summary) string {
+	if p.Dim == results.DimStrategy {
+		return s.Dims[p.Dim]
+	}
+	return p.Dim + "=" + s.Dims[p.Dim]
+}
+
+// Pairs pairs every summary of the baseline strategy with the summaries of
+// each other strategy that match it in every other dimension.
+func Pairs(sums []*Summary, baseline string, alpha float64) []*Pair {
+	return PairsBy(sums, results.DimStrategy, baseline, alpha)
+}
+
+// PairsBy pairs every summary whose dimension dim is baseline with the
+// summaries that match it in every other dimension.
+func PairsBy(sums []*Summary, dim, baseline string, alpha float64) []*Pair {
+	key := func(s *Summary) string {
+		r := results.Result{Dims: s.Dims}
+		return r.Key(dim)
+	}
+
+	others := make(map[string][]*Summary)
+	for _, s := range sums {
+		if s.Dims[dim] != baseline {
+			k := key(s)
+			others[k] = append(others[k], s)
+		}
+	}
+
+	var pairs []*Pair
+	for _, b := range sums {
+		if b.Dims[dim] != baseline {
+			continue
+		}
+
+		matches := others[key(b)]
+		sort.Slice(matches, func(i, j int) bool {
+			return matches[i].Dims[dim] < matches[j].Dims[dim]
+		})
+		for _, o := range matches {
+			_, pValue := stats.MannWhitneyU(b.Samples, o.Samples)
+			pairs = append(pairs, &Pair{
+				Dim:         dim,
+				Baseline:    b,
+				Other:       o,
+				Delta:       (o.GrossMedian() - b.GrossMedian()) / b.GrossMedian(),
+				P:           pValue,
+				Significant: pValue < alpha,
+			})
+		}
+	}
+
+	return pairs
+}
+
+// WriteSummaries writes a table of sums to w. An interval whose coverage is
+// below confidence, because there are too few runs, is followed by its
+// actual coverage. The lowering column is only written if any summary has a
+// lowering, and the ns/op column, which tells net from gross summaries, only
+// if any summary is net.
+func WriteSummaries(w io.Writer, sums []*Summary, confidence float64) error {
+	lowering, net := false, false
+	for _, s := range sums {
+		if s.Lowering != "" {
+			lowering = true
+		}
+		if s.Net {
+			net = true
+		}
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintf(tw, "name\truns\tmedian ns/op\tIQR\t%g%% CI", confidence*100)
+	if net {
+		fmt.Fprint(tw, "\tns/op")
+	}
+	if lowering {
+		fmt.Fprint(tw, "\tlowering")
+	}
+	fmt.Fprintln(tw)
+	for _, s := range sums {
+		fmt.Fprintf(tw, "%s\t%d\t%.2f\t%.2f\t[%.2f, %.2f]", s.Name, len(s.Samples), s.Median, s.IQR, s.Lo, s.Hi)
+		if s.Coverage < confidence {
+			fmt.Fprintf(tw, " %.4g%%", s.Coverage*100)
+		}
+		if net {
+			if s.Net {
+				fmt.Fprint(tw, "\tnet")
+			} else {
+				fmt.Fprint(tw, "\tgross")
+			}
+		}
+		if lowering {
+			fmt.Fprintf(tw, "\t%s", s.Lowering)
+		}
+		fmt.Fprintln(tw)
+	}
+	return tw.Flush()
+}
+
+// WritePairs writes a table of pairs to w.
+func WritePairs(w io.Writer, pairs []*Pair) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintf(tw, "baseline\tother\tbaseline ns/op\tother ns/op\tdelta\tp\tverdict\n")
+	for _, p := range pairs {
+		fmt.Fprintf(tw, "%s\t%s\t%.2f\t%.2f\t%+.1f%%\t%.3f\t%s\n",
+			p.Baseline.Name, p.Other.Dims[p.Dim], p.Baseline.Median, p.Other.Median, p.Delta*100, p.P, p.Verdict())
+	}
+	return tw.Flush()
+}
+
+// Row is a row of a table of pairs with a column per value of a dimension,
+// e.g. one benchmark under each toolchain.
+type Row struct {
+	Name  string
+	Cells map[string]*Pair
+}
+
+// Rows groups pairs into rows named by name with a cell for each column, in
+// the order each row first appears.
+func Rows(pairs []*Pair, name, column func(*Pair) string) []*Row {
+	var rows []*Row
+	byName := make(map[string]*Row)
+	for _, p := range pairs {
+		n := name(p)
+		r, ok := byName[n]
+		if !ok {
+			r = &Row{Name: n, Cells: make(map[string]*Pair)}
+			byName[n] = r
+			rows = append(rows, r)
+		}
+		r.Cells[column(p)] = p
+	}
+	return rows
+}
+
+// WriteRows writes a table of rows to w with a column for each of columns.
+// Each cell is the baseline and other median ns/op and the change between
+// them, marked ~ if it is not significant.
+func WriteRows(w io.Writer, rows []*Row, columns []string) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprint(tw, "benchmark")
+	for _, c := range columns {
+		fmt.Fprintf(tw, "\t%s", c)
+	}
+	fmt.Fprintln(tw)
+
+	for _, r := range rows {
+		fmt.Fprint(tw, r.Name)
+		for _, c := range columns {
+			p, ok := r.Cells[c]
+			if !ok {
+				fmt.Fprint(tw, "\t-")
+				continue
+			}
+			fmt.Fprintf(tw, "\t%.2f / %.2f %+.1f%%", p.Baseline.Median, p.Other.Median, p.Delta*100)
+			if !p.Significant {
+				fmt.Fprint(tw, " ~")
+			}
+		}
+		fmt.Fprintln(tw)
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,154 @@
+package compare
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/jackc/go_map_vs_switch/internal/results"
+)
+
+const output = `BenchmarkSwitch/inline=true/pattern=random/n=64 	1000	 10.0 ns/op
+BenchmarkSwitch/inline=true/pattern=random/n=64 	1000	 10.2 ns/op
+BenchmarkSwitch/inline=true/pattern=random/n=64 	1000	 10.1 ns/op
+BenchmarkSwitch/inline=true/pattern=random/n=64 	1000	 10.3 ns/op
+BenchmarkSwitch/inline=true/pattern=random/n=64 	1000	 10.4 ns/op
+BenchmarkMap/inline=true/pattern=random/n=64    	1000	 20.0 ns/op
+BenchmarkMap/inline=true/pattern=random/n=64    	1000	 20.2 ns/op
+BenchmarkMap/inline=true/pattern=random/n=64    	1000	 20.1 ns/op
+BenchmarkMap/inline=true/pattern=random/n=64    	1000	 20.3 ns/op
+BenchmarkMap/inline=true/pattern=random/n=64    	1000	 20.4 ns/op
+BenchmarkSlice/inline=true/pattern=random/n=64  	1000	 10.05 ns/op
+BenchmarkSlice/inline=true/pattern=random/n=64  	1000	 10.25 ns/op
+BenchmarkSlice/inline=true/pattern=random/n=64  	1000	 10.15 ns/op
+BenchmarkSlice/inline=true/pattern=random/n=64  	1000	 10.35 ns/op
+BenchmarkSlice/inline=true/pattern=random/n=64  	1000	 9.95 ns/op
+BenchmarkMap/inline=true/pattern=random/n=128   	1000	 20.0 ns/op
+`
+
+func TestPairs(t *testing.T) {
+	d, err := results.Parse(strings.NewReader(output))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sums := Summarize(d, 0.95)
+	if len(sums) != 4 {
+		t.Fatalf("got %d summaries, want 4", len(sums))
+	}
+	if s := sums[0]; s.Median != 10.2 || len(s.Samples) != 5 || s.Lo != 10 || s.Hi != 10.4 {
+		t.Errorf("unexpected switch summary %+v", s)
+	}
+
+	pairs := Pairs(sums, "switch", 0.05)
+	if len(pairs) != 2 {
+		t.Fatalf("got %d pairs, want 2", len(pairs))
+	}
+
+	if p := pairs[0]; p.Other.Dims["strategy"] != "map" || !p.Significant || p.Verdict() != "switch faster" {
+		t.Errorf("unexpected map pair %+v, verdict %q", p, p.Verdict())
+	}
+	if p := pairs[1]; p.Other.Dims["strategy"] != "slice" || p.Significant || p.Verdict() != "~" {
+		t.Errorf("unexpected slice pair %+v, verdict %q", p, p.Verdict())
+	}
+}
+
+func TestWriteSummariesCoverage(t *testing.T) {
+	d, err := results.Parse(strings.NewReader(output))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Add 15 more runs of the switch, enough for a 95% interval.
+	for i := 0; i < 15; i++ {
+		r := d.Results[i%5]
+		d.Results = append(d.Results, r)
+	}
+
+	var buf strings.Builder
+	if err := WriteSummaries(&buf, Summarize(d, 0.95), 0.95); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(buf.String(), "\n")
+	if len(lines) < 4 {
+		t.Fatalf("table =\n%s", buf.String())
+	}
+	if !strings.Contains(lines[1], " 20 ") || strings.Contains(lines[1], "%") {
+		t.Errorf("20 runs: got %q, want a 95%% interval without its coverage", lines[1])
+	}
+	if !strings.Contains(lines[2], "[20.00, 20.40] 93.75%") {
+		t.Errorf("5 runs: got %q, want the [min, max] interval marked 93.75%%", lines[2])
+	}
+}
+
+func TestSubtract(t *testing.T) {
+	d, err := results.Parse(strings.NewReader(output + `BenchmarkNone/inline=true/pattern=random/n=64   	1000	 1.0 ns/op
+BenchmarkNone/inline=true/pattern=random/n=64   	1000	 1.2 ns/op
+BenchmarkNone/inline=true/pattern=random/n=64   	1000	 1.1 ns/op
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sums := Summarize(d, 0.95)
+	net := Subtract(sums, "none")
+	if len(net) != 5 {
+		t.Fatalf("got %d summaries, want 5", len(net))
+	}
+	if s := net[0]; s.Dims["strategy"] != "switch" || !s.Net || s.Gross != 10.2 || math.Abs(s.Median-9.1) > 1e-9 || math.Abs(s.Samples[0]-8.9) > 1e-9 || math.Abs(s.Lo-8.9) > 1e-9 {
+		t.Errorf("unexpected net switch summary %+v", s)
+	}
+	if s := net[3]; s.Dims["n"] != "128" || s.Net || s.Median != 20 {
+		t.Errorf("summary without a baseline = %+v, want it unchanged", s)
+	}
+	if s := net[4]; s.Dims["strategy"] != "none" || !s.Net || math.Abs(s.Median) > 1e-9 {
+		t.Errorf("unexpected net none summary %+v", s)
+	}
+	if sums[0].Median != 10.2 || sums[0].Net {
+		t.Errorf("Subtract modified its input: %+v", sums[0])
+	}
+
+	gross := make(map[string]*Pair)
+	for _, p := range Pairs(sums, "switch", 0.05) {
+		gross[p.Other.Dims["strategy"]] = p
+	}
+	for _, p := range Pairs(net, "switch", 0.05) {
+		want := gross[p.Other.Dims["strategy"]]
+		if p.P != want.P || p.Delta != want.Delta || p.Verdict() != want.Verdict() {
+			t.Errorf("%s: p = %v, delta = %v, verdict %q after subtraction, want %v, %v, %q",
+				p.Other.Dims["strategy"], p.P, p.Delta, p.Verdict(), want.P, want.Delta, want.Verdict())
+		}
+	}
+}
+
+func TestPairsBy(t *testing.T) {
+	d, err := results.Parse(strings.NewReader(output))
+	if err != nil {
+		t.Fatal(err)
+	}
+	n := len(d.Results)
+	for i := 0; i < n; i++ {
+		r := d.Results[i]
+		r.Dims = map[string]string{"pgo": "on"}
+		for k, v := range d.Results[i].Dims {
+			if k != "pgo" {
+				r.Dims[k] = v
+			}
+		}
+		r.NsPerOp /= 2
+		d.Results[i].Dims["pgo"] = "off"
+		d.Results = append(d.Results, r)
+	}
+
+	sums := Summarize(d, 0.95)
+	if len(sums) != 8 {
+		t.Fatalf("got %d summaries, want 8", len(sums))
+	}
+
+	pairs := PairsBy(sums, "pgo", "off", 0.05)
+	if len(pairs) != 4 {
+		t.Fatalf("got %d pairs, want 4", len(pairs))
+	}
+	if p := pairs[0]; p.Baseline.Name != p.Other.Name || p.Other.Dims["pgo"] != "on" || p.Delta != -0.5 || p.Verdict() != "pgo=on faster" {
+		t.Errorf("unexpected pair %+v, verdict %q", p, p.Verdict())
+	}
+}
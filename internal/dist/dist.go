@@ -0,0 +1,407 @@
+// Package dist generates sequences of branch selectors with controlled
+// distributions.
+//
+// A distribution is named by a spec such as "uniform" or "zipf(1.1)". Every
+// generator takes its randomness from the rand.Rand it is given so that a
+// sequence is reproducible from its seed.
+package dist
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Generator produces branch selectors.
+type Generator interface {
+	// Generate returns length selectors, each in [0, n).
+	Generate(rng *rand.Rand, n, length int) []int
+}
+
+// constructor builds a generator from the arguments of a spec. It must
+// reject arguments it does not accept.
+type constructor func(args []float64) (Generator, error)
+
+// constructors are the supported distributions by spec name.
+var constructors = map[string]constructor{
+	"sequential": func(args []float64) (Generator, error) {
+		if err := wantArgs(args, 0, 0); err != nil {
+			return nil, err
+		}
+		return sequential{}, nil
+	},
+	"uniform": func(args []float64) (Generator, error) {
+		if err := wantArgs(args, 0, 0); err != nil {
+			return nil, err
+		}
+		return uniform{}, nil
+	},
+	"zipf": func(args []float64) (Generator, error) {
+		if err := wantArgs(args, 1, 1); err != nil {
+			return nil, err
+		}
+		if args[0] <= 1 {
+			return nil, fmt.Errorf("exponent must be > 1")
+		}
+		return zipf{s: args[0]}, nil
+	},
+	"hotk": func(args []float64) (Generator, error) {
+		if err := wantArgs(args, 1, 2); err != nil {
+			return nil, err
+		}
+		g := hotK{k: int(args[0]), p: 0.9}
+		if len(args) == 2 {
+			g.p = args[1]
+		}
+		if g.k < 1 || float64(g.k) != args[0] {
+			return nil, fmt.Errorf("k must be a positive integer")
+		}
+		if g.p < 0 || g.p > 1 {
+			return nil, fmt.Errorf("probability must be in [0, 1]")
+		}
+		return g, nil
+	},
+	"bursty": func(args []float64) (Generator, error) {
+		if err := wantArgs(args, 1, 1); err != nil {
+			return nil, err
+		}
+		if args[0] < 1 {
+			return nil, fmt.Errorf("mean run length must be >= 1")
+		}
+		return bursty{mean: args[0]}, nil
+	},
+	"periodic": func(args []float64) (Generator, error) {
+		if err := wantArgs(args, 1, 1); err != nil {
+			return nil, err
+		}
+		p := int(args[0])
+		if p < 1 || float64(p) != args[0] {
+			return nil, fmt.Errorf("period must be a positive integer")
+		}
+		return periodic{period: p}, nil
+	},
+	"mix": func(args []float64) (Generator, error) {
+		if err := wantArgs(args, 1, 1); err != nil {
+			return nil, err
+		}
+		if args[0] < 0 || args[0] > 1 {
+			return nil, fmt.Errorf("probability must be in [0, 1]")
+		}
+		return mix{p: args[0]}, nil
+	},
+	"entropy": func(args []float64) (Generator, error) {
+		if err := wantArgs(args, 1, 1); err != nil {
+			return nil, err
+		}
+		if args[0] < 0 {
+			return nil, fmt.Errorf("entropy must be >= 0")
+		}
+		return entropy{bits: args[0]}, nil
+	},
+	"phase": func(args []float64) (Generator, error) {
+		if err := wantArgs(args, 1, 2); err != nil {
+			return nil, err
+		}
+		g := phase{every: int(args[0]), k: 4}
+		if len(args) == 2 {
+			g.k = int(args[1])
+			if g.k < 1 || float64(g.k) != args[1] {
+				return nil, fmt.Errorf("k must be a positive integer")
+			}
+		}
+		if g.every < 1 || float64(g.every) != args[0] {
+			return nil, fmt.Errorf("phase length must be a positive integer")
+		}
+		return g, nil
+	},
+}
+
+// Names returns the names of the supported distributions in sorted order.
+func Names() []string {
+	names := make([]string, 0, len(constructors))
+	for name := range constructors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Parse returns the generator for spec. A spec is a distribution name
+// optionally followed by comma separated numeric arguments in parentheses:
+//
+//	sequential       0, 1, ..., n-1, 0, 1, ...
+//	uniform          independent uniform choices
+//	zipf(s)          Zipf distributed with exponent s > 1 over a random ranking of the branches
+//	hotk(k[,p])      one of k hot branches with probability p (default 0.9), otherwise uniform
+//	bursty(r)        runs of a single branch with mean length r
+//	periodic(p)      a random cycle of p branches repeated
+//	phase(m[,k])     uniform over k (default 4) hot branches that change every m selections
+//	mix(p)           the next branch in the cycle 0, 1, ..., n-1, replaced by a uniform choice with probability p
+//	entropy(h)       like mix, with the probability chosen so the entropy rate is h bits (at most log2(n))
+func Parse(spec string) (Generator, error) {
+	name, argStr := spec, ""
+	i := strings.IndexByte(spec, '(')
+	if i >= 0 {
+		name, argStr = spec[:i], spec[i+1:]
+	}
+
+	var args []float64
+	if i >= 0 {
+		if !strings.HasSuffix(argStr, ")") {
+			return nil, fmt.Errorf("distribution %q: missing )", spec)
+		}
+		argStr = strings.TrimSuffix(argStr, ")")
+		for _, s := range strings.Split(argStr, ",") {
+			a, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+			if err != nil {
+				return nil, fmt.Errorf("distribution %q: bad argument %q", spec, s)
+			}
+			args = append(args, a)
+		}
+	}
+
+	c, ok := constructors[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown distribution %q (want one of %s)", name, strings.Join(Names(), ", "))
+	}
+	g, err := c(args)
+	if err != nil {
+		return nil, fmt.Errorf("distribution %q: %v", spec, err)
+	}
+	return g, nil
+}
+
+func wantArgs(args []float64, min, max int) error {
+	if len(args) < min || len(args) > max {
+		if min == max {
+			return fmt.Errorf("want %d arguments, got %d", min, len(args))
+		}
+		return fmt.Errorf("want %d to %d arguments, got %d", min, max, len(args))
+	}
+	return nil
+}
+
+type sequential struct{}
+
+func (sequential) Generate(rng *rand.Rand, n, length int) []int {
+	s := make([]int, length)
+	for i := range s {
+		s[i] = i % n
+	}
+	return s
+}
+
+type uniform struct{}
+
+func (uniform) Generate(rng *rand.Rand, n, length int) []int {
+	s := make([]int, length)
+	for i := range s {
+		s[i] = rng.Intn(n)
+	}
+	return s
+}
+
+type zipf struct {
+	s float64
+}
+
+func (g zipf) Generate(rng *rand.Rand, n, length int) []int {
+	// Rank the branches randomly so that the most frequent branch is not
+	// always case 0.
+	ranking := rng.Perm(n)
+	z := rand.NewZipf(rng, g.s, 1, uint64(n-1))
+
+	s := make([]int, length)
+	for i := range s {
+		s[i] = ranking[z.Uint64()]
+	}
+	return s
+}
+
+type hotK struct {
+	k int
+	p float64
+}
+
+func (g hotK) Generate(rng *rand.Rand, n, length int) []int {
+	hot := rng.Perm(n)[:minInt(g.k, n)]
+
+	s := make([]int, length)
+	for i := range s {
+		if rng.Float64() < g.p {
+			s[i] = hot[rng.Intn(len(hot))]
+		} else {
+			s[i] = rng.Intn(n)
+		}
+	}
+	return s
+}
+
+type bursty struct {
+	mean float64
+}
+
+func (g bursty) Generate(rng *rand.Rand, n, length int) []int {
+	s := make([]int, 0, length)
+	for len(s) < length {
+		// Run lengths are geometrically distributed with the given mean.
+		branch := rng.Intn(n)
+		s = append(s, branch)
+		for len(s) < length && rng.Float64() >= 1/g.mean {
+			s = append(s, branch)
+		}
+	}
+	return s
+}
+
+type periodic struct {
+	period int
+}
+
+func (g periodic) Generate(rng *rand.Rand, n, length int) []int {
+	cycle := make([]int, g.period)
+	for i := range cycle {
+		cycle[i] = rng.Intn(n)
+	}
+
+	s := make([]int, length)
+	for i := range s {
+		s[i] = cycle[i%len(cycle)]
+	}
+	return s
+}
+
+type phase struct {
+	every int
+	k     int
+}
+
+func (g phase) Generate(rng *rand.Rand, n, length int) []int {
+	var hot []int
+
+	s := make([]int, length)
+	for i := range s {
+		if i%g.every == 0 {
+			hot = rng.Perm(n)[:minInt(g.k, n)]
+		}
+		s[i] = hot[rng.Intn(len(hot))]
+	}
+	return s
+}
+
+type mix struct {
+	p float64
+}
+
+func (g mix) Generate(rng *rand.Rand, n, length int) []int {
+	s := make([]int, length)
+	prev := n - 1
+	for i := range s {
+		if rng.Float64() < g.p {
+			s[i] = rng.Intn(n)
+		} else {
+			s[i] = (prev + 1) % n
+		}
+		prev = s[i]
+	}
+	return s
+}
+
+type entropy struct {
+	bits float64
+}
+
+// Generate produces a Markov chain that continues the cycle with
+// probability q and otherwise moves to one of the other n-1 branches
+// uniformly. q is chosen so that the entropy rate is g.bits.
+func (g entropy) Generate(rng *rand.Rand, n, length int) []int {
+	q := cycleProbability(g.bits, n)
+
+	s := make([]int, length)
+	prev := n - 1
+	for i := range s {
+		next := (prev + 1) % n
+		if n > 1 && rng.Float64() >= q {
+			// Pick uniformly among the branches other than next.
+			other := rng.Intn(n - 1)
+			if other >= next {
+				other++
+			}
+			next = other
+		}
+		s[i] = next
+		prev = next
+	}
+	return s
+}
+
+// cycleProbability returns the probability q in [1/n, 1] of continuing the
+// cycle that gives an entropy rate of bits, clamped to [0, log2(n)].
+func cycleProbability(bits float64, n int) float64 {
+	if n <= 1 || bits <= 0 {
+		return 1
+	}
+	if bits >= math.Log2(float64(n)) {
+		return 1 / float64(n)
+	}
+
+	// The entropy rate decreases monotonically from log2(n) at q = 1/n to 0
+	// at q = 1.
+	rate := func(q float64) float64 {
+		return -xlog2x(q) - (1-q)*math.Log2((1-q)/float64(n-1))
+	}
+	lo, hi := 1/float64(n), 1.0
+	for i := 0; i < 100; i++ {
+		mid := (lo + hi) / 2
+		if rate(mid) > bits {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return (lo + hi) / 2
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func xlog2x(x float64) float64 {
+	if x == 0 {
+		return 0
+	}
+	return x * math.Log2(x)
+}
+
+// ConditionalEntropy returns the plug-in estimate, in bits, of the entropy
+// of each selector in s given the one before it. It is 0 for a fixed cycle
+// and approaches log2(n) for independent uniform choices. The estimate is
+// biased low when len(s) is not much larger than n*n.
+func ConditionalEntropy(s []int) float64 {
+	if len(s) < 2 {
+		return 0
+	}
+
+	type pair struct{ prev, next int }
+	pairs := make(map[pair]int)
+	prevs := make(map[int]int)
+	for i := 1; i < len(s); i++ {
+		pairs[pair{s[i-1], s[i]}]++
+		prevs[s[i-1]]++
+	}
+
+	total := float64(len(s) - 1)
+	h := 0.0
+	for p, c := range pairs {
+		joint := float64(c) / total
+		cond := float64(c) / float64(prevs[p.prev])
+		h -= joint * math.Log2(cond)
+	}
+	return h
+}
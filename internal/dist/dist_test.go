@@ -0,0 +1,122 @@
+package dist
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	valid := []string{"sequential", "uniform", "zipf(1.1)", "hotk(4)", "hotk(4, 0.5)", "bursty(16)", "periodic(8)", "phase(1024)", "phase(1024,2)", "mix(0)", "mix(0.5)", "entropy(3)"}
+	for _, spec := range valid {
+		if _, err := Parse(spec); err != nil {
+			t.Errorf("Parse(%q): %v", spec, err)
+		}
+	}
+
+	invalid := []string{"", "bogus", "uniform(1)", "zipf", "zipf(1)", "zipf(1.1", "hotk(0)", "hotk(2.5)", "hotk(4,2)", "bursty(0.5)", "periodic(x)", "phase(0)", "mix(1.5)", "entropy(-1)"}
+	for _, spec := range invalid {
+		if _, err := Parse(spec); err == nil {
+			t.Errorf("Parse(%q) succeeded, want error", spec)
+		}
+	}
+}
+
+func TestGenerateRange(t *testing.T) {
+	for _, spec := range []string{"sequential", "uniform", "zipf(1.1)", "hotk(4)", "bursty(16)", "periodic(8)", "phase(100)", "mix(0.5)", "entropy(1.5)"} {
+		g, err := Parse(spec)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, n := range []int{1, 4, 512} {
+			s := g.Generate(rand.New(rand.NewSource(1)), n, 4096)
+			if len(s) != 4096 {
+				t.Errorf("%s n=%d: got %d selectors, want 4096", spec, n, len(s))
+			}
+			for i, v := range s {
+				if v < 0 || v >= n {
+					t.Errorf("%s n=%d: selector %d is %d", spec, n, i, v)
+					break
+				}
+			}
+		}
+	}
+}
+
+func TestGenerateReproducible(t *testing.T) {
+	g, err := Parse("zipf(1.2)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := g.Generate(rand.New(rand.NewSource(42)), 64, 1000)
+	b := g.Generate(rand.New(rand.NewSource(42)), 64, 1000)
+	if !reflect.DeepEqual(a, b) {
+		t.Error("same seed produced different sequences")
+	}
+}
+
+func TestGenerateShape(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+
+	if s := mustGenerate(t, "periodic(3)", rng, 64, 9); !reflect.DeepEqual(s[:3], s[3:6]) || !reflect.DeepEqual(s[:3], s[6:]) {
+		t.Errorf("periodic(3) is not periodic: %v", s)
+	}
+
+	s := mustGenerate(t, "hotk(2, 1)", rng, 64, 1000)
+	distinct := make(map[int]bool)
+	for _, v := range s {
+		distinct[v] = true
+	}
+	if len(distinct) > 2 {
+		t.Errorf("hotk(2, 1) produced %d distinct branches, want at most 2", len(distinct))
+	}
+
+	s = mustGenerate(t, "bursty(50)", rng, 512, 10000)
+	changes := 0
+	for i := 1; i < len(s); i++ {
+		if s[i] != s[i-1] {
+			changes++
+		}
+	}
+	if changes > 1000 {
+		t.Errorf("bursty(50) changed branch %d times in 10000 selections", changes)
+	}
+}
+
+func mustGenerate(t *testing.T, spec string, rng *rand.Rand, n, length int) []int {
+	t.Helper()
+	g, err := Parse(spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return g.Generate(rng, n, length)
+}
+
+func TestConditionalEntropy(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+
+	if h := ConditionalEntropy(mustGenerate(t, "mix(0)", rng, 8, 4096)); h != 0 {
+		t.Errorf("mix(0) entropy = %v, want 0", h)
+	}
+	if h := ConditionalEntropy(mustGenerate(t, "mix(1)", rng, 4, 1<<16)); math.Abs(h-2) > 0.01 {
+		t.Errorf("mix(1) entropy = %v, want about 2", h)
+	}
+
+	prev := -1.0
+	for _, p := range []string{"0.1", "0.5", "0.9"} {
+		h := ConditionalEntropy(mustGenerate(t, "mix("+p+")", rng, 4, 1<<16))
+		if h <= prev {
+			t.Errorf("mix(%s) entropy %v is not greater than %v", p, h, prev)
+		}
+		prev = h
+	}
+
+	for _, bits := range []float64{0.5, 1, 1.5} {
+		h := ConditionalEntropy(mustGenerate(t, fmt.Sprintf("entropy(%g)", bits), rng, 4, 1<<16))
+		if math.Abs(h-bits) > 0.02 {
+			t.Errorf("entropy(%g) measured %v", bits, h)
+		}
+	}
+}
@@ -0,0 +1,215 @@
+// Package lowering classifies how the compiler lowered the switches of the
+// generated benchmark functions by inspecting their disassembly.
+//
+// A switch is lowered to a jump table when the function has an indirect
+// jump, to a binary search when it compares the switched value with a
+// constant and branches on the ordering, and to a linear sequence of
+// comparisons when it only branches on equality with constants. Only the
+// amd64 disassembly of go tool objdump is understood.
+package lowering
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/jackc/go_map_vs_switch/internal/results"
+	"github.com/jackc/go_map_vs_switch/internal/runner"
+)
+
+// DefaultFuncs matches the generated benchmark functions of every switch
+// strategy: Switch, TypeSwitch, StringSwitch and LayoutSwitch.
+const DefaultFuncs = `\.bench\w*Switch`
+
+// Shapes of a lowered switch.
+const (
+	JumpTable    = "jumptable"
+	BinarySearch = "binary"
+	Linear       = "linear"
+
+	// None is the shape of a function with no comparison with a constant,
+	// e.g. one that dispatches through a table of functions.
+	None = "none"
+)
+
+// Func is the classification of one function.
+type Func struct {
+	// Name is the function name without its package path, e.g.
+	// benchSwitchInlineLookupMod256.
+	Name string
+
+	Shape string
+
+	// JumpTables is the number of indirect jumps, Ordered the number of
+	// ordered branches and Equality the number of equality branches that
+	// follow a comparison with a constant.
+	JumpTables int
+	Ordered    int
+	Equality   int
+}
+
+func (f *Func) classify() {
+	switch {
+	case f.JumpTables > 0:
+		f.Shape = JumpTable
+	case f.Ordered > 0:
+		f.Shape = BinarySearch
+	case f.Equality > 0:
+		f.Shape = Linear
+	default:
+		f.Shape = None
+	}
+}
+
+var (
+	textRegexp = regexp.MustCompile(`^TEXT (\S+)\(SB\)`)
+
+	// cmpImmRegexp matches a comparison of a register or memory operand with
+	// an immediate, e.g. CMPQ DX, $0x7 or CMPB 0x4(DX), $0x6c.
+	cmpImmRegexp = regexp.MustCompile(`^CMP[BWLQ] [^,]+, \$`)
+
+	// indirectJumpRegexp matches a jump through a register or memory operand,
+	// e.g. JMP 0(AX)(DX*8) or JMP AX, but not a jump to an address or to a
+	// symbol, which ends in (SB).
+	indirectJumpRegexp = regexp.MustCompile(`^JMP (?:[A-Z][A-Z0-9]*|-?(?:0x)?[0-9a-f]*\([A-Z0-9]+\)(?:\([A-Z0-9]+\*[1248]\))?)$`)
+)
+
+// orderedJumps and equalityJumps are the amd64 conditional jumps.
+var (
+	orderedJumps  = map[string]bool{"JA": true, "JAE": true, "JB": true, "JBE": true, "JG": true, "JGE": true, "JL": true, "JLE": true}
+	equalityJumps = map[string]bool{"JE": true, "JNE": true}
+)
+
+// Inspect builds the test binary of the benchmark package described by c and
+// classifies the functions whose symbol names match the regexp funcs.
+func Inspect(ctx context.Context, c runner.Config, funcs string) ([]Func, error) {
+	dir, err := os.MkdirTemp("", "mvslowering")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	bin := filepath.Join(dir, "bench.test")
+	if err := runner.BuildTest(ctx, c, bin); err != nil {
+		return nil, err
+	}
+	out, err := runner.Objdump(ctx, c, bin, funcs)
+	if err != nil {
+		return nil, err
+	}
+	return Parse(bytes.NewReader(out))
+}
+
+// Parse reads go tool objdump output from r and classifies each function in
+// it.
+func Parse(r io.Reader) ([]Func, error) {
+	var funcs []Func
+	var f *Func
+	afterCmp := false
+
+	s := bufio.NewScanner(r)
+	s.Buffer(nil, 1024*1024)
+	for s.Scan() {
+		line := s.Text()
+		if m := textRegexp.FindStringSubmatch(line); m != nil {
+			name := m[1]
+			if i := strings.LastIndexByte(name, '.'); i >= 0 {
+				name = name[i+1:]
+			}
+			funcs = append(funcs, Func{Name: name})
+			f = &funcs[len(funcs)-1]
+			afterCmp = false
+			continue
+		}
+		if f == nil {
+			continue
+		}
+
+		// Instruction lines are file:line, address, encoding and the
+		// instruction separated by runs of tabs.
+		var fields []string
+		for _, field := range strings.Split(line, "\t") {
+			if field = strings.TrimSpace(field); field != "" {
+				fields = append(fields, field)
+			}
+		}
+		if len(fields) != 4 {
+			continue
+		}
+		inst := fields[3]
+
+		op := inst
+		if i := strings.IndexByte(inst, ' '); i >= 0 {
+			op = inst[:i]
+		}
+		switch {
+		case indirectJumpRegexp.MatchString(inst):
+			f.JumpTables++
+		case afterCmp && orderedJumps[op]:
+			f.Ordered++
+		case afterCmp && equalityJumps[op]:
+			f.Equality++
+		}
+		afterCmp = cmpImmRegexp.MatchString(inst)
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+
+	for i := range funcs {
+		funcs[i].classify()
+	}
+	return funcs, nil
+}
+
+// FuncName returns the name of the generated function that a result with
+// dims measured, in lower case, e.g. benchswitchinlinelookupmod256 for
+// BenchmarkSwitch/inline=true/pattern=random/len=4096/index=mod/n=256. It
+// follows the naming of the templates in cmd/genbench.
+func FuncName(dims map[string]string) string {
+	var sb strings.Builder
+	sb.WriteString("bench")
+	sb.WriteString(dims[results.DimStrategy])
+	if dims[results.DimInline] == "true" {
+		sb.WriteString("inline")
+	} else {
+		sb.WriteString("noinline")
+	}
+	if l, ok := dims["keylen"]; ok {
+		fmt.Fprintf(&sb, "%sp%s", l, dims["prefix"])
+	}
+	sb.WriteString(dims["layout"])
+	if index, ok := dims[results.DimIndex]; ok {
+		sb.WriteString("lookup")
+		sb.WriteString(index)
+	} else {
+		sb.WriteString(dims[results.DimPattern])
+	}
+	sb.WriteString(dims[results.DimN])
+	return sb.String()
+}
+
+// Annotate sets the Lowering of each result of d that measured one of funcs
+// and returns the number of results annotated.
+func Annotate(d *results.Dataset, funcs []Func) int {
+	shapes := make(map[string]string, len(funcs))
+	for _, f := range funcs {
+		shapes[strings.ToLower(f.Name)] = f.Shape
+	}
+
+	annotated := 0
+	for i := range d.Results {
+		r := &d.Results[i]
+		if shape, ok := shapes[FuncName(r.Dims)]; ok {
+			r.Lowering = shape
+			annotated++
+		}
+	}
+	return annotated
+}
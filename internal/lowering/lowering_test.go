@@ -0,0 +1,82 @@
+package lowering
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/jackc/go_map_vs_switch/internal/results"
+)
+
+// objdump is trimmed go tool objdump output of a jump table, a binary
+// search, a linear search and a table dispatch.
+const objdump = `TEXT github.com/jackc/go_map_vs_switch.benchSwitchNoInlineLookupMod8(SB) /src/bench_test.go
+  bench_test.go:11779	0x5da540		493b6610		CMPQ SP, 0x10(R14)
+  bench_test.go:11779	0x5da544		0f861d020000		JBE 0x5da767
+  bench_test.go:11783	0x5da584		48398a10020000		CMPQ 0x210(DX), CX
+  bench_test.go:11783	0x5da58b		0f8e98010000		JLE 0x5da729
+  bench_test.go:11784	0x5da5ac		4883fa07		CMPQ DX, $0x7
+  bench_test.go:11784	0x5da5b0		77c9			JA 0x5da57b
+  bench_test.go:11784	0x5da5bc		488d05dd934e00		LEAQ 0x4e93dd(IP), AX
+  bench_test.go:11784	0x5da5c3		ff24d0			JMP 0(AX)(DX*8)
+  bench_test.go:11786	0x5da5c9		e8d2fef7ff		CALL github.com/jackc/go_map_vs_switch.NoInline0(SB)
+TEXT github.com/jackc/go_map_vs_switch.benchLayoutSwitchNoInlineSparseLookupMod4(SB) /src/layouts_test.go
+  layouts_test.go:26365	0x804b4e		4839d0			CMPQ R8, DX
+  layouts_test.go:26365	0x804b51		0f864a040000		JBE 0x804fa1
+  layouts_test.go:26365	0x804b57		4881fa90fed218		CMPQ DX, $0x18d2fe90
+  layouts_test.go:26382	0x804b5e		0f8f0d020000		JG 0x804d71
+  layouts_test.go:26386	0x804b64		4881fa8df9e483		CMPQ DX, $-0x7c1b0673
+  layouts_test.go:26386	0x804b6b		7470			JE 0x804bd9
+  layouts_test.go:26387	0x804b6d		e96effffff		JMP github.com/jackc/go_map_vs_switch.benchLayoutSwitchNoInlineSparseLookupMod4(SB)
+TEXT github.com/jackc/go_map_vs_switch.benchIfChainNoInlineLookupMod2(SB) /src/bench_test.go
+  bench_test.go:20000	0x600000		4885d2			TESTQ DX, DX
+  bench_test.go:20000	0x600003		7405			JE 0x60000a
+  bench_test.go:20002	0x600005		4883fa01		CMPQ DX, $0x1
+  bench_test.go:20002	0x600009		75f5			JNE 0x600000
+TEXT github.com/jackc/go_map_vs_switch.benchSliceNoInlineLookupMod8(SB) /src/bench_test.go
+  bench_test.go:30000	0x700000		4839d0			CMPQ R8, DX
+  bench_test.go:30000	0x700003		0f864a040000		JBE 0x700100
+  bench_test.go:30000	0x700009		ff d2			CALL DX
+`
+
+func TestParse(t *testing.T) {
+	funcs, err := Parse(strings.NewReader(objdump))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []Func{
+		{Name: "benchSwitchNoInlineLookupMod8", Shape: JumpTable, JumpTables: 1, Ordered: 1},
+		{Name: "benchLayoutSwitchNoInlineSparseLookupMod4", Shape: BinarySearch, Ordered: 1, Equality: 1},
+		{Name: "benchIfChainNoInlineLookupMod2", Shape: Linear, Equality: 1},
+		{Name: "benchSliceNoInlineLookupMod8", Shape: None},
+	}
+	if !reflect.DeepEqual(funcs, want) {
+		t.Errorf("Parse = %+v, want %+v", funcs, want)
+	}
+}
+
+func TestAnnotate(t *testing.T) {
+	d := &results.Dataset{Results: []results.Result{
+		{Dims: results.ParseName("BenchmarkSwitch/inline=false/pattern=random/len=4096/index=mod/n=8")},
+		{Dims: results.ParseName("BenchmarkLayoutSwitch/inline=false/layout=sparse/pattern=sequential/len=64/index=mod/n=4")},
+		{Dims: results.ParseName("BenchmarkStringSwitch/inline=true/keylen=32/prefix=24/pattern=computed/n=64")},
+		{Dims: results.ParseName("BenchmarkMap/inline=false/pattern=random/len=4096/index=mod/n=8")},
+	}}
+	funcs := []Func{
+		{Name: "benchSwitchNoInlineLookupMod8", Shape: JumpTable},
+		{Name: "benchLayoutSwitchNoInlineSparseLookupMod4", Shape: BinarySearch},
+		{Name: "benchStringSwitchInline32P24Computed64", Shape: BinarySearch},
+	}
+
+	if n := Annotate(d, funcs); n != 3 {
+		t.Errorf("Annotate = %d, want 3", n)
+	}
+	var got []string
+	for _, r := range d.Results {
+		got = append(got, r.Lowering)
+	}
+	if want := []string{JumpTable, BinarySearch, BinarySearch, ""}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Lowering = %q, want %q", got, want)
+	}
+}
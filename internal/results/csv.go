@@ -0,0 +1,86 @@
+package results
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+)
+
+// leadingDims are the dimensions written first, in this order, by WriteCSV.
+// Any other dimensions follow in sorted order.
+var leadingDims = []string{DimStrategy, DimInline, DimPattern, DimPredictability, DimSelection, DimLength, DimIndex, DimN}
+
+// WriteCSV writes d to w as CSV with a header row. Each result is one row
+// with a column per dimension followed by the measurements, a column per
+// metric, the lowering if any result has one, the host metadata and the
+// configuration.
+func (d *Dataset) WriteCSV(w io.Writer) error {
+	dims := append([]string(nil), leadingDims...)
+	for _, k := range d.DimNames() {
+		if !contains(leadingDims, k) {
+			dims = append(dims, k)
+		}
+	}
+
+	cw := csv.NewWriter(w)
+
+	header := []string{"name"}
+	header = append(header, dims...)
+	header = append(header, "procs", "iterations", "ns_per_op")
+	metrics := d.MetricNames()
+	header = append(header, metrics...)
+	lowering := d.HasLowering()
+	if lowering {
+		header = append(header, "lowering")
+	}
+	header = append(header, "goos", "goarch", "cpu", "pkg", "go_version", "hostname", "date", "schema_version")
+	config := d.ConfigNames()
+	for _, k := range config {
+		header = append(header, configPrefix+k)
+	}
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+
+	for _, r := range d.Results {
+		row := []string{r.Name}
+		for _, k := range dims {
+			row = append(row, r.Dims[k])
+		}
+		row = append(row,
+			strconv.Itoa(r.Procs),
+			strconv.FormatInt(r.Iterations, 10),
+			strconv.FormatFloat(r.NsPerOp, 'f', -1, 64),
+		)
+		for _, k := range metrics {
+			v, ok := r.Metrics[k]
+			if !ok {
+				row = append(row, "")
+				continue
+			}
+			row = append(row, strconv.FormatFloat(v, 'f', -1, 64))
+		}
+		if lowering {
+			row = append(row, r.Lowering)
+		}
+		row = append(row,
+			d.Host.GOOS,
+			d.Host.GOARCH,
+			d.Host.CPU,
+			d.Host.Pkg,
+			d.Host.GoVersion,
+			d.Host.Hostname,
+			d.Date,
+			strconv.Itoa(d.SchemaVersion),
+		)
+		for _, k := range config {
+			row = append(row, d.Config[k])
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
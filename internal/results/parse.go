@@ -0,0 +1,270 @@
+package results
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// patterns maps each input pattern to its predictability and selection.
+var patterns = map[string][2]string{
+	"computed":   {"predictable", "computed"},
+	"masked":     {"predictable", "computed"},
+	"sequential": {"predictable", "lookup"},
+	"random":     {"unpredictable", "lookup"},
+}
+
+// configPrefix is the prefix of the configuration lines the benchmarks print,
+// e.g. "mvs.seed: 1".
+const configPrefix = "mvs."
+
+// legacyPatterns maps the branch strategy prefix of the flat benchmark names
+// used before sub-benchmarks (e.g. BenchmarkUnpredictableLookupSwitchInlineFunc4)
+// to the equivalent pattern.
+var legacyPatterns = map[string]string{
+	"PredictableComputed": "computed",
+	"PredictableLookup":   "sequential",
+	"UnpredictableLookup": "random",
+}
+
+// legacyStrategies maps the strategy of the flat benchmark names to the
+// current strategy. The flat Map benchmarks indexed a slice of functions;
+// they were renamed Slice when a real map strategy was added, so a legacy
+// Map result is a slice result.
+var legacyStrategies = map[string]string{
+	"Switch": "switch",
+	"Slice":  "slice",
+	"Map":    "slice",
+}
+
+var legacyNameRegexp = regexp.MustCompile(`^Benchmark(PredictableComputed|PredictableLookup|UnpredictableLookup)(Switch|Slice|Map)(Inline|NoInline)Func(\d+)$`)
+
+// Parse reads go test -bench output from r. Both plain text output and
+// go test -json output are accepted.
+func Parse(r io.Reader) (*Dataset, error) {
+	br := bufio.NewReader(r)
+	for {
+		b, err := br.Peek(1)
+		if err != nil {
+			if err == io.EOF {
+				return &Dataset{SchemaVersion: SchemaVersion}, nil
+			}
+			return nil, err
+		}
+		switch b[0] {
+		case ' ', '\t', '\r', '\n':
+			br.ReadByte()
+			continue
+		case '{':
+			return ParseJSON(br)
+		default:
+			return ParseText(br)
+		}
+	}
+}
+
+// testEvent is the subset of a go test -json event used by ParseJSON.
+type testEvent struct {
+	Action  string
+	Package string
+	Output  string
+}
+
+// ParseJSON reads go test -json output from r. Output events are joined per
+// package before parsing because benchmark result lines may be split across
+// events.
+func ParseJSON(r io.Reader) (*Dataset, error) {
+	var pkgs []string
+	outputs := make(map[string]*bytes.Buffer)
+
+	dec := json.NewDecoder(r)
+	for {
+		var e testEvent
+		if err := dec.Decode(&e); err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		if e.Action != "output" {
+			continue
+		}
+
+		buf, ok := outputs[e.Package]
+		if !ok {
+			buf = &bytes.Buffer{}
+			outputs[e.Package] = buf
+			pkgs = append(pkgs, e.Package)
+		}
+		buf.WriteString(e.Output)
+	}
+
+	d := &Dataset{SchemaVersion: SchemaVersion}
+	for _, pkg := range pkgs {
+		pd, err := ParseText(outputs[pkg])
+		if err != nil {
+			return nil, err
+		}
+		d.Merge(pd)
+	}
+
+	return d, nil
+}
+
+// ParseText reads plain go test -bench output from r.
+func ParseText(r io.Reader) (*Dataset, error) {
+	d := &Dataset{SchemaVersion: SchemaVersion}
+
+	s := bufio.NewScanner(r)
+	s.Buffer(nil, 1024*1024)
+	lineNum := 0
+	for s.Scan() {
+		lineNum++
+		line := strings.TrimSpace(s.Text())
+
+		if i := strings.Index(line, ": "); i >= 0 {
+			k, v := line[:i], line[i+2:]
+			switch k {
+			case "goos":
+				d.Host.GOOS = v
+				continue
+			case "goarch":
+				d.Host.GOARCH = v
+				continue
+			case "cpu":
+				d.Host.CPU = v
+				continue
+			case "pkg":
+				d.Host.Pkg = v
+				continue
+			}
+			if strings.HasPrefix(k, configPrefix) {
+				if d.Config == nil {
+					d.Config = make(map[string]string)
+				}
+				d.Config[strings.TrimPrefix(k, configPrefix)] = v
+				continue
+			}
+		}
+
+		if !strings.HasPrefix(line, "Benchmark") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) < 4 || fields[3] != "ns/op" {
+			// A benchmark name without results, e.g. a parent benchmark or a
+			// line split by interleaved log output.
+			continue
+		}
+
+		res, err := parseResult(fields)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %v", lineNum, err)
+		}
+		d.Results = append(d.Results, *res)
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+func parseResult(fields []string) (*Result, error) {
+	r := &Result{Name: fields[0], Procs: 1}
+
+	if i := strings.LastIndexByte(r.Name, '-'); i >= 0 {
+		if procs, err := strconv.Atoi(r.Name[i+1:]); err == nil {
+			r.Name = r.Name[:i]
+			r.Procs = procs
+		}
+	}
+
+	var err error
+	r.Iterations, err = strconv.ParseInt(fields[1], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("bad iteration count %q", fields[1])
+	}
+	r.NsPerOp, err = strconv.ParseFloat(fields[2], 64)
+	if err != nil {
+		return nil, fmt.Errorf("bad ns/op %q", fields[2])
+	}
+
+	for i := 4; i+1 < len(fields); i += 2 {
+		v, err := strconv.ParseFloat(fields[i], 64)
+		if err != nil {
+			return nil, fmt.Errorf("bad %s value %q", fields[i+1], fields[i])
+		}
+		if r.Metrics == nil {
+			r.Metrics = make(map[string]float64)
+		}
+		r.Metrics[fields[i+1]] = v
+	}
+
+	r.Dims = ParseName(r.Name)
+
+	return r, nil
+}
+
+// ParseName splits a benchmark name without its GOMAXPROCS suffix into
+// dimensions. The top-level benchmark name gives the strategy, e.g.
+// BenchmarkSwitch/inline=true/n=4 has strategy=switch, inline=true and n=4.
+// The flat names used before sub-benchmarks are also recognized.
+func ParseName(name string) map[string]string {
+	dims := make(map[string]string)
+
+	if m := legacyNameRegexp.FindStringSubmatch(name); m != nil {
+		dims[DimStrategy] = legacyStrategies[m[2]]
+		dims[DimInline] = strconv.FormatBool(m[3] == "Inline")
+		dims[DimPattern] = legacyPatterns[m[1]]
+		dims[DimN] = m[4]
+	} else {
+		elems := strings.Split(name, "/")
+		dims[DimStrategy] = strings.ToLower(strings.TrimPrefix(elems[0], "Benchmark"))
+		for _, e := range elems[1:] {
+			if i := strings.IndexByte(e, '='); i >= 0 {
+				dims[e[:i]] = e[i+1:]
+			}
+		}
+	}
+
+	if p, ok := patterns[dims[DimPattern]]; ok {
+		dims[DimPredictability] = p[0]
+		dims[DimSelection] = p[1]
+	}
+
+	return dims
+}
+
+// Merge appends the results of o to d and fills in any host fields and
+// configuration d is missing.
+func (d *Dataset) Merge(o *Dataset) {
+	d.Results = append(d.Results, o.Results...)
+	if d.Host.GOOS == "" {
+		d.Host.GOOS = o.Host.GOOS
+	}
+	if d.Host.GOARCH == "" {
+		d.Host.GOARCH = o.Host.GOARCH
+	}
+	if d.Host.CPU == "" {
+		d.Host.CPU = o.Host.CPU
+	}
+	if d.Host.Pkg == "" {
+		d.Host.Pkg = o.Host.Pkg
+	}
+	if d.Host.Description == "" {
+		d.Host.Description = o.Host.Description
+	}
+	for k, v := range o.Config {
+		if _, ok := d.Config[k]; !ok {
+			if d.Config == nil {
+				d.Config = make(map[string]string)
+			}
+			d.Config[k] = v
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package results
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const textOutput = `goos: linux
+goarch: amd64
+pkg: github.com/jackc/go_map_vs_switch
+cpu: Intel(R) Core(TM) i7-4790K CPU @ 4.00GHz
+mvs.seed: 42
+BenchmarkSwitch/inline=true/pattern=random/len=4096/n=4-8         	10000000	        14.2 ns/op
+BenchmarkMap/inline=false/pattern=computed/n=512         	 5000000	        27.5 ns/op	         1.5 entropy-bits
+BenchmarkUnpredictableLookupSliceNoInlineFunc256-8       	50000000	        25.5 ns/op
+BenchmarkPredictableComputedMapInlineFunc4-8        1000000000           2.38 ns/op
+PASS
+ok  	github.com/jackc/go_map_vs_switch	3.456s
+`
+
+func TestParseText(t *testing.T) {
+	d, err := Parse(strings.NewReader(textOutput))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wantHost := Host{GOOS: "linux", GOARCH: "amd64", CPU: "Intel(R) Core(TM) i7-4790K CPU @ 4.00GHz", Pkg: "github.com/jackc/go_map_vs_switch"}
+	if d.Host != wantHost {
+		t.Errorf("Host = %+v, want %+v", d.Host, wantHost)
+	}
+	if d.Config["seed"] != "42" {
+		t.Errorf("Config = %v, want seed 42", d.Config)
+	}
+
+	want := []Result{
+		{
+			Name:       "BenchmarkSwitch/inline=true/pattern=random/len=4096/n=4",
+			Dims:       map[string]string{"strategy": "switch", "inline": "true", "pattern": "random", "predictability": "unpredictable", "selection": "lookup", "len": "4096", "n": "4"},
+			Procs:      8,
+			Iterations: 10000000,
+			NsPerOp:    14.2,
+		},
+		{
+			Name:       "BenchmarkMap/inline=false/pattern=computed/n=512",
+			Dims:       map[string]string{"strategy": "map", "inline": "false", "pattern": "computed", "predictability": "predictable", "selection": "computed", "n": "512"},
+			Procs:      1,
+			Iterations: 5000000,
+			NsPerOp:    27.5,
+			Metrics:    map[string]float64{"entropy-bits": 1.5},
+		},
+		{
+			Name:       "BenchmarkUnpredictableLookupSliceNoInlineFunc256",
+			Dims:       map[string]string{"strategy": "slice", "inline": "false", "pattern": "random", "predictability": "unpredictable", "selection": "lookup", "n": "256"},
+			Procs:      8,
+			Iterations: 50000000,
+			NsPerOp:    25.5,
+		},
+		{
+			Name:       "BenchmarkPredictableComputedMapInlineFunc4",
+			Dims:       map[string]string{"strategy": "slice", "inline": "true", "pattern": "computed", "predictability": "predictable", "selection": "computed", "n": "4"},
+			Procs:      8,
+			Iterations: 1000000000,
+			NsPerOp:    2.38,
+		},
+	}
+	if !reflect.DeepEqual(d.Results, want) {
+		t.Errorf("Results = %+v, want %+v", d.Results, want)
+	}
+}
+
+func TestParseJSON(t *testing.T) {
+	const jsonOutput = `{"Action":"start","Package":"p"}
+{"Action":"output","Package":"p","Output":"goos: linux\n"}
+{"Action":"output","Package":"p","Output":"BenchmarkSlice/inline=true/pattern=sequential/n=16-4 \t"}
+{"Action":"output","Package":"p","Output":"  200000\t        10.5 ns/op\n"}
+{"Action":"pass","Package":"p"}
+`
+	d, err := Parse(strings.NewReader(jsonOutput))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(d.Results) != 1 {
+		t.Fatalf("got %d results, want 1", len(d.Results))
+	}
+	r := d.Results[0]
+	if r.Name != "BenchmarkSlice/inline=true/pattern=sequential/n=16" || r.Procs != 4 || r.Iterations != 200000 || r.NsPerOp != 10.5 || r.N() != 16 {
+		t.Errorf("unexpected result %+v", r)
+	}
+	if d.Host.GOOS != "linux" {
+		t.Errorf("GOOS = %q, want linux", d.Host.GOOS)
+	}
+}
+
+func TestWriteCSV(t *testing.T) {
+	d, err := Parse(strings.NewReader(textOutput))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := d.WriteCSV(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 5 {
+		t.Fatalf("got %d lines, want 5", len(lines))
+	}
+	wantHeader := "name,strategy,inline,pattern,predictability,selection,len,index,n,procs,iterations,ns_per_op,entropy-bits,goos,goarch,cpu,pkg,go_version,hostname,date,schema_version,mvs.seed"
+	if lines[0] != wantHeader {
+		t.Errorf("header = %q, want %q", lines[0], wantHeader)
+	}
+	wantRow := "BenchmarkSwitch/inline=true/pattern=random/len=4096/n=4,switch,true,random,unpredictable,lookup,4096,,4,8,10000000,14.2,,linux,amd64,Intel(R) Core(TM) i7-4790K CPU @ 4.00GHz,github.com/jackc/go_map_vs_switch,,,,1,42"
+	if lines[1] != wantRow {
+		t.Errorf("row = %q, want %q", lines[1], wantRow)
+	}
+}
@@ -0,0 +1,254 @@
+// Package results defines the dataset format shared by the reporting tools
+// and parses go test -bench output into it.
+//
+// A dataset is stored as JSON with a schemaVersion field. Readers reject
+// datasets with a schema version they do not understand.
+package results
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// SchemaVersion is the version of the JSON dataset format written by this
+// package. It must be incremented whenever the format changes incompatibly.
+const SchemaVersion = 1
+
+// Dataset is a set of benchmark results from a single host.
+type Dataset struct {
+	SchemaVersion int `json:"schemaVersion"`
+
+	// Date is the day the results were collected as YYYY-MM-DD.
+	Date string `json:"date,omitempty"`
+
+	Host Host `json:"host"`
+
+	// Config holds the suite's configuration lines from the benchmark output
+	// (lines of the form "mvs.key: value") keyed without the mvs. prefix,
+	// e.g. the input seed.
+	Config map[string]string `json:"config,omitempty"`
+
+	Results []Result `json:"results"`
+}
+
+// Host describes the machine and toolchain that produced a dataset.
+type Host struct {
+	GOOS      string `json:"goos,omitempty"`
+	GOARCH    string `json:"goarch,omitempty"`
+	CPU       string `json:"cpu,omitempty"`
+	Pkg       string `json:"pkg,omitempty"`
+	GoVersion string `json:"goVersion,omitempty"`
+	Hostname  string `json:"hostname,omitempty"`
+
+	// Description describes the host in prose when the fields above were
+	// not recorded, e.g. for results transcribed from an old README.
+	Description string `json:"description,omitempty"`
+}
+
+// Result is one measurement of one benchmark. Repeated runs of the same
+// benchmark produce multiple results with the same Name.
+type Result struct {
+	// Name is the full benchmark name without the GOMAXPROCS suffix.
+	Name string `json:"name"`
+
+	// Dims holds the dimensions parsed from Name, e.g. strategy, inline,
+	// pattern and n.
+	Dims map[string]string `json:"dims"`
+
+	// Procs is the GOMAXPROCS suffix of the benchmark name, or 1 if there was
+	// no suffix.
+	Procs int `json:"procs"`
+
+	Iterations int64   `json:"iterations"`
+	NsPerOp    float64 `json:"nsPerOp"`
+
+	// Metrics holds any other values reported with the result by unit, e.g.
+	// entropy-bits.
+	Metrics map[string]float64 `json:"metrics,omitempty"`
+
+	// Lowering is how the compiler lowered the switch of the benchmarked
+	// function, e.g. jumptable, if it was inspected. See package lowering.
+	Lowering string `json:"lowering,omitempty"`
+}
+
+// Dimension names that are always present in a result parsed from a
+// recognized benchmark name.
+const (
+	DimStrategy = "strategy"
+	DimInline   = "inline"
+	DimPattern  = "pattern"
+	DimN        = "n"
+
+	// DimPredictability is "predictable" or "unpredictable" and DimSelection
+	// is "computed" or "lookup". Both are derived from DimPattern.
+	DimPredictability = "predictability"
+	DimSelection      = "selection"
+)
+
+// Dimension names that are only present for patterns with pre-generated
+// inputs. DimLength is the number of selectors in the input sequence and
+// DimIndex is how the benchmark loop reads them: mod, mask or range.
+const (
+	DimLength = "len"
+	DimIndex  = "index"
+)
+
+// Dimension names that are not parsed from the benchmark name but added by
+// the runners that build the suite more than one way. DimToolchain is the
+// version of the Go toolchain that produced the result, e.g. go1.22.1,
+// DimPGO is whether the suite was built with profile-guided optimization,
+// on or off, and DimGCFlags is the name of the compiler flag configuration,
+// e.g. noinline.
+const (
+	DimToolchain = "toolchain"
+	DimPGO       = "pgo"
+	DimGCFlags   = "gcflags"
+)
+
+// N returns the branch count of r or 0 if it has none.
+func (r *Result) N() int {
+	n, _ := strconv.Atoi(r.Dims[DimN])
+	return n
+}
+
+// Key returns a canonical string of r's dimensions excluding the named ones.
+// Results that differ only in the excluded dimensions have the same key.
+func (r *Result) Key(exclude ...string) string {
+	keys := make([]string, 0, len(r.Dims))
+	for k := range r.Dims {
+		if !contains(exclude, k) {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	var sb strings.Builder
+	for i, k := range keys {
+		if i > 0 {
+			sb.WriteByte('/')
+		}
+		sb.WriteString(k)
+		sb.WriteByte('=')
+		sb.WriteString(r.Dims[k])
+	}
+	return sb.String()
+}
+
+func contains(s []string, v string) bool {
+	for _, e := range s {
+		if e == v {
+			return true
+		}
+	}
+	return false
+}
+
+// ConfigNames returns the sorted keys of d.Config.
+func (d *Dataset) ConfigNames() []string {
+	names := make([]string, 0, len(d.Config))
+	for k := range d.Config {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// MetricNames returns the sorted union of the metric units in d.
+func (d *Dataset) MetricNames() []string {
+	seen := make(map[string]bool)
+	var names []string
+	for _, r := range d.Results {
+		for k := range r.Metrics {
+			if !seen[k] {
+				seen[k] = true
+				names = append(names, k)
+			}
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// HasLowering reports whether any result in d has a Lowering.
+func (d *Dataset) HasLowering() bool {
+	for _, r := range d.Results {
+		if r.Lowering != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// DimNames returns the sorted union of the dimension names in d.
+func (d *Dataset) DimNames() []string {
+	seen := make(map[string]bool)
+	var names []string
+	for _, r := range d.Results {
+		for k := range r.Dims {
+			if !seen[k] {
+				seen[k] = true
+				names = append(names, k)
+			}
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// DimValues returns the values of the dimension dim in d in the order they
+// first appear.
+func (d *Dataset) DimValues(dim string) []string {
+	seen := make(map[string]bool)
+	var values []string
+	for _, r := range d.Results {
+		if v, ok := r.Dims[dim]; ok && !seen[v] {
+			seen[v] = true
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+// ReadFile reads a JSON dataset from path.
+func ReadFile(path string) (*Dataset, error) {
+	buf, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var d Dataset
+	if err := json.Unmarshal(buf, &d); err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	if d.SchemaVersion != SchemaVersion {
+		return nil, fmt.Errorf("%s: unsupported schema version %d (want %d)", path, d.SchemaVersion, SchemaVersion)
+	}
+
+	return &d, nil
+}
+
+// WriteJSON writes d to w as indented JSON.
+func (d *Dataset) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(d)
+}
+
+// WriteFile creates the file at path and writes it with write, e.g. a
+// dataset's WriteJSON or WriteCSV.
+func WriteFile(path string, write func(io.Writer) error) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := write(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,189 @@
+// Package runner runs the benchmark suite with go test and parses the output
+// into a results dataset.
+package runner
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackc/go_map_vs_switch/internal/results"
+)
+
+// Config controls a run of the benchmark suite.
+type Config struct {
+	// Dir is the directory of the benchmark package. The default is the
+	// current directory.
+	Dir string
+
+	// Go is the go command to use. The default is "go" from PATH.
+	Go string
+
+	// Bench is the -bench regexp. The default is ".".
+	Bench string
+
+	// Count is the number of times to run each benchmark. The default is 1.
+	Count int
+
+	// Benchtime is the -benchtime value, if any.
+	Benchtime string
+
+	// Args are extra arguments passed to go test before the package, e.g.
+	// -gcflags.
+	Args []string
+
+	// Env is added to the environment of the go command.
+	Env []string
+
+	// Seed, if not empty, is the seed the benchmarks generate their inputs
+	// from. It is passed in the MVS_SEED environment variable, which
+	// otherwise defaults to 1.
+	Seed string
+
+	// Output, if not nil, receives a copy of the raw go test output.
+	Output io.Writer
+}
+
+// seedEnv is the environment variable the benchmarks read their input seed
+// from.
+const seedEnv = "MVS_SEED"
+
+func (c *Config) goCmd() string {
+	if c.Go == "" {
+		return "go"
+	}
+	return c.Go
+}
+
+func (c *Config) command(ctx context.Context, args ...string) *exec.Cmd {
+	cmd := exec.CommandContext(ctx, c.goCmd(), args...)
+	cmd.Dir = c.Dir
+	cmd.Env = append(os.Environ(), c.Env...)
+	if c.Seed != "" {
+		cmd.Env = append(cmd.Env, seedEnv+"="+c.Seed)
+	}
+	return cmd
+}
+
+// TestArgs returns the go test arguments for c.
+func (c *Config) TestArgs() []string {
+	bench := c.Bench
+	if bench == "" {
+		bench = "."
+	}
+	count := c.Count
+	if count < 1 {
+		count = 1
+	}
+
+	args := []string{"test", "-run=^$", "-bench=" + bench, "-count=" + strconv.Itoa(count)}
+	if c.Benchtime != "" {
+		args = append(args, "-benchtime="+c.Benchtime)
+	}
+	args = append(args, c.Args...)
+	return append(args, ".")
+}
+
+// SelectsAll reports whether c runs every benchmark of the suite, which takes
+// many hours per count.
+func (c *Config) SelectsAll() bool {
+	return c.Bench == "" || c.Bench == "."
+}
+
+// Run runs the benchmarks described by c and returns the parsed results
+// with the host metadata filled in.
+func Run(ctx context.Context, c Config) (*results.Dataset, error) {
+	var out bytes.Buffer
+	var stdout io.Writer = &out
+	if c.Output != nil {
+		stdout = io.MultiWriter(&out, c.Output)
+	}
+
+	cmd := c.command(ctx, c.TestArgs()...)
+	cmd.Stdout = stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("go %s: %v", strings.Join(c.TestArgs(), " "), err)
+	}
+
+	d, err := results.Parse(&out)
+	if err != nil {
+		return nil, err
+	}
+
+	d.Date = time.Now().Format("2006-01-02")
+	d.Host.GoVersion, err = GoVersion(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	d.Host.Hostname, _ = os.Hostname()
+
+	return d, nil
+}
+
+// BuildTest compiles the test binary of the benchmark package described by c
+// to path without running it.
+func BuildTest(ctx context.Context, c Config, path string) error {
+	args := append([]string{"test", "-c", "-o", path}, c.Args...)
+	args = append(args, ".")
+
+	cmd := c.command(ctx, args...)
+	cmd.Stdout = os.Stderr
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("go %s: %v", strings.Join(args, " "), err)
+	}
+	return nil
+}
+
+// Objdump returns the go tool objdump disassembly of the functions of the
+// binary at path whose names match the regexp pattern.
+func Objdump(ctx context.Context, c Config, path, pattern string) ([]byte, error) {
+	cmd := c.command(ctx, "tool", "objdump", "-s", pattern, path)
+	cmd.Stderr = os.Stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("go tool objdump: %v", err)
+	}
+	return out, nil
+}
+
+// Nm returns the go tool nm -size listing of the symbols of the binary at
+// path.
+func Nm(ctx context.Context, c Config, path string) ([]byte, error) {
+	cmd := c.command(ctx, "tool", "nm", "-size", path)
+	cmd.Stderr = os.Stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("go tool nm: %v", err)
+	}
+	return out, nil
+}
+
+// Generate runs cmd/genbench of the benchmark package described by c to
+// write the files generated from the matrix at matrixPath to dir.
+func Generate(ctx context.Context, c Config, matrixPath, dir string) error {
+	args := []string{"run", "./cmd/genbench", "-matrix", matrixPath, "-dir", dir}
+	cmd := c.command(ctx, args...)
+	cmd.Stdout = os.Stderr
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("go %s: %v", strings.Join(args, " "), err)
+	}
+	return nil
+}
+
+// GoVersion returns the version of the go command c uses, e.g. go1.22.1.
+func GoVersion(ctx context.Context, c Config) (string, error) {
+	out, err := c.command(ctx, "env", "GOVERSION").Output()
+	if err != nil {
+		return "", fmt.Errorf("go env GOVERSION: %v", err)
+	}
+	return strings.TrimSpace(string(out)), nil
+}
@@ -0,0 +1,112 @@
+package stats
+
+import (
+	"math"
+	"sort"
+)
+
+// exactLimit is the largest sample size for which MannWhitneyU computes the
+// exact distribution of U rather than the normal approximation.
+const exactLimit = 50
+
+// MannWhitneyU performs a two-sided Mann-Whitney U test of whether xs and ys
+// come from the same distribution. It returns U for xs and the p-value. The
+// exact distribution of U is used for small samples without ties and the
+// normal approximation with tie and continuity corrections otherwise.
+func MannWhitneyU(xs, ys []float64) (u, p float64) {
+	n1, n2 := len(xs), len(ys)
+	if n1 == 0 || n2 == 0 {
+		return math.NaN(), math.NaN()
+	}
+
+	type obs struct {
+		v     float64
+		first bool
+	}
+	all := make([]obs, 0, n1+n2)
+	for _, x := range xs {
+		all = append(all, obs{x, true})
+	}
+	for _, y := range ys {
+		all = append(all, obs{y, false})
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i].v < all[j].v })
+
+	// Assign average ranks to ties and accumulate the tie correction.
+	var r1, tieSum float64
+	for i := 0; i < len(all); {
+		j := i
+		for j < len(all) && all[j].v == all[i].v {
+			j++
+		}
+		rank := float64(i+j+1) / 2
+		for k := i; k < j; k++ {
+			if all[k].first {
+				r1 += rank
+			}
+		}
+		t := float64(j - i)
+		tieSum += t*t*t - t
+		i = j
+	}
+
+	u = r1 - float64(n1*(n1+1))/2
+
+	if tieSum == 0 && n1 <= exactLimit && n2 <= exactLimit {
+		return u, exactP(n1, n2, u)
+	}
+
+	n := float64(n1 + n2)
+	mu := float64(n1*n2) / 2
+	sigma := math.Sqrt(float64(n1*n2) / 12 * ((n + 1) - tieSum/(n*(n-1))))
+	if sigma == 0 {
+		return u, 1
+	}
+	z := (math.Abs(u-mu) - 0.5) / sigma
+	if z < 0 {
+		z = 0
+	}
+	return u, math.Min(1, math.Erfc(z/math.Sqrt2))
+}
+
+// exactP returns the two-sided p-value of u under the exact null
+// distribution of U for samples of size n1 and n2.
+func exactP(n1, n2 int, u float64) float64 {
+	// counts[i][j][v] is the number of arrangements of i x's and j y's with
+	// U = v. Only the previous row of i is kept.
+	prev := make([][]float64, n2+1)
+	for j := range prev {
+		prev[j] = []float64{1}
+	}
+	for i := 1; i <= n1; i++ {
+		cur := make([][]float64, n2+1)
+		cur[0] = []float64{1}
+		for j := 1; j <= n2; j++ {
+			c := make([]float64, i*j+1)
+			// The largest observation is either an x, which beats all j y's,
+			// or a y, which beats nothing.
+			for v, cnt := range prev[j] {
+				c[v+j] += cnt
+			}
+			for v, cnt := range cur[j-1] {
+				c[v] += cnt
+			}
+			cur[j] = c
+		}
+		prev = cur
+	}
+
+	dist := prev[n2]
+	var total, le, ge float64
+	for v, cnt := range dist {
+		total += cnt
+		if float64(v) <= u {
+			le += cnt
+		}
+		if float64(v) >= u {
+			ge += cnt
+		}
+	}
+
+	return math.Min(1, 2*math.Min(le, ge)/total)
+}
@@ -0,0 +1,78 @@
+// Package stats implements the summary statistics used by the reporting
+// tools.
+package stats
+
+import (
+	"math"
+	"sort"
+)
+
+// Sorted returns a sorted copy of xs.
+func Sorted(xs []float64) []float64 {
+	s := append([]float64(nil), xs...)
+	sort.Float64s(s)
+	return s
+}
+
+// Quantile returns the q-th quantile of xs, 0 <= q <= 1, linearly
+// interpolating between the closest ranks. It returns NaN if xs is empty.
+func Quantile(xs []float64, q float64) float64 {
+	if len(xs) == 0 {
+		return math.NaN()
+	}
+
+	s := Sorted(xs)
+	pos := q * float64(len(s)-1)
+	lo := int(math.Floor(pos))
+	hi := int(math.Ceil(pos))
+	return s[lo] + (s[hi]-s[lo])*(pos-float64(lo))
+}
+
+// Median returns the median of xs or NaN if xs is empty.
+func Median(xs []float64) float64 {
+	return Quantile(xs, 0.5)
+}
+
+// IQR returns the interquartile range of xs or NaN if xs is empty.
+func IQR(xs []float64) float64 {
+	return Quantile(xs, 0.75) - Quantile(xs, 0.25)
+}
+
+// MedianCI returns a distribution-free confidence interval for the median of
+// xs using order statistics, and its actual coverage. The coverage is at
+// least confidence when xs is large enough; smaller samples get the
+// [min, max] interval, whose coverage is below confidence, e.g. 93.75% for 5
+// samples. It returns NaNs if xs is empty.
+func MedianCI(xs []float64, confidence float64) (lo, hi, coverage float64) {
+	n := len(xs)
+	if n == 0 {
+		return math.NaN(), math.NaN(), math.NaN()
+	}
+
+	s := Sorted(xs)
+	alpha := (1 - confidence) / 2
+
+	// Find the largest k such that P(Binomial(n, 0.5) < k) <= alpha. The
+	// interval is then [s[k-1], s[n-k]].
+	k := 1
+	cdf := binomialPMF(n, 0)
+	for j := 1; j < n/2; j++ {
+		next := cdf + binomialPMF(n, j)
+		if next > alpha {
+			break
+		}
+		cdf = next
+		k = j + 1
+	}
+
+	return s[k-1], s[n-k], 1 - 2*cdf
+}
+
+// binomialPMF returns P(X = k) for X ~ Binomial(n, 0.5).
+func binomialPMF(n, k int) float64 {
+	lg := func(x int) float64 {
+		v, _ := math.Lgamma(float64(x + 1))
+		return v
+	}
+	return math.Exp(lg(n) - lg(k) - lg(n-k) - float64(n)*math.Ln2)
+}
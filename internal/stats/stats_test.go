@@ -0,0 +1,87 @@
+package stats
+
+import (
+	"math"
+	"testing"
+)
+
+func TestQuantile(t *testing.T) {
+	tests := []struct {
+		xs   []float64
+		q    float64
+		want float64
+	}{
+		{[]float64{3, 1, 2}, 0.5, 2},
+		{[]float64{4, 1, 3, 2}, 0.5, 2.5},
+		{[]float64{1, 2, 3, 4, 5}, 0.25, 2},
+		{[]float64{1, 2, 3, 4, 5}, 0, 1},
+		{[]float64{1, 2, 3, 4, 5}, 1, 5},
+		{[]float64{7}, 0.9, 7},
+	}
+
+	for _, tt := range tests {
+		if got := Quantile(tt.xs, tt.q); got != tt.want {
+			t.Errorf("Quantile(%v, %v) = %v, want %v", tt.xs, tt.q, got, tt.want)
+		}
+	}
+
+	if got := Median(nil); !math.IsNaN(got) {
+		t.Errorf("Median(nil) = %v, want NaN", got)
+	}
+}
+
+func TestIQR(t *testing.T) {
+	if got := IQR([]float64{1, 2, 3, 4, 5}); got != 2 {
+		t.Errorf("IQR = %v, want 2", got)
+	}
+}
+
+func TestMedianCI(t *testing.T) {
+	xs := make([]float64, 20)
+	for i := range xs {
+		xs[i] = float64(20 - i)
+	}
+
+	// For n = 20 the 95% interval is [x(6), x(15)], which covers the
+	// median with probability 1 - 2*P(Binomial(20, 0.5) < 6).
+	lo, hi, coverage := MedianCI(xs, 0.95)
+	if lo != 6 || hi != 15 || math.Abs(coverage-0.958611) > 1e-6 {
+		t.Errorf("MedianCI = [%v, %v] %v, want [6, 15] 0.958611", lo, hi, coverage)
+	}
+
+	// Five samples are too few for 95%: [min, max] covers 1 - 2/2^5.
+	lo, hi, coverage = MedianCI([]float64{5, 3, 1, 2, 4}, 0.95)
+	if lo != 1 || hi != 5 || coverage != 0.9375 {
+		t.Errorf("MedianCI = [%v, %v] %v, want [1, 5] 0.9375", lo, hi, coverage)
+	}
+
+	lo, hi, coverage = MedianCI([]float64{3, 1, 2}, 0.95)
+	if lo != 1 || hi != 3 || coverage != 0.75 {
+		t.Errorf("MedianCI = [%v, %v] %v, want [1, 3] 0.75", lo, hi, coverage)
+	}
+}
+
+func TestMannWhitneyU(t *testing.T) {
+	tests := []struct {
+		xs, ys []float64
+		u, p   float64
+	}{
+		// Complete separation of 5 and 5 is the most extreme of 252
+		// arrangements in each direction.
+		{[]float64{1, 2, 3, 4, 5}, []float64{6, 7, 8, 9, 10}, 0, 2.0 / 252},
+		{[]float64{6, 7, 8, 9, 10}, []float64{1, 2, 3, 4, 5}, 25, 2.0 / 252},
+		{[]float64{1, 3, 5}, []float64{2, 4, 6}, 3, 0.7},
+	}
+
+	for _, tt := range tests {
+		u, p := MannWhitneyU(tt.xs, tt.ys)
+		if u != tt.u || math.Abs(p-tt.p) > 1e-12 {
+			t.Errorf("MannWhitneyU(%v, %v) = (%v, %v), want (%v, %v)", tt.xs, tt.ys, u, p, tt.u, tt.p)
+		}
+	}
+
+	// Identical samples are ties throughout and cannot be distinguished.
+	if _, p := MannWhitneyU([]float64{1, 1, 1}, []float64{1, 1, 1}); p != 1 {
+		t.Errorf("p = %v for identical samples, want 1", p)
+	}
+}
@@ -0,0 +1,2455 @@
+// Code generated by genbench from matrix.json. DO NOT EDIT.
+
+package go_map_vs_switch
+
+// LayoutKeysDense are the keys of each branch in the dense layout.
+var LayoutKeysDense = []int{
+	0,
+	1,
+	2,
+	3,
+	4,
+	5,
+	6,
+	7,
+	8,
+	9,
+	10,
+	11,
+	12,
+	13,
+	14,
+	15,
+	16,
+	17,
+	18,
+	19,
+	20,
+	21,
+	22,
+	23,
+	24,
+	25,
+	26,
+	27,
+	28,
+	29,
+	30,
+	31,
+	32,
+	33,
+	34,
+	35,
+	36,
+	37,
+	38,
+	39,
+	40,
+	41,
+	42,
+	43,
+	44,
+	45,
+	46,
+	47,
+	48,
+	49,
+	50,
+	51,
+	52,
+	53,
+	54,
+	55,
+	56,
+	57,
+	58,
+	59,
+	60,
+	61,
+	62,
+	63,
+	64,
+	65,
+	66,
+	67,
+	68,
+	69,
+	70,
+	71,
+	72,
+	73,
+	74,
+	75,
+	76,
+	77,
+	78,
+	79,
+	80,
+	81,
+	82,
+	83,
+	84,
+	85,
+	86,
+	87,
+	88,
+	89,
+	90,
+	91,
+	92,
+	93,
+	94,
+	95,
+	96,
+	97,
+	98,
+	99,
+	100,
+	101,
+	102,
+	103,
+	104,
+	105,
+	106,
+	107,
+	108,
+	109,
+	110,
+	111,
+	112,
+	113,
+	114,
+	115,
+	116,
+	117,
+	118,
+	119,
+	120,
+	121,
+	122,
+	123,
+	124,
+	125,
+	126,
+	127,
+	128,
+	129,
+	130,
+	131,
+	132,
+	133,
+	134,
+	135,
+	136,
+	137,
+	138,
+	139,
+	140,
+	141,
+	142,
+	143,
+	144,
+	145,
+	146,
+	147,
+	148,
+	149,
+	150,
+	151,
+	152,
+	153,
+	154,
+	155,
+	156,
+	157,
+	158,
+	159,
+	160,
+	161,
+	162,
+	163,
+	164,
+	165,
+	166,
+	167,
+	168,
+	169,
+	170,
+	171,
+	172,
+	173,
+	174,
+	175,
+	176,
+	177,
+	178,
+	179,
+	180,
+	181,
+	182,
+	183,
+	184,
+	185,
+	186,
+	187,
+	188,
+	189,
+	190,
+	191,
+	192,
+	193,
+	194,
+	195,
+	196,
+	197,
+	198,
+	199,
+	200,
+	201,
+	202,
+	203,
+	204,
+	205,
+	206,
+	207,
+	208,
+	209,
+	210,
+	211,
+	212,
+	213,
+	214,
+	215,
+	216,
+	217,
+	218,
+	219,
+	220,
+	221,
+	222,
+	223,
+	224,
+	225,
+	226,
+	227,
+	228,
+	229,
+	230,
+	231,
+	232,
+	233,
+	234,
+	235,
+	236,
+	237,
+	238,
+	239,
+	240,
+	241,
+	242,
+	243,
+	244,
+	245,
+	246,
+	247,
+	248,
+	249,
+	250,
+	251,
+	252,
+	253,
+	254,
+	255,
+	256,
+	257,
+	258,
+	259,
+	260,
+	261,
+	262,
+	263,
+	264,
+	265,
+	266,
+	267,
+	268,
+	269,
+	270,
+	271,
+	272,
+	273,
+	274,
+	275,
+	276,
+	277,
+	278,
+	279,
+	280,
+	281,
+	282,
+	283,
+	284,
+	285,
+	286,
+	287,
+	288,
+	289,
+	290,
+	291,
+	292,
+	293,
+	294,
+	295,
+	296,
+	297,
+	298,
+	299,
+	300,
+	301,
+	302,
+	303,
+	304,
+	305,
+	306,
+	307,
+	308,
+	309,
+	310,
+	311,
+	312,
+	313,
+	314,
+	315,
+	316,
+	317,
+	318,
+	319,
+	320,
+	321,
+	322,
+	323,
+	324,
+	325,
+	326,
+	327,
+	328,
+	329,
+	330,
+	331,
+	332,
+	333,
+	334,
+	335,
+	336,
+	337,
+	338,
+	339,
+	340,
+	341,
+	342,
+	343,
+	344,
+	345,
+	346,
+	347,
+	348,
+	349,
+	350,
+	351,
+	352,
+	353,
+	354,
+	355,
+	356,
+	357,
+	358,
+	359,
+	360,
+	361,
+	362,
+	363,
+	364,
+	365,
+	366,
+	367,
+	368,
+	369,
+	370,
+	371,
+	372,
+	373,
+	374,
+	375,
+	376,
+	377,
+	378,
+	379,
+	380,
+	381,
+	382,
+	383,
+	384,
+	385,
+	386,
+	387,
+	388,
+	389,
+	390,
+	391,
+	392,
+	393,
+	394,
+	395,
+	396,
+	397,
+	398,
+	399,
+	400,
+	401,
+	402,
+	403,
+	404,
+	405,
+	406,
+	407,
+	408,
+	409,
+	410,
+	411,
+	412,
+	413,
+	414,
+	415,
+	416,
+	417,
+	418,
+	419,
+	420,
+	421,
+	422,
+	423,
+	424,
+	425,
+	426,
+	427,
+	428,
+	429,
+	430,
+	431,
+	432,
+	433,
+	434,
+	435,
+	436,
+	437,
+	438,
+	439,
+	440,
+	441,
+	442,
+	443,
+	444,
+	445,
+	446,
+	447,
+	448,
+	449,
+	450,
+	451,
+	452,
+	453,
+	454,
+	455,
+	456,
+	457,
+	458,
+	459,
+	460,
+	461,
+	462,
+	463,
+	464,
+	465,
+	466,
+	467,
+	468,
+	469,
+	470,
+	471,
+	472,
+	473,
+	474,
+	475,
+	476,
+	477,
+	478,
+	479,
+	480,
+	481,
+	482,
+	483,
+	484,
+	485,
+	486,
+	487,
+	488,
+	489,
+	490,
+	491,
+	492,
+	493,
+	494,
+	495,
+	496,
+	497,
+	498,
+	499,
+	500,
+	501,
+	502,
+	503,
+	504,
+	505,
+	506,
+	507,
+	508,
+	509,
+	510,
+	511,
+}
+
+// LayoutKeysStrided are the keys of each branch in the strided(16) layout.
+var LayoutKeysStrided = []int{
+	0,
+	16,
+	32,
+	48,
+	64,
+	80,
+	96,
+	112,
+	128,
+	144,
+	160,
+	176,
+	192,
+	208,
+	224,
+	240,
+	256,
+	272,
+	288,
+	304,
+	320,
+	336,
+	352,
+	368,
+	384,
+	400,
+	416,
+	432,
+	448,
+	464,
+	480,
+	496,
+	512,
+	528,
+	544,
+	560,
+	576,
+	592,
+	608,
+	624,
+	640,
+	656,
+	672,
+	688,
+	704,
+	720,
+	736,
+	752,
+	768,
+	784,
+	800,
+	816,
+	832,
+	848,
+	864,
+	880,
+	896,
+	912,
+	928,
+	944,
+	960,
+	976,
+	992,
+	1008,
+	1024,
+	1040,
+	1056,
+	1072,
+	1088,
+	1104,
+	1120,
+	1136,
+	1152,
+	1168,
+	1184,
+	1200,
+	1216,
+	1232,
+	1248,
+	1264,
+	1280,
+	1296,
+	1312,
+	1328,
+	1344,
+	1360,
+	1376,
+	1392,
+	1408,
+	1424,
+	1440,
+	1456,
+	1472,
+	1488,
+	1504,
+	1520,
+	1536,
+	1552,
+	1568,
+	1584,
+	1600,
+	1616,
+	1632,
+	1648,
+	1664,
+	1680,
+	1696,
+	1712,
+	1728,
+	1744,
+	1760,
+	1776,
+	1792,
+	1808,
+	1824,
+	1840,
+	1856,
+	1872,
+	1888,
+	1904,
+	1920,
+	1936,
+	1952,
+	1968,
+	1984,
+	2000,
+	2016,
+	2032,
+	2048,
+	2064,
+	2080,
+	2096,
+	2112,
+	2128,
+	2144,
+	2160,
+	2176,
+	2192,
+	2208,
+	2224,
+	2240,
+	2256,
+	2272,
+	2288,
+	2304,
+	2320,
+	2336,
+	2352,
+	2368,
+	2384,
+	2400,
+	2416,
+	2432,
+	2448,
+	2464,
+	2480,
+	2496,
+	2512,
+	2528,
+	2544,
+	2560,
+	2576,
+	2592,
+	2608,
+	2624,
+	2640,
+	2656,
+	2672,
+	2688,
+	2704,
+	2720,
+	2736,
+	2752,
+	2768,
+	2784,
+	2800,
+	2816,
+	2832,
+	2848,
+	2864,
+	2880,
+	2896,
+	2912,
+	2928,
+	2944,
+	2960,
+	2976,
+	2992,
+	3008,
+	3024,
+	3040,
+	3056,
+	3072,
+	3088,
+	3104,
+	3120,
+	3136,
+	3152,
+	3168,
+	3184,
+	3200,
+	3216,
+	3232,
+	3248,
+	3264,
+	3280,
+	3296,
+	3312,
+	3328,
+	3344,
+	3360,
+	3376,
+	3392,
+	3408,
+	3424,
+	3440,
+	3456,
+	3472,
+	3488,
+	3504,
+	3520,
+	3536,
+	3552,
+	3568,
+	3584,
+	3600,
+	3616,
+	3632,
+	3648,
+	3664,
+	3680,
+	3696,
+	3712,
+	3728,
+	3744,
+	3760,
+	3776,
+	3792,
+	3808,
+	3824,
+	3840,
+	3856,
+	3872,
+	3888,
+	3904,
+	3920,
+	3936,
+	3952,
+	3968,
+	3984,
+	4000,
+	4016,
+	4032,
+	4048,
+	4064,
+	4080,
+	4096,
+	4112,
+	4128,
+	4144,
+	4160,
+	4176,
+	4192,
+	4208,
+	4224,
+	4240,
+	4256,
+	4272,
+	4288,
+	4304,
+	4320,
+	4336,
+	4352,
+	4368,
+	4384,
+	4400,
+	4416,
+	4432,
+	4448,
+	4464,
+	4480,
+	4496,
+	4512,
+	4528,
+	4544,
+	4560,
+	4576,
+	4592,
+	4608,
+	4624,
+	4640,
+	4656,
+	4672,
+	4688,
+	4704,
+	4720,
+	4736,
+	4752,
+	4768,
+	4784,
+	4800,
+	4816,
+	4832,
+	4848,
+	4864,
+	4880,
+	4896,
+	4912,
+	4928,
+	4944,
+	4960,
+	4976,
+	4992,
+	5008,
+	5024,
+	5040,
+	5056,
+	5072,
+	5088,
+	5104,
+	5120,
+	5136,
+	5152,
+	5168,
+	5184,
+	5200,
+	5216,
+	5232,
+	5248,
+	5264,
+	5280,
+	5296,
+	5312,
+	5328,
+	5344,
+	5360,
+	5376,
+	5392,
+	5408,
+	5424,
+	5440,
+	5456,
+	5472,
+	5488,
+	5504,
+	5520,
+	5536,
+	5552,
+	5568,
+	5584,
+	5600,
+	5616,
+	5632,
+	5648,
+	5664,
+	5680,
+	5696,
+	5712,
+	5728,
+	5744,
+	5760,
+	5776,
+	5792,
+	5808,
+	5824,
+	5840,
+	5856,
+	5872,
+	5888,
+	5904,
+	5920,
+	5936,
+	5952,
+	5968,
+	5984,
+	6000,
+	6016,
+	6032,
+	6048,
+	6064,
+	6080,
+	6096,
+	6112,
+	6128,
+	6144,
+	6160,
+	6176,
+	6192,
+	6208,
+	6224,
+	6240,
+	6256,
+	6272,
+	6288,
+	6304,
+	6320,
+	6336,
+	6352,
+	6368,
+	6384,
+	6400,
+	6416,
+	6432,
+	6448,
+	6464,
+	6480,
+	6496,
+	6512,
+	6528,
+	6544,
+	6560,
+	6576,
+	6592,
+	6608,
+	6624,
+	6640,
+	6656,
+	6672,
+	6688,
+	6704,
+	6720,
+	6736,
+	6752,
+	6768,
+	6784,
+	6800,
+	6816,
+	6832,
+	6848,
+	6864,
+	6880,
+	6896,
+	6912,
+	6928,
+	6944,
+	6960,
+	6976,
+	6992,
+	7008,
+	7024,
+	7040,
+	7056,
+	7072,
+	7088,
+	7104,
+	7120,
+	7136,
+	7152,
+	7168,
+	7184,
+	7200,
+	7216,
+	7232,
+	7248,
+	7264,
+	7280,
+	7296,
+	7312,
+	7328,
+	7344,
+	7360,
+	7376,
+	7392,
+	7408,
+	7424,
+	7440,
+	7456,
+	7472,
+	7488,
+	7504,
+	7520,
+	7536,
+	7552,
+	7568,
+	7584,
+	7600,
+	7616,
+	7632,
+	7648,
+	7664,
+	7680,
+	7696,
+	7712,
+	7728,
+	7744,
+	7760,
+	7776,
+	7792,
+	7808,
+	7824,
+	7840,
+	7856,
+	7872,
+	7888,
+	7904,
+	7920,
+	7936,
+	7952,
+	7968,
+	7984,
+	8000,
+	8016,
+	8032,
+	8048,
+	8064,
+	8080,
+	8096,
+	8112,
+	8128,
+	8144,
+	8160,
+	8176,
+}
+
+// LayoutKeysSparse are the keys of each branch in the sparse layout.
+var LayoutKeysSparse = []int{
+	-1697971134,
+	-255511522,
+	-1440703602,
+	1879968118,
+	1823804162,
+	-1345084660,
+	281908850,
+	672245080,
+	416480912,
+	1292406600,
+	-2082145907,
+	-800410273,
+	920256325,
+	1634910179,
+	1366049456,
+	2013866549,
+	1215622422,
+	1258862891,
+	-1378320822,
+	938678213,
+	872680990,
+	1549930933,
+	-1843944239,
+	-590594780,
+	1258916094,
+	1275959894,
+	-1062690721,
+	887265776,
+	-578381716,
+	-1302581265,
+	-2045176213,
+	121560817,
+	680014774,
+	-1686833633,
+	-106336438,
+	341250713,
+	-1740283822,
+	253921263,
+	-1322744326,
+	1295030053,
+	744172826,
+	-1970961115,
+	-1957836907,
+	1196181127,
+	1817424866,
+	-2016119001,
+	1088948157,
+	1211528648,
+	-907934977,
+	1553942706,
+	-513063382,
+	1276087442,
+	-453712918,
+	418564398,
+	-99141295,
+	319077410,
+	954725776,
+	-1369758219,
+	1037299406,
+	1337978710,
+	-288422394,
+	-1108750275,
+	-854462086,
+	-1158646983,
+	785656533,
+	1839779657,
+	-442416174,
+	-1362992891,
+	-90497729,
+	-334095804,
+	390143126,
+	2118028752,
+	-313589291,
+	-193507860,
+	1494450894,
+	-1327837108,
+	-1241644142,
+	-1873552370,
+	-1505431304,
+	-1925154456,
+	-1048730050,
+	1734321906,
+	561142274,
+	-60281029,
+	-445208814,
+	1383340119,
+	-1197661229,
+	-1526690010,
+	367307783,
+	-1419163291,
+	-1620369539,
+	1587818672,
+	1017145093,
+	-1995949082,
+	804215881,
+	1025813007,
+	-1597306192,
+	544399686,
+	1208304411,
+	1762323197,
+	1867934851,
+	-1610204593,
+	-1932104265,
+	-1616587781,
+	-1163159922,
+	-727851276,
+	2206820,
+	-1133576725,
+	1717917180,
+	2138327264,
+	-1700901100,
+	1759297107,
+	127437182,
+	8177164,
+	12210768,
+	-361544701,
+	-1761648762,
+	-1892395022,
+	-792828754,
+	-523935508,
+	1968995452,
+	-1717275692,
+	112807962,
+	-662143137,
+	1072424133,
+	-1538524756,
+	1062860987,
+	745846173,
+	-1749667658,
+	-797169334,
+	-1314955191,
+	130234351,
+	-1979077526,
+	-104475875,
+	-1070464522,
+	1262747498,
+	-1060164240,
+	648385636,
+	1528008769,
+	-721851490,
+	995702448,
+	-1598438201,
+	2140587224,
+	385843065,
+	108207233,
+	1684555679,
+	-1763586216,
+	-302315724,
+	-1837873194,
+	-1767051143,
+	1768507280,
+	-1921652594,
+	2111437688,
+	-180586567,
+	-870982685,
+	461198367,
+	-931857694,
+	1689000180,
+	560123204,
+	816184516,
+	-1117439762,
+	-1485880834,
+	422555156,
+	-2059950988,
+	428335644,
+	652162446,
+	327234684,
+	1353808417,
+	685695486,
+	591863937,
+	1385602332,
+	-1979659876,
+	-1843178226,
+	-2092586415,
+	-1356457488,
+	-1490180972,
+	-2042257982,
+	-1484898466,
+	-1218188554,
+	-1560601218,
+	55086860,
+	131779027,
+	421039401,
+	1585322719,
+	-745373855,
+	1493281567,
+	1478821740,
+	1086625968,
+	929736495,
+	-1911251275,
+	1726881131,
+	-2119578972,
+	724473658,
+	1423215840,
+	-739043200,
+	-1287253784,
+	248791390,
+	-3609963,
+	1767552401,
+	479638912,
+	-941654037,
+	395642184,
+	229757662,
+	-1225372141,
+	1077015772,
+	-650116655,
+	-112176497,
+	912942324,
+	92486895,
+	-235386712,
+	399303777,
+	-1521134074,
+	1339538206,
+	1926139776,
+	2092676637,
+	354247513,
+	-1409483257,
+	1718795615,
+	-428314982,
+	-215249991,
+	1371517350,
+	2144844363,
+	1719843706,
+	85077591,
+	-1524546462,
+	1841202787,
+	1458556941,
+	-483409303,
+	1015018773,
+	-1009282032,
+	153565041,
+	-1170046776,
+	-1607019451,
+	-2091273238,
+	311163323,
+	-1184427176,
+	-516062456,
+	-95505138,
+	-654981387,
+	-720704368,
+	1064484121,
+	-371948193,
+	322282363,
+	-708223782,
+	-1592008263,
+	-1066266095,
+	-1580533217,
+	416329275,
+	63683067,
+	-1787416174,
+	295305609,
+	-7413926,
+	-1506724278,
+	-62424865,
+	-709503858,
+	1426170028,
+	-1454305216,
+	-188889971,
+	1333631481,
+	791952000,
+	-141328728,
+	-716220949,
+	1329500471,
+	-833774410,
+	1792400843,
+	-1208902515,
+	1746921148,
+	-447521558,
+	-179631155,
+	80372672,
+	-894760620,
+	1819146944,
+	65203089,
+	1858424790,
+	-408982948,
+	-619744821,
+	184347049,
+	-1464452710,
+	1494043213,
+	-2132507999,
+	-687420592,
+	99254840,
+	534137245,
+	1121740742,
+	-708895062,
+	1352076303,
+	32990530,
+	-430569932,
+	1590286959,
+	430353185,
+	-1532427038,
+	-988318833,
+	-897109853,
+	1126921711,
+	1489769022,
+	921930687,
+	-764105189,
+	1508110333,
+	-1721447578,
+	-1810967562,
+	1776316817,
+	514754016,
+	-379616239,
+	231007307,
+	983193548,
+	1392316569,
+	1506524751,
+	1500183748,
+	1304820212,
+	-134234221,
+	-1410782724,
+	893108935,
+	-158315067,
+	1297949317,
+	-824886777,
+	575887093,
+	-224367850,
+	-1542473848,
+	-200752122,
+	-816583982,
+	779926748,
+	-245855218,
+	-724814234,
+	2124636293,
+	-621437330,
+	-1242345439,
+	1174651033,
+	1750769853,
+	-387572341,
+	-238812471,
+	2141610073,
+	1239692102,
+	-103529086,
+	1943835524,
+	193233579,
+	1354469397,
+	-206561521,
+	-1067028436,
+	-1993762478,
+	-1418565040,
+	-579068977,
+	1970893722,
+	-1810110091,
+	2068154836,
+	-1930090606,
+	-212071554,
+	-2105111990,
+	-1105890816,
+	2107944240,
+	284118163,
+	1127388811,
+	-320112743,
+	1595523019,
+	1758442933,
+	1785641113,
+	417735390,
+	-422506136,
+	19089668,
+	1176496955,
+	469468534,
+	-620844340,
+	1104044350,
+	-46677310,
+	-316060535,
+	734207617,
+	1305185263,
+	-2003810766,
+	758017117,
+	-800621527,
+	-1266426411,
+	1104698047,
+	1075326325,
+	1439219346,
+	-1068413352,
+	20958506,
+	-682928979,
+	986011055,
+	57060992,
+	-215017199,
+	-432196269,
+	-160527834,
+	184685148,
+	-1234104684,
+	219447481,
+	1750337062,
+	2043104664,
+	1492365354,
+	174890805,
+	-1728439988,
+	1117226966,
+	-717878635,
+	-169661923,
+	-271838922,
+	984922924,
+	-1201246088,
+	-1045900454,
+	1933396386,
+	1455896797,
+	2029337912,
+	-60153766,
+	1556201844,
+	1072260091,
+	205592989,
+	-903157683,
+	-1047069298,
+	-1471159248,
+	949182841,
+	-1471003208,
+	1678655519,
+	-346799282,
+	642894248,
+	-2059900581,
+	-1006789590,
+	1065935549,
+	1956116951,
+	-1289395816,
+	352056179,
+	-2094775668,
+	1144101090,
+	-1623490618,
+	425503163,
+	603766263,
+	683774103,
+	-240453325,
+	-1582796037,
+	1055668406,
+	-139563906,
+	-1988653112,
+	1452476744,
+	-1410176746,
+	-698078541,
+	-1143084771,
+	-2137945349,
+	1818806034,
+	1988739519,
+	-201671681,
+	-1099199736,
+	1955024410,
+	1066942813,
+	-1203082347,
+	1819787089,
+	-1105563850,
+	-1473825507,
+	-1363559451,
+	-1559971230,
+	1067655148,
+	1661557221,
+	-987967235,
+	542730790,
+	1231099828,
+	-2098782249,
+	852815300,
+	-23165478,
+	-1563568742,
+	-1171156006,
+	1770881343,
+	-2087252879,
+	563456396,
+	-1951621827,
+	-665569537,
+	1259646708,
+	-952177854,
+	608935175,
+	-1484428644,
+	-1583347276,
+	1692067842,
+	6062535,
+	-1864588694,
+	-481929598,
+	1943398976,
+	-1734399456,
+	-1534010547,
+	-730838493,
+	-814411016,
+	283187946,
+	349931193,
+	1441038932,
+	2107260151,
+	-1636255076,
+	-1599193552,
+	1394335827,
+	-888136867,
+	361579919,
+	1092320650,
+	-1341884404,
+	1804819842,
+	95052488,
+	931994127,
+	336062872,
+	-1873274321,
+	981722977,
+	1578716447,
+	1168423850,
+	-428209243,
+	-1452428858,
+	1911818401,
+	-134241068,
+	-1427589835,
+	1565086353,
+	28462600,
+	885516103,
+	-934348428,
+	-1003170058,
+	-823595963,
+	1236561826,
+	-1675253206,
+	1942201082,
+	972851284,
+	1670984478,
+	1060275388,
+	32334443,
+	659173149,
+}
+
+// LayoutKeysClustered are the keys of each branch in the clustered(8) layout.
+var LayoutKeysClustered = []int{
+	0,
+	1,
+	2,
+	3,
+	4,
+	5,
+	6,
+	7,
+	65536,
+	65537,
+	65538,
+	65539,
+	65540,
+	65541,
+	65542,
+	65543,
+	131072,
+	131073,
+	131074,
+	131075,
+	131076,
+	131077,
+	131078,
+	131079,
+	196608,
+	196609,
+	196610,
+	196611,
+	196612,
+	196613,
+	196614,
+	196615,
+	262144,
+	262145,
+	262146,
+	262147,
+	262148,
+	262149,
+	262150,
+	262151,
+	327680,
+	327681,
+	327682,
+	327683,
+	327684,
+	327685,
+	327686,
+	327687,
+	393216,
+	393217,
+	393218,
+	393219,
+	393220,
+	393221,
+	393222,
+	393223,
+	458752,
+	458753,
+	458754,
+	458755,
+	458756,
+	458757,
+	458758,
+	458759,
+	524288,
+	524289,
+	524290,
+	524291,
+	524292,
+	524293,
+	524294,
+	524295,
+	589824,
+	589825,
+	589826,
+	589827,
+	589828,
+	589829,
+	589830,
+	589831,
+	655360,
+	655361,
+	655362,
+	655363,
+	655364,
+	655365,
+	655366,
+	655367,
+	720896,
+	720897,
+	720898,
+	720899,
+	720900,
+	720901,
+	720902,
+	720903,
+	786432,
+	786433,
+	786434,
+	786435,
+	786436,
+	786437,
+	786438,
+	786439,
+	851968,
+	851969,
+	851970,
+	851971,
+	851972,
+	851973,
+	851974,
+	851975,
+	917504,
+	917505,
+	917506,
+	917507,
+	917508,
+	917509,
+	917510,
+	917511,
+	983040,
+	983041,
+	983042,
+	983043,
+	983044,
+	983045,
+	983046,
+	983047,
+	1048576,
+	1048577,
+	1048578,
+	1048579,
+	1048580,
+	1048581,
+	1048582,
+	1048583,
+	1114112,
+	1114113,
+	1114114,
+	1114115,
+	1114116,
+	1114117,
+	1114118,
+	1114119,
+	1179648,
+	1179649,
+	1179650,
+	1179651,
+	1179652,
+	1179653,
+	1179654,
+	1179655,
+	1245184,
+	1245185,
+	1245186,
+	1245187,
+	1245188,
+	1245189,
+	1245190,
+	1245191,
+	1310720,
+	1310721,
+	1310722,
+	1310723,
+	1310724,
+	1310725,
+	1310726,
+	1310727,
+	1376256,
+	1376257,
+	1376258,
+	1376259,
+	1376260,
+	1376261,
+	1376262,
+	1376263,
+	1441792,
+	1441793,
+	1441794,
+	1441795,
+	1441796,
+	1441797,
+	1441798,
+	1441799,
+	1507328,
+	1507329,
+	1507330,
+	1507331,
+	1507332,
+	1507333,
+	1507334,
+	1507335,
+	1572864,
+	1572865,
+	1572866,
+	1572867,
+	1572868,
+	1572869,
+	1572870,
+	1572871,
+	1638400,
+	1638401,
+	1638402,
+	1638403,
+	1638404,
+	1638405,
+	1638406,
+	1638407,
+	1703936,
+	1703937,
+	1703938,
+	1703939,
+	1703940,
+	1703941,
+	1703942,
+	1703943,
+	1769472,
+	1769473,
+	1769474,
+	1769475,
+	1769476,
+	1769477,
+	1769478,
+	1769479,
+	1835008,
+	1835009,
+	1835010,
+	1835011,
+	1835012,
+	1835013,
+	1835014,
+	1835015,
+	1900544,
+	1900545,
+	1900546,
+	1900547,
+	1900548,
+	1900549,
+	1900550,
+	1900551,
+	1966080,
+	1966081,
+	1966082,
+	1966083,
+	1966084,
+	1966085,
+	1966086,
+	1966087,
+	2031616,
+	2031617,
+	2031618,
+	2031619,
+	2031620,
+	2031621,
+	2031622,
+	2031623,
+	2097152,
+	2097153,
+	2097154,
+	2097155,
+	2097156,
+	2097157,
+	2097158,
+	2097159,
+	2162688,
+	2162689,
+	2162690,
+	2162691,
+	2162692,
+	2162693,
+	2162694,
+	2162695,
+	2228224,
+	2228225,
+	2228226,
+	2228227,
+	2228228,
+	2228229,
+	2228230,
+	2228231,
+	2293760,
+	2293761,
+	2293762,
+	2293763,
+	2293764,
+	2293765,
+	2293766,
+	2293767,
+	2359296,
+	2359297,
+	2359298,
+	2359299,
+	2359300,
+	2359301,
+	2359302,
+	2359303,
+	2424832,
+	2424833,
+	2424834,
+	2424835,
+	2424836,
+	2424837,
+	2424838,
+	2424839,
+	2490368,
+	2490369,
+	2490370,
+	2490371,
+	2490372,
+	2490373,
+	2490374,
+	2490375,
+	2555904,
+	2555905,
+	2555906,
+	2555907,
+	2555908,
+	2555909,
+	2555910,
+	2555911,
+	2621440,
+	2621441,
+	2621442,
+	2621443,
+	2621444,
+	2621445,
+	2621446,
+	2621447,
+	2686976,
+	2686977,
+	2686978,
+	2686979,
+	2686980,
+	2686981,
+	2686982,
+	2686983,
+	2752512,
+	2752513,
+	2752514,
+	2752515,
+	2752516,
+	2752517,
+	2752518,
+	2752519,
+	2818048,
+	2818049,
+	2818050,
+	2818051,
+	2818052,
+	2818053,
+	2818054,
+	2818055,
+	2883584,
+	2883585,
+	2883586,
+	2883587,
+	2883588,
+	2883589,
+	2883590,
+	2883591,
+	2949120,
+	2949121,
+	2949122,
+	2949123,
+	2949124,
+	2949125,
+	2949126,
+	2949127,
+	3014656,
+	3014657,
+	3014658,
+	3014659,
+	3014660,
+	3014661,
+	3014662,
+	3014663,
+	3080192,
+	3080193,
+	3080194,
+	3080195,
+	3080196,
+	3080197,
+	3080198,
+	3080199,
+	3145728,
+	3145729,
+	3145730,
+	3145731,
+	3145732,
+	3145733,
+	3145734,
+	3145735,
+	3211264,
+	3211265,
+	3211266,
+	3211267,
+	3211268,
+	3211269,
+	3211270,
+	3211271,
+	3276800,
+	3276801,
+	3276802,
+	3276803,
+	3276804,
+	3276805,
+	3276806,
+	3276807,
+	3342336,
+	3342337,
+	3342338,
+	3342339,
+	3342340,
+	3342341,
+	3342342,
+	3342343,
+	3407872,
+	3407873,
+	3407874,
+	3407875,
+	3407876,
+	3407877,
+	3407878,
+	3407879,
+	3473408,
+	3473409,
+	3473410,
+	3473411,
+	3473412,
+	3473413,
+	3473414,
+	3473415,
+	3538944,
+	3538945,
+	3538946,
+	3538947,
+	3538948,
+	3538949,
+	3538950,
+	3538951,
+	3604480,
+	3604481,
+	3604482,
+	3604483,
+	3604484,
+	3604485,
+	3604486,
+	3604487,
+	3670016,
+	3670017,
+	3670018,
+	3670019,
+	3670020,
+	3670021,
+	3670022,
+	3670023,
+	3735552,
+	3735553,
+	3735554,
+	3735555,
+	3735556,
+	3735557,
+	3735558,
+	3735559,
+	3801088,
+	3801089,
+	3801090,
+	3801091,
+	3801092,
+	3801093,
+	3801094,
+	3801095,
+	3866624,
+	3866625,
+	3866626,
+	3866627,
+	3866628,
+	3866629,
+	3866630,
+	3866631,
+	3932160,
+	3932161,
+	3932162,
+	3932163,
+	3932164,
+	3932165,
+	3932166,
+	3932167,
+	3997696,
+	3997697,
+	3997698,
+	3997699,
+	3997700,
+	3997701,
+	3997702,
+	3997703,
+	4063232,
+	4063233,
+	4063234,
+	4063235,
+	4063236,
+	4063237,
+	4063238,
+	4063239,
+	4128768,
+	4128769,
+	4128770,
+	4128771,
+	4128772,
+	4128773,
+	4128774,
+	4128775,
+}
+
+var InlineLayoutMapDenseN4 map[int]func(int) int
+var InlineLayoutMapDenseN8 map[int]func(int) int
+var InlineLayoutMapDenseN16 map[int]func(int) int
+var InlineLayoutMapDenseN32 map[int]func(int) int
+var InlineLayoutMapDenseN64 map[int]func(int) int
+var InlineLayoutMapDenseN128 map[int]func(int) int
+var InlineLayoutMapDenseN256 map[int]func(int) int
+var InlineLayoutMapDenseN512 map[int]func(int) int
+var InlineLayoutMapStridedN4 map[int]func(int) int
+var InlineLayoutMapStridedN8 map[int]func(int) int
+var InlineLayoutMapStridedN16 map[int]func(int) int
+var InlineLayoutMapStridedN32 map[int]func(int) int
+var InlineLayoutMapStridedN64 map[int]func(int) int
+var InlineLayoutMapStridedN128 map[int]func(int) int
+var InlineLayoutMapStridedN256 map[int]func(int) int
+var InlineLayoutMapStridedN512 map[int]func(int) int
+var InlineLayoutMapSparseN4 map[int]func(int) int
+var InlineLayoutMapSparseN8 map[int]func(int) int
+var InlineLayoutMapSparseN16 map[int]func(int) int
+var InlineLayoutMapSparseN32 map[int]func(int) int
+var InlineLayoutMapSparseN64 map[int]func(int) int
+var InlineLayoutMapSparseN128 map[int]func(int) int
+var InlineLayoutMapSparseN256 map[int]func(int) int
+var InlineLayoutMapSparseN512 map[int]func(int) int
+var InlineLayoutMapClusteredN4 map[int]func(int) int
+var InlineLayoutMapClusteredN8 map[int]func(int) int
+var InlineLayoutMapClusteredN16 map[int]func(int) int
+var InlineLayoutMapClusteredN32 map[int]func(int) int
+var InlineLayoutMapClusteredN64 map[int]func(int) int
+var InlineLayoutMapClusteredN128 map[int]func(int) int
+var InlineLayoutMapClusteredN256 map[int]func(int) int
+var InlineLayoutMapClusteredN512 map[int]func(int) int
+var NoInlineLayoutMapDenseN4 map[int]func(int) int
+var NoInlineLayoutMapDenseN8 map[int]func(int) int
+var NoInlineLayoutMapDenseN16 map[int]func(int) int
+var NoInlineLayoutMapDenseN32 map[int]func(int) int
+var NoInlineLayoutMapDenseN64 map[int]func(int) int
+var NoInlineLayoutMapDenseN128 map[int]func(int) int
+var NoInlineLayoutMapDenseN256 map[int]func(int) int
+var NoInlineLayoutMapDenseN512 map[int]func(int) int
+var NoInlineLayoutMapStridedN4 map[int]func(int) int
+var NoInlineLayoutMapStridedN8 map[int]func(int) int
+var NoInlineLayoutMapStridedN16 map[int]func(int) int
+var NoInlineLayoutMapStridedN32 map[int]func(int) int
+var NoInlineLayoutMapStridedN64 map[int]func(int) int
+var NoInlineLayoutMapStridedN128 map[int]func(int) int
+var NoInlineLayoutMapStridedN256 map[int]func(int) int
+var NoInlineLayoutMapStridedN512 map[int]func(int) int
+var NoInlineLayoutMapSparseN4 map[int]func(int) int
+var NoInlineLayoutMapSparseN8 map[int]func(int) int
+var NoInlineLayoutMapSparseN16 map[int]func(int) int
+var NoInlineLayoutMapSparseN32 map[int]func(int) int
+var NoInlineLayoutMapSparseN64 map[int]func(int) int
+var NoInlineLayoutMapSparseN128 map[int]func(int) int
+var NoInlineLayoutMapSparseN256 map[int]func(int) int
+var NoInlineLayoutMapSparseN512 map[int]func(int) int
+var NoInlineLayoutMapClusteredN4 map[int]func(int) int
+var NoInlineLayoutMapClusteredN8 map[int]func(int) int
+var NoInlineLayoutMapClusteredN16 map[int]func(int) int
+var NoInlineLayoutMapClusteredN32 map[int]func(int) int
+var NoInlineLayoutMapClusteredN64 map[int]func(int) int
+var NoInlineLayoutMapClusteredN128 map[int]func(int) int
+var NoInlineLayoutMapClusteredN256 map[int]func(int) int
+var NoInlineLayoutMapClusteredN512 map[int]func(int) int
+
+func init() {
+
+	InlineLayoutMapDenseN4 = make(map[int]func(int) int, 4)
+	for i, k := range LayoutKeysDense[:4] {
+		InlineLayoutMapDenseN4[k] = InlineFuncs[i]
+	}
+
+	InlineLayoutMapDenseN8 = make(map[int]func(int) int, 8)
+	for i, k := range LayoutKeysDense[:8] {
+		InlineLayoutMapDenseN8[k] = InlineFuncs[i]
+	}
+
+	InlineLayoutMapDenseN16 = make(map[int]func(int) int, 16)
+	for i, k := range LayoutKeysDense[:16] {
+		InlineLayoutMapDenseN16[k] = InlineFuncs[i]
+	}
+
+	InlineLayoutMapDenseN32 = make(map[int]func(int) int, 32)
+	for i, k := range LayoutKeysDense[:32] {
+		InlineLayoutMapDenseN32[k] = InlineFuncs[i]
+	}
+
+	InlineLayoutMapDenseN64 = make(map[int]func(int) int, 64)
+	for i, k := range LayoutKeysDense[:64] {
+		InlineLayoutMapDenseN64[k] = InlineFuncs[i]
+	}
+
+	InlineLayoutMapDenseN128 = make(map[int]func(int) int, 128)
+	for i, k := range LayoutKeysDense[:128] {
+		InlineLayoutMapDenseN128[k] = InlineFuncs[i]
+	}
+
+	InlineLayoutMapDenseN256 = make(map[int]func(int) int, 256)
+	for i, k := range LayoutKeysDense[:256] {
+		InlineLayoutMapDenseN256[k] = InlineFuncs[i]
+	}
+
+	InlineLayoutMapDenseN512 = make(map[int]func(int) int, 512)
+	for i, k := range LayoutKeysDense[:512] {
+		InlineLayoutMapDenseN512[k] = InlineFuncs[i]
+	}
+
+	InlineLayoutMapStridedN4 = make(map[int]func(int) int, 4)
+	for i, k := range LayoutKeysStrided[:4] {
+		InlineLayoutMapStridedN4[k] = InlineFuncs[i]
+	}
+
+	InlineLayoutMapStridedN8 = make(map[int]func(int) int, 8)
+	for i, k := range LayoutKeysStrided[:8] {
+		InlineLayoutMapStridedN8[k] = InlineFuncs[i]
+	}
+
+	InlineLayoutMapStridedN16 = make(map[int]func(int) int, 16)
+	for i, k := range LayoutKeysStrided[:16] {
+		InlineLayoutMapStridedN16[k] = InlineFuncs[i]
+	}
+
+	InlineLayoutMapStridedN32 = make(map[int]func(int) int, 32)
+	for i, k := range LayoutKeysStrided[:32] {
+		InlineLayoutMapStridedN32[k] = InlineFuncs[i]
+	}
+
+	InlineLayoutMapStridedN64 = make(map[int]func(int) int, 64)
+	for i, k := range LayoutKeysStrided[:64] {
+		InlineLayoutMapStridedN64[k] = InlineFuncs[i]
+	}
+
+	InlineLayoutMapStridedN128 = make(map[int]func(int) int, 128)
+	for i, k := range LayoutKeysStrided[:128] {
+		InlineLayoutMapStridedN128[k] = InlineFuncs[i]
+	}
+
+	InlineLayoutMapStridedN256 = make(map[int]func(int) int, 256)
+	for i, k := range LayoutKeysStrided[:256] {
+		InlineLayoutMapStridedN256[k] = InlineFuncs[i]
+	}
+
+	InlineLayoutMapStridedN512 = make(map[int]func(int) int, 512)
+	for i, k := range LayoutKeysStrided[:512] {
+		InlineLayoutMapStridedN512[k] = InlineFuncs[i]
+	}
+
+	InlineLayoutMapSparseN4 = make(map[int]func(int) int, 4)
+	for i, k := range LayoutKeysSparse[:4] {
+		InlineLayoutMapSparseN4[k] = InlineFuncs[i]
+	}
+
+	InlineLayoutMapSparseN8 = make(map[int]func(int) int, 8)
+	for i, k := range LayoutKeysSparse[:8] {
+		InlineLayoutMapSparseN8[k] = InlineFuncs[i]
+	}
+
+	InlineLayoutMapSparseN16 = make(map[int]func(int) int, 16)
+	for i, k := range LayoutKeysSparse[:16] {
+		InlineLayoutMapSparseN16[k] = InlineFuncs[i]
+	}
+
+	InlineLayoutMapSparseN32 = make(map[int]func(int) int, 32)
+	for i, k := range LayoutKeysSparse[:32] {
+		InlineLayoutMapSparseN32[k] = InlineFuncs[i]
+	}
+
+	InlineLayoutMapSparseN64 = make(map[int]func(int) int, 64)
+	for i, k := range LayoutKeysSparse[:64] {
+		InlineLayoutMapSparseN64[k] = InlineFuncs[i]
+	}
+
+	InlineLayoutMapSparseN128 = make(map[int]func(int) int, 128)
+	for i, k := range LayoutKeysSparse[:128] {
+		InlineLayoutMapSparseN128[k] = InlineFuncs[i]
+	}
+
+	InlineLayoutMapSparseN256 = make(map[int]func(int) int, 256)
+	for i, k := range LayoutKeysSparse[:256] {
+		InlineLayoutMapSparseN256[k] = InlineFuncs[i]
+	}
+
+	InlineLayoutMapSparseN512 = make(map[int]func(int) int, 512)
+	for i, k := range LayoutKeysSparse[:512] {
+		InlineLayoutMapSparseN512[k] = InlineFuncs[i]
+	}
+
+	InlineLayoutMapClusteredN4 = make(map[int]func(int) int, 4)
+	for i, k := range LayoutKeysClustered[:4] {
+		InlineLayoutMapClusteredN4[k] = InlineFuncs[i]
+	}
+
+	InlineLayoutMapClusteredN8 = make(map[int]func(int) int, 8)
+	for i, k := range LayoutKeysClustered[:8] {
+		InlineLayoutMapClusteredN8[k] = InlineFuncs[i]
+	}
+
+	InlineLayoutMapClusteredN16 = make(map[int]func(int) int, 16)
+	for i, k := range LayoutKeysClustered[:16] {
+		InlineLayoutMapClusteredN16[k] = InlineFuncs[i]
+	}
+
+	InlineLayoutMapClusteredN32 = make(map[int]func(int) int, 32)
+	for i, k := range LayoutKeysClustered[:32] {
+		InlineLayoutMapClusteredN32[k] = InlineFuncs[i]
+	}
+
+	InlineLayoutMapClusteredN64 = make(map[int]func(int) int, 64)
+	for i, k := range LayoutKeysClustered[:64] {
+		InlineLayoutMapClusteredN64[k] = InlineFuncs[i]
+	}
+
+	InlineLayoutMapClusteredN128 = make(map[int]func(int) int, 128)
+	for i, k := range LayoutKeysClustered[:128] {
+		InlineLayoutMapClusteredN128[k] = InlineFuncs[i]
+	}
+
+	InlineLayoutMapClusteredN256 = make(map[int]func(int) int, 256)
+	for i, k := range LayoutKeysClustered[:256] {
+		InlineLayoutMapClusteredN256[k] = InlineFuncs[i]
+	}
+
+	InlineLayoutMapClusteredN512 = make(map[int]func(int) int, 512)
+	for i, k := range LayoutKeysClustered[:512] {
+		InlineLayoutMapClusteredN512[k] = InlineFuncs[i]
+	}
+
+	NoInlineLayoutMapDenseN4 = make(map[int]func(int) int, 4)
+	for i, k := range LayoutKeysDense[:4] {
+		NoInlineLayoutMapDenseN4[k] = NoInlineFuncs[i]
+	}
+
+	NoInlineLayoutMapDenseN8 = make(map[int]func(int) int, 8)
+	for i, k := range LayoutKeysDense[:8] {
+		NoInlineLayoutMapDenseN8[k] = NoInlineFuncs[i]
+	}
+
+	NoInlineLayoutMapDenseN16 = make(map[int]func(int) int, 16)
+	for i, k := range LayoutKeysDense[:16] {
+		NoInlineLayoutMapDenseN16[k] = NoInlineFuncs[i]
+	}
+
+	NoInlineLayoutMapDenseN32 = make(map[int]func(int) int, 32)
+	for i, k := range LayoutKeysDense[:32] {
+		NoInlineLayoutMapDenseN32[k] = NoInlineFuncs[i]
+	}
+
+	NoInlineLayoutMapDenseN64 = make(map[int]func(int) int, 64)
+	for i, k := range LayoutKeysDense[:64] {
+		NoInlineLayoutMapDenseN64[k] = NoInlineFuncs[i]
+	}
+
+	NoInlineLayoutMapDenseN128 = make(map[int]func(int) int, 128)
+	for i, k := range LayoutKeysDense[:128] {
+		NoInlineLayoutMapDenseN128[k] = NoInlineFuncs[i]
+	}
+
+	NoInlineLayoutMapDenseN256 = make(map[int]func(int) int, 256)
+	for i, k := range LayoutKeysDense[:256] {
+		NoInlineLayoutMapDenseN256[k] = NoInlineFuncs[i]
+	}
+
+	NoInlineLayoutMapDenseN512 = make(map[int]func(int) int, 512)
+	for i, k := range LayoutKeysDense[:512] {
+		NoInlineLayoutMapDenseN512[k] = NoInlineFuncs[i]
+	}
+
+	NoInlineLayoutMapStridedN4 = make(map[int]func(int) int, 4)
+	for i, k := range LayoutKeysStrided[:4] {
+		NoInlineLayoutMapStridedN4[k] = NoInlineFuncs[i]
+	}
+
+	NoInlineLayoutMapStridedN8 = make(map[int]func(int) int, 8)
+	for i, k := range LayoutKeysStrided[:8] {
+		NoInlineLayoutMapStridedN8[k] = NoInlineFuncs[i]
+	}
+
+	NoInlineLayoutMapStridedN16 = make(map[int]func(int) int, 16)
+	for i, k := range LayoutKeysStrided[:16] {
+		NoInlineLayoutMapStridedN16[k] = NoInlineFuncs[i]
+	}
+
+	NoInlineLayoutMapStridedN32 = make(map[int]func(int) int, 32)
+	for i, k := range LayoutKeysStrided[:32] {
+		NoInlineLayoutMapStridedN32[k] = NoInlineFuncs[i]
+	}
+
+	NoInlineLayoutMapStridedN64 = make(map[int]func(int) int, 64)
+	for i, k := range LayoutKeysStrided[:64] {
+		NoInlineLayoutMapStridedN64[k] = NoInlineFuncs[i]
+	}
+
+	NoInlineLayoutMapStridedN128 = make(map[int]func(int) int, 128)
+	for i, k := range LayoutKeysStrided[:128] {
+		NoInlineLayoutMapStridedN128[k] = NoInlineFuncs[i]
+	}
+
+	NoInlineLayoutMapStridedN256 = make(map[int]func(int) int, 256)
+	for i, k := range LayoutKeysStrided[:256] {
+		NoInlineLayoutMapStridedN256[k] = NoInlineFuncs[i]
+	}
+
+	NoInlineLayoutMapStridedN512 = make(map[int]func(int) int, 512)
+	for i, k := range LayoutKeysStrided[:512] {
+		NoInlineLayoutMapStridedN512[k] = NoInlineFuncs[i]
+	}
+
+	NoInlineLayoutMapSparseN4 = make(map[int]func(int) int, 4)
+	for i, k := range LayoutKeysSparse[:4] {
+		NoInlineLayoutMapSparseN4[k] = NoInlineFuncs[i]
+	}
+
+	NoInlineLayoutMapSparseN8 = make(map[int]func(int) int, 8)
+	for i, k := range LayoutKeysSparse[:8] {
+		NoInlineLayoutMapSparseN8[k] = NoInlineFuncs[i]
+	}
+
+	NoInlineLayoutMapSparseN16 = make(map[int]func(int) int, 16)
+	for i, k := range LayoutKeysSparse[:16] {
+		NoInlineLayoutMapSparseN16[k] = NoInlineFuncs[i]
+	}
+
+	NoInlineLayoutMapSparseN32 = make(map[int]func(int) int, 32)
+	for i, k := range LayoutKeysSparse[:32] {
+		NoInlineLayoutMapSparseN32[k] = NoInlineFuncs[i]
+	}
+
+	NoInlineLayoutMapSparseN64 = make(map[int]func(int) int, 64)
+	for i, k := range LayoutKeysSparse[:64] {
+		NoInlineLayoutMapSparseN64[k] = NoInlineFuncs[i]
+	}
+
+	NoInlineLayoutMapSparseN128 = make(map[int]func(int) int, 128)
+	for i, k := range LayoutKeysSparse[:128] {
+		NoInlineLayoutMapSparseN128[k] = NoInlineFuncs[i]
+	}
+
+	NoInlineLayoutMapSparseN256 = make(map[int]func(int) int, 256)
+	for i, k := range LayoutKeysSparse[:256] {
+		NoInlineLayoutMapSparseN256[k] = NoInlineFuncs[i]
+	}
+
+	NoInlineLayoutMapSparseN512 = make(map[int]func(int) int, 512)
+	for i, k := range LayoutKeysSparse[:512] {
+		NoInlineLayoutMapSparseN512[k] = NoInlineFuncs[i]
+	}
+
+	NoInlineLayoutMapClusteredN4 = make(map[int]func(int) int, 4)
+	for i, k := range LayoutKeysClustered[:4] {
+		NoInlineLayoutMapClusteredN4[k] = NoInlineFuncs[i]
+	}
+
+	NoInlineLayoutMapClusteredN8 = make(map[int]func(int) int, 8)
+	for i, k := range LayoutKeysClustered[:8] {
+		NoInlineLayoutMapClusteredN8[k] = NoInlineFuncs[i]
+	}
+
+	NoInlineLayoutMapClusteredN16 = make(map[int]func(int) int, 16)
+	for i, k := range LayoutKeysClustered[:16] {
+		NoInlineLayoutMapClusteredN16[k] = NoInlineFuncs[i]
+	}
+
+	NoInlineLayoutMapClusteredN32 = make(map[int]func(int) int, 32)
+	for i, k := range LayoutKeysClustered[:32] {
+		NoInlineLayoutMapClusteredN32[k] = NoInlineFuncs[i]
+	}
+
+	NoInlineLayoutMapClusteredN64 = make(map[int]func(int) int, 64)
+	for i, k := range LayoutKeysClustered[:64] {
+		NoInlineLayoutMapClusteredN64[k] = NoInlineFuncs[i]
+	}
+
+	NoInlineLayoutMapClusteredN128 = make(map[int]func(int) int, 128)
+	for i, k := range LayoutKeysClustered[:128] {
+		NoInlineLayoutMapClusteredN128[k] = NoInlineFuncs[i]
+	}
+
+	NoInlineLayoutMapClusteredN256 = make(map[int]func(int) int, 256)
+	for i, k := range LayoutKeysClustered[:256] {
+		NoInlineLayoutMapClusteredN256[k] = NoInlineFuncs[i]
+	}
+
+	NoInlineLayoutMapClusteredN512 = make(map[int]func(int) int, 512)
+	for i, k := range LayoutKeysClustered[:512] {
+		NoInlineLayoutMapClusteredN512[k] = NoInlineFuncs[i]
+	}
+}
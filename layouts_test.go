@@ -0,0 +1,36709 @@
+// Code generated by genbench from matrix.json. DO NOT EDIT.
+
+package go_map_vs_switch
+
+import (
+	"testing"
+)
+
+var lookupsLayoutSwitchInlineDense = []lookupBench{
+	{"mod", 4, benchLayoutSwitchInlineDenseLookupMod4},
+	{"mod", 8, benchLayoutSwitchInlineDenseLookupMod8},
+	{"mod", 16, benchLayoutSwitchInlineDenseLookupMod16},
+	{"mod", 32, benchLayoutSwitchInlineDenseLookupMod32},
+	{"mod", 64, benchLayoutSwitchInlineDenseLookupMod64},
+	{"mod", 128, benchLayoutSwitchInlineDenseLookupMod128},
+	{"mod", 256, benchLayoutSwitchInlineDenseLookupMod256},
+	{"mod", 512, benchLayoutSwitchInlineDenseLookupMod512},
+}
+
+var lookupsLayoutSwitchInlineStrided = []lookupBench{
+	{"mod", 4, benchLayoutSwitchInlineStridedLookupMod4},
+	{"mod", 8, benchLayoutSwitchInlineStridedLookupMod8},
+	{"mod", 16, benchLayoutSwitchInlineStridedLookupMod16},
+	{"mod", 32, benchLayoutSwitchInlineStridedLookupMod32},
+	{"mod", 64, benchLayoutSwitchInlineStridedLookupMod64},
+	{"mod", 128, benchLayoutSwitchInlineStridedLookupMod128},
+	{"mod", 256, benchLayoutSwitchInlineStridedLookupMod256},
+	{"mod", 512, benchLayoutSwitchInlineStridedLookupMod512},
+}
+
+var lookupsLayoutSwitchInlineSparse = []lookupBench{
+	{"mod", 4, benchLayoutSwitchInlineSparseLookupMod4},
+	{"mod", 8, benchLayoutSwitchInlineSparseLookupMod8},
+	{"mod", 16, benchLayoutSwitchInlineSparseLookupMod16},
+	{"mod", 32, benchLayoutSwitchInlineSparseLookupMod32},
+	{"mod", 64, benchLayoutSwitchInlineSparseLookupMod64},
+	{"mod", 128, benchLayoutSwitchInlineSparseLookupMod128},
+	{"mod", 256, benchLayoutSwitchInlineSparseLookupMod256},
+	{"mod", 512, benchLayoutSwitchInlineSparseLookupMod512},
+}
+
+var lookupsLayoutSwitchInlineClustered = []lookupBench{
+	{"mod", 4, benchLayoutSwitchInlineClusteredLookupMod4},
+	{"mod", 8, benchLayoutSwitchInlineClusteredLookupMod8},
+	{"mod", 16, benchLayoutSwitchInlineClusteredLookupMod16},
+	{"mod", 32, benchLayoutSwitchInlineClusteredLookupMod32},
+	{"mod", 64, benchLayoutSwitchInlineClusteredLookupMod64},
+	{"mod", 128, benchLayoutSwitchInlineClusteredLookupMod128},
+	{"mod", 256, benchLayoutSwitchInlineClusteredLookupMod256},
+	{"mod", 512, benchLayoutSwitchInlineClusteredLookupMod512},
+}
+
+var lookupsLayoutSwitchNoInlineDense = []lookupBench{
+	{"mod", 4, benchLayoutSwitchNoInlineDenseLookupMod4},
+	{"mod", 8, benchLayoutSwitchNoInlineDenseLookupMod8},
+	{"mod", 16, benchLayoutSwitchNoInlineDenseLookupMod16},
+	{"mod", 32, benchLayoutSwitchNoInlineDenseLookupMod32},
+	{"mod", 64, benchLayoutSwitchNoInlineDenseLookupMod64},
+	{"mod", 128, benchLayoutSwitchNoInlineDenseLookupMod128},
+	{"mod", 256, benchLayoutSwitchNoInlineDenseLookupMod256},
+	{"mod", 512, benchLayoutSwitchNoInlineDenseLookupMod512},
+}
+
+var lookupsLayoutSwitchNoInlineStrided = []lookupBench{
+	{"mod", 4, benchLayoutSwitchNoInlineStridedLookupMod4},
+	{"mod", 8, benchLayoutSwitchNoInlineStridedLookupMod8},
+	{"mod", 16, benchLayoutSwitchNoInlineStridedLookupMod16},
+	{"mod", 32, benchLayoutSwitchNoInlineStridedLookupMod32},
+	{"mod", 64, benchLayoutSwitchNoInlineStridedLookupMod64},
+	{"mod", 128, benchLayoutSwitchNoInlineStridedLookupMod128},
+	{"mod", 256, benchLayoutSwitchNoInlineStridedLookupMod256},
+	{"mod", 512, benchLayoutSwitchNoInlineStridedLookupMod512},
+}
+
+var lookupsLayoutSwitchNoInlineSparse = []lookupBench{
+	{"mod", 4, benchLayoutSwitchNoInlineSparseLookupMod4},
+	{"mod", 8, benchLayoutSwitchNoInlineSparseLookupMod8},
+	{"mod", 16, benchLayoutSwitchNoInlineSparseLookupMod16},
+	{"mod", 32, benchLayoutSwitchNoInlineSparseLookupMod32},
+	{"mod", 64, benchLayoutSwitchNoInlineSparseLookupMod64},
+	{"mod", 128, benchLayoutSwitchNoInlineSparseLookupMod128},
+	{"mod", 256, benchLayoutSwitchNoInlineSparseLookupMod256},
+	{"mod", 512, benchLayoutSwitchNoInlineSparseLookupMod512},
+}
+
+var lookupsLayoutSwitchNoInlineClustered = []lookupBench{
+	{"mod", 4, benchLayoutSwitchNoInlineClusteredLookupMod4},
+	{"mod", 8, benchLayoutSwitchNoInlineClusteredLookupMod8},
+	{"mod", 16, benchLayoutSwitchNoInlineClusteredLookupMod16},
+	{"mod", 32, benchLayoutSwitchNoInlineClusteredLookupMod32},
+	{"mod", 64, benchLayoutSwitchNoInlineClusteredLookupMod64},
+	{"mod", 128, benchLayoutSwitchNoInlineClusteredLookupMod128},
+	{"mod", 256, benchLayoutSwitchNoInlineClusteredLookupMod256},
+	{"mod", 512, benchLayoutSwitchNoInlineClusteredLookupMod512},
+}
+
+var lookupsLayoutMapInlineDense = []lookupBench{
+	{"mod", 4, benchLayoutMapInlineDenseLookupMod4},
+	{"mod", 8, benchLayoutMapInlineDenseLookupMod8},
+	{"mod", 16, benchLayoutMapInlineDenseLookupMod16},
+	{"mod", 32, benchLayoutMapInlineDenseLookupMod32},
+	{"mod", 64, benchLayoutMapInlineDenseLookupMod64},
+	{"mod", 128, benchLayoutMapInlineDenseLookupMod128},
+	{"mod", 256, benchLayoutMapInlineDenseLookupMod256},
+	{"mod", 512, benchLayoutMapInlineDenseLookupMod512},
+}
+
+var lookupsLayoutMapInlineStrided = []lookupBench{
+	{"mod", 4, benchLayoutMapInlineStridedLookupMod4},
+	{"mod", 8, benchLayoutMapInlineStridedLookupMod8},
+	{"mod", 16, benchLayoutMapInlineStridedLookupMod16},
+	{"mod", 32, benchLayoutMapInlineStridedLookupMod32},
+	{"mod", 64, benchLayoutMapInlineStridedLookupMod64},
+	{"mod", 128, benchLayoutMapInlineStridedLookupMod128},
+	{"mod", 256, benchLayoutMapInlineStridedLookupMod256},
+	{"mod", 512, benchLayoutMapInlineStridedLookupMod512},
+}
+
+var lookupsLayoutMapInlineSparse = []lookupBench{
+	{"mod", 4, benchLayoutMapInlineSparseLookupMod4},
+	{"mod", 8, benchLayoutMapInlineSparseLookupMod8},
+	{"mod", 16, benchLayoutMapInlineSparseLookupMod16},
+	{"mod", 32, benchLayoutMapInlineSparseLookupMod32},
+	{"mod", 64, benchLayoutMapInlineSparseLookupMod64},
+	{"mod", 128, benchLayoutMapInlineSparseLookupMod128},
+	{"mod", 256, benchLayoutMapInlineSparseLookupMod256},
+	{"mod", 512, benchLayoutMapInlineSparseLookupMod512},
+}
+
+var lookupsLayoutMapInlineClustered = []lookupBench{
+	{"mod", 4, benchLayoutMapInlineClusteredLookupMod4},
+	{"mod", 8, benchLayoutMapInlineClusteredLookupMod8},
+	{"mod", 16, benchLayoutMapInlineClusteredLookupMod16},
+	{"mod", 32, benchLayoutMapInlineClusteredLookupMod32},
+	{"mod", 64, benchLayoutMapInlineClusteredLookupMod64},
+	{"mod", 128, benchLayoutMapInlineClusteredLookupMod128},
+	{"mod", 256, benchLayoutMapInlineClusteredLookupMod256},
+	{"mod", 512, benchLayoutMapInlineClusteredLookupMod512},
+}
+
+var lookupsLayoutMapNoInlineDense = []lookupBench{
+	{"mod", 4, benchLayoutMapNoInlineDenseLookupMod4},
+	{"mod", 8, benchLayoutMapNoInlineDenseLookupMod8},
+	{"mod", 16, benchLayoutMapNoInlineDenseLookupMod16},
+	{"mod", 32, benchLayoutMapNoInlineDenseLookupMod32},
+	{"mod", 64, benchLayoutMapNoInlineDenseLookupMod64},
+	{"mod", 128, benchLayoutMapNoInlineDenseLookupMod128},
+	{"mod", 256, benchLayoutMapNoInlineDenseLookupMod256},
+	{"mod", 512, benchLayoutMapNoInlineDenseLookupMod512},
+}
+
+var lookupsLayoutMapNoInlineStrided = []lookupBench{
+	{"mod", 4, benchLayoutMapNoInlineStridedLookupMod4},
+	{"mod", 8, benchLayoutMapNoInlineStridedLookupMod8},
+	{"mod", 16, benchLayoutMapNoInlineStridedLookupMod16},
+	{"mod", 32, benchLayoutMapNoInlineStridedLookupMod32},
+	{"mod", 64, benchLayoutMapNoInlineStridedLookupMod64},
+	{"mod", 128, benchLayoutMapNoInlineStridedLookupMod128},
+	{"mod", 256, benchLayoutMapNoInlineStridedLookupMod256},
+	{"mod", 512, benchLayoutMapNoInlineStridedLookupMod512},
+}
+
+var lookupsLayoutMapNoInlineSparse = []lookupBench{
+	{"mod", 4, benchLayoutMapNoInlineSparseLookupMod4},
+	{"mod", 8, benchLayoutMapNoInlineSparseLookupMod8},
+	{"mod", 16, benchLayoutMapNoInlineSparseLookupMod16},
+	{"mod", 32, benchLayoutMapNoInlineSparseLookupMod32},
+	{"mod", 64, benchLayoutMapNoInlineSparseLookupMod64},
+	{"mod", 128, benchLayoutMapNoInlineSparseLookupMod128},
+	{"mod", 256, benchLayoutMapNoInlineSparseLookupMod256},
+	{"mod", 512, benchLayoutMapNoInlineSparseLookupMod512},
+}
+
+var lookupsLayoutMapNoInlineClustered = []lookupBench{
+	{"mod", 4, benchLayoutMapNoInlineClusteredLookupMod4},
+	{"mod", 8, benchLayoutMapNoInlineClusteredLookupMod8},
+	{"mod", 16, benchLayoutMapNoInlineClusteredLookupMod16},
+	{"mod", 32, benchLayoutMapNoInlineClusteredLookupMod32},
+	{"mod", 64, benchLayoutMapNoInlineClusteredLookupMod64},
+	{"mod", 128, benchLayoutMapNoInlineClusteredLookupMod128},
+	{"mod", 256, benchLayoutMapNoInlineClusteredLookupMod256},
+	{"mod", 512, benchLayoutMapNoInlineClusteredLookupMod512},
+}
+
+func BenchmarkLayoutSwitch(b *testing.B) {
+	b.Run("inline=true", func(b *testing.B) {
+		b.Run("layout=dense", func(b *testing.B) {
+			b.Run("pattern=computed", func(b *testing.B) {
+				b.Run("n=4", benchLayoutSwitchInlineDenseComputed4)
+				b.Run("n=8", benchLayoutSwitchInlineDenseComputed8)
+				b.Run("n=16", benchLayoutSwitchInlineDenseComputed16)
+				b.Run("n=32", benchLayoutSwitchInlineDenseComputed32)
+				b.Run("n=64", benchLayoutSwitchInlineDenseComputed64)
+				b.Run("n=128", benchLayoutSwitchInlineDenseComputed128)
+				b.Run("n=256", benchLayoutSwitchInlineDenseComputed256)
+				b.Run("n=512", benchLayoutSwitchInlineDenseComputed512)
+			})
+			b.Run("pattern=sequential", func(b *testing.B) {
+				runLookups(b, "sequential", lookupsLayoutSwitchInlineDense)
+			})
+			b.Run("pattern=random", func(b *testing.B) {
+				runLookups(b, "uniform", lookupsLayoutSwitchInlineDense)
+			})
+		})
+		b.Run("layout=strided", func(b *testing.B) {
+			b.Run("pattern=computed", func(b *testing.B) {
+				b.Run("n=4", benchLayoutSwitchInlineStridedComputed4)
+				b.Run("n=8", benchLayoutSwitchInlineStridedComputed8)
+				b.Run("n=16", benchLayoutSwitchInlineStridedComputed16)
+				b.Run("n=32", benchLayoutSwitchInlineStridedComputed32)
+				b.Run("n=64", benchLayoutSwitchInlineStridedComputed64)
+				b.Run("n=128", benchLayoutSwitchInlineStridedComputed128)
+				b.Run("n=256", benchLayoutSwitchInlineStridedComputed256)
+				b.Run("n=512", benchLayoutSwitchInlineStridedComputed512)
+			})
+			b.Run("pattern=sequential", func(b *testing.B) {
+				runLookups(b, "sequential", lookupsLayoutSwitchInlineStrided)
+			})
+			b.Run("pattern=random", func(b *testing.B) {
+				runLookups(b, "uniform", lookupsLayoutSwitchInlineStrided)
+			})
+		})
+		b.Run("layout=sparse", func(b *testing.B) {
+			b.Run("pattern=computed", func(b *testing.B) {
+				b.Run("n=4", benchLayoutSwitchInlineSparseComputed4)
+				b.Run("n=8", benchLayoutSwitchInlineSparseComputed8)
+				b.Run("n=16", benchLayoutSwitchInlineSparseComputed16)
+				b.Run("n=32", benchLayoutSwitchInlineSparseComputed32)
+				b.Run("n=64", benchLayoutSwitchInlineSparseComputed64)
+				b.Run("n=128", benchLayoutSwitchInlineSparseComputed128)
+				b.Run("n=256", benchLayoutSwitchInlineSparseComputed256)
+				b.Run("n=512", benchLayoutSwitchInlineSparseComputed512)
+			})
+			b.Run("pattern=sequential", func(b *testing.B) {
+				runLookups(b, "sequential", lookupsLayoutSwitchInlineSparse)
+			})
+			b.Run("pattern=random", func(b *testing.B) {
+				runLookups(b, "uniform", lookupsLayoutSwitchInlineSparse)
+			})
+		})
+		b.Run("layout=clustered", func(b *testing.B) {
+			b.Run("pattern=computed", func(b *testing.B) {
+				b.Run("n=4", benchLayoutSwitchInlineClusteredComputed4)
+				b.Run("n=8", benchLayoutSwitchInlineClusteredComputed8)
+				b.Run("n=16", benchLayoutSwitchInlineClusteredComputed16)
+				b.Run("n=32", benchLayoutSwitchInlineClusteredComputed32)
+				b.Run("n=64", benchLayoutSwitchInlineClusteredComputed64)
+				b.Run("n=128", benchLayoutSwitchInlineClusteredComputed128)
+				b.Run("n=256", benchLayoutSwitchInlineClusteredComputed256)
+				b.Run("n=512", benchLayoutSwitchInlineClusteredComputed512)
+			})
+			b.Run("pattern=sequential", func(b *testing.B) {
+				runLookups(b, "sequential", lookupsLayoutSwitchInlineClustered)
+			})
+			b.Run("pattern=random", func(b *testing.B) {
+				runLookups(b, "uniform", lookupsLayoutSwitchInlineClustered)
+			})
+		})
+	})
+	b.Run("inline=false", func(b *testing.B) {
+		b.Run("layout=dense", func(b *testing.B) {
+			b.Run("pattern=computed", func(b *testing.B) {
+				b.Run("n=4", benchLayoutSwitchNoInlineDenseComputed4)
+				b.Run("n=8", benchLayoutSwitchNoInlineDenseComputed8)
+				b.Run("n=16", benchLayoutSwitchNoInlineDenseComputed16)
+				b.Run("n=32", benchLayoutSwitchNoInlineDenseComputed32)
+				b.Run("n=64", benchLayoutSwitchNoInlineDenseComputed64)
+				b.Run("n=128", benchLayoutSwitchNoInlineDenseComputed128)
+				b.Run("n=256", benchLayoutSwitchNoInlineDenseComputed256)
+				b.Run("n=512", benchLayoutSwitchNoInlineDenseComputed512)
+			})
+			b.Run("pattern=sequential", func(b *testing.B) {
+				runLookups(b, "sequential", lookupsLayoutSwitchNoInlineDense)
+			})
+			b.Run("pattern=random", func(b *testing.B) {
+				runLookups(b, "uniform", lookupsLayoutSwitchNoInlineDense)
+			})
+		})
+		b.Run("layout=strided", func(b *testing.B) {
+			b.Run("pattern=computed", func(b *testing.B) {
+				b.Run("n=4", benchLayoutSwitchNoInlineStridedComputed4)
+				b.Run("n=8", benchLayoutSwitchNoInlineStridedComputed8)
+				b.Run("n=16", benchLayoutSwitchNoInlineStridedComputed16)
+				b.Run("n=32", benchLayoutSwitchNoInlineStridedComputed32)
+				b.Run("n=64", benchLayoutSwitchNoInlineStridedComputed64)
+				b.Run("n=128", benchLayoutSwitchNoInlineStridedComputed128)
+				b.Run("n=256", benchLayoutSwitchNoInlineStridedComputed256)
+				b.Run("n=512", benchLayoutSwitchNoInlineStridedComputed512)
+			})
+			b.Run("pattern=sequential", func(b *testing.B) {
+				runLookups(b, "sequential", lookupsLayoutSwitchNoInlineStrided)
+			})
+			b.Run("pattern=random", func(b *testing.B) {
+				runLookups(b, "uniform", lookupsLayoutSwitchNoInlineStrided)
+			})
+		})
+		b.Run("layout=sparse", func(b *testing.B) {
+			b.Run("pattern=computed", func(b *testing.B) {
+				b.Run("n=4", benchLayoutSwitchNoInlineSparseComputed4)
+				b.Run("n=8", benchLayoutSwitchNoInlineSparseComputed8)
+				b.Run("n=16", benchLayoutSwitchNoInlineSparseComputed16)
+				b.Run("n=32", benchLayoutSwitchNoInlineSparseComputed32)
+				b.Run("n=64", benchLayoutSwitchNoInlineSparseComputed64)
+				b.Run("n=128", benchLayoutSwitchNoInlineSparseComputed128)
+				b.Run("n=256", benchLayoutSwitchNoInlineSparseComputed256)
+				b.Run("n=512", benchLayoutSwitchNoInlineSparseComputed512)
+			})
+			b.Run("pattern=sequential", func(b *testing.B) {
+				runLookups(b, "sequential", lookupsLayoutSwitchNoInlineSparse)
+			})
+			b.Run("pattern=random", func(b *testing.B) {
+				runLookups(b, "uniform", lookupsLayoutSwitchNoInlineSparse)
+			})
+		})
+		b.Run("layout=clustered", func(b *testing.B) {
+			b.Run("pattern=computed", func(b *testing.B) {
+				b.Run("n=4", benchLayoutSwitchNoInlineClusteredComputed4)
+				b.Run("n=8", benchLayoutSwitchNoInlineClusteredComputed8)
+				b.Run("n=16", benchLayoutSwitchNoInlineClusteredComputed16)
+				b.Run("n=32", benchLayoutSwitchNoInlineClusteredComputed32)
+				b.Run("n=64", benchLayoutSwitchNoInlineClusteredComputed64)
+				b.Run("n=128", benchLayoutSwitchNoInlineClusteredComputed128)
+				b.Run("n=256", benchLayoutSwitchNoInlineClusteredComputed256)
+				b.Run("n=512", benchLayoutSwitchNoInlineClusteredComputed512)
+			})
+			b.Run("pattern=sequential", func(b *testing.B) {
+				runLookups(b, "sequential", lookupsLayoutSwitchNoInlineClustered)
+			})
+			b.Run("pattern=random", func(b *testing.B) {
+				runLookups(b, "uniform", lookupsLayoutSwitchNoInlineClustered)
+			})
+		})
+	})
+}
+
+func benchLayoutSwitchInlineDenseComputed4(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysDense[i%4] {
+		case 0:
+			n += Inline0(i)
+		case 1:
+			n += Inline1(i)
+		case 2:
+			n += Inline2(i)
+		case 3:
+			n += Inline3(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchInlineDenseLookupMod4(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysDense[inputs[i%len(inputs)]] {
+		case 0:
+			n += Inline0(i)
+		case 1:
+			n += Inline1(i)
+		case 2:
+			n += Inline2(i)
+		case 3:
+			n += Inline3(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchInlineDenseComputed8(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysDense[i%8] {
+		case 0:
+			n += Inline0(i)
+		case 1:
+			n += Inline1(i)
+		case 2:
+			n += Inline2(i)
+		case 3:
+			n += Inline3(i)
+		case 4:
+			n += Inline4(i)
+		case 5:
+			n += Inline5(i)
+		case 6:
+			n += Inline6(i)
+		case 7:
+			n += Inline7(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchInlineDenseLookupMod8(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysDense[inputs[i%len(inputs)]] {
+		case 0:
+			n += Inline0(i)
+		case 1:
+			n += Inline1(i)
+		case 2:
+			n += Inline2(i)
+		case 3:
+			n += Inline3(i)
+		case 4:
+			n += Inline4(i)
+		case 5:
+			n += Inline5(i)
+		case 6:
+			n += Inline6(i)
+		case 7:
+			n += Inline7(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchInlineDenseComputed16(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysDense[i%16] {
+		case 0:
+			n += Inline0(i)
+		case 1:
+			n += Inline1(i)
+		case 2:
+			n += Inline2(i)
+		case 3:
+			n += Inline3(i)
+		case 4:
+			n += Inline4(i)
+		case 5:
+			n += Inline5(i)
+		case 6:
+			n += Inline6(i)
+		case 7:
+			n += Inline7(i)
+		case 8:
+			n += Inline8(i)
+		case 9:
+			n += Inline9(i)
+		case 10:
+			n += Inline10(i)
+		case 11:
+			n += Inline11(i)
+		case 12:
+			n += Inline12(i)
+		case 13:
+			n += Inline13(i)
+		case 14:
+			n += Inline14(i)
+		case 15:
+			n += Inline15(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchInlineDenseLookupMod16(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysDense[inputs[i%len(inputs)]] {
+		case 0:
+			n += Inline0(i)
+		case 1:
+			n += Inline1(i)
+		case 2:
+			n += Inline2(i)
+		case 3:
+			n += Inline3(i)
+		case 4:
+			n += Inline4(i)
+		case 5:
+			n += Inline5(i)
+		case 6:
+			n += Inline6(i)
+		case 7:
+			n += Inline7(i)
+		case 8:
+			n += Inline8(i)
+		case 9:
+			n += Inline9(i)
+		case 10:
+			n += Inline10(i)
+		case 11:
+			n += Inline11(i)
+		case 12:
+			n += Inline12(i)
+		case 13:
+			n += Inline13(i)
+		case 14:
+			n += Inline14(i)
+		case 15:
+			n += Inline15(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchInlineDenseComputed32(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysDense[i%32] {
+		case 0:
+			n += Inline0(i)
+		case 1:
+			n += Inline1(i)
+		case 2:
+			n += Inline2(i)
+		case 3:
+			n += Inline3(i)
+		case 4:
+			n += Inline4(i)
+		case 5:
+			n += Inline5(i)
+		case 6:
+			n += Inline6(i)
+		case 7:
+			n += Inline7(i)
+		case 8:
+			n += Inline8(i)
+		case 9:
+			n += Inline9(i)
+		case 10:
+			n += Inline10(i)
+		case 11:
+			n += Inline11(i)
+		case 12:
+			n += Inline12(i)
+		case 13:
+			n += Inline13(i)
+		case 14:
+			n += Inline14(i)
+		case 15:
+			n += Inline15(i)
+		case 16:
+			n += Inline16(i)
+		case 17:
+			n += Inline17(i)
+		case 18:
+			n += Inline18(i)
+		case 19:
+			n += Inline19(i)
+		case 20:
+			n += Inline20(i)
+		case 21:
+			n += Inline21(i)
+		case 22:
+			n += Inline22(i)
+		case 23:
+			n += Inline23(i)
+		case 24:
+			n += Inline24(i)
+		case 25:
+			n += Inline25(i)
+		case 26:
+			n += Inline26(i)
+		case 27:
+			n += Inline27(i)
+		case 28:
+			n += Inline28(i)
+		case 29:
+			n += Inline29(i)
+		case 30:
+			n += Inline30(i)
+		case 31:
+			n += Inline31(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchInlineDenseLookupMod32(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysDense[inputs[i%len(inputs)]] {
+		case 0:
+			n += Inline0(i)
+		case 1:
+			n += Inline1(i)
+		case 2:
+			n += Inline2(i)
+		case 3:
+			n += Inline3(i)
+		case 4:
+			n += Inline4(i)
+		case 5:
+			n += Inline5(i)
+		case 6:
+			n += Inline6(i)
+		case 7:
+			n += Inline7(i)
+		case 8:
+			n += Inline8(i)
+		case 9:
+			n += Inline9(i)
+		case 10:
+			n += Inline10(i)
+		case 11:
+			n += Inline11(i)
+		case 12:
+			n += Inline12(i)
+		case 13:
+			n += Inline13(i)
+		case 14:
+			n += Inline14(i)
+		case 15:
+			n += Inline15(i)
+		case 16:
+			n += Inline16(i)
+		case 17:
+			n += Inline17(i)
+		case 18:
+			n += Inline18(i)
+		case 19:
+			n += Inline19(i)
+		case 20:
+			n += Inline20(i)
+		case 21:
+			n += Inline21(i)
+		case 22:
+			n += Inline22(i)
+		case 23:
+			n += Inline23(i)
+		case 24:
+			n += Inline24(i)
+		case 25:
+			n += Inline25(i)
+		case 26:
+			n += Inline26(i)
+		case 27:
+			n += Inline27(i)
+		case 28:
+			n += Inline28(i)
+		case 29:
+			n += Inline29(i)
+		case 30:
+			n += Inline30(i)
+		case 31:
+			n += Inline31(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchInlineDenseComputed64(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysDense[i%64] {
+		case 0:
+			n += Inline0(i)
+		case 1:
+			n += Inline1(i)
+		case 2:
+			n += Inline2(i)
+		case 3:
+			n += Inline3(i)
+		case 4:
+			n += Inline4(i)
+		case 5:
+			n += Inline5(i)
+		case 6:
+			n += Inline6(i)
+		case 7:
+			n += Inline7(i)
+		case 8:
+			n += Inline8(i)
+		case 9:
+			n += Inline9(i)
+		case 10:
+			n += Inline10(i)
+		case 11:
+			n += Inline11(i)
+		case 12:
+			n += Inline12(i)
+		case 13:
+			n += Inline13(i)
+		case 14:
+			n += Inline14(i)
+		case 15:
+			n += Inline15(i)
+		case 16:
+			n += Inline16(i)
+		case 17:
+			n += Inline17(i)
+		case 18:
+			n += Inline18(i)
+		case 19:
+			n += Inline19(i)
+		case 20:
+			n += Inline20(i)
+		case 21:
+			n += Inline21(i)
+		case 22:
+			n += Inline22(i)
+		case 23:
+			n += Inline23(i)
+		case 24:
+			n += Inline24(i)
+		case 25:
+			n += Inline25(i)
+		case 26:
+			n += Inline26(i)
+		case 27:
+			n += Inline27(i)
+		case 28:
+			n += Inline28(i)
+		case 29:
+			n += Inline29(i)
+		case 30:
+			n += Inline30(i)
+		case 31:
+			n += Inline31(i)
+		case 32:
+			n += Inline32(i)
+		case 33:
+			n += Inline33(i)
+		case 34:
+			n += Inline34(i)
+		case 35:
+			n += Inline35(i)
+		case 36:
+			n += Inline36(i)
+		case 37:
+			n += Inline37(i)
+		case 38:
+			n += Inline38(i)
+		case 39:
+			n += Inline39(i)
+		case 40:
+			n += Inline40(i)
+		case 41:
+			n += Inline41(i)
+		case 42:
+			n += Inline42(i)
+		case 43:
+			n += Inline43(i)
+		case 44:
+			n += Inline44(i)
+		case 45:
+			n += Inline45(i)
+		case 46:
+			n += Inline46(i)
+		case 47:
+			n += Inline47(i)
+		case 48:
+			n += Inline48(i)
+		case 49:
+			n += Inline49(i)
+		case 50:
+			n += Inline50(i)
+		case 51:
+			n += Inline51(i)
+		case 52:
+			n += Inline52(i)
+		case 53:
+			n += Inline53(i)
+		case 54:
+			n += Inline54(i)
+		case 55:
+			n += Inline55(i)
+		case 56:
+			n += Inline56(i)
+		case 57:
+			n += Inline57(i)
+		case 58:
+			n += Inline58(i)
+		case 59:
+			n += Inline59(i)
+		case 60:
+			n += Inline60(i)
+		case 61:
+			n += Inline61(i)
+		case 62:
+			n += Inline62(i)
+		case 63:
+			n += Inline63(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchInlineDenseLookupMod64(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysDense[inputs[i%len(inputs)]] {
+		case 0:
+			n += Inline0(i)
+		case 1:
+			n += Inline1(i)
+		case 2:
+			n += Inline2(i)
+		case 3:
+			n += Inline3(i)
+		case 4:
+			n += Inline4(i)
+		case 5:
+			n += Inline5(i)
+		case 6:
+			n += Inline6(i)
+		case 7:
+			n += Inline7(i)
+		case 8:
+			n += Inline8(i)
+		case 9:
+			n += Inline9(i)
+		case 10:
+			n += Inline10(i)
+		case 11:
+			n += Inline11(i)
+		case 12:
+			n += Inline12(i)
+		case 13:
+			n += Inline13(i)
+		case 14:
+			n += Inline14(i)
+		case 15:
+			n += Inline15(i)
+		case 16:
+			n += Inline16(i)
+		case 17:
+			n += Inline17(i)
+		case 18:
+			n += Inline18(i)
+		case 19:
+			n += Inline19(i)
+		case 20:
+			n += Inline20(i)
+		case 21:
+			n += Inline21(i)
+		case 22:
+			n += Inline22(i)
+		case 23:
+			n += Inline23(i)
+		case 24:
+			n += Inline24(i)
+		case 25:
+			n += Inline25(i)
+		case 26:
+			n += Inline26(i)
+		case 27:
+			n += Inline27(i)
+		case 28:
+			n += Inline28(i)
+		case 29:
+			n += Inline29(i)
+		case 30:
+			n += Inline30(i)
+		case 31:
+			n += Inline31(i)
+		case 32:
+			n += Inline32(i)
+		case 33:
+			n += Inline33(i)
+		case 34:
+			n += Inline34(i)
+		case 35:
+			n += Inline35(i)
+		case 36:
+			n += Inline36(i)
+		case 37:
+			n += Inline37(i)
+		case 38:
+			n += Inline38(i)
+		case 39:
+			n += Inline39(i)
+		case 40:
+			n += Inline40(i)
+		case 41:
+			n += Inline41(i)
+		case 42:
+			n += Inline42(i)
+		case 43:
+			n += Inline43(i)
+		case 44:
+			n += Inline44(i)
+		case 45:
+			n += Inline45(i)
+		case 46:
+			n += Inline46(i)
+		case 47:
+			n += Inline47(i)
+		case 48:
+			n += Inline48(i)
+		case 49:
+			n += Inline49(i)
+		case 50:
+			n += Inline50(i)
+		case 51:
+			n += Inline51(i)
+		case 52:
+			n += Inline52(i)
+		case 53:
+			n += Inline53(i)
+		case 54:
+			n += Inline54(i)
+		case 55:
+			n += Inline55(i)
+		case 56:
+			n += Inline56(i)
+		case 57:
+			n += Inline57(i)
+		case 58:
+			n += Inline58(i)
+		case 59:
+			n += Inline59(i)
+		case 60:
+			n += Inline60(i)
+		case 61:
+			n += Inline61(i)
+		case 62:
+			n += Inline62(i)
+		case 63:
+			n += Inline63(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchInlineDenseComputed128(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysDense[i%128] {
+		case 0:
+			n += Inline0(i)
+		case 1:
+			n += Inline1(i)
+		case 2:
+			n += Inline2(i)
+		case 3:
+			n += Inline3(i)
+		case 4:
+			n += Inline4(i)
+		case 5:
+			n += Inline5(i)
+		case 6:
+			n += Inline6(i)
+		case 7:
+			n += Inline7(i)
+		case 8:
+			n += Inline8(i)
+		case 9:
+			n += Inline9(i)
+		case 10:
+			n += Inline10(i)
+		case 11:
+			n += Inline11(i)
+		case 12:
+			n += Inline12(i)
+		case 13:
+			n += Inline13(i)
+		case 14:
+			n += Inline14(i)
+		case 15:
+			n += Inline15(i)
+		case 16:
+			n += Inline16(i)
+		case 17:
+			n += Inline17(i)
+		case 18:
+			n += Inline18(i)
+		case 19:
+			n += Inline19(i)
+		case 20:
+			n += Inline20(i)
+		case 21:
+			n += Inline21(i)
+		case 22:
+			n += Inline22(i)
+		case 23:
+			n += Inline23(i)
+		case 24:
+			n += Inline24(i)
+		case 25:
+			n += Inline25(i)
+		case 26:
+			n += Inline26(i)
+		case 27:
+			n += Inline27(i)
+		case 28:
+			n += Inline28(i)
+		case 29:
+			n += Inline29(i)
+		case 30:
+			n += Inline30(i)
+		case 31:
+			n += Inline31(i)
+		case 32:
+			n += Inline32(i)
+		case 33:
+			n += Inline33(i)
+		case 34:
+			n += Inline34(i)
+		case 35:
+			n += Inline35(i)
+		case 36:
+			n += Inline36(i)
+		case 37:
+			n += Inline37(i)
+		case 38:
+			n += Inline38(i)
+		case 39:
+			n += Inline39(i)
+		case 40:
+			n += Inline40(i)
+		case 41:
+			n += Inline41(i)
+		case 42:
+			n += Inline42(i)
+		case 43:
+			n += Inline43(i)
+		case 44:
+			n += Inline44(i)
+		case 45:
+			n += Inline45(i)
+		case 46:
+			n += Inline46(i)
+		case 47:
+			n += Inline47(i)
+		case 48:
+			n += Inline48(i)
+		case 49:
+			n += Inline49(i)
+		case 50:
+			n += Inline50(i)
+		case 51:
+			n += Inline51(i)
+		case 52:
+			n += Inline52(i)
+		case 53:
+			n += Inline53(i)
+		case 54:
+			n += Inline54(i)
+		case 55:
+			n += Inline55(i)
+		case 56:
+			n += Inline56(i)
+		case 57:
+			n += Inline57(i)
+		case 58:
+			n += Inline58(i)
+		case 59:
+			n += Inline59(i)
+		case 60:
+			n += Inline60(i)
+		case 61:
+			n += Inline61(i)
+		case 62:
+			n += Inline62(i)
+		case 63:
+			n += Inline63(i)
+		case 64:
+			n += Inline64(i)
+		case 65:
+			n += Inline65(i)
+		case 66:
+			n += Inline66(i)
+		case 67:
+			n += Inline67(i)
+		case 68:
+			n += Inline68(i)
+		case 69:
+			n += Inline69(i)
+		case 70:
+			n += Inline70(i)
+		case 71:
+			n += Inline71(i)
+		case 72:
+			n += Inline72(i)
+		case 73:
+			n += Inline73(i)
+		case 74:
+			n += Inline74(i)
+		case 75:
+			n += Inline75(i)
+		case 76:
+			n += Inline76(i)
+		case 77:
+			n += Inline77(i)
+		case 78:
+			n += Inline78(i)
+		case 79:
+			n += Inline79(i)
+		case 80:
+			n += Inline80(i)
+		case 81:
+			n += Inline81(i)
+		case 82:
+			n += Inline82(i)
+		case 83:
+			n += Inline83(i)
+		case 84:
+			n += Inline84(i)
+		case 85:
+			n += Inline85(i)
+		case 86:
+			n += Inline86(i)
+		case 87:
+			n += Inline87(i)
+		case 88:
+			n += Inline88(i)
+		case 89:
+			n += Inline89(i)
+		case 90:
+			n += Inline90(i)
+		case 91:
+			n += Inline91(i)
+		case 92:
+			n += Inline92(i)
+		case 93:
+			n += Inline93(i)
+		case 94:
+			n += Inline94(i)
+		case 95:
+			n += Inline95(i)
+		case 96:
+			n += Inline96(i)
+		case 97:
+			n += Inline97(i)
+		case 98:
+			n += Inline98(i)
+		case 99:
+			n += Inline99(i)
+		case 100:
+			n += Inline100(i)
+		case 101:
+			n += Inline101(i)
+		case 102:
+			n += Inline102(i)
+		case 103:
+			n += Inline103(i)
+		case 104:
+			n += Inline104(i)
+		case 105:
+			n += Inline105(i)
+		case 106:
+			n += Inline106(i)
+		case 107:
+			n += Inline107(i)
+		case 108:
+			n += Inline108(i)
+		case 109:
+			n += Inline109(i)
+		case 110:
+			n += Inline110(i)
+		case 111:
+			n += Inline111(i)
+		case 112:
+			n += Inline112(i)
+		case 113:
+			n += Inline113(i)
+		case 114:
+			n += Inline114(i)
+		case 115:
+			n += Inline115(i)
+		case 116:
+			n += Inline116(i)
+		case 117:
+			n += Inline117(i)
+		case 118:
+			n += Inline118(i)
+		case 119:
+			n += Inline119(i)
+		case 120:
+			n += Inline120(i)
+		case 121:
+			n += Inline121(i)
+		case 122:
+			n += Inline122(i)
+		case 123:
+			n += Inline123(i)
+		case 124:
+			n += Inline124(i)
+		case 125:
+			n += Inline125(i)
+		case 126:
+			n += Inline126(i)
+		case 127:
+			n += Inline127(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchInlineDenseLookupMod128(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysDense[inputs[i%len(inputs)]] {
+		case 0:
+			n += Inline0(i)
+		case 1:
+			n += Inline1(i)
+		case 2:
+			n += Inline2(i)
+		case 3:
+			n += Inline3(i)
+		case 4:
+			n += Inline4(i)
+		case 5:
+			n += Inline5(i)
+		case 6:
+			n += Inline6(i)
+		case 7:
+			n += Inline7(i)
+		case 8:
+			n += Inline8(i)
+		case 9:
+			n += Inline9(i)
+		case 10:
+			n += Inline10(i)
+		case 11:
+			n += Inline11(i)
+		case 12:
+			n += Inline12(i)
+		case 13:
+			n += Inline13(i)
+		case 14:
+			n += Inline14(i)
+		case 15:
+			n += Inline15(i)
+		case 16:
+			n += Inline16(i)
+		case 17:
+			n += Inline17(i)
+		case 18:
+			n += Inline18(i)
+		case 19:
+			n += Inline19(i)
+		case 20:
+			n += Inline20(i)
+		case 21:
+			n += Inline21(i)
+		case 22:
+			n += Inline22(i)
+		case 23:
+			n += Inline23(i)
+		case 24:
+			n += Inline24(i)
+		case 25:
+			n += Inline25(i)
+		case 26:
+			n += Inline26(i)
+		case 27:
+			n += Inline27(i)
+		case 28:
+			n += Inline28(i)
+		case 29:
+			n += Inline29(i)
+		case 30:
+			n += Inline30(i)
+		case 31:
+			n += Inline31(i)
+		case 32:
+			n += Inline32(i)
+		case 33:
+			n += Inline33(i)
+		case 34:
+			n += Inline34(i)
+		case 35:
+			n += Inline35(i)
+		case 36:
+			n += Inline36(i)
+		case 37:
+			n += Inline37(i)
+		case 38:
+			n += Inline38(i)
+		case 39:
+			n += Inline39(i)
+		case 40:
+			n += Inline40(i)
+		case 41:
+			n += Inline41(i)
+		case 42:
+			n += Inline42(i)
+		case 43:
+			n += Inline43(i)
+		case 44:
+			n += Inline44(i)
+		case 45:
+			n += Inline45(i)
+		case 46:
+			n += Inline46(i)
+		case 47:
+			n += Inline47(i)
+		case 48:
+			n += Inline48(i)
+		case 49:
+			n += Inline49(i)
+		case 50:
+			n += Inline50(i)
+		case 51:
+			n += Inline51(i)
+		case 52:
+			n += Inline52(i)
+		case 53:
+			n += Inline53(i)
+		case 54:
+			n += Inline54(i)
+		case 55:
+			n += Inline55(i)
+		case 56:
+			n += Inline56(i)
+		case 57:
+			n += Inline57(i)
+		case 58:
+			n += Inline58(i)
+		case 59:
+			n += Inline59(i)
+		case 60:
+			n += Inline60(i)
+		case 61:
+			n += Inline61(i)
+		case 62:
+			n += Inline62(i)
+		case 63:
+			n += Inline63(i)
+		case 64:
+			n += Inline64(i)
+		case 65:
+			n += Inline65(i)
+		case 66:
+			n += Inline66(i)
+		case 67:
+			n += Inline67(i)
+		case 68:
+			n += Inline68(i)
+		case 69:
+			n += Inline69(i)
+		case 70:
+			n += Inline70(i)
+		case 71:
+			n += Inline71(i)
+		case 72:
+			n += Inline72(i)
+		case 73:
+			n += Inline73(i)
+		case 74:
+			n += Inline74(i)
+		case 75:
+			n += Inline75(i)
+		case 76:
+			n += Inline76(i)
+		case 77:
+			n += Inline77(i)
+		case 78:
+			n += Inline78(i)
+		case 79:
+			n += Inline79(i)
+		case 80:
+			n += Inline80(i)
+		case 81:
+			n += Inline81(i)
+		case 82:
+			n += Inline82(i)
+		case 83:
+			n += Inline83(i)
+		case 84:
+			n += Inline84(i)
+		case 85:
+			n += Inline85(i)
+		case 86:
+			n += Inline86(i)
+		case 87:
+			n += Inline87(i)
+		case 88:
+			n += Inline88(i)
+		case 89:
+			n += Inline89(i)
+		case 90:
+			n += Inline90(i)
+		case 91:
+			n += Inline91(i)
+		case 92:
+			n += Inline92(i)
+		case 93:
+			n += Inline93(i)
+		case 94:
+			n += Inline94(i)
+		case 95:
+			n += Inline95(i)
+		case 96:
+			n += Inline96(i)
+		case 97:
+			n += Inline97(i)
+		case 98:
+			n += Inline98(i)
+		case 99:
+			n += Inline99(i)
+		case 100:
+			n += Inline100(i)
+		case 101:
+			n += Inline101(i)
+		case 102:
+			n += Inline102(i)
+		case 103:
+			n += Inline103(i)
+		case 104:
+			n += Inline104(i)
+		case 105:
+			n += Inline105(i)
+		case 106:
+			n += Inline106(i)
+		case 107:
+			n += Inline107(i)
+		case 108:
+			n += Inline108(i)
+		case 109:
+			n += Inline109(i)
+		case 110:
+			n += Inline110(i)
+		case 111:
+			n += Inline111(i)
+		case 112:
+			n += Inline112(i)
+		case 113:
+			n += Inline113(i)
+		case 114:
+			n += Inline114(i)
+		case 115:
+			n += Inline115(i)
+		case 116:
+			n += Inline116(i)
+		case 117:
+			n += Inline117(i)
+		case 118:
+			n += Inline118(i)
+		case 119:
+			n += Inline119(i)
+		case 120:
+			n += Inline120(i)
+		case 121:
+			n += Inline121(i)
+		case 122:
+			n += Inline122(i)
+		case 123:
+			n += Inline123(i)
+		case 124:
+			n += Inline124(i)
+		case 125:
+			n += Inline125(i)
+		case 126:
+			n += Inline126(i)
+		case 127:
+			n += Inline127(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchInlineDenseComputed256(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysDense[i%256] {
+		case 0:
+			n += Inline0(i)
+		case 1:
+			n += Inline1(i)
+		case 2:
+			n += Inline2(i)
+		case 3:
+			n += Inline3(i)
+		case 4:
+			n += Inline4(i)
+		case 5:
+			n += Inline5(i)
+		case 6:
+			n += Inline6(i)
+		case 7:
+			n += Inline7(i)
+		case 8:
+			n += Inline8(i)
+		case 9:
+			n += Inline9(i)
+		case 10:
+			n += Inline10(i)
+		case 11:
+			n += Inline11(i)
+		case 12:
+			n += Inline12(i)
+		case 13:
+			n += Inline13(i)
+		case 14:
+			n += Inline14(i)
+		case 15:
+			n += Inline15(i)
+		case 16:
+			n += Inline16(i)
+		case 17:
+			n += Inline17(i)
+		case 18:
+			n += Inline18(i)
+		case 19:
+			n += Inline19(i)
+		case 20:
+			n += Inline20(i)
+		case 21:
+			n += Inline21(i)
+		case 22:
+			n += Inline22(i)
+		case 23:
+			n += Inline23(i)
+		case 24:
+			n += Inline24(i)
+		case 25:
+			n += Inline25(i)
+		case 26:
+			n += Inline26(i)
+		case 27:
+			n += Inline27(i)
+		case 28:
+			n += Inline28(i)
+		case 29:
+			n += Inline29(i)
+		case 30:
+			n += Inline30(i)
+		case 31:
+			n += Inline31(i)
+		case 32:
+			n += Inline32(i)
+		case 33:
+			n += Inline33(i)
+		case 34:
+			n += Inline34(i)
+		case 35:
+			n += Inline35(i)
+		case 36:
+			n += Inline36(i)
+		case 37:
+			n += Inline37(i)
+		case 38:
+			n += Inline38(i)
+		case 39:
+			n += Inline39(i)
+		case 40:
+			n += Inline40(i)
+		case 41:
+			n += Inline41(i)
+		case 42:
+			n += Inline42(i)
+		case 43:
+			n += Inline43(i)
+		case 44:
+			n += Inline44(i)
+		case 45:
+			n += Inline45(i)
+		case 46:
+			n += Inline46(i)
+		case 47:
+			n += Inline47(i)
+		case 48:
+			n += Inline48(i)
+		case 49:
+			n += Inline49(i)
+		case 50:
+			n += Inline50(i)
+		case 51:
+			n += Inline51(i)
+		case 52:
+			n += Inline52(i)
+		case 53:
+			n += Inline53(i)
+		case 54:
+			n += Inline54(i)
+		case 55:
+			n += Inline55(i)
+		case 56:
+			n += Inline56(i)
+		case 57:
+			n += Inline57(i)
+		case 58:
+			n += Inline58(i)
+		case 59:
+			n += Inline59(i)
+		case 60:
+			n += Inline60(i)
+		case 61:
+			n += Inline61(i)
+		case 62:
+			n += Inline62(i)
+		case 63:
+			n += Inline63(i)
+		case 64:
+			n += Inline64(i)
+		case 65:
+			n += Inline65(i)
+		case 66:
+			n += Inline66(i)
+		case 67:
+			n += Inline67(i)
+		case 68:
+			n += Inline68(i)
+		case 69:
+			n += Inline69(i)
+		case 70:
+			n += Inline70(i)
+		case 71:
+			n += Inline71(i)
+		case 72:
+			n += Inline72(i)
+		case 73:
+			n += Inline73(i)
+		case 74:
+			n += Inline74(i)
+		case 75:
+			n += Inline75(i)
+		case 76:
+			n += Inline76(i)
+		case 77:
+			n += Inline77(i)
+		case 78:
+			n += Inline78(i)
+		case 79:
+			n += Inline79(i)
+		case 80:
+			n += Inline80(i)
+		case 81:
+			n += Inline81(i)
+		case 82:
+			n += Inline82(i)
+		case 83:
+			n += Inline83(i)
+		case 84:
+			n += Inline84(i)
+		case 85:
+			n += Inline85(i)
+		case 86:
+			n += Inline86(i)
+		case 87:
+			n += Inline87(i)
+		case 88:
+			n += Inline88(i)
+		case 89:
+			n += Inline89(i)
+		case 90:
+			n += Inline90(i)
+		case 91:
+			n += Inline91(i)
+		case 92:
+			n += Inline92(i)
+		case 93:
+			n += Inline93(i)
+		case 94:
+			n += Inline94(i)
+		case 95:
+			n += Inline95(i)
+		case 96:
+			n += Inline96(i)
+		case 97:
+			n += Inline97(i)
+		case 98:
+			n += Inline98(i)
+		case 99:
+			n += Inline99(i)
+		case 100:
+			n += Inline100(i)
+		case 101:
+			n += Inline101(i)
+		case 102:
+			n += Inline102(i)
+		case 103:
+			n += Inline103(i)
+		case 104:
+			n += Inline104(i)
+		case 105:
+			n += Inline105(i)
+		case 106:
+			n += Inline106(i)
+		case 107:
+			n += Inline107(i)
+		case 108:
+			n += Inline108(i)
+		case 109:
+			n += Inline109(i)
+		case 110:
+			n += Inline110(i)
+		case 111:
+			n += Inline111(i)
+		case 112:
+			n += Inline112(i)
+		case 113:
+			n += Inline113(i)
+		case 114:
+			n += Inline114(i)
+		case 115:
+			n += Inline115(i)
+		case 116:
+			n += Inline116(i)
+		case 117:
+			n += Inline117(i)
+		case 118:
+			n += Inline118(i)
+		case 119:
+			n += Inline119(i)
+		case 120:
+			n += Inline120(i)
+		case 121:
+			n += Inline121(i)
+		case 122:
+			n += Inline122(i)
+		case 123:
+			n += Inline123(i)
+		case 124:
+			n += Inline124(i)
+		case 125:
+			n += Inline125(i)
+		case 126:
+			n += Inline126(i)
+		case 127:
+			n += Inline127(i)
+		case 128:
+			n += Inline128(i)
+		case 129:
+			n += Inline129(i)
+		case 130:
+			n += Inline130(i)
+		case 131:
+			n += Inline131(i)
+		case 132:
+			n += Inline132(i)
+		case 133:
+			n += Inline133(i)
+		case 134:
+			n += Inline134(i)
+		case 135:
+			n += Inline135(i)
+		case 136:
+			n += Inline136(i)
+		case 137:
+			n += Inline137(i)
+		case 138:
+			n += Inline138(i)
+		case 139:
+			n += Inline139(i)
+		case 140:
+			n += Inline140(i)
+		case 141:
+			n += Inline141(i)
+		case 142:
+			n += Inline142(i)
+		case 143:
+			n += Inline143(i)
+		case 144:
+			n += Inline144(i)
+		case 145:
+			n += Inline145(i)
+		case 146:
+			n += Inline146(i)
+		case 147:
+			n += Inline147(i)
+		case 148:
+			n += Inline148(i)
+		case 149:
+			n += Inline149(i)
+		case 150:
+			n += Inline150(i)
+		case 151:
+			n += Inline151(i)
+		case 152:
+			n += Inline152(i)
+		case 153:
+			n += Inline153(i)
+		case 154:
+			n += Inline154(i)
+		case 155:
+			n += Inline155(i)
+		case 156:
+			n += Inline156(i)
+		case 157:
+			n += Inline157(i)
+		case 158:
+			n += Inline158(i)
+		case 159:
+			n += Inline159(i)
+		case 160:
+			n += Inline160(i)
+		case 161:
+			n += Inline161(i)
+		case 162:
+			n += Inline162(i)
+		case 163:
+			n += Inline163(i)
+		case 164:
+			n += Inline164(i)
+		case 165:
+			n += Inline165(i)
+		case 166:
+			n += Inline166(i)
+		case 167:
+			n += Inline167(i)
+		case 168:
+			n += Inline168(i)
+		case 169:
+			n += Inline169(i)
+		case 170:
+			n += Inline170(i)
+		case 171:
+			n += Inline171(i)
+		case 172:
+			n += Inline172(i)
+		case 173:
+			n += Inline173(i)
+		case 174:
+			n += Inline174(i)
+		case 175:
+			n += Inline175(i)
+		case 176:
+			n += Inline176(i)
+		case 177:
+			n += Inline177(i)
+		case 178:
+			n += Inline178(i)
+		case 179:
+			n += Inline179(i)
+		case 180:
+			n += Inline180(i)
+		case 181:
+			n += Inline181(i)
+		case 182:
+			n += Inline182(i)
+		case 183:
+			n += Inline183(i)
+		case 184:
+			n += Inline184(i)
+		case 185:
+			n += Inline185(i)
+		case 186:
+			n += Inline186(i)
+		case 187:
+			n += Inline187(i)
+		case 188:
+			n += Inline188(i)
+		case 189:
+			n += Inline189(i)
+		case 190:
+			n += Inline190(i)
+		case 191:
+			n += Inline191(i)
+		case 192:
+			n += Inline192(i)
+		case 193:
+			n += Inline193(i)
+		case 194:
+			n += Inline194(i)
+		case 195:
+			n += Inline195(i)
+		case 196:
+			n += Inline196(i)
+		case 197:
+			n += Inline197(i)
+		case 198:
+			n += Inline198(i)
+		case 199:
+			n += Inline199(i)
+		case 200:
+			n += Inline200(i)
+		case 201:
+			n += Inline201(i)
+		case 202:
+			n += Inline202(i)
+		case 203:
+			n += Inline203(i)
+		case 204:
+			n += Inline204(i)
+		case 205:
+			n += Inline205(i)
+		case 206:
+			n += Inline206(i)
+		case 207:
+			n += Inline207(i)
+		case 208:
+			n += Inline208(i)
+		case 209:
+			n += Inline209(i)
+		case 210:
+			n += Inline210(i)
+		case 211:
+			n += Inline211(i)
+		case 212:
+			n += Inline212(i)
+		case 213:
+			n += Inline213(i)
+		case 214:
+			n += Inline214(i)
+		case 215:
+			n += Inline215(i)
+		case 216:
+			n += Inline216(i)
+		case 217:
+			n += Inline217(i)
+		case 218:
+			n += Inline218(i)
+		case 219:
+			n += Inline219(i)
+		case 220:
+			n += Inline220(i)
+		case 221:
+			n += Inline221(i)
+		case 222:
+			n += Inline222(i)
+		case 223:
+			n += Inline223(i)
+		case 224:
+			n += Inline224(i)
+		case 225:
+			n += Inline225(i)
+		case 226:
+			n += Inline226(i)
+		case 227:
+			n += Inline227(i)
+		case 228:
+			n += Inline228(i)
+		case 229:
+			n += Inline229(i)
+		case 230:
+			n += Inline230(i)
+		case 231:
+			n += Inline231(i)
+		case 232:
+			n += Inline232(i)
+		case 233:
+			n += Inline233(i)
+		case 234:
+			n += Inline234(i)
+		case 235:
+			n += Inline235(i)
+		case 236:
+			n += Inline236(i)
+		case 237:
+			n += Inline237(i)
+		case 238:
+			n += Inline238(i)
+		case 239:
+			n += Inline239(i)
+		case 240:
+			n += Inline240(i)
+		case 241:
+			n += Inline241(i)
+		case 242:
+			n += Inline242(i)
+		case 243:
+			n += Inline243(i)
+		case 244:
+			n += Inline244(i)
+		case 245:
+			n += Inline245(i)
+		case 246:
+			n += Inline246(i)
+		case 247:
+			n += Inline247(i)
+		case 248:
+			n += Inline248(i)
+		case 249:
+			n += Inline249(i)
+		case 250:
+			n += Inline250(i)
+		case 251:
+			n += Inline251(i)
+		case 252:
+			n += Inline252(i)
+		case 253:
+			n += Inline253(i)
+		case 254:
+			n += Inline254(i)
+		case 255:
+			n += Inline255(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchInlineDenseLookupMod256(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysDense[inputs[i%len(inputs)]] {
+		case 0:
+			n += Inline0(i)
+		case 1:
+			n += Inline1(i)
+		case 2:
+			n += Inline2(i)
+		case 3:
+			n += Inline3(i)
+		case 4:
+			n += Inline4(i)
+		case 5:
+			n += Inline5(i)
+		case 6:
+			n += Inline6(i)
+		case 7:
+			n += Inline7(i)
+		case 8:
+			n += Inline8(i)
+		case 9:
+			n += Inline9(i)
+		case 10:
+			n += Inline10(i)
+		case 11:
+			n += Inline11(i)
+		case 12:
+			n += Inline12(i)
+		case 13:
+			n += Inline13(i)
+		case 14:
+			n += Inline14(i)
+		case 15:
+			n += Inline15(i)
+		case 16:
+			n += Inline16(i)
+		case 17:
+			n += Inline17(i)
+		case 18:
+			n += Inline18(i)
+		case 19:
+			n += Inline19(i)
+		case 20:
+			n += Inline20(i)
+		case 21:
+			n += Inline21(i)
+		case 22:
+			n += Inline22(i)
+		case 23:
+			n += Inline23(i)
+		case 24:
+			n += Inline24(i)
+		case 25:
+			n += Inline25(i)
+		case 26:
+			n += Inline26(i)
+		case 27:
+			n += Inline27(i)
+		case 28:
+			n += Inline28(i)
+		case 29:
+			n += Inline29(i)
+		case 30:
+			n += Inline30(i)
+		case 31:
+			n += Inline31(i)
+		case 32:
+			n += Inline32(i)
+		case 33:
+			n += Inline33(i)
+		case 34:
+			n += Inline34(i)
+		case 35:
+			n += Inline35(i)
+		case 36:
+			n += Inline36(i)
+		case 37:
+			n += Inline37(i)
+		case 38:
+			n += Inline38(i)
+		case 39:
+			n += Inline39(i)
+		case 40:
+			n += Inline40(i)
+		case 41:
+			n += Inline41(i)
+		case 42:
+			n += Inline42(i)
+		case 43:
+			n += Inline43(i)
+		case 44:
+			n += Inline44(i)
+		case 45:
+			n += Inline45(i)
+		case 46:
+			n += Inline46(i)
+		case 47:
+			n += Inline47(i)
+		case 48:
+			n += Inline48(i)
+		case 49:
+			n += Inline49(i)
+		case 50:
+			n += Inline50(i)
+		case 51:
+			n += Inline51(i)
+		case 52:
+			n += Inline52(i)
+		case 53:
+			n += Inline53(i)
+		case 54:
+			n += Inline54(i)
+		case 55:
+			n += Inline55(i)
+		case 56:
+			n += Inline56(i)
+		case 57:
+			n += Inline57(i)
+		case 58:
+			n += Inline58(i)
+		case 59:
+			n += Inline59(i)
+		case 60:
+			n += Inline60(i)
+		case 61:
+			n += Inline61(i)
+		case 62:
+			n += Inline62(i)
+		case 63:
+			n += Inline63(i)
+		case 64:
+			n += Inline64(i)
+		case 65:
+			n += Inline65(i)
+		case 66:
+			n += Inline66(i)
+		case 67:
+			n += Inline67(i)
+		case 68:
+			n += Inline68(i)
+		case 69:
+			n += Inline69(i)
+		case 70:
+			n += Inline70(i)
+		case 71:
+			n += Inline71(i)
+		case 72:
+			n += Inline72(i)
+		case 73:
+			n += Inline73(i)
+		case 74:
+			n += Inline74(i)
+		case 75:
+			n += Inline75(i)
+		case 76:
+			n += Inline76(i)
+		case 77:
+			n += Inline77(i)
+		case 78:
+			n += Inline78(i)
+		case 79:
+			n += Inline79(i)
+		case 80:
+			n += Inline80(i)
+		case 81:
+			n += Inline81(i)
+		case 82:
+			n += Inline82(i)
+		case 83:
+			n += Inline83(i)
+		case 84:
+			n += Inline84(i)
+		case 85:
+			n += Inline85(i)
+		case 86:
+			n += Inline86(i)
+		case 87:
+			n += Inline87(i)
+		case 88:
+			n += Inline88(i)
+		case 89:
+			n += Inline89(i)
+		case 90:
+			n += Inline90(i)
+		case 91:
+			n += Inline91(i)
+		case 92:
+			n += Inline92(i)
+		case 93:
+			n += Inline93(i)
+		case 94:
+			n += Inline94(i)
+		case 95:
+			n += Inline95(i)
+		case 96:
+			n += Inline96(i)
+		case 97:
+			n += Inline97(i)
+		case 98:
+			n += Inline98(i)
+		case 99:
+			n += Inline99(i)
+		case 100:
+			n += Inline100(i)
+		case 101:
+			n += Inline101(i)
+		case 102:
+			n += Inline102(i)
+		case 103:
+			n += Inline103(i)
+		case 104:
+			n += Inline104(i)
+		case 105:
+			n += Inline105(i)
+		case 106:
+			n += Inline106(i)
+		case 107:
+			n += Inline107(i)
+		case 108:
+			n += Inline108(i)
+		case 109:
+			n += Inline109(i)
+		case 110:
+			n += Inline110(i)
+		case 111:
+			n += Inline111(i)
+		case 112:
+			n += Inline112(i)
+		case 113:
+			n += Inline113(i)
+		case 114:
+			n += Inline114(i)
+		case 115:
+			n += Inline115(i)
+		case 116:
+			n += Inline116(i)
+		case 117:
+			n += Inline117(i)
+		case 118:
+			n += Inline118(i)
+		case 119:
+			n += Inline119(i)
+		case 120:
+			n += Inline120(i)
+		case 121:
+			n += Inline121(i)
+		case 122:
+			n += Inline122(i)
+		case 123:
+			n += Inline123(i)
+		case 124:
+			n += Inline124(i)
+		case 125:
+			n += Inline125(i)
+		case 126:
+			n += Inline126(i)
+		case 127:
+			n += Inline127(i)
+		case 128:
+			n += Inline128(i)
+		case 129:
+			n += Inline129(i)
+		case 130:
+			n += Inline130(i)
+		case 131:
+			n += Inline131(i)
+		case 132:
+			n += Inline132(i)
+		case 133:
+			n += Inline133(i)
+		case 134:
+			n += Inline134(i)
+		case 135:
+			n += Inline135(i)
+		case 136:
+			n += Inline136(i)
+		case 137:
+			n += Inline137(i)
+		case 138:
+			n += Inline138(i)
+		case 139:
+			n += Inline139(i)
+		case 140:
+			n += Inline140(i)
+		case 141:
+			n += Inline141(i)
+		case 142:
+			n += Inline142(i)
+		case 143:
+			n += Inline143(i)
+		case 144:
+			n += Inline144(i)
+		case 145:
+			n += Inline145(i)
+		case 146:
+			n += Inline146(i)
+		case 147:
+			n += Inline147(i)
+		case 148:
+			n += Inline148(i)
+		case 149:
+			n += Inline149(i)
+		case 150:
+			n += Inline150(i)
+		case 151:
+			n += Inline151(i)
+		case 152:
+			n += Inline152(i)
+		case 153:
+			n += Inline153(i)
+		case 154:
+			n += Inline154(i)
+		case 155:
+			n += Inline155(i)
+		case 156:
+			n += Inline156(i)
+		case 157:
+			n += Inline157(i)
+		case 158:
+			n += Inline158(i)
+		case 159:
+			n += Inline159(i)
+		case 160:
+			n += Inline160(i)
+		case 161:
+			n += Inline161(i)
+		case 162:
+			n += Inline162(i)
+		case 163:
+			n += Inline163(i)
+		case 164:
+			n += Inline164(i)
+		case 165:
+			n += Inline165(i)
+		case 166:
+			n += Inline166(i)
+		case 167:
+			n += Inline167(i)
+		case 168:
+			n += Inline168(i)
+		case 169:
+			n += Inline169(i)
+		case 170:
+			n += Inline170(i)
+		case 171:
+			n += Inline171(i)
+		case 172:
+			n += Inline172(i)
+		case 173:
+			n += Inline173(i)
+		case 174:
+			n += Inline174(i)
+		case 175:
+			n += Inline175(i)
+		case 176:
+			n += Inline176(i)
+		case 177:
+			n += Inline177(i)
+		case 178:
+			n += Inline178(i)
+		case 179:
+			n += Inline179(i)
+		case 180:
+			n += Inline180(i)
+		case 181:
+			n += Inline181(i)
+		case 182:
+			n += Inline182(i)
+		case 183:
+			n += Inline183(i)
+		case 184:
+			n += Inline184(i)
+		case 185:
+			n += Inline185(i)
+		case 186:
+			n += Inline186(i)
+		case 187:
+			n += Inline187(i)
+		case 188:
+			n += Inline188(i)
+		case 189:
+			n += Inline189(i)
+		case 190:
+			n += Inline190(i)
+		case 191:
+			n += Inline191(i)
+		case 192:
+			n += Inline192(i)
+		case 193:
+			n += Inline193(i)
+		case 194:
+			n += Inline194(i)
+		case 195:
+			n += Inline195(i)
+		case 196:
+			n += Inline196(i)
+		case 197:
+			n += Inline197(i)
+		case 198:
+			n += Inline198(i)
+		case 199:
+			n += Inline199(i)
+		case 200:
+			n += Inline200(i)
+		case 201:
+			n += Inline201(i)
+		case 202:
+			n += Inline202(i)
+		case 203:
+			n += Inline203(i)
+		case 204:
+			n += Inline204(i)
+		case 205:
+			n += Inline205(i)
+		case 206:
+			n += Inline206(i)
+		case 207:
+			n += Inline207(i)
+		case 208:
+			n += Inline208(i)
+		case 209:
+			n += Inline209(i)
+		case 210:
+			n += Inline210(i)
+		case 211:
+			n += Inline211(i)
+		case 212:
+			n += Inline212(i)
+		case 213:
+			n += Inline213(i)
+		case 214:
+			n += Inline214(i)
+		case 215:
+			n += Inline215(i)
+		case 216:
+			n += Inline216(i)
+		case 217:
+			n += Inline217(i)
+		case 218:
+			n += Inline218(i)
+		case 219:
+			n += Inline219(i)
+		case 220:
+			n += Inline220(i)
+		case 221:
+			n += Inline221(i)
+		case 222:
+			n += Inline222(i)
+		case 223:
+			n += Inline223(i)
+		case 224:
+			n += Inline224(i)
+		case 225:
+			n += Inline225(i)
+		case 226:
+			n += Inline226(i)
+		case 227:
+			n += Inline227(i)
+		case 228:
+			n += Inline228(i)
+		case 229:
+			n += Inline229(i)
+		case 230:
+			n += Inline230(i)
+		case 231:
+			n += Inline231(i)
+		case 232:
+			n += Inline232(i)
+		case 233:
+			n += Inline233(i)
+		case 234:
+			n += Inline234(i)
+		case 235:
+			n += Inline235(i)
+		case 236:
+			n += Inline236(i)
+		case 237:
+			n += Inline237(i)
+		case 238:
+			n += Inline238(i)
+		case 239:
+			n += Inline239(i)
+		case 240:
+			n += Inline240(i)
+		case 241:
+			n += Inline241(i)
+		case 242:
+			n += Inline242(i)
+		case 243:
+			n += Inline243(i)
+		case 244:
+			n += Inline244(i)
+		case 245:
+			n += Inline245(i)
+		case 246:
+			n += Inline246(i)
+		case 247:
+			n += Inline247(i)
+		case 248:
+			n += Inline248(i)
+		case 249:
+			n += Inline249(i)
+		case 250:
+			n += Inline250(i)
+		case 251:
+			n += Inline251(i)
+		case 252:
+			n += Inline252(i)
+		case 253:
+			n += Inline253(i)
+		case 254:
+			n += Inline254(i)
+		case 255:
+			n += Inline255(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchInlineDenseComputed512(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysDense[i%512] {
+		case 0:
+			n += Inline0(i)
+		case 1:
+			n += Inline1(i)
+		case 2:
+			n += Inline2(i)
+		case 3:
+			n += Inline3(i)
+		case 4:
+			n += Inline4(i)
+		case 5:
+			n += Inline5(i)
+		case 6:
+			n += Inline6(i)
+		case 7:
+			n += Inline7(i)
+		case 8:
+			n += Inline8(i)
+		case 9:
+			n += Inline9(i)
+		case 10:
+			n += Inline10(i)
+		case 11:
+			n += Inline11(i)
+		case 12:
+			n += Inline12(i)
+		case 13:
+			n += Inline13(i)
+		case 14:
+			n += Inline14(i)
+		case 15:
+			n += Inline15(i)
+		case 16:
+			n += Inline16(i)
+		case 17:
+			n += Inline17(i)
+		case 18:
+			n += Inline18(i)
+		case 19:
+			n += Inline19(i)
+		case 20:
+			n += Inline20(i)
+		case 21:
+			n += Inline21(i)
+		case 22:
+			n += Inline22(i)
+		case 23:
+			n += Inline23(i)
+		case 24:
+			n += Inline24(i)
+		case 25:
+			n += Inline25(i)
+		case 26:
+			n += Inline26(i)
+		case 27:
+			n += Inline27(i)
+		case 28:
+			n += Inline28(i)
+		case 29:
+			n += Inline29(i)
+		case 30:
+			n += Inline30(i)
+		case 31:
+			n += Inline31(i)
+		case 32:
+			n += Inline32(i)
+		case 33:
+			n += Inline33(i)
+		case 34:
+			n += Inline34(i)
+		case 35:
+			n += Inline35(i)
+		case 36:
+			n += Inline36(i)
+		case 37:
+			n += Inline37(i)
+		case 38:
+			n += Inline38(i)
+		case 39:
+			n += Inline39(i)
+		case 40:
+			n += Inline40(i)
+		case 41:
+			n += Inline41(i)
+		case 42:
+			n += Inline42(i)
+		case 43:
+			n += Inline43(i)
+		case 44:
+			n += Inline44(i)
+		case 45:
+			n += Inline45(i)
+		case 46:
+			n += Inline46(i)
+		case 47:
+			n += Inline47(i)
+		case 48:
+			n += Inline48(i)
+		case 49:
+			n += Inline49(i)
+		case 50:
+			n += Inline50(i)
+		case 51:
+			n += Inline51(i)
+		case 52:
+			n += Inline52(i)
+		case 53:
+			n += Inline53(i)
+		case 54:
+			n += Inline54(i)
+		case 55:
+			n += Inline55(i)
+		case 56:
+			n += Inline56(i)
+		case 57:
+			n += Inline57(i)
+		case 58:
+			n += Inline58(i)
+		case 59:
+			n += Inline59(i)
+		case 60:
+			n += Inline60(i)
+		case 61:
+			n += Inline61(i)
+		case 62:
+			n += Inline62(i)
+		case 63:
+			n += Inline63(i)
+		case 64:
+			n += Inline64(i)
+		case 65:
+			n += Inline65(i)
+		case 66:
+			n += Inline66(i)
+		case 67:
+			n += Inline67(i)
+		case 68:
+			n += Inline68(i)
+		case 69:
+			n += Inline69(i)
+		case 70:
+			n += Inline70(i)
+		case 71:
+			n += Inline71(i)
+		case 72:
+			n += Inline72(i)
+		case 73:
+			n += Inline73(i)
+		case 74:
+			n += Inline74(i)
+		case 75:
+			n += Inline75(i)
+		case 76:
+			n += Inline76(i)
+		case 77:
+			n += Inline77(i)
+		case 78:
+			n += Inline78(i)
+		case 79:
+			n += Inline79(i)
+		case 80:
+			n += Inline80(i)
+		case 81:
+			n += Inline81(i)
+		case 82:
+			n += Inline82(i)
+		case 83:
+			n += Inline83(i)
+		case 84:
+			n += Inline84(i)
+		case 85:
+			n += Inline85(i)
+		case 86:
+			n += Inline86(i)
+		case 87:
+			n += Inline87(i)
+		case 88:
+			n += Inline88(i)
+		case 89:
+			n += Inline89(i)
+		case 90:
+			n += Inline90(i)
+		case 91:
+			n += Inline91(i)
+		case 92:
+			n += Inline92(i)
+		case 93:
+			n += Inline93(i)
+		case 94:
+			n += Inline94(i)
+		case 95:
+			n += Inline95(i)
+		case 96:
+			n += Inline96(i)
+		case 97:
+			n += Inline97(i)
+		case 98:
+			n += Inline98(i)
+		case 99:
+			n += Inline99(i)
+		case 100:
+			n += Inline100(i)
+		case 101:
+			n += Inline101(i)
+		case 102:
+			n += Inline102(i)
+		case 103:
+			n += Inline103(i)
+		case 104:
+			n += Inline104(i)
+		case 105:
+			n += Inline105(i)
+		case 106:
+			n += Inline106(i)
+		case 107:
+			n += Inline107(i)
+		case 108:
+			n += Inline108(i)
+		case 109:
+			n += Inline109(i)
+		case 110:
+			n += Inline110(i)
+		case 111:
+			n += Inline111(i)
+		case 112:
+			n += Inline112(i)
+		case 113:
+			n += Inline113(i)
+		case 114:
+			n += Inline114(i)
+		case 115:
+			n += Inline115(i)
+		case 116:
+			n += Inline116(i)
+		case 117:
+			n += Inline117(i)
+		case 118:
+			n += Inline118(i)
+		case 119:
+			n += Inline119(i)
+		case 120:
+			n += Inline120(i)
+		case 121:
+			n += Inline121(i)
+		case 122:
+			n += Inline122(i)
+		case 123:
+			n += Inline123(i)
+		case 124:
+			n += Inline124(i)
+		case 125:
+			n += Inline125(i)
+		case 126:
+			n += Inline126(i)
+		case 127:
+			n += Inline127(i)
+		case 128:
+			n += Inline128(i)
+		case 129:
+			n += Inline129(i)
+		case 130:
+			n += Inline130(i)
+		case 131:
+			n += Inline131(i)
+		case 132:
+			n += Inline132(i)
+		case 133:
+			n += Inline133(i)
+		case 134:
+			n += Inline134(i)
+		case 135:
+			n += Inline135(i)
+		case 136:
+			n += Inline136(i)
+		case 137:
+			n += Inline137(i)
+		case 138:
+			n += Inline138(i)
+		case 139:
+			n += Inline139(i)
+		case 140:
+			n += Inline140(i)
+		case 141:
+			n += Inline141(i)
+		case 142:
+			n += Inline142(i)
+		case 143:
+			n += Inline143(i)
+		case 144:
+			n += Inline144(i)
+		case 145:
+			n += Inline145(i)
+		case 146:
+			n += Inline146(i)
+		case 147:
+			n += Inline147(i)
+		case 148:
+			n += Inline148(i)
+		case 149:
+			n += Inline149(i)
+		case 150:
+			n += Inline150(i)
+		case 151:
+			n += Inline151(i)
+		case 152:
+			n += Inline152(i)
+		case 153:
+			n += Inline153(i)
+		case 154:
+			n += Inline154(i)
+		case 155:
+			n += Inline155(i)
+		case 156:
+			n += Inline156(i)
+		case 157:
+			n += Inline157(i)
+		case 158:
+			n += Inline158(i)
+		case 159:
+			n += Inline159(i)
+		case 160:
+			n += Inline160(i)
+		case 161:
+			n += Inline161(i)
+		case 162:
+			n += Inline162(i)
+		case 163:
+			n += Inline163(i)
+		case 164:
+			n += Inline164(i)
+		case 165:
+			n += Inline165(i)
+		case 166:
+			n += Inline166(i)
+		case 167:
+			n += Inline167(i)
+		case 168:
+			n += Inline168(i)
+		case 169:
+			n += Inline169(i)
+		case 170:
+			n += Inline170(i)
+		case 171:
+			n += Inline171(i)
+		case 172:
+			n += Inline172(i)
+		case 173:
+			n += Inline173(i)
+		case 174:
+			n += Inline174(i)
+		case 175:
+			n += Inline175(i)
+		case 176:
+			n += Inline176(i)
+		case 177:
+			n += Inline177(i)
+		case 178:
+			n += Inline178(i)
+		case 179:
+			n += Inline179(i)
+		case 180:
+			n += Inline180(i)
+		case 181:
+			n += Inline181(i)
+		case 182:
+			n += Inline182(i)
+		case 183:
+			n += Inline183(i)
+		case 184:
+			n += Inline184(i)
+		case 185:
+			n += Inline185(i)
+		case 186:
+			n += Inline186(i)
+		case 187:
+			n += Inline187(i)
+		case 188:
+			n += Inline188(i)
+		case 189:
+			n += Inline189(i)
+		case 190:
+			n += Inline190(i)
+		case 191:
+			n += Inline191(i)
+		case 192:
+			n += Inline192(i)
+		case 193:
+			n += Inline193(i)
+		case 194:
+			n += Inline194(i)
+		case 195:
+			n += Inline195(i)
+		case 196:
+			n += Inline196(i)
+		case 197:
+			n += Inline197(i)
+		case 198:
+			n += Inline198(i)
+		case 199:
+			n += Inline199(i)
+		case 200:
+			n += Inline200(i)
+		case 201:
+			n += Inline201(i)
+		case 202:
+			n += Inline202(i)
+		case 203:
+			n += Inline203(i)
+		case 204:
+			n += Inline204(i)
+		case 205:
+			n += Inline205(i)
+		case 206:
+			n += Inline206(i)
+		case 207:
+			n += Inline207(i)
+		case 208:
+			n += Inline208(i)
+		case 209:
+			n += Inline209(i)
+		case 210:
+			n += Inline210(i)
+		case 211:
+			n += Inline211(i)
+		case 212:
+			n += Inline212(i)
+		case 213:
+			n += Inline213(i)
+		case 214:
+			n += Inline214(i)
+		case 215:
+			n += Inline215(i)
+		case 216:
+			n += Inline216(i)
+		case 217:
+			n += Inline217(i)
+		case 218:
+			n += Inline218(i)
+		case 219:
+			n += Inline219(i)
+		case 220:
+			n += Inline220(i)
+		case 221:
+			n += Inline221(i)
+		case 222:
+			n += Inline222(i)
+		case 223:
+			n += Inline223(i)
+		case 224:
+			n += Inline224(i)
+		case 225:
+			n += Inline225(i)
+		case 226:
+			n += Inline226(i)
+		case 227:
+			n += Inline227(i)
+		case 228:
+			n += Inline228(i)
+		case 229:
+			n += Inline229(i)
+		case 230:
+			n += Inline230(i)
+		case 231:
+			n += Inline231(i)
+		case 232:
+			n += Inline232(i)
+		case 233:
+			n += Inline233(i)
+		case 234:
+			n += Inline234(i)
+		case 235:
+			n += Inline235(i)
+		case 236:
+			n += Inline236(i)
+		case 237:
+			n += Inline237(i)
+		case 238:
+			n += Inline238(i)
+		case 239:
+			n += Inline239(i)
+		case 240:
+			n += Inline240(i)
+		case 241:
+			n += Inline241(i)
+		case 242:
+			n += Inline242(i)
+		case 243:
+			n += Inline243(i)
+		case 244:
+			n += Inline244(i)
+		case 245:
+			n += Inline245(i)
+		case 246:
+			n += Inline246(i)
+		case 247:
+			n += Inline247(i)
+		case 248:
+			n += Inline248(i)
+		case 249:
+			n += Inline249(i)
+		case 250:
+			n += Inline250(i)
+		case 251:
+			n += Inline251(i)
+		case 252:
+			n += Inline252(i)
+		case 253:
+			n += Inline253(i)
+		case 254:
+			n += Inline254(i)
+		case 255:
+			n += Inline255(i)
+		case 256:
+			n += Inline256(i)
+		case 257:
+			n += Inline257(i)
+		case 258:
+			n += Inline258(i)
+		case 259:
+			n += Inline259(i)
+		case 260:
+			n += Inline260(i)
+		case 261:
+			n += Inline261(i)
+		case 262:
+			n += Inline262(i)
+		case 263:
+			n += Inline263(i)
+		case 264:
+			n += Inline264(i)
+		case 265:
+			n += Inline265(i)
+		case 266:
+			n += Inline266(i)
+		case 267:
+			n += Inline267(i)
+		case 268:
+			n += Inline268(i)
+		case 269:
+			n += Inline269(i)
+		case 270:
+			n += Inline270(i)
+		case 271:
+			n += Inline271(i)
+		case 272:
+			n += Inline272(i)
+		case 273:
+			n += Inline273(i)
+		case 274:
+			n += Inline274(i)
+		case 275:
+			n += Inline275(i)
+		case 276:
+			n += Inline276(i)
+		case 277:
+			n += Inline277(i)
+		case 278:
+			n += Inline278(i)
+		case 279:
+			n += Inline279(i)
+		case 280:
+			n += Inline280(i)
+		case 281:
+			n += Inline281(i)
+		case 282:
+			n += Inline282(i)
+		case 283:
+			n += Inline283(i)
+		case 284:
+			n += Inline284(i)
+		case 285:
+			n += Inline285(i)
+		case 286:
+			n += Inline286(i)
+		case 287:
+			n += Inline287(i)
+		case 288:
+			n += Inline288(i)
+		case 289:
+			n += Inline289(i)
+		case 290:
+			n += Inline290(i)
+		case 291:
+			n += Inline291(i)
+		case 292:
+			n += Inline292(i)
+		case 293:
+			n += Inline293(i)
+		case 294:
+			n += Inline294(i)
+		case 295:
+			n += Inline295(i)
+		case 296:
+			n += Inline296(i)
+		case 297:
+			n += Inline297(i)
+		case 298:
+			n += Inline298(i)
+		case 299:
+			n += Inline299(i)
+		case 300:
+			n += Inline300(i)
+		case 301:
+			n += Inline301(i)
+		case 302:
+			n += Inline302(i)
+		case 303:
+			n += Inline303(i)
+		case 304:
+			n += Inline304(i)
+		case 305:
+			n += Inline305(i)
+		case 306:
+			n += Inline306(i)
+		case 307:
+			n += Inline307(i)
+		case 308:
+			n += Inline308(i)
+		case 309:
+			n += Inline309(i)
+		case 310:
+			n += Inline310(i)
+		case 311:
+			n += Inline311(i)
+		case 312:
+			n += Inline312(i)
+		case 313:
+			n += Inline313(i)
+		case 314:
+			n += Inline314(i)
+		case 315:
+			n += Inline315(i)
+		case 316:
+			n += Inline316(i)
+		case 317:
+			n += Inline317(i)
+		case 318:
+			n += Inline318(i)
+		case 319:
+			n += Inline319(i)
+		case 320:
+			n += Inline320(i)
+		case 321:
+			n += Inline321(i)
+		case 322:
+			n += Inline322(i)
+		case 323:
+			n += Inline323(i)
+		case 324:
+			n += Inline324(i)
+		case 325:
+			n += Inline325(i)
+		case 326:
+			n += Inline326(i)
+		case 327:
+			n += Inline327(i)
+		case 328:
+			n += Inline328(i)
+		case 329:
+			n += Inline329(i)
+		case 330:
+			n += Inline330(i)
+		case 331:
+			n += Inline331(i)
+		case 332:
+			n += Inline332(i)
+		case 333:
+			n += Inline333(i)
+		case 334:
+			n += Inline334(i)
+		case 335:
+			n += Inline335(i)
+		case 336:
+			n += Inline336(i)
+		case 337:
+			n += Inline337(i)
+		case 338:
+			n += Inline338(i)
+		case 339:
+			n += Inline339(i)
+		case 340:
+			n += Inline340(i)
+		case 341:
+			n += Inline341(i)
+		case 342:
+			n += Inline342(i)
+		case 343:
+			n += Inline343(i)
+		case 344:
+			n += Inline344(i)
+		case 345:
+			n += Inline345(i)
+		case 346:
+			n += Inline346(i)
+		case 347:
+			n += Inline347(i)
+		case 348:
+			n += Inline348(i)
+		case 349:
+			n += Inline349(i)
+		case 350:
+			n += Inline350(i)
+		case 351:
+			n += Inline351(i)
+		case 352:
+			n += Inline352(i)
+		case 353:
+			n += Inline353(i)
+		case 354:
+			n += Inline354(i)
+		case 355:
+			n += Inline355(i)
+		case 356:
+			n += Inline356(i)
+		case 357:
+			n += Inline357(i)
+		case 358:
+			n += Inline358(i)
+		case 359:
+			n += Inline359(i)
+		case 360:
+			n += Inline360(i)
+		case 361:
+			n += Inline361(i)
+		case 362:
+			n += Inline362(i)
+		case 363:
+			n += Inline363(i)
+		case 364:
+			n += Inline364(i)
+		case 365:
+			n += Inline365(i)
+		case 366:
+			n += Inline366(i)
+		case 367:
+			n += Inline367(i)
+		case 368:
+			n += Inline368(i)
+		case 369:
+			n += Inline369(i)
+		case 370:
+			n += Inline370(i)
+		case 371:
+			n += Inline371(i)
+		case 372:
+			n += Inline372(i)
+		case 373:
+			n += Inline373(i)
+		case 374:
+			n += Inline374(i)
+		case 375:
+			n += Inline375(i)
+		case 376:
+			n += Inline376(i)
+		case 377:
+			n += Inline377(i)
+		case 378:
+			n += Inline378(i)
+		case 379:
+			n += Inline379(i)
+		case 380:
+			n += Inline380(i)
+		case 381:
+			n += Inline381(i)
+		case 382:
+			n += Inline382(i)
+		case 383:
+			n += Inline383(i)
+		case 384:
+			n += Inline384(i)
+		case 385:
+			n += Inline385(i)
+		case 386:
+			n += Inline386(i)
+		case 387:
+			n += Inline387(i)
+		case 388:
+			n += Inline388(i)
+		case 389:
+			n += Inline389(i)
+		case 390:
+			n += Inline390(i)
+		case 391:
+			n += Inline391(i)
+		case 392:
+			n += Inline392(i)
+		case 393:
+			n += Inline393(i)
+		case 394:
+			n += Inline394(i)
+		case 395:
+			n += Inline395(i)
+		case 396:
+			n += Inline396(i)
+		case 397:
+			n += Inline397(i)
+		case 398:
+			n += Inline398(i)
+		case 399:
+			n += Inline399(i)
+		case 400:
+			n += Inline400(i)
+		case 401:
+			n += Inline401(i)
+		case 402:
+			n += Inline402(i)
+		case 403:
+			n += Inline403(i)
+		case 404:
+			n += Inline404(i)
+		case 405:
+			n += Inline405(i)
+		case 406:
+			n += Inline406(i)
+		case 407:
+			n += Inline407(i)
+		case 408:
+			n += Inline408(i)
+		case 409:
+			n += Inline409(i)
+		case 410:
+			n += Inline410(i)
+		case 411:
+			n += Inline411(i)
+		case 412:
+			n += Inline412(i)
+		case 413:
+			n += Inline413(i)
+		case 414:
+			n += Inline414(i)
+		case 415:
+			n += Inline415(i)
+		case 416:
+			n += Inline416(i)
+		case 417:
+			n += Inline417(i)
+		case 418:
+			n += Inline418(i)
+		case 419:
+			n += Inline419(i)
+		case 420:
+			n += Inline420(i)
+		case 421:
+			n += Inline421(i)
+		case 422:
+			n += Inline422(i)
+		case 423:
+			n += Inline423(i)
+		case 424:
+			n += Inline424(i)
+		case 425:
+			n += Inline425(i)
+		case 426:
+			n += Inline426(i)
+		case 427:
+			n += Inline427(i)
+		case 428:
+			n += Inline428(i)
+		case 429:
+			n += Inline429(i)
+		case 430:
+			n += Inline430(i)
+		case 431:
+			n += Inline431(i)
+		case 432:
+			n += Inline432(i)
+		case 433:
+			n += Inline433(i)
+		case 434:
+			n += Inline434(i)
+		case 435:
+			n += Inline435(i)
+		case 436:
+			n += Inline436(i)
+		case 437:
+			n += Inline437(i)
+		case 438:
+			n += Inline438(i)
+		case 439:
+			n += Inline439(i)
+		case 440:
+			n += Inline440(i)
+		case 441:
+			n += Inline441(i)
+		case 442:
+			n += Inline442(i)
+		case 443:
+			n += Inline443(i)
+		case 444:
+			n += Inline444(i)
+		case 445:
+			n += Inline445(i)
+		case 446:
+			n += Inline446(i)
+		case 447:
+			n += Inline447(i)
+		case 448:
+			n += Inline448(i)
+		case 449:
+			n += Inline449(i)
+		case 450:
+			n += Inline450(i)
+		case 451:
+			n += Inline451(i)
+		case 452:
+			n += Inline452(i)
+		case 453:
+			n += Inline453(i)
+		case 454:
+			n += Inline454(i)
+		case 455:
+			n += Inline455(i)
+		case 456:
+			n += Inline456(i)
+		case 457:
+			n += Inline457(i)
+		case 458:
+			n += Inline458(i)
+		case 459:
+			n += Inline459(i)
+		case 460:
+			n += Inline460(i)
+		case 461:
+			n += Inline461(i)
+		case 462:
+			n += Inline462(i)
+		case 463:
+			n += Inline463(i)
+		case 464:
+			n += Inline464(i)
+		case 465:
+			n += Inline465(i)
+		case 466:
+			n += Inline466(i)
+		case 467:
+			n += Inline467(i)
+		case 468:
+			n += Inline468(i)
+		case 469:
+			n += Inline469(i)
+		case 470:
+			n += Inline470(i)
+		case 471:
+			n += Inline471(i)
+		case 472:
+			n += Inline472(i)
+		case 473:
+			n += Inline473(i)
+		case 474:
+			n += Inline474(i)
+		case 475:
+			n += Inline475(i)
+		case 476:
+			n += Inline476(i)
+		case 477:
+			n += Inline477(i)
+		case 478:
+			n += Inline478(i)
+		case 479:
+			n += Inline479(i)
+		case 480:
+			n += Inline480(i)
+		case 481:
+			n += Inline481(i)
+		case 482:
+			n += Inline482(i)
+		case 483:
+			n += Inline483(i)
+		case 484:
+			n += Inline484(i)
+		case 485:
+			n += Inline485(i)
+		case 486:
+			n += Inline486(i)
+		case 487:
+			n += Inline487(i)
+		case 488:
+			n += Inline488(i)
+		case 489:
+			n += Inline489(i)
+		case 490:
+			n += Inline490(i)
+		case 491:
+			n += Inline491(i)
+		case 492:
+			n += Inline492(i)
+		case 493:
+			n += Inline493(i)
+		case 494:
+			n += Inline494(i)
+		case 495:
+			n += Inline495(i)
+		case 496:
+			n += Inline496(i)
+		case 497:
+			n += Inline497(i)
+		case 498:
+			n += Inline498(i)
+		case 499:
+			n += Inline499(i)
+		case 500:
+			n += Inline500(i)
+		case 501:
+			n += Inline501(i)
+		case 502:
+			n += Inline502(i)
+		case 503:
+			n += Inline503(i)
+		case 504:
+			n += Inline504(i)
+		case 505:
+			n += Inline505(i)
+		case 506:
+			n += Inline506(i)
+		case 507:
+			n += Inline507(i)
+		case 508:
+			n += Inline508(i)
+		case 509:
+			n += Inline509(i)
+		case 510:
+			n += Inline510(i)
+		case 511:
+			n += Inline511(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchInlineDenseLookupMod512(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysDense[inputs[i%len(inputs)]] {
+		case 0:
+			n += Inline0(i)
+		case 1:
+			n += Inline1(i)
+		case 2:
+			n += Inline2(i)
+		case 3:
+			n += Inline3(i)
+		case 4:
+			n += Inline4(i)
+		case 5:
+			n += Inline5(i)
+		case 6:
+			n += Inline6(i)
+		case 7:
+			n += Inline7(i)
+		case 8:
+			n += Inline8(i)
+		case 9:
+			n += Inline9(i)
+		case 10:
+			n += Inline10(i)
+		case 11:
+			n += Inline11(i)
+		case 12:
+			n += Inline12(i)
+		case 13:
+			n += Inline13(i)
+		case 14:
+			n += Inline14(i)
+		case 15:
+			n += Inline15(i)
+		case 16:
+			n += Inline16(i)
+		case 17:
+			n += Inline17(i)
+		case 18:
+			n += Inline18(i)
+		case 19:
+			n += Inline19(i)
+		case 20:
+			n += Inline20(i)
+		case 21:
+			n += Inline21(i)
+		case 22:
+			n += Inline22(i)
+		case 23:
+			n += Inline23(i)
+		case 24:
+			n += Inline24(i)
+		case 25:
+			n += Inline25(i)
+		case 26:
+			n += Inline26(i)
+		case 27:
+			n += Inline27(i)
+		case 28:
+			n += Inline28(i)
+		case 29:
+			n += Inline29(i)
+		case 30:
+			n += Inline30(i)
+		case 31:
+			n += Inline31(i)
+		case 32:
+			n += Inline32(i)
+		case 33:
+			n += Inline33(i)
+		case 34:
+			n += Inline34(i)
+		case 35:
+			n += Inline35(i)
+		case 36:
+			n += Inline36(i)
+		case 37:
+			n += Inline37(i)
+		case 38:
+			n += Inline38(i)
+		case 39:
+			n += Inline39(i)
+		case 40:
+			n += Inline40(i)
+		case 41:
+			n += Inline41(i)
+		case 42:
+			n += Inline42(i)
+		case 43:
+			n += Inline43(i)
+		case 44:
+			n += Inline44(i)
+		case 45:
+			n += Inline45(i)
+		case 46:
+			n += Inline46(i)
+		case 47:
+			n += Inline47(i)
+		case 48:
+			n += Inline48(i)
+		case 49:
+			n += Inline49(i)
+		case 50:
+			n += Inline50(i)
+		case 51:
+			n += Inline51(i)
+		case 52:
+			n += Inline52(i)
+		case 53:
+			n += Inline53(i)
+		case 54:
+			n += Inline54(i)
+		case 55:
+			n += Inline55(i)
+		case 56:
+			n += Inline56(i)
+		case 57:
+			n += Inline57(i)
+		case 58:
+			n += Inline58(i)
+		case 59:
+			n += Inline59(i)
+		case 60:
+			n += Inline60(i)
+		case 61:
+			n += Inline61(i)
+		case 62:
+			n += Inline62(i)
+		case 63:
+			n += Inline63(i)
+		case 64:
+			n += Inline64(i)
+		case 65:
+			n += Inline65(i)
+		case 66:
+			n += Inline66(i)
+		case 67:
+			n += Inline67(i)
+		case 68:
+			n += Inline68(i)
+		case 69:
+			n += Inline69(i)
+		case 70:
+			n += Inline70(i)
+		case 71:
+			n += Inline71(i)
+		case 72:
+			n += Inline72(i)
+		case 73:
+			n += Inline73(i)
+		case 74:
+			n += Inline74(i)
+		case 75:
+			n += Inline75(i)
+		case 76:
+			n += Inline76(i)
+		case 77:
+			n += Inline77(i)
+		case 78:
+			n += Inline78(i)
+		case 79:
+			n += Inline79(i)
+		case 80:
+			n += Inline80(i)
+		case 81:
+			n += Inline81(i)
+		case 82:
+			n += Inline82(i)
+		case 83:
+			n += Inline83(i)
+		case 84:
+			n += Inline84(i)
+		case 85:
+			n += Inline85(i)
+		case 86:
+			n += Inline86(i)
+		case 87:
+			n += Inline87(i)
+		case 88:
+			n += Inline88(i)
+		case 89:
+			n += Inline89(i)
+		case 90:
+			n += Inline90(i)
+		case 91:
+			n += Inline91(i)
+		case 92:
+			n += Inline92(i)
+		case 93:
+			n += Inline93(i)
+		case 94:
+			n += Inline94(i)
+		case 95:
+			n += Inline95(i)
+		case 96:
+			n += Inline96(i)
+		case 97:
+			n += Inline97(i)
+		case 98:
+			n += Inline98(i)
+		case 99:
+			n += Inline99(i)
+		case 100:
+			n += Inline100(i)
+		case 101:
+			n += Inline101(i)
+		case 102:
+			n += Inline102(i)
+		case 103:
+			n += Inline103(i)
+		case 104:
+			n += Inline104(i)
+		case 105:
+			n += Inline105(i)
+		case 106:
+			n += Inline106(i)
+		case 107:
+			n += Inline107(i)
+		case 108:
+			n += Inline108(i)
+		case 109:
+			n += Inline109(i)
+		case 110:
+			n += Inline110(i)
+		case 111:
+			n += Inline111(i)
+		case 112:
+			n += Inline112(i)
+		case 113:
+			n += Inline113(i)
+		case 114:
+			n += Inline114(i)
+		case 115:
+			n += Inline115(i)
+		case 116:
+			n += Inline116(i)
+		case 117:
+			n += Inline117(i)
+		case 118:
+			n += Inline118(i)
+		case 119:
+			n += Inline119(i)
+		case 120:
+			n += Inline120(i)
+		case 121:
+			n += Inline121(i)
+		case 122:
+			n += Inline122(i)
+		case 123:
+			n += Inline123(i)
+		case 124:
+			n += Inline124(i)
+		case 125:
+			n += Inline125(i)
+		case 126:
+			n += Inline126(i)
+		case 127:
+			n += Inline127(i)
+		case 128:
+			n += Inline128(i)
+		case 129:
+			n += Inline129(i)
+		case 130:
+			n += Inline130(i)
+		case 131:
+			n += Inline131(i)
+		case 132:
+			n += Inline132(i)
+		case 133:
+			n += Inline133(i)
+		case 134:
+			n += Inline134(i)
+		case 135:
+			n += Inline135(i)
+		case 136:
+			n += Inline136(i)
+		case 137:
+			n += Inline137(i)
+		case 138:
+			n += Inline138(i)
+		case 139:
+			n += Inline139(i)
+		case 140:
+			n += Inline140(i)
+		case 141:
+			n += Inline141(i)
+		case 142:
+			n += Inline142(i)
+		case 143:
+			n += Inline143(i)
+		case 144:
+			n += Inline144(i)
+		case 145:
+			n += Inline145(i)
+		case 146:
+			n += Inline146(i)
+		case 147:
+			n += Inline147(i)
+		case 148:
+			n += Inline148(i)
+		case 149:
+			n += Inline149(i)
+		case 150:
+			n += Inline150(i)
+		case 151:
+			n += Inline151(i)
+		case 152:
+			n += Inline152(i)
+		case 153:
+			n += Inline153(i)
+		case 154:
+			n += Inline154(i)
+		case 155:
+			n += Inline155(i)
+		case 156:
+			n += Inline156(i)
+		case 157:
+			n += Inline157(i)
+		case 158:
+			n += Inline158(i)
+		case 159:
+			n += Inline159(i)
+		case 160:
+			n += Inline160(i)
+		case 161:
+			n += Inline161(i)
+		case 162:
+			n += Inline162(i)
+		case 163:
+			n += Inline163(i)
+		case 164:
+			n += Inline164(i)
+		case 165:
+			n += Inline165(i)
+		case 166:
+			n += Inline166(i)
+		case 167:
+			n += Inline167(i)
+		case 168:
+			n += Inline168(i)
+		case 169:
+			n += Inline169(i)
+		case 170:
+			n += Inline170(i)
+		case 171:
+			n += Inline171(i)
+		case 172:
+			n += Inline172(i)
+		case 173:
+			n += Inline173(i)
+		case 174:
+			n += Inline174(i)
+		case 175:
+			n += Inline175(i)
+		case 176:
+			n += Inline176(i)
+		case 177:
+			n += Inline177(i)
+		case 178:
+			n += Inline178(i)
+		case 179:
+			n += Inline179(i)
+		case 180:
+			n += Inline180(i)
+		case 181:
+			n += Inline181(i)
+		case 182:
+			n += Inline182(i)
+		case 183:
+			n += Inline183(i)
+		case 184:
+			n += Inline184(i)
+		case 185:
+			n += Inline185(i)
+		case 186:
+			n += Inline186(i)
+		case 187:
+			n += Inline187(i)
+		case 188:
+			n += Inline188(i)
+		case 189:
+			n += Inline189(i)
+		case 190:
+			n += Inline190(i)
+		case 191:
+			n += Inline191(i)
+		case 192:
+			n += Inline192(i)
+		case 193:
+			n += Inline193(i)
+		case 194:
+			n += Inline194(i)
+		case 195:
+			n += Inline195(i)
+		case 196:
+			n += Inline196(i)
+		case 197:
+			n += Inline197(i)
+		case 198:
+			n += Inline198(i)
+		case 199:
+			n += Inline199(i)
+		case 200:
+			n += Inline200(i)
+		case 201:
+			n += Inline201(i)
+		case 202:
+			n += Inline202(i)
+		case 203:
+			n += Inline203(i)
+		case 204:
+			n += Inline204(i)
+		case 205:
+			n += Inline205(i)
+		case 206:
+			n += Inline206(i)
+		case 207:
+			n += Inline207(i)
+		case 208:
+			n += Inline208(i)
+		case 209:
+			n += Inline209(i)
+		case 210:
+			n += Inline210(i)
+		case 211:
+			n += Inline211(i)
+		case 212:
+			n += Inline212(i)
+		case 213:
+			n += Inline213(i)
+		case 214:
+			n += Inline214(i)
+		case 215:
+			n += Inline215(i)
+		case 216:
+			n += Inline216(i)
+		case 217:
+			n += Inline217(i)
+		case 218:
+			n += Inline218(i)
+		case 219:
+			n += Inline219(i)
+		case 220:
+			n += Inline220(i)
+		case 221:
+			n += Inline221(i)
+		case 222:
+			n += Inline222(i)
+		case 223:
+			n += Inline223(i)
+		case 224:
+			n += Inline224(i)
+		case 225:
+			n += Inline225(i)
+		case 226:
+			n += Inline226(i)
+		case 227:
+			n += Inline227(i)
+		case 228:
+			n += Inline228(i)
+		case 229:
+			n += Inline229(i)
+		case 230:
+			n += Inline230(i)
+		case 231:
+			n += Inline231(i)
+		case 232:
+			n += Inline232(i)
+		case 233:
+			n += Inline233(i)
+		case 234:
+			n += Inline234(i)
+		case 235:
+			n += Inline235(i)
+		case 236:
+			n += Inline236(i)
+		case 237:
+			n += Inline237(i)
+		case 238:
+			n += Inline238(i)
+		case 239:
+			n += Inline239(i)
+		case 240:
+			n += Inline240(i)
+		case 241:
+			n += Inline241(i)
+		case 242:
+			n += Inline242(i)
+		case 243:
+			n += Inline243(i)
+		case 244:
+			n += Inline244(i)
+		case 245:
+			n += Inline245(i)
+		case 246:
+			n += Inline246(i)
+		case 247:
+			n += Inline247(i)
+		case 248:
+			n += Inline248(i)
+		case 249:
+			n += Inline249(i)
+		case 250:
+			n += Inline250(i)
+		case 251:
+			n += Inline251(i)
+		case 252:
+			n += Inline252(i)
+		case 253:
+			n += Inline253(i)
+		case 254:
+			n += Inline254(i)
+		case 255:
+			n += Inline255(i)
+		case 256:
+			n += Inline256(i)
+		case 257:
+			n += Inline257(i)
+		case 258:
+			n += Inline258(i)
+		case 259:
+			n += Inline259(i)
+		case 260:
+			n += Inline260(i)
+		case 261:
+			n += Inline261(i)
+		case 262:
+			n += Inline262(i)
+		case 263:
+			n += Inline263(i)
+		case 264:
+			n += Inline264(i)
+		case 265:
+			n += Inline265(i)
+		case 266:
+			n += Inline266(i)
+		case 267:
+			n += Inline267(i)
+		case 268:
+			n += Inline268(i)
+		case 269:
+			n += Inline269(i)
+		case 270:
+			n += Inline270(i)
+		case 271:
+			n += Inline271(i)
+		case 272:
+			n += Inline272(i)
+		case 273:
+			n += Inline273(i)
+		case 274:
+			n += Inline274(i)
+		case 275:
+			n += Inline275(i)
+		case 276:
+			n += Inline276(i)
+		case 277:
+			n += Inline277(i)
+		case 278:
+			n += Inline278(i)
+		case 279:
+			n += Inline279(i)
+		case 280:
+			n += Inline280(i)
+		case 281:
+			n += Inline281(i)
+		case 282:
+			n += Inline282(i)
+		case 283:
+			n += Inline283(i)
+		case 284:
+			n += Inline284(i)
+		case 285:
+			n += Inline285(i)
+		case 286:
+			n += Inline286(i)
+		case 287:
+			n += Inline287(i)
+		case 288:
+			n += Inline288(i)
+		case 289:
+			n += Inline289(i)
+		case 290:
+			n += Inline290(i)
+		case 291:
+			n += Inline291(i)
+		case 292:
+			n += Inline292(i)
+		case 293:
+			n += Inline293(i)
+		case 294:
+			n += Inline294(i)
+		case 295:
+			n += Inline295(i)
+		case 296:
+			n += Inline296(i)
+		case 297:
+			n += Inline297(i)
+		case 298:
+			n += Inline298(i)
+		case 299:
+			n += Inline299(i)
+		case 300:
+			n += Inline300(i)
+		case 301:
+			n += Inline301(i)
+		case 302:
+			n += Inline302(i)
+		case 303:
+			n += Inline303(i)
+		case 304:
+			n += Inline304(i)
+		case 305:
+			n += Inline305(i)
+		case 306:
+			n += Inline306(i)
+		case 307:
+			n += Inline307(i)
+		case 308:
+			n += Inline308(i)
+		case 309:
+			n += Inline309(i)
+		case 310:
+			n += Inline310(i)
+		case 311:
+			n += Inline311(i)
+		case 312:
+			n += Inline312(i)
+		case 313:
+			n += Inline313(i)
+		case 314:
+			n += Inline314(i)
+		case 315:
+			n += Inline315(i)
+		case 316:
+			n += Inline316(i)
+		case 317:
+			n += Inline317(i)
+		case 318:
+			n += Inline318(i)
+		case 319:
+			n += Inline319(i)
+		case 320:
+			n += Inline320(i)
+		case 321:
+			n += Inline321(i)
+		case 322:
+			n += Inline322(i)
+		case 323:
+			n += Inline323(i)
+		case 324:
+			n += Inline324(i)
+		case 325:
+			n += Inline325(i)
+		case 326:
+			n += Inline326(i)
+		case 327:
+			n += Inline327(i)
+		case 328:
+			n += Inline328(i)
+		case 329:
+			n += Inline329(i)
+		case 330:
+			n += Inline330(i)
+		case 331:
+			n += Inline331(i)
+		case 332:
+			n += Inline332(i)
+		case 333:
+			n += Inline333(i)
+		case 334:
+			n += Inline334(i)
+		case 335:
+			n += Inline335(i)
+		case 336:
+			n += Inline336(i)
+		case 337:
+			n += Inline337(i)
+		case 338:
+			n += Inline338(i)
+		case 339:
+			n += Inline339(i)
+		case 340:
+			n += Inline340(i)
+		case 341:
+			n += Inline341(i)
+		case 342:
+			n += Inline342(i)
+		case 343:
+			n += Inline343(i)
+		case 344:
+			n += Inline344(i)
+		case 345:
+			n += Inline345(i)
+		case 346:
+			n += Inline346(i)
+		case 347:
+			n += Inline347(i)
+		case 348:
+			n += Inline348(i)
+		case 349:
+			n += Inline349(i)
+		case 350:
+			n += Inline350(i)
+		case 351:
+			n += Inline351(i)
+		case 352:
+			n += Inline352(i)
+		case 353:
+			n += Inline353(i)
+		case 354:
+			n += Inline354(i)
+		case 355:
+			n += Inline355(i)
+		case 356:
+			n += Inline356(i)
+		case 357:
+			n += Inline357(i)
+		case 358:
+			n += Inline358(i)
+		case 359:
+			n += Inline359(i)
+		case 360:
+			n += Inline360(i)
+		case 361:
+			n += Inline361(i)
+		case 362:
+			n += Inline362(i)
+		case 363:
+			n += Inline363(i)
+		case 364:
+			n += Inline364(i)
+		case 365:
+			n += Inline365(i)
+		case 366:
+			n += Inline366(i)
+		case 367:
+			n += Inline367(i)
+		case 368:
+			n += Inline368(i)
+		case 369:
+			n += Inline369(i)
+		case 370:
+			n += Inline370(i)
+		case 371:
+			n += Inline371(i)
+		case 372:
+			n += Inline372(i)
+		case 373:
+			n += Inline373(i)
+		case 374:
+			n += Inline374(i)
+		case 375:
+			n += Inline375(i)
+		case 376:
+			n += Inline376(i)
+		case 377:
+			n += Inline377(i)
+		case 378:
+			n += Inline378(i)
+		case 379:
+			n += Inline379(i)
+		case 380:
+			n += Inline380(i)
+		case 381:
+			n += Inline381(i)
+		case 382:
+			n += Inline382(i)
+		case 383:
+			n += Inline383(i)
+		case 384:
+			n += Inline384(i)
+		case 385:
+			n += Inline385(i)
+		case 386:
+			n += Inline386(i)
+		case 387:
+			n += Inline387(i)
+		case 388:
+			n += Inline388(i)
+		case 389:
+			n += Inline389(i)
+		case 390:
+			n += Inline390(i)
+		case 391:
+			n += Inline391(i)
+		case 392:
+			n += Inline392(i)
+		case 393:
+			n += Inline393(i)
+		case 394:
+			n += Inline394(i)
+		case 395:
+			n += Inline395(i)
+		case 396:
+			n += Inline396(i)
+		case 397:
+			n += Inline397(i)
+		case 398:
+			n += Inline398(i)
+		case 399:
+			n += Inline399(i)
+		case 400:
+			n += Inline400(i)
+		case 401:
+			n += Inline401(i)
+		case 402:
+			n += Inline402(i)
+		case 403:
+			n += Inline403(i)
+		case 404:
+			n += Inline404(i)
+		case 405:
+			n += Inline405(i)
+		case 406:
+			n += Inline406(i)
+		case 407:
+			n += Inline407(i)
+		case 408:
+			n += Inline408(i)
+		case 409:
+			n += Inline409(i)
+		case 410:
+			n += Inline410(i)
+		case 411:
+			n += Inline411(i)
+		case 412:
+			n += Inline412(i)
+		case 413:
+			n += Inline413(i)
+		case 414:
+			n += Inline414(i)
+		case 415:
+			n += Inline415(i)
+		case 416:
+			n += Inline416(i)
+		case 417:
+			n += Inline417(i)
+		case 418:
+			n += Inline418(i)
+		case 419:
+			n += Inline419(i)
+		case 420:
+			n += Inline420(i)
+		case 421:
+			n += Inline421(i)
+		case 422:
+			n += Inline422(i)
+		case 423:
+			n += Inline423(i)
+		case 424:
+			n += Inline424(i)
+		case 425:
+			n += Inline425(i)
+		case 426:
+			n += Inline426(i)
+		case 427:
+			n += Inline427(i)
+		case 428:
+			n += Inline428(i)
+		case 429:
+			n += Inline429(i)
+		case 430:
+			n += Inline430(i)
+		case 431:
+			n += Inline431(i)
+		case 432:
+			n += Inline432(i)
+		case 433:
+			n += Inline433(i)
+		case 434:
+			n += Inline434(i)
+		case 435:
+			n += Inline435(i)
+		case 436:
+			n += Inline436(i)
+		case 437:
+			n += Inline437(i)
+		case 438:
+			n += Inline438(i)
+		case 439:
+			n += Inline439(i)
+		case 440:
+			n += Inline440(i)
+		case 441:
+			n += Inline441(i)
+		case 442:
+			n += Inline442(i)
+		case 443:
+			n += Inline443(i)
+		case 444:
+			n += Inline444(i)
+		case 445:
+			n += Inline445(i)
+		case 446:
+			n += Inline446(i)
+		case 447:
+			n += Inline447(i)
+		case 448:
+			n += Inline448(i)
+		case 449:
+			n += Inline449(i)
+		case 450:
+			n += Inline450(i)
+		case 451:
+			n += Inline451(i)
+		case 452:
+			n += Inline452(i)
+		case 453:
+			n += Inline453(i)
+		case 454:
+			n += Inline454(i)
+		case 455:
+			n += Inline455(i)
+		case 456:
+			n += Inline456(i)
+		case 457:
+			n += Inline457(i)
+		case 458:
+			n += Inline458(i)
+		case 459:
+			n += Inline459(i)
+		case 460:
+			n += Inline460(i)
+		case 461:
+			n += Inline461(i)
+		case 462:
+			n += Inline462(i)
+		case 463:
+			n += Inline463(i)
+		case 464:
+			n += Inline464(i)
+		case 465:
+			n += Inline465(i)
+		case 466:
+			n += Inline466(i)
+		case 467:
+			n += Inline467(i)
+		case 468:
+			n += Inline468(i)
+		case 469:
+			n += Inline469(i)
+		case 470:
+			n += Inline470(i)
+		case 471:
+			n += Inline471(i)
+		case 472:
+			n += Inline472(i)
+		case 473:
+			n += Inline473(i)
+		case 474:
+			n += Inline474(i)
+		case 475:
+			n += Inline475(i)
+		case 476:
+			n += Inline476(i)
+		case 477:
+			n += Inline477(i)
+		case 478:
+			n += Inline478(i)
+		case 479:
+			n += Inline479(i)
+		case 480:
+			n += Inline480(i)
+		case 481:
+			n += Inline481(i)
+		case 482:
+			n += Inline482(i)
+		case 483:
+			n += Inline483(i)
+		case 484:
+			n += Inline484(i)
+		case 485:
+			n += Inline485(i)
+		case 486:
+			n += Inline486(i)
+		case 487:
+			n += Inline487(i)
+		case 488:
+			n += Inline488(i)
+		case 489:
+			n += Inline489(i)
+		case 490:
+			n += Inline490(i)
+		case 491:
+			n += Inline491(i)
+		case 492:
+			n += Inline492(i)
+		case 493:
+			n += Inline493(i)
+		case 494:
+			n += Inline494(i)
+		case 495:
+			n += Inline495(i)
+		case 496:
+			n += Inline496(i)
+		case 497:
+			n += Inline497(i)
+		case 498:
+			n += Inline498(i)
+		case 499:
+			n += Inline499(i)
+		case 500:
+			n += Inline500(i)
+		case 501:
+			n += Inline501(i)
+		case 502:
+			n += Inline502(i)
+		case 503:
+			n += Inline503(i)
+		case 504:
+			n += Inline504(i)
+		case 505:
+			n += Inline505(i)
+		case 506:
+			n += Inline506(i)
+		case 507:
+			n += Inline507(i)
+		case 508:
+			n += Inline508(i)
+		case 509:
+			n += Inline509(i)
+		case 510:
+			n += Inline510(i)
+		case 511:
+			n += Inline511(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchInlineStridedComputed4(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysStrided[i%4] {
+		case 0:
+			n += Inline0(i)
+		case 16:
+			n += Inline1(i)
+		case 32:
+			n += Inline2(i)
+		case 48:
+			n += Inline3(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchInlineStridedLookupMod4(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysStrided[inputs[i%len(inputs)]] {
+		case 0:
+			n += Inline0(i)
+		case 16:
+			n += Inline1(i)
+		case 32:
+			n += Inline2(i)
+		case 48:
+			n += Inline3(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchInlineStridedComputed8(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysStrided[i%8] {
+		case 0:
+			n += Inline0(i)
+		case 16:
+			n += Inline1(i)
+		case 32:
+			n += Inline2(i)
+		case 48:
+			n += Inline3(i)
+		case 64:
+			n += Inline4(i)
+		case 80:
+			n += Inline5(i)
+		case 96:
+			n += Inline6(i)
+		case 112:
+			n += Inline7(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchInlineStridedLookupMod8(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysStrided[inputs[i%len(inputs)]] {
+		case 0:
+			n += Inline0(i)
+		case 16:
+			n += Inline1(i)
+		case 32:
+			n += Inline2(i)
+		case 48:
+			n += Inline3(i)
+		case 64:
+			n += Inline4(i)
+		case 80:
+			n += Inline5(i)
+		case 96:
+			n += Inline6(i)
+		case 112:
+			n += Inline7(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchInlineStridedComputed16(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysStrided[i%16] {
+		case 0:
+			n += Inline0(i)
+		case 16:
+			n += Inline1(i)
+		case 32:
+			n += Inline2(i)
+		case 48:
+			n += Inline3(i)
+		case 64:
+			n += Inline4(i)
+		case 80:
+			n += Inline5(i)
+		case 96:
+			n += Inline6(i)
+		case 112:
+			n += Inline7(i)
+		case 128:
+			n += Inline8(i)
+		case 144:
+			n += Inline9(i)
+		case 160:
+			n += Inline10(i)
+		case 176:
+			n += Inline11(i)
+		case 192:
+			n += Inline12(i)
+		case 208:
+			n += Inline13(i)
+		case 224:
+			n += Inline14(i)
+		case 240:
+			n += Inline15(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchInlineStridedLookupMod16(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysStrided[inputs[i%len(inputs)]] {
+		case 0:
+			n += Inline0(i)
+		case 16:
+			n += Inline1(i)
+		case 32:
+			n += Inline2(i)
+		case 48:
+			n += Inline3(i)
+		case 64:
+			n += Inline4(i)
+		case 80:
+			n += Inline5(i)
+		case 96:
+			n += Inline6(i)
+		case 112:
+			n += Inline7(i)
+		case 128:
+			n += Inline8(i)
+		case 144:
+			n += Inline9(i)
+		case 160:
+			n += Inline10(i)
+		case 176:
+			n += Inline11(i)
+		case 192:
+			n += Inline12(i)
+		case 208:
+			n += Inline13(i)
+		case 224:
+			n += Inline14(i)
+		case 240:
+			n += Inline15(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchInlineStridedComputed32(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysStrided[i%32] {
+		case 0:
+			n += Inline0(i)
+		case 16:
+			n += Inline1(i)
+		case 32:
+			n += Inline2(i)
+		case 48:
+			n += Inline3(i)
+		case 64:
+			n += Inline4(i)
+		case 80:
+			n += Inline5(i)
+		case 96:
+			n += Inline6(i)
+		case 112:
+			n += Inline7(i)
+		case 128:
+			n += Inline8(i)
+		case 144:
+			n += Inline9(i)
+		case 160:
+			n += Inline10(i)
+		case 176:
+			n += Inline11(i)
+		case 192:
+			n += Inline12(i)
+		case 208:
+			n += Inline13(i)
+		case 224:
+			n += Inline14(i)
+		case 240:
+			n += Inline15(i)
+		case 256:
+			n += Inline16(i)
+		case 272:
+			n += Inline17(i)
+		case 288:
+			n += Inline18(i)
+		case 304:
+			n += Inline19(i)
+		case 320:
+			n += Inline20(i)
+		case 336:
+			n += Inline21(i)
+		case 352:
+			n += Inline22(i)
+		case 368:
+			n += Inline23(i)
+		case 384:
+			n += Inline24(i)
+		case 400:
+			n += Inline25(i)
+		case 416:
+			n += Inline26(i)
+		case 432:
+			n += Inline27(i)
+		case 448:
+			n += Inline28(i)
+		case 464:
+			n += Inline29(i)
+		case 480:
+			n += Inline30(i)
+		case 496:
+			n += Inline31(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchInlineStridedLookupMod32(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysStrided[inputs[i%len(inputs)]] {
+		case 0:
+			n += Inline0(i)
+		case 16:
+			n += Inline1(i)
+		case 32:
+			n += Inline2(i)
+		case 48:
+			n += Inline3(i)
+		case 64:
+			n += Inline4(i)
+		case 80:
+			n += Inline5(i)
+		case 96:
+			n += Inline6(i)
+		case 112:
+			n += Inline7(i)
+		case 128:
+			n += Inline8(i)
+		case 144:
+			n += Inline9(i)
+		case 160:
+			n += Inline10(i)
+		case 176:
+			n += Inline11(i)
+		case 192:
+			n += Inline12(i)
+		case 208:
+			n += Inline13(i)
+		case 224:
+			n += Inline14(i)
+		case 240:
+			n += Inline15(i)
+		case 256:
+			n += Inline16(i)
+		case 272:
+			n += Inline17(i)
+		case 288:
+			n += Inline18(i)
+		case 304:
+			n += Inline19(i)
+		case 320:
+			n += Inline20(i)
+		case 336:
+			n += Inline21(i)
+		case 352:
+			n += Inline22(i)
+		case 368:
+			n += Inline23(i)
+		case 384:
+			n += Inline24(i)
+		case 400:
+			n += Inline25(i)
+		case 416:
+			n += Inline26(i)
+		case 432:
+			n += Inline27(i)
+		case 448:
+			n += Inline28(i)
+		case 464:
+			n += Inline29(i)
+		case 480:
+			n += Inline30(i)
+		case 496:
+			n += Inline31(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchInlineStridedComputed64(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysStrided[i%64] {
+		case 0:
+			n += Inline0(i)
+		case 16:
+			n += Inline1(i)
+		case 32:
+			n += Inline2(i)
+		case 48:
+			n += Inline3(i)
+		case 64:
+			n += Inline4(i)
+		case 80:
+			n += Inline5(i)
+		case 96:
+			n += Inline6(i)
+		case 112:
+			n += Inline7(i)
+		case 128:
+			n += Inline8(i)
+		case 144:
+			n += Inline9(i)
+		case 160:
+			n += Inline10(i)
+		case 176:
+			n += Inline11(i)
+		case 192:
+			n += Inline12(i)
+		case 208:
+			n += Inline13(i)
+		case 224:
+			n += Inline14(i)
+		case 240:
+			n += Inline15(i)
+		case 256:
+			n += Inline16(i)
+		case 272:
+			n += Inline17(i)
+		case 288:
+			n += Inline18(i)
+		case 304:
+			n += Inline19(i)
+		case 320:
+			n += Inline20(i)
+		case 336:
+			n += Inline21(i)
+		case 352:
+			n += Inline22(i)
+		case 368:
+			n += Inline23(i)
+		case 384:
+			n += Inline24(i)
+		case 400:
+			n += Inline25(i)
+		case 416:
+			n += Inline26(i)
+		case 432:
+			n += Inline27(i)
+		case 448:
+			n += Inline28(i)
+		case 464:
+			n += Inline29(i)
+		case 480:
+			n += Inline30(i)
+		case 496:
+			n += Inline31(i)
+		case 512:
+			n += Inline32(i)
+		case 528:
+			n += Inline33(i)
+		case 544:
+			n += Inline34(i)
+		case 560:
+			n += Inline35(i)
+		case 576:
+			n += Inline36(i)
+		case 592:
+			n += Inline37(i)
+		case 608:
+			n += Inline38(i)
+		case 624:
+			n += Inline39(i)
+		case 640:
+			n += Inline40(i)
+		case 656:
+			n += Inline41(i)
+		case 672:
+			n += Inline42(i)
+		case 688:
+			n += Inline43(i)
+		case 704:
+			n += Inline44(i)
+		case 720:
+			n += Inline45(i)
+		case 736:
+			n += Inline46(i)
+		case 752:
+			n += Inline47(i)
+		case 768:
+			n += Inline48(i)
+		case 784:
+			n += Inline49(i)
+		case 800:
+			n += Inline50(i)
+		case 816:
+			n += Inline51(i)
+		case 832:
+			n += Inline52(i)
+		case 848:
+			n += Inline53(i)
+		case 864:
+			n += Inline54(i)
+		case 880:
+			n += Inline55(i)
+		case 896:
+			n += Inline56(i)
+		case 912:
+			n += Inline57(i)
+		case 928:
+			n += Inline58(i)
+		case 944:
+			n += Inline59(i)
+		case 960:
+			n += Inline60(i)
+		case 976:
+			n += Inline61(i)
+		case 992:
+			n += Inline62(i)
+		case 1008:
+			n += Inline63(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchInlineStridedLookupMod64(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysStrided[inputs[i%len(inputs)]] {
+		case 0:
+			n += Inline0(i)
+		case 16:
+			n += Inline1(i)
+		case 32:
+			n += Inline2(i)
+		case 48:
+			n += Inline3(i)
+		case 64:
+			n += Inline4(i)
+		case 80:
+			n += Inline5(i)
+		case 96:
+			n += Inline6(i)
+		case 112:
+			n += Inline7(i)
+		case 128:
+			n += Inline8(i)
+		case 144:
+			n += Inline9(i)
+		case 160:
+			n += Inline10(i)
+		case 176:
+			n += Inline11(i)
+		case 192:
+			n += Inline12(i)
+		case 208:
+			n += Inline13(i)
+		case 224:
+			n += Inline14(i)
+		case 240:
+			n += Inline15(i)
+		case 256:
+			n += Inline16(i)
+		case 272:
+			n += Inline17(i)
+		case 288:
+			n += Inline18(i)
+		case 304:
+			n += Inline19(i)
+		case 320:
+			n += Inline20(i)
+		case 336:
+			n += Inline21(i)
+		case 352:
+			n += Inline22(i)
+		case 368:
+			n += Inline23(i)
+		case 384:
+			n += Inline24(i)
+		case 400:
+			n += Inline25(i)
+		case 416:
+			n += Inline26(i)
+		case 432:
+			n += Inline27(i)
+		case 448:
+			n += Inline28(i)
+		case 464:
+			n += Inline29(i)
+		case 480:
+			n += Inline30(i)
+		case 496:
+			n += Inline31(i)
+		case 512:
+			n += Inline32(i)
+		case 528:
+			n += Inline33(i)
+		case 544:
+			n += Inline34(i)
+		case 560:
+			n += Inline35(i)
+		case 576:
+			n += Inline36(i)
+		case 592:
+			n += Inline37(i)
+		case 608:
+			n += Inline38(i)
+		case 624:
+			n += Inline39(i)
+		case 640:
+			n += Inline40(i)
+		case 656:
+			n += Inline41(i)
+		case 672:
+			n += Inline42(i)
+		case 688:
+			n += Inline43(i)
+		case 704:
+			n += Inline44(i)
+		case 720:
+			n += Inline45(i)
+		case 736:
+			n += Inline46(i)
+		case 752:
+			n += Inline47(i)
+		case 768:
+			n += Inline48(i)
+		case 784:
+			n += Inline49(i)
+		case 800:
+			n += Inline50(i)
+		case 816:
+			n += Inline51(i)
+		case 832:
+			n += Inline52(i)
+		case 848:
+			n += Inline53(i)
+		case 864:
+			n += Inline54(i)
+		case 880:
+			n += Inline55(i)
+		case 896:
+			n += Inline56(i)
+		case 912:
+			n += Inline57(i)
+		case 928:
+			n += Inline58(i)
+		case 944:
+			n += Inline59(i)
+		case 960:
+			n += Inline60(i)
+		case 976:
+			n += Inline61(i)
+		case 992:
+			n += Inline62(i)
+		case 1008:
+			n += Inline63(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchInlineStridedComputed128(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysStrided[i%128] {
+		case 0:
+			n += Inline0(i)
+		case 16:
+			n += Inline1(i)
+		case 32:
+			n += Inline2(i)
+		case 48:
+			n += Inline3(i)
+		case 64:
+			n += Inline4(i)
+		case 80:
+			n += Inline5(i)
+		case 96:
+			n += Inline6(i)
+		case 112:
+			n += Inline7(i)
+		case 128:
+			n += Inline8(i)
+		case 144:
+			n += Inline9(i)
+		case 160:
+			n += Inline10(i)
+		case 176:
+			n += Inline11(i)
+		case 192:
+			n += Inline12(i)
+		case 208:
+			n += Inline13(i)
+		case 224:
+			n += Inline14(i)
+		case 240:
+			n += Inline15(i)
+		case 256:
+			n += Inline16(i)
+		case 272:
+			n += Inline17(i)
+		case 288:
+			n += Inline18(i)
+		case 304:
+			n += Inline19(i)
+		case 320:
+			n += Inline20(i)
+		case 336:
+			n += Inline21(i)
+		case 352:
+			n += Inline22(i)
+		case 368:
+			n += Inline23(i)
+		case 384:
+			n += Inline24(i)
+		case 400:
+			n += Inline25(i)
+		case 416:
+			n += Inline26(i)
+		case 432:
+			n += Inline27(i)
+		case 448:
+			n += Inline28(i)
+		case 464:
+			n += Inline29(i)
+		case 480:
+			n += Inline30(i)
+		case 496:
+			n += Inline31(i)
+		case 512:
+			n += Inline32(i)
+		case 528:
+			n += Inline33(i)
+		case 544:
+			n += Inline34(i)
+		case 560:
+			n += Inline35(i)
+		case 576:
+			n += Inline36(i)
+		case 592:
+			n += Inline37(i)
+		case 608:
+			n += Inline38(i)
+		case 624:
+			n += Inline39(i)
+		case 640:
+			n += Inline40(i)
+		case 656:
+			n += Inline41(i)
+		case 672:
+			n += Inline42(i)
+		case 688:
+			n += Inline43(i)
+		case 704:
+			n += Inline44(i)
+		case 720:
+			n += Inline45(i)
+		case 736:
+			n += Inline46(i)
+		case 752:
+			n += Inline47(i)
+		case 768:
+			n += Inline48(i)
+		case 784:
+			n += Inline49(i)
+		case 800:
+			n += Inline50(i)
+		case 816:
+			n += Inline51(i)
+		case 832:
+			n += Inline52(i)
+		case 848:
+			n += Inline53(i)
+		case 864:
+			n += Inline54(i)
+		case 880:
+			n += Inline55(i)
+		case 896:
+			n += Inline56(i)
+		case 912:
+			n += Inline57(i)
+		case 928:
+			n += Inline58(i)
+		case 944:
+			n += Inline59(i)
+		case 960:
+			n += Inline60(i)
+		case 976:
+			n += Inline61(i)
+		case 992:
+			n += Inline62(i)
+		case 1008:
+			n += Inline63(i)
+		case 1024:
+			n += Inline64(i)
+		case 1040:
+			n += Inline65(i)
+		case 1056:
+			n += Inline66(i)
+		case 1072:
+			n += Inline67(i)
+		case 1088:
+			n += Inline68(i)
+		case 1104:
+			n += Inline69(i)
+		case 1120:
+			n += Inline70(i)
+		case 1136:
+			n += Inline71(i)
+		case 1152:
+			n += Inline72(i)
+		case 1168:
+			n += Inline73(i)
+		case 1184:
+			n += Inline74(i)
+		case 1200:
+			n += Inline75(i)
+		case 1216:
+			n += Inline76(i)
+		case 1232:
+			n += Inline77(i)
+		case 1248:
+			n += Inline78(i)
+		case 1264:
+			n += Inline79(i)
+		case 1280:
+			n += Inline80(i)
+		case 1296:
+			n += Inline81(i)
+		case 1312:
+			n += Inline82(i)
+		case 1328:
+			n += Inline83(i)
+		case 1344:
+			n += Inline84(i)
+		case 1360:
+			n += Inline85(i)
+		case 1376:
+			n += Inline86(i)
+		case 1392:
+			n += Inline87(i)
+		case 1408:
+			n += Inline88(i)
+		case 1424:
+			n += Inline89(i)
+		case 1440:
+			n += Inline90(i)
+		case 1456:
+			n += Inline91(i)
+		case 1472:
+			n += Inline92(i)
+		case 1488:
+			n += Inline93(i)
+		case 1504:
+			n += Inline94(i)
+		case 1520:
+			n += Inline95(i)
+		case 1536:
+			n += Inline96(i)
+		case 1552:
+			n += Inline97(i)
+		case 1568:
+			n += Inline98(i)
+		case 1584:
+			n += Inline99(i)
+		case 1600:
+			n += Inline100(i)
+		case 1616:
+			n += Inline101(i)
+		case 1632:
+			n += Inline102(i)
+		case 1648:
+			n += Inline103(i)
+		case 1664:
+			n += Inline104(i)
+		case 1680:
+			n += Inline105(i)
+		case 1696:
+			n += Inline106(i)
+		case 1712:
+			n += Inline107(i)
+		case 1728:
+			n += Inline108(i)
+		case 1744:
+			n += Inline109(i)
+		case 1760:
+			n += Inline110(i)
+		case 1776:
+			n += Inline111(i)
+		case 1792:
+			n += Inline112(i)
+		case 1808:
+			n += Inline113(i)
+		case 1824:
+			n += Inline114(i)
+		case 1840:
+			n += Inline115(i)
+		case 1856:
+			n += Inline116(i)
+		case 1872:
+			n += Inline117(i)
+		case 1888:
+			n += Inline118(i)
+		case 1904:
+			n += Inline119(i)
+		case 1920:
+			n += Inline120(i)
+		case 1936:
+			n += Inline121(i)
+		case 1952:
+			n += Inline122(i)
+		case 1968:
+			n += Inline123(i)
+		case 1984:
+			n += Inline124(i)
+		case 2000:
+			n += Inline125(i)
+		case 2016:
+			n += Inline126(i)
+		case 2032:
+			n += Inline127(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchInlineStridedLookupMod128(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysStrided[inputs[i%len(inputs)]] {
+		case 0:
+			n += Inline0(i)
+		case 16:
+			n += Inline1(i)
+		case 32:
+			n += Inline2(i)
+		case 48:
+			n += Inline3(i)
+		case 64:
+			n += Inline4(i)
+		case 80:
+			n += Inline5(i)
+		case 96:
+			n += Inline6(i)
+		case 112:
+			n += Inline7(i)
+		case 128:
+			n += Inline8(i)
+		case 144:
+			n += Inline9(i)
+		case 160:
+			n += Inline10(i)
+		case 176:
+			n += Inline11(i)
+		case 192:
+			n += Inline12(i)
+		case 208:
+			n += Inline13(i)
+		case 224:
+			n += Inline14(i)
+		case 240:
+			n += Inline15(i)
+		case 256:
+			n += Inline16(i)
+		case 272:
+			n += Inline17(i)
+		case 288:
+			n += Inline18(i)
+		case 304:
+			n += Inline19(i)
+		case 320:
+			n += Inline20(i)
+		case 336:
+			n += Inline21(i)
+		case 352:
+			n += Inline22(i)
+		case 368:
+			n += Inline23(i)
+		case 384:
+			n += Inline24(i)
+		case 400:
+			n += Inline25(i)
+		case 416:
+			n += Inline26(i)
+		case 432:
+			n += Inline27(i)
+		case 448:
+			n += Inline28(i)
+		case 464:
+			n += Inline29(i)
+		case 480:
+			n += Inline30(i)
+		case 496:
+			n += Inline31(i)
+		case 512:
+			n += Inline32(i)
+		case 528:
+			n += Inline33(i)
+		case 544:
+			n += Inline34(i)
+		case 560:
+			n += Inline35(i)
+		case 576:
+			n += Inline36(i)
+		case 592:
+			n += Inline37(i)
+		case 608:
+			n += Inline38(i)
+		case 624:
+			n += Inline39(i)
+		case 640:
+			n += Inline40(i)
+		case 656:
+			n += Inline41(i)
+		case 672:
+			n += Inline42(i)
+		case 688:
+			n += Inline43(i)
+		case 704:
+			n += Inline44(i)
+		case 720:
+			n += Inline45(i)
+		case 736:
+			n += Inline46(i)
+		case 752:
+			n += Inline47(i)
+		case 768:
+			n += Inline48(i)
+		case 784:
+			n += Inline49(i)
+		case 800:
+			n += Inline50(i)
+		case 816:
+			n += Inline51(i)
+		case 832:
+			n += Inline52(i)
+		case 848:
+			n += Inline53(i)
+		case 864:
+			n += Inline54(i)
+		case 880:
+			n += Inline55(i)
+		case 896:
+			n += Inline56(i)
+		case 912:
+			n += Inline57(i)
+		case 928:
+			n += Inline58(i)
+		case 944:
+			n += Inline59(i)
+		case 960:
+			n += Inline60(i)
+		case 976:
+			n += Inline61(i)
+		case 992:
+			n += Inline62(i)
+		case 1008:
+			n += Inline63(i)
+		case 1024:
+			n += Inline64(i)
+		case 1040:
+			n += Inline65(i)
+		case 1056:
+			n += Inline66(i)
+		case 1072:
+			n += Inline67(i)
+		case 1088:
+			n += Inline68(i)
+		case 1104:
+			n += Inline69(i)
+		case 1120:
+			n += Inline70(i)
+		case 1136:
+			n += Inline71(i)
+		case 1152:
+			n += Inline72(i)
+		case 1168:
+			n += Inline73(i)
+		case 1184:
+			n += Inline74(i)
+		case 1200:
+			n += Inline75(i)
+		case 1216:
+			n += Inline76(i)
+		case 1232:
+			n += Inline77(i)
+		case 1248:
+			n += Inline78(i)
+		case 1264:
+			n += Inline79(i)
+		case 1280:
+			n += Inline80(i)
+		case 1296:
+			n += Inline81(i)
+		case 1312:
+			n += Inline82(i)
+		case 1328:
+			n += Inline83(i)
+		case 1344:
+			n += Inline84(i)
+		case 1360:
+			n += Inline85(i)
+		case 1376:
+			n += Inline86(i)
+		case 1392:
+			n += Inline87(i)
+		case 1408:
+			n += Inline88(i)
+		case 1424:
+			n += Inline89(i)
+		case 1440:
+			n += Inline90(i)
+		case 1456:
+			n += Inline91(i)
+		case 1472:
+			n += Inline92(i)
+		case 1488:
+			n += Inline93(i)
+		case 1504:
+			n += Inline94(i)
+		case 1520:
+			n += Inline95(i)
+		case 1536:
+			n += Inline96(i)
+		case 1552:
+			n += Inline97(i)
+		case 1568:
+			n += Inline98(i)
+		case 1584:
+			n += Inline99(i)
+		case 1600:
+			n += Inline100(i)
+		case 1616:
+			n += Inline101(i)
+		case 1632:
+			n += Inline102(i)
+		case 1648:
+			n += Inline103(i)
+		case 1664:
+			n += Inline104(i)
+		case 1680:
+			n += Inline105(i)
+		case 1696:
+			n += Inline106(i)
+		case 1712:
+			n += Inline107(i)
+		case 1728:
+			n += Inline108(i)
+		case 1744:
+			n += Inline109(i)
+		case 1760:
+			n += Inline110(i)
+		case 1776:
+			n += Inline111(i)
+		case 1792:
+			n += Inline112(i)
+		case 1808:
+			n += Inline113(i)
+		case 1824:
+			n += Inline114(i)
+		case 1840:
+			n += Inline115(i)
+		case 1856:
+			n += Inline116(i)
+		case 1872:
+			n += Inline117(i)
+		case 1888:
+			n += Inline118(i)
+		case 1904:
+			n += Inline119(i)
+		case 1920:
+			n += Inline120(i)
+		case 1936:
+			n += Inline121(i)
+		case 1952:
+			n += Inline122(i)
+		case 1968:
+			n += Inline123(i)
+		case 1984:
+			n += Inline124(i)
+		case 2000:
+			n += Inline125(i)
+		case 2016:
+			n += Inline126(i)
+		case 2032:
+			n += Inline127(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchInlineStridedComputed256(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysStrided[i%256] {
+		case 0:
+			n += Inline0(i)
+		case 16:
+			n += Inline1(i)
+		case 32:
+			n += Inline2(i)
+		case 48:
+			n += Inline3(i)
+		case 64:
+			n += Inline4(i)
+		case 80:
+			n += Inline5(i)
+		case 96:
+			n += Inline6(i)
+		case 112:
+			n += Inline7(i)
+		case 128:
+			n += Inline8(i)
+		case 144:
+			n += Inline9(i)
+		case 160:
+			n += Inline10(i)
+		case 176:
+			n += Inline11(i)
+		case 192:
+			n += Inline12(i)
+		case 208:
+			n += Inline13(i)
+		case 224:
+			n += Inline14(i)
+		case 240:
+			n += Inline15(i)
+		case 256:
+			n += Inline16(i)
+		case 272:
+			n += Inline17(i)
+		case 288:
+			n += Inline18(i)
+		case 304:
+			n += Inline19(i)
+		case 320:
+			n += Inline20(i)
+		case 336:
+			n += Inline21(i)
+		case 352:
+			n += Inline22(i)
+		case 368:
+			n += Inline23(i)
+		case 384:
+			n += Inline24(i)
+		case 400:
+			n += Inline25(i)
+		case 416:
+			n += Inline26(i)
+		case 432:
+			n += Inline27(i)
+		case 448:
+			n += Inline28(i)
+		case 464:
+			n += Inline29(i)
+		case 480:
+			n += Inline30(i)
+		case 496:
+			n += Inline31(i)
+		case 512:
+			n += Inline32(i)
+		case 528:
+			n += Inline33(i)
+		case 544:
+			n += Inline34(i)
+		case 560:
+			n += Inline35(i)
+		case 576:
+			n += Inline36(i)
+		case 592:
+			n += Inline37(i)
+		case 608:
+			n += Inline38(i)
+		case 624:
+			n += Inline39(i)
+		case 640:
+			n += Inline40(i)
+		case 656:
+			n += Inline41(i)
+		case 672:
+			n += Inline42(i)
+		case 688:
+			n += Inline43(i)
+		case 704:
+			n += Inline44(i)
+		case 720:
+			n += Inline45(i)
+		case 736:
+			n += Inline46(i)
+		case 752:
+			n += Inline47(i)
+		case 768:
+			n += Inline48(i)
+		case 784:
+			n += Inline49(i)
+		case 800:
+			n += Inline50(i)
+		case 816:
+			n += Inline51(i)
+		case 832:
+			n += Inline52(i)
+		case 848:
+			n += Inline53(i)
+		case 864:
+			n += Inline54(i)
+		case 880:
+			n += Inline55(i)
+		case 896:
+			n += Inline56(i)
+		case 912:
+			n += Inline57(i)
+		case 928:
+			n += Inline58(i)
+		case 944:
+			n += Inline59(i)
+		case 960:
+			n += Inline60(i)
+		case 976:
+			n += Inline61(i)
+		case 992:
+			n += Inline62(i)
+		case 1008:
+			n += Inline63(i)
+		case 1024:
+			n += Inline64(i)
+		case 1040:
+			n += Inline65(i)
+		case 1056:
+			n += Inline66(i)
+		case 1072:
+			n += Inline67(i)
+		case 1088:
+			n += Inline68(i)
+		case 1104:
+			n += Inline69(i)
+		case 1120:
+			n += Inline70(i)
+		case 1136:
+			n += Inline71(i)
+		case 1152:
+			n += Inline72(i)
+		case 1168:
+			n += Inline73(i)
+		case 1184:
+			n += Inline74(i)
+		case 1200:
+			n += Inline75(i)
+		case 1216:
+			n += Inline76(i)
+		case 1232:
+			n += Inline77(i)
+		case 1248:
+			n += Inline78(i)
+		case 1264:
+			n += Inline79(i)
+		case 1280:
+			n += Inline80(i)
+		case 1296:
+			n += Inline81(i)
+		case 1312:
+			n += Inline82(i)
+		case 1328:
+			n += Inline83(i)
+		case 1344:
+			n += Inline84(i)
+		case 1360:
+			n += Inline85(i)
+		case 1376:
+			n += Inline86(i)
+		case 1392:
+			n += Inline87(i)
+		case 1408:
+			n += Inline88(i)
+		case 1424:
+			n += Inline89(i)
+		case 1440:
+			n += Inline90(i)
+		case 1456:
+			n += Inline91(i)
+		case 1472:
+			n += Inline92(i)
+		case 1488:
+			n += Inline93(i)
+		case 1504:
+			n += Inline94(i)
+		case 1520:
+			n += Inline95(i)
+		case 1536:
+			n += Inline96(i)
+		case 1552:
+			n += Inline97(i)
+		case 1568:
+			n += Inline98(i)
+		case 1584:
+			n += Inline99(i)
+		case 1600:
+			n += Inline100(i)
+		case 1616:
+			n += Inline101(i)
+		case 1632:
+			n += Inline102(i)
+		case 1648:
+			n += Inline103(i)
+		case 1664:
+			n += Inline104(i)
+		case 1680:
+			n += Inline105(i)
+		case 1696:
+			n += Inline106(i)
+		case 1712:
+			n += Inline107(i)
+		case 1728:
+			n += Inline108(i)
+		case 1744:
+			n += Inline109(i)
+		case 1760:
+			n += Inline110(i)
+		case 1776:
+			n += Inline111(i)
+		case 1792:
+			n += Inline112(i)
+		case 1808:
+			n += Inline113(i)
+		case 1824:
+			n += Inline114(i)
+		case 1840:
+			n += Inline115(i)
+		case 1856:
+			n += Inline116(i)
+		case 1872:
+			n += Inline117(i)
+		case 1888:
+			n += Inline118(i)
+		case 1904:
+			n += Inline119(i)
+		case 1920:
+			n += Inline120(i)
+		case 1936:
+			n += Inline121(i)
+		case 1952:
+			n += Inline122(i)
+		case 1968:
+			n += Inline123(i)
+		case 1984:
+			n += Inline124(i)
+		case 2000:
+			n += Inline125(i)
+		case 2016:
+			n += Inline126(i)
+		case 2032:
+			n += Inline127(i)
+		case 2048:
+			n += Inline128(i)
+		case 2064:
+			n += Inline129(i)
+		case 2080:
+			n += Inline130(i)
+		case 2096:
+			n += Inline131(i)
+		case 2112:
+			n += Inline132(i)
+		case 2128:
+			n += Inline133(i)
+		case 2144:
+			n += Inline134(i)
+		case 2160:
+			n += Inline135(i)
+		case 2176:
+			n += Inline136(i)
+		case 2192:
+			n += Inline137(i)
+		case 2208:
+			n += Inline138(i)
+		case 2224:
+			n += Inline139(i)
+		case 2240:
+			n += Inline140(i)
+		case 2256:
+			n += Inline141(i)
+		case 2272:
+			n += Inline142(i)
+		case 2288:
+			n += Inline143(i)
+		case 2304:
+			n += Inline144(i)
+		case 2320:
+			n += Inline145(i)
+		case 2336:
+			n += Inline146(i)
+		case 2352:
+			n += Inline147(i)
+		case 2368:
+			n += Inline148(i)
+		case 2384:
+			n += Inline149(i)
+		case 2400:
+			n += Inline150(i)
+		case 2416:
+			n += Inline151(i)
+		case 2432:
+			n += Inline152(i)
+		case 2448:
+			n += Inline153(i)
+		case 2464:
+			n += Inline154(i)
+		case 2480:
+			n += Inline155(i)
+		case 2496:
+			n += Inline156(i)
+		case 2512:
+			n += Inline157(i)
+		case 2528:
+			n += Inline158(i)
+		case 2544:
+			n += Inline159(i)
+		case 2560:
+			n += Inline160(i)
+		case 2576:
+			n += Inline161(i)
+		case 2592:
+			n += Inline162(i)
+		case 2608:
+			n += Inline163(i)
+		case 2624:
+			n += Inline164(i)
+		case 2640:
+			n += Inline165(i)
+		case 2656:
+			n += Inline166(i)
+		case 2672:
+			n += Inline167(i)
+		case 2688:
+			n += Inline168(i)
+		case 2704:
+			n += Inline169(i)
+		case 2720:
+			n += Inline170(i)
+		case 2736:
+			n += Inline171(i)
+		case 2752:
+			n += Inline172(i)
+		case 2768:
+			n += Inline173(i)
+		case 2784:
+			n += Inline174(i)
+		case 2800:
+			n += Inline175(i)
+		case 2816:
+			n += Inline176(i)
+		case 2832:
+			n += Inline177(i)
+		case 2848:
+			n += Inline178(i)
+		case 2864:
+			n += Inline179(i)
+		case 2880:
+			n += Inline180(i)
+		case 2896:
+			n += Inline181(i)
+		case 2912:
+			n += Inline182(i)
+		case 2928:
+			n += Inline183(i)
+		case 2944:
+			n += Inline184(i)
+		case 2960:
+			n += Inline185(i)
+		case 2976:
+			n += Inline186(i)
+		case 2992:
+			n += Inline187(i)
+		case 3008:
+			n += Inline188(i)
+		case 3024:
+			n += Inline189(i)
+		case 3040:
+			n += Inline190(i)
+		case 3056:
+			n += Inline191(i)
+		case 3072:
+			n += Inline192(i)
+		case 3088:
+			n += Inline193(i)
+		case 3104:
+			n += Inline194(i)
+		case 3120:
+			n += Inline195(i)
+		case 3136:
+			n += Inline196(i)
+		case 3152:
+			n += Inline197(i)
+		case 3168:
+			n += Inline198(i)
+		case 3184:
+			n += Inline199(i)
+		case 3200:
+			n += Inline200(i)
+		case 3216:
+			n += Inline201(i)
+		case 3232:
+			n += Inline202(i)
+		case 3248:
+			n += Inline203(i)
+		case 3264:
+			n += Inline204(i)
+		case 3280:
+			n += Inline205(i)
+		case 3296:
+			n += Inline206(i)
+		case 3312:
+			n += Inline207(i)
+		case 3328:
+			n += Inline208(i)
+		case 3344:
+			n += Inline209(i)
+		case 3360:
+			n += Inline210(i)
+		case 3376:
+			n += Inline211(i)
+		case 3392:
+			n += Inline212(i)
+		case 3408:
+			n += Inline213(i)
+		case 3424:
+			n += Inline214(i)
+		case 3440:
+			n += Inline215(i)
+		case 3456:
+			n += Inline216(i)
+		case 3472:
+			n += Inline217(i)
+		case 3488:
+			n += Inline218(i)
+		case 3504:
+			n += Inline219(i)
+		case 3520:
+			n += Inline220(i)
+		case 3536:
+			n += Inline221(i)
+		case 3552:
+			n += Inline222(i)
+		case 3568:
+			n += Inline223(i)
+		case 3584:
+			n += Inline224(i)
+		case 3600:
+			n += Inline225(i)
+		case 3616:
+			n += Inline226(i)
+		case 3632:
+			n += Inline227(i)
+		case 3648:
+			n += Inline228(i)
+		case 3664:
+			n += Inline229(i)
+		case 3680:
+			n += Inline230(i)
+		case 3696:
+			n += Inline231(i)
+		case 3712:
+			n += Inline232(i)
+		case 3728:
+			n += Inline233(i)
+		case 3744:
+			n += Inline234(i)
+		case 3760:
+			n += Inline235(i)
+		case 3776:
+			n += Inline236(i)
+		case 3792:
+			n += Inline237(i)
+		case 3808:
+			n += Inline238(i)
+		case 3824:
+			n += Inline239(i)
+		case 3840:
+			n += Inline240(i)
+		case 3856:
+			n += Inline241(i)
+		case 3872:
+			n += Inline242(i)
+		case 3888:
+			n += Inline243(i)
+		case 3904:
+			n += Inline244(i)
+		case 3920:
+			n += Inline245(i)
+		case 3936:
+			n += Inline246(i)
+		case 3952:
+			n += Inline247(i)
+		case 3968:
+			n += Inline248(i)
+		case 3984:
+			n += Inline249(i)
+		case 4000:
+			n += Inline250(i)
+		case 4016:
+			n += Inline251(i)
+		case 4032:
+			n += Inline252(i)
+		case 4048:
+			n += Inline253(i)
+		case 4064:
+			n += Inline254(i)
+		case 4080:
+			n += Inline255(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchInlineStridedLookupMod256(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysStrided[inputs[i%len(inputs)]] {
+		case 0:
+			n += Inline0(i)
+		case 16:
+			n += Inline1(i)
+		case 32:
+			n += Inline2(i)
+		case 48:
+			n += Inline3(i)
+		case 64:
+			n += Inline4(i)
+		case 80:
+			n += Inline5(i)
+		case 96:
+			n += Inline6(i)
+		case 112:
+			n += Inline7(i)
+		case 128:
+			n += Inline8(i)
+		case 144:
+			n += Inline9(i)
+		case 160:
+			n += Inline10(i)
+		case 176:
+			n += Inline11(i)
+		case 192:
+			n += Inline12(i)
+		case 208:
+			n += Inline13(i)
+		case 224:
+			n += Inline14(i)
+		case 240:
+			n += Inline15(i)
+		case 256:
+			n += Inline16(i)
+		case 272:
+			n += Inline17(i)
+		case 288:
+			n += Inline18(i)
+		case 304:
+			n += Inline19(i)
+		case 320:
+			n += Inline20(i)
+		case 336:
+			n += Inline21(i)
+		case 352:
+			n += Inline22(i)
+		case 368:
+			n += Inline23(i)
+		case 384:
+			n += Inline24(i)
+		case 400:
+			n += Inline25(i)
+		case 416:
+			n += Inline26(i)
+		case 432:
+			n += Inline27(i)
+		case 448:
+			n += Inline28(i)
+		case 464:
+			n += Inline29(i)
+		case 480:
+			n += Inline30(i)
+		case 496:
+			n += Inline31(i)
+		case 512:
+			n += Inline32(i)
+		case 528:
+			n += Inline33(i)
+		case 544:
+			n += Inline34(i)
+		case 560:
+			n += Inline35(i)
+		case 576:
+			n += Inline36(i)
+		case 592:
+			n += Inline37(i)
+		case 608:
+			n += Inline38(i)
+		case 624:
+			n += Inline39(i)
+		case 640:
+			n += Inline40(i)
+		case 656:
+			n += Inline41(i)
+		case 672:
+			n += Inline42(i)
+		case 688:
+			n += Inline43(i)
+		case 704:
+			n += Inline44(i)
+		case 720:
+			n += Inline45(i)
+		case 736:
+			n += Inline46(i)
+		case 752:
+			n += Inline47(i)
+		case 768:
+			n += Inline48(i)
+		case 784:
+			n += Inline49(i)
+		case 800:
+			n += Inline50(i)
+		case 816:
+			n += Inline51(i)
+		case 832:
+			n += Inline52(i)
+		case 848:
+			n += Inline53(i)
+		case 864:
+			n += Inline54(i)
+		case 880:
+			n += Inline55(i)
+		case 896:
+			n += Inline56(i)
+		case 912:
+			n += Inline57(i)
+		case 928:
+			n += Inline58(i)
+		case 944:
+			n += Inline59(i)
+		case 960:
+			n += Inline60(i)
+		case 976:
+			n += Inline61(i)
+		case 992:
+			n += Inline62(i)
+		case 1008:
+			n += Inline63(i)
+		case 1024:
+			n += Inline64(i)
+		case 1040:
+			n += Inline65(i)
+		case 1056:
+			n += Inline66(i)
+		case 1072:
+			n += Inline67(i)
+		case 1088:
+			n += Inline68(i)
+		case 1104:
+			n += Inline69(i)
+		case 1120:
+			n += Inline70(i)
+		case 1136:
+			n += Inline71(i)
+		case 1152:
+			n += Inline72(i)
+		case 1168:
+			n += Inline73(i)
+		case 1184:
+			n += Inline74(i)
+		case 1200:
+			n += Inline75(i)
+		case 1216:
+			n += Inline76(i)
+		case 1232:
+			n += Inline77(i)
+		case 1248:
+			n += Inline78(i)
+		case 1264:
+			n += Inline79(i)
+		case 1280:
+			n += Inline80(i)
+		case 1296:
+			n += Inline81(i)
+		case 1312:
+			n += Inline82(i)
+		case 1328:
+			n += Inline83(i)
+		case 1344:
+			n += Inline84(i)
+		case 1360:
+			n += Inline85(i)
+		case 1376:
+			n += Inline86(i)
+		case 1392:
+			n += Inline87(i)
+		case 1408:
+			n += Inline88(i)
+		case 1424:
+			n += Inline89(i)
+		case 1440:
+			n += Inline90(i)
+		case 1456:
+			n += Inline91(i)
+		case 1472:
+			n += Inline92(i)
+		case 1488:
+			n += Inline93(i)
+		case 1504:
+			n += Inline94(i)
+		case 1520:
+			n += Inline95(i)
+		case 1536:
+			n += Inline96(i)
+		case 1552:
+			n += Inline97(i)
+		case 1568:
+			n += Inline98(i)
+		case 1584:
+			n += Inline99(i)
+		case 1600:
+			n += Inline100(i)
+		case 1616:
+			n += Inline101(i)
+		case 1632:
+			n += Inline102(i)
+		case 1648:
+			n += Inline103(i)
+		case 1664:
+			n += Inline104(i)
+		case 1680:
+			n += Inline105(i)
+		case 1696:
+			n += Inline106(i)
+		case 1712:
+			n += Inline107(i)
+		case 1728:
+			n += Inline108(i)
+		case 1744:
+			n += Inline109(i)
+		case 1760:
+			n += Inline110(i)
+		case 1776:
+			n += Inline111(i)
+		case 1792:
+			n += Inline112(i)
+		case 1808:
+			n += Inline113(i)
+		case 1824:
+			n += Inline114(i)
+		case 1840:
+			n += Inline115(i)
+		case 1856:
+			n += Inline116(i)
+		case 1872:
+			n += Inline117(i)
+		case 1888:
+			n += Inline118(i)
+		case 1904:
+			n += Inline119(i)
+		case 1920:
+			n += Inline120(i)
+		case 1936:
+			n += Inline121(i)
+		case 1952:
+			n += Inline122(i)
+		case 1968:
+			n += Inline123(i)
+		case 1984:
+			n += Inline124(i)
+		case 2000:
+			n += Inline125(i)
+		case 2016:
+			n += Inline126(i)
+		case 2032:
+			n += Inline127(i)
+		case 2048:
+			n += Inline128(i)
+		case 2064:
+			n += Inline129(i)
+		case 2080:
+			n += Inline130(i)
+		case 2096:
+			n += Inline131(i)
+		case 2112:
+			n += Inline132(i)
+		case 2128:
+			n += Inline133(i)
+		case 2144:
+			n += Inline134(i)
+		case 2160:
+			n += Inline135(i)
+		case 2176:
+			n += Inline136(i)
+		case 2192:
+			n += Inline137(i)
+		case 2208:
+			n += Inline138(i)
+		case 2224:
+			n += Inline139(i)
+		case 2240:
+			n += Inline140(i)
+		case 2256:
+			n += Inline141(i)
+		case 2272:
+			n += Inline142(i)
+		case 2288:
+			n += Inline143(i)
+		case 2304:
+			n += Inline144(i)
+		case 2320:
+			n += Inline145(i)
+		case 2336:
+			n += Inline146(i)
+		case 2352:
+			n += Inline147(i)
+		case 2368:
+			n += Inline148(i)
+		case 2384:
+			n += Inline149(i)
+		case 2400:
+			n += Inline150(i)
+		case 2416:
+			n += Inline151(i)
+		case 2432:
+			n += Inline152(i)
+		case 2448:
+			n += Inline153(i)
+		case 2464:
+			n += Inline154(i)
+		case 2480:
+			n += Inline155(i)
+		case 2496:
+			n += Inline156(i)
+		case 2512:
+			n += Inline157(i)
+		case 2528:
+			n += Inline158(i)
+		case 2544:
+			n += Inline159(i)
+		case 2560:
+			n += Inline160(i)
+		case 2576:
+			n += Inline161(i)
+		case 2592:
+			n += Inline162(i)
+		case 2608:
+			n += Inline163(i)
+		case 2624:
+			n += Inline164(i)
+		case 2640:
+			n += Inline165(i)
+		case 2656:
+			n += Inline166(i)
+		case 2672:
+			n += Inline167(i)
+		case 2688:
+			n += Inline168(i)
+		case 2704:
+			n += Inline169(i)
+		case 2720:
+			n += Inline170(i)
+		case 2736:
+			n += Inline171(i)
+		case 2752:
+			n += Inline172(i)
+		case 2768:
+			n += Inline173(i)
+		case 2784:
+			n += Inline174(i)
+		case 2800:
+			n += Inline175(i)
+		case 2816:
+			n += Inline176(i)
+		case 2832:
+			n += Inline177(i)
+		case 2848:
+			n += Inline178(i)
+		case 2864:
+			n += Inline179(i)
+		case 2880:
+			n += Inline180(i)
+		case 2896:
+			n += Inline181(i)
+		case 2912:
+			n += Inline182(i)
+		case 2928:
+			n += Inline183(i)
+		case 2944:
+			n += Inline184(i)
+		case 2960:
+			n += Inline185(i)
+		case 2976:
+			n += Inline186(i)
+		case 2992:
+			n += Inline187(i)
+		case 3008:
+			n += Inline188(i)
+		case 3024:
+			n += Inline189(i)
+		case 3040:
+			n += Inline190(i)
+		case 3056:
+			n += Inline191(i)
+		case 3072:
+			n += Inline192(i)
+		case 3088:
+			n += Inline193(i)
+		case 3104:
+			n += Inline194(i)
+		case 3120:
+			n += Inline195(i)
+		case 3136:
+			n += Inline196(i)
+		case 3152:
+			n += Inline197(i)
+		case 3168:
+			n += Inline198(i)
+		case 3184:
+			n += Inline199(i)
+		case 3200:
+			n += Inline200(i)
+		case 3216:
+			n += Inline201(i)
+		case 3232:
+			n += Inline202(i)
+		case 3248:
+			n += Inline203(i)
+		case 3264:
+			n += Inline204(i)
+		case 3280:
+			n += Inline205(i)
+		case 3296:
+			n += Inline206(i)
+		case 3312:
+			n += Inline207(i)
+		case 3328:
+			n += Inline208(i)
+		case 3344:
+			n += Inline209(i)
+		case 3360:
+			n += Inline210(i)
+		case 3376:
+			n += Inline211(i)
+		case 3392:
+			n += Inline212(i)
+		case 3408:
+			n += Inline213(i)
+		case 3424:
+			n += Inline214(i)
+		case 3440:
+			n += Inline215(i)
+		case 3456:
+			n += Inline216(i)
+		case 3472:
+			n += Inline217(i)
+		case 3488:
+			n += Inline218(i)
+		case 3504:
+			n += Inline219(i)
+		case 3520:
+			n += Inline220(i)
+		case 3536:
+			n += Inline221(i)
+		case 3552:
+			n += Inline222(i)
+		case 3568:
+			n += Inline223(i)
+		case 3584:
+			n += Inline224(i)
+		case 3600:
+			n += Inline225(i)
+		case 3616:
+			n += Inline226(i)
+		case 3632:
+			n += Inline227(i)
+		case 3648:
+			n += Inline228(i)
+		case 3664:
+			n += Inline229(i)
+		case 3680:
+			n += Inline230(i)
+		case 3696:
+			n += Inline231(i)
+		case 3712:
+			n += Inline232(i)
+		case 3728:
+			n += Inline233(i)
+		case 3744:
+			n += Inline234(i)
+		case 3760:
+			n += Inline235(i)
+		case 3776:
+			n += Inline236(i)
+		case 3792:
+			n += Inline237(i)
+		case 3808:
+			n += Inline238(i)
+		case 3824:
+			n += Inline239(i)
+		case 3840:
+			n += Inline240(i)
+		case 3856:
+			n += Inline241(i)
+		case 3872:
+			n += Inline242(i)
+		case 3888:
+			n += Inline243(i)
+		case 3904:
+			n += Inline244(i)
+		case 3920:
+			n += Inline245(i)
+		case 3936:
+			n += Inline246(i)
+		case 3952:
+			n += Inline247(i)
+		case 3968:
+			n += Inline248(i)
+		case 3984:
+			n += Inline249(i)
+		case 4000:
+			n += Inline250(i)
+		case 4016:
+			n += Inline251(i)
+		case 4032:
+			n += Inline252(i)
+		case 4048:
+			n += Inline253(i)
+		case 4064:
+			n += Inline254(i)
+		case 4080:
+			n += Inline255(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchInlineStridedComputed512(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysStrided[i%512] {
+		case 0:
+			n += Inline0(i)
+		case 16:
+			n += Inline1(i)
+		case 32:
+			n += Inline2(i)
+		case 48:
+			n += Inline3(i)
+		case 64:
+			n += Inline4(i)
+		case 80:
+			n += Inline5(i)
+		case 96:
+			n += Inline6(i)
+		case 112:
+			n += Inline7(i)
+		case 128:
+			n += Inline8(i)
+		case 144:
+			n += Inline9(i)
+		case 160:
+			n += Inline10(i)
+		case 176:
+			n += Inline11(i)
+		case 192:
+			n += Inline12(i)
+		case 208:
+			n += Inline13(i)
+		case 224:
+			n += Inline14(i)
+		case 240:
+			n += Inline15(i)
+		case 256:
+			n += Inline16(i)
+		case 272:
+			n += Inline17(i)
+		case 288:
+			n += Inline18(i)
+		case 304:
+			n += Inline19(i)
+		case 320:
+			n += Inline20(i)
+		case 336:
+			n += Inline21(i)
+		case 352:
+			n += Inline22(i)
+		case 368:
+			n += Inline23(i)
+		case 384:
+			n += Inline24(i)
+		case 400:
+			n += Inline25(i)
+		case 416:
+			n += Inline26(i)
+		case 432:
+			n += Inline27(i)
+		case 448:
+			n += Inline28(i)
+		case 464:
+			n += Inline29(i)
+		case 480:
+			n += Inline30(i)
+		case 496:
+			n += Inline31(i)
+		case 512:
+			n += Inline32(i)
+		case 528:
+			n += Inline33(i)
+		case 544:
+			n += Inline34(i)
+		case 560:
+			n += Inline35(i)
+		case 576:
+			n += Inline36(i)
+		case 592:
+			n += Inline37(i)
+		case 608:
+			n += Inline38(i)
+		case 624:
+			n += Inline39(i)
+		case 640:
+			n += Inline40(i)
+		case 656:
+			n += Inline41(i)
+		case 672:
+			n += Inline42(i)
+		case 688:
+			n += Inline43(i)
+		case 704:
+			n += Inline44(i)
+		case 720:
+			n += Inline45(i)
+		case 736:
+			n += Inline46(i)
+		case 752:
+			n += Inline47(i)
+		case 768:
+			n += Inline48(i)
+		case 784:
+			n += Inline49(i)
+		case 800:
+			n += Inline50(i)
+		case 816:
+			n += Inline51(i)
+		case 832:
+			n += Inline52(i)
+		case 848:
+			n += Inline53(i)
+		case 864:
+			n += Inline54(i)
+		case 880:
+			n += Inline55(i)
+		case 896:
+			n += Inline56(i)
+		case 912:
+			n += Inline57(i)
+		case 928:
+			n += Inline58(i)
+		case 944:
+			n += Inline59(i)
+		case 960:
+			n += Inline60(i)
+		case 976:
+			n += Inline61(i)
+		case 992:
+			n += Inline62(i)
+		case 1008:
+			n += Inline63(i)
+		case 1024:
+			n += Inline64(i)
+		case 1040:
+			n += Inline65(i)
+		case 1056:
+			n += Inline66(i)
+		case 1072:
+			n += Inline67(i)
+		case 1088:
+			n += Inline68(i)
+		case 1104:
+			n += Inline69(i)
+		case 1120:
+			n += Inline70(i)
+		case 1136:
+			n += Inline71(i)
+		case 1152:
+			n += Inline72(i)
+		case 1168:
+			n += Inline73(i)
+		case 1184:
+			n += Inline74(i)
+		case 1200:
+			n += Inline75(i)
+		case 1216:
+			n += Inline76(i)
+		case 1232:
+			n += Inline77(i)
+		case 1248:
+			n += Inline78(i)
+		case 1264:
+			n += Inline79(i)
+		case 1280:
+			n += Inline80(i)
+		case 1296:
+			n += Inline81(i)
+		case 1312:
+			n += Inline82(i)
+		case 1328:
+			n += Inline83(i)
+		case 1344:
+			n += Inline84(i)
+		case 1360:
+			n += Inline85(i)
+		case 1376:
+			n += Inline86(i)
+		case 1392:
+			n += Inline87(i)
+		case 1408:
+			n += Inline88(i)
+		case 1424:
+			n += Inline89(i)
+		case 1440:
+			n += Inline90(i)
+		case 1456:
+			n += Inline91(i)
+		case 1472:
+			n += Inline92(i)
+		case 1488:
+			n += Inline93(i)
+		case 1504:
+			n += Inline94(i)
+		case 1520:
+			n += Inline95(i)
+		case 1536:
+			n += Inline96(i)
+		case 1552:
+			n += Inline97(i)
+		case 1568:
+			n += Inline98(i)
+		case 1584:
+			n += Inline99(i)
+		case 1600:
+			n += Inline100(i)
+		case 1616:
+			n += Inline101(i)
+		case 1632:
+			n += Inline102(i)
+		case 1648:
+			n += Inline103(i)
+		case 1664:
+			n += Inline104(i)
+		case 1680:
+			n += Inline105(i)
+		case 1696:
+			n += Inline106(i)
+		case 1712:
+			n += Inline107(i)
+		case 1728:
+			n += Inline108(i)
+		case 1744:
+			n += Inline109(i)
+		case 1760:
+			n += Inline110(i)
+		case 1776:
+			n += Inline111(i)
+		case 1792:
+			n += Inline112(i)
+		case 1808:
+			n += Inline113(i)
+		case 1824:
+			n += Inline114(i)
+		case 1840:
+			n += Inline115(i)
+		case 1856:
+			n += Inline116(i)
+		case 1872:
+			n += Inline117(i)
+		case 1888:
+			n += Inline118(i)
+		case 1904:
+			n += Inline119(i)
+		case 1920:
+			n += Inline120(i)
+		case 1936:
+			n += Inline121(i)
+		case 1952:
+			n += Inline122(i)
+		case 1968:
+			n += Inline123(i)
+		case 1984:
+			n += Inline124(i)
+		case 2000:
+			n += Inline125(i)
+		case 2016:
+			n += Inline126(i)
+		case 2032:
+			n += Inline127(i)
+		case 2048:
+			n += Inline128(i)
+		case 2064:
+			n += Inline129(i)
+		case 2080:
+			n += Inline130(i)
+		case 2096:
+			n += Inline131(i)
+		case 2112:
+			n += Inline132(i)
+		case 2128:
+			n += Inline133(i)
+		case 2144:
+			n += Inline134(i)
+		case 2160:
+			n += Inline135(i)
+		case 2176:
+			n += Inline136(i)
+		case 2192:
+			n += Inline137(i)
+		case 2208:
+			n += Inline138(i)
+		case 2224:
+			n += Inline139(i)
+		case 2240:
+			n += Inline140(i)
+		case 2256:
+			n += Inline141(i)
+		case 2272:
+			n += Inline142(i)
+		case 2288:
+			n += Inline143(i)
+		case 2304:
+			n += Inline144(i)
+		case 2320:
+			n += Inline145(i)
+		case 2336:
+			n += Inline146(i)
+		case 2352:
+			n += Inline147(i)
+		case 2368:
+			n += Inline148(i)
+		case 2384:
+			n += Inline149(i)
+		case 2400:
+			n += Inline150(i)
+		case 2416:
+			n += Inline151(i)
+		case 2432:
+			n += Inline152(i)
+		case 2448:
+			n += Inline153(i)
+		case 2464:
+			n += Inline154(i)
+		case 2480:
+			n += Inline155(i)
+		case 2496:
+			n += Inline156(i)
+		case 2512:
+			n += Inline157(i)
+		case 2528:
+			n += Inline158(i)
+		case 2544:
+			n += Inline159(i)
+		case 2560:
+			n += Inline160(i)
+		case 2576:
+			n += Inline161(i)
+		case 2592:
+			n += Inline162(i)
+		case 2608:
+			n += Inline163(i)
+		case 2624:
+			n += Inline164(i)
+		case 2640:
+			n += Inline165(i)
+		case 2656:
+			n += Inline166(i)
+		case 2672:
+			n += Inline167(i)
+		case 2688:
+			n += Inline168(i)
+		case 2704:
+			n += Inline169(i)
+		case 2720:
+			n += Inline170(i)
+		case 2736:
+			n += Inline171(i)
+		case 2752:
+			n += Inline172(i)
+		case 2768:
+			n += Inline173(i)
+		case 2784:
+			n += Inline174(i)
+		case 2800:
+			n += Inline175(i)
+		case 2816:
+			n += Inline176(i)
+		case 2832:
+			n += Inline177(i)
+		case 2848:
+			n += Inline178(i)
+		case 2864:
+			n += Inline179(i)
+		case 2880:
+			n += Inline180(i)
+		case 2896:
+			n += Inline181(i)
+		case 2912:
+			n += Inline182(i)
+		case 2928:
+			n += Inline183(i)
+		case 2944:
+			n += Inline184(i)
+		case 2960:
+			n += Inline185(i)
+		case 2976:
+			n += Inline186(i)
+		case 2992:
+			n += Inline187(i)
+		case 3008:
+			n += Inline188(i)
+		case 3024:
+			n += Inline189(i)
+		case 3040:
+			n += Inline190(i)
+		case 3056:
+			n += Inline191(i)
+		case 3072:
+			n += Inline192(i)
+		case 3088:
+			n += Inline193(i)
+		case 3104:
+			n += Inline194(i)
+		case 3120:
+			n += Inline195(i)
+		case 3136:
+			n += Inline196(i)
+		case 3152:
+			n += Inline197(i)
+		case 3168:
+			n += Inline198(i)
+		case 3184:
+			n += Inline199(i)
+		case 3200:
+			n += Inline200(i)
+		case 3216:
+			n += Inline201(i)
+		case 3232:
+			n += Inline202(i)
+		case 3248:
+			n += Inline203(i)
+		case 3264:
+			n += Inline204(i)
+		case 3280:
+			n += Inline205(i)
+		case 3296:
+			n += Inline206(i)
+		case 3312:
+			n += Inline207(i)
+		case 3328:
+			n += Inline208(i)
+		case 3344:
+			n += Inline209(i)
+		case 3360:
+			n += Inline210(i)
+		case 3376:
+			n += Inline211(i)
+		case 3392:
+			n += Inline212(i)
+		case 3408:
+			n += Inline213(i)
+		case 3424:
+			n += Inline214(i)
+		case 3440:
+			n += Inline215(i)
+		case 3456:
+			n += Inline216(i)
+		case 3472:
+			n += Inline217(i)
+		case 3488:
+			n += Inline218(i)
+		case 3504:
+			n += Inline219(i)
+		case 3520:
+			n += Inline220(i)
+		case 3536:
+			n += Inline221(i)
+		case 3552:
+			n += Inline222(i)
+		case 3568:
+			n += Inline223(i)
+		case 3584:
+			n += Inline224(i)
+		case 3600:
+			n += Inline225(i)
+		case 3616:
+			n += Inline226(i)
+		case 3632:
+			n += Inline227(i)
+		case 3648:
+			n += Inline228(i)
+		case 3664:
+			n += Inline229(i)
+		case 3680:
+			n += Inline230(i)
+		case 3696:
+			n += Inline231(i)
+		case 3712:
+			n += Inline232(i)
+		case 3728:
+			n += Inline233(i)
+		case 3744:
+			n += Inline234(i)
+		case 3760:
+			n += Inline235(i)
+		case 3776:
+			n += Inline236(i)
+		case 3792:
+			n += Inline237(i)
+		case 3808:
+			n += Inline238(i)
+		case 3824:
+			n += Inline239(i)
+		case 3840:
+			n += Inline240(i)
+		case 3856:
+			n += Inline241(i)
+		case 3872:
+			n += Inline242(i)
+		case 3888:
+			n += Inline243(i)
+		case 3904:
+			n += Inline244(i)
+		case 3920:
+			n += Inline245(i)
+		case 3936:
+			n += Inline246(i)
+		case 3952:
+			n += Inline247(i)
+		case 3968:
+			n += Inline248(i)
+		case 3984:
+			n += Inline249(i)
+		case 4000:
+			n += Inline250(i)
+		case 4016:
+			n += Inline251(i)
+		case 4032:
+			n += Inline252(i)
+		case 4048:
+			n += Inline253(i)
+		case 4064:
+			n += Inline254(i)
+		case 4080:
+			n += Inline255(i)
+		case 4096:
+			n += Inline256(i)
+		case 4112:
+			n += Inline257(i)
+		case 4128:
+			n += Inline258(i)
+		case 4144:
+			n += Inline259(i)
+		case 4160:
+			n += Inline260(i)
+		case 4176:
+			n += Inline261(i)
+		case 4192:
+			n += Inline262(i)
+		case 4208:
+			n += Inline263(i)
+		case 4224:
+			n += Inline264(i)
+		case 4240:
+			n += Inline265(i)
+		case 4256:
+			n += Inline266(i)
+		case 4272:
+			n += Inline267(i)
+		case 4288:
+			n += Inline268(i)
+		case 4304:
+			n += Inline269(i)
+		case 4320:
+			n += Inline270(i)
+		case 4336:
+			n += Inline271(i)
+		case 4352:
+			n += Inline272(i)
+		case 4368:
+			n += Inline273(i)
+		case 4384:
+			n += Inline274(i)
+		case 4400:
+			n += Inline275(i)
+		case 4416:
+			n += Inline276(i)
+		case 4432:
+			n += Inline277(i)
+		case 4448:
+			n += Inline278(i)
+		case 4464:
+			n += Inline279(i)
+		case 4480:
+			n += Inline280(i)
+		case 4496:
+			n += Inline281(i)
+		case 4512:
+			n += Inline282(i)
+		case 4528:
+			n += Inline283(i)
+		case 4544:
+			n += Inline284(i)
+		case 4560:
+			n += Inline285(i)
+		case 4576:
+			n += Inline286(i)
+		case 4592:
+			n += Inline287(i)
+		case 4608:
+			n += Inline288(i)
+		case 4624:
+			n += Inline289(i)
+		case 4640:
+			n += Inline290(i)
+		case 4656:
+			n += Inline291(i)
+		case 4672:
+			n += Inline292(i)
+		case 4688:
+			n += Inline293(i)
+		case 4704:
+			n += Inline294(i)
+		case 4720:
+			n += Inline295(i)
+		case 4736:
+			n += Inline296(i)
+		case 4752:
+			n += Inline297(i)
+		case 4768:
+			n += Inline298(i)
+		case 4784:
+			n += Inline299(i)
+		case 4800:
+			n += Inline300(i)
+		case 4816:
+			n += Inline301(i)
+		case 4832:
+			n += Inline302(i)
+		case 4848:
+			n += Inline303(i)
+		case 4864:
+			n += Inline304(i)
+		case 4880:
+			n += Inline305(i)
+		case 4896:
+			n += Inline306(i)
+		case 4912:
+			n += Inline307(i)
+		case 4928:
+			n += Inline308(i)
+		case 4944:
+			n += Inline309(i)
+		case 4960:
+			n += Inline310(i)
+		case 4976:
+			n += Inline311(i)
+		case 4992:
+			n += Inline312(i)
+		case 5008:
+			n += Inline313(i)
+		case 5024:
+			n += Inline314(i)
+		case 5040:
+			n += Inline315(i)
+		case 5056:
+			n += Inline316(i)
+		case 5072:
+			n += Inline317(i)
+		case 5088:
+			n += Inline318(i)
+		case 5104:
+			n += Inline319(i)
+		case 5120:
+			n += Inline320(i)
+		case 5136:
+			n += Inline321(i)
+		case 5152:
+			n += Inline322(i)
+		case 5168:
+			n += Inline323(i)
+		case 5184:
+			n += Inline324(i)
+		case 5200:
+			n += Inline325(i)
+		case 5216:
+			n += Inline326(i)
+		case 5232:
+			n += Inline327(i)
+		case 5248:
+			n += Inline328(i)
+		case 5264:
+			n += Inline329(i)
+		case 5280:
+			n += Inline330(i)
+		case 5296:
+			n += Inline331(i)
+		case 5312:
+			n += Inline332(i)
+		case 5328:
+			n += Inline333(i)
+		case 5344:
+			n += Inline334(i)
+		case 5360:
+			n += Inline335(i)
+		case 5376:
+			n += Inline336(i)
+		case 5392:
+			n += Inline337(i)
+		case 5408:
+			n += Inline338(i)
+		case 5424:
+			n += Inline339(i)
+		case 5440:
+			n += Inline340(i)
+		case 5456:
+			n += Inline341(i)
+		case 5472:
+			n += Inline342(i)
+		case 5488:
+			n += Inline343(i)
+		case 5504:
+			n += Inline344(i)
+		case 5520:
+			n += Inline345(i)
+		case 5536:
+			n += Inline346(i)
+		case 5552:
+			n += Inline347(i)
+		case 5568:
+			n += Inline348(i)
+		case 5584:
+			n += Inline349(i)
+		case 5600:
+			n += Inline350(i)
+		case 5616:
+			n += Inline351(i)
+		case 5632:
+			n += Inline352(i)
+		case 5648:
+			n += Inline353(i)
+		case 5664:
+			n += Inline354(i)
+		case 5680:
+			n += Inline355(i)
+		case 5696:
+			n += Inline356(i)
+		case 5712:
+			n += Inline357(i)
+		case 5728:
+			n += Inline358(i)
+		case 5744:
+			n += Inline359(i)
+		case 5760:
+			n += Inline360(i)
+		case 5776:
+			n += Inline361(i)
+		case 5792:
+			n += Inline362(i)
+		case 5808:
+			n += Inline363(i)
+		case 5824:
+			n += Inline364(i)
+		case 5840:
+			n += Inline365(i)
+		case 5856:
+			n += Inline366(i)
+		case 5872:
+			n += Inline367(i)
+		case 5888:
+			n += Inline368(i)
+		case 5904:
+			n += Inline369(i)
+		case 5920:
+			n += Inline370(i)
+		case 5936:
+			n += Inline371(i)
+		case 5952:
+			n += Inline372(i)
+		case 5968:
+			n += Inline373(i)
+		case 5984:
+			n += Inline374(i)
+		case 6000:
+			n += Inline375(i)
+		case 6016:
+			n += Inline376(i)
+		case 6032:
+			n += Inline377(i)
+		case 6048:
+			n += Inline378(i)
+		case 6064:
+			n += Inline379(i)
+		case 6080:
+			n += Inline380(i)
+		case 6096:
+			n += Inline381(i)
+		case 6112:
+			n += Inline382(i)
+		case 6128:
+			n += Inline383(i)
+		case 6144:
+			n += Inline384(i)
+		case 6160:
+			n += Inline385(i)
+		case 6176:
+			n += Inline386(i)
+		case 6192:
+			n += Inline387(i)
+		case 6208:
+			n += Inline388(i)
+		case 6224:
+			n += Inline389(i)
+		case 6240:
+			n += Inline390(i)
+		case 6256:
+			n += Inline391(i)
+		case 6272:
+			n += Inline392(i)
+		case 6288:
+			n += Inline393(i)
+		case 6304:
+			n += Inline394(i)
+		case 6320:
+			n += Inline395(i)
+		case 6336:
+			n += Inline396(i)
+		case 6352:
+			n += Inline397(i)
+		case 6368:
+			n += Inline398(i)
+		case 6384:
+			n += Inline399(i)
+		case 6400:
+			n += Inline400(i)
+		case 6416:
+			n += Inline401(i)
+		case 6432:
+			n += Inline402(i)
+		case 6448:
+			n += Inline403(i)
+		case 6464:
+			n += Inline404(i)
+		case 6480:
+			n += Inline405(i)
+		case 6496:
+			n += Inline406(i)
+		case 6512:
+			n += Inline407(i)
+		case 6528:
+			n += Inline408(i)
+		case 6544:
+			n += Inline409(i)
+		case 6560:
+			n += Inline410(i)
+		case 6576:
+			n += Inline411(i)
+		case 6592:
+			n += Inline412(i)
+		case 6608:
+			n += Inline413(i)
+		case 6624:
+			n += Inline414(i)
+		case 6640:
+			n += Inline415(i)
+		case 6656:
+			n += Inline416(i)
+		case 6672:
+			n += Inline417(i)
+		case 6688:
+			n += Inline418(i)
+		case 6704:
+			n += Inline419(i)
+		case 6720:
+			n += Inline420(i)
+		case 6736:
+			n += Inline421(i)
+		case 6752:
+			n += Inline422(i)
+		case 6768:
+			n += Inline423(i)
+		case 6784:
+			n += Inline424(i)
+		case 6800:
+			n += Inline425(i)
+		case 6816:
+			n += Inline426(i)
+		case 6832:
+			n += Inline427(i)
+		case 6848:
+			n += Inline428(i)
+		case 6864:
+			n += Inline429(i)
+		case 6880:
+			n += Inline430(i)
+		case 6896:
+			n += Inline431(i)
+		case 6912:
+			n += Inline432(i)
+		case 6928:
+			n += Inline433(i)
+		case 6944:
+			n += Inline434(i)
+		case 6960:
+			n += Inline435(i)
+		case 6976:
+			n += Inline436(i)
+		case 6992:
+			n += Inline437(i)
+		case 7008:
+			n += Inline438(i)
+		case 7024:
+			n += Inline439(i)
+		case 7040:
+			n += Inline440(i)
+		case 7056:
+			n += Inline441(i)
+		case 7072:
+			n += Inline442(i)
+		case 7088:
+			n += Inline443(i)
+		case 7104:
+			n += Inline444(i)
+		case 7120:
+			n += Inline445(i)
+		case 7136:
+			n += Inline446(i)
+		case 7152:
+			n += Inline447(i)
+		case 7168:
+			n += Inline448(i)
+		case 7184:
+			n += Inline449(i)
+		case 7200:
+			n += Inline450(i)
+		case 7216:
+			n += Inline451(i)
+		case 7232:
+			n += Inline452(i)
+		case 7248:
+			n += Inline453(i)
+		case 7264:
+			n += Inline454(i)
+		case 7280:
+			n += Inline455(i)
+		case 7296:
+			n += Inline456(i)
+		case 7312:
+			n += Inline457(i)
+		case 7328:
+			n += Inline458(i)
+		case 7344:
+			n += Inline459(i)
+		case 7360:
+			n += Inline460(i)
+		case 7376:
+			n += Inline461(i)
+		case 7392:
+			n += Inline462(i)
+		case 7408:
+			n += Inline463(i)
+		case 7424:
+			n += Inline464(i)
+		case 7440:
+			n += Inline465(i)
+		case 7456:
+			n += Inline466(i)
+		case 7472:
+			n += Inline467(i)
+		case 7488:
+			n += Inline468(i)
+		case 7504:
+			n += Inline469(i)
+		case 7520:
+			n += Inline470(i)
+		case 7536:
+			n += Inline471(i)
+		case 7552:
+			n += Inline472(i)
+		case 7568:
+			n += Inline473(i)
+		case 7584:
+			n += Inline474(i)
+		case 7600:
+			n += Inline475(i)
+		case 7616:
+			n += Inline476(i)
+		case 7632:
+			n += Inline477(i)
+		case 7648:
+			n += Inline478(i)
+		case 7664:
+			n += Inline479(i)
+		case 7680:
+			n += Inline480(i)
+		case 7696:
+			n += Inline481(i)
+		case 7712:
+			n += Inline482(i)
+		case 7728:
+			n += Inline483(i)
+		case 7744:
+			n += Inline484(i)
+		case 7760:
+			n += Inline485(i)
+		case 7776:
+			n += Inline486(i)
+		case 7792:
+			n += Inline487(i)
+		case 7808:
+			n += Inline488(i)
+		case 7824:
+			n += Inline489(i)
+		case 7840:
+			n += Inline490(i)
+		case 7856:
+			n += Inline491(i)
+		case 7872:
+			n += Inline492(i)
+		case 7888:
+			n += Inline493(i)
+		case 7904:
+			n += Inline494(i)
+		case 7920:
+			n += Inline495(i)
+		case 7936:
+			n += Inline496(i)
+		case 7952:
+			n += Inline497(i)
+		case 7968:
+			n += Inline498(i)
+		case 7984:
+			n += Inline499(i)
+		case 8000:
+			n += Inline500(i)
+		case 8016:
+			n += Inline501(i)
+		case 8032:
+			n += Inline502(i)
+		case 8048:
+			n += Inline503(i)
+		case 8064:
+			n += Inline504(i)
+		case 8080:
+			n += Inline505(i)
+		case 8096:
+			n += Inline506(i)
+		case 8112:
+			n += Inline507(i)
+		case 8128:
+			n += Inline508(i)
+		case 8144:
+			n += Inline509(i)
+		case 8160:
+			n += Inline510(i)
+		case 8176:
+			n += Inline511(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchInlineStridedLookupMod512(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysStrided[inputs[i%len(inputs)]] {
+		case 0:
+			n += Inline0(i)
+		case 16:
+			n += Inline1(i)
+		case 32:
+			n += Inline2(i)
+		case 48:
+			n += Inline3(i)
+		case 64:
+			n += Inline4(i)
+		case 80:
+			n += Inline5(i)
+		case 96:
+			n += Inline6(i)
+		case 112:
+			n += Inline7(i)
+		case 128:
+			n += Inline8(i)
+		case 144:
+			n += Inline9(i)
+		case 160:
+			n += Inline10(i)
+		case 176:
+			n += Inline11(i)
+		case 192:
+			n += Inline12(i)
+		case 208:
+			n += Inline13(i)
+		case 224:
+			n += Inline14(i)
+		case 240:
+			n += Inline15(i)
+		case 256:
+			n += Inline16(i)
+		case 272:
+			n += Inline17(i)
+		case 288:
+			n += Inline18(i)
+		case 304:
+			n += Inline19(i)
+		case 320:
+			n += Inline20(i)
+		case 336:
+			n += Inline21(i)
+		case 352:
+			n += Inline22(i)
+		case 368:
+			n += Inline23(i)
+		case 384:
+			n += Inline24(i)
+		case 400:
+			n += Inline25(i)
+		case 416:
+			n += Inline26(i)
+		case 432:
+			n += Inline27(i)
+		case 448:
+			n += Inline28(i)
+		case 464:
+			n += Inline29(i)
+		case 480:
+			n += Inline30(i)
+		case 496:
+			n += Inline31(i)
+		case 512:
+			n += Inline32(i)
+		case 528:
+			n += Inline33(i)
+		case 544:
+			n += Inline34(i)
+		case 560:
+			n += Inline35(i)
+		case 576:
+			n += Inline36(i)
+		case 592:
+			n += Inline37(i)
+		case 608:
+			n += Inline38(i)
+		case 624:
+			n += Inline39(i)
+		case 640:
+			n += Inline40(i)
+		case 656:
+			n += Inline41(i)
+		case 672:
+			n += Inline42(i)
+		case 688:
+			n += Inline43(i)
+		case 704:
+			n += Inline44(i)
+		case 720:
+			n += Inline45(i)
+		case 736:
+			n += Inline46(i)
+		case 752:
+			n += Inline47(i)
+		case 768:
+			n += Inline48(i)
+		case 784:
+			n += Inline49(i)
+		case 800:
+			n += Inline50(i)
+		case 816:
+			n += Inline51(i)
+		case 832:
+			n += Inline52(i)
+		case 848:
+			n += Inline53(i)
+		case 864:
+			n += Inline54(i)
+		case 880:
+			n += Inline55(i)
+		case 896:
+			n += Inline56(i)
+		case 912:
+			n += Inline57(i)
+		case 928:
+			n += Inline58(i)
+		case 944:
+			n += Inline59(i)
+		case 960:
+			n += Inline60(i)
+		case 976:
+			n += Inline61(i)
+		case 992:
+			n += Inline62(i)
+		case 1008:
+			n += Inline63(i)
+		case 1024:
+			n += Inline64(i)
+		case 1040:
+			n += Inline65(i)
+		case 1056:
+			n += Inline66(i)
+		case 1072:
+			n += Inline67(i)
+		case 1088:
+			n += Inline68(i)
+		case 1104:
+			n += Inline69(i)
+		case 1120:
+			n += Inline70(i)
+		case 1136:
+			n += Inline71(i)
+		case 1152:
+			n += Inline72(i)
+		case 1168:
+			n += Inline73(i)
+		case 1184:
+			n += Inline74(i)
+		case 1200:
+			n += Inline75(i)
+		case 1216:
+			n += Inline76(i)
+		case 1232:
+			n += Inline77(i)
+		case 1248:
+			n += Inline78(i)
+		case 1264:
+			n += Inline79(i)
+		case 1280:
+			n += Inline80(i)
+		case 1296:
+			n += Inline81(i)
+		case 1312:
+			n += Inline82(i)
+		case 1328:
+			n += Inline83(i)
+		case 1344:
+			n += Inline84(i)
+		case 1360:
+			n += Inline85(i)
+		case 1376:
+			n += Inline86(i)
+		case 1392:
+			n += Inline87(i)
+		case 1408:
+			n += Inline88(i)
+		case 1424:
+			n += Inline89(i)
+		case 1440:
+			n += Inline90(i)
+		case 1456:
+			n += Inline91(i)
+		case 1472:
+			n += Inline92(i)
+		case 1488:
+			n += Inline93(i)
+		case 1504:
+			n += Inline94(i)
+		case 1520:
+			n += Inline95(i)
+		case 1536:
+			n += Inline96(i)
+		case 1552:
+			n += Inline97(i)
+		case 1568:
+			n += Inline98(i)
+		case 1584:
+			n += Inline99(i)
+		case 1600:
+			n += Inline100(i)
+		case 1616:
+			n += Inline101(i)
+		case 1632:
+			n += Inline102(i)
+		case 1648:
+			n += Inline103(i)
+		case 1664:
+			n += Inline104(i)
+		case 1680:
+			n += Inline105(i)
+		case 1696:
+			n += Inline106(i)
+		case 1712:
+			n += Inline107(i)
+		case 1728:
+			n += Inline108(i)
+		case 1744:
+			n += Inline109(i)
+		case 1760:
+			n += Inline110(i)
+		case 1776:
+			n += Inline111(i)
+		case 1792:
+			n += Inline112(i)
+		case 1808:
+			n += Inline113(i)
+		case 1824:
+			n += Inline114(i)
+		case 1840:
+			n += Inline115(i)
+		case 1856:
+			n += Inline116(i)
+		case 1872:
+			n += Inline117(i)
+		case 1888:
+			n += Inline118(i)
+		case 1904:
+			n += Inline119(i)
+		case 1920:
+			n += Inline120(i)
+		case 1936:
+			n += Inline121(i)
+		case 1952:
+			n += Inline122(i)
+		case 1968:
+			n += Inline123(i)
+		case 1984:
+			n += Inline124(i)
+		case 2000:
+			n += Inline125(i)
+		case 2016:
+			n += Inline126(i)
+		case 2032:
+			n += Inline127(i)
+		case 2048:
+			n += Inline128(i)
+		case 2064:
+			n += Inline129(i)
+		case 2080:
+			n += Inline130(i)
+		case 2096:
+			n += Inline131(i)
+		case 2112:
+			n += Inline132(i)
+		case 2128:
+			n += Inline133(i)
+		case 2144:
+			n += Inline134(i)
+		case 2160:
+			n += Inline135(i)
+		case 2176:
+			n += Inline136(i)
+		case 2192:
+			n += Inline137(i)
+		case 2208:
+			n += Inline138(i)
+		case 2224:
+			n += Inline139(i)
+		case 2240:
+			n += Inline140(i)
+		case 2256:
+			n += Inline141(i)
+		case 2272:
+			n += Inline142(i)
+		case 2288:
+			n += Inline143(i)
+		case 2304:
+			n += Inline144(i)
+		case 2320:
+			n += Inline145(i)
+		case 2336:
+			n += Inline146(i)
+		case 2352:
+			n += Inline147(i)
+		case 2368:
+			n += Inline148(i)
+		case 2384:
+			n += Inline149(i)
+		case 2400:
+			n += Inline150(i)
+		case 2416:
+			n += Inline151(i)
+		case 2432:
+			n += Inline152(i)
+		case 2448:
+			n += Inline153(i)
+		case 2464:
+			n += Inline154(i)
+		case 2480:
+			n += Inline155(i)
+		case 2496:
+			n += Inline156(i)
+		case 2512:
+			n += Inline157(i)
+		case 2528:
+			n += Inline158(i)
+		case 2544:
+			n += Inline159(i)
+		case 2560:
+			n += Inline160(i)
+		case 2576:
+			n += Inline161(i)
+		case 2592:
+			n += Inline162(i)
+		case 2608:
+			n += Inline163(i)
+		case 2624:
+			n += Inline164(i)
+		case 2640:
+			n += Inline165(i)
+		case 2656:
+			n += Inline166(i)
+		case 2672:
+			n += Inline167(i)
+		case 2688:
+			n += Inline168(i)
+		case 2704:
+			n += Inline169(i)
+		case 2720:
+			n += Inline170(i)
+		case 2736:
+			n += Inline171(i)
+		case 2752:
+			n += Inline172(i)
+		case 2768:
+			n += Inline173(i)
+		case 2784:
+			n += Inline174(i)
+		case 2800:
+			n += Inline175(i)
+		case 2816:
+			n += Inline176(i)
+		case 2832:
+			n += Inline177(i)
+		case 2848:
+			n += Inline178(i)
+		case 2864:
+			n += Inline179(i)
+		case 2880:
+			n += Inline180(i)
+		case 2896:
+			n += Inline181(i)
+		case 2912:
+			n += Inline182(i)
+		case 2928:
+			n += Inline183(i)
+		case 2944:
+			n += Inline184(i)
+		case 2960:
+			n += Inline185(i)
+		case 2976:
+			n += Inline186(i)
+		case 2992:
+			n += Inline187(i)
+		case 3008:
+			n += Inline188(i)
+		case 3024:
+			n += Inline189(i)
+		case 3040:
+			n += Inline190(i)
+		case 3056:
+			n += Inline191(i)
+		case 3072:
+			n += Inline192(i)
+		case 3088:
+			n += Inline193(i)
+		case 3104:
+			n += Inline194(i)
+		case 3120:
+			n += Inline195(i)
+		case 3136:
+			n += Inline196(i)
+		case 3152:
+			n += Inline197(i)
+		case 3168:
+			n += Inline198(i)
+		case 3184:
+			n += Inline199(i)
+		case 3200:
+			n += Inline200(i)
+		case 3216:
+			n += Inline201(i)
+		case 3232:
+			n += Inline202(i)
+		case 3248:
+			n += Inline203(i)
+		case 3264:
+			n += Inline204(i)
+		case 3280:
+			n += Inline205(i)
+		case 3296:
+			n += Inline206(i)
+		case 3312:
+			n += Inline207(i)
+		case 3328:
+			n += Inline208(i)
+		case 3344:
+			n += Inline209(i)
+		case 3360:
+			n += Inline210(i)
+		case 3376:
+			n += Inline211(i)
+		case 3392:
+			n += Inline212(i)
+		case 3408:
+			n += Inline213(i)
+		case 3424:
+			n += Inline214(i)
+		case 3440:
+			n += Inline215(i)
+		case 3456:
+			n += Inline216(i)
+		case 3472:
+			n += Inline217(i)
+		case 3488:
+			n += Inline218(i)
+		case 3504:
+			n += Inline219(i)
+		case 3520:
+			n += Inline220(i)
+		case 3536:
+			n += Inline221(i)
+		case 3552:
+			n += Inline222(i)
+		case 3568:
+			n += Inline223(i)
+		case 3584:
+			n += Inline224(i)
+		case 3600:
+			n += Inline225(i)
+		case 3616:
+			n += Inline226(i)
+		case 3632:
+			n += Inline227(i)
+		case 3648:
+			n += Inline228(i)
+		case 3664:
+			n += Inline229(i)
+		case 3680:
+			n += Inline230(i)
+		case 3696:
+			n += Inline231(i)
+		case 3712:
+			n += Inline232(i)
+		case 3728:
+			n += Inline233(i)
+		case 3744:
+			n += Inline234(i)
+		case 3760:
+			n += Inline235(i)
+		case 3776:
+			n += Inline236(i)
+		case 3792:
+			n += Inline237(i)
+		case 3808:
+			n += Inline238(i)
+		case 3824:
+			n += Inline239(i)
+		case 3840:
+			n += Inline240(i)
+		case 3856:
+			n += Inline241(i)
+		case 3872:
+			n += Inline242(i)
+		case 3888:
+			n += Inline243(i)
+		case 3904:
+			n += Inline244(i)
+		case 3920:
+			n += Inline245(i)
+		case 3936:
+			n += Inline246(i)
+		case 3952:
+			n += Inline247(i)
+		case 3968:
+			n += Inline248(i)
+		case 3984:
+			n += Inline249(i)
+		case 4000:
+			n += Inline250(i)
+		case 4016:
+			n += Inline251(i)
+		case 4032:
+			n += Inline252(i)
+		case 4048:
+			n += Inline253(i)
+		case 4064:
+			n += Inline254(i)
+		case 4080:
+			n += Inline255(i)
+		case 4096:
+			n += Inline256(i)
+		case 4112:
+			n += Inline257(i)
+		case 4128:
+			n += Inline258(i)
+		case 4144:
+			n += Inline259(i)
+		case 4160:
+			n += Inline260(i)
+		case 4176:
+			n += Inline261(i)
+		case 4192:
+			n += Inline262(i)
+		case 4208:
+			n += Inline263(i)
+		case 4224:
+			n += Inline264(i)
+		case 4240:
+			n += Inline265(i)
+		case 4256:
+			n += Inline266(i)
+		case 4272:
+			n += Inline267(i)
+		case 4288:
+			n += Inline268(i)
+		case 4304:
+			n += Inline269(i)
+		case 4320:
+			n += Inline270(i)
+		case 4336:
+			n += Inline271(i)
+		case 4352:
+			n += Inline272(i)
+		case 4368:
+			n += Inline273(i)
+		case 4384:
+			n += Inline274(i)
+		case 4400:
+			n += Inline275(i)
+		case 4416:
+			n += Inline276(i)
+		case 4432:
+			n += Inline277(i)
+		case 4448:
+			n += Inline278(i)
+		case 4464:
+			n += Inline279(i)
+		case 4480:
+			n += Inline280(i)
+		case 4496:
+			n += Inline281(i)
+		case 4512:
+			n += Inline282(i)
+		case 4528:
+			n += Inline283(i)
+		case 4544:
+			n += Inline284(i)
+		case 4560:
+			n += Inline285(i)
+		case 4576:
+			n += Inline286(i)
+		case 4592:
+			n += Inline287(i)
+		case 4608:
+			n += Inline288(i)
+		case 4624:
+			n += Inline289(i)
+		case 4640:
+			n += Inline290(i)
+		case 4656:
+			n += Inline291(i)
+		case 4672:
+			n += Inline292(i)
+		case 4688:
+			n += Inline293(i)
+		case 4704:
+			n += Inline294(i)
+		case 4720:
+			n += Inline295(i)
+		case 4736:
+			n += Inline296(i)
+		case 4752:
+			n += Inline297(i)
+		case 4768:
+			n += Inline298(i)
+		case 4784:
+			n += Inline299(i)
+		case 4800:
+			n += Inline300(i)
+		case 4816:
+			n += Inline301(i)
+		case 4832:
+			n += Inline302(i)
+		case 4848:
+			n += Inline303(i)
+		case 4864:
+			n += Inline304(i)
+		case 4880:
+			n += Inline305(i)
+		case 4896:
+			n += Inline306(i)
+		case 4912:
+			n += Inline307(i)
+		case 4928:
+			n += Inline308(i)
+		case 4944:
+			n += Inline309(i)
+		case 4960:
+			n += Inline310(i)
+		case 4976:
+			n += Inline311(i)
+		case 4992:
+			n += Inline312(i)
+		case 5008:
+			n += Inline313(i)
+		case 5024:
+			n += Inline314(i)
+		case 5040:
+			n += Inline315(i)
+		case 5056:
+			n += Inline316(i)
+		case 5072:
+			n += Inline317(i)
+		case 5088:
+			n += Inline318(i)
+		case 5104:
+			n += Inline319(i)
+		case 5120:
+			n += Inline320(i)
+		case 5136:
+			n += Inline321(i)
+		case 5152:
+			n += Inline322(i)
+		case 5168:
+			n += Inline323(i)
+		case 5184:
+			n += Inline324(i)
+		case 5200:
+			n += Inline325(i)
+		case 5216:
+			n += Inline326(i)
+		case 5232:
+			n += Inline327(i)
+		case 5248:
+			n += Inline328(i)
+		case 5264:
+			n += Inline329(i)
+		case 5280:
+			n += Inline330(i)
+		case 5296:
+			n += Inline331(i)
+		case 5312:
+			n += Inline332(i)
+		case 5328:
+			n += Inline333(i)
+		case 5344:
+			n += Inline334(i)
+		case 5360:
+			n += Inline335(i)
+		case 5376:
+			n += Inline336(i)
+		case 5392:
+			n += Inline337(i)
+		case 5408:
+			n += Inline338(i)
+		case 5424:
+			n += Inline339(i)
+		case 5440:
+			n += Inline340(i)
+		case 5456:
+			n += Inline341(i)
+		case 5472:
+			n += Inline342(i)
+		case 5488:
+			n += Inline343(i)
+		case 5504:
+			n += Inline344(i)
+		case 5520:
+			n += Inline345(i)
+		case 5536:
+			n += Inline346(i)
+		case 5552:
+			n += Inline347(i)
+		case 5568:
+			n += Inline348(i)
+		case 5584:
+			n += Inline349(i)
+		case 5600:
+			n += Inline350(i)
+		case 5616:
+			n += Inline351(i)
+		case 5632:
+			n += Inline352(i)
+		case 5648:
+			n += Inline353(i)
+		case 5664:
+			n += Inline354(i)
+		case 5680:
+			n += Inline355(i)
+		case 5696:
+			n += Inline356(i)
+		case 5712:
+			n += Inline357(i)
+		case 5728:
+			n += Inline358(i)
+		case 5744:
+			n += Inline359(i)
+		case 5760:
+			n += Inline360(i)
+		case 5776:
+			n += Inline361(i)
+		case 5792:
+			n += Inline362(i)
+		case 5808:
+			n += Inline363(i)
+		case 5824:
+			n += Inline364(i)
+		case 5840:
+			n += Inline365(i)
+		case 5856:
+			n += Inline366(i)
+		case 5872:
+			n += Inline367(i)
+		case 5888:
+			n += Inline368(i)
+		case 5904:
+			n += Inline369(i)
+		case 5920:
+			n += Inline370(i)
+		case 5936:
+			n += Inline371(i)
+		case 5952:
+			n += Inline372(i)
+		case 5968:
+			n += Inline373(i)
+		case 5984:
+			n += Inline374(i)
+		case 6000:
+			n += Inline375(i)
+		case 6016:
+			n += Inline376(i)
+		case 6032:
+			n += Inline377(i)
+		case 6048:
+			n += Inline378(i)
+		case 6064:
+			n += Inline379(i)
+		case 6080:
+			n += Inline380(i)
+		case 6096:
+			n += Inline381(i)
+		case 6112:
+			n += Inline382(i)
+		case 6128:
+			n += Inline383(i)
+		case 6144:
+			n += Inline384(i)
+		case 6160:
+			n += Inline385(i)
+		case 6176:
+			n += Inline386(i)
+		case 6192:
+			n += Inline387(i)
+		case 6208:
+			n += Inline388(i)
+		case 6224:
+			n += Inline389(i)
+		case 6240:
+			n += Inline390(i)
+		case 6256:
+			n += Inline391(i)
+		case 6272:
+			n += Inline392(i)
+		case 6288:
+			n += Inline393(i)
+		case 6304:
+			n += Inline394(i)
+		case 6320:
+			n += Inline395(i)
+		case 6336:
+			n += Inline396(i)
+		case 6352:
+			n += Inline397(i)
+		case 6368:
+			n += Inline398(i)
+		case 6384:
+			n += Inline399(i)
+		case 6400:
+			n += Inline400(i)
+		case 6416:
+			n += Inline401(i)
+		case 6432:
+			n += Inline402(i)
+		case 6448:
+			n += Inline403(i)
+		case 6464:
+			n += Inline404(i)
+		case 6480:
+			n += Inline405(i)
+		case 6496:
+			n += Inline406(i)
+		case 6512:
+			n += Inline407(i)
+		case 6528:
+			n += Inline408(i)
+		case 6544:
+			n += Inline409(i)
+		case 6560:
+			n += Inline410(i)
+		case 6576:
+			n += Inline411(i)
+		case 6592:
+			n += Inline412(i)
+		case 6608:
+			n += Inline413(i)
+		case 6624:
+			n += Inline414(i)
+		case 6640:
+			n += Inline415(i)
+		case 6656:
+			n += Inline416(i)
+		case 6672:
+			n += Inline417(i)
+		case 6688:
+			n += Inline418(i)
+		case 6704:
+			n += Inline419(i)
+		case 6720:
+			n += Inline420(i)
+		case 6736:
+			n += Inline421(i)
+		case 6752:
+			n += Inline422(i)
+		case 6768:
+			n += Inline423(i)
+		case 6784:
+			n += Inline424(i)
+		case 6800:
+			n += Inline425(i)
+		case 6816:
+			n += Inline426(i)
+		case 6832:
+			n += Inline427(i)
+		case 6848:
+			n += Inline428(i)
+		case 6864:
+			n += Inline429(i)
+		case 6880:
+			n += Inline430(i)
+		case 6896:
+			n += Inline431(i)
+		case 6912:
+			n += Inline432(i)
+		case 6928:
+			n += Inline433(i)
+		case 6944:
+			n += Inline434(i)
+		case 6960:
+			n += Inline435(i)
+		case 6976:
+			n += Inline436(i)
+		case 6992:
+			n += Inline437(i)
+		case 7008:
+			n += Inline438(i)
+		case 7024:
+			n += Inline439(i)
+		case 7040:
+			n += Inline440(i)
+		case 7056:
+			n += Inline441(i)
+		case 7072:
+			n += Inline442(i)
+		case 7088:
+			n += Inline443(i)
+		case 7104:
+			n += Inline444(i)
+		case 7120:
+			n += Inline445(i)
+		case 7136:
+			n += Inline446(i)
+		case 7152:
+			n += Inline447(i)
+		case 7168:
+			n += Inline448(i)
+		case 7184:
+			n += Inline449(i)
+		case 7200:
+			n += Inline450(i)
+		case 7216:
+			n += Inline451(i)
+		case 7232:
+			n += Inline452(i)
+		case 7248:
+			n += Inline453(i)
+		case 7264:
+			n += Inline454(i)
+		case 7280:
+			n += Inline455(i)
+		case 7296:
+			n += Inline456(i)
+		case 7312:
+			n += Inline457(i)
+		case 7328:
+			n += Inline458(i)
+		case 7344:
+			n += Inline459(i)
+		case 7360:
+			n += Inline460(i)
+		case 7376:
+			n += Inline461(i)
+		case 7392:
+			n += Inline462(i)
+		case 7408:
+			n += Inline463(i)
+		case 7424:
+			n += Inline464(i)
+		case 7440:
+			n += Inline465(i)
+		case 7456:
+			n += Inline466(i)
+		case 7472:
+			n += Inline467(i)
+		case 7488:
+			n += Inline468(i)
+		case 7504:
+			n += Inline469(i)
+		case 7520:
+			n += Inline470(i)
+		case 7536:
+			n += Inline471(i)
+		case 7552:
+			n += Inline472(i)
+		case 7568:
+			n += Inline473(i)
+		case 7584:
+			n += Inline474(i)
+		case 7600:
+			n += Inline475(i)
+		case 7616:
+			n += Inline476(i)
+		case 7632:
+			n += Inline477(i)
+		case 7648:
+			n += Inline478(i)
+		case 7664:
+			n += Inline479(i)
+		case 7680:
+			n += Inline480(i)
+		case 7696:
+			n += Inline481(i)
+		case 7712:
+			n += Inline482(i)
+		case 7728:
+			n += Inline483(i)
+		case 7744:
+			n += Inline484(i)
+		case 7760:
+			n += Inline485(i)
+		case 7776:
+			n += Inline486(i)
+		case 7792:
+			n += Inline487(i)
+		case 7808:
+			n += Inline488(i)
+		case 7824:
+			n += Inline489(i)
+		case 7840:
+			n += Inline490(i)
+		case 7856:
+			n += Inline491(i)
+		case 7872:
+			n += Inline492(i)
+		case 7888:
+			n += Inline493(i)
+		case 7904:
+			n += Inline494(i)
+		case 7920:
+			n += Inline495(i)
+		case 7936:
+			n += Inline496(i)
+		case 7952:
+			n += Inline497(i)
+		case 7968:
+			n += Inline498(i)
+		case 7984:
+			n += Inline499(i)
+		case 8000:
+			n += Inline500(i)
+		case 8016:
+			n += Inline501(i)
+		case 8032:
+			n += Inline502(i)
+		case 8048:
+			n += Inline503(i)
+		case 8064:
+			n += Inline504(i)
+		case 8080:
+			n += Inline505(i)
+		case 8096:
+			n += Inline506(i)
+		case 8112:
+			n += Inline507(i)
+		case 8128:
+			n += Inline508(i)
+		case 8144:
+			n += Inline509(i)
+		case 8160:
+			n += Inline510(i)
+		case 8176:
+			n += Inline511(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchInlineSparseComputed4(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysSparse[i%4] {
+		case -1697971134:
+			n += Inline0(i)
+		case -255511522:
+			n += Inline1(i)
+		case -1440703602:
+			n += Inline2(i)
+		case 1879968118:
+			n += Inline3(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchInlineSparseLookupMod4(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysSparse[inputs[i%len(inputs)]] {
+		case -1697971134:
+			n += Inline0(i)
+		case -255511522:
+			n += Inline1(i)
+		case -1440703602:
+			n += Inline2(i)
+		case 1879968118:
+			n += Inline3(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchInlineSparseComputed8(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysSparse[i%8] {
+		case -1697971134:
+			n += Inline0(i)
+		case -255511522:
+			n += Inline1(i)
+		case -1440703602:
+			n += Inline2(i)
+		case 1879968118:
+			n += Inline3(i)
+		case 1823804162:
+			n += Inline4(i)
+		case -1345084660:
+			n += Inline5(i)
+		case 281908850:
+			n += Inline6(i)
+		case 672245080:
+			n += Inline7(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchInlineSparseLookupMod8(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysSparse[inputs[i%len(inputs)]] {
+		case -1697971134:
+			n += Inline0(i)
+		case -255511522:
+			n += Inline1(i)
+		case -1440703602:
+			n += Inline2(i)
+		case 1879968118:
+			n += Inline3(i)
+		case 1823804162:
+			n += Inline4(i)
+		case -1345084660:
+			n += Inline5(i)
+		case 281908850:
+			n += Inline6(i)
+		case 672245080:
+			n += Inline7(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchInlineSparseComputed16(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysSparse[i%16] {
+		case -1697971134:
+			n += Inline0(i)
+		case -255511522:
+			n += Inline1(i)
+		case -1440703602:
+			n += Inline2(i)
+		case 1879968118:
+			n += Inline3(i)
+		case 1823804162:
+			n += Inline4(i)
+		case -1345084660:
+			n += Inline5(i)
+		case 281908850:
+			n += Inline6(i)
+		case 672245080:
+			n += Inline7(i)
+		case 416480912:
+			n += Inline8(i)
+		case 1292406600:
+			n += Inline9(i)
+		case -2082145907:
+			n += Inline10(i)
+		case -800410273:
+			n += Inline11(i)
+		case 920256325:
+			n += Inline12(i)
+		case 1634910179:
+			n += Inline13(i)
+		case 1366049456:
+			n += Inline14(i)
+		case 2013866549:
+			n += Inline15(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchInlineSparseLookupMod16(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysSparse[inputs[i%len(inputs)]] {
+		case -1697971134:
+			n += Inline0(i)
+		case -255511522:
+			n += Inline1(i)
+		case -1440703602:
+			n += Inline2(i)
+		case 1879968118:
+			n += Inline3(i)
+		case 1823804162:
+			n += Inline4(i)
+		case -1345084660:
+			n += Inline5(i)
+		case 281908850:
+			n += Inline6(i)
+		case 672245080:
+			n += Inline7(i)
+		case 416480912:
+			n += Inline8(i)
+		case 1292406600:
+			n += Inline9(i)
+		case -2082145907:
+			n += Inline10(i)
+		case -800410273:
+			n += Inline11(i)
+		case 920256325:
+			n += Inline12(i)
+		case 1634910179:
+			n += Inline13(i)
+		case 1366049456:
+			n += Inline14(i)
+		case 2013866549:
+			n += Inline15(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchInlineSparseComputed32(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysSparse[i%32] {
+		case -1697971134:
+			n += Inline0(i)
+		case -255511522:
+			n += Inline1(i)
+		case -1440703602:
+			n += Inline2(i)
+		case 1879968118:
+			n += Inline3(i)
+		case 1823804162:
+			n += Inline4(i)
+		case -1345084660:
+			n += Inline5(i)
+		case 281908850:
+			n += Inline6(i)
+		case 672245080:
+			n += Inline7(i)
+		case 416480912:
+			n += Inline8(i)
+		case 1292406600:
+			n += Inline9(i)
+		case -2082145907:
+			n += Inline10(i)
+		case -800410273:
+			n += Inline11(i)
+		case 920256325:
+			n += Inline12(i)
+		case 1634910179:
+			n += Inline13(i)
+		case 1366049456:
+			n += Inline14(i)
+		case 2013866549:
+			n += Inline15(i)
+		case 1215622422:
+			n += Inline16(i)
+		case 1258862891:
+			n += Inline17(i)
+		case -1378320822:
+			n += Inline18(i)
+		case 938678213:
+			n += Inline19(i)
+		case 872680990:
+			n += Inline20(i)
+		case 1549930933:
+			n += Inline21(i)
+		case -1843944239:
+			n += Inline22(i)
+		case -590594780:
+			n += Inline23(i)
+		case 1258916094:
+			n += Inline24(i)
+		case 1275959894:
+			n += Inline25(i)
+		case -1062690721:
+			n += Inline26(i)
+		case 887265776:
+			n += Inline27(i)
+		case -578381716:
+			n += Inline28(i)
+		case -1302581265:
+			n += Inline29(i)
+		case -2045176213:
+			n += Inline30(i)
+		case 121560817:
+			n += Inline31(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchInlineSparseLookupMod32(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysSparse[inputs[i%len(inputs)]] {
+		case -1697971134:
+			n += Inline0(i)
+		case -255511522:
+			n += Inline1(i)
+		case -1440703602:
+			n += Inline2(i)
+		case 1879968118:
+			n += Inline3(i)
+		case 1823804162:
+			n += Inline4(i)
+		case -1345084660:
+			n += Inline5(i)
+		case 281908850:
+			n += Inline6(i)
+		case 672245080:
+			n += Inline7(i)
+		case 416480912:
+			n += Inline8(i)
+		case 1292406600:
+			n += Inline9(i)
+		case -2082145907:
+			n += Inline10(i)
+		case -800410273:
+			n += Inline11(i)
+		case 920256325:
+			n += Inline12(i)
+		case 1634910179:
+			n += Inline13(i)
+		case 1366049456:
+			n += Inline14(i)
+		case 2013866549:
+			n += Inline15(i)
+		case 1215622422:
+			n += Inline16(i)
+		case 1258862891:
+			n += Inline17(i)
+		case -1378320822:
+			n += Inline18(i)
+		case 938678213:
+			n += Inline19(i)
+		case 872680990:
+			n += Inline20(i)
+		case 1549930933:
+			n += Inline21(i)
+		case -1843944239:
+			n += Inline22(i)
+		case -590594780:
+			n += Inline23(i)
+		case 1258916094:
+			n += Inline24(i)
+		case 1275959894:
+			n += Inline25(i)
+		case -1062690721:
+			n += Inline26(i)
+		case 887265776:
+			n += Inline27(i)
+		case -578381716:
+			n += Inline28(i)
+		case -1302581265:
+			n += Inline29(i)
+		case -2045176213:
+			n += Inline30(i)
+		case 121560817:
+			n += Inline31(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchInlineSparseComputed64(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysSparse[i%64] {
+		case -1697971134:
+			n += Inline0(i)
+		case -255511522:
+			n += Inline1(i)
+		case -1440703602:
+			n += Inline2(i)
+		case 1879968118:
+			n += Inline3(i)
+		case 1823804162:
+			n += Inline4(i)
+		case -1345084660:
+			n += Inline5(i)
+		case 281908850:
+			n += Inline6(i)
+		case 672245080:
+			n += Inline7(i)
+		case 416480912:
+			n += Inline8(i)
+		case 1292406600:
+			n += Inline9(i)
+		case -2082145907:
+			n += Inline10(i)
+		case -800410273:
+			n += Inline11(i)
+		case 920256325:
+			n += Inline12(i)
+		case 1634910179:
+			n += Inline13(i)
+		case 1366049456:
+			n += Inline14(i)
+		case 2013866549:
+			n += Inline15(i)
+		case 1215622422:
+			n += Inline16(i)
+		case 1258862891:
+			n += Inline17(i)
+		case -1378320822:
+			n += Inline18(i)
+		case 938678213:
+			n += Inline19(i)
+		case 872680990:
+			n += Inline20(i)
+		case 1549930933:
+			n += Inline21(i)
+		case -1843944239:
+			n += Inline22(i)
+		case -590594780:
+			n += Inline23(i)
+		case 1258916094:
+			n += Inline24(i)
+		case 1275959894:
+			n += Inline25(i)
+		case -1062690721:
+			n += Inline26(i)
+		case 887265776:
+			n += Inline27(i)
+		case -578381716:
+			n += Inline28(i)
+		case -1302581265:
+			n += Inline29(i)
+		case -2045176213:
+			n += Inline30(i)
+		case 121560817:
+			n += Inline31(i)
+		case 680014774:
+			n += Inline32(i)
+		case -1686833633:
+			n += Inline33(i)
+		case -106336438:
+			n += Inline34(i)
+		case 341250713:
+			n += Inline35(i)
+		case -1740283822:
+			n += Inline36(i)
+		case 253921263:
+			n += Inline37(i)
+		case -1322744326:
+			n += Inline38(i)
+		case 1295030053:
+			n += Inline39(i)
+		case 744172826:
+			n += Inline40(i)
+		case -1970961115:
+			n += Inline41(i)
+		case -1957836907:
+			n += Inline42(i)
+		case 1196181127:
+			n += Inline43(i)
+		case 1817424866:
+			n += Inline44(i)
+		case -2016119001:
+			n += Inline45(i)
+		case 1088948157:
+			n += Inline46(i)
+		case 1211528648:
+			n += Inline47(i)
+		case -907934977:
+			n += Inline48(i)
+		case 1553942706:
+			n += Inline49(i)
+		case -513063382:
+			n += Inline50(i)
+		case 1276087442:
+			n += Inline51(i)
+		case -453712918:
+			n += Inline52(i)
+		case 418564398:
+			n += Inline53(i)
+		case -99141295:
+			n += Inline54(i)
+		case 319077410:
+			n += Inline55(i)
+		case 954725776:
+			n += Inline56(i)
+		case -1369758219:
+			n += Inline57(i)
+		case 1037299406:
+			n += Inline58(i)
+		case 1337978710:
+			n += Inline59(i)
+		case -288422394:
+			n += Inline60(i)
+		case -1108750275:
+			n += Inline61(i)
+		case -854462086:
+			n += Inline62(i)
+		case -1158646983:
+			n += Inline63(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchInlineSparseLookupMod64(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysSparse[inputs[i%len(inputs)]] {
+		case -1697971134:
+			n += Inline0(i)
+		case -255511522:
+			n += Inline1(i)
+		case -1440703602:
+			n += Inline2(i)
+		case 1879968118:
+			n += Inline3(i)
+		case 1823804162:
+			n += Inline4(i)
+		case -1345084660:
+			n += Inline5(i)
+		case 281908850:
+			n += Inline6(i)
+		case 672245080:
+			n += Inline7(i)
+		case 416480912:
+			n += Inline8(i)
+		case 1292406600:
+			n += Inline9(i)
+		case -2082145907:
+			n += Inline10(i)
+		case -800410273:
+			n += Inline11(i)
+		case 920256325:
+			n += Inline12(i)
+		case 1634910179:
+			n += Inline13(i)
+		case 1366049456:
+			n += Inline14(i)
+		case 2013866549:
+			n += Inline15(i)
+		case 1215622422:
+			n += Inline16(i)
+		case 1258862891:
+			n += Inline17(i)
+		case -1378320822:
+			n += Inline18(i)
+		case 938678213:
+			n += Inline19(i)
+		case 872680990:
+			n += Inline20(i)
+		case 1549930933:
+			n += Inline21(i)
+		case -1843944239:
+			n += Inline22(i)
+		case -590594780:
+			n += Inline23(i)
+		case 1258916094:
+			n += Inline24(i)
+		case 1275959894:
+			n += Inline25(i)
+		case -1062690721:
+			n += Inline26(i)
+		case 887265776:
+			n += Inline27(i)
+		case -578381716:
+			n += Inline28(i)
+		case -1302581265:
+			n += Inline29(i)
+		case -2045176213:
+			n += Inline30(i)
+		case 121560817:
+			n += Inline31(i)
+		case 680014774:
+			n += Inline32(i)
+		case -1686833633:
+			n += Inline33(i)
+		case -106336438:
+			n += Inline34(i)
+		case 341250713:
+			n += Inline35(i)
+		case -1740283822:
+			n += Inline36(i)
+		case 253921263:
+			n += Inline37(i)
+		case -1322744326:
+			n += Inline38(i)
+		case 1295030053:
+			n += Inline39(i)
+		case 744172826:
+			n += Inline40(i)
+		case -1970961115:
+			n += Inline41(i)
+		case -1957836907:
+			n += Inline42(i)
+		case 1196181127:
+			n += Inline43(i)
+		case 1817424866:
+			n += Inline44(i)
+		case -2016119001:
+			n += Inline45(i)
+		case 1088948157:
+			n += Inline46(i)
+		case 1211528648:
+			n += Inline47(i)
+		case -907934977:
+			n += Inline48(i)
+		case 1553942706:
+			n += Inline49(i)
+		case -513063382:
+			n += Inline50(i)
+		case 1276087442:
+			n += Inline51(i)
+		case -453712918:
+			n += Inline52(i)
+		case 418564398:
+			n += Inline53(i)
+		case -99141295:
+			n += Inline54(i)
+		case 319077410:
+			n += Inline55(i)
+		case 954725776:
+			n += Inline56(i)
+		case -1369758219:
+			n += Inline57(i)
+		case 1037299406:
+			n += Inline58(i)
+		case 1337978710:
+			n += Inline59(i)
+		case -288422394:
+			n += Inline60(i)
+		case -1108750275:
+			n += Inline61(i)
+		case -854462086:
+			n += Inline62(i)
+		case -1158646983:
+			n += Inline63(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchInlineSparseComputed128(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysSparse[i%128] {
+		case -1697971134:
+			n += Inline0(i)
+		case -255511522:
+			n += Inline1(i)
+		case -1440703602:
+			n += Inline2(i)
+		case 1879968118:
+			n += Inline3(i)
+		case 1823804162:
+			n += Inline4(i)
+		case -1345084660:
+			n += Inline5(i)
+		case 281908850:
+			n += Inline6(i)
+		case 672245080:
+			n += Inline7(i)
+		case 416480912:
+			n += Inline8(i)
+		case 1292406600:
+			n += Inline9(i)
+		case -2082145907:
+			n += Inline10(i)
+		case -800410273:
+			n += Inline11(i)
+		case 920256325:
+			n += Inline12(i)
+		case 1634910179:
+			n += Inline13(i)
+		case 1366049456:
+			n += Inline14(i)
+		case 2013866549:
+			n += Inline15(i)
+		case 1215622422:
+			n += Inline16(i)
+		case 1258862891:
+			n += Inline17(i)
+		case -1378320822:
+			n += Inline18(i)
+		case 938678213:
+			n += Inline19(i)
+		case 872680990:
+			n += Inline20(i)
+		case 1549930933:
+			n += Inline21(i)
+		case -1843944239:
+			n += Inline22(i)
+		case -590594780:
+			n += Inline23(i)
+		case 1258916094:
+			n += Inline24(i)
+		case 1275959894:
+			n += Inline25(i)
+		case -1062690721:
+			n += Inline26(i)
+		case 887265776:
+			n += Inline27(i)
+		case -578381716:
+			n += Inline28(i)
+		case -1302581265:
+			n += Inline29(i)
+		case -2045176213:
+			n += Inline30(i)
+		case 121560817:
+			n += Inline31(i)
+		case 680014774:
+			n += Inline32(i)
+		case -1686833633:
+			n += Inline33(i)
+		case -106336438:
+			n += Inline34(i)
+		case 341250713:
+			n += Inline35(i)
+		case -1740283822:
+			n += Inline36(i)
+		case 253921263:
+			n += Inline37(i)
+		case -1322744326:
+			n += Inline38(i)
+		case 1295030053:
+			n += Inline39(i)
+		case 744172826:
+			n += Inline40(i)
+		case -1970961115:
+			n += Inline41(i)
+		case -1957836907:
+			n += Inline42(i)
+		case 1196181127:
+			n += Inline43(i)
+		case 1817424866:
+			n += Inline44(i)
+		case -2016119001:
+			n += Inline45(i)
+		case 1088948157:
+			n += Inline46(i)
+		case 1211528648:
+			n += Inline47(i)
+		case -907934977:
+			n += Inline48(i)
+		case 1553942706:
+			n += Inline49(i)
+		case -513063382:
+			n += Inline50(i)
+		case 1276087442:
+			n += Inline51(i)
+		case -453712918:
+			n += Inline52(i)
+		case 418564398:
+			n += Inline53(i)
+		case -99141295:
+			n += Inline54(i)
+		case 319077410:
+			n += Inline55(i)
+		case 954725776:
+			n += Inline56(i)
+		case -1369758219:
+			n += Inline57(i)
+		case 1037299406:
+			n += Inline58(i)
+		case 1337978710:
+			n += Inline59(i)
+		case -288422394:
+			n += Inline60(i)
+		case -1108750275:
+			n += Inline61(i)
+		case -854462086:
+			n += Inline62(i)
+		case -1158646983:
+			n += Inline63(i)
+		case 785656533:
+			n += Inline64(i)
+		case 1839779657:
+			n += Inline65(i)
+		case -442416174:
+			n += Inline66(i)
+		case -1362992891:
+			n += Inline67(i)
+		case -90497729:
+			n += Inline68(i)
+		case -334095804:
+			n += Inline69(i)
+		case 390143126:
+			n += Inline70(i)
+		case 2118028752:
+			n += Inline71(i)
+		case -313589291:
+			n += Inline72(i)
+		case -193507860:
+			n += Inline73(i)
+		case 1494450894:
+			n += Inline74(i)
+		case -1327837108:
+			n += Inline75(i)
+		case -1241644142:
+			n += Inline76(i)
+		case -1873552370:
+			n += Inline77(i)
+		case -1505431304:
+			n += Inline78(i)
+		case -1925154456:
+			n += Inline79(i)
+		case -1048730050:
+			n += Inline80(i)
+		case 1734321906:
+			n += Inline81(i)
+		case 561142274:
+			n += Inline82(i)
+		case -60281029:
+			n += Inline83(i)
+		case -445208814:
+			n += Inline84(i)
+		case 1383340119:
+			n += Inline85(i)
+		case -1197661229:
+			n += Inline86(i)
+		case -1526690010:
+			n += Inline87(i)
+		case 367307783:
+			n += Inline88(i)
+		case -1419163291:
+			n += Inline89(i)
+		case -1620369539:
+			n += Inline90(i)
+		case 1587818672:
+			n += Inline91(i)
+		case 1017145093:
+			n += Inline92(i)
+		case -1995949082:
+			n += Inline93(i)
+		case 804215881:
+			n += Inline94(i)
+		case 1025813007:
+			n += Inline95(i)
+		case -1597306192:
+			n += Inline96(i)
+		case 544399686:
+			n += Inline97(i)
+		case 1208304411:
+			n += Inline98(i)
+		case 1762323197:
+			n += Inline99(i)
+		case 1867934851:
+			n += Inline100(i)
+		case -1610204593:
+			n += Inline101(i)
+		case -1932104265:
+			n += Inline102(i)
+		case -1616587781:
+			n += Inline103(i)
+		case -1163159922:
+			n += Inline104(i)
+		case -727851276:
+			n += Inline105(i)
+		case 2206820:
+			n += Inline106(i)
+		case -1133576725:
+			n += Inline107(i)
+		case 1717917180:
+			n += Inline108(i)
+		case 2138327264:
+			n += Inline109(i)
+		case -1700901100:
+			n += Inline110(i)
+		case 1759297107:
+			n += Inline111(i)
+		case 127437182:
+			n += Inline112(i)
+		case 8177164:
+			n += Inline113(i)
+		case 12210768:
+			n += Inline114(i)
+		case -361544701:
+			n += Inline115(i)
+		case -1761648762:
+			n += Inline116(i)
+		case -1892395022:
+			n += Inline117(i)
+		case -792828754:
+			n += Inline118(i)
+		case -523935508:
+			n += Inline119(i)
+		case 1968995452:
+			n += Inline120(i)
+		case -1717275692:
+			n += Inline121(i)
+		case 112807962:
+			n += Inline122(i)
+		case -662143137:
+			n += Inline123(i)
+		case 1072424133:
+			n += Inline124(i)
+		case -1538524756:
+			n += Inline125(i)
+		case 1062860987:
+			n += Inline126(i)
+		case 745846173:
+			n += Inline127(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchInlineSparseLookupMod128(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysSparse[inputs[i%len(inputs)]] {
+		case -1697971134:
+			n += Inline0(i)
+		case -255511522:
+			n += Inline1(i)
+		case -1440703602:
+			n += Inline2(i)
+		case 1879968118:
+			n += Inline3(i)
+		case 1823804162:
+			n += Inline4(i)
+		case -1345084660:
+			n += Inline5(i)
+		case 281908850:
+			n += Inline6(i)
+		case 672245080:
+			n += Inline7(i)
+		case 416480912:
+			n += Inline8(i)
+		case 1292406600:
+			n += Inline9(i)
+		case -2082145907:
+			n += Inline10(i)
+		case -800410273:
+			n += Inline11(i)
+		case 920256325:
+			n += Inline12(i)
+		case 1634910179:
+			n += Inline13(i)
+		case 1366049456:
+			n += Inline14(i)
+		case 2013866549:
+			n += Inline15(i)
+		case 1215622422:
+			n += Inline16(i)
+		case 1258862891:
+			n += Inline17(i)
+		case -1378320822:
+			n += Inline18(i)
+		case 938678213:
+			n += Inline19(i)
+		case 872680990:
+			n += Inline20(i)
+		case 1549930933:
+			n += Inline21(i)
+		case -1843944239:
+			n += Inline22(i)
+		case -590594780:
+			n += Inline23(i)
+		case 1258916094:
+			n += Inline24(i)
+		case 1275959894:
+			n += Inline25(i)
+		case -1062690721:
+			n += Inline26(i)
+		case 887265776:
+			n += Inline27(i)
+		case -578381716:
+			n += Inline28(i)
+		case -1302581265:
+			n += Inline29(i)
+		case -2045176213:
+			n += Inline30(i)
+		case 121560817:
+			n += Inline31(i)
+		case 680014774:
+			n += Inline32(i)
+		case -1686833633:
+			n += Inline33(i)
+		case -106336438:
+			n += Inline34(i)
+		case 341250713:
+			n += Inline35(i)
+		case -1740283822:
+			n += Inline36(i)
+		case 253921263:
+			n += Inline37(i)
+		case -1322744326:
+			n += Inline38(i)
+		case 1295030053:
+			n += Inline39(i)
+		case 744172826:
+			n += Inline40(i)
+		case -1970961115:
+			n += Inline41(i)
+		case -1957836907:
+			n += Inline42(i)
+		case 1196181127:
+			n += Inline43(i)
+		case 1817424866:
+			n += Inline44(i)
+		case -2016119001:
+			n += Inline45(i)
+		case 1088948157:
+			n += Inline46(i)
+		case 1211528648:
+			n += Inline47(i)
+		case -907934977:
+			n += Inline48(i)
+		case 1553942706:
+			n += Inline49(i)
+		case -513063382:
+			n += Inline50(i)
+		case 1276087442:
+			n += Inline51(i)
+		case -453712918:
+			n += Inline52(i)
+		case 418564398:
+			n += Inline53(i)
+		case -99141295:
+			n += Inline54(i)
+		case 319077410:
+			n += Inline55(i)
+		case 954725776:
+			n += Inline56(i)
+		case -1369758219:
+			n += Inline57(i)
+		case 1037299406:
+			n += Inline58(i)
+		case 1337978710:
+			n += Inline59(i)
+		case -288422394:
+			n += Inline60(i)
+		case -1108750275:
+			n += Inline61(i)
+		case -854462086:
+			n += Inline62(i)
+		case -1158646983:
+			n += Inline63(i)
+		case 785656533:
+			n += Inline64(i)
+		case 1839779657:
+			n += Inline65(i)
+		case -442416174:
+			n += Inline66(i)
+		case -1362992891:
+			n += Inline67(i)
+		case -90497729:
+			n += Inline68(i)
+		case -334095804:
+			n += Inline69(i)
+		case 390143126:
+			n += Inline70(i)
+		case 2118028752:
+			n += Inline71(i)
+		case -313589291:
+			n += Inline72(i)
+		case -193507860:
+			n += Inline73(i)
+		case 1494450894:
+			n += Inline74(i)
+		case -1327837108:
+			n += Inline75(i)
+		case -1241644142:
+			n += Inline76(i)
+		case -1873552370:
+			n += Inline77(i)
+		case -1505431304:
+			n += Inline78(i)
+		case -1925154456:
+			n += Inline79(i)
+		case -1048730050:
+			n += Inline80(i)
+		case 1734321906:
+			n += Inline81(i)
+		case 561142274:
+			n += Inline82(i)
+		case -60281029:
+			n += Inline83(i)
+		case -445208814:
+			n += Inline84(i)
+		case 1383340119:
+			n += Inline85(i)
+		case -1197661229:
+			n += Inline86(i)
+		case -1526690010:
+			n += Inline87(i)
+		case 367307783:
+			n += Inline88(i)
+		case -1419163291:
+			n += Inline89(i)
+		case -1620369539:
+			n += Inline90(i)
+		case 1587818672:
+			n += Inline91(i)
+		case 1017145093:
+			n += Inline92(i)
+		case -1995949082:
+			n += Inline93(i)
+		case 804215881:
+			n += Inline94(i)
+		case 1025813007:
+			n += Inline95(i)
+		case -1597306192:
+			n += Inline96(i)
+		case 544399686:
+			n += Inline97(i)
+		case 1208304411:
+			n += Inline98(i)
+		case 1762323197:
+			n += Inline99(i)
+		case 1867934851:
+			n += Inline100(i)
+		case -1610204593:
+			n += Inline101(i)
+		case -1932104265:
+			n += Inline102(i)
+		case -1616587781:
+			n += Inline103(i)
+		case -1163159922:
+			n += Inline104(i)
+		case -727851276:
+			n += Inline105(i)
+		case 2206820:
+			n += Inline106(i)
+		case -1133576725:
+			n += Inline107(i)
+		case 1717917180:
+			n += Inline108(i)
+		case 2138327264:
+			n += Inline109(i)
+		case -1700901100:
+			n += Inline110(i)
+		case 1759297107:
+			n += Inline111(i)
+		case 127437182:
+			n += Inline112(i)
+		case 8177164:
+			n += Inline113(i)
+		case 12210768:
+			n += Inline114(i)
+		case -361544701:
+			n += Inline115(i)
+		case -1761648762:
+			n += Inline116(i)
+		case -1892395022:
+			n += Inline117(i)
+		case -792828754:
+			n += Inline118(i)
+		case -523935508:
+			n += Inline119(i)
+		case 1968995452:
+			n += Inline120(i)
+		case -1717275692:
+			n += Inline121(i)
+		case 112807962:
+			n += Inline122(i)
+		case -662143137:
+			n += Inline123(i)
+		case 1072424133:
+			n += Inline124(i)
+		case -1538524756:
+			n += Inline125(i)
+		case 1062860987:
+			n += Inline126(i)
+		case 745846173:
+			n += Inline127(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchInlineSparseComputed256(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysSparse[i%256] {
+		case -1697971134:
+			n += Inline0(i)
+		case -255511522:
+			n += Inline1(i)
+		case -1440703602:
+			n += Inline2(i)
+		case 1879968118:
+			n += Inline3(i)
+		case 1823804162:
+			n += Inline4(i)
+		case -1345084660:
+			n += Inline5(i)
+		case 281908850:
+			n += Inline6(i)
+		case 672245080:
+			n += Inline7(i)
+		case 416480912:
+			n += Inline8(i)
+		case 1292406600:
+			n += Inline9(i)
+		case -2082145907:
+			n += Inline10(i)
+		case -800410273:
+			n += Inline11(i)
+		case 920256325:
+			n += Inline12(i)
+		case 1634910179:
+			n += Inline13(i)
+		case 1366049456:
+			n += Inline14(i)
+		case 2013866549:
+			n += Inline15(i)
+		case 1215622422:
+			n += Inline16(i)
+		case 1258862891:
+			n += Inline17(i)
+		case -1378320822:
+			n += Inline18(i)
+		case 938678213:
+			n += Inline19(i)
+		case 872680990:
+			n += Inline20(i)
+		case 1549930933:
+			n += Inline21(i)
+		case -1843944239:
+			n += Inline22(i)
+		case -590594780:
+			n += Inline23(i)
+		case 1258916094:
+			n += Inline24(i)
+		case 1275959894:
+			n += Inline25(i)
+		case -1062690721:
+			n += Inline26(i)
+		case 887265776:
+			n += Inline27(i)
+		case -578381716:
+			n += Inline28(i)
+		case -1302581265:
+			n += Inline29(i)
+		case -2045176213:
+			n += Inline30(i)
+		case 121560817:
+			n += Inline31(i)
+		case 680014774:
+			n += Inline32(i)
+		case -1686833633:
+			n += Inline33(i)
+		case -106336438:
+			n += Inline34(i)
+		case 341250713:
+			n += Inline35(i)
+		case -1740283822:
+			n += Inline36(i)
+		case 253921263:
+			n += Inline37(i)
+		case -1322744326:
+			n += Inline38(i)
+		case 1295030053:
+			n += Inline39(i)
+		case 744172826:
+			n += Inline40(i)
+		case -1970961115:
+			n += Inline41(i)
+		case -1957836907:
+			n += Inline42(i)
+		case 1196181127:
+			n += Inline43(i)
+		case 1817424866:
+			n += Inline44(i)
+		case -2016119001:
+			n += Inline45(i)
+		case 1088948157:
+			n += Inline46(i)
+		case 1211528648:
+			n += Inline47(i)
+		case -907934977:
+			n += Inline48(i)
+		case 1553942706:
+			n += Inline49(i)
+		case -513063382:
+			n += Inline50(i)
+		case 1276087442:
+			n += Inline51(i)
+		case -453712918:
+			n += Inline52(i)
+		case 418564398:
+			n += Inline53(i)
+		case -99141295:
+			n += Inline54(i)
+		case 319077410:
+			n += Inline55(i)
+		case 954725776:
+			n += Inline56(i)
+		case -1369758219:
+			n += Inline57(i)
+		case 1037299406:
+			n += Inline58(i)
+		case 1337978710:
+			n += Inline59(i)
+		case -288422394:
+			n += Inline60(i)
+		case -1108750275:
+			n += Inline61(i)
+		case -854462086:
+			n += Inline62(i)
+		case -1158646983:
+			n += Inline63(i)
+		case 785656533:
+			n += Inline64(i)
+		case 1839779657:
+			n += Inline65(i)
+		case -442416174:
+			n += Inline66(i)
+		case -1362992891:
+			n += Inline67(i)
+		case -90497729:
+			n += Inline68(i)
+		case -334095804:
+			n += Inline69(i)
+		case 390143126:
+			n += Inline70(i)
+		case 2118028752:
+			n += Inline71(i)
+		case -313589291:
+			n += Inline72(i)
+		case -193507860:
+			n += Inline73(i)
+		case 1494450894:
+			n += Inline74(i)
+		case -1327837108:
+			n += Inline75(i)
+		case -1241644142:
+			n += Inline76(i)
+		case -1873552370:
+			n += Inline77(i)
+		case -1505431304:
+			n += Inline78(i)
+		case -1925154456:
+			n += Inline79(i)
+		case -1048730050:
+			n += Inline80(i)
+		case 1734321906:
+			n += Inline81(i)
+		case 561142274:
+			n += Inline82(i)
+		case -60281029:
+			n += Inline83(i)
+		case -445208814:
+			n += Inline84(i)
+		case 1383340119:
+			n += Inline85(i)
+		case -1197661229:
+			n += Inline86(i)
+		case -1526690010:
+			n += Inline87(i)
+		case 367307783:
+			n += Inline88(i)
+		case -1419163291:
+			n += Inline89(i)
+		case -1620369539:
+			n += Inline90(i)
+		case 1587818672:
+			n += Inline91(i)
+		case 1017145093:
+			n += Inline92(i)
+		case -1995949082:
+			n += Inline93(i)
+		case 804215881:
+			n += Inline94(i)
+		case 1025813007:
+			n += Inline95(i)
+		case -1597306192:
+			n += Inline96(i)
+		case 544399686:
+			n += Inline97(i)
+		case 1208304411:
+			n += Inline98(i)
+		case 1762323197:
+			n += Inline99(i)
+		case 1867934851:
+			n += Inline100(i)
+		case -1610204593:
+			n += Inline101(i)
+		case -1932104265:
+			n += Inline102(i)
+		case -1616587781:
+			n += Inline103(i)
+		case -1163159922:
+			n += Inline104(i)
+		case -727851276:
+			n += Inline105(i)
+		case 2206820:
+			n += Inline106(i)
+		case -1133576725:
+			n += Inline107(i)
+		case 1717917180:
+			n += Inline108(i)
+		case 2138327264:
+			n += Inline109(i)
+		case -1700901100:
+			n += Inline110(i)
+		case 1759297107:
+			n += Inline111(i)
+		case 127437182:
+			n += Inline112(i)
+		case 8177164:
+			n += Inline113(i)
+		case 12210768:
+			n += Inline114(i)
+		case -361544701:
+			n += Inline115(i)
+		case -1761648762:
+			n += Inline116(i)
+		case -1892395022:
+			n += Inline117(i)
+		case -792828754:
+			n += Inline118(i)
+		case -523935508:
+			n += Inline119(i)
+		case 1968995452:
+			n += Inline120(i)
+		case -1717275692:
+			n += Inline121(i)
+		case 112807962:
+			n += Inline122(i)
+		case -662143137:
+			n += Inline123(i)
+		case 1072424133:
+			n += Inline124(i)
+		case -1538524756:
+			n += Inline125(i)
+		case 1062860987:
+			n += Inline126(i)
+		case 745846173:
+			n += Inline127(i)
+		case -1749667658:
+			n += Inline128(i)
+		case -797169334:
+			n += Inline129(i)
+		case -1314955191:
+			n += Inline130(i)
+		case 130234351:
+			n += Inline131(i)
+		case -1979077526:
+			n += Inline132(i)
+		case -104475875:
+			n += Inline133(i)
+		case -1070464522:
+			n += Inline134(i)
+		case 1262747498:
+			n += Inline135(i)
+		case -1060164240:
+			n += Inline136(i)
+		case 648385636:
+			n += Inline137(i)
+		case 1528008769:
+			n += Inline138(i)
+		case -721851490:
+			n += Inline139(i)
+		case 995702448:
+			n += Inline140(i)
+		case -1598438201:
+			n += Inline141(i)
+		case 2140587224:
+			n += Inline142(i)
+		case 385843065:
+			n += Inline143(i)
+		case 108207233:
+			n += Inline144(i)
+		case 1684555679:
+			n += Inline145(i)
+		case -1763586216:
+			n += Inline146(i)
+		case -302315724:
+			n += Inline147(i)
+		case -1837873194:
+			n += Inline148(i)
+		case -1767051143:
+			n += Inline149(i)
+		case 1768507280:
+			n += Inline150(i)
+		case -1921652594:
+			n += Inline151(i)
+		case 2111437688:
+			n += Inline152(i)
+		case -180586567:
+			n += Inline153(i)
+		case -870982685:
+			n += Inline154(i)
+		case 461198367:
+			n += Inline155(i)
+		case -931857694:
+			n += Inline156(i)
+		case 1689000180:
+			n += Inline157(i)
+		case 560123204:
+			n += Inline158(i)
+		case 816184516:
+			n += Inline159(i)
+		case -1117439762:
+			n += Inline160(i)
+		case -1485880834:
+			n += Inline161(i)
+		case 422555156:
+			n += Inline162(i)
+		case -2059950988:
+			n += Inline163(i)
+		case 428335644:
+			n += Inline164(i)
+		case 652162446:
+			n += Inline165(i)
+		case 327234684:
+			n += Inline166(i)
+		case 1353808417:
+			n += Inline167(i)
+		case 685695486:
+			n += Inline168(i)
+		case 591863937:
+			n += Inline169(i)
+		case 1385602332:
+			n += Inline170(i)
+		case -1979659876:
+			n += Inline171(i)
+		case -1843178226:
+			n += Inline172(i)
+		case -2092586415:
+			n += Inline173(i)
+		case -1356457488:
+			n += Inline174(i)
+		case -1490180972:
+			n += Inline175(i)
+		case -2042257982:
+			n += Inline176(i)
+		case -1484898466:
+			n += Inline177(i)
+		case -1218188554:
+			n += Inline178(i)
+		case -1560601218:
+			n += Inline179(i)
+		case 55086860:
+			n += Inline180(i)
+		case 131779027:
+			n += Inline181(i)
+		case 421039401:
+			n += Inline182(i)
+		case 1585322719:
+			n += Inline183(i)
+		case -745373855:
+			n += Inline184(i)
+		case 1493281567:
+			n += Inline185(i)
+		case 1478821740:
+			n += Inline186(i)
+		case 1086625968:
+			n += Inline187(i)
+		case 929736495:
+			n += Inline188(i)
+		case -1911251275:
+			n += Inline189(i)
+		case 1726881131:
+			n += Inline190(i)
+		case -2119578972:
+			n += Inline191(i)
+		case 724473658:
+			n += Inline192(i)
+		case 1423215840:
+			n += Inline193(i)
+		case -739043200:
+			n += Inline194(i)
+		case -1287253784:
+			n += Inline195(i)
+		case 248791390:
+			n += Inline196(i)
+		case -3609963:
+			n += Inline197(i)
+		case 1767552401:
+			n += Inline198(i)
+		case 479638912:
+			n += Inline199(i)
+		case -941654037:
+			n += Inline200(i)
+		case 395642184:
+			n += Inline201(i)
+		case 229757662:
+			n += Inline202(i)
+		case -1225372141:
+			n += Inline203(i)
+		case 1077015772:
+			n += Inline204(i)
+		case -650116655:
+			n += Inline205(i)
+		case -112176497:
+			n += Inline206(i)
+		case 912942324:
+			n += Inline207(i)
+		case 92486895:
+			n += Inline208(i)
+		case -235386712:
+			n += Inline209(i)
+		case 399303777:
+			n += Inline210(i)
+		case -1521134074:
+			n += Inline211(i)
+		case 1339538206:
+			n += Inline212(i)
+		case 1926139776:
+			n += Inline213(i)
+		case 2092676637:
+			n += Inline214(i)
+		case 354247513:
+			n += Inline215(i)
+		case -1409483257:
+			n += Inline216(i)
+		case 1718795615:
+			n += Inline217(i)
+		case -428314982:
+			n += Inline218(i)
+		case -215249991:
+			n += Inline219(i)
+		case 1371517350:
+			n += Inline220(i)
+		case 2144844363:
+			n += Inline221(i)
+		case 1719843706:
+			n += Inline222(i)
+		case 85077591:
+			n += Inline223(i)
+		case -1524546462:
+			n += Inline224(i)
+		case 1841202787:
+			n += Inline225(i)
+		case 1458556941:
+			n += Inline226(i)
+		case -483409303:
+			n += Inline227(i)
+		case 1015018773:
+			n += Inline228(i)
+		case -1009282032:
+			n += Inline229(i)
+		case 153565041:
+			n += Inline230(i)
+		case -1170046776:
+			n += Inline231(i)
+		case -1607019451:
+			n += Inline232(i)
+		case -2091273238:
+			n += Inline233(i)
+		case 311163323:
+			n += Inline234(i)
+		case -1184427176:
+			n += Inline235(i)
+		case -516062456:
+			n += Inline236(i)
+		case -95505138:
+			n += Inline237(i)
+		case -654981387:
+			n += Inline238(i)
+		case -720704368:
+			n += Inline239(i)
+		case 1064484121:
+			n += Inline240(i)
+		case -371948193:
+			n += Inline241(i)
+		case 322282363:
+			n += Inline242(i)
+		case -708223782:
+			n += Inline243(i)
+		case -1592008263:
+			n += Inline244(i)
+		case -1066266095:
+			n += Inline245(i)
+		case -1580533217:
+			n += Inline246(i)
+		case 416329275:
+			n += Inline247(i)
+		case 63683067:
+			n += Inline248(i)
+		case -1787416174:
+			n += Inline249(i)
+		case 295305609:
+			n += Inline250(i)
+		case -7413926:
+			n += Inline251(i)
+		case -1506724278:
+			n += Inline252(i)
+		case -62424865:
+			n += Inline253(i)
+		case -709503858:
+			n += Inline254(i)
+		case 1426170028:
+			n += Inline255(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchInlineSparseLookupMod256(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysSparse[inputs[i%len(inputs)]] {
+		case -1697971134:
+			n += Inline0(i)
+		case -255511522:
+			n += Inline1(i)
+		case -1440703602:
+			n += Inline2(i)
+		case 1879968118:
+			n += Inline3(i)
+		case 1823804162:
+			n += Inline4(i)
+		case -1345084660:
+			n += Inline5(i)
+		case 281908850:
+			n += Inline6(i)
+		case 672245080:
+			n += Inline7(i)
+		case 416480912:
+			n += Inline8(i)
+		case 1292406600:
+			n += Inline9(i)
+		case -2082145907:
+			n += Inline10(i)
+		case -800410273:
+			n += Inline11(i)
+		case 920256325:
+			n += Inline12(i)
+		case 1634910179:
+			n += Inline13(i)
+		case 1366049456:
+			n += Inline14(i)
+		case 2013866549:
+			n += Inline15(i)
+		case 1215622422:
+			n += Inline16(i)
+		case 1258862891:
+			n += Inline17(i)
+		case -1378320822:
+			n += Inline18(i)
+		case 938678213:
+			n += Inline19(i)
+		case 872680990:
+			n += Inline20(i)
+		case 1549930933:
+			n += Inline21(i)
+		case -1843944239:
+			n += Inline22(i)
+		case -590594780:
+			n += Inline23(i)
+		case 1258916094:
+			n += Inline24(i)
+		case 1275959894:
+			n += Inline25(i)
+		case -1062690721:
+			n += Inline26(i)
+		case 887265776:
+			n += Inline27(i)
+		case -578381716:
+			n += Inline28(i)
+		case -1302581265:
+			n += Inline29(i)
+		case -2045176213:
+			n += Inline30(i)
+		case 121560817:
+			n += Inline31(i)
+		case 680014774:
+			n += Inline32(i)
+		case -1686833633:
+			n += Inline33(i)
+		case -106336438:
+			n += Inline34(i)
+		case 341250713:
+			n += Inline35(i)
+		case -1740283822:
+			n += Inline36(i)
+		case 253921263:
+			n += Inline37(i)
+		case -1322744326:
+			n += Inline38(i)
+		case 1295030053:
+			n += Inline39(i)
+		case 744172826:
+			n += Inline40(i)
+		case -1970961115:
+			n += Inline41(i)
+		case -1957836907:
+			n += Inline42(i)
+		case 1196181127:
+			n += Inline43(i)
+		case 1817424866:
+			n += Inline44(i)
+		case -2016119001:
+			n += Inline45(i)
+		case 1088948157:
+			n += Inline46(i)
+		case 1211528648:
+			n += Inline47(i)
+		case -907934977:
+			n += Inline48(i)
+		case 1553942706:
+			n += Inline49(i)
+		case -513063382:
+			n += Inline50(i)
+		case 1276087442:
+			n += Inline51(i)
+		case -453712918:
+			n += Inline52(i)
+		case 418564398:
+			n += Inline53(i)
+		case -99141295:
+			n += Inline54(i)
+		case 319077410:
+			n += Inline55(i)
+		case 954725776:
+			n += Inline56(i)
+		case -1369758219:
+			n += Inline57(i)
+		case 1037299406:
+			n += Inline58(i)
+		case 1337978710:
+			n += Inline59(i)
+		case -288422394:
+			n += Inline60(i)
+		case -1108750275:
+			n += Inline61(i)
+		case -854462086:
+			n += Inline62(i)
+		case -1158646983:
+			n += Inline63(i)
+		case 785656533:
+			n += Inline64(i)
+		case 1839779657:
+			n += Inline65(i)
+		case -442416174:
+			n += Inline66(i)
+		case -1362992891:
+			n += Inline67(i)
+		case -90497729:
+			n += Inline68(i)
+		case -334095804:
+			n += Inline69(i)
+		case 390143126:
+			n += Inline70(i)
+		case 2118028752:
+			n += Inline71(i)
+		case -313589291:
+			n += Inline72(i)
+		case -193507860:
+			n += Inline73(i)
+		case 1494450894:
+			n += Inline74(i)
+		case -1327837108:
+			n += Inline75(i)
+		case -1241644142:
+			n += Inline76(i)
+		case -1873552370:
+			n += Inline77(i)
+		case -1505431304:
+			n += Inline78(i)
+		case -1925154456:
+			n += Inline79(i)
+		case -1048730050:
+			n += Inline80(i)
+		case 1734321906:
+			n += Inline81(i)
+		case 561142274:
+			n += Inline82(i)
+		case -60281029:
+			n += Inline83(i)
+		case -445208814:
+			n += Inline84(i)
+		case 1383340119:
+			n += Inline85(i)
+		case -1197661229:
+			n += Inline86(i)
+		case -1526690010:
+			n += Inline87(i)
+		case 367307783:
+			n += Inline88(i)
+		case -1419163291:
+			n += Inline89(i)
+		case -1620369539:
+			n += Inline90(i)
+		case 1587818672:
+			n += Inline91(i)
+		case 1017145093:
+			n += Inline92(i)
+		case -1995949082:
+			n += Inline93(i)
+		case 804215881:
+			n += Inline94(i)
+		case 1025813007:
+			n += Inline95(i)
+		case -1597306192:
+			n += Inline96(i)
+		case 544399686:
+			n += Inline97(i)
+		case 1208304411:
+			n += Inline98(i)
+		case 1762323197:
+			n += Inline99(i)
+		case 1867934851:
+			n += Inline100(i)
+		case -1610204593:
+			n += Inline101(i)
+		case -1932104265:
+			n += Inline102(i)
+		case -1616587781:
+			n += Inline103(i)
+		case -1163159922:
+			n += Inline104(i)
+		case -727851276:
+			n += Inline105(i)
+		case 2206820:
+			n += Inline106(i)
+		case -1133576725:
+			n += Inline107(i)
+		case 1717917180:
+			n += Inline108(i)
+		case 2138327264:
+			n += Inline109(i)
+		case -1700901100:
+			n += Inline110(i)
+		case 1759297107:
+			n += Inline111(i)
+		case 127437182:
+			n += Inline112(i)
+		case 8177164:
+			n += Inline113(i)
+		case 12210768:
+			n += Inline114(i)
+		case -361544701:
+			n += Inline115(i)
+		case -1761648762:
+			n += Inline116(i)
+		case -1892395022:
+			n += Inline117(i)
+		case -792828754:
+			n += Inline118(i)
+		case -523935508:
+			n += Inline119(i)
+		case 1968995452:
+			n += Inline120(i)
+		case -1717275692:
+			n += Inline121(i)
+		case 112807962:
+			n += Inline122(i)
+		case -662143137:
+			n += Inline123(i)
+		case 1072424133:
+			n += Inline124(i)
+		case -1538524756:
+			n += Inline125(i)
+		case 1062860987:
+			n += Inline126(i)
+		case 745846173:
+			n += Inline127(i)
+		case -1749667658:
+			n += Inline128(i)
+		case -797169334:
+			n += Inline129(i)
+		case -1314955191:
+			n += Inline130(i)
+		case 130234351:
+			n += Inline131(i)
+		case -1979077526:
+			n += Inline132(i)
+		case -104475875:
+			n += Inline133(i)
+		case -1070464522:
+			n += Inline134(i)
+		case 1262747498:
+			n += Inline135(i)
+		case -1060164240:
+			n += Inline136(i)
+		case 648385636:
+			n += Inline137(i)
+		case 1528008769:
+			n += Inline138(i)
+		case -721851490:
+			n += Inline139(i)
+		case 995702448:
+			n += Inline140(i)
+		case -1598438201:
+			n += Inline141(i)
+		case 2140587224:
+			n += Inline142(i)
+		case 385843065:
+			n += Inline143(i)
+		case 108207233:
+			n += Inline144(i)
+		case 1684555679:
+			n += Inline145(i)
+		case -1763586216:
+			n += Inline146(i)
+		case -302315724:
+			n += Inline147(i)
+		case -1837873194:
+			n += Inline148(i)
+		case -1767051143:
+			n += Inline149(i)
+		case 1768507280:
+			n += Inline150(i)
+		case -1921652594:
+			n += Inline151(i)
+		case 2111437688:
+			n += Inline152(i)
+		case -180586567:
+			n += Inline153(i)
+		case -870982685:
+			n += Inline154(i)
+		case 461198367:
+			n += Inline155(i)
+		case -931857694:
+			n += Inline156(i)
+		case 1689000180:
+			n += Inline157(i)
+		case 560123204:
+			n += Inline158(i)
+		case 816184516:
+			n += Inline159(i)
+		case -1117439762:
+			n += Inline160(i)
+		case -1485880834:
+			n += Inline161(i)
+		case 422555156:
+			n += Inline162(i)
+		case -2059950988:
+			n += Inline163(i)
+		case 428335644:
+			n += Inline164(i)
+		case 652162446:
+			n += Inline165(i)
+		case 327234684:
+			n += Inline166(i)
+		case 1353808417:
+			n += Inline167(i)
+		case 685695486:
+			n += Inline168(i)
+		case 591863937:
+			n += Inline169(i)
+		case 1385602332:
+			n += Inline170(i)
+		case -1979659876:
+			n += Inline171(i)
+		case -1843178226:
+			n += Inline172(i)
+		case -2092586415:
+			n += Inline173(i)
+		case -1356457488:
+			n += Inline174(i)
+		case -1490180972:
+			n += Inline175(i)
+		case -2042257982:
+			n += Inline176(i)
+		case -1484898466:
+			n += Inline177(i)
+		case -1218188554:
+			n += Inline178(i)
+		case -1560601218:
+			n += Inline179(i)
+		case 55086860:
+			n += Inline180(i)
+		case 131779027:
+			n += Inline181(i)
+		case 421039401:
+			n += Inline182(i)
+		case 1585322719:
+			n += Inline183(i)
+		case -745373855:
+			n += Inline184(i)
+		case 1493281567:
+			n += Inline185(i)
+		case 1478821740:
+			n += Inline186(i)
+		case 1086625968:
+			n += Inline187(i)
+		case 929736495:
+			n += Inline188(i)
+		case -1911251275:
+			n += Inline189(i)
+		case 1726881131:
+			n += Inline190(i)
+		case -2119578972:
+			n += Inline191(i)
+		case 724473658:
+			n += Inline192(i)
+		case 1423215840:
+			n += Inline193(i)
+		case -739043200:
+			n += Inline194(i)
+		case -1287253784:
+			n += Inline195(i)
+		case 248791390:
+			n += Inline196(i)
+		case -3609963:
+			n += Inline197(i)
+		case 1767552401:
+			n += Inline198(i)
+		case 479638912:
+			n += Inline199(i)
+		case -941654037:
+			n += Inline200(i)
+		case 395642184:
+			n += Inline201(i)
+		case 229757662:
+			n += Inline202(i)
+		case -1225372141:
+			n += Inline203(i)
+		case 1077015772:
+			n += Inline204(i)
+		case -650116655:
+			n += Inline205(i)
+		case -112176497:
+			n += Inline206(i)
+		case 912942324:
+			n += Inline207(i)
+		case 92486895:
+			n += Inline208(i)
+		case -235386712:
+			n += Inline209(i)
+		case 399303777:
+			n += Inline210(i)
+		case -1521134074:
+			n += Inline211(i)
+		case 1339538206:
+			n += Inline212(i)
+		case 1926139776:
+			n += Inline213(i)
+		case 2092676637:
+			n += Inline214(i)
+		case 354247513:
+			n += Inline215(i)
+		case -1409483257:
+			n += Inline216(i)
+		case 1718795615:
+			n += Inline217(i)
+		case -428314982:
+			n += Inline218(i)
+		case -215249991:
+			n += Inline219(i)
+		case 1371517350:
+			n += Inline220(i)
+		case 2144844363:
+			n += Inline221(i)
+		case 1719843706:
+			n += Inline222(i)
+		case 85077591:
+			n += Inline223(i)
+		case -1524546462:
+			n += Inline224(i)
+		case 1841202787:
+			n += Inline225(i)
+		case 1458556941:
+			n += Inline226(i)
+		case -483409303:
+			n += Inline227(i)
+		case 1015018773:
+			n += Inline228(i)
+		case -1009282032:
+			n += Inline229(i)
+		case 153565041:
+			n += Inline230(i)
+		case -1170046776:
+			n += Inline231(i)
+		case -1607019451:
+			n += Inline232(i)
+		case -2091273238:
+			n += Inline233(i)
+		case 311163323:
+			n += Inline234(i)
+		case -1184427176:
+			n += Inline235(i)
+		case -516062456:
+			n += Inline236(i)
+		case -95505138:
+			n += Inline237(i)
+		case -654981387:
+			n += Inline238(i)
+		case -720704368:
+			n += Inline239(i)
+		case 1064484121:
+			n += Inline240(i)
+		case -371948193:
+			n += Inline241(i)
+		case 322282363:
+			n += Inline242(i)
+		case -708223782:
+			n += Inline243(i)
+		case -1592008263:
+			n += Inline244(i)
+		case -1066266095:
+			n += Inline245(i)
+		case -1580533217:
+			n += Inline246(i)
+		case 416329275:
+			n += Inline247(i)
+		case 63683067:
+			n += Inline248(i)
+		case -1787416174:
+			n += Inline249(i)
+		case 295305609:
+			n += Inline250(i)
+		case -7413926:
+			n += Inline251(i)
+		case -1506724278:
+			n += Inline252(i)
+		case -62424865:
+			n += Inline253(i)
+		case -709503858:
+			n += Inline254(i)
+		case 1426170028:
+			n += Inline255(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchInlineSparseComputed512(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysSparse[i%512] {
+		case -1697971134:
+			n += Inline0(i)
+		case -255511522:
+			n += Inline1(i)
+		case -1440703602:
+			n += Inline2(i)
+		case 1879968118:
+			n += Inline3(i)
+		case 1823804162:
+			n += Inline4(i)
+		case -1345084660:
+			n += Inline5(i)
+		case 281908850:
+			n += Inline6(i)
+		case 672245080:
+			n += Inline7(i)
+		case 416480912:
+			n += Inline8(i)
+		case 1292406600:
+			n += Inline9(i)
+		case -2082145907:
+			n += Inline10(i)
+		case -800410273:
+			n += Inline11(i)
+		case 920256325:
+			n += Inline12(i)
+		case 1634910179:
+			n += Inline13(i)
+		case 1366049456:
+			n += Inline14(i)
+		case 2013866549:
+			n += Inline15(i)
+		case 1215622422:
+			n += Inline16(i)
+		case 1258862891:
+			n += Inline17(i)
+		case -1378320822:
+			n += Inline18(i)
+		case 938678213:
+			n += Inline19(i)
+		case 872680990:
+			n += Inline20(i)
+		case 1549930933:
+			n += Inline21(i)
+		case -1843944239:
+			n += Inline22(i)
+		case -590594780:
+			n += Inline23(i)
+		case 1258916094:
+			n += Inline24(i)
+		case 1275959894:
+			n += Inline25(i)
+		case -1062690721:
+			n += Inline26(i)
+		case 887265776:
+			n += Inline27(i)
+		case -578381716:
+			n += Inline28(i)
+		case -1302581265:
+			n += Inline29(i)
+		case -2045176213:
+			n += Inline30(i)
+		case 121560817:
+			n += Inline31(i)
+		case 680014774:
+			n += Inline32(i)
+		case -1686833633:
+			n += Inline33(i)
+		case -106336438:
+			n += Inline34(i)
+		case 341250713:
+			n += Inline35(i)
+		case -1740283822:
+			n += Inline36(i)
+		case 253921263:
+			n += Inline37(i)
+		case -1322744326:
+			n += Inline38(i)
+		case 1295030053:
+			n += Inline39(i)
+		case 744172826:
+			n += Inline40(i)
+		case -1970961115:
+			n += Inline41(i)
+		case -1957836907:
+			n += Inline42(i)
+		case 1196181127:
+			n += Inline43(i)
+		case 1817424866:
+			n += Inline44(i)
+		case -2016119001:
+			n += Inline45(i)
+		case 1088948157:
+			n += Inline46(i)
+		case 1211528648:
+			n += Inline47(i)
+		case -907934977:
+			n += Inline48(i)
+		case 1553942706:
+			n += Inline49(i)
+		case -513063382:
+			n += Inline50(i)
+		case 1276087442:
+			n += Inline51(i)
+		case -453712918:
+			n += Inline52(i)
+		case 418564398:
+			n += Inline53(i)
+		case -99141295:
+			n += Inline54(i)
+		case 319077410:
+			n += Inline55(i)
+		case 954725776:
+			n += Inline56(i)
+		case -1369758219:
+			n += Inline57(i)
+		case 1037299406:
+			n += Inline58(i)
+		case 1337978710:
+			n += Inline59(i)
+		case -288422394:
+			n += Inline60(i)
+		case -1108750275:
+			n += Inline61(i)
+		case -854462086:
+			n += Inline62(i)
+		case -1158646983:
+			n += Inline63(i)
+		case 785656533:
+			n += Inline64(i)
+		case 1839779657:
+			n += Inline65(i)
+		case -442416174:
+			n += Inline66(i)
+		case -1362992891:
+			n += Inline67(i)
+		case -90497729:
+			n += Inline68(i)
+		case -334095804:
+			n += Inline69(i)
+		case 390143126:
+			n += Inline70(i)
+		case 2118028752:
+			n += Inline71(i)
+		case -313589291:
+			n += Inline72(i)
+		case -193507860:
+			n += Inline73(i)
+		case 1494450894:
+			n += Inline74(i)
+		case -1327837108:
+			n += Inline75(i)
+		case -1241644142:
+			n += Inline76(i)
+		case -1873552370:
+			n += Inline77(i)
+		case -1505431304:
+			n += Inline78(i)
+		case -1925154456:
+			n += Inline79(i)
+		case -1048730050:
+			n += Inline80(i)
+		case 1734321906:
+			n += Inline81(i)
+		case 561142274:
+			n += Inline82(i)
+		case -60281029:
+			n += Inline83(i)
+		case -445208814:
+			n += Inline84(i)
+		case 1383340119:
+			n += Inline85(i)
+		case -1197661229:
+			n += Inline86(i)
+		case -1526690010:
+			n += Inline87(i)
+		case 367307783:
+			n += Inline88(i)
+		case -1419163291:
+			n += Inline89(i)
+		case -1620369539:
+			n += Inline90(i)
+		case 1587818672:
+			n += Inline91(i)
+		case 1017145093:
+			n += Inline92(i)
+		case -1995949082:
+			n += Inline93(i)
+		case 804215881:
+			n += Inline94(i)
+		case 1025813007:
+			n += Inline95(i)
+		case -1597306192:
+			n += Inline96(i)
+		case 544399686:
+			n += Inline97(i)
+		case 1208304411:
+			n += Inline98(i)
+		case 1762323197:
+			n += Inline99(i)
+		case 1867934851:
+			n += Inline100(i)
+		case -1610204593:
+			n += Inline101(i)
+		case -1932104265:
+			n += Inline102(i)
+		case -1616587781:
+			n += Inline103(i)
+		case -1163159922:
+			n += Inline104(i)
+		case -727851276:
+			n += Inline105(i)
+		case 2206820:
+			n += Inline106(i)
+		case -1133576725:
+			n += Inline107(i)
+		case 1717917180:
+			n += Inline108(i)
+		case 2138327264:
+			n += Inline109(i)
+		case -1700901100:
+			n += Inline110(i)
+		case 1759297107:
+			n += Inline111(i)
+		case 127437182:
+			n += Inline112(i)
+		case 8177164:
+			n += Inline113(i)
+		case 12210768:
+			n += Inline114(i)
+		case -361544701:
+			n += Inline115(i)
+		case -1761648762:
+			n += Inline116(i)
+		case -1892395022:
+			n += Inline117(i)
+		case -792828754:
+			n += Inline118(i)
+		case -523935508:
+			n += Inline119(i)
+		case 1968995452:
+			n += Inline120(i)
+		case -1717275692:
+			n += Inline121(i)
+		case 112807962:
+			n += Inline122(i)
+		case -662143137:
+			n += Inline123(i)
+		case 1072424133:
+			n += Inline124(i)
+		case -1538524756:
+			n += Inline125(i)
+		case 1062860987:
+			n += Inline126(i)
+		case 745846173:
+			n += Inline127(i)
+		case -1749667658:
+			n += Inline128(i)
+		case -797169334:
+			n += Inline129(i)
+		case -1314955191:
+			n += Inline130(i)
+		case 130234351:
+			n += Inline131(i)
+		case -1979077526:
+			n += Inline132(i)
+		case -104475875:
+			n += Inline133(i)
+		case -1070464522:
+			n += Inline134(i)
+		case 1262747498:
+			n += Inline135(i)
+		case -1060164240:
+			n += Inline136(i)
+		case 648385636:
+			n += Inline137(i)
+		case 1528008769:
+			n += Inline138(i)
+		case -721851490:
+			n += Inline139(i)
+		case 995702448:
+			n += Inline140(i)
+		case -1598438201:
+			n += Inline141(i)
+		case 2140587224:
+			n += Inline142(i)
+		case 385843065:
+			n += Inline143(i)
+		case 108207233:
+			n += Inline144(i)
+		case 1684555679:
+			n += Inline145(i)
+		case -1763586216:
+			n += Inline146(i)
+		case -302315724:
+			n += Inline147(i)
+		case -1837873194:
+			n += Inline148(i)
+		case -1767051143:
+			n += Inline149(i)
+		case 1768507280:
+			n += Inline150(i)
+		case -1921652594:
+			n += Inline151(i)
+		case 2111437688:
+			n += Inline152(i)
+		case -180586567:
+			n += Inline153(i)
+		case -870982685:
+			n += Inline154(i)
+		case 461198367:
+			n += Inline155(i)
+		case -931857694:
+			n += Inline156(i)
+		case 1689000180:
+			n += Inline157(i)
+		case 560123204:
+			n += Inline158(i)
+		case 816184516:
+			n += Inline159(i)
+		case -1117439762:
+			n += Inline160(i)
+		case -1485880834:
+			n += Inline161(i)
+		case 422555156:
+			n += Inline162(i)
+		case -2059950988:
+			n += Inline163(i)
+		case 428335644:
+			n += Inline164(i)
+		case 652162446:
+			n += Inline165(i)
+		case 327234684:
+			n += Inline166(i)
+		case 1353808417:
+			n += Inline167(i)
+		case 685695486:
+			n += Inline168(i)
+		case 591863937:
+			n += Inline169(i)
+		case 1385602332:
+			n += Inline170(i)
+		case -1979659876:
+			n += Inline171(i)
+		case -1843178226:
+			n += Inline172(i)
+		case -2092586415:
+			n += Inline173(i)
+		case -1356457488:
+			n += Inline174(i)
+		case -1490180972:
+			n += Inline175(i)
+		case -2042257982:
+			n += Inline176(i)
+		case -1484898466:
+			n += Inline177(i)
+		case -1218188554:
+			n += Inline178(i)
+		case -1560601218:
+			n += Inline179(i)
+		case 55086860:
+			n += Inline180(i)
+		case 131779027:
+			n += Inline181(i)
+		case 421039401:
+			n += Inline182(i)
+		case 1585322719:
+			n += Inline183(i)
+		case -745373855:
+			n += Inline184(i)
+		case 1493281567:
+			n += Inline185(i)
+		case 1478821740:
+			n += Inline186(i)
+		case 1086625968:
+			n += Inline187(i)
+		case 929736495:
+			n += Inline188(i)
+		case -1911251275:
+			n += Inline189(i)
+		case 1726881131:
+			n += Inline190(i)
+		case -2119578972:
+			n += Inline191(i)
+		case 724473658:
+			n += Inline192(i)
+		case 1423215840:
+			n += Inline193(i)
+		case -739043200:
+			n += Inline194(i)
+		case -1287253784:
+			n += Inline195(i)
+		case 248791390:
+			n += Inline196(i)
+		case -3609963:
+			n += Inline197(i)
+		case 1767552401:
+			n += Inline198(i)
+		case 479638912:
+			n += Inline199(i)
+		case -941654037:
+			n += Inline200(i)
+		case 395642184:
+			n += Inline201(i)
+		case 229757662:
+			n += Inline202(i)
+		case -1225372141:
+			n += Inline203(i)
+		case 1077015772:
+			n += Inline204(i)
+		case -650116655:
+			n += Inline205(i)
+		case -112176497:
+			n += Inline206(i)
+		case 912942324:
+			n += Inline207(i)
+		case 92486895:
+			n += Inline208(i)
+		case -235386712:
+			n += Inline209(i)
+		case 399303777:
+			n += Inline210(i)
+		case -1521134074:
+			n += Inline211(i)
+		case 1339538206:
+			n += Inline212(i)
+		case 1926139776:
+			n += Inline213(i)
+		case 2092676637:
+			n += Inline214(i)
+		case 354247513:
+			n += Inline215(i)
+		case -1409483257:
+			n += Inline216(i)
+		case 1718795615:
+			n += Inline217(i)
+		case -428314982:
+			n += Inline218(i)
+		case -215249991:
+			n += Inline219(i)
+		case 1371517350:
+			n += Inline220(i)
+		case 2144844363:
+			n += Inline221(i)
+		case 1719843706:
+			n += Inline222(i)
+		case 85077591:
+			n += Inline223(i)
+		case -1524546462:
+			n += Inline224(i)
+		case 1841202787:
+			n += Inline225(i)
+		case 1458556941:
+			n += Inline226(i)
+		case -483409303:
+			n += Inline227(i)
+		case 1015018773:
+			n += Inline228(i)
+		case -1009282032:
+			n += Inline229(i)
+		case 153565041:
+			n += Inline230(i)
+		case -1170046776:
+			n += Inline231(i)
+		case -1607019451:
+			n += Inline232(i)
+		case -2091273238:
+			n += Inline233(i)
+		case 311163323:
+			n += Inline234(i)
+		case -1184427176:
+			n += Inline235(i)
+		case -516062456:
+			n += Inline236(i)
+		case -95505138:
+			n += Inline237(i)
+		case -654981387:
+			n += Inline238(i)
+		case -720704368:
+			n += Inline239(i)
+		case 1064484121:
+			n += Inline240(i)
+		case -371948193:
+			n += Inline241(i)
+		case 322282363:
+			n += Inline242(i)
+		case -708223782:
+			n += Inline243(i)
+		case -1592008263:
+			n += Inline244(i)
+		case -1066266095:
+			n += Inline245(i)
+		case -1580533217:
+			n += Inline246(i)
+		case 416329275:
+			n += Inline247(i)
+		case 63683067:
+			n += Inline248(i)
+		case -1787416174:
+			n += Inline249(i)
+		case 295305609:
+			n += Inline250(i)
+		case -7413926:
+			n += Inline251(i)
+		case -1506724278:
+			n += Inline252(i)
+		case -62424865:
+			n += Inline253(i)
+		case -709503858:
+			n += Inline254(i)
+		case 1426170028:
+			n += Inline255(i)
+		case -1454305216:
+			n += Inline256(i)
+		case -188889971:
+			n += Inline257(i)
+		case 1333631481:
+			n += Inline258(i)
+		case 791952000:
+			n += Inline259(i)
+		case -141328728:
+			n += Inline260(i)
+		case -716220949:
+			n += Inline261(i)
+		case 1329500471:
+			n += Inline262(i)
+		case -833774410:
+			n += Inline263(i)
+		case 1792400843:
+			n += Inline264(i)
+		case -1208902515:
+			n += Inline265(i)
+		case 1746921148:
+			n += Inline266(i)
+		case -447521558:
+			n += Inline267(i)
+		case -179631155:
+			n += Inline268(i)
+		case 80372672:
+			n += Inline269(i)
+		case -894760620:
+			n += Inline270(i)
+		case 1819146944:
+			n += Inline271(i)
+		case 65203089:
+			n += Inline272(i)
+		case 1858424790:
+			n += Inline273(i)
+		case -408982948:
+			n += Inline274(i)
+		case -619744821:
+			n += Inline275(i)
+		case 184347049:
+			n += Inline276(i)
+		case -1464452710:
+			n += Inline277(i)
+		case 1494043213:
+			n += Inline278(i)
+		case -2132507999:
+			n += Inline279(i)
+		case -687420592:
+			n += Inline280(i)
+		case 99254840:
+			n += Inline281(i)
+		case 534137245:
+			n += Inline282(i)
+		case 1121740742:
+			n += Inline283(i)
+		case -708895062:
+			n += Inline284(i)
+		case 1352076303:
+			n += Inline285(i)
+		case 32990530:
+			n += Inline286(i)
+		case -430569932:
+			n += Inline287(i)
+		case 1590286959:
+			n += Inline288(i)
+		case 430353185:
+			n += Inline289(i)
+		case -1532427038:
+			n += Inline290(i)
+		case -988318833:
+			n += Inline291(i)
+		case -897109853:
+			n += Inline292(i)
+		case 1126921711:
+			n += Inline293(i)
+		case 1489769022:
+			n += Inline294(i)
+		case 921930687:
+			n += Inline295(i)
+		case -764105189:
+			n += Inline296(i)
+		case 1508110333:
+			n += Inline297(i)
+		case -1721447578:
+			n += Inline298(i)
+		case -1810967562:
+			n += Inline299(i)
+		case 1776316817:
+			n += Inline300(i)
+		case 514754016:
+			n += Inline301(i)
+		case -379616239:
+			n += Inline302(i)
+		case 231007307:
+			n += Inline303(i)
+		case 983193548:
+			n += Inline304(i)
+		case 1392316569:
+			n += Inline305(i)
+		case 1506524751:
+			n += Inline306(i)
+		case 1500183748:
+			n += Inline307(i)
+		case 1304820212:
+			n += Inline308(i)
+		case -134234221:
+			n += Inline309(i)
+		case -1410782724:
+			n += Inline310(i)
+		case 893108935:
+			n += Inline311(i)
+		case -158315067:
+			n += Inline312(i)
+		case 1297949317:
+			n += Inline313(i)
+		case -824886777:
+			n += Inline314(i)
+		case 575887093:
+			n += Inline315(i)
+		case -224367850:
+			n += Inline316(i)
+		case -1542473848:
+			n += Inline317(i)
+		case -200752122:
+			n += Inline318(i)
+		case -816583982:
+			n += Inline319(i)
+		case 779926748:
+			n += Inline320(i)
+		case -245855218:
+			n += Inline321(i)
+		case -724814234:
+			n += Inline322(i)
+		case 2124636293:
+			n += Inline323(i)
+		case -621437330:
+			n += Inline324(i)
+		case -1242345439:
+			n += Inline325(i)
+		case 1174651033:
+			n += Inline326(i)
+		case 1750769853:
+			n += Inline327(i)
+		case -387572341:
+			n += Inline328(i)
+		case -238812471:
+			n += Inline329(i)
+		case 2141610073:
+			n += Inline330(i)
+		case 1239692102:
+			n += Inline331(i)
+		case -103529086:
+			n += Inline332(i)
+		case 1943835524:
+			n += Inline333(i)
+		case 193233579:
+			n += Inline334(i)
+		case 1354469397:
+			n += Inline335(i)
+		case -206561521:
+			n += Inline336(i)
+		case -1067028436:
+			n += Inline337(i)
+		case -1993762478:
+			n += Inline338(i)
+		case -1418565040:
+			n += Inline339(i)
+		case -579068977:
+			n += Inline340(i)
+		case 1970893722:
+			n += Inline341(i)
+		case -1810110091:
+			n += Inline342(i)
+		case 2068154836:
+			n += Inline343(i)
+		case -1930090606:
+			n += Inline344(i)
+		case -212071554:
+			n += Inline345(i)
+		case -2105111990:
+			n += Inline346(i)
+		case -1105890816:
+			n += Inline347(i)
+		case 2107944240:
+			n += Inline348(i)
+		case 284118163:
+			n += Inline349(i)
+		case 1127388811:
+			n += Inline350(i)
+		case -320112743:
+			n += Inline351(i)
+		case 1595523019:
+			n += Inline352(i)
+		case 1758442933:
+			n += Inline353(i)
+		case 1785641113:
+			n += Inline354(i)
+		case 417735390:
+			n += Inline355(i)
+		case -422506136:
+			n += Inline356(i)
+		case 19089668:
+			n += Inline357(i)
+		case 1176496955:
+			n += Inline358(i)
+		case 469468534:
+			n += Inline359(i)
+		case -620844340:
+			n += Inline360(i)
+		case 1104044350:
+			n += Inline361(i)
+		case -46677310:
+			n += Inline362(i)
+		case -316060535:
+			n += Inline363(i)
+		case 734207617:
+			n += Inline364(i)
+		case 1305185263:
+			n += Inline365(i)
+		case -2003810766:
+			n += Inline366(i)
+		case 758017117:
+			n += Inline367(i)
+		case -800621527:
+			n += Inline368(i)
+		case -1266426411:
+			n += Inline369(i)
+		case 1104698047:
+			n += Inline370(i)
+		case 1075326325:
+			n += Inline371(i)
+		case 1439219346:
+			n += Inline372(i)
+		case -1068413352:
+			n += Inline373(i)
+		case 20958506:
+			n += Inline374(i)
+		case -682928979:
+			n += Inline375(i)
+		case 986011055:
+			n += Inline376(i)
+		case 57060992:
+			n += Inline377(i)
+		case -215017199:
+			n += Inline378(i)
+		case -432196269:
+			n += Inline379(i)
+		case -160527834:
+			n += Inline380(i)
+		case 184685148:
+			n += Inline381(i)
+		case -1234104684:
+			n += Inline382(i)
+		case 219447481:
+			n += Inline383(i)
+		case 1750337062:
+			n += Inline384(i)
+		case 2043104664:
+			n += Inline385(i)
+		case 1492365354:
+			n += Inline386(i)
+		case 174890805:
+			n += Inline387(i)
+		case -1728439988:
+			n += Inline388(i)
+		case 1117226966:
+			n += Inline389(i)
+		case -717878635:
+			n += Inline390(i)
+		case -169661923:
+			n += Inline391(i)
+		case -271838922:
+			n += Inline392(i)
+		case 984922924:
+			n += Inline393(i)
+		case -1201246088:
+			n += Inline394(i)
+		case -1045900454:
+			n += Inline395(i)
+		case 1933396386:
+			n += Inline396(i)
+		case 1455896797:
+			n += Inline397(i)
+		case 2029337912:
+			n += Inline398(i)
+		case -60153766:
+			n += Inline399(i)
+		case 1556201844:
+			n += Inline400(i)
+		case 1072260091:
+			n += Inline401(i)
+		case 205592989:
+			n += Inline402(i)
+		case -903157683:
+			n += Inline403(i)
+		case -1047069298:
+			n += Inline404(i)
+		case -1471159248:
+			n += Inline405(i)
+		case 949182841:
+			n += Inline406(i)
+		case -1471003208:
+			n += Inline407(i)
+		case 1678655519:
+			n += Inline408(i)
+		case -346799282:
+			n += Inline409(i)
+		case 642894248:
+			n += Inline410(i)
+		case -2059900581:
+			n += Inline411(i)
+		case -1006789590:
+			n += Inline412(i)
+		case 1065935549:
+			n += Inline413(i)
+		case 1956116951:
+			n += Inline414(i)
+		case -1289395816:
+			n += Inline415(i)
+		case 352056179:
+			n += Inline416(i)
+		case -2094775668:
+			n += Inline417(i)
+		case 1144101090:
+			n += Inline418(i)
+		case -1623490618:
+			n += Inline419(i)
+		case 425503163:
+			n += Inline420(i)
+		case 603766263:
+			n += Inline421(i)
+		case 683774103:
+			n += Inline422(i)
+		case -240453325:
+			n += Inline423(i)
+		case -1582796037:
+			n += Inline424(i)
+		case 1055668406:
+			n += Inline425(i)
+		case -139563906:
+			n += Inline426(i)
+		case -1988653112:
+			n += Inline427(i)
+		case 1452476744:
+			n += Inline428(i)
+		case -1410176746:
+			n += Inline429(i)
+		case -698078541:
+			n += Inline430(i)
+		case -1143084771:
+			n += Inline431(i)
+		case -2137945349:
+			n += Inline432(i)
+		case 1818806034:
+			n += Inline433(i)
+		case 1988739519:
+			n += Inline434(i)
+		case -201671681:
+			n += Inline435(i)
+		case -1099199736:
+			n += Inline436(i)
+		case 1955024410:
+			n += Inline437(i)
+		case 1066942813:
+			n += Inline438(i)
+		case -1203082347:
+			n += Inline439(i)
+		case 1819787089:
+			n += Inline440(i)
+		case -1105563850:
+			n += Inline441(i)
+		case -1473825507:
+			n += Inline442(i)
+		case -1363559451:
+			n += Inline443(i)
+		case -1559971230:
+			n += Inline444(i)
+		case 1067655148:
+			n += Inline445(i)
+		case 1661557221:
+			n += Inline446(i)
+		case -987967235:
+			n += Inline447(i)
+		case 542730790:
+			n += Inline448(i)
+		case 1231099828:
+			n += Inline449(i)
+		case -2098782249:
+			n += Inline450(i)
+		case 852815300:
+			n += Inline451(i)
+		case -23165478:
+			n += Inline452(i)
+		case -1563568742:
+			n += Inline453(i)
+		case -1171156006:
+			n += Inline454(i)
+		case 1770881343:
+			n += Inline455(i)
+		case -2087252879:
+			n += Inline456(i)
+		case 563456396:
+			n += Inline457(i)
+		case -1951621827:
+			n += Inline458(i)
+		case -665569537:
+			n += Inline459(i)
+		case 1259646708:
+			n += Inline460(i)
+		case -952177854:
+			n += Inline461(i)
+		case 608935175:
+			n += Inline462(i)
+		case -1484428644:
+			n += Inline463(i)
+		case -1583347276:
+			n += Inline464(i)
+		case 1692067842:
+			n += Inline465(i)
+		case 6062535:
+			n += Inline466(i)
+		case -1864588694:
+			n += Inline467(i)
+		case -481929598:
+			n += Inline468(i)
+		case 1943398976:
+			n += Inline469(i)
+		case -1734399456:
+			n += Inline470(i)
+		case -1534010547:
+			n += Inline471(i)
+		case -730838493:
+			n += Inline472(i)
+		case -814411016:
+			n += Inline473(i)
+		case 283187946:
+			n += Inline474(i)
+		case 349931193:
+			n += Inline475(i)
+		case 1441038932:
+			n += Inline476(i)
+		case 2107260151:
+			n += Inline477(i)
+		case -1636255076:
+			n += Inline478(i)
+		case -1599193552:
+			n += Inline479(i)
+		case 1394335827:
+			n += Inline480(i)
+		case -888136867:
+			n += Inline481(i)
+		case 361579919:
+			n += Inline482(i)
+		case 1092320650:
+			n += Inline483(i)
+		case -1341884404:
+			n += Inline484(i)
+		case 1804819842:
+			n += Inline485(i)
+		case 95052488:
+			n += Inline486(i)
+		case 931994127:
+			n += Inline487(i)
+		case 336062872:
+			n += Inline488(i)
+		case -1873274321:
+			n += Inline489(i)
+		case 981722977:
+			n += Inline490(i)
+		case 1578716447:
+			n += Inline491(i)
+		case 1168423850:
+			n += Inline492(i)
+		case -428209243:
+			n += Inline493(i)
+		case -1452428858:
+			n += Inline494(i)
+		case 1911818401:
+			n += Inline495(i)
+		case -134241068:
+			n += Inline496(i)
+		case -1427589835:
+			n += Inline497(i)
+		case 1565086353:
+			n += Inline498(i)
+		case 28462600:
+			n += Inline499(i)
+		case 885516103:
+			n += Inline500(i)
+		case -934348428:
+			n += Inline501(i)
+		case -1003170058:
+			n += Inline502(i)
+		case -823595963:
+			n += Inline503(i)
+		case 1236561826:
+			n += Inline504(i)
+		case -1675253206:
+			n += Inline505(i)
+		case 1942201082:
+			n += Inline506(i)
+		case 972851284:
+			n += Inline507(i)
+		case 1670984478:
+			n += Inline508(i)
+		case 1060275388:
+			n += Inline509(i)
+		case 32334443:
+			n += Inline510(i)
+		case 659173149:
+			n += Inline511(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchInlineSparseLookupMod512(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysSparse[inputs[i%len(inputs)]] {
+		case -1697971134:
+			n += Inline0(i)
+		case -255511522:
+			n += Inline1(i)
+		case -1440703602:
+			n += Inline2(i)
+		case 1879968118:
+			n += Inline3(i)
+		case 1823804162:
+			n += Inline4(i)
+		case -1345084660:
+			n += Inline5(i)
+		case 281908850:
+			n += Inline6(i)
+		case 672245080:
+			n += Inline7(i)
+		case 416480912:
+			n += Inline8(i)
+		case 1292406600:
+			n += Inline9(i)
+		case -2082145907:
+			n += Inline10(i)
+		case -800410273:
+			n += Inline11(i)
+		case 920256325:
+			n += Inline12(i)
+		case 1634910179:
+			n += Inline13(i)
+		case 1366049456:
+			n += Inline14(i)
+		case 2013866549:
+			n += Inline15(i)
+		case 1215622422:
+			n += Inline16(i)
+		case 1258862891:
+			n += Inline17(i)
+		case -1378320822:
+			n += Inline18(i)
+		case 938678213:
+			n += Inline19(i)
+		case 872680990:
+			n += Inline20(i)
+		case 1549930933:
+			n += Inline21(i)
+		case -1843944239:
+			n += Inline22(i)
+		case -590594780:
+			n += Inline23(i)
+		case 1258916094:
+			n += Inline24(i)
+		case 1275959894:
+			n += Inline25(i)
+		case -1062690721:
+			n += Inline26(i)
+		case 887265776:
+			n += Inline27(i)
+		case -578381716:
+			n += Inline28(i)
+		case -1302581265:
+			n += Inline29(i)
+		case -2045176213:
+			n += Inline30(i)
+		case 121560817:
+			n += Inline31(i)
+		case 680014774:
+			n += Inline32(i)
+		case -1686833633:
+			n += Inline33(i)
+		case -106336438:
+			n += Inline34(i)
+		case 341250713:
+			n += Inline35(i)
+		case -1740283822:
+			n += Inline36(i)
+		case 253921263:
+			n += Inline37(i)
+		case -1322744326:
+			n += Inline38(i)
+		case 1295030053:
+			n += Inline39(i)
+		case 744172826:
+			n += Inline40(i)
+		case -1970961115:
+			n += Inline41(i)
+		case -1957836907:
+			n += Inline42(i)
+		case 1196181127:
+			n += Inline43(i)
+		case 1817424866:
+			n += Inline44(i)
+		case -2016119001:
+			n += Inline45(i)
+		case 1088948157:
+			n += Inline46(i)
+		case 1211528648:
+			n += Inline47(i)
+		case -907934977:
+			n += Inline48(i)
+		case 1553942706:
+			n += Inline49(i)
+		case -513063382:
+			n += Inline50(i)
+		case 1276087442:
+			n += Inline51(i)
+		case -453712918:
+			n += Inline52(i)
+		case 418564398:
+			n += Inline53(i)
+		case -99141295:
+			n += Inline54(i)
+		case 319077410:
+			n += Inline55(i)
+		case 954725776:
+			n += Inline56(i)
+		case -1369758219:
+			n += Inline57(i)
+		case 1037299406:
+			n += Inline58(i)
+		case 1337978710:
+			n += Inline59(i)
+		case -288422394:
+			n += Inline60(i)
+		case -1108750275:
+			n += Inline61(i)
+		case -854462086:
+			n += Inline62(i)
+		case -1158646983:
+			n += Inline63(i)
+		case 785656533:
+			n += Inline64(i)
+		case 1839779657:
+			n += Inline65(i)
+		case -442416174:
+			n += Inline66(i)
+		case -1362992891:
+			n += Inline67(i)
+		case -90497729:
+			n += Inline68(i)
+		case -334095804:
+			n += Inline69(i)
+		case 390143126:
+			n += Inline70(i)
+		case 2118028752:
+			n += Inline71(i)
+		case -313589291:
+			n += Inline72(i)
+		case -193507860:
+			n += Inline73(i)
+		case 1494450894:
+			n += Inline74(i)
+		case -1327837108:
+			n += Inline75(i)
+		case -1241644142:
+			n += Inline76(i)
+		case -1873552370:
+			n += Inline77(i)
+		case -1505431304:
+			n += Inline78(i)
+		case -1925154456:
+			n += Inline79(i)
+		case -1048730050:
+			n += Inline80(i)
+		case 1734321906:
+			n += Inline81(i)
+		case 561142274:
+			n += Inline82(i)
+		case -60281029:
+			n += Inline83(i)
+		case -445208814:
+			n += Inline84(i)
+		case 1383340119:
+			n += Inline85(i)
+		case -1197661229:
+			n += Inline86(i)
+		case -1526690010:
+			n += Inline87(i)
+		case 367307783:
+			n += Inline88(i)
+		case -1419163291:
+			n += Inline89(i)
+		case -1620369539:
+			n += Inline90(i)
+		case 1587818672:
+			n += Inline91(i)
+		case 1017145093:
+			n += Inline92(i)
+		case -1995949082:
+			n += Inline93(i)
+		case 804215881:
+			n += Inline94(i)
+		case 1025813007:
+			n += Inline95(i)
+		case -1597306192:
+			n += Inline96(i)
+		case 544399686:
+			n += Inline97(i)
+		case 1208304411:
+			n += Inline98(i)
+		case 1762323197:
+			n += Inline99(i)
+		case 1867934851:
+			n += Inline100(i)
+		case -1610204593:
+			n += Inline101(i)
+		case -1932104265:
+			n += Inline102(i)
+		case -1616587781:
+			n += Inline103(i)
+		case -1163159922:
+			n += Inline104(i)
+		case -727851276:
+			n += Inline105(i)
+		case 2206820:
+			n += Inline106(i)
+		case -1133576725:
+			n += Inline107(i)
+		case 1717917180:
+			n += Inline108(i)
+		case 2138327264:
+			n += Inline109(i)
+		case -1700901100:
+			n += Inline110(i)
+		case 1759297107:
+			n += Inline111(i)
+		case 127437182:
+			n += Inline112(i)
+		case 8177164:
+			n += Inline113(i)
+		case 12210768:
+			n += Inline114(i)
+		case -361544701:
+			n += Inline115(i)
+		case -1761648762:
+			n += Inline116(i)
+		case -1892395022:
+			n += Inline117(i)
+		case -792828754:
+			n += Inline118(i)
+		case -523935508:
+			n += Inline119(i)
+		case 1968995452:
+			n += Inline120(i)
+		case -1717275692:
+			n += Inline121(i)
+		case 112807962:
+			n += Inline122(i)
+		case -662143137:
+			n += Inline123(i)
+		case 1072424133:
+			n += Inline124(i)
+		case -1538524756:
+			n += Inline125(i)
+		case 1062860987:
+			n += Inline126(i)
+		case 745846173:
+			n += Inline127(i)
+		case -1749667658:
+			n += Inline128(i)
+		case -797169334:
+			n += Inline129(i)
+		case -1314955191:
+			n += Inline130(i)
+		case 130234351:
+			n += Inline131(i)
+		case -1979077526:
+			n += Inline132(i)
+		case -104475875:
+			n += Inline133(i)
+		case -1070464522:
+			n += Inline134(i)
+		case 1262747498:
+			n += Inline135(i)
+		case -1060164240:
+			n += Inline136(i)
+		case 648385636:
+			n += Inline137(i)
+		case 1528008769:
+			n += Inline138(i)
+		case -721851490:
+			n += Inline139(i)
+		case 995702448:
+			n += Inline140(i)
+		case -1598438201:
+			n += Inline141(i)
+		case 2140587224:
+			n += Inline142(i)
+		case 385843065:
+			n += Inline143(i)
+		case 108207233:
+			n += Inline144(i)
+		case 1684555679:
+			n += Inline145(i)
+		case -1763586216:
+			n += Inline146(i)
+		case -302315724:
+			n += Inline147(i)
+		case -1837873194:
+			n += Inline148(i)
+		case -1767051143:
+			n += Inline149(i)
+		case 1768507280:
+			n += Inline150(i)
+		case -1921652594:
+			n += Inline151(i)
+		case 2111437688:
+			n += Inline152(i)
+		case -180586567:
+			n += Inline153(i)
+		case -870982685:
+			n += Inline154(i)
+		case 461198367:
+			n += Inline155(i)
+		case -931857694:
+			n += Inline156(i)
+		case 1689000180:
+			n += Inline157(i)
+		case 560123204:
+			n += Inline158(i)
+		case 816184516:
+			n += Inline159(i)
+		case -1117439762:
+			n += Inline160(i)
+		case -1485880834:
+			n += Inline161(i)
+		case 422555156:
+			n += Inline162(i)
+		case -2059950988:
+			n += Inline163(i)
+		case 428335644:
+			n += Inline164(i)
+		case 652162446:
+			n += Inline165(i)
+		case 327234684:
+			n += Inline166(i)
+		case 1353808417:
+			n += Inline167(i)
+		case 685695486:
+			n += Inline168(i)
+		case 591863937:
+			n += Inline169(i)
+		case 1385602332:
+			n += Inline170(i)
+		case -1979659876:
+			n += Inline171(i)
+		case -1843178226:
+			n += Inline172(i)
+		case -2092586415:
+			n += Inline173(i)
+		case -1356457488:
+			n += Inline174(i)
+		case -1490180972:
+			n += Inline175(i)
+		case -2042257982:
+			n += Inline176(i)
+		case -1484898466:
+			n += Inline177(i)
+		case -1218188554:
+			n += Inline178(i)
+		case -1560601218:
+			n += Inline179(i)
+		case 55086860:
+			n += Inline180(i)
+		case 131779027:
+			n += Inline181(i)
+		case 421039401:
+			n += Inline182(i)
+		case 1585322719:
+			n += Inline183(i)
+		case -745373855:
+			n += Inline184(i)
+		case 1493281567:
+			n += Inline185(i)
+		case 1478821740:
+			n += Inline186(i)
+		case 1086625968:
+			n += Inline187(i)
+		case 929736495:
+			n += Inline188(i)
+		case -1911251275:
+			n += Inline189(i)
+		case 1726881131:
+			n += Inline190(i)
+		case -2119578972:
+			n += Inline191(i)
+		case 724473658:
+			n += Inline192(i)
+		case 1423215840:
+			n += Inline193(i)
+		case -739043200:
+			n += Inline194(i)
+		case -1287253784:
+			n += Inline195(i)
+		case 248791390:
+			n += Inline196(i)
+		case -3609963:
+			n += Inline197(i)
+		case 1767552401:
+			n += Inline198(i)
+		case 479638912:
+			n += Inline199(i)
+		case -941654037:
+			n += Inline200(i)
+		case 395642184:
+			n += Inline201(i)
+		case 229757662:
+			n += Inline202(i)
+		case -1225372141:
+			n += Inline203(i)
+		case 1077015772:
+			n += Inline204(i)
+		case -650116655:
+			n += Inline205(i)
+		case -112176497:
+			n += Inline206(i)
+		case 912942324:
+			n += Inline207(i)
+		case 92486895:
+			n += Inline208(i)
+		case -235386712:
+			n += Inline209(i)
+		case 399303777:
+			n += Inline210(i)
+		case -1521134074:
+			n += Inline211(i)
+		case 1339538206:
+			n += Inline212(i)
+		case 1926139776:
+			n += Inline213(i)
+		case 2092676637:
+			n += Inline214(i)
+		case 354247513:
+			n += Inline215(i)
+		case -1409483257:
+			n += Inline216(i)
+		case 1718795615:
+			n += Inline217(i)
+		case -428314982:
+			n += Inline218(i)
+		case -215249991:
+			n += Inline219(i)
+		case 1371517350:
+			n += Inline220(i)
+		case 2144844363:
+			n += Inline221(i)
+		case 1719843706:
+			n += Inline222(i)
+		case 85077591:
+			n += Inline223(i)
+		case -1524546462:
+			n += Inline224(i)
+		case 1841202787:
+			n += Inline225(i)
+		case 1458556941:
+			n += Inline226(i)
+		case -483409303:
+			n += Inline227(i)
+		case 1015018773:
+			n += Inline228(i)
+		case -1009282032:
+			n += Inline229(i)
+		case 153565041:
+			n += Inline230(i)
+		case -1170046776:
+			n += Inline231(i)
+		case -1607019451:
+			n += Inline232(i)
+		case -2091273238:
+			n += Inline233(i)
+		case 311163323:
+			n += Inline234(i)
+		case -1184427176:
+			n += Inline235(i)
+		case -516062456:
+			n += Inline236(i)
+		case -95505138:
+			n += Inline237(i)
+		case -654981387:
+			n += Inline238(i)
+		case -720704368:
+			n += Inline239(i)
+		case 1064484121:
+			n += Inline240(i)
+		case -371948193:
+			n += Inline241(i)
+		case 322282363:
+			n += Inline242(i)
+		case -708223782:
+			n += Inline243(i)
+		case -1592008263:
+			n += Inline244(i)
+		case -1066266095:
+			n += Inline245(i)
+		case -1580533217:
+			n += Inline246(i)
+		case 416329275:
+			n += Inline247(i)
+		case 63683067:
+			n += Inline248(i)
+		case -1787416174:
+			n += Inline249(i)
+		case 295305609:
+			n += Inline250(i)
+		case -7413926:
+			n += Inline251(i)
+		case -1506724278:
+			n += Inline252(i)
+		case -62424865:
+			n += Inline253(i)
+		case -709503858:
+			n += Inline254(i)
+		case 1426170028:
+			n += Inline255(i)
+		case -1454305216:
+			n += Inline256(i)
+		case -188889971:
+			n += Inline257(i)
+		case 1333631481:
+			n += Inline258(i)
+		case 791952000:
+			n += Inline259(i)
+		case -141328728:
+			n += Inline260(i)
+		case -716220949:
+			n += Inline261(i)
+		case 1329500471:
+			n += Inline262(i)
+		case -833774410:
+			n += Inline263(i)
+		case 1792400843:
+			n += Inline264(i)
+		case -1208902515:
+			n += Inline265(i)
+		case 1746921148:
+			n += Inline266(i)
+		case -447521558:
+			n += Inline267(i)
+		case -179631155:
+			n += Inline268(i)
+		case 80372672:
+			n += Inline269(i)
+		case -894760620:
+			n += Inline270(i)
+		case 1819146944:
+			n += Inline271(i)
+		case 65203089:
+			n += Inline272(i)
+		case 1858424790:
+			n += Inline273(i)
+		case -408982948:
+			n += Inline274(i)
+		case -619744821:
+			n += Inline275(i)
+		case 184347049:
+			n += Inline276(i)
+		case -1464452710:
+			n += Inline277(i)
+		case 1494043213:
+			n += Inline278(i)
+		case -2132507999:
+			n += Inline279(i)
+		case -687420592:
+			n += Inline280(i)
+		case 99254840:
+			n += Inline281(i)
+		case 534137245:
+			n += Inline282(i)
+		case 1121740742:
+			n += Inline283(i)
+		case -708895062:
+			n += Inline284(i)
+		case 1352076303:
+			n += Inline285(i)
+		case 32990530:
+			n += Inline286(i)
+		case -430569932:
+			n += Inline287(i)
+		case 1590286959:
+			n += Inline288(i)
+		case 430353185:
+			n += Inline289(i)
+		case -1532427038:
+			n += Inline290(i)
+		case -988318833:
+			n += Inline291(i)
+		case -897109853:
+			n += Inline292(i)
+		case 1126921711:
+			n += Inline293(i)
+		case 1489769022:
+			n += Inline294(i)
+		case 921930687:
+			n += Inline295(i)
+		case -764105189:
+			n += Inline296(i)
+		case 1508110333:
+			n += Inline297(i)
+		case -1721447578:
+			n += Inline298(i)
+		case -1810967562:
+			n += Inline299(i)
+		case 1776316817:
+			n += Inline300(i)
+		case 514754016:
+			n += Inline301(i)
+		case -379616239:
+			n += Inline302(i)
+		case 231007307:
+			n += Inline303(i)
+		case 983193548:
+			n += Inline304(i)
+		case 1392316569:
+			n += Inline305(i)
+		case 1506524751:
+			n += Inline306(i)
+		case 1500183748:
+			n += Inline307(i)
+		case 1304820212:
+			n += Inline308(i)
+		case -134234221:
+			n += Inline309(i)
+		case -1410782724:
+			n += Inline310(i)
+		case 893108935:
+			n += Inline311(i)
+		case -158315067:
+			n += Inline312(i)
+		case 1297949317:
+			n += Inline313(i)
+		case -824886777:
+			n += Inline314(i)
+		case 575887093:
+			n += Inline315(i)
+		case -224367850:
+			n += Inline316(i)
+		case -1542473848:
+			n += Inline317(i)
+		case -200752122:
+			n += Inline318(i)
+		case -816583982:
+			n += Inline319(i)
+		case 779926748:
+			n += Inline320(i)
+		case -245855218:
+			n += Inline321(i)
+		case -724814234:
+			n += Inline322(i)
+		case 2124636293:
+			n += Inline323(i)
+		case -621437330:
+			n += Inline324(i)
+		case -1242345439:
+			n += Inline325(i)
+		case 1174651033:
+			n += Inline326(i)
+		case 1750769853:
+			n += Inline327(i)
+		case -387572341:
+			n += Inline328(i)
+		case -238812471:
+			n += Inline329(i)
+		case 2141610073:
+			n += Inline330(i)
+		case 1239692102:
+			n += Inline331(i)
+		case -103529086:
+			n += Inline332(i)
+		case 1943835524:
+			n += Inline333(i)
+		case 193233579:
+			n += Inline334(i)
+		case 1354469397:
+			n += Inline335(i)
+		case -206561521:
+			n += Inline336(i)
+		case -1067028436:
+			n += Inline337(i)
+		case -1993762478:
+			n += Inline338(i)
+		case -1418565040:
+			n += Inline339(i)
+		case -579068977:
+			n += Inline340(i)
+		case 1970893722:
+			n += Inline341(i)
+		case -1810110091:
+			n += Inline342(i)
+		case 2068154836:
+			n += Inline343(i)
+		case -1930090606:
+			n += Inline344(i)
+		case -212071554:
+			n += Inline345(i)
+		case -2105111990:
+			n += Inline346(i)
+		case -1105890816:
+			n += Inline347(i)
+		case 2107944240:
+			n += Inline348(i)
+		case 284118163:
+			n += Inline349(i)
+		case 1127388811:
+			n += Inline350(i)
+		case -320112743:
+			n += Inline351(i)
+		case 1595523019:
+			n += Inline352(i)
+		case 1758442933:
+			n += Inline353(i)
+		case 1785641113:
+			n += Inline354(i)
+		case 417735390:
+			n += Inline355(i)
+		case -422506136:
+			n += Inline356(i)
+		case 19089668:
+			n += Inline357(i)
+		case 1176496955:
+			n += Inline358(i)
+		case 469468534:
+			n += Inline359(i)
+		case -620844340:
+			n += Inline360(i)
+		case 1104044350:
+			n += Inline361(i)
+		case -46677310:
+			n += Inline362(i)
+		case -316060535:
+			n += Inline363(i)
+		case 734207617:
+			n += Inline364(i)
+		case 1305185263:
+			n += Inline365(i)
+		case -2003810766:
+			n += Inline366(i)
+		case 758017117:
+			n += Inline367(i)
+		case -800621527:
+			n += Inline368(i)
+		case -1266426411:
+			n += Inline369(i)
+		case 1104698047:
+			n += Inline370(i)
+		case 1075326325:
+			n += Inline371(i)
+		case 1439219346:
+			n += Inline372(i)
+		case -1068413352:
+			n += Inline373(i)
+		case 20958506:
+			n += Inline374(i)
+		case -682928979:
+			n += Inline375(i)
+		case 986011055:
+			n += Inline376(i)
+		case 57060992:
+			n += Inline377(i)
+		case -215017199:
+			n += Inline378(i)
+		case -432196269:
+			n += Inline379(i)
+		case -160527834:
+			n += Inline380(i)
+		case 184685148:
+			n += Inline381(i)
+		case -1234104684:
+			n += Inline382(i)
+		case 219447481:
+			n += Inline383(i)
+		case 1750337062:
+			n += Inline384(i)
+		case 2043104664:
+			n += Inline385(i)
+		case 1492365354:
+			n += Inline386(i)
+		case 174890805:
+			n += Inline387(i)
+		case -1728439988:
+			n += Inline388(i)
+		case 1117226966:
+			n += Inline389(i)
+		case -717878635:
+			n += Inline390(i)
+		case -169661923:
+			n += Inline391(i)
+		case -271838922:
+			n += Inline392(i)
+		case 984922924:
+			n += Inline393(i)
+		case -1201246088:
+			n += Inline394(i)
+		case -1045900454:
+			n += Inline395(i)
+		case 1933396386:
+			n += Inline396(i)
+		case 1455896797:
+			n += Inline397(i)
+		case 2029337912:
+			n += Inline398(i)
+		case -60153766:
+			n += Inline399(i)
+		case 1556201844:
+			n += Inline400(i)
+		case 1072260091:
+			n += Inline401(i)
+		case 205592989:
+			n += Inline402(i)
+		case -903157683:
+			n += Inline403(i)
+		case -1047069298:
+			n += Inline404(i)
+		case -1471159248:
+			n += Inline405(i)
+		case 949182841:
+			n += Inline406(i)
+		case -1471003208:
+			n += Inline407(i)
+		case 1678655519:
+			n += Inline408(i)
+		case -346799282:
+			n += Inline409(i)
+		case 642894248:
+			n += Inline410(i)
+		case -2059900581:
+			n += Inline411(i)
+		case -1006789590:
+			n += Inline412(i)
+		case 1065935549:
+			n += Inline413(i)
+		case 1956116951:
+			n += Inline414(i)
+		case -1289395816:
+			n += Inline415(i)
+		case 352056179:
+			n += Inline416(i)
+		case -2094775668:
+			n += Inline417(i)
+		case 1144101090:
+			n += Inline418(i)
+		case -1623490618:
+			n += Inline419(i)
+		case 425503163:
+			n += Inline420(i)
+		case 603766263:
+			n += Inline421(i)
+		case 683774103:
+			n += Inline422(i)
+		case -240453325:
+			n += Inline423(i)
+		case -1582796037:
+			n += Inline424(i)
+		case 1055668406:
+			n += Inline425(i)
+		case -139563906:
+			n += Inline426(i)
+		case -1988653112:
+			n += Inline427(i)
+		case 1452476744:
+			n += Inline428(i)
+		case -1410176746:
+			n += Inline429(i)
+		case -698078541:
+			n += Inline430(i)
+		case -1143084771:
+			n += Inline431(i)
+		case -2137945349:
+			n += Inline432(i)
+		case 1818806034:
+			n += Inline433(i)
+		case 1988739519:
+			n += Inline434(i)
+		case -201671681:
+			n += Inline435(i)
+		case -1099199736:
+			n += Inline436(i)
+		case 1955024410:
+			n += Inline437(i)
+		case 1066942813:
+			n += Inline438(i)
+		case -1203082347:
+			n += Inline439(i)
+		case 1819787089:
+			n += Inline440(i)
+		case -1105563850:
+			n += Inline441(i)
+		case -1473825507:
+			n += Inline442(i)
+		case -1363559451:
+			n += Inline443(i)
+		case -1559971230:
+			n += Inline444(i)
+		case 1067655148:
+			n += Inline445(i)
+		case 1661557221:
+			n += Inline446(i)
+		case -987967235:
+			n += Inline447(i)
+		case 542730790:
+			n += Inline448(i)
+		case 1231099828:
+			n += Inline449(i)
+		case -2098782249:
+			n += Inline450(i)
+		case 852815300:
+			n += Inline451(i)
+		case -23165478:
+			n += Inline452(i)
+		case -1563568742:
+			n += Inline453(i)
+		case -1171156006:
+			n += Inline454(i)
+		case 1770881343:
+			n += Inline455(i)
+		case -2087252879:
+			n += Inline456(i)
+		case 563456396:
+			n += Inline457(i)
+		case -1951621827:
+			n += Inline458(i)
+		case -665569537:
+			n += Inline459(i)
+		case 1259646708:
+			n += Inline460(i)
+		case -952177854:
+			n += Inline461(i)
+		case 608935175:
+			n += Inline462(i)
+		case -1484428644:
+			n += Inline463(i)
+		case -1583347276:
+			n += Inline464(i)
+		case 1692067842:
+			n += Inline465(i)
+		case 6062535:
+			n += Inline466(i)
+		case -1864588694:
+			n += Inline467(i)
+		case -481929598:
+			n += Inline468(i)
+		case 1943398976:
+			n += Inline469(i)
+		case -1734399456:
+			n += Inline470(i)
+		case -1534010547:
+			n += Inline471(i)
+		case -730838493:
+			n += Inline472(i)
+		case -814411016:
+			n += Inline473(i)
+		case 283187946:
+			n += Inline474(i)
+		case 349931193:
+			n += Inline475(i)
+		case 1441038932:
+			n += Inline476(i)
+		case 2107260151:
+			n += Inline477(i)
+		case -1636255076:
+			n += Inline478(i)
+		case -1599193552:
+			n += Inline479(i)
+		case 1394335827:
+			n += Inline480(i)
+		case -888136867:
+			n += Inline481(i)
+		case 361579919:
+			n += Inline482(i)
+		case 1092320650:
+			n += Inline483(i)
+		case -1341884404:
+			n += Inline484(i)
+		case 1804819842:
+			n += Inline485(i)
+		case 95052488:
+			n += Inline486(i)
+		case 931994127:
+			n += Inline487(i)
+		case 336062872:
+			n += Inline488(i)
+		case -1873274321:
+			n += Inline489(i)
+		case 981722977:
+			n += Inline490(i)
+		case 1578716447:
+			n += Inline491(i)
+		case 1168423850:
+			n += Inline492(i)
+		case -428209243:
+			n += Inline493(i)
+		case -1452428858:
+			n += Inline494(i)
+		case 1911818401:
+			n += Inline495(i)
+		case -134241068:
+			n += Inline496(i)
+		case -1427589835:
+			n += Inline497(i)
+		case 1565086353:
+			n += Inline498(i)
+		case 28462600:
+			n += Inline499(i)
+		case 885516103:
+			n += Inline500(i)
+		case -934348428:
+			n += Inline501(i)
+		case -1003170058:
+			n += Inline502(i)
+		case -823595963:
+			n += Inline503(i)
+		case 1236561826:
+			n += Inline504(i)
+		case -1675253206:
+			n += Inline505(i)
+		case 1942201082:
+			n += Inline506(i)
+		case 972851284:
+			n += Inline507(i)
+		case 1670984478:
+			n += Inline508(i)
+		case 1060275388:
+			n += Inline509(i)
+		case 32334443:
+			n += Inline510(i)
+		case 659173149:
+			n += Inline511(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchInlineClusteredComputed4(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysClustered[i%4] {
+		case 0:
+			n += Inline0(i)
+		case 1:
+			n += Inline1(i)
+		case 2:
+			n += Inline2(i)
+		case 3:
+			n += Inline3(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchInlineClusteredLookupMod4(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysClustered[inputs[i%len(inputs)]] {
+		case 0:
+			n += Inline0(i)
+		case 1:
+			n += Inline1(i)
+		case 2:
+			n += Inline2(i)
+		case 3:
+			n += Inline3(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchInlineClusteredComputed8(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysClustered[i%8] {
+		case 0:
+			n += Inline0(i)
+		case 1:
+			n += Inline1(i)
+		case 2:
+			n += Inline2(i)
+		case 3:
+			n += Inline3(i)
+		case 4:
+			n += Inline4(i)
+		case 5:
+			n += Inline5(i)
+		case 6:
+			n += Inline6(i)
+		case 7:
+			n += Inline7(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchInlineClusteredLookupMod8(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysClustered[inputs[i%len(inputs)]] {
+		case 0:
+			n += Inline0(i)
+		case 1:
+			n += Inline1(i)
+		case 2:
+			n += Inline2(i)
+		case 3:
+			n += Inline3(i)
+		case 4:
+			n += Inline4(i)
+		case 5:
+			n += Inline5(i)
+		case 6:
+			n += Inline6(i)
+		case 7:
+			n += Inline7(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchInlineClusteredComputed16(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysClustered[i%16] {
+		case 0:
+			n += Inline0(i)
+		case 1:
+			n += Inline1(i)
+		case 2:
+			n += Inline2(i)
+		case 3:
+			n += Inline3(i)
+		case 4:
+			n += Inline4(i)
+		case 5:
+			n += Inline5(i)
+		case 6:
+			n += Inline6(i)
+		case 7:
+			n += Inline7(i)
+		case 65536:
+			n += Inline8(i)
+		case 65537:
+			n += Inline9(i)
+		case 65538:
+			n += Inline10(i)
+		case 65539:
+			n += Inline11(i)
+		case 65540:
+			n += Inline12(i)
+		case 65541:
+			n += Inline13(i)
+		case 65542:
+			n += Inline14(i)
+		case 65543:
+			n += Inline15(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchInlineClusteredLookupMod16(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysClustered[inputs[i%len(inputs)]] {
+		case 0:
+			n += Inline0(i)
+		case 1:
+			n += Inline1(i)
+		case 2:
+			n += Inline2(i)
+		case 3:
+			n += Inline3(i)
+		case 4:
+			n += Inline4(i)
+		case 5:
+			n += Inline5(i)
+		case 6:
+			n += Inline6(i)
+		case 7:
+			n += Inline7(i)
+		case 65536:
+			n += Inline8(i)
+		case 65537:
+			n += Inline9(i)
+		case 65538:
+			n += Inline10(i)
+		case 65539:
+			n += Inline11(i)
+		case 65540:
+			n += Inline12(i)
+		case 65541:
+			n += Inline13(i)
+		case 65542:
+			n += Inline14(i)
+		case 65543:
+			n += Inline15(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchInlineClusteredComputed32(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysClustered[i%32] {
+		case 0:
+			n += Inline0(i)
+		case 1:
+			n += Inline1(i)
+		case 2:
+			n += Inline2(i)
+		case 3:
+			n += Inline3(i)
+		case 4:
+			n += Inline4(i)
+		case 5:
+			n += Inline5(i)
+		case 6:
+			n += Inline6(i)
+		case 7:
+			n += Inline7(i)
+		case 65536:
+			n += Inline8(i)
+		case 65537:
+			n += Inline9(i)
+		case 65538:
+			n += Inline10(i)
+		case 65539:
+			n += Inline11(i)
+		case 65540:
+			n += Inline12(i)
+		case 65541:
+			n += Inline13(i)
+		case 65542:
+			n += Inline14(i)
+		case 65543:
+			n += Inline15(i)
+		case 131072:
+			n += Inline16(i)
+		case 131073:
+			n += Inline17(i)
+		case 131074:
+			n += Inline18(i)
+		case 131075:
+			n += Inline19(i)
+		case 131076:
+			n += Inline20(i)
+		case 131077:
+			n += Inline21(i)
+		case 131078:
+			n += Inline22(i)
+		case 131079:
+			n += Inline23(i)
+		case 196608:
+			n += Inline24(i)
+		case 196609:
+			n += Inline25(i)
+		case 196610:
+			n += Inline26(i)
+		case 196611:
+			n += Inline27(i)
+		case 196612:
+			n += Inline28(i)
+		case 196613:
+			n += Inline29(i)
+		case 196614:
+			n += Inline30(i)
+		case 196615:
+			n += Inline31(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchInlineClusteredLookupMod32(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysClustered[inputs[i%len(inputs)]] {
+		case 0:
+			n += Inline0(i)
+		case 1:
+			n += Inline1(i)
+		case 2:
+			n += Inline2(i)
+		case 3:
+			n += Inline3(i)
+		case 4:
+			n += Inline4(i)
+		case 5:
+			n += Inline5(i)
+		case 6:
+			n += Inline6(i)
+		case 7:
+			n += Inline7(i)
+		case 65536:
+			n += Inline8(i)
+		case 65537:
+			n += Inline9(i)
+		case 65538:
+			n += Inline10(i)
+		case 65539:
+			n += Inline11(i)
+		case 65540:
+			n += Inline12(i)
+		case 65541:
+			n += Inline13(i)
+		case 65542:
+			n += Inline14(i)
+		case 65543:
+			n += Inline15(i)
+		case 131072:
+			n += Inline16(i)
+		case 131073:
+			n += Inline17(i)
+		case 131074:
+			n += Inline18(i)
+		case 131075:
+			n += Inline19(i)
+		case 131076:
+			n += Inline20(i)
+		case 131077:
+			n += Inline21(i)
+		case 131078:
+			n += Inline22(i)
+		case 131079:
+			n += Inline23(i)
+		case 196608:
+			n += Inline24(i)
+		case 196609:
+			n += Inline25(i)
+		case 196610:
+			n += Inline26(i)
+		case 196611:
+			n += Inline27(i)
+		case 196612:
+			n += Inline28(i)
+		case 196613:
+			n += Inline29(i)
+		case 196614:
+			n += Inline30(i)
+		case 196615:
+			n += Inline31(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchInlineClusteredComputed64(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysClustered[i%64] {
+		case 0:
+			n += Inline0(i)
+		case 1:
+			n += Inline1(i)
+		case 2:
+			n += Inline2(i)
+		case 3:
+			n += Inline3(i)
+		case 4:
+			n += Inline4(i)
+		case 5:
+			n += Inline5(i)
+		case 6:
+			n += Inline6(i)
+		case 7:
+			n += Inline7(i)
+		case 65536:
+			n += Inline8(i)
+		case 65537:
+			n += Inline9(i)
+		case 65538:
+			n += Inline10(i)
+		case 65539:
+			n += Inline11(i)
+		case 65540:
+			n += Inline12(i)
+		case 65541:
+			n += Inline13(i)
+		case 65542:
+			n += Inline14(i)
+		case 65543:
+			n += Inline15(i)
+		case 131072:
+			n += Inline16(i)
+		case 131073:
+			n += Inline17(i)
+		case 131074:
+			n += Inline18(i)
+		case 131075:
+			n += Inline19(i)
+		case 131076:
+			n += Inline20(i)
+		case 131077:
+			n += Inline21(i)
+		case 131078:
+			n += Inline22(i)
+		case 131079:
+			n += Inline23(i)
+		case 196608:
+			n += Inline24(i)
+		case 196609:
+			n += Inline25(i)
+		case 196610:
+			n += Inline26(i)
+		case 196611:
+			n += Inline27(i)
+		case 196612:
+			n += Inline28(i)
+		case 196613:
+			n += Inline29(i)
+		case 196614:
+			n += Inline30(i)
+		case 196615:
+			n += Inline31(i)
+		case 262144:
+			n += Inline32(i)
+		case 262145:
+			n += Inline33(i)
+		case 262146:
+			n += Inline34(i)
+		case 262147:
+			n += Inline35(i)
+		case 262148:
+			n += Inline36(i)
+		case 262149:
+			n += Inline37(i)
+		case 262150:
+			n += Inline38(i)
+		case 262151:
+			n += Inline39(i)
+		case 327680:
+			n += Inline40(i)
+		case 327681:
+			n += Inline41(i)
+		case 327682:
+			n += Inline42(i)
+		case 327683:
+			n += Inline43(i)
+		case 327684:
+			n += Inline44(i)
+		case 327685:
+			n += Inline45(i)
+		case 327686:
+			n += Inline46(i)
+		case 327687:
+			n += Inline47(i)
+		case 393216:
+			n += Inline48(i)
+		case 393217:
+			n += Inline49(i)
+		case 393218:
+			n += Inline50(i)
+		case 393219:
+			n += Inline51(i)
+		case 393220:
+			n += Inline52(i)
+		case 393221:
+			n += Inline53(i)
+		case 393222:
+			n += Inline54(i)
+		case 393223:
+			n += Inline55(i)
+		case 458752:
+			n += Inline56(i)
+		case 458753:
+			n += Inline57(i)
+		case 458754:
+			n += Inline58(i)
+		case 458755:
+			n += Inline59(i)
+		case 458756:
+			n += Inline60(i)
+		case 458757:
+			n += Inline61(i)
+		case 458758:
+			n += Inline62(i)
+		case 458759:
+			n += Inline63(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchInlineClusteredLookupMod64(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysClustered[inputs[i%len(inputs)]] {
+		case 0:
+			n += Inline0(i)
+		case 1:
+			n += Inline1(i)
+		case 2:
+			n += Inline2(i)
+		case 3:
+			n += Inline3(i)
+		case 4:
+			n += Inline4(i)
+		case 5:
+			n += Inline5(i)
+		case 6:
+			n += Inline6(i)
+		case 7:
+			n += Inline7(i)
+		case 65536:
+			n += Inline8(i)
+		case 65537:
+			n += Inline9(i)
+		case 65538:
+			n += Inline10(i)
+		case 65539:
+			n += Inline11(i)
+		case 65540:
+			n += Inline12(i)
+		case 65541:
+			n += Inline13(i)
+		case 65542:
+			n += Inline14(i)
+		case 65543:
+			n += Inline15(i)
+		case 131072:
+			n += Inline16(i)
+		case 131073:
+			n += Inline17(i)
+		case 131074:
+			n += Inline18(i)
+		case 131075:
+			n += Inline19(i)
+		case 131076:
+			n += Inline20(i)
+		case 131077:
+			n += Inline21(i)
+		case 131078:
+			n += Inline22(i)
+		case 131079:
+			n += Inline23(i)
+		case 196608:
+			n += Inline24(i)
+		case 196609:
+			n += Inline25(i)
+		case 196610:
+			n += Inline26(i)
+		case 196611:
+			n += Inline27(i)
+		case 196612:
+			n += Inline28(i)
+		case 196613:
+			n += Inline29(i)
+		case 196614:
+			n += Inline30(i)
+		case 196615:
+			n += Inline31(i)
+		case 262144:
+			n += Inline32(i)
+		case 262145:
+			n += Inline33(i)
+		case 262146:
+			n += Inline34(i)
+		case 262147:
+			n += Inline35(i)
+		case 262148:
+			n += Inline36(i)
+		case 262149:
+			n += Inline37(i)
+		case 262150:
+			n += Inline38(i)
+		case 262151:
+			n += Inline39(i)
+		case 327680:
+			n += Inline40(i)
+		case 327681:
+			n += Inline41(i)
+		case 327682:
+			n += Inline42(i)
+		case 327683:
+			n += Inline43(i)
+		case 327684:
+			n += Inline44(i)
+		case 327685:
+			n += Inline45(i)
+		case 327686:
+			n += Inline46(i)
+		case 327687:
+			n += Inline47(i)
+		case 393216:
+			n += Inline48(i)
+		case 393217:
+			n += Inline49(i)
+		case 393218:
+			n += Inline50(i)
+		case 393219:
+			n += Inline51(i)
+		case 393220:
+			n += Inline52(i)
+		case 393221:
+			n += Inline53(i)
+		case 393222:
+			n += Inline54(i)
+		case 393223:
+			n += Inline55(i)
+		case 458752:
+			n += Inline56(i)
+		case 458753:
+			n += Inline57(i)
+		case 458754:
+			n += Inline58(i)
+		case 458755:
+			n += Inline59(i)
+		case 458756:
+			n += Inline60(i)
+		case 458757:
+			n += Inline61(i)
+		case 458758:
+			n += Inline62(i)
+		case 458759:
+			n += Inline63(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchInlineClusteredComputed128(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysClustered[i%128] {
+		case 0:
+			n += Inline0(i)
+		case 1:
+			n += Inline1(i)
+		case 2:
+			n += Inline2(i)
+		case 3:
+			n += Inline3(i)
+		case 4:
+			n += Inline4(i)
+		case 5:
+			n += Inline5(i)
+		case 6:
+			n += Inline6(i)
+		case 7:
+			n += Inline7(i)
+		case 65536:
+			n += Inline8(i)
+		case 65537:
+			n += Inline9(i)
+		case 65538:
+			n += Inline10(i)
+		case 65539:
+			n += Inline11(i)
+		case 65540:
+			n += Inline12(i)
+		case 65541:
+			n += Inline13(i)
+		case 65542:
+			n += Inline14(i)
+		case 65543:
+			n += Inline15(i)
+		case 131072:
+			n += Inline16(i)
+		case 131073:
+			n += Inline17(i)
+		case 131074:
+			n += Inline18(i)
+		case 131075:
+			n += Inline19(i)
+		case 131076:
+			n += Inline20(i)
+		case 131077:
+			n += Inline21(i)
+		case 131078:
+			n += Inline22(i)
+		case 131079:
+			n += Inline23(i)
+		case 196608:
+			n += Inline24(i)
+		case 196609:
+			n += Inline25(i)
+		case 196610:
+			n += Inline26(i)
+		case 196611:
+			n += Inline27(i)
+		case 196612:
+			n += Inline28(i)
+		case 196613:
+			n += Inline29(i)
+		case 196614:
+			n += Inline30(i)
+		case 196615:
+			n += Inline31(i)
+		case 262144:
+			n += Inline32(i)
+		case 262145:
+			n += Inline33(i)
+		case 262146:
+			n += Inline34(i)
+		case 262147:
+			n += Inline35(i)
+		case 262148:
+			n += Inline36(i)
+		case 262149:
+			n += Inline37(i)
+		case 262150:
+			n += Inline38(i)
+		case 262151:
+			n += Inline39(i)
+		case 327680:
+			n += Inline40(i)
+		case 327681:
+			n += Inline41(i)
+		case 327682:
+			n += Inline42(i)
+		case 327683:
+			n += Inline43(i)
+		case 327684:
+			n += Inline44(i)
+		case 327685:
+			n += Inline45(i)
+		case 327686:
+			n += Inline46(i)
+		case 327687:
+			n += Inline47(i)
+		case 393216:
+			n += Inline48(i)
+		case 393217:
+			n += Inline49(i)
+		case 393218:
+			n += Inline50(i)
+		case 393219:
+			n += Inline51(i)
+		case 393220:
+			n += Inline52(i)
+		case 393221:
+			n += Inline53(i)
+		case 393222:
+			n += Inline54(i)
+		case 393223:
+			n += Inline55(i)
+		case 458752:
+			n += Inline56(i)
+		case 458753:
+			n += Inline57(i)
+		case 458754:
+			n += Inline58(i)
+		case 458755:
+			n += Inline59(i)
+		case 458756:
+			n += Inline60(i)
+		case 458757:
+			n += Inline61(i)
+		case 458758:
+			n += Inline62(i)
+		case 458759:
+			n += Inline63(i)
+		case 524288:
+			n += Inline64(i)
+		case 524289:
+			n += Inline65(i)
+		case 524290:
+			n += Inline66(i)
+		case 524291:
+			n += Inline67(i)
+		case 524292:
+			n += Inline68(i)
+		case 524293:
+			n += Inline69(i)
+		case 524294:
+			n += Inline70(i)
+		case 524295:
+			n += Inline71(i)
+		case 589824:
+			n += Inline72(i)
+		case 589825:
+			n += Inline73(i)
+		case 589826:
+			n += Inline74(i)
+		case 589827:
+			n += Inline75(i)
+		case 589828:
+			n += Inline76(i)
+		case 589829:
+			n += Inline77(i)
+		case 589830:
+			n += Inline78(i)
+		case 589831:
+			n += Inline79(i)
+		case 655360:
+			n += Inline80(i)
+		case 655361:
+			n += Inline81(i)
+		case 655362:
+			n += Inline82(i)
+		case 655363:
+			n += Inline83(i)
+		case 655364:
+			n += Inline84(i)
+		case 655365:
+			n += Inline85(i)
+		case 655366:
+			n += Inline86(i)
+		case 655367:
+			n += Inline87(i)
+		case 720896:
+			n += Inline88(i)
+		case 720897:
+			n += Inline89(i)
+		case 720898:
+			n += Inline90(i)
+		case 720899:
+			n += Inline91(i)
+		case 720900:
+			n += Inline92(i)
+		case 720901:
+			n += Inline93(i)
+		case 720902:
+			n += Inline94(i)
+		case 720903:
+			n += Inline95(i)
+		case 786432:
+			n += Inline96(i)
+		case 786433:
+			n += Inline97(i)
+		case 786434:
+			n += Inline98(i)
+		case 786435:
+			n += Inline99(i)
+		case 786436:
+			n += Inline100(i)
+		case 786437:
+			n += Inline101(i)
+		case 786438:
+			n += Inline102(i)
+		case 786439:
+			n += Inline103(i)
+		case 851968:
+			n += Inline104(i)
+		case 851969:
+			n += Inline105(i)
+		case 851970:
+			n += Inline106(i)
+		case 851971:
+			n += Inline107(i)
+		case 851972:
+			n += Inline108(i)
+		case 851973:
+			n += Inline109(i)
+		case 851974:
+			n += Inline110(i)
+		case 851975:
+			n += Inline111(i)
+		case 917504:
+			n += Inline112(i)
+		case 917505:
+			n += Inline113(i)
+		case 917506:
+			n += Inline114(i)
+		case 917507:
+			n += Inline115(i)
+		case 917508:
+			n += Inline116(i)
+		case 917509:
+			n += Inline117(i)
+		case 917510:
+			n += Inline118(i)
+		case 917511:
+			n += Inline119(i)
+		case 983040:
+			n += Inline120(i)
+		case 983041:
+			n += Inline121(i)
+		case 983042:
+			n += Inline122(i)
+		case 983043:
+			n += Inline123(i)
+		case 983044:
+			n += Inline124(i)
+		case 983045:
+			n += Inline125(i)
+		case 983046:
+			n += Inline126(i)
+		case 983047:
+			n += Inline127(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchInlineClusteredLookupMod128(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysClustered[inputs[i%len(inputs)]] {
+		case 0:
+			n += Inline0(i)
+		case 1:
+			n += Inline1(i)
+		case 2:
+			n += Inline2(i)
+		case 3:
+			n += Inline3(i)
+		case 4:
+			n += Inline4(i)
+		case 5:
+			n += Inline5(i)
+		case 6:
+			n += Inline6(i)
+		case 7:
+			n += Inline7(i)
+		case 65536:
+			n += Inline8(i)
+		case 65537:
+			n += Inline9(i)
+		case 65538:
+			n += Inline10(i)
+		case 65539:
+			n += Inline11(i)
+		case 65540:
+			n += Inline12(i)
+		case 65541:
+			n += Inline13(i)
+		case 65542:
+			n += Inline14(i)
+		case 65543:
+			n += Inline15(i)
+		case 131072:
+			n += Inline16(i)
+		case 131073:
+			n += Inline17(i)
+		case 131074:
+			n += Inline18(i)
+		case 131075:
+			n += Inline19(i)
+		case 131076:
+			n += Inline20(i)
+		case 131077:
+			n += Inline21(i)
+		case 131078:
+			n += Inline22(i)
+		case 131079:
+			n += Inline23(i)
+		case 196608:
+			n += Inline24(i)
+		case 196609:
+			n += Inline25(i)
+		case 196610:
+			n += Inline26(i)
+		case 196611:
+			n += Inline27(i)
+		case 196612:
+			n += Inline28(i)
+		case 196613:
+			n += Inline29(i)
+		case 196614:
+			n += Inline30(i)
+		case 196615:
+			n += Inline31(i)
+		case 262144:
+			n += Inline32(i)
+		case 262145:
+			n += Inline33(i)
+		case 262146:
+			n += Inline34(i)
+		case 262147:
+			n += Inline35(i)
+		case 262148:
+			n += Inline36(i)
+		case 262149:
+			n += Inline37(i)
+		case 262150:
+			n += Inline38(i)
+		case 262151:
+			n += Inline39(i)
+		case 327680:
+			n += Inline40(i)
+		case 327681:
+			n += Inline41(i)
+		case 327682:
+			n += Inline42(i)
+		case 327683:
+			n += Inline43(i)
+		case 327684:
+			n += Inline44(i)
+		case 327685:
+			n += Inline45(i)
+		case 327686:
+			n += Inline46(i)
+		case 327687:
+			n += Inline47(i)
+		case 393216:
+			n += Inline48(i)
+		case 393217:
+			n += Inline49(i)
+		case 393218:
+			n += Inline50(i)
+		case 393219:
+			n += Inline51(i)
+		case 393220:
+			n += Inline52(i)
+		case 393221:
+			n += Inline53(i)
+		case 393222:
+			n += Inline54(i)
+		case 393223:
+			n += Inline55(i)
+		case 458752:
+			n += Inline56(i)
+		case 458753:
+			n += Inline57(i)
+		case 458754:
+			n += Inline58(i)
+		case 458755:
+			n += Inline59(i)
+		case 458756:
+			n += Inline60(i)
+		case 458757:
+			n += Inline61(i)
+		case 458758:
+			n += Inline62(i)
+		case 458759:
+			n += Inline63(i)
+		case 524288:
+			n += Inline64(i)
+		case 524289:
+			n += Inline65(i)
+		case 524290:
+			n += Inline66(i)
+		case 524291:
+			n += Inline67(i)
+		case 524292:
+			n += Inline68(i)
+		case 524293:
+			n += Inline69(i)
+		case 524294:
+			n += Inline70(i)
+		case 524295:
+			n += Inline71(i)
+		case 589824:
+			n += Inline72(i)
+		case 589825:
+			n += Inline73(i)
+		case 589826:
+			n += Inline74(i)
+		case 589827:
+			n += Inline75(i)
+		case 589828:
+			n += Inline76(i)
+		case 589829:
+			n += Inline77(i)
+		case 589830:
+			n += Inline78(i)
+		case 589831:
+			n += Inline79(i)
+		case 655360:
+			n += Inline80(i)
+		case 655361:
+			n += Inline81(i)
+		case 655362:
+			n += Inline82(i)
+		case 655363:
+			n += Inline83(i)
+		case 655364:
+			n += Inline84(i)
+		case 655365:
+			n += Inline85(i)
+		case 655366:
+			n += Inline86(i)
+		case 655367:
+			n += Inline87(i)
+		case 720896:
+			n += Inline88(i)
+		case 720897:
+			n += Inline89(i)
+		case 720898:
+			n += Inline90(i)
+		case 720899:
+			n += Inline91(i)
+		case 720900:
+			n += Inline92(i)
+		case 720901:
+			n += Inline93(i)
+		case 720902:
+			n += Inline94(i)
+		case 720903:
+			n += Inline95(i)
+		case 786432:
+			n += Inline96(i)
+		case 786433:
+			n += Inline97(i)
+		case 786434:
+			n += Inline98(i)
+		case 786435:
+			n += Inline99(i)
+		case 786436:
+			n += Inline100(i)
+		case 786437:
+			n += Inline101(i)
+		case 786438:
+			n += Inline102(i)
+		case 786439:
+			n += Inline103(i)
+		case 851968:
+			n += Inline104(i)
+		case 851969:
+			n += Inline105(i)
+		case 851970:
+			n += Inline106(i)
+		case 851971:
+			n += Inline107(i)
+		case 851972:
+			n += Inline108(i)
+		case 851973:
+			n += Inline109(i)
+		case 851974:
+			n += Inline110(i)
+		case 851975:
+			n += Inline111(i)
+		case 917504:
+			n += Inline112(i)
+		case 917505:
+			n += Inline113(i)
+		case 917506:
+			n += Inline114(i)
+		case 917507:
+			n += Inline115(i)
+		case 917508:
+			n += Inline116(i)
+		case 917509:
+			n += Inline117(i)
+		case 917510:
+			n += Inline118(i)
+		case 917511:
+			n += Inline119(i)
+		case 983040:
+			n += Inline120(i)
+		case 983041:
+			n += Inline121(i)
+		case 983042:
+			n += Inline122(i)
+		case 983043:
+			n += Inline123(i)
+		case 983044:
+			n += Inline124(i)
+		case 983045:
+			n += Inline125(i)
+		case 983046:
+			n += Inline126(i)
+		case 983047:
+			n += Inline127(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchInlineClusteredComputed256(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysClustered[i%256] {
+		case 0:
+			n += Inline0(i)
+		case 1:
+			n += Inline1(i)
+		case 2:
+			n += Inline2(i)
+		case 3:
+			n += Inline3(i)
+		case 4:
+			n += Inline4(i)
+		case 5:
+			n += Inline5(i)
+		case 6:
+			n += Inline6(i)
+		case 7:
+			n += Inline7(i)
+		case 65536:
+			n += Inline8(i)
+		case 65537:
+			n += Inline9(i)
+		case 65538:
+			n += Inline10(i)
+		case 65539:
+			n += Inline11(i)
+		case 65540:
+			n += Inline12(i)
+		case 65541:
+			n += Inline13(i)
+		case 65542:
+			n += Inline14(i)
+		case 65543:
+			n += Inline15(i)
+		case 131072:
+			n += Inline16(i)
+		case 131073:
+			n += Inline17(i)
+		case 131074:
+			n += Inline18(i)
+		case 131075:
+			n += Inline19(i)
+		case 131076:
+			n += Inline20(i)
+		case 131077:
+			n += Inline21(i)
+		case 131078:
+			n += Inline22(i)
+		case 131079:
+			n += Inline23(i)
+		case 196608:
+			n += Inline24(i)
+		case 196609:
+			n += Inline25(i)
+		case 196610:
+			n += Inline26(i)
+		case 196611:
+			n += Inline27(i)
+		case 196612:
+			n += Inline28(i)
+		case 196613:
+			n += Inline29(i)
+		case 196614:
+			n += Inline30(i)
+		case 196615:
+			n += Inline31(i)
+		case 262144:
+			n += Inline32(i)
+		case 262145:
+			n += Inline33(i)
+		case 262146:
+			n += Inline34(i)
+		case 262147:
+			n += Inline35(i)
+		case 262148:
+			n += Inline36(i)
+		case 262149:
+			n += Inline37(i)
+		case 262150:
+			n += Inline38(i)
+		case 262151:
+			n += Inline39(i)
+		case 327680:
+			n += Inline40(i)
+		case 327681:
+			n += Inline41(i)
+		case 327682:
+			n += Inline42(i)
+		case 327683:
+			n += Inline43(i)
+		case 327684:
+			n += Inline44(i)
+		case 327685:
+			n += Inline45(i)
+		case 327686:
+			n += Inline46(i)
+		case 327687:
+			n += Inline47(i)
+		case 393216:
+			n += Inline48(i)
+		case 393217:
+			n += Inline49(i)
+		case 393218:
+			n += Inline50(i)
+		case 393219:
+			n += Inline51(i)
+		case 393220:
+			n += Inline52(i)
+		case 393221:
+			n += Inline53(i)
+		case 393222:
+			n += Inline54(i)
+		case 393223:
+			n += Inline55(i)
+		case 458752:
+			n += Inline56(i)
+		case 458753:
+			n += Inline57(i)
+		case 458754:
+			n += Inline58(i)
+		case 458755:
+			n += Inline59(i)
+		case 458756:
+			n += Inline60(i)
+		case 458757:
+			n += Inline61(i)
+		case 458758:
+			n += Inline62(i)
+		case 458759:
+			n += Inline63(i)
+		case 524288:
+			n += Inline64(i)
+		case 524289:
+			n += Inline65(i)
+		case 524290:
+			n += Inline66(i)
+		case 524291:
+			n += Inline67(i)
+		case 524292:
+			n += Inline68(i)
+		case 524293:
+			n += Inline69(i)
+		case 524294:
+			n += Inline70(i)
+		case 524295:
+			n += Inline71(i)
+		case 589824:
+			n += Inline72(i)
+		case 589825:
+			n += Inline73(i)
+		case 589826:
+			n += Inline74(i)
+		case 589827:
+			n += Inline75(i)
+		case 589828:
+			n += Inline76(i)
+		case 589829:
+			n += Inline77(i)
+		case 589830:
+			n += Inline78(i)
+		case 589831:
+			n += Inline79(i)
+		case 655360:
+			n += Inline80(i)
+		case 655361:
+			n += Inline81(i)
+		case 655362:
+			n += Inline82(i)
+		case 655363:
+			n += Inline83(i)
+		case 655364:
+			n += Inline84(i)
+		case 655365:
+			n += Inline85(i)
+		case 655366:
+			n += Inline86(i)
+		case 655367:
+			n += Inline87(i)
+		case 720896:
+			n += Inline88(i)
+		case 720897:
+			n += Inline89(i)
+		case 720898:
+			n += Inline90(i)
+		case 720899:
+			n += Inline91(i)
+		case 720900:
+			n += Inline92(i)
+		case 720901:
+			n += Inline93(i)
+		case 720902:
+			n += Inline94(i)
+		case 720903:
+			n += Inline95(i)
+		case 786432:
+			n += Inline96(i)
+		case 786433:
+			n += Inline97(i)
+		case 786434:
+			n += Inline98(i)
+		case 786435:
+			n += Inline99(i)
+		case 786436:
+			n += Inline100(i)
+		case 786437:
+			n += Inline101(i)
+		case 786438:
+			n += Inline102(i)
+		case 786439:
+			n += Inline103(i)
+		case 851968:
+			n += Inline104(i)
+		case 851969:
+			n += Inline105(i)
+		case 851970:
+			n += Inline106(i)
+		case 851971:
+			n += Inline107(i)
+		case 851972:
+			n += Inline108(i)
+		case 851973:
+			n += Inline109(i)
+		case 851974:
+			n += Inline110(i)
+		case 851975:
+			n += Inline111(i)
+		case 917504:
+			n += Inline112(i)
+		case 917505:
+			n += Inline113(i)
+		case 917506:
+			n += Inline114(i)
+		case 917507:
+			n += Inline115(i)
+		case 917508:
+			n += Inline116(i)
+		case 917509:
+			n += Inline117(i)
+		case 917510:
+			n += Inline118(i)
+		case 917511:
+			n += Inline119(i)
+		case 983040:
+			n += Inline120(i)
+		case 983041:
+			n += Inline121(i)
+		case 983042:
+			n += Inline122(i)
+		case 983043:
+			n += Inline123(i)
+		case 983044:
+			n += Inline124(i)
+		case 983045:
+			n += Inline125(i)
+		case 983046:
+			n += Inline126(i)
+		case 983047:
+			n += Inline127(i)
+		case 1048576:
+			n += Inline128(i)
+		case 1048577:
+			n += Inline129(i)
+		case 1048578:
+			n += Inline130(i)
+		case 1048579:
+			n += Inline131(i)
+		case 1048580:
+			n += Inline132(i)
+		case 1048581:
+			n += Inline133(i)
+		case 1048582:
+			n += Inline134(i)
+		case 1048583:
+			n += Inline135(i)
+		case 1114112:
+			n += Inline136(i)
+		case 1114113:
+			n += Inline137(i)
+		case 1114114:
+			n += Inline138(i)
+		case 1114115:
+			n += Inline139(i)
+		case 1114116:
+			n += Inline140(i)
+		case 1114117:
+			n += Inline141(i)
+		case 1114118:
+			n += Inline142(i)
+		case 1114119:
+			n += Inline143(i)
+		case 1179648:
+			n += Inline144(i)
+		case 1179649:
+			n += Inline145(i)
+		case 1179650:
+			n += Inline146(i)
+		case 1179651:
+			n += Inline147(i)
+		case 1179652:
+			n += Inline148(i)
+		case 1179653:
+			n += Inline149(i)
+		case 1179654:
+			n += Inline150(i)
+		case 1179655:
+			n += Inline151(i)
+		case 1245184:
+			n += Inline152(i)
+		case 1245185:
+			n += Inline153(i)
+		case 1245186:
+			n += Inline154(i)
+		case 1245187:
+			n += Inline155(i)
+		case 1245188:
+			n += Inline156(i)
+		case 1245189:
+			n += Inline157(i)
+		case 1245190:
+			n += Inline158(i)
+		case 1245191:
+			n += Inline159(i)
+		case 1310720:
+			n += Inline160(i)
+		case 1310721:
+			n += Inline161(i)
+		case 1310722:
+			n += Inline162(i)
+		case 1310723:
+			n += Inline163(i)
+		case 1310724:
+			n += Inline164(i)
+		case 1310725:
+			n += Inline165(i)
+		case 1310726:
+			n += Inline166(i)
+		case 1310727:
+			n += Inline167(i)
+		case 1376256:
+			n += Inline168(i)
+		case 1376257:
+			n += Inline169(i)
+		case 1376258:
+			n += Inline170(i)
+		case 1376259:
+			n += Inline171(i)
+		case 1376260:
+			n += Inline172(i)
+		case 1376261:
+			n += Inline173(i)
+		case 1376262:
+			n += Inline174(i)
+		case 1376263:
+			n += Inline175(i)
+		case 1441792:
+			n += Inline176(i)
+		case 1441793:
+			n += Inline177(i)
+		case 1441794:
+			n += Inline178(i)
+		case 1441795:
+			n += Inline179(i)
+		case 1441796:
+			n += Inline180(i)
+		case 1441797:
+			n += Inline181(i)
+		case 1441798:
+			n += Inline182(i)
+		case 1441799:
+			n += Inline183(i)
+		case 1507328:
+			n += Inline184(i)
+		case 1507329:
+			n += Inline185(i)
+		case 1507330:
+			n += Inline186(i)
+		case 1507331:
+			n += Inline187(i)
+		case 1507332:
+			n += Inline188(i)
+		case 1507333:
+			n += Inline189(i)
+		case 1507334:
+			n += Inline190(i)
+		case 1507335:
+			n += Inline191(i)
+		case 1572864:
+			n += Inline192(i)
+		case 1572865:
+			n += Inline193(i)
+		case 1572866:
+			n += Inline194(i)
+		case 1572867:
+			n += Inline195(i)
+		case 1572868:
+			n += Inline196(i)
+		case 1572869:
+			n += Inline197(i)
+		case 1572870:
+			n += Inline198(i)
+		case 1572871:
+			n += Inline199(i)
+		case 1638400:
+			n += Inline200(i)
+		case 1638401:
+			n += Inline201(i)
+		case 1638402:
+			n += Inline202(i)
+		case 1638403:
+			n += Inline203(i)
+		case 1638404:
+			n += Inline204(i)
+		case 1638405:
+			n += Inline205(i)
+		case 1638406:
+			n += Inline206(i)
+		case 1638407:
+			n += Inline207(i)
+		case 1703936:
+			n += Inline208(i)
+		case 1703937:
+			n += Inline209(i)
+		case 1703938:
+			n += Inline210(i)
+		case 1703939:
+			n += Inline211(i)
+		case 1703940:
+			n += Inline212(i)
+		case 1703941:
+			n += Inline213(i)
+		case 1703942:
+			n += Inline214(i)
+		case 1703943:
+			n += Inline215(i)
+		case 1769472:
+			n += Inline216(i)
+		case 1769473:
+			n += Inline217(i)
+		case 1769474:
+			n += Inline218(i)
+		case 1769475:
+			n += Inline219(i)
+		case 1769476:
+			n += Inline220(i)
+		case 1769477:
+			n += Inline221(i)
+		case 1769478:
+			n += Inline222(i)
+		case 1769479:
+			n += Inline223(i)
+		case 1835008:
+			n += Inline224(i)
+		case 1835009:
+			n += Inline225(i)
+		case 1835010:
+			n += Inline226(i)
+		case 1835011:
+			n += Inline227(i)
+		case 1835012:
+			n += Inline228(i)
+		case 1835013:
+			n += Inline229(i)
+		case 1835014:
+			n += Inline230(i)
+		case 1835015:
+			n += Inline231(i)
+		case 1900544:
+			n += Inline232(i)
+		case 1900545:
+			n += Inline233(i)
+		case 1900546:
+			n += Inline234(i)
+		case 1900547:
+			n += Inline235(i)
+		case 1900548:
+			n += Inline236(i)
+		case 1900549:
+			n += Inline237(i)
+		case 1900550:
+			n += Inline238(i)
+		case 1900551:
+			n += Inline239(i)
+		case 1966080:
+			n += Inline240(i)
+		case 1966081:
+			n += Inline241(i)
+		case 1966082:
+			n += Inline242(i)
+		case 1966083:
+			n += Inline243(i)
+		case 1966084:
+			n += Inline244(i)
+		case 1966085:
+			n += Inline245(i)
+		case 1966086:
+			n += Inline246(i)
+		case 1966087:
+			n += Inline247(i)
+		case 2031616:
+			n += Inline248(i)
+		case 2031617:
+			n += Inline249(i)
+		case 2031618:
+			n += Inline250(i)
+		case 2031619:
+			n += Inline251(i)
+		case 2031620:
+			n += Inline252(i)
+		case 2031621:
+			n += Inline253(i)
+		case 2031622:
+			n += Inline254(i)
+		case 2031623:
+			n += Inline255(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchInlineClusteredLookupMod256(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysClustered[inputs[i%len(inputs)]] {
+		case 0:
+			n += Inline0(i)
+		case 1:
+			n += Inline1(i)
+		case 2:
+			n += Inline2(i)
+		case 3:
+			n += Inline3(i)
+		case 4:
+			n += Inline4(i)
+		case 5:
+			n += Inline5(i)
+		case 6:
+			n += Inline6(i)
+		case 7:
+			n += Inline7(i)
+		case 65536:
+			n += Inline8(i)
+		case 65537:
+			n += Inline9(i)
+		case 65538:
+			n += Inline10(i)
+		case 65539:
+			n += Inline11(i)
+		case 65540:
+			n += Inline12(i)
+		case 65541:
+			n += Inline13(i)
+		case 65542:
+			n += Inline14(i)
+		case 65543:
+			n += Inline15(i)
+		case 131072:
+			n += Inline16(i)
+		case 131073:
+			n += Inline17(i)
+		case 131074:
+			n += Inline18(i)
+		case 131075:
+			n += Inline19(i)
+		case 131076:
+			n += Inline20(i)
+		case 131077:
+			n += Inline21(i)
+		case 131078:
+			n += Inline22(i)
+		case 131079:
+			n += Inline23(i)
+		case 196608:
+			n += Inline24(i)
+		case 196609:
+			n += Inline25(i)
+		case 196610:
+			n += Inline26(i)
+		case 196611:
+			n += Inline27(i)
+		case 196612:
+			n += Inline28(i)
+		case 196613:
+			n += Inline29(i)
+		case 196614:
+			n += Inline30(i)
+		case 196615:
+			n += Inline31(i)
+		case 262144:
+			n += Inline32(i)
+		case 262145:
+			n += Inline33(i)
+		case 262146:
+			n += Inline34(i)
+		case 262147:
+			n += Inline35(i)
+		case 262148:
+			n += Inline36(i)
+		case 262149:
+			n += Inline37(i)
+		case 262150:
+			n += Inline38(i)
+		case 262151:
+			n += Inline39(i)
+		case 327680:
+			n += Inline40(i)
+		case 327681:
+			n += Inline41(i)
+		case 327682:
+			n += Inline42(i)
+		case 327683:
+			n += Inline43(i)
+		case 327684:
+			n += Inline44(i)
+		case 327685:
+			n += Inline45(i)
+		case 327686:
+			n += Inline46(i)
+		case 327687:
+			n += Inline47(i)
+		case 393216:
+			n += Inline48(i)
+		case 393217:
+			n += Inline49(i)
+		case 393218:
+			n += Inline50(i)
+		case 393219:
+			n += Inline51(i)
+		case 393220:
+			n += Inline52(i)
+		case 393221:
+			n += Inline53(i)
+		case 393222:
+			n += Inline54(i)
+		case 393223:
+			n += Inline55(i)
+		case 458752:
+			n += Inline56(i)
+		case 458753:
+			n += Inline57(i)
+		case 458754:
+			n += Inline58(i)
+		case 458755:
+			n += Inline59(i)
+		case 458756:
+			n += Inline60(i)
+		case 458757:
+			n += Inline61(i)
+		case 458758:
+			n += Inline62(i)
+		case 458759:
+			n += Inline63(i)
+		case 524288:
+			n += Inline64(i)
+		case 524289:
+			n += Inline65(i)
+		case 524290:
+			n += Inline66(i)
+		case 524291:
+			n += Inline67(i)
+		case 524292:
+			n += Inline68(i)
+		case 524293:
+			n += Inline69(i)
+		case 524294:
+			n += Inline70(i)
+		case 524295:
+			n += Inline71(i)
+		case 589824:
+			n += Inline72(i)
+		case 589825:
+			n += Inline73(i)
+		case 589826:
+			n += Inline74(i)
+		case 589827:
+			n += Inline75(i)
+		case 589828:
+			n += Inline76(i)
+		case 589829:
+			n += Inline77(i)
+		case 589830:
+			n += Inline78(i)
+		case 589831:
+			n += Inline79(i)
+		case 655360:
+			n += Inline80(i)
+		case 655361:
+			n += Inline81(i)
+		case 655362:
+			n += Inline82(i)
+		case 655363:
+			n += Inline83(i)
+		case 655364:
+			n += Inline84(i)
+		case 655365:
+			n += Inline85(i)
+		case 655366:
+			n += Inline86(i)
+		case 655367:
+			n += Inline87(i)
+		case 720896:
+			n += Inline88(i)
+		case 720897:
+			n += Inline89(i)
+		case 720898:
+			n += Inline90(i)
+		case 720899:
+			n += Inline91(i)
+		case 720900:
+			n += Inline92(i)
+		case 720901:
+			n += Inline93(i)
+		case 720902:
+			n += Inline94(i)
+		case 720903:
+			n += Inline95(i)
+		case 786432:
+			n += Inline96(i)
+		case 786433:
+			n += Inline97(i)
+		case 786434:
+			n += Inline98(i)
+		case 786435:
+			n += Inline99(i)
+		case 786436:
+			n += Inline100(i)
+		case 786437:
+			n += Inline101(i)
+		case 786438:
+			n += Inline102(i)
+		case 786439:
+			n += Inline103(i)
+		case 851968:
+			n += Inline104(i)
+		case 851969:
+			n += Inline105(i)
+		case 851970:
+			n += Inline106(i)
+		case 851971:
+			n += Inline107(i)
+		case 851972:
+			n += Inline108(i)
+		case 851973:
+			n += Inline109(i)
+		case 851974:
+			n += Inline110(i)
+		case 851975:
+			n += Inline111(i)
+		case 917504:
+			n += Inline112(i)
+		case 917505:
+			n += Inline113(i)
+		case 917506:
+			n += Inline114(i)
+		case 917507:
+			n += Inline115(i)
+		case 917508:
+			n += Inline116(i)
+		case 917509:
+			n += Inline117(i)
+		case 917510:
+			n += Inline118(i)
+		case 917511:
+			n += Inline119(i)
+		case 983040:
+			n += Inline120(i)
+		case 983041:
+			n += Inline121(i)
+		case 983042:
+			n += Inline122(i)
+		case 983043:
+			n += Inline123(i)
+		case 983044:
+			n += Inline124(i)
+		case 983045:
+			n += Inline125(i)
+		case 983046:
+			n += Inline126(i)
+		case 983047:
+			n += Inline127(i)
+		case 1048576:
+			n += Inline128(i)
+		case 1048577:
+			n += Inline129(i)
+		case 1048578:
+			n += Inline130(i)
+		case 1048579:
+			n += Inline131(i)
+		case 1048580:
+			n += Inline132(i)
+		case 1048581:
+			n += Inline133(i)
+		case 1048582:
+			n += Inline134(i)
+		case 1048583:
+			n += Inline135(i)
+		case 1114112:
+			n += Inline136(i)
+		case 1114113:
+			n += Inline137(i)
+		case 1114114:
+			n += Inline138(i)
+		case 1114115:
+			n += Inline139(i)
+		case 1114116:
+			n += Inline140(i)
+		case 1114117:
+			n += Inline141(i)
+		case 1114118:
+			n += Inline142(i)
+		case 1114119:
+			n += Inline143(i)
+		case 1179648:
+			n += Inline144(i)
+		case 1179649:
+			n += Inline145(i)
+		case 1179650:
+			n += Inline146(i)
+		case 1179651:
+			n += Inline147(i)
+		case 1179652:
+			n += Inline148(i)
+		case 1179653:
+			n += Inline149(i)
+		case 1179654:
+			n += Inline150(i)
+		case 1179655:
+			n += Inline151(i)
+		case 1245184:
+			n += Inline152(i)
+		case 1245185:
+			n += Inline153(i)
+		case 1245186:
+			n += Inline154(i)
+		case 1245187:
+			n += Inline155(i)
+		case 1245188:
+			n += Inline156(i)
+		case 1245189:
+			n += Inline157(i)
+		case 1245190:
+			n += Inline158(i)
+		case 1245191:
+			n += Inline159(i)
+		case 1310720:
+			n += Inline160(i)
+		case 1310721:
+			n += Inline161(i)
+		case 1310722:
+			n += Inline162(i)
+		case 1310723:
+			n += Inline163(i)
+		case 1310724:
+			n += Inline164(i)
+		case 1310725:
+			n += Inline165(i)
+		case 1310726:
+			n += Inline166(i)
+		case 1310727:
+			n += Inline167(i)
+		case 1376256:
+			n += Inline168(i)
+		case 1376257:
+			n += Inline169(i)
+		case 1376258:
+			n += Inline170(i)
+		case 1376259:
+			n += Inline171(i)
+		case 1376260:
+			n += Inline172(i)
+		case 1376261:
+			n += Inline173(i)
+		case 1376262:
+			n += Inline174(i)
+		case 1376263:
+			n += Inline175(i)
+		case 1441792:
+			n += Inline176(i)
+		case 1441793:
+			n += Inline177(i)
+		case 1441794:
+			n += Inline178(i)
+		case 1441795:
+			n += Inline179(i)
+		case 1441796:
+			n += Inline180(i)
+		case 1441797:
+			n += Inline181(i)
+		case 1441798:
+			n += Inline182(i)
+		case 1441799:
+			n += Inline183(i)
+		case 1507328:
+			n += Inline184(i)
+		case 1507329:
+			n += Inline185(i)
+		case 1507330:
+			n += Inline186(i)
+		case 1507331:
+			n += Inline187(i)
+		case 1507332:
+			n += Inline188(i)
+		case 1507333:
+			n += Inline189(i)
+		case 1507334:
+			n += Inline190(i)
+		case 1507335:
+			n += Inline191(i)
+		case 1572864:
+			n += Inline192(i)
+		case 1572865:
+			n += Inline193(i)
+		case 1572866:
+			n += Inline194(i)
+		case 1572867:
+			n += Inline195(i)
+		case 1572868:
+			n += Inline196(i)
+		case 1572869:
+			n += Inline197(i)
+		case 1572870:
+			n += Inline198(i)
+		case 1572871:
+			n += Inline199(i)
+		case 1638400:
+			n += Inline200(i)
+		case 1638401:
+			n += Inline201(i)
+		case 1638402:
+			n += Inline202(i)
+		case 1638403:
+			n += Inline203(i)
+		case 1638404:
+			n += Inline204(i)
+		case 1638405:
+			n += Inline205(i)
+		case 1638406:
+			n += Inline206(i)
+		case 1638407:
+			n += Inline207(i)
+		case 1703936:
+			n += Inline208(i)
+		case 1703937:
+			n += Inline209(i)
+		case 1703938:
+			n += Inline210(i)
+		case 1703939:
+			n += Inline211(i)
+		case 1703940:
+			n += Inline212(i)
+		case 1703941:
+			n += Inline213(i)
+		case 1703942:
+			n += Inline214(i)
+		case 1703943:
+			n += Inline215(i)
+		case 1769472:
+			n += Inline216(i)
+		case 1769473:
+			n += Inline217(i)
+		case 1769474:
+			n += Inline218(i)
+		case 1769475:
+			n += Inline219(i)
+		case 1769476:
+			n += Inline220(i)
+		case 1769477:
+			n += Inline221(i)
+		case 1769478:
+			n += Inline222(i)
+		case 1769479:
+			n += Inline223(i)
+		case 1835008:
+			n += Inline224(i)
+		case 1835009:
+			n += Inline225(i)
+		case 1835010:
+			n += Inline226(i)
+		case 1835011:
+			n += Inline227(i)
+		case 1835012:
+			n += Inline228(i)
+		case 1835013:
+			n += Inline229(i)
+		case 1835014:
+			n += Inline230(i)
+		case 1835015:
+			n += Inline231(i)
+		case 1900544:
+			n += Inline232(i)
+		case 1900545:
+			n += Inline233(i)
+		case 1900546:
+			n += Inline234(i)
+		case 1900547:
+			n += Inline235(i)
+		case 1900548:
+			n += Inline236(i)
+		case 1900549:
+			n += Inline237(i)
+		case 1900550:
+			n += Inline238(i)
+		case 1900551:
+			n += Inline239(i)
+		case 1966080:
+			n += Inline240(i)
+		case 1966081:
+			n += Inline241(i)
+		case 1966082:
+			n += Inline242(i)
+		case 1966083:
+			n += Inline243(i)
+		case 1966084:
+			n += Inline244(i)
+		case 1966085:
+			n += Inline245(i)
+		case 1966086:
+			n += Inline246(i)
+		case 1966087:
+			n += Inline247(i)
+		case 2031616:
+			n += Inline248(i)
+		case 2031617:
+			n += Inline249(i)
+		case 2031618:
+			n += Inline250(i)
+		case 2031619:
+			n += Inline251(i)
+		case 2031620:
+			n += Inline252(i)
+		case 2031621:
+			n += Inline253(i)
+		case 2031622:
+			n += Inline254(i)
+		case 2031623:
+			n += Inline255(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchInlineClusteredComputed512(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysClustered[i%512] {
+		case 0:
+			n += Inline0(i)
+		case 1:
+			n += Inline1(i)
+		case 2:
+			n += Inline2(i)
+		case 3:
+			n += Inline3(i)
+		case 4:
+			n += Inline4(i)
+		case 5:
+			n += Inline5(i)
+		case 6:
+			n += Inline6(i)
+		case 7:
+			n += Inline7(i)
+		case 65536:
+			n += Inline8(i)
+		case 65537:
+			n += Inline9(i)
+		case 65538:
+			n += Inline10(i)
+		case 65539:
+			n += Inline11(i)
+		case 65540:
+			n += Inline12(i)
+		case 65541:
+			n += Inline13(i)
+		case 65542:
+			n += Inline14(i)
+		case 65543:
+			n += Inline15(i)
+		case 131072:
+			n += Inline16(i)
+		case 131073:
+			n += Inline17(i)
+		case 131074:
+			n += Inline18(i)
+		case 131075:
+			n += Inline19(i)
+		case 131076:
+			n += Inline20(i)
+		case 131077:
+			n += Inline21(i)
+		case 131078:
+			n += Inline22(i)
+		case 131079:
+			n += Inline23(i)
+		case 196608:
+			n += Inline24(i)
+		case 196609:
+			n += Inline25(i)
+		case 196610:
+			n += Inline26(i)
+		case 196611:
+			n += Inline27(i)
+		case 196612:
+			n += Inline28(i)
+		case 196613:
+			n += Inline29(i)
+		case 196614:
+			n += Inline30(i)
+		case 196615:
+			n += Inline31(i)
+		case 262144:
+			n += Inline32(i)
+		case 262145:
+			n += Inline33(i)
+		case 262146:
+			n += Inline34(i)
+		case 262147:
+			n += Inline35(i)
+		case 262148:
+			n += Inline36(i)
+		case 262149:
+			n += Inline37(i)
+		case 262150:
+			n += Inline38(i)
+		case 262151:
+			n += Inline39(i)
+		case 327680:
+			n += Inline40(i)
+		case 327681:
+			n += Inline41(i)
+		case 327682:
+			n += Inline42(i)
+		case 327683:
+			n += Inline43(i)
+		case 327684:
+			n += Inline44(i)
+		case 327685:
+			n += Inline45(i)
+		case 327686:
+			n += Inline46(i)
+		case 327687:
+			n += Inline47(i)
+		case 393216:
+			n += Inline48(i)
+		case 393217:
+			n += Inline49(i)
+		case 393218:
+			n += Inline50(i)
+		case 393219:
+			n += Inline51(i)
+		case 393220:
+			n += Inline52(i)
+		case 393221:
+			n += Inline53(i)
+		case 393222:
+			n += Inline54(i)
+		case 393223:
+			n += Inline55(i)
+		case 458752:
+			n += Inline56(i)
+		case 458753:
+			n += Inline57(i)
+		case 458754:
+			n += Inline58(i)
+		case 458755:
+			n += Inline59(i)
+		case 458756:
+			n += Inline60(i)
+		case 458757:
+			n += Inline61(i)
+		case 458758:
+			n += Inline62(i)
+		case 458759:
+			n += Inline63(i)
+		case 524288:
+			n += Inline64(i)
+		case 524289:
+			n += Inline65(i)
+		case 524290:
+			n += Inline66(i)
+		case 524291:
+			n += Inline67(i)
+		case 524292:
+			n += Inline68(i)
+		case 524293:
+			n += Inline69(i)
+		case 524294:
+			n += Inline70(i)
+		case 524295:
+			n += Inline71(i)
+		case 589824:
+			n += Inline72(i)
+		case 589825:
+			n += Inline73(i)
+		case 589826:
+			n += Inline74(i)
+		case 589827:
+			n += Inline75(i)
+		case 589828:
+			n += Inline76(i)
+		case 589829:
+			n += Inline77(i)
+		case 589830:
+			n += Inline78(i)
+		case 589831:
+			n += Inline79(i)
+		case 655360:
+			n += Inline80(i)
+		case 655361:
+			n += Inline81(i)
+		case 655362:
+			n += Inline82(i)
+		case 655363:
+			n += Inline83(i)
+		case 655364:
+			n += Inline84(i)
+		case 655365:
+			n += Inline85(i)
+		case 655366:
+			n += Inline86(i)
+		case 655367:
+			n += Inline87(i)
+		case 720896:
+			n += Inline88(i)
+		case 720897:
+			n += Inline89(i)
+		case 720898:
+			n += Inline90(i)
+		case 720899:
+			n += Inline91(i)
+		case 720900:
+			n += Inline92(i)
+		case 720901:
+			n += Inline93(i)
+		case 720902:
+			n += Inline94(i)
+		case 720903:
+			n += Inline95(i)
+		case 786432:
+			n += Inline96(i)
+		case 786433:
+			n += Inline97(i)
+		case 786434:
+			n += Inline98(i)
+		case 786435:
+			n += Inline99(i)
+		case 786436:
+			n += Inline100(i)
+		case 786437:
+			n += Inline101(i)
+		case 786438:
+			n += Inline102(i)
+		case 786439:
+			n += Inline103(i)
+		case 851968:
+			n += Inline104(i)
+		case 851969:
+			n += Inline105(i)
+		case 851970:
+			n += Inline106(i)
+		case 851971:
+			n += Inline107(i)
+		case 851972:
+			n += Inline108(i)
+		case 851973:
+			n += Inline109(i)
+		case 851974:
+			n += Inline110(i)
+		case 851975:
+			n += Inline111(i)
+		case 917504:
+			n += Inline112(i)
+		case 917505:
+			n += Inline113(i)
+		case 917506:
+			n += Inline114(i)
+		case 917507:
+			n += Inline115(i)
+		case 917508:
+			n += Inline116(i)
+		case 917509:
+			n += Inline117(i)
+		case 917510:
+			n += Inline118(i)
+		case 917511:
+			n += Inline119(i)
+		case 983040:
+			n += Inline120(i)
+		case 983041:
+			n += Inline121(i)
+		case 983042:
+			n += Inline122(i)
+		case 983043:
+			n += Inline123(i)
+		case 983044:
+			n += Inline124(i)
+		case 983045:
+			n += Inline125(i)
+		case 983046:
+			n += Inline126(i)
+		case 983047:
+			n += Inline127(i)
+		case 1048576:
+			n += Inline128(i)
+		case 1048577:
+			n += Inline129(i)
+		case 1048578:
+			n += Inline130(i)
+		case 1048579:
+			n += Inline131(i)
+		case 1048580:
+			n += Inline132(i)
+		case 1048581:
+			n += Inline133(i)
+		case 1048582:
+			n += Inline134(i)
+		case 1048583:
+			n += Inline135(i)
+		case 1114112:
+			n += Inline136(i)
+		case 1114113:
+			n += Inline137(i)
+		case 1114114:
+			n += Inline138(i)
+		case 1114115:
+			n += Inline139(i)
+		case 1114116:
+			n += Inline140(i)
+		case 1114117:
+			n += Inline141(i)
+		case 1114118:
+			n += Inline142(i)
+		case 1114119:
+			n += Inline143(i)
+		case 1179648:
+			n += Inline144(i)
+		case 1179649:
+			n += Inline145(i)
+		case 1179650:
+			n += Inline146(i)
+		case 1179651:
+			n += Inline147(i)
+		case 1179652:
+			n += Inline148(i)
+		case 1179653:
+			n += Inline149(i)
+		case 1179654:
+			n += Inline150(i)
+		case 1179655:
+			n += Inline151(i)
+		case 1245184:
+			n += Inline152(i)
+		case 1245185:
+			n += Inline153(i)
+		case 1245186:
+			n += Inline154(i)
+		case 1245187:
+			n += Inline155(i)
+		case 1245188:
+			n += Inline156(i)
+		case 1245189:
+			n += Inline157(i)
+		case 1245190:
+			n += Inline158(i)
+		case 1245191:
+			n += Inline159(i)
+		case 1310720:
+			n += Inline160(i)
+		case 1310721:
+			n += Inline161(i)
+		case 1310722:
+			n += Inline162(i)
+		case 1310723:
+			n += Inline163(i)
+		case 1310724:
+			n += Inline164(i)
+		case 1310725:
+			n += Inline165(i)
+		case 1310726:
+			n += Inline166(i)
+		case 1310727:
+			n += Inline167(i)
+		case 1376256:
+			n += Inline168(i)
+		case 1376257:
+			n += Inline169(i)
+		case 1376258:
+			n += Inline170(i)
+		case 1376259:
+			n += Inline171(i)
+		case 1376260:
+			n += Inline172(i)
+		case 1376261:
+			n += Inline173(i)
+		case 1376262:
+			n += Inline174(i)
+		case 1376263:
+			n += Inline175(i)
+		case 1441792:
+			n += Inline176(i)
+		case 1441793:
+			n += Inline177(i)
+		case 1441794:
+			n += Inline178(i)
+		case 1441795:
+			n += Inline179(i)
+		case 1441796:
+			n += Inline180(i)
+		case 1441797:
+			n += Inline181(i)
+		case 1441798:
+			n += Inline182(i)
+		case 1441799:
+			n += Inline183(i)
+		case 1507328:
+			n += Inline184(i)
+		case 1507329:
+			n += Inline185(i)
+		case 1507330:
+			n += Inline186(i)
+		case 1507331:
+			n += Inline187(i)
+		case 1507332:
+			n += Inline188(i)
+		case 1507333:
+			n += Inline189(i)
+		case 1507334:
+			n += Inline190(i)
+		case 1507335:
+			n += Inline191(i)
+		case 1572864:
+			n += Inline192(i)
+		case 1572865:
+			n += Inline193(i)
+		case 1572866:
+			n += Inline194(i)
+		case 1572867:
+			n += Inline195(i)
+		case 1572868:
+			n += Inline196(i)
+		case 1572869:
+			n += Inline197(i)
+		case 1572870:
+			n += Inline198(i)
+		case 1572871:
+			n += Inline199(i)
+		case 1638400:
+			n += Inline200(i)
+		case 1638401:
+			n += Inline201(i)
+		case 1638402:
+			n += Inline202(i)
+		case 1638403:
+			n += Inline203(i)
+		case 1638404:
+			n += Inline204(i)
+		case 1638405:
+			n += Inline205(i)
+		case 1638406:
+			n += Inline206(i)
+		case 1638407:
+			n += Inline207(i)
+		case 1703936:
+			n += Inline208(i)
+		case 1703937:
+			n += Inline209(i)
+		case 1703938:
+			n += Inline210(i)
+		case 1703939:
+			n += Inline211(i)
+		case 1703940:
+			n += Inline212(i)
+		case 1703941:
+			n += Inline213(i)
+		case 1703942:
+			n += Inline214(i)
+		case 1703943:
+			n += Inline215(i)
+		case 1769472:
+			n += Inline216(i)
+		case 1769473:
+			n += Inline217(i)
+		case 1769474:
+			n += Inline218(i)
+		case 1769475:
+			n += Inline219(i)
+		case 1769476:
+			n += Inline220(i)
+		case 1769477:
+			n += Inline221(i)
+		case 1769478:
+			n += Inline222(i)
+		case 1769479:
+			n += Inline223(i)
+		case 1835008:
+			n += Inline224(i)
+		case 1835009:
+			n += Inline225(i)
+		case 1835010:
+			n += Inline226(i)
+		case 1835011:
+			n += Inline227(i)
+		case 1835012:
+			n += Inline228(i)
+		case 1835013:
+			n += Inline229(i)
+		case 1835014:
+			n += Inline230(i)
+		case 1835015:
+			n += Inline231(i)
+		case 1900544:
+			n += Inline232(i)
+		case 1900545:
+			n += Inline233(i)
+		case 1900546:
+			n += Inline234(i)
+		case 1900547:
+			n += Inline235(i)
+		case 1900548:
+			n += Inline236(i)
+		case 1900549:
+			n += Inline237(i)
+		case 1900550:
+			n += Inline238(i)
+		case 1900551:
+			n += Inline239(i)
+		case 1966080:
+			n += Inline240(i)
+		case 1966081:
+			n += Inline241(i)
+		case 1966082:
+			n += Inline242(i)
+		case 1966083:
+			n += Inline243(i)
+		case 1966084:
+			n += Inline244(i)
+		case 1966085:
+			n += Inline245(i)
+		case 1966086:
+			n += Inline246(i)
+		case 1966087:
+			n += Inline247(i)
+		case 2031616:
+			n += Inline248(i)
+		case 2031617:
+			n += Inline249(i)
+		case 2031618:
+			n += Inline250(i)
+		case 2031619:
+			n += Inline251(i)
+		case 2031620:
+			n += Inline252(i)
+		case 2031621:
+			n += Inline253(i)
+		case 2031622:
+			n += Inline254(i)
+		case 2031623:
+			n += Inline255(i)
+		case 2097152:
+			n += Inline256(i)
+		case 2097153:
+			n += Inline257(i)
+		case 2097154:
+			n += Inline258(i)
+		case 2097155:
+			n += Inline259(i)
+		case 2097156:
+			n += Inline260(i)
+		case 2097157:
+			n += Inline261(i)
+		case 2097158:
+			n += Inline262(i)
+		case 2097159:
+			n += Inline263(i)
+		case 2162688:
+			n += Inline264(i)
+		case 2162689:
+			n += Inline265(i)
+		case 2162690:
+			n += Inline266(i)
+		case 2162691:
+			n += Inline267(i)
+		case 2162692:
+			n += Inline268(i)
+		case 2162693:
+			n += Inline269(i)
+		case 2162694:
+			n += Inline270(i)
+		case 2162695:
+			n += Inline271(i)
+		case 2228224:
+			n += Inline272(i)
+		case 2228225:
+			n += Inline273(i)
+		case 2228226:
+			n += Inline274(i)
+		case 2228227:
+			n += Inline275(i)
+		case 2228228:
+			n += Inline276(i)
+		case 2228229:
+			n += Inline277(i)
+		case 2228230:
+			n += Inline278(i)
+		case 2228231:
+			n += Inline279(i)
+		case 2293760:
+			n += Inline280(i)
+		case 2293761:
+			n += Inline281(i)
+		case 2293762:
+			n += Inline282(i)
+		case 2293763:
+			n += Inline283(i)
+		case 2293764:
+			n += Inline284(i)
+		case 2293765:
+			n += Inline285(i)
+		case 2293766:
+			n += Inline286(i)
+		case 2293767:
+			n += Inline287(i)
+		case 2359296:
+			n += Inline288(i)
+		case 2359297:
+			n += Inline289(i)
+		case 2359298:
+			n += Inline290(i)
+		case 2359299:
+			n += Inline291(i)
+		case 2359300:
+			n += Inline292(i)
+		case 2359301:
+			n += Inline293(i)
+		case 2359302:
+			n += Inline294(i)
+		case 2359303:
+			n += Inline295(i)
+		case 2424832:
+			n += Inline296(i)
+		case 2424833:
+			n += Inline297(i)
+		case 2424834:
+			n += Inline298(i)
+		case 2424835:
+			n += Inline299(i)
+		case 2424836:
+			n += Inline300(i)
+		case 2424837:
+			n += Inline301(i)
+		case 2424838:
+			n += Inline302(i)
+		case 2424839:
+			n += Inline303(i)
+		case 2490368:
+			n += Inline304(i)
+		case 2490369:
+			n += Inline305(i)
+		case 2490370:
+			n += Inline306(i)
+		case 2490371:
+			n += Inline307(i)
+		case 2490372:
+			n += Inline308(i)
+		case 2490373:
+			n += Inline309(i)
+		case 2490374:
+			n += Inline310(i)
+		case 2490375:
+			n += Inline311(i)
+		case 2555904:
+			n += Inline312(i)
+		case 2555905:
+			n += Inline313(i)
+		case 2555906:
+			n += Inline314(i)
+		case 2555907:
+			n += Inline315(i)
+		case 2555908:
+			n += Inline316(i)
+		case 2555909:
+			n += Inline317(i)
+		case 2555910:
+			n += Inline318(i)
+		case 2555911:
+			n += Inline319(i)
+		case 2621440:
+			n += Inline320(i)
+		case 2621441:
+			n += Inline321(i)
+		case 2621442:
+			n += Inline322(i)
+		case 2621443:
+			n += Inline323(i)
+		case 2621444:
+			n += Inline324(i)
+		case 2621445:
+			n += Inline325(i)
+		case 2621446:
+			n += Inline326(i)
+		case 2621447:
+			n += Inline327(i)
+		case 2686976:
+			n += Inline328(i)
+		case 2686977:
+			n += Inline329(i)
+		case 2686978:
+			n += Inline330(i)
+		case 2686979:
+			n += Inline331(i)
+		case 2686980:
+			n += Inline332(i)
+		case 2686981:
+			n += Inline333(i)
+		case 2686982:
+			n += Inline334(i)
+		case 2686983:
+			n += Inline335(i)
+		case 2752512:
+			n += Inline336(i)
+		case 2752513:
+			n += Inline337(i)
+		case 2752514:
+			n += Inline338(i)
+		case 2752515:
+			n += Inline339(i)
+		case 2752516:
+			n += Inline340(i)
+		case 2752517:
+			n += Inline341(i)
+		case 2752518:
+			n += Inline342(i)
+		case 2752519:
+			n += Inline343(i)
+		case 2818048:
+			n += Inline344(i)
+		case 2818049:
+			n += Inline345(i)
+		case 2818050:
+			n += Inline346(i)
+		case 2818051:
+			n += Inline347(i)
+		case 2818052:
+			n += Inline348(i)
+		case 2818053:
+			n += Inline349(i)
+		case 2818054:
+			n += Inline350(i)
+		case 2818055:
+			n += Inline351(i)
+		case 2883584:
+			n += Inline352(i)
+		case 2883585:
+			n += Inline353(i)
+		case 2883586:
+			n += Inline354(i)
+		case 2883587:
+			n += Inline355(i)
+		case 2883588:
+			n += Inline356(i)
+		case 2883589:
+			n += Inline357(i)
+		case 2883590:
+			n += Inline358(i)
+		case 2883591:
+			n += Inline359(i)
+		case 2949120:
+			n += Inline360(i)
+		case 2949121:
+			n += Inline361(i)
+		case 2949122:
+			n += Inline362(i)
+		case 2949123:
+			n += Inline363(i)
+		case 2949124:
+			n += Inline364(i)
+		case 2949125:
+			n += Inline365(i)
+		case 2949126:
+			n += Inline366(i)
+		case 2949127:
+			n += Inline367(i)
+		case 3014656:
+			n += Inline368(i)
+		case 3014657:
+			n += Inline369(i)
+		case 3014658:
+			n += Inline370(i)
+		case 3014659:
+			n += Inline371(i)
+		case 3014660:
+			n += Inline372(i)
+		case 3014661:
+			n += Inline373(i)
+		case 3014662:
+			n += Inline374(i)
+		case 3014663:
+			n += Inline375(i)
+		case 3080192:
+			n += Inline376(i)
+		case 3080193:
+			n += Inline377(i)
+		case 3080194:
+			n += Inline378(i)
+		case 3080195:
+			n += Inline379(i)
+		case 3080196:
+			n += Inline380(i)
+		case 3080197:
+			n += Inline381(i)
+		case 3080198:
+			n += Inline382(i)
+		case 3080199:
+			n += Inline383(i)
+		case 3145728:
+			n += Inline384(i)
+		case 3145729:
+			n += Inline385(i)
+		case 3145730:
+			n += Inline386(i)
+		case 3145731:
+			n += Inline387(i)
+		case 3145732:
+			n += Inline388(i)
+		case 3145733:
+			n += Inline389(i)
+		case 3145734:
+			n += Inline390(i)
+		case 3145735:
+			n += Inline391(i)
+		case 3211264:
+			n += Inline392(i)
+		case 3211265:
+			n += Inline393(i)
+		case 3211266:
+			n += Inline394(i)
+		case 3211267:
+			n += Inline395(i)
+		case 3211268:
+			n += Inline396(i)
+		case 3211269:
+			n += Inline397(i)
+		case 3211270:
+			n += Inline398(i)
+		case 3211271:
+			n += Inline399(i)
+		case 3276800:
+			n += Inline400(i)
+		case 3276801:
+			n += Inline401(i)
+		case 3276802:
+			n += Inline402(i)
+		case 3276803:
+			n += Inline403(i)
+		case 3276804:
+			n += Inline404(i)
+		case 3276805:
+			n += Inline405(i)
+		case 3276806:
+			n += Inline406(i)
+		case 3276807:
+			n += Inline407(i)
+		case 3342336:
+			n += Inline408(i)
+		case 3342337:
+			n += Inline409(i)
+		case 3342338:
+			n += Inline410(i)
+		case 3342339:
+			n += Inline411(i)
+		case 3342340:
+			n += Inline412(i)
+		case 3342341:
+			n += Inline413(i)
+		case 3342342:
+			n += Inline414(i)
+		case 3342343:
+			n += Inline415(i)
+		case 3407872:
+			n += Inline416(i)
+		case 3407873:
+			n += Inline417(i)
+		case 3407874:
+			n += Inline418(i)
+		case 3407875:
+			n += Inline419(i)
+		case 3407876:
+			n += Inline420(i)
+		case 3407877:
+			n += Inline421(i)
+		case 3407878:
+			n += Inline422(i)
+		case 3407879:
+			n += Inline423(i)
+		case 3473408:
+			n += Inline424(i)
+		case 3473409:
+			n += Inline425(i)
+		case 3473410:
+			n += Inline426(i)
+		case 3473411:
+			n += Inline427(i)
+		case 3473412:
+			n += Inline428(i)
+		case 3473413:
+			n += Inline429(i)
+		case 3473414:
+			n += Inline430(i)
+		case 3473415:
+			n += Inline431(i)
+		case 3538944:
+			n += Inline432(i)
+		case 3538945:
+			n += Inline433(i)
+		case 3538946:
+			n += Inline434(i)
+		case 3538947:
+			n += Inline435(i)
+		case 3538948:
+			n += Inline436(i)
+		case 3538949:
+			n += Inline437(i)
+		case 3538950:
+			n += Inline438(i)
+		case 3538951:
+			n += Inline439(i)
+		case 3604480:
+			n += Inline440(i)
+		case 3604481:
+			n += Inline441(i)
+		case 3604482:
+			n += Inline442(i)
+		case 3604483:
+			n += Inline443(i)
+		case 3604484:
+			n += Inline444(i)
+		case 3604485:
+			n += Inline445(i)
+		case 3604486:
+			n += Inline446(i)
+		case 3604487:
+			n += Inline447(i)
+		case 3670016:
+			n += Inline448(i)
+		case 3670017:
+			n += Inline449(i)
+		case 3670018:
+			n += Inline450(i)
+		case 3670019:
+			n += Inline451(i)
+		case 3670020:
+			n += Inline452(i)
+		case 3670021:
+			n += Inline453(i)
+		case 3670022:
+			n += Inline454(i)
+		case 3670023:
+			n += Inline455(i)
+		case 3735552:
+			n += Inline456(i)
+		case 3735553:
+			n += Inline457(i)
+		case 3735554:
+			n += Inline458(i)
+		case 3735555:
+			n += Inline459(i)
+		case 3735556:
+			n += Inline460(i)
+		case 3735557:
+			n += Inline461(i)
+		case 3735558:
+			n += Inline462(i)
+		case 3735559:
+			n += Inline463(i)
+		case 3801088:
+			n += Inline464(i)
+		case 3801089:
+			n += Inline465(i)
+		case 3801090:
+			n += Inline466(i)
+		case 3801091:
+			n += Inline467(i)
+		case 3801092:
+			n += Inline468(i)
+		case 3801093:
+			n += Inline469(i)
+		case 3801094:
+			n += Inline470(i)
+		case 3801095:
+			n += Inline471(i)
+		case 3866624:
+			n += Inline472(i)
+		case 3866625:
+			n += Inline473(i)
+		case 3866626:
+			n += Inline474(i)
+		case 3866627:
+			n += Inline475(i)
+		case 3866628:
+			n += Inline476(i)
+		case 3866629:
+			n += Inline477(i)
+		case 3866630:
+			n += Inline478(i)
+		case 3866631:
+			n += Inline479(i)
+		case 3932160:
+			n += Inline480(i)
+		case 3932161:
+			n += Inline481(i)
+		case 3932162:
+			n += Inline482(i)
+		case 3932163:
+			n += Inline483(i)
+		case 3932164:
+			n += Inline484(i)
+		case 3932165:
+			n += Inline485(i)
+		case 3932166:
+			n += Inline486(i)
+		case 3932167:
+			n += Inline487(i)
+		case 3997696:
+			n += Inline488(i)
+		case 3997697:
+			n += Inline489(i)
+		case 3997698:
+			n += Inline490(i)
+		case 3997699:
+			n += Inline491(i)
+		case 3997700:
+			n += Inline492(i)
+		case 3997701:
+			n += Inline493(i)
+		case 3997702:
+			n += Inline494(i)
+		case 3997703:
+			n += Inline495(i)
+		case 4063232:
+			n += Inline496(i)
+		case 4063233:
+			n += Inline497(i)
+		case 4063234:
+			n += Inline498(i)
+		case 4063235:
+			n += Inline499(i)
+		case 4063236:
+			n += Inline500(i)
+		case 4063237:
+			n += Inline501(i)
+		case 4063238:
+			n += Inline502(i)
+		case 4063239:
+			n += Inline503(i)
+		case 4128768:
+			n += Inline504(i)
+		case 4128769:
+			n += Inline505(i)
+		case 4128770:
+			n += Inline506(i)
+		case 4128771:
+			n += Inline507(i)
+		case 4128772:
+			n += Inline508(i)
+		case 4128773:
+			n += Inline509(i)
+		case 4128774:
+			n += Inline510(i)
+		case 4128775:
+			n += Inline511(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchInlineClusteredLookupMod512(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysClustered[inputs[i%len(inputs)]] {
+		case 0:
+			n += Inline0(i)
+		case 1:
+			n += Inline1(i)
+		case 2:
+			n += Inline2(i)
+		case 3:
+			n += Inline3(i)
+		case 4:
+			n += Inline4(i)
+		case 5:
+			n += Inline5(i)
+		case 6:
+			n += Inline6(i)
+		case 7:
+			n += Inline7(i)
+		case 65536:
+			n += Inline8(i)
+		case 65537:
+			n += Inline9(i)
+		case 65538:
+			n += Inline10(i)
+		case 65539:
+			n += Inline11(i)
+		case 65540:
+			n += Inline12(i)
+		case 65541:
+			n += Inline13(i)
+		case 65542:
+			n += Inline14(i)
+		case 65543:
+			n += Inline15(i)
+		case 131072:
+			n += Inline16(i)
+		case 131073:
+			n += Inline17(i)
+		case 131074:
+			n += Inline18(i)
+		case 131075:
+			n += Inline19(i)
+		case 131076:
+			n += Inline20(i)
+		case 131077:
+			n += Inline21(i)
+		case 131078:
+			n += Inline22(i)
+		case 131079:
+			n += Inline23(i)
+		case 196608:
+			n += Inline24(i)
+		case 196609:
+			n += Inline25(i)
+		case 196610:
+			n += Inline26(i)
+		case 196611:
+			n += Inline27(i)
+		case 196612:
+			n += Inline28(i)
+		case 196613:
+			n += Inline29(i)
+		case 196614:
+			n += Inline30(i)
+		case 196615:
+			n += Inline31(i)
+		case 262144:
+			n += Inline32(i)
+		case 262145:
+			n += Inline33(i)
+		case 262146:
+			n += Inline34(i)
+		case 262147:
+			n += Inline35(i)
+		case 262148:
+			n += Inline36(i)
+		case 262149:
+			n += Inline37(i)
+		case 262150:
+			n += Inline38(i)
+		case 262151:
+			n += Inline39(i)
+		case 327680:
+			n += Inline40(i)
+		case 327681:
+			n += Inline41(i)
+		case 327682:
+			n += Inline42(i)
+		case 327683:
+			n += Inline43(i)
+		case 327684:
+			n += Inline44(i)
+		case 327685:
+			n += Inline45(i)
+		case 327686:
+			n += Inline46(i)
+		case 327687:
+			n += Inline47(i)
+		case 393216:
+			n += Inline48(i)
+		case 393217:
+			n += Inline49(i)
+		case 393218:
+			n += Inline50(i)
+		case 393219:
+			n += Inline51(i)
+		case 393220:
+			n += Inline52(i)
+		case 393221:
+			n += Inline53(i)
+		case 393222:
+			n += Inline54(i)
+		case 393223:
+			n += Inline55(i)
+		case 458752:
+			n += Inline56(i)
+		case 458753:
+			n += Inline57(i)
+		case 458754:
+			n += Inline58(i)
+		case 458755:
+			n += Inline59(i)
+		case 458756:
+			n += Inline60(i)
+		case 458757:
+			n += Inline61(i)
+		case 458758:
+			n += Inline62(i)
+		case 458759:
+			n += Inline63(i)
+		case 524288:
+			n += Inline64(i)
+		case 524289:
+			n += Inline65(i)
+		case 524290:
+			n += Inline66(i)
+		case 524291:
+			n += Inline67(i)
+		case 524292:
+			n += Inline68(i)
+		case 524293:
+			n += Inline69(i)
+		case 524294:
+			n += Inline70(i)
+		case 524295:
+			n += Inline71(i)
+		case 589824:
+			n += Inline72(i)
+		case 589825:
+			n += Inline73(i)
+		case 589826:
+			n += Inline74(i)
+		case 589827:
+			n += Inline75(i)
+		case 589828:
+			n += Inline76(i)
+		case 589829:
+			n += Inline77(i)
+		case 589830:
+			n += Inline78(i)
+		case 589831:
+			n += Inline79(i)
+		case 655360:
+			n += Inline80(i)
+		case 655361:
+			n += Inline81(i)
+		case 655362:
+			n += Inline82(i)
+		case 655363:
+			n += Inline83(i)
+		case 655364:
+			n += Inline84(i)
+		case 655365:
+			n += Inline85(i)
+		case 655366:
+			n += Inline86(i)
+		case 655367:
+			n += Inline87(i)
+		case 720896:
+			n += Inline88(i)
+		case 720897:
+			n += Inline89(i)
+		case 720898:
+			n += Inline90(i)
+		case 720899:
+			n += Inline91(i)
+		case 720900:
+			n += Inline92(i)
+		case 720901:
+			n += Inline93(i)
+		case 720902:
+			n += Inline94(i)
+		case 720903:
+			n += Inline95(i)
+		case 786432:
+			n += Inline96(i)
+		case 786433:
+			n += Inline97(i)
+		case 786434:
+			n += Inline98(i)
+		case 786435:
+			n += Inline99(i)
+		case 786436:
+			n += Inline100(i)
+		case 786437:
+			n += Inline101(i)
+		case 786438:
+			n += Inline102(i)
+		case 786439:
+			n += Inline103(i)
+		case 851968:
+			n += Inline104(i)
+		case 851969:
+			n += Inline105(i)
+		case 851970:
+			n += Inline106(i)
+		case 851971:
+			n += Inline107(i)
+		case 851972:
+			n += Inline108(i)
+		case 851973:
+			n += Inline109(i)
+		case 851974:
+			n += Inline110(i)
+		case 851975:
+			n += Inline111(i)
+		case 917504:
+			n += Inline112(i)
+		case 917505:
+			n += Inline113(i)
+		case 917506:
+			n += Inline114(i)
+		case 917507:
+			n += Inline115(i)
+		case 917508:
+			n += Inline116(i)
+		case 917509:
+			n += Inline117(i)
+		case 917510:
+			n += Inline118(i)
+		case 917511:
+			n += Inline119(i)
+		case 983040:
+			n += Inline120(i)
+		case 983041:
+			n += Inline121(i)
+		case 983042:
+			n += Inline122(i)
+		case 983043:
+			n += Inline123(i)
+		case 983044:
+			n += Inline124(i)
+		case 983045:
+			n += Inline125(i)
+		case 983046:
+			n += Inline126(i)
+		case 983047:
+			n += Inline127(i)
+		case 1048576:
+			n += Inline128(i)
+		case 1048577:
+			n += Inline129(i)
+		case 1048578:
+			n += Inline130(i)
+		case 1048579:
+			n += Inline131(i)
+		case 1048580:
+			n += Inline132(i)
+		case 1048581:
+			n += Inline133(i)
+		case 1048582:
+			n += Inline134(i)
+		case 1048583:
+			n += Inline135(i)
+		case 1114112:
+			n += Inline136(i)
+		case 1114113:
+			n += Inline137(i)
+		case 1114114:
+			n += Inline138(i)
+		case 1114115:
+			n += Inline139(i)
+		case 1114116:
+			n += Inline140(i)
+		case 1114117:
+			n += Inline141(i)
+		case 1114118:
+			n += Inline142(i)
+		case 1114119:
+			n += Inline143(i)
+		case 1179648:
+			n += Inline144(i)
+		case 1179649:
+			n += Inline145(i)
+		case 1179650:
+			n += Inline146(i)
+		case 1179651:
+			n += Inline147(i)
+		case 1179652:
+			n += Inline148(i)
+		case 1179653:
+			n += Inline149(i)
+		case 1179654:
+			n += Inline150(i)
+		case 1179655:
+			n += Inline151(i)
+		case 1245184:
+			n += Inline152(i)
+		case 1245185:
+			n += Inline153(i)
+		case 1245186:
+			n += Inline154(i)
+		case 1245187:
+			n += Inline155(i)
+		case 1245188:
+			n += Inline156(i)
+		case 1245189:
+			n += Inline157(i)
+		case 1245190:
+			n += Inline158(i)
+		case 1245191:
+			n += Inline159(i)
+		case 1310720:
+			n += Inline160(i)
+		case 1310721:
+			n += Inline161(i)
+		case 1310722:
+			n += Inline162(i)
+		case 1310723:
+			n += Inline163(i)
+		case 1310724:
+			n += Inline164(i)
+		case 1310725:
+			n += Inline165(i)
+		case 1310726:
+			n += Inline166(i)
+		case 1310727:
+			n += Inline167(i)
+		case 1376256:
+			n += Inline168(i)
+		case 1376257:
+			n += Inline169(i)
+		case 1376258:
+			n += Inline170(i)
+		case 1376259:
+			n += Inline171(i)
+		case 1376260:
+			n += Inline172(i)
+		case 1376261:
+			n += Inline173(i)
+		case 1376262:
+			n += Inline174(i)
+		case 1376263:
+			n += Inline175(i)
+		case 1441792:
+			n += Inline176(i)
+		case 1441793:
+			n += Inline177(i)
+		case 1441794:
+			n += Inline178(i)
+		case 1441795:
+			n += Inline179(i)
+		case 1441796:
+			n += Inline180(i)
+		case 1441797:
+			n += Inline181(i)
+		case 1441798:
+			n += Inline182(i)
+		case 1441799:
+			n += Inline183(i)
+		case 1507328:
+			n += Inline184(i)
+		case 1507329:
+			n += Inline185(i)
+		case 1507330:
+			n += Inline186(i)
+		case 1507331:
+			n += Inline187(i)
+		case 1507332:
+			n += Inline188(i)
+		case 1507333:
+			n += Inline189(i)
+		case 1507334:
+			n += Inline190(i)
+		case 1507335:
+			n += Inline191(i)
+		case 1572864:
+			n += Inline192(i)
+		case 1572865:
+			n += Inline193(i)
+		case 1572866:
+			n += Inline194(i)
+		case 1572867:
+			n += Inline195(i)
+		case 1572868:
+			n += Inline196(i)
+		case 1572869:
+			n += Inline197(i)
+		case 1572870:
+			n += Inline198(i)
+		case 1572871:
+			n += Inline199(i)
+		case 1638400:
+			n += Inline200(i)
+		case 1638401:
+			n += Inline201(i)
+		case 1638402:
+			n += Inline202(i)
+		case 1638403:
+			n += Inline203(i)
+		case 1638404:
+			n += Inline204(i)
+		case 1638405:
+			n += Inline205(i)
+		case 1638406:
+			n += Inline206(i)
+		case 1638407:
+			n += Inline207(i)
+		case 1703936:
+			n += Inline208(i)
+		case 1703937:
+			n += Inline209(i)
+		case 1703938:
+			n += Inline210(i)
+		case 1703939:
+			n += Inline211(i)
+		case 1703940:
+			n += Inline212(i)
+		case 1703941:
+			n += Inline213(i)
+		case 1703942:
+			n += Inline214(i)
+		case 1703943:
+			n += Inline215(i)
+		case 1769472:
+			n += Inline216(i)
+		case 1769473:
+			n += Inline217(i)
+		case 1769474:
+			n += Inline218(i)
+		case 1769475:
+			n += Inline219(i)
+		case 1769476:
+			n += Inline220(i)
+		case 1769477:
+			n += Inline221(i)
+		case 1769478:
+			n += Inline222(i)
+		case 1769479:
+			n += Inline223(i)
+		case 1835008:
+			n += Inline224(i)
+		case 1835009:
+			n += Inline225(i)
+		case 1835010:
+			n += Inline226(i)
+		case 1835011:
+			n += Inline227(i)
+		case 1835012:
+			n += Inline228(i)
+		case 1835013:
+			n += Inline229(i)
+		case 1835014:
+			n += Inline230(i)
+		case 1835015:
+			n += Inline231(i)
+		case 1900544:
+			n += Inline232(i)
+		case 1900545:
+			n += Inline233(i)
+		case 1900546:
+			n += Inline234(i)
+		case 1900547:
+			n += Inline235(i)
+		case 1900548:
+			n += Inline236(i)
+		case 1900549:
+			n += Inline237(i)
+		case 1900550:
+			n += Inline238(i)
+		case 1900551:
+			n += Inline239(i)
+		case 1966080:
+			n += Inline240(i)
+		case 1966081:
+			n += Inline241(i)
+		case 1966082:
+			n += Inline242(i)
+		case 1966083:
+			n += Inline243(i)
+		case 1966084:
+			n += Inline244(i)
+		case 1966085:
+			n += Inline245(i)
+		case 1966086:
+			n += Inline246(i)
+		case 1966087:
+			n += Inline247(i)
+		case 2031616:
+			n += Inline248(i)
+		case 2031617:
+			n += Inline249(i)
+		case 2031618:
+			n += Inline250(i)
+		case 2031619:
+			n += Inline251(i)
+		case 2031620:
+			n += Inline252(i)
+		case 2031621:
+			n += Inline253(i)
+		case 2031622:
+			n += Inline254(i)
+		case 2031623:
+			n += Inline255(i)
+		case 2097152:
+			n += Inline256(i)
+		case 2097153:
+			n += Inline257(i)
+		case 2097154:
+			n += Inline258(i)
+		case 2097155:
+			n += Inline259(i)
+		case 2097156:
+			n += Inline260(i)
+		case 2097157:
+			n += Inline261(i)
+		case 2097158:
+			n += Inline262(i)
+		case 2097159:
+			n += Inline263(i)
+		case 2162688:
+			n += Inline264(i)
+		case 2162689:
+			n += Inline265(i)
+		case 2162690:
+			n += Inline266(i)
+		case 2162691:
+			n += Inline267(i)
+		case 2162692:
+			n += Inline268(i)
+		case 2162693:
+			n += Inline269(i)
+		case 2162694:
+			n += Inline270(i)
+		case 2162695:
+			n += Inline271(i)
+		case 2228224:
+			n += Inline272(i)
+		case 2228225:
+			n += Inline273(i)
+		case 2228226:
+			n += Inline274(i)
+		case 2228227:
+			n += Inline275(i)
+		case 2228228:
+			n += Inline276(i)
+		case 2228229:
+			n += Inline277(i)
+		case 2228230:
+			n += Inline278(i)
+		case 2228231:
+			n += Inline279(i)
+		case 2293760:
+			n += Inline280(i)
+		case 2293761:
+			n += Inline281(i)
+		case 2293762:
+			n += Inline282(i)
+		case 2293763:
+			n += Inline283(i)
+		case 2293764:
+			n += Inline284(i)
+		case 2293765:
+			n += Inline285(i)
+		case 2293766:
+			n += Inline286(i)
+		case 2293767:
+			n += Inline287(i)
+		case 2359296:
+			n += Inline288(i)
+		case 2359297:
+			n += Inline289(i)
+		case 2359298:
+			n += Inline290(i)
+		case 2359299:
+			n += Inline291(i)
+		case 2359300:
+			n += Inline292(i)
+		case 2359301:
+			n += Inline293(i)
+		case 2359302:
+			n += Inline294(i)
+		case 2359303:
+			n += Inline295(i)
+		case 2424832:
+			n += Inline296(i)
+		case 2424833:
+			n += Inline297(i)
+		case 2424834:
+			n += Inline298(i)
+		case 2424835:
+			n += Inline299(i)
+		case 2424836:
+			n += Inline300(i)
+		case 2424837:
+			n += Inline301(i)
+		case 2424838:
+			n += Inline302(i)
+		case 2424839:
+			n += Inline303(i)
+		case 2490368:
+			n += Inline304(i)
+		case 2490369:
+			n += Inline305(i)
+		case 2490370:
+			n += Inline306(i)
+		case 2490371:
+			n += Inline307(i)
+		case 2490372:
+			n += Inline308(i)
+		case 2490373:
+			n += Inline309(i)
+		case 2490374:
+			n += Inline310(i)
+		case 2490375:
+			n += Inline311(i)
+		case 2555904:
+			n += Inline312(i)
+		case 2555905:
+			n += Inline313(i)
+		case 2555906:
+			n += Inline314(i)
+		case 2555907:
+			n += Inline315(i)
+		case 2555908:
+			n += Inline316(i)
+		case 2555909:
+			n += Inline317(i)
+		case 2555910:
+			n += Inline318(i)
+		case 2555911:
+			n += Inline319(i)
+		case 2621440:
+			n += Inline320(i)
+		case 2621441:
+			n += Inline321(i)
+		case 2621442:
+			n += Inline322(i)
+		case 2621443:
+			n += Inline323(i)
+		case 2621444:
+			n += Inline324(i)
+		case 2621445:
+			n += Inline325(i)
+		case 2621446:
+			n += Inline326(i)
+		case 2621447:
+			n += Inline327(i)
+		case 2686976:
+			n += Inline328(i)
+		case 2686977:
+			n += Inline329(i)
+		case 2686978:
+			n += Inline330(i)
+		case 2686979:
+			n += Inline331(i)
+		case 2686980:
+			n += Inline332(i)
+		case 2686981:
+			n += Inline333(i)
+		case 2686982:
+			n += Inline334(i)
+		case 2686983:
+			n += Inline335(i)
+		case 2752512:
+			n += Inline336(i)
+		case 2752513:
+			n += Inline337(i)
+		case 2752514:
+			n += Inline338(i)
+		case 2752515:
+			n += Inline339(i)
+		case 2752516:
+			n += Inline340(i)
+		case 2752517:
+			n += Inline341(i)
+		case 2752518:
+			n += Inline342(i)
+		case 2752519:
+			n += Inline343(i)
+		case 2818048:
+			n += Inline344(i)
+		case 2818049:
+			n += Inline345(i)
+		case 2818050:
+			n += Inline346(i)
+		case 2818051:
+			n += Inline347(i)
+		case 2818052:
+			n += Inline348(i)
+		case 2818053:
+			n += Inline349(i)
+		case 2818054:
+			n += Inline350(i)
+		case 2818055:
+			n += Inline351(i)
+		case 2883584:
+			n += Inline352(i)
+		case 2883585:
+			n += Inline353(i)
+		case 2883586:
+			n += Inline354(i)
+		case 2883587:
+			n += Inline355(i)
+		case 2883588:
+			n += Inline356(i)
+		case 2883589:
+			n += Inline357(i)
+		case 2883590:
+			n += Inline358(i)
+		case 2883591:
+			n += Inline359(i)
+		case 2949120:
+			n += Inline360(i)
+		case 2949121:
+			n += Inline361(i)
+		case 2949122:
+			n += Inline362(i)
+		case 2949123:
+			n += Inline363(i)
+		case 2949124:
+			n += Inline364(i)
+		case 2949125:
+			n += Inline365(i)
+		case 2949126:
+			n += Inline366(i)
+		case 2949127:
+			n += Inline367(i)
+		case 3014656:
+			n += Inline368(i)
+		case 3014657:
+			n += Inline369(i)
+		case 3014658:
+			n += Inline370(i)
+		case 3014659:
+			n += Inline371(i)
+		case 3014660:
+			n += Inline372(i)
+		case 3014661:
+			n += Inline373(i)
+		case 3014662:
+			n += Inline374(i)
+		case 3014663:
+			n += Inline375(i)
+		case 3080192:
+			n += Inline376(i)
+		case 3080193:
+			n += Inline377(i)
+		case 3080194:
+			n += Inline378(i)
+		case 3080195:
+			n += Inline379(i)
+		case 3080196:
+			n += Inline380(i)
+		case 3080197:
+			n += Inline381(i)
+		case 3080198:
+			n += Inline382(i)
+		case 3080199:
+			n += Inline383(i)
+		case 3145728:
+			n += Inline384(i)
+		case 3145729:
+			n += Inline385(i)
+		case 3145730:
+			n += Inline386(i)
+		case 3145731:
+			n += Inline387(i)
+		case 3145732:
+			n += Inline388(i)
+		case 3145733:
+			n += Inline389(i)
+		case 3145734:
+			n += Inline390(i)
+		case 3145735:
+			n += Inline391(i)
+		case 3211264:
+			n += Inline392(i)
+		case 3211265:
+			n += Inline393(i)
+		case 3211266:
+			n += Inline394(i)
+		case 3211267:
+			n += Inline395(i)
+		case 3211268:
+			n += Inline396(i)
+		case 3211269:
+			n += Inline397(i)
+		case 3211270:
+			n += Inline398(i)
+		case 3211271:
+			n += Inline399(i)
+		case 3276800:
+			n += Inline400(i)
+		case 3276801:
+			n += Inline401(i)
+		case 3276802:
+			n += Inline402(i)
+		case 3276803:
+			n += Inline403(i)
+		case 3276804:
+			n += Inline404(i)
+		case 3276805:
+			n += Inline405(i)
+		case 3276806:
+			n += Inline406(i)
+		case 3276807:
+			n += Inline407(i)
+		case 3342336:
+			n += Inline408(i)
+		case 3342337:
+			n += Inline409(i)
+		case 3342338:
+			n += Inline410(i)
+		case 3342339:
+			n += Inline411(i)
+		case 3342340:
+			n += Inline412(i)
+		case 3342341:
+			n += Inline413(i)
+		case 3342342:
+			n += Inline414(i)
+		case 3342343:
+			n += Inline415(i)
+		case 3407872:
+			n += Inline416(i)
+		case 3407873:
+			n += Inline417(i)
+		case 3407874:
+			n += Inline418(i)
+		case 3407875:
+			n += Inline419(i)
+		case 3407876:
+			n += Inline420(i)
+		case 3407877:
+			n += Inline421(i)
+		case 3407878:
+			n += Inline422(i)
+		case 3407879:
+			n += Inline423(i)
+		case 3473408:
+			n += Inline424(i)
+		case 3473409:
+			n += Inline425(i)
+		case 3473410:
+			n += Inline426(i)
+		case 3473411:
+			n += Inline427(i)
+		case 3473412:
+			n += Inline428(i)
+		case 3473413:
+			n += Inline429(i)
+		case 3473414:
+			n += Inline430(i)
+		case 3473415:
+			n += Inline431(i)
+		case 3538944:
+			n += Inline432(i)
+		case 3538945:
+			n += Inline433(i)
+		case 3538946:
+			n += Inline434(i)
+		case 3538947:
+			n += Inline435(i)
+		case 3538948:
+			n += Inline436(i)
+		case 3538949:
+			n += Inline437(i)
+		case 3538950:
+			n += Inline438(i)
+		case 3538951:
+			n += Inline439(i)
+		case 3604480:
+			n += Inline440(i)
+		case 3604481:
+			n += Inline441(i)
+		case 3604482:
+			n += Inline442(i)
+		case 3604483:
+			n += Inline443(i)
+		case 3604484:
+			n += Inline444(i)
+		case 3604485:
+			n += Inline445(i)
+		case 3604486:
+			n += Inline446(i)
+		case 3604487:
+			n += Inline447(i)
+		case 3670016:
+			n += Inline448(i)
+		case 3670017:
+			n += Inline449(i)
+		case 3670018:
+			n += Inline450(i)
+		case 3670019:
+			n += Inline451(i)
+		case 3670020:
+			n += Inline452(i)
+		case 3670021:
+			n += Inline453(i)
+		case 3670022:
+			n += Inline454(i)
+		case 3670023:
+			n += Inline455(i)
+		case 3735552:
+			n += Inline456(i)
+		case 3735553:
+			n += Inline457(i)
+		case 3735554:
+			n += Inline458(i)
+		case 3735555:
+			n += Inline459(i)
+		case 3735556:
+			n += Inline460(i)
+		case 3735557:
+			n += Inline461(i)
+		case 3735558:
+			n += Inline462(i)
+		case 3735559:
+			n += Inline463(i)
+		case 3801088:
+			n += Inline464(i)
+		case 3801089:
+			n += Inline465(i)
+		case 3801090:
+			n += Inline466(i)
+		case 3801091:
+			n += Inline467(i)
+		case 3801092:
+			n += Inline468(i)
+		case 3801093:
+			n += Inline469(i)
+		case 3801094:
+			n += Inline470(i)
+		case 3801095:
+			n += Inline471(i)
+		case 3866624:
+			n += Inline472(i)
+		case 3866625:
+			n += Inline473(i)
+		case 3866626:
+			n += Inline474(i)
+		case 3866627:
+			n += Inline475(i)
+		case 3866628:
+			n += Inline476(i)
+		case 3866629:
+			n += Inline477(i)
+		case 3866630:
+			n += Inline478(i)
+		case 3866631:
+			n += Inline479(i)
+		case 3932160:
+			n += Inline480(i)
+		case 3932161:
+			n += Inline481(i)
+		case 3932162:
+			n += Inline482(i)
+		case 3932163:
+			n += Inline483(i)
+		case 3932164:
+			n += Inline484(i)
+		case 3932165:
+			n += Inline485(i)
+		case 3932166:
+			n += Inline486(i)
+		case 3932167:
+			n += Inline487(i)
+		case 3997696:
+			n += Inline488(i)
+		case 3997697:
+			n += Inline489(i)
+		case 3997698:
+			n += Inline490(i)
+		case 3997699:
+			n += Inline491(i)
+		case 3997700:
+			n += Inline492(i)
+		case 3997701:
+			n += Inline493(i)
+		case 3997702:
+			n += Inline494(i)
+		case 3997703:
+			n += Inline495(i)
+		case 4063232:
+			n += Inline496(i)
+		case 4063233:
+			n += Inline497(i)
+		case 4063234:
+			n += Inline498(i)
+		case 4063235:
+			n += Inline499(i)
+		case 4063236:
+			n += Inline500(i)
+		case 4063237:
+			n += Inline501(i)
+		case 4063238:
+			n += Inline502(i)
+		case 4063239:
+			n += Inline503(i)
+		case 4128768:
+			n += Inline504(i)
+		case 4128769:
+			n += Inline505(i)
+		case 4128770:
+			n += Inline506(i)
+		case 4128771:
+			n += Inline507(i)
+		case 4128772:
+			n += Inline508(i)
+		case 4128773:
+			n += Inline509(i)
+		case 4128774:
+			n += Inline510(i)
+		case 4128775:
+			n += Inline511(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchNoInlineDenseComputed4(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysDense[i%4] {
+		case 0:
+			n += NoInline0(i)
+		case 1:
+			n += NoInline1(i)
+		case 2:
+			n += NoInline2(i)
+		case 3:
+			n += NoInline3(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchNoInlineDenseLookupMod4(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysDense[inputs[i%len(inputs)]] {
+		case 0:
+			n += NoInline0(i)
+		case 1:
+			n += NoInline1(i)
+		case 2:
+			n += NoInline2(i)
+		case 3:
+			n += NoInline3(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchNoInlineDenseComputed8(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysDense[i%8] {
+		case 0:
+			n += NoInline0(i)
+		case 1:
+			n += NoInline1(i)
+		case 2:
+			n += NoInline2(i)
+		case 3:
+			n += NoInline3(i)
+		case 4:
+			n += NoInline4(i)
+		case 5:
+			n += NoInline5(i)
+		case 6:
+			n += NoInline6(i)
+		case 7:
+			n += NoInline7(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchNoInlineDenseLookupMod8(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysDense[inputs[i%len(inputs)]] {
+		case 0:
+			n += NoInline0(i)
+		case 1:
+			n += NoInline1(i)
+		case 2:
+			n += NoInline2(i)
+		case 3:
+			n += NoInline3(i)
+		case 4:
+			n += NoInline4(i)
+		case 5:
+			n += NoInline5(i)
+		case 6:
+			n += NoInline6(i)
+		case 7:
+			n += NoInline7(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchNoInlineDenseComputed16(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysDense[i%16] {
+		case 0:
+			n += NoInline0(i)
+		case 1:
+			n += NoInline1(i)
+		case 2:
+			n += NoInline2(i)
+		case 3:
+			n += NoInline3(i)
+		case 4:
+			n += NoInline4(i)
+		case 5:
+			n += NoInline5(i)
+		case 6:
+			n += NoInline6(i)
+		case 7:
+			n += NoInline7(i)
+		case 8:
+			n += NoInline8(i)
+		case 9:
+			n += NoInline9(i)
+		case 10:
+			n += NoInline10(i)
+		case 11:
+			n += NoInline11(i)
+		case 12:
+			n += NoInline12(i)
+		case 13:
+			n += NoInline13(i)
+		case 14:
+			n += NoInline14(i)
+		case 15:
+			n += NoInline15(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchNoInlineDenseLookupMod16(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysDense[inputs[i%len(inputs)]] {
+		case 0:
+			n += NoInline0(i)
+		case 1:
+			n += NoInline1(i)
+		case 2:
+			n += NoInline2(i)
+		case 3:
+			n += NoInline3(i)
+		case 4:
+			n += NoInline4(i)
+		case 5:
+			n += NoInline5(i)
+		case 6:
+			n += NoInline6(i)
+		case 7:
+			n += NoInline7(i)
+		case 8:
+			n += NoInline8(i)
+		case 9:
+			n += NoInline9(i)
+		case 10:
+			n += NoInline10(i)
+		case 11:
+			n += NoInline11(i)
+		case 12:
+			n += NoInline12(i)
+		case 13:
+			n += NoInline13(i)
+		case 14:
+			n += NoInline14(i)
+		case 15:
+			n += NoInline15(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchNoInlineDenseComputed32(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysDense[i%32] {
+		case 0:
+			n += NoInline0(i)
+		case 1:
+			n += NoInline1(i)
+		case 2:
+			n += NoInline2(i)
+		case 3:
+			n += NoInline3(i)
+		case 4:
+			n += NoInline4(i)
+		case 5:
+			n += NoInline5(i)
+		case 6:
+			n += NoInline6(i)
+		case 7:
+			n += NoInline7(i)
+		case 8:
+			n += NoInline8(i)
+		case 9:
+			n += NoInline9(i)
+		case 10:
+			n += NoInline10(i)
+		case 11:
+			n += NoInline11(i)
+		case 12:
+			n += NoInline12(i)
+		case 13:
+			n += NoInline13(i)
+		case 14:
+			n += NoInline14(i)
+		case 15:
+			n += NoInline15(i)
+		case 16:
+			n += NoInline16(i)
+		case 17:
+			n += NoInline17(i)
+		case 18:
+			n += NoInline18(i)
+		case 19:
+			n += NoInline19(i)
+		case 20:
+			n += NoInline20(i)
+		case 21:
+			n += NoInline21(i)
+		case 22:
+			n += NoInline22(i)
+		case 23:
+			n += NoInline23(i)
+		case 24:
+			n += NoInline24(i)
+		case 25:
+			n += NoInline25(i)
+		case 26:
+			n += NoInline26(i)
+		case 27:
+			n += NoInline27(i)
+		case 28:
+			n += NoInline28(i)
+		case 29:
+			n += NoInline29(i)
+		case 30:
+			n += NoInline30(i)
+		case 31:
+			n += NoInline31(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchNoInlineDenseLookupMod32(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysDense[inputs[i%len(inputs)]] {
+		case 0:
+			n += NoInline0(i)
+		case 1:
+			n += NoInline1(i)
+		case 2:
+			n += NoInline2(i)
+		case 3:
+			n += NoInline3(i)
+		case 4:
+			n += NoInline4(i)
+		case 5:
+			n += NoInline5(i)
+		case 6:
+			n += NoInline6(i)
+		case 7:
+			n += NoInline7(i)
+		case 8:
+			n += NoInline8(i)
+		case 9:
+			n += NoInline9(i)
+		case 10:
+			n += NoInline10(i)
+		case 11:
+			n += NoInline11(i)
+		case 12:
+			n += NoInline12(i)
+		case 13:
+			n += NoInline13(i)
+		case 14:
+			n += NoInline14(i)
+		case 15:
+			n += NoInline15(i)
+		case 16:
+			n += NoInline16(i)
+		case 17:
+			n += NoInline17(i)
+		case 18:
+			n += NoInline18(i)
+		case 19:
+			n += NoInline19(i)
+		case 20:
+			n += NoInline20(i)
+		case 21:
+			n += NoInline21(i)
+		case 22:
+			n += NoInline22(i)
+		case 23:
+			n += NoInline23(i)
+		case 24:
+			n += NoInline24(i)
+		case 25:
+			n += NoInline25(i)
+		case 26:
+			n += NoInline26(i)
+		case 27:
+			n += NoInline27(i)
+		case 28:
+			n += NoInline28(i)
+		case 29:
+			n += NoInline29(i)
+		case 30:
+			n += NoInline30(i)
+		case 31:
+			n += NoInline31(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchNoInlineDenseComputed64(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysDense[i%64] {
+		case 0:
+			n += NoInline0(i)
+		case 1:
+			n += NoInline1(i)
+		case 2:
+			n += NoInline2(i)
+		case 3:
+			n += NoInline3(i)
+		case 4:
+			n += NoInline4(i)
+		case 5:
+			n += NoInline5(i)
+		case 6:
+			n += NoInline6(i)
+		case 7:
+			n += NoInline7(i)
+		case 8:
+			n += NoInline8(i)
+		case 9:
+			n += NoInline9(i)
+		case 10:
+			n += NoInline10(i)
+		case 11:
+			n += NoInline11(i)
+		case 12:
+			n += NoInline12(i)
+		case 13:
+			n += NoInline13(i)
+		case 14:
+			n += NoInline14(i)
+		case 15:
+			n += NoInline15(i)
+		case 16:
+			n += NoInline16(i)
+		case 17:
+			n += NoInline17(i)
+		case 18:
+			n += NoInline18(i)
+		case 19:
+			n += NoInline19(i)
+		case 20:
+			n += NoInline20(i)
+		case 21:
+			n += NoInline21(i)
+		case 22:
+			n += NoInline22(i)
+		case 23:
+			n += NoInline23(i)
+		case 24:
+			n += NoInline24(i)
+		case 25:
+			n += NoInline25(i)
+		case 26:
+			n += NoInline26(i)
+		case 27:
+			n += NoInline27(i)
+		case 28:
+			n += NoInline28(i)
+		case 29:
+			n += NoInline29(i)
+		case 30:
+			n += NoInline30(i)
+		case 31:
+			n += NoInline31(i)
+		case 32:
+			n += NoInline32(i)
+		case 33:
+			n += NoInline33(i)
+		case 34:
+			n += NoInline34(i)
+		case 35:
+			n += NoInline35(i)
+		case 36:
+			n += NoInline36(i)
+		case 37:
+			n += NoInline37(i)
+		case 38:
+			n += NoInline38(i)
+		case 39:
+			n += NoInline39(i)
+		case 40:
+			n += NoInline40(i)
+		case 41:
+			n += NoInline41(i)
+		case 42:
+			n += NoInline42(i)
+		case 43:
+			n += NoInline43(i)
+		case 44:
+			n += NoInline44(i)
+		case 45:
+			n += NoInline45(i)
+		case 46:
+			n += NoInline46(i)
+		case 47:
+			n += NoInline47(i)
+		case 48:
+			n += NoInline48(i)
+		case 49:
+			n += NoInline49(i)
+		case 50:
+			n += NoInline50(i)
+		case 51:
+			n += NoInline51(i)
+		case 52:
+			n += NoInline52(i)
+		case 53:
+			n += NoInline53(i)
+		case 54:
+			n += NoInline54(i)
+		case 55:
+			n += NoInline55(i)
+		case 56:
+			n += NoInline56(i)
+		case 57:
+			n += NoInline57(i)
+		case 58:
+			n += NoInline58(i)
+		case 59:
+			n += NoInline59(i)
+		case 60:
+			n += NoInline60(i)
+		case 61:
+			n += NoInline61(i)
+		case 62:
+			n += NoInline62(i)
+		case 63:
+			n += NoInline63(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchNoInlineDenseLookupMod64(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysDense[inputs[i%len(inputs)]] {
+		case 0:
+			n += NoInline0(i)
+		case 1:
+			n += NoInline1(i)
+		case 2:
+			n += NoInline2(i)
+		case 3:
+			n += NoInline3(i)
+		case 4:
+			n += NoInline4(i)
+		case 5:
+			n += NoInline5(i)
+		case 6:
+			n += NoInline6(i)
+		case 7:
+			n += NoInline7(i)
+		case 8:
+			n += NoInline8(i)
+		case 9:
+			n += NoInline9(i)
+		case 10:
+			n += NoInline10(i)
+		case 11:
+			n += NoInline11(i)
+		case 12:
+			n += NoInline12(i)
+		case 13:
+			n += NoInline13(i)
+		case 14:
+			n += NoInline14(i)
+		case 15:
+			n += NoInline15(i)
+		case 16:
+			n += NoInline16(i)
+		case 17:
+			n += NoInline17(i)
+		case 18:
+			n += NoInline18(i)
+		case 19:
+			n += NoInline19(i)
+		case 20:
+			n += NoInline20(i)
+		case 21:
+			n += NoInline21(i)
+		case 22:
+			n += NoInline22(i)
+		case 23:
+			n += NoInline23(i)
+		case 24:
+			n += NoInline24(i)
+		case 25:
+			n += NoInline25(i)
+		case 26:
+			n += NoInline26(i)
+		case 27:
+			n += NoInline27(i)
+		case 28:
+			n += NoInline28(i)
+		case 29:
+			n += NoInline29(i)
+		case 30:
+			n += NoInline30(i)
+		case 31:
+			n += NoInline31(i)
+		case 32:
+			n += NoInline32(i)
+		case 33:
+			n += NoInline33(i)
+		case 34:
+			n += NoInline34(i)
+		case 35:
+			n += NoInline35(i)
+		case 36:
+			n += NoInline36(i)
+		case 37:
+			n += NoInline37(i)
+		case 38:
+			n += NoInline38(i)
+		case 39:
+			n += NoInline39(i)
+		case 40:
+			n += NoInline40(i)
+		case 41:
+			n += NoInline41(i)
+		case 42:
+			n += NoInline42(i)
+		case 43:
+			n += NoInline43(i)
+		case 44:
+			n += NoInline44(i)
+		case 45:
+			n += NoInline45(i)
+		case 46:
+			n += NoInline46(i)
+		case 47:
+			n += NoInline47(i)
+		case 48:
+			n += NoInline48(i)
+		case 49:
+			n += NoInline49(i)
+		case 50:
+			n += NoInline50(i)
+		case 51:
+			n += NoInline51(i)
+		case 52:
+			n += NoInline52(i)
+		case 53:
+			n += NoInline53(i)
+		case 54:
+			n += NoInline54(i)
+		case 55:
+			n += NoInline55(i)
+		case 56:
+			n += NoInline56(i)
+		case 57:
+			n += NoInline57(i)
+		case 58:
+			n += NoInline58(i)
+		case 59:
+			n += NoInline59(i)
+		case 60:
+			n += NoInline60(i)
+		case 61:
+			n += NoInline61(i)
+		case 62:
+			n += NoInline62(i)
+		case 63:
+			n += NoInline63(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchNoInlineDenseComputed128(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysDense[i%128] {
+		case 0:
+			n += NoInline0(i)
+		case 1:
+			n += NoInline1(i)
+		case 2:
+			n += NoInline2(i)
+		case 3:
+			n += NoInline3(i)
+		case 4:
+			n += NoInline4(i)
+		case 5:
+			n += NoInline5(i)
+		case 6:
+			n += NoInline6(i)
+		case 7:
+			n += NoInline7(i)
+		case 8:
+			n += NoInline8(i)
+		case 9:
+			n += NoInline9(i)
+		case 10:
+			n += NoInline10(i)
+		case 11:
+			n += NoInline11(i)
+		case 12:
+			n += NoInline12(i)
+		case 13:
+			n += NoInline13(i)
+		case 14:
+			n += NoInline14(i)
+		case 15:
+			n += NoInline15(i)
+		case 16:
+			n += NoInline16(i)
+		case 17:
+			n += NoInline17(i)
+		case 18:
+			n += NoInline18(i)
+		case 19:
+			n += NoInline19(i)
+		case 20:
+			n += NoInline20(i)
+		case 21:
+			n += NoInline21(i)
+		case 22:
+			n += NoInline22(i)
+		case 23:
+			n += NoInline23(i)
+		case 24:
+			n += NoInline24(i)
+		case 25:
+			n += NoInline25(i)
+		case 26:
+			n += NoInline26(i)
+		case 27:
+			n += NoInline27(i)
+		case 28:
+			n += NoInline28(i)
+		case 29:
+			n += NoInline29(i)
+		case 30:
+			n += NoInline30(i)
+		case 31:
+			n += NoInline31(i)
+		case 32:
+			n += NoInline32(i)
+		case 33:
+			n += NoInline33(i)
+		case 34:
+			n += NoInline34(i)
+		case 35:
+			n += NoInline35(i)
+		case 36:
+			n += NoInline36(i)
+		case 37:
+			n += NoInline37(i)
+		case 38:
+			n += NoInline38(i)
+		case 39:
+			n += NoInline39(i)
+		case 40:
+			n += NoInline40(i)
+		case 41:
+			n += NoInline41(i)
+		case 42:
+			n += NoInline42(i)
+		case 43:
+			n += NoInline43(i)
+		case 44:
+			n += NoInline44(i)
+		case 45:
+			n += NoInline45(i)
+		case 46:
+			n += NoInline46(i)
+		case 47:
+			n += NoInline47(i)
+		case 48:
+			n += NoInline48(i)
+		case 49:
+			n += NoInline49(i)
+		case 50:
+			n += NoInline50(i)
+		case 51:
+			n += NoInline51(i)
+		case 52:
+			n += NoInline52(i)
+		case 53:
+			n += NoInline53(i)
+		case 54:
+			n += NoInline54(i)
+		case 55:
+			n += NoInline55(i)
+		case 56:
+			n += NoInline56(i)
+		case 57:
+			n += NoInline57(i)
+		case 58:
+			n += NoInline58(i)
+		case 59:
+			n += NoInline59(i)
+		case 60:
+			n += NoInline60(i)
+		case 61:
+			n += NoInline61(i)
+		case 62:
+			n += NoInline62(i)
+		case 63:
+			n += NoInline63(i)
+		case 64:
+			n += NoInline64(i)
+		case 65:
+			n += NoInline65(i)
+		case 66:
+			n += NoInline66(i)
+		case 67:
+			n += NoInline67(i)
+		case 68:
+			n += NoInline68(i)
+		case 69:
+			n += NoInline69(i)
+		case 70:
+			n += NoInline70(i)
+		case 71:
+			n += NoInline71(i)
+		case 72:
+			n += NoInline72(i)
+		case 73:
+			n += NoInline73(i)
+		case 74:
+			n += NoInline74(i)
+		case 75:
+			n += NoInline75(i)
+		case 76:
+			n += NoInline76(i)
+		case 77:
+			n += NoInline77(i)
+		case 78:
+			n += NoInline78(i)
+		case 79:
+			n += NoInline79(i)
+		case 80:
+			n += NoInline80(i)
+		case 81:
+			n += NoInline81(i)
+		case 82:
+			n += NoInline82(i)
+		case 83:
+			n += NoInline83(i)
+		case 84:
+			n += NoInline84(i)
+		case 85:
+			n += NoInline85(i)
+		case 86:
+			n += NoInline86(i)
+		case 87:
+			n += NoInline87(i)
+		case 88:
+			n += NoInline88(i)
+		case 89:
+			n += NoInline89(i)
+		case 90:
+			n += NoInline90(i)
+		case 91:
+			n += NoInline91(i)
+		case 92:
+			n += NoInline92(i)
+		case 93:
+			n += NoInline93(i)
+		case 94:
+			n += NoInline94(i)
+		case 95:
+			n += NoInline95(i)
+		case 96:
+			n += NoInline96(i)
+		case 97:
+			n += NoInline97(i)
+		case 98:
+			n += NoInline98(i)
+		case 99:
+			n += NoInline99(i)
+		case 100:
+			n += NoInline100(i)
+		case 101:
+			n += NoInline101(i)
+		case 102:
+			n += NoInline102(i)
+		case 103:
+			n += NoInline103(i)
+		case 104:
+			n += NoInline104(i)
+		case 105:
+			n += NoInline105(i)
+		case 106:
+			n += NoInline106(i)
+		case 107:
+			n += NoInline107(i)
+		case 108:
+			n += NoInline108(i)
+		case 109:
+			n += NoInline109(i)
+		case 110:
+			n += NoInline110(i)
+		case 111:
+			n += NoInline111(i)
+		case 112:
+			n += NoInline112(i)
+		case 113:
+			n += NoInline113(i)
+		case 114:
+			n += NoInline114(i)
+		case 115:
+			n += NoInline115(i)
+		case 116:
+			n += NoInline116(i)
+		case 117:
+			n += NoInline117(i)
+		case 118:
+			n += NoInline118(i)
+		case 119:
+			n += NoInline119(i)
+		case 120:
+			n += NoInline120(i)
+		case 121:
+			n += NoInline121(i)
+		case 122:
+			n += NoInline122(i)
+		case 123:
+			n += NoInline123(i)
+		case 124:
+			n += NoInline124(i)
+		case 125:
+			n += NoInline125(i)
+		case 126:
+			n += NoInline126(i)
+		case 127:
+			n += NoInline127(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchNoInlineDenseLookupMod128(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysDense[inputs[i%len(inputs)]] {
+		case 0:
+			n += NoInline0(i)
+		case 1:
+			n += NoInline1(i)
+		case 2:
+			n += NoInline2(i)
+		case 3:
+			n += NoInline3(i)
+		case 4:
+			n += NoInline4(i)
+		case 5:
+			n += NoInline5(i)
+		case 6:
+			n += NoInline6(i)
+		case 7:
+			n += NoInline7(i)
+		case 8:
+			n += NoInline8(i)
+		case 9:
+			n += NoInline9(i)
+		case 10:
+			n += NoInline10(i)
+		case 11:
+			n += NoInline11(i)
+		case 12:
+			n += NoInline12(i)
+		case 13:
+			n += NoInline13(i)
+		case 14:
+			n += NoInline14(i)
+		case 15:
+			n += NoInline15(i)
+		case 16:
+			n += NoInline16(i)
+		case 17:
+			n += NoInline17(i)
+		case 18:
+			n += NoInline18(i)
+		case 19:
+			n += NoInline19(i)
+		case 20:
+			n += NoInline20(i)
+		case 21:
+			n += NoInline21(i)
+		case 22:
+			n += NoInline22(i)
+		case 23:
+			n += NoInline23(i)
+		case 24:
+			n += NoInline24(i)
+		case 25:
+			n += NoInline25(i)
+		case 26:
+			n += NoInline26(i)
+		case 27:
+			n += NoInline27(i)
+		case 28:
+			n += NoInline28(i)
+		case 29:
+			n += NoInline29(i)
+		case 30:
+			n += NoInline30(i)
+		case 31:
+			n += NoInline31(i)
+		case 32:
+			n += NoInline32(i)
+		case 33:
+			n += NoInline33(i)
+		case 34:
+			n += NoInline34(i)
+		case 35:
+			n += NoInline35(i)
+		case 36:
+			n += NoInline36(i)
+		case 37:
+			n += NoInline37(i)
+		case 38:
+			n += NoInline38(i)
+		case 39:
+			n += NoInline39(i)
+		case 40:
+			n += NoInline40(i)
+		case 41:
+			n += NoInline41(i)
+		case 42:
+			n += NoInline42(i)
+		case 43:
+			n += NoInline43(i)
+		case 44:
+			n += NoInline44(i)
+		case 45:
+			n += NoInline45(i)
+		case 46:
+			n += NoInline46(i)
+		case 47:
+			n += NoInline47(i)
+		case 48:
+			n += NoInline48(i)
+		case 49:
+			n += NoInline49(i)
+		case 50:
+			n += NoInline50(i)
+		case 51:
+			n += NoInline51(i)
+		case 52:
+			n += NoInline52(i)
+		case 53:
+			n += NoInline53(i)
+		case 54:
+			n += NoInline54(i)
+		case 55:
+			n += NoInline55(i)
+		case 56:
+			n += NoInline56(i)
+		case 57:
+			n += NoInline57(i)
+		case 58:
+			n += NoInline58(i)
+		case 59:
+			n += NoInline59(i)
+		case 60:
+			n += NoInline60(i)
+		case 61:
+			n += NoInline61(i)
+		case 62:
+			n += NoInline62(i)
+		case 63:
+			n += NoInline63(i)
+		case 64:
+			n += NoInline64(i)
+		case 65:
+			n += NoInline65(i)
+		case 66:
+			n += NoInline66(i)
+		case 67:
+			n += NoInline67(i)
+		case 68:
+			n += NoInline68(i)
+		case 69:
+			n += NoInline69(i)
+		case 70:
+			n += NoInline70(i)
+		case 71:
+			n += NoInline71(i)
+		case 72:
+			n += NoInline72(i)
+		case 73:
+			n += NoInline73(i)
+		case 74:
+			n += NoInline74(i)
+		case 75:
+			n += NoInline75(i)
+		case 76:
+			n += NoInline76(i)
+		case 77:
+			n += NoInline77(i)
+		case 78:
+			n += NoInline78(i)
+		case 79:
+			n += NoInline79(i)
+		case 80:
+			n += NoInline80(i)
+		case 81:
+			n += NoInline81(i)
+		case 82:
+			n += NoInline82(i)
+		case 83:
+			n += NoInline83(i)
+		case 84:
+			n += NoInline84(i)
+		case 85:
+			n += NoInline85(i)
+		case 86:
+			n += NoInline86(i)
+		case 87:
+			n += NoInline87(i)
+		case 88:
+			n += NoInline88(i)
+		case 89:
+			n += NoInline89(i)
+		case 90:
+			n += NoInline90(i)
+		case 91:
+			n += NoInline91(i)
+		case 92:
+			n += NoInline92(i)
+		case 93:
+			n += NoInline93(i)
+		case 94:
+			n += NoInline94(i)
+		case 95:
+			n += NoInline95(i)
+		case 96:
+			n += NoInline96(i)
+		case 97:
+			n += NoInline97(i)
+		case 98:
+			n += NoInline98(i)
+		case 99:
+			n += NoInline99(i)
+		case 100:
+			n += NoInline100(i)
+		case 101:
+			n += NoInline101(i)
+		case 102:
+			n += NoInline102(i)
+		case 103:
+			n += NoInline103(i)
+		case 104:
+			n += NoInline104(i)
+		case 105:
+			n += NoInline105(i)
+		case 106:
+			n += NoInline106(i)
+		case 107:
+			n += NoInline107(i)
+		case 108:
+			n += NoInline108(i)
+		case 109:
+			n += NoInline109(i)
+		case 110:
+			n += NoInline110(i)
+		case 111:
+			n += NoInline111(i)
+		case 112:
+			n += NoInline112(i)
+		case 113:
+			n += NoInline113(i)
+		case 114:
+			n += NoInline114(i)
+		case 115:
+			n += NoInline115(i)
+		case 116:
+			n += NoInline116(i)
+		case 117:
+			n += NoInline117(i)
+		case 118:
+			n += NoInline118(i)
+		case 119:
+			n += NoInline119(i)
+		case 120:
+			n += NoInline120(i)
+		case 121:
+			n += NoInline121(i)
+		case 122:
+			n += NoInline122(i)
+		case 123:
+			n += NoInline123(i)
+		case 124:
+			n += NoInline124(i)
+		case 125:
+			n += NoInline125(i)
+		case 126:
+			n += NoInline126(i)
+		case 127:
+			n += NoInline127(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchNoInlineDenseComputed256(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysDense[i%256] {
+		case 0:
+			n += NoInline0(i)
+		case 1:
+			n += NoInline1(i)
+		case 2:
+			n += NoInline2(i)
+		case 3:
+			n += NoInline3(i)
+		case 4:
+			n += NoInline4(i)
+		case 5:
+			n += NoInline5(i)
+		case 6:
+			n += NoInline6(i)
+		case 7:
+			n += NoInline7(i)
+		case 8:
+			n += NoInline8(i)
+		case 9:
+			n += NoInline9(i)
+		case 10:
+			n += NoInline10(i)
+		case 11:
+			n += NoInline11(i)
+		case 12:
+			n += NoInline12(i)
+		case 13:
+			n += NoInline13(i)
+		case 14:
+			n += NoInline14(i)
+		case 15:
+			n += NoInline15(i)
+		case 16:
+			n += NoInline16(i)
+		case 17:
+			n += NoInline17(i)
+		case 18:
+			n += NoInline18(i)
+		case 19:
+			n += NoInline19(i)
+		case 20:
+			n += NoInline20(i)
+		case 21:
+			n += NoInline21(i)
+		case 22:
+			n += NoInline22(i)
+		case 23:
+			n += NoInline23(i)
+		case 24:
+			n += NoInline24(i)
+		case 25:
+			n += NoInline25(i)
+		case 26:
+			n += NoInline26(i)
+		case 27:
+			n += NoInline27(i)
+		case 28:
+			n += NoInline28(i)
+		case 29:
+			n += NoInline29(i)
+		case 30:
+			n += NoInline30(i)
+		case 31:
+			n += NoInline31(i)
+		case 32:
+			n += NoInline32(i)
+		case 33:
+			n += NoInline33(i)
+		case 34:
+			n += NoInline34(i)
+		case 35:
+			n += NoInline35(i)
+		case 36:
+			n += NoInline36(i)
+		case 37:
+			n += NoInline37(i)
+		case 38:
+			n += NoInline38(i)
+		case 39:
+			n += NoInline39(i)
+		case 40:
+			n += NoInline40(i)
+		case 41:
+			n += NoInline41(i)
+		case 42:
+			n += NoInline42(i)
+		case 43:
+			n += NoInline43(i)
+		case 44:
+			n += NoInline44(i)
+		case 45:
+			n += NoInline45(i)
+		case 46:
+			n += NoInline46(i)
+		case 47:
+			n += NoInline47(i)
+		case 48:
+			n += NoInline48(i)
+		case 49:
+			n += NoInline49(i)
+		case 50:
+			n += NoInline50(i)
+		case 51:
+			n += NoInline51(i)
+		case 52:
+			n += NoInline52(i)
+		case 53:
+			n += NoInline53(i)
+		case 54:
+			n += NoInline54(i)
+		case 55:
+			n += NoInline55(i)
+		case 56:
+			n += NoInline56(i)
+		case 57:
+			n += NoInline57(i)
+		case 58:
+			n += NoInline58(i)
+		case 59:
+			n += NoInline59(i)
+		case 60:
+			n += NoInline60(i)
+		case 61:
+			n += NoInline61(i)
+		case 62:
+			n += NoInline62(i)
+		case 63:
+			n += NoInline63(i)
+		case 64:
+			n += NoInline64(i)
+		case 65:
+			n += NoInline65(i)
+		case 66:
+			n += NoInline66(i)
+		case 67:
+			n += NoInline67(i)
+		case 68:
+			n += NoInline68(i)
+		case 69:
+			n += NoInline69(i)
+		case 70:
+			n += NoInline70(i)
+		case 71:
+			n += NoInline71(i)
+		case 72:
+			n += NoInline72(i)
+		case 73:
+			n += NoInline73(i)
+		case 74:
+			n += NoInline74(i)
+		case 75:
+			n += NoInline75(i)
+		case 76:
+			n += NoInline76(i)
+		case 77:
+			n += NoInline77(i)
+		case 78:
+			n += NoInline78(i)
+		case 79:
+			n += NoInline79(i)
+		case 80:
+			n += NoInline80(i)
+		case 81:
+			n += NoInline81(i)
+		case 82:
+			n += NoInline82(i)
+		case 83:
+			n += NoInline83(i)
+		case 84:
+			n += NoInline84(i)
+		case 85:
+			n += NoInline85(i)
+		case 86:
+			n += NoInline86(i)
+		case 87:
+			n += NoInline87(i)
+		case 88:
+			n += NoInline88(i)
+		case 89:
+			n += NoInline89(i)
+		case 90:
+			n += NoInline90(i)
+		case 91:
+			n += NoInline91(i)
+		case 92:
+			n += NoInline92(i)
+		case 93:
+			n += NoInline93(i)
+		case 94:
+			n += NoInline94(i)
+		case 95:
+			n += NoInline95(i)
+		case 96:
+			n += NoInline96(i)
+		case 97:
+			n += NoInline97(i)
+		case 98:
+			n += NoInline98(i)
+		case 99:
+			n += NoInline99(i)
+		case 100:
+			n += NoInline100(i)
+		case 101:
+			n += NoInline101(i)
+		case 102:
+			n += NoInline102(i)
+		case 103:
+			n += NoInline103(i)
+		case 104:
+			n += NoInline104(i)
+		case 105:
+			n += NoInline105(i)
+		case 106:
+			n += NoInline106(i)
+		case 107:
+			n += NoInline107(i)
+		case 108:
+			n += NoInline108(i)
+		case 109:
+			n += NoInline109(i)
+		case 110:
+			n += NoInline110(i)
+		case 111:
+			n += NoInline111(i)
+		case 112:
+			n += NoInline112(i)
+		case 113:
+			n += NoInline113(i)
+		case 114:
+			n += NoInline114(i)
+		case 115:
+			n += NoInline115(i)
+		case 116:
+			n += NoInline116(i)
+		case 117:
+			n += NoInline117(i)
+		case 118:
+			n += NoInline118(i)
+		case 119:
+			n += NoInline119(i)
+		case 120:
+			n += NoInline120(i)
+		case 121:
+			n += NoInline121(i)
+		case 122:
+			n += NoInline122(i)
+		case 123:
+			n += NoInline123(i)
+		case 124:
+			n += NoInline124(i)
+		case 125:
+			n += NoInline125(i)
+		case 126:
+			n += NoInline126(i)
+		case 127:
+			n += NoInline127(i)
+		case 128:
+			n += NoInline128(i)
+		case 129:
+			n += NoInline129(i)
+		case 130:
+			n += NoInline130(i)
+		case 131:
+			n += NoInline131(i)
+		case 132:
+			n += NoInline132(i)
+		case 133:
+			n += NoInline133(i)
+		case 134:
+			n += NoInline134(i)
+		case 135:
+			n += NoInline135(i)
+		case 136:
+			n += NoInline136(i)
+		case 137:
+			n += NoInline137(i)
+		case 138:
+			n += NoInline138(i)
+		case 139:
+			n += NoInline139(i)
+		case 140:
+			n += NoInline140(i)
+		case 141:
+			n += NoInline141(i)
+		case 142:
+			n += NoInline142(i)
+		case 143:
+			n += NoInline143(i)
+		case 144:
+			n += NoInline144(i)
+		case 145:
+			n += NoInline145(i)
+		case 146:
+			n += NoInline146(i)
+		case 147:
+			n += NoInline147(i)
+		case 148:
+			n += NoInline148(i)
+		case 149:
+			n += NoInline149(i)
+		case 150:
+			n += NoInline150(i)
+		case 151:
+			n += NoInline151(i)
+		case 152:
+			n += NoInline152(i)
+		case 153:
+			n += NoInline153(i)
+		case 154:
+			n += NoInline154(i)
+		case 155:
+			n += NoInline155(i)
+		case 156:
+			n += NoInline156(i)
+		case 157:
+			n += NoInline157(i)
+		case 158:
+			n += NoInline158(i)
+		case 159:
+			n += NoInline159(i)
+		case 160:
+			n += NoInline160(i)
+		case 161:
+			n += NoInline161(i)
+		case 162:
+			n += NoInline162(i)
+		case 163:
+			n += NoInline163(i)
+		case 164:
+			n += NoInline164(i)
+		case 165:
+			n += NoInline165(i)
+		case 166:
+			n += NoInline166(i)
+		case 167:
+			n += NoInline167(i)
+		case 168:
+			n += NoInline168(i)
+		case 169:
+			n += NoInline169(i)
+		case 170:
+			n += NoInline170(i)
+		case 171:
+			n += NoInline171(i)
+		case 172:
+			n += NoInline172(i)
+		case 173:
+			n += NoInline173(i)
+		case 174:
+			n += NoInline174(i)
+		case 175:
+			n += NoInline175(i)
+		case 176:
+			n += NoInline176(i)
+		case 177:
+			n += NoInline177(i)
+		case 178:
+			n += NoInline178(i)
+		case 179:
+			n += NoInline179(i)
+		case 180:
+			n += NoInline180(i)
+		case 181:
+			n += NoInline181(i)
+		case 182:
+			n += NoInline182(i)
+		case 183:
+			n += NoInline183(i)
+		case 184:
+			n += NoInline184(i)
+		case 185:
+			n += NoInline185(i)
+		case 186:
+			n += NoInline186(i)
+		case 187:
+			n += NoInline187(i)
+		case 188:
+			n += NoInline188(i)
+		case 189:
+			n += NoInline189(i)
+		case 190:
+			n += NoInline190(i)
+		case 191:
+			n += NoInline191(i)
+		case 192:
+			n += NoInline192(i)
+		case 193:
+			n += NoInline193(i)
+		case 194:
+			n += NoInline194(i)
+		case 195:
+			n += NoInline195(i)
+		case 196:
+			n += NoInline196(i)
+		case 197:
+			n += NoInline197(i)
+		case 198:
+			n += NoInline198(i)
+		case 199:
+			n += NoInline199(i)
+		case 200:
+			n += NoInline200(i)
+		case 201:
+			n += NoInline201(i)
+		case 202:
+			n += NoInline202(i)
+		case 203:
+			n += NoInline203(i)
+		case 204:
+			n += NoInline204(i)
+		case 205:
+			n += NoInline205(i)
+		case 206:
+			n += NoInline206(i)
+		case 207:
+			n += NoInline207(i)
+		case 208:
+			n += NoInline208(i)
+		case 209:
+			n += NoInline209(i)
+		case 210:
+			n += NoInline210(i)
+		case 211:
+			n += NoInline211(i)
+		case 212:
+			n += NoInline212(i)
+		case 213:
+			n += NoInline213(i)
+		case 214:
+			n += NoInline214(i)
+		case 215:
+			n += NoInline215(i)
+		case 216:
+			n += NoInline216(i)
+		case 217:
+			n += NoInline217(i)
+		case 218:
+			n += NoInline218(i)
+		case 219:
+			n += NoInline219(i)
+		case 220:
+			n += NoInline220(i)
+		case 221:
+			n += NoInline221(i)
+		case 222:
+			n += NoInline222(i)
+		case 223:
+			n += NoInline223(i)
+		case 224:
+			n += NoInline224(i)
+		case 225:
+			n += NoInline225(i)
+		case 226:
+			n += NoInline226(i)
+		case 227:
+			n += NoInline227(i)
+		case 228:
+			n += NoInline228(i)
+		case 229:
+			n += NoInline229(i)
+		case 230:
+			n += NoInline230(i)
+		case 231:
+			n += NoInline231(i)
+		case 232:
+			n += NoInline232(i)
+		case 233:
+			n += NoInline233(i)
+		case 234:
+			n += NoInline234(i)
+		case 235:
+			n += NoInline235(i)
+		case 236:
+			n += NoInline236(i)
+		case 237:
+			n += NoInline237(i)
+		case 238:
+			n += NoInline238(i)
+		case 239:
+			n += NoInline239(i)
+		case 240:
+			n += NoInline240(i)
+		case 241:
+			n += NoInline241(i)
+		case 242:
+			n += NoInline242(i)
+		case 243:
+			n += NoInline243(i)
+		case 244:
+			n += NoInline244(i)
+		case 245:
+			n += NoInline245(i)
+		case 246:
+			n += NoInline246(i)
+		case 247:
+			n += NoInline247(i)
+		case 248:
+			n += NoInline248(i)
+		case 249:
+			n += NoInline249(i)
+		case 250:
+			n += NoInline250(i)
+		case 251:
+			n += NoInline251(i)
+		case 252:
+			n += NoInline252(i)
+		case 253:
+			n += NoInline253(i)
+		case 254:
+			n += NoInline254(i)
+		case 255:
+			n += NoInline255(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchNoInlineDenseLookupMod256(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysDense[inputs[i%len(inputs)]] {
+		case 0:
+			n += NoInline0(i)
+		case 1:
+			n += NoInline1(i)
+		case 2:
+			n += NoInline2(i)
+		case 3:
+			n += NoInline3(i)
+		case 4:
+			n += NoInline4(i)
+		case 5:
+			n += NoInline5(i)
+		case 6:
+			n += NoInline6(i)
+		case 7:
+			n += NoInline7(i)
+		case 8:
+			n += NoInline8(i)
+		case 9:
+			n += NoInline9(i)
+		case 10:
+			n += NoInline10(i)
+		case 11:
+			n += NoInline11(i)
+		case 12:
+			n += NoInline12(i)
+		case 13:
+			n += NoInline13(i)
+		case 14:
+			n += NoInline14(i)
+		case 15:
+			n += NoInline15(i)
+		case 16:
+			n += NoInline16(i)
+		case 17:
+			n += NoInline17(i)
+		case 18:
+			n += NoInline18(i)
+		case 19:
+			n += NoInline19(i)
+		case 20:
+			n += NoInline20(i)
+		case 21:
+			n += NoInline21(i)
+		case 22:
+			n += NoInline22(i)
+		case 23:
+			n += NoInline23(i)
+		case 24:
+			n += NoInline24(i)
+		case 25:
+			n += NoInline25(i)
+		case 26:
+			n += NoInline26(i)
+		case 27:
+			n += NoInline27(i)
+		case 28:
+			n += NoInline28(i)
+		case 29:
+			n += NoInline29(i)
+		case 30:
+			n += NoInline30(i)
+		case 31:
+			n += NoInline31(i)
+		case 32:
+			n += NoInline32(i)
+		case 33:
+			n += NoInline33(i)
+		case 34:
+			n += NoInline34(i)
+		case 35:
+			n += NoInline35(i)
+		case 36:
+			n += NoInline36(i)
+		case 37:
+			n += NoInline37(i)
+		case 38:
+			n += NoInline38(i)
+		case 39:
+			n += NoInline39(i)
+		case 40:
+			n += NoInline40(i)
+		case 41:
+			n += NoInline41(i)
+		case 42:
+			n += NoInline42(i)
+		case 43:
+			n += NoInline43(i)
+		case 44:
+			n += NoInline44(i)
+		case 45:
+			n += NoInline45(i)
+		case 46:
+			n += NoInline46(i)
+		case 47:
+			n += NoInline47(i)
+		case 48:
+			n += NoInline48(i)
+		case 49:
+			n += NoInline49(i)
+		case 50:
+			n += NoInline50(i)
+		case 51:
+			n += NoInline51(i)
+		case 52:
+			n += NoInline52(i)
+		case 53:
+			n += NoInline53(i)
+		case 54:
+			n += NoInline54(i)
+		case 55:
+			n += NoInline55(i)
+		case 56:
+			n += NoInline56(i)
+		case 57:
+			n += NoInline57(i)
+		case 58:
+			n += NoInline58(i)
+		case 59:
+			n += NoInline59(i)
+		case 60:
+			n += NoInline60(i)
+		case 61:
+			n += NoInline61(i)
+		case 62:
+			n += NoInline62(i)
+		case 63:
+			n += NoInline63(i)
+		case 64:
+			n += NoInline64(i)
+		case 65:
+			n += NoInline65(i)
+		case 66:
+			n += NoInline66(i)
+		case 67:
+			n += NoInline67(i)
+		case 68:
+			n += NoInline68(i)
+		case 69:
+			n += NoInline69(i)
+		case 70:
+			n += NoInline70(i)
+		case 71:
+			n += NoInline71(i)
+		case 72:
+			n += NoInline72(i)
+		case 73:
+			n += NoInline73(i)
+		case 74:
+			n += NoInline74(i)
+		case 75:
+			n += NoInline75(i)
+		case 76:
+			n += NoInline76(i)
+		case 77:
+			n += NoInline77(i)
+		case 78:
+			n += NoInline78(i)
+		case 79:
+			n += NoInline79(i)
+		case 80:
+			n += NoInline80(i)
+		case 81:
+			n += NoInline81(i)
+		case 82:
+			n += NoInline82(i)
+		case 83:
+			n += NoInline83(i)
+		case 84:
+			n += NoInline84(i)
+		case 85:
+			n += NoInline85(i)
+		case 86:
+			n += NoInline86(i)
+		case 87:
+			n += NoInline87(i)
+		case 88:
+			n += NoInline88(i)
+		case 89:
+			n += NoInline89(i)
+		case 90:
+			n += NoInline90(i)
+		case 91:
+			n += NoInline91(i)
+		case 92:
+			n += NoInline92(i)
+		case 93:
+			n += NoInline93(i)
+		case 94:
+			n += NoInline94(i)
+		case 95:
+			n += NoInline95(i)
+		case 96:
+			n += NoInline96(i)
+		case 97:
+			n += NoInline97(i)
+		case 98:
+			n += NoInline98(i)
+		case 99:
+			n += NoInline99(i)
+		case 100:
+			n += NoInline100(i)
+		case 101:
+			n += NoInline101(i)
+		case 102:
+			n += NoInline102(i)
+		case 103:
+			n += NoInline103(i)
+		case 104:
+			n += NoInline104(i)
+		case 105:
+			n += NoInline105(i)
+		case 106:
+			n += NoInline106(i)
+		case 107:
+			n += NoInline107(i)
+		case 108:
+			n += NoInline108(i)
+		case 109:
+			n += NoInline109(i)
+		case 110:
+			n += NoInline110(i)
+		case 111:
+			n += NoInline111(i)
+		case 112:
+			n += NoInline112(i)
+		case 113:
+			n += NoInline113(i)
+		case 114:
+			n += NoInline114(i)
+		case 115:
+			n += NoInline115(i)
+		case 116:
+			n += NoInline116(i)
+		case 117:
+			n += NoInline117(i)
+		case 118:
+			n += NoInline118(i)
+		case 119:
+			n += NoInline119(i)
+		case 120:
+			n += NoInline120(i)
+		case 121:
+			n += NoInline121(i)
+		case 122:
+			n += NoInline122(i)
+		case 123:
+			n += NoInline123(i)
+		case 124:
+			n += NoInline124(i)
+		case 125:
+			n += NoInline125(i)
+		case 126:
+			n += NoInline126(i)
+		case 127:
+			n += NoInline127(i)
+		case 128:
+			n += NoInline128(i)
+		case 129:
+			n += NoInline129(i)
+		case 130:
+			n += NoInline130(i)
+		case 131:
+			n += NoInline131(i)
+		case 132:
+			n += NoInline132(i)
+		case 133:
+			n += NoInline133(i)
+		case 134:
+			n += NoInline134(i)
+		case 135:
+			n += NoInline135(i)
+		case 136:
+			n += NoInline136(i)
+		case 137:
+			n += NoInline137(i)
+		case 138:
+			n += NoInline138(i)
+		case 139:
+			n += NoInline139(i)
+		case 140:
+			n += NoInline140(i)
+		case 141:
+			n += NoInline141(i)
+		case 142:
+			n += NoInline142(i)
+		case 143:
+			n += NoInline143(i)
+		case 144:
+			n += NoInline144(i)
+		case 145:
+			n += NoInline145(i)
+		case 146:
+			n += NoInline146(i)
+		case 147:
+			n += NoInline147(i)
+		case 148:
+			n += NoInline148(i)
+		case 149:
+			n += NoInline149(i)
+		case 150:
+			n += NoInline150(i)
+		case 151:
+			n += NoInline151(i)
+		case 152:
+			n += NoInline152(i)
+		case 153:
+			n += NoInline153(i)
+		case 154:
+			n += NoInline154(i)
+		case 155:
+			n += NoInline155(i)
+		case 156:
+			n += NoInline156(i)
+		case 157:
+			n += NoInline157(i)
+		case 158:
+			n += NoInline158(i)
+		case 159:
+			n += NoInline159(i)
+		case 160:
+			n += NoInline160(i)
+		case 161:
+			n += NoInline161(i)
+		case 162:
+			n += NoInline162(i)
+		case 163:
+			n += NoInline163(i)
+		case 164:
+			n += NoInline164(i)
+		case 165:
+			n += NoInline165(i)
+		case 166:
+			n += NoInline166(i)
+		case 167:
+			n += NoInline167(i)
+		case 168:
+			n += NoInline168(i)
+		case 169:
+			n += NoInline169(i)
+		case 170:
+			n += NoInline170(i)
+		case 171:
+			n += NoInline171(i)
+		case 172:
+			n += NoInline172(i)
+		case 173:
+			n += NoInline173(i)
+		case 174:
+			n += NoInline174(i)
+		case 175:
+			n += NoInline175(i)
+		case 176:
+			n += NoInline176(i)
+		case 177:
+			n += NoInline177(i)
+		case 178:
+			n += NoInline178(i)
+		case 179:
+			n += NoInline179(i)
+		case 180:
+			n += NoInline180(i)
+		case 181:
+			n += NoInline181(i)
+		case 182:
+			n += NoInline182(i)
+		case 183:
+			n += NoInline183(i)
+		case 184:
+			n += NoInline184(i)
+		case 185:
+			n += NoInline185(i)
+		case 186:
+			n += NoInline186(i)
+		case 187:
+			n += NoInline187(i)
+		case 188:
+			n += NoInline188(i)
+		case 189:
+			n += NoInline189(i)
+		case 190:
+			n += NoInline190(i)
+		case 191:
+			n += NoInline191(i)
+		case 192:
+			n += NoInline192(i)
+		case 193:
+			n += NoInline193(i)
+		case 194:
+			n += NoInline194(i)
+		case 195:
+			n += NoInline195(i)
+		case 196:
+			n += NoInline196(i)
+		case 197:
+			n += NoInline197(i)
+		case 198:
+			n += NoInline198(i)
+		case 199:
+			n += NoInline199(i)
+		case 200:
+			n += NoInline200(i)
+		case 201:
+			n += NoInline201(i)
+		case 202:
+			n += NoInline202(i)
+		case 203:
+			n += NoInline203(i)
+		case 204:
+			n += NoInline204(i)
+		case 205:
+			n += NoInline205(i)
+		case 206:
+			n += NoInline206(i)
+		case 207:
+			n += NoInline207(i)
+		case 208:
+			n += NoInline208(i)
+		case 209:
+			n += NoInline209(i)
+		case 210:
+			n += NoInline210(i)
+		case 211:
+			n += NoInline211(i)
+		case 212:
+			n += NoInline212(i)
+		case 213:
+			n += NoInline213(i)
+		case 214:
+			n += NoInline214(i)
+		case 215:
+			n += NoInline215(i)
+		case 216:
+			n += NoInline216(i)
+		case 217:
+			n += NoInline217(i)
+		case 218:
+			n += NoInline218(i)
+		case 219:
+			n += NoInline219(i)
+		case 220:
+			n += NoInline220(i)
+		case 221:
+			n += NoInline221(i)
+		case 222:
+			n += NoInline222(i)
+		case 223:
+			n += NoInline223(i)
+		case 224:
+			n += NoInline224(i)
+		case 225:
+			n += NoInline225(i)
+		case 226:
+			n += NoInline226(i)
+		case 227:
+			n += NoInline227(i)
+		case 228:
+			n += NoInline228(i)
+		case 229:
+			n += NoInline229(i)
+		case 230:
+			n += NoInline230(i)
+		case 231:
+			n += NoInline231(i)
+		case 232:
+			n += NoInline232(i)
+		case 233:
+			n += NoInline233(i)
+		case 234:
+			n += NoInline234(i)
+		case 235:
+			n += NoInline235(i)
+		case 236:
+			n += NoInline236(i)
+		case 237:
+			n += NoInline237(i)
+		case 238:
+			n += NoInline238(i)
+		case 239:
+			n += NoInline239(i)
+		case 240:
+			n += NoInline240(i)
+		case 241:
+			n += NoInline241(i)
+		case 242:
+			n += NoInline242(i)
+		case 243:
+			n += NoInline243(i)
+		case 244:
+			n += NoInline244(i)
+		case 245:
+			n += NoInline245(i)
+		case 246:
+			n += NoInline246(i)
+		case 247:
+			n += NoInline247(i)
+		case 248:
+			n += NoInline248(i)
+		case 249:
+			n += NoInline249(i)
+		case 250:
+			n += NoInline250(i)
+		case 251:
+			n += NoInline251(i)
+		case 252:
+			n += NoInline252(i)
+		case 253:
+			n += NoInline253(i)
+		case 254:
+			n += NoInline254(i)
+		case 255:
+			n += NoInline255(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchNoInlineDenseComputed512(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysDense[i%512] {
+		case 0:
+			n += NoInline0(i)
+		case 1:
+			n += NoInline1(i)
+		case 2:
+			n += NoInline2(i)
+		case 3:
+			n += NoInline3(i)
+		case 4:
+			n += NoInline4(i)
+		case 5:
+			n += NoInline5(i)
+		case 6:
+			n += NoInline6(i)
+		case 7:
+			n += NoInline7(i)
+		case 8:
+			n += NoInline8(i)
+		case 9:
+			n += NoInline9(i)
+		case 10:
+			n += NoInline10(i)
+		case 11:
+			n += NoInline11(i)
+		case 12:
+			n += NoInline12(i)
+		case 13:
+			n += NoInline13(i)
+		case 14:
+			n += NoInline14(i)
+		case 15:
+			n += NoInline15(i)
+		case 16:
+			n += NoInline16(i)
+		case 17:
+			n += NoInline17(i)
+		case 18:
+			n += NoInline18(i)
+		case 19:
+			n += NoInline19(i)
+		case 20:
+			n += NoInline20(i)
+		case 21:
+			n += NoInline21(i)
+		case 22:
+			n += NoInline22(i)
+		case 23:
+			n += NoInline23(i)
+		case 24:
+			n += NoInline24(i)
+		case 25:
+			n += NoInline25(i)
+		case 26:
+			n += NoInline26(i)
+		case 27:
+			n += NoInline27(i)
+		case 28:
+			n += NoInline28(i)
+		case 29:
+			n += NoInline29(i)
+		case 30:
+			n += NoInline30(i)
+		case 31:
+			n += NoInline31(i)
+		case 32:
+			n += NoInline32(i)
+		case 33:
+			n += NoInline33(i)
+		case 34:
+			n += NoInline34(i)
+		case 35:
+			n += NoInline35(i)
+		case 36:
+			n += NoInline36(i)
+		case 37:
+			n += NoInline37(i)
+		case 38:
+			n += NoInline38(i)
+		case 39:
+			n += NoInline39(i)
+		case 40:
+			n += NoInline40(i)
+		case 41:
+			n += NoInline41(i)
+		case 42:
+			n += NoInline42(i)
+		case 43:
+			n += NoInline43(i)
+		case 44:
+			n += NoInline44(i)
+		case 45:
+			n += NoInline45(i)
+		case 46:
+			n += NoInline46(i)
+		case 47:
+			n += NoInline47(i)
+		case 48:
+			n += NoInline48(i)
+		case 49:
+			n += NoInline49(i)
+		case 50:
+			n += NoInline50(i)
+		case 51:
+			n += NoInline51(i)
+		case 52:
+			n += NoInline52(i)
+		case 53:
+			n += NoInline53(i)
+		case 54:
+			n += NoInline54(i)
+		case 55:
+			n += NoInline55(i)
+		case 56:
+			n += NoInline56(i)
+		case 57:
+			n += NoInline57(i)
+		case 58:
+			n += NoInline58(i)
+		case 59:
+			n += NoInline59(i)
+		case 60:
+			n += NoInline60(i)
+		case 61:
+			n += NoInline61(i)
+		case 62:
+			n += NoInline62(i)
+		case 63:
+			n += NoInline63(i)
+		case 64:
+			n += NoInline64(i)
+		case 65:
+			n += NoInline65(i)
+		case 66:
+			n += NoInline66(i)
+		case 67:
+			n += NoInline67(i)
+		case 68:
+			n += NoInline68(i)
+		case 69:
+			n += NoInline69(i)
+		case 70:
+			n += NoInline70(i)
+		case 71:
+			n += NoInline71(i)
+		case 72:
+			n += NoInline72(i)
+		case 73:
+			n += NoInline73(i)
+		case 74:
+			n += NoInline74(i)
+		case 75:
+			n += NoInline75(i)
+		case 76:
+			n += NoInline76(i)
+		case 77:
+			n += NoInline77(i)
+		case 78:
+			n += NoInline78(i)
+		case 79:
+			n += NoInline79(i)
+		case 80:
+			n += NoInline80(i)
+		case 81:
+			n += NoInline81(i)
+		case 82:
+			n += NoInline82(i)
+		case 83:
+			n += NoInline83(i)
+		case 84:
+			n += NoInline84(i)
+		case 85:
+			n += NoInline85(i)
+		case 86:
+			n += NoInline86(i)
+		case 87:
+			n += NoInline87(i)
+		case 88:
+			n += NoInline88(i)
+		case 89:
+			n += NoInline89(i)
+		case 90:
+			n += NoInline90(i)
+		case 91:
+			n += NoInline91(i)
+		case 92:
+			n += NoInline92(i)
+		case 93:
+			n += NoInline93(i)
+		case 94:
+			n += NoInline94(i)
+		case 95:
+			n += NoInline95(i)
+		case 96:
+			n += NoInline96(i)
+		case 97:
+			n += NoInline97(i)
+		case 98:
+			n += NoInline98(i)
+		case 99:
+			n += NoInline99(i)
+		case 100:
+			n += NoInline100(i)
+		case 101:
+			n += NoInline101(i)
+		case 102:
+			n += NoInline102(i)
+		case 103:
+			n += NoInline103(i)
+		case 104:
+			n += NoInline104(i)
+		case 105:
+			n += NoInline105(i)
+		case 106:
+			n += NoInline106(i)
+		case 107:
+			n += NoInline107(i)
+		case 108:
+			n += NoInline108(i)
+		case 109:
+			n += NoInline109(i)
+		case 110:
+			n += NoInline110(i)
+		case 111:
+			n += NoInline111(i)
+		case 112:
+			n += NoInline112(i)
+		case 113:
+			n += NoInline113(i)
+		case 114:
+			n += NoInline114(i)
+		case 115:
+			n += NoInline115(i)
+		case 116:
+			n += NoInline116(i)
+		case 117:
+			n += NoInline117(i)
+		case 118:
+			n += NoInline118(i)
+		case 119:
+			n += NoInline119(i)
+		case 120:
+			n += NoInline120(i)
+		case 121:
+			n += NoInline121(i)
+		case 122:
+			n += NoInline122(i)
+		case 123:
+			n += NoInline123(i)
+		case 124:
+			n += NoInline124(i)
+		case 125:
+			n += NoInline125(i)
+		case 126:
+			n += NoInline126(i)
+		case 127:
+			n += NoInline127(i)
+		case 128:
+			n += NoInline128(i)
+		case 129:
+			n += NoInline129(i)
+		case 130:
+			n += NoInline130(i)
+		case 131:
+			n += NoInline131(i)
+		case 132:
+			n += NoInline132(i)
+		case 133:
+			n += NoInline133(i)
+		case 134:
+			n += NoInline134(i)
+		case 135:
+			n += NoInline135(i)
+		case 136:
+			n += NoInline136(i)
+		case 137:
+			n += NoInline137(i)
+		case 138:
+			n += NoInline138(i)
+		case 139:
+			n += NoInline139(i)
+		case 140:
+			n += NoInline140(i)
+		case 141:
+			n += NoInline141(i)
+		case 142:
+			n += NoInline142(i)
+		case 143:
+			n += NoInline143(i)
+		case 144:
+			n += NoInline144(i)
+		case 145:
+			n += NoInline145(i)
+		case 146:
+			n += NoInline146(i)
+		case 147:
+			n += NoInline147(i)
+		case 148:
+			n += NoInline148(i)
+		case 149:
+			n += NoInline149(i)
+		case 150:
+			n += NoInline150(i)
+		case 151:
+			n += NoInline151(i)
+		case 152:
+			n += NoInline152(i)
+		case 153:
+			n += NoInline153(i)
+		case 154:
+			n += NoInline154(i)
+		case 155:
+			n += NoInline155(i)
+		case 156:
+			n += NoInline156(i)
+		case 157:
+			n += NoInline157(i)
+		case 158:
+			n += NoInline158(i)
+		case 159:
+			n += NoInline159(i)
+		case 160:
+			n += NoInline160(i)
+		case 161:
+			n += NoInline161(i)
+		case 162:
+			n += NoInline162(i)
+		case 163:
+			n += NoInline163(i)
+		case 164:
+			n += NoInline164(i)
+		case 165:
+			n += NoInline165(i)
+		case 166:
+			n += NoInline166(i)
+		case 167:
+			n += NoInline167(i)
+		case 168:
+			n += NoInline168(i)
+		case 169:
+			n += NoInline169(i)
+		case 170:
+			n += NoInline170(i)
+		case 171:
+			n += NoInline171(i)
+		case 172:
+			n += NoInline172(i)
+		case 173:
+			n += NoInline173(i)
+		case 174:
+			n += NoInline174(i)
+		case 175:
+			n += NoInline175(i)
+		case 176:
+			n += NoInline176(i)
+		case 177:
+			n += NoInline177(i)
+		case 178:
+			n += NoInline178(i)
+		case 179:
+			n += NoInline179(i)
+		case 180:
+			n += NoInline180(i)
+		case 181:
+			n += NoInline181(i)
+		case 182:
+			n += NoInline182(i)
+		case 183:
+			n += NoInline183(i)
+		case 184:
+			n += NoInline184(i)
+		case 185:
+			n += NoInline185(i)
+		case 186:
+			n += NoInline186(i)
+		case 187:
+			n += NoInline187(i)
+		case 188:
+			n += NoInline188(i)
+		case 189:
+			n += NoInline189(i)
+		case 190:
+			n += NoInline190(i)
+		case 191:
+			n += NoInline191(i)
+		case 192:
+			n += NoInline192(i)
+		case 193:
+			n += NoInline193(i)
+		case 194:
+			n += NoInline194(i)
+		case 195:
+			n += NoInline195(i)
+		case 196:
+			n += NoInline196(i)
+		case 197:
+			n += NoInline197(i)
+		case 198:
+			n += NoInline198(i)
+		case 199:
+			n += NoInline199(i)
+		case 200:
+			n += NoInline200(i)
+		case 201:
+			n += NoInline201(i)
+		case 202:
+			n += NoInline202(i)
+		case 203:
+			n += NoInline203(i)
+		case 204:
+			n += NoInline204(i)
+		case 205:
+			n += NoInline205(i)
+		case 206:
+			n += NoInline206(i)
+		case 207:
+			n += NoInline207(i)
+		case 208:
+			n += NoInline208(i)
+		case 209:
+			n += NoInline209(i)
+		case 210:
+			n += NoInline210(i)
+		case 211:
+			n += NoInline211(i)
+		case 212:
+			n += NoInline212(i)
+		case 213:
+			n += NoInline213(i)
+		case 214:
+			n += NoInline214(i)
+		case 215:
+			n += NoInline215(i)
+		case 216:
+			n += NoInline216(i)
+		case 217:
+			n += NoInline217(i)
+		case 218:
+			n += NoInline218(i)
+		case 219:
+			n += NoInline219(i)
+		case 220:
+			n += NoInline220(i)
+		case 221:
+			n += NoInline221(i)
+		case 222:
+			n += NoInline222(i)
+		case 223:
+			n += NoInline223(i)
+		case 224:
+			n += NoInline224(i)
+		case 225:
+			n += NoInline225(i)
+		case 226:
+			n += NoInline226(i)
+		case 227:
+			n += NoInline227(i)
+		case 228:
+			n += NoInline228(i)
+		case 229:
+			n += NoInline229(i)
+		case 230:
+			n += NoInline230(i)
+		case 231:
+			n += NoInline231(i)
+		case 232:
+			n += NoInline232(i)
+		case 233:
+			n += NoInline233(i)
+		case 234:
+			n += NoInline234(i)
+		case 235:
+			n += NoInline235(i)
+		case 236:
+			n += NoInline236(i)
+		case 237:
+			n += NoInline237(i)
+		case 238:
+			n += NoInline238(i)
+		case 239:
+			n += NoInline239(i)
+		case 240:
+			n += NoInline240(i)
+		case 241:
+			n += NoInline241(i)
+		case 242:
+			n += NoInline242(i)
+		case 243:
+			n += NoInline243(i)
+		case 244:
+			n += NoInline244(i)
+		case 245:
+			n += NoInline245(i)
+		case 246:
+			n += NoInline246(i)
+		case 247:
+			n += NoInline247(i)
+		case 248:
+			n += NoInline248(i)
+		case 249:
+			n += NoInline249(i)
+		case 250:
+			n += NoInline250(i)
+		case 251:
+			n += NoInline251(i)
+		case 252:
+			n += NoInline252(i)
+		case 253:
+			n += NoInline253(i)
+		case 254:
+			n += NoInline254(i)
+		case 255:
+			n += NoInline255(i)
+		case 256:
+			n += NoInline256(i)
+		case 257:
+			n += NoInline257(i)
+		case 258:
+			n += NoInline258(i)
+		case 259:
+			n += NoInline259(i)
+		case 260:
+			n += NoInline260(i)
+		case 261:
+			n += NoInline261(i)
+		case 262:
+			n += NoInline262(i)
+		case 263:
+			n += NoInline263(i)
+		case 264:
+			n += NoInline264(i)
+		case 265:
+			n += NoInline265(i)
+		case 266:
+			n += NoInline266(i)
+		case 267:
+			n += NoInline267(i)
+		case 268:
+			n += NoInline268(i)
+		case 269:
+			n += NoInline269(i)
+		case 270:
+			n += NoInline270(i)
+		case 271:
+			n += NoInline271(i)
+		case 272:
+			n += NoInline272(i)
+		case 273:
+			n += NoInline273(i)
+		case 274:
+			n += NoInline274(i)
+		case 275:
+			n += NoInline275(i)
+		case 276:
+			n += NoInline276(i)
+		case 277:
+			n += NoInline277(i)
+		case 278:
+			n += NoInline278(i)
+		case 279:
+			n += NoInline279(i)
+		case 280:
+			n += NoInline280(i)
+		case 281:
+			n += NoInline281(i)
+		case 282:
+			n += NoInline282(i)
+		case 283:
+			n += NoInline283(i)
+		case 284:
+			n += NoInline284(i)
+		case 285:
+			n += NoInline285(i)
+		case 286:
+			n += NoInline286(i)
+		case 287:
+			n += NoInline287(i)
+		case 288:
+			n += NoInline288(i)
+		case 289:
+			n += NoInline289(i)
+		case 290:
+			n += NoInline290(i)
+		case 291:
+			n += NoInline291(i)
+		case 292:
+			n += NoInline292(i)
+		case 293:
+			n += NoInline293(i)
+		case 294:
+			n += NoInline294(i)
+		case 295:
+			n += NoInline295(i)
+		case 296:
+			n += NoInline296(i)
+		case 297:
+			n += NoInline297(i)
+		case 298:
+			n += NoInline298(i)
+		case 299:
+			n += NoInline299(i)
+		case 300:
+			n += NoInline300(i)
+		case 301:
+			n += NoInline301(i)
+		case 302:
+			n += NoInline302(i)
+		case 303:
+			n += NoInline303(i)
+		case 304:
+			n += NoInline304(i)
+		case 305:
+			n += NoInline305(i)
+		case 306:
+			n += NoInline306(i)
+		case 307:
+			n += NoInline307(i)
+		case 308:
+			n += NoInline308(i)
+		case 309:
+			n += NoInline309(i)
+		case 310:
+			n += NoInline310(i)
+		case 311:
+			n += NoInline311(i)
+		case 312:
+			n += NoInline312(i)
+		case 313:
+			n += NoInline313(i)
+		case 314:
+			n += NoInline314(i)
+		case 315:
+			n += NoInline315(i)
+		case 316:
+			n += NoInline316(i)
+		case 317:
+			n += NoInline317(i)
+		case 318:
+			n += NoInline318(i)
+		case 319:
+			n += NoInline319(i)
+		case 320:
+			n += NoInline320(i)
+		case 321:
+			n += NoInline321(i)
+		case 322:
+			n += NoInline322(i)
+		case 323:
+			n += NoInline323(i)
+		case 324:
+			n += NoInline324(i)
+		case 325:
+			n += NoInline325(i)
+		case 326:
+			n += NoInline326(i)
+		case 327:
+			n += NoInline327(i)
+		case 328:
+			n += NoInline328(i)
+		case 329:
+			n += NoInline329(i)
+		case 330:
+			n += NoInline330(i)
+		case 331:
+			n += NoInline331(i)
+		case 332:
+			n += NoInline332(i)
+		case 333:
+			n += NoInline333(i)
+		case 334:
+			n += NoInline334(i)
+		case 335:
+			n += NoInline335(i)
+		case 336:
+			n += NoInline336(i)
+		case 337:
+			n += NoInline337(i)
+		case 338:
+			n += NoInline338(i)
+		case 339:
+			n += NoInline339(i)
+		case 340:
+			n += NoInline340(i)
+		case 341:
+			n += NoInline341(i)
+		case 342:
+			n += NoInline342(i)
+		case 343:
+			n += NoInline343(i)
+		case 344:
+			n += NoInline344(i)
+		case 345:
+			n += NoInline345(i)
+		case 346:
+			n += NoInline346(i)
+		case 347:
+			n += NoInline347(i)
+		case 348:
+			n += NoInline348(i)
+		case 349:
+			n += NoInline349(i)
+		case 350:
+			n += NoInline350(i)
+		case 351:
+			n += NoInline351(i)
+		case 352:
+			n += NoInline352(i)
+		case 353:
+			n += NoInline353(i)
+		case 354:
+			n += NoInline354(i)
+		case 355:
+			n += NoInline355(i)
+		case 356:
+			n += NoInline356(i)
+		case 357:
+			n += NoInline357(i)
+		case 358:
+			n += NoInline358(i)
+		case 359:
+			n += NoInline359(i)
+		case 360:
+			n += NoInline360(i)
+		case 361:
+			n += NoInline361(i)
+		case 362:
+			n += NoInline362(i)
+		case 363:
+			n += NoInline363(i)
+		case 364:
+			n += NoInline364(i)
+		case 365:
+			n += NoInline365(i)
+		case 366:
+			n += NoInline366(i)
+		case 367:
+			n += NoInline367(i)
+		case 368:
+			n += NoInline368(i)
+		case 369:
+			n += NoInline369(i)
+		case 370:
+			n += NoInline370(i)
+		case 371:
+			n += NoInline371(i)
+		case 372:
+			n += NoInline372(i)
+		case 373:
+			n += NoInline373(i)
+		case 374:
+			n += NoInline374(i)
+		case 375:
+			n += NoInline375(i)
+		case 376:
+			n += NoInline376(i)
+		case 377:
+			n += NoInline377(i)
+		case 378:
+			n += NoInline378(i)
+		case 379:
+			n += NoInline379(i)
+		case 380:
+			n += NoInline380(i)
+		case 381:
+			n += NoInline381(i)
+		case 382:
+			n += NoInline382(i)
+		case 383:
+			n += NoInline383(i)
+		case 384:
+			n += NoInline384(i)
+		case 385:
+			n += NoInline385(i)
+		case 386:
+			n += NoInline386(i)
+		case 387:
+			n += NoInline387(i)
+		case 388:
+			n += NoInline388(i)
+		case 389:
+			n += NoInline389(i)
+		case 390:
+			n += NoInline390(i)
+		case 391:
+			n += NoInline391(i)
+		case 392:
+			n += NoInline392(i)
+		case 393:
+			n += NoInline393(i)
+		case 394:
+			n += NoInline394(i)
+		case 395:
+			n += NoInline395(i)
+		case 396:
+			n += NoInline396(i)
+		case 397:
+			n += NoInline397(i)
+		case 398:
+			n += NoInline398(i)
+		case 399:
+			n += NoInline399(i)
+		case 400:
+			n += NoInline400(i)
+		case 401:
+			n += NoInline401(i)
+		case 402:
+			n += NoInline402(i)
+		case 403:
+			n += NoInline403(i)
+		case 404:
+			n += NoInline404(i)
+		case 405:
+			n += NoInline405(i)
+		case 406:
+			n += NoInline406(i)
+		case 407:
+			n += NoInline407(i)
+		case 408:
+			n += NoInline408(i)
+		case 409:
+			n += NoInline409(i)
+		case 410:
+			n += NoInline410(i)
+		case 411:
+			n += NoInline411(i)
+		case 412:
+			n += NoInline412(i)
+		case 413:
+			n += NoInline413(i)
+		case 414:
+			n += NoInline414(i)
+		case 415:
+			n += NoInline415(i)
+		case 416:
+			n += NoInline416(i)
+		case 417:
+			n += NoInline417(i)
+		case 418:
+			n += NoInline418(i)
+		case 419:
+			n += NoInline419(i)
+		case 420:
+			n += NoInline420(i)
+		case 421:
+			n += NoInline421(i)
+		case 422:
+			n += NoInline422(i)
+		case 423:
+			n += NoInline423(i)
+		case 424:
+			n += NoInline424(i)
+		case 425:
+			n += NoInline425(i)
+		case 426:
+			n += NoInline426(i)
+		case 427:
+			n += NoInline427(i)
+		case 428:
+			n += NoInline428(i)
+		case 429:
+			n += NoInline429(i)
+		case 430:
+			n += NoInline430(i)
+		case 431:
+			n += NoInline431(i)
+		case 432:
+			n += NoInline432(i)
+		case 433:
+			n += NoInline433(i)
+		case 434:
+			n += NoInline434(i)
+		case 435:
+			n += NoInline435(i)
+		case 436:
+			n += NoInline436(i)
+		case 437:
+			n += NoInline437(i)
+		case 438:
+			n += NoInline438(i)
+		case 439:
+			n += NoInline439(i)
+		case 440:
+			n += NoInline440(i)
+		case 441:
+			n += NoInline441(i)
+		case 442:
+			n += NoInline442(i)
+		case 443:
+			n += NoInline443(i)
+		case 444:
+			n += NoInline444(i)
+		case 445:
+			n += NoInline445(i)
+		case 446:
+			n += NoInline446(i)
+		case 447:
+			n += NoInline447(i)
+		case 448:
+			n += NoInline448(i)
+		case 449:
+			n += NoInline449(i)
+		case 450:
+			n += NoInline450(i)
+		case 451:
+			n += NoInline451(i)
+		case 452:
+			n += NoInline452(i)
+		case 453:
+			n += NoInline453(i)
+		case 454:
+			n += NoInline454(i)
+		case 455:
+			n += NoInline455(i)
+		case 456:
+			n += NoInline456(i)
+		case 457:
+			n += NoInline457(i)
+		case 458:
+			n += NoInline458(i)
+		case 459:
+			n += NoInline459(i)
+		case 460:
+			n += NoInline460(i)
+		case 461:
+			n += NoInline461(i)
+		case 462:
+			n += NoInline462(i)
+		case 463:
+			n += NoInline463(i)
+		case 464:
+			n += NoInline464(i)
+		case 465:
+			n += NoInline465(i)
+		case 466:
+			n += NoInline466(i)
+		case 467:
+			n += NoInline467(i)
+		case 468:
+			n += NoInline468(i)
+		case 469:
+			n += NoInline469(i)
+		case 470:
+			n += NoInline470(i)
+		case 471:
+			n += NoInline471(i)
+		case 472:
+			n += NoInline472(i)
+		case 473:
+			n += NoInline473(i)
+		case 474:
+			n += NoInline474(i)
+		case 475:
+			n += NoInline475(i)
+		case 476:
+			n += NoInline476(i)
+		case 477:
+			n += NoInline477(i)
+		case 478:
+			n += NoInline478(i)
+		case 479:
+			n += NoInline479(i)
+		case 480:
+			n += NoInline480(i)
+		case 481:
+			n += NoInline481(i)
+		case 482:
+			n += NoInline482(i)
+		case 483:
+			n += NoInline483(i)
+		case 484:
+			n += NoInline484(i)
+		case 485:
+			n += NoInline485(i)
+		case 486:
+			n += NoInline486(i)
+		case 487:
+			n += NoInline487(i)
+		case 488:
+			n += NoInline488(i)
+		case 489:
+			n += NoInline489(i)
+		case 490:
+			n += NoInline490(i)
+		case 491:
+			n += NoInline491(i)
+		case 492:
+			n += NoInline492(i)
+		case 493:
+			n += NoInline493(i)
+		case 494:
+			n += NoInline494(i)
+		case 495:
+			n += NoInline495(i)
+		case 496:
+			n += NoInline496(i)
+		case 497:
+			n += NoInline497(i)
+		case 498:
+			n += NoInline498(i)
+		case 499:
+			n += NoInline499(i)
+		case 500:
+			n += NoInline500(i)
+		case 501:
+			n += NoInline501(i)
+		case 502:
+			n += NoInline502(i)
+		case 503:
+			n += NoInline503(i)
+		case 504:
+			n += NoInline504(i)
+		case 505:
+			n += NoInline505(i)
+		case 506:
+			n += NoInline506(i)
+		case 507:
+			n += NoInline507(i)
+		case 508:
+			n += NoInline508(i)
+		case 509:
+			n += NoInline509(i)
+		case 510:
+			n += NoInline510(i)
+		case 511:
+			n += NoInline511(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchNoInlineDenseLookupMod512(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysDense[inputs[i%len(inputs)]] {
+		case 0:
+			n += NoInline0(i)
+		case 1:
+			n += NoInline1(i)
+		case 2:
+			n += NoInline2(i)
+		case 3:
+			n += NoInline3(i)
+		case 4:
+			n += NoInline4(i)
+		case 5:
+			n += NoInline5(i)
+		case 6:
+			n += NoInline6(i)
+		case 7:
+			n += NoInline7(i)
+		case 8:
+			n += NoInline8(i)
+		case 9:
+			n += NoInline9(i)
+		case 10:
+			n += NoInline10(i)
+		case 11:
+			n += NoInline11(i)
+		case 12:
+			n += NoInline12(i)
+		case 13:
+			n += NoInline13(i)
+		case 14:
+			n += NoInline14(i)
+		case 15:
+			n += NoInline15(i)
+		case 16:
+			n += NoInline16(i)
+		case 17:
+			n += NoInline17(i)
+		case 18:
+			n += NoInline18(i)
+		case 19:
+			n += NoInline19(i)
+		case 20:
+			n += NoInline20(i)
+		case 21:
+			n += NoInline21(i)
+		case 22:
+			n += NoInline22(i)
+		case 23:
+			n += NoInline23(i)
+		case 24:
+			n += NoInline24(i)
+		case 25:
+			n += NoInline25(i)
+		case 26:
+			n += NoInline26(i)
+		case 27:
+			n += NoInline27(i)
+		case 28:
+			n += NoInline28(i)
+		case 29:
+			n += NoInline29(i)
+		case 30:
+			n += NoInline30(i)
+		case 31:
+			n += NoInline31(i)
+		case 32:
+			n += NoInline32(i)
+		case 33:
+			n += NoInline33(i)
+		case 34:
+			n += NoInline34(i)
+		case 35:
+			n += NoInline35(i)
+		case 36:
+			n += NoInline36(i)
+		case 37:
+			n += NoInline37(i)
+		case 38:
+			n += NoInline38(i)
+		case 39:
+			n += NoInline39(i)
+		case 40:
+			n += NoInline40(i)
+		case 41:
+			n += NoInline41(i)
+		case 42:
+			n += NoInline42(i)
+		case 43:
+			n += NoInline43(i)
+		case 44:
+			n += NoInline44(i)
+		case 45:
+			n += NoInline45(i)
+		case 46:
+			n += NoInline46(i)
+		case 47:
+			n += NoInline47(i)
+		case 48:
+			n += NoInline48(i)
+		case 49:
+			n += NoInline49(i)
+		case 50:
+			n += NoInline50(i)
+		case 51:
+			n += NoInline51(i)
+		case 52:
+			n += NoInline52(i)
+		case 53:
+			n += NoInline53(i)
+		case 54:
+			n += NoInline54(i)
+		case 55:
+			n += NoInline55(i)
+		case 56:
+			n += NoInline56(i)
+		case 57:
+			n += NoInline57(i)
+		case 58:
+			n += NoInline58(i)
+		case 59:
+			n += NoInline59(i)
+		case 60:
+			n += NoInline60(i)
+		case 61:
+			n += NoInline61(i)
+		case 62:
+			n += NoInline62(i)
+		case 63:
+			n += NoInline63(i)
+		case 64:
+			n += NoInline64(i)
+		case 65:
+			n += NoInline65(i)
+		case 66:
+			n += NoInline66(i)
+		case 67:
+			n += NoInline67(i)
+		case 68:
+			n += NoInline68(i)
+		case 69:
+			n += NoInline69(i)
+		case 70:
+			n += NoInline70(i)
+		case 71:
+			n += NoInline71(i)
+		case 72:
+			n += NoInline72(i)
+		case 73:
+			n += NoInline73(i)
+		case 74:
+			n += NoInline74(i)
+		case 75:
+			n += NoInline75(i)
+		case 76:
+			n += NoInline76(i)
+		case 77:
+			n += NoInline77(i)
+		case 78:
+			n += NoInline78(i)
+		case 79:
+			n += NoInline79(i)
+		case 80:
+			n += NoInline80(i)
+		case 81:
+			n += NoInline81(i)
+		case 82:
+			n += NoInline82(i)
+		case 83:
+			n += NoInline83(i)
+		case 84:
+			n += NoInline84(i)
+		case 85:
+			n += NoInline85(i)
+		case 86:
+			n += NoInline86(i)
+		case 87:
+			n += NoInline87(i)
+		case 88:
+			n += NoInline88(i)
+		case 89:
+			n += NoInline89(i)
+		case 90:
+			n += NoInline90(i)
+		case 91:
+			n += NoInline91(i)
+		case 92:
+			n += NoInline92(i)
+		case 93:
+			n += NoInline93(i)
+		case 94:
+			n += NoInline94(i)
+		case 95:
+			n += NoInline95(i)
+		case 96:
+			n += NoInline96(i)
+		case 97:
+			n += NoInline97(i)
+		case 98:
+			n += NoInline98(i)
+		case 99:
+			n += NoInline99(i)
+		case 100:
+			n += NoInline100(i)
+		case 101:
+			n += NoInline101(i)
+		case 102:
+			n += NoInline102(i)
+		case 103:
+			n += NoInline103(i)
+		case 104:
+			n += NoInline104(i)
+		case 105:
+			n += NoInline105(i)
+		case 106:
+			n += NoInline106(i)
+		case 107:
+			n += NoInline107(i)
+		case 108:
+			n += NoInline108(i)
+		case 109:
+			n += NoInline109(i)
+		case 110:
+			n += NoInline110(i)
+		case 111:
+			n += NoInline111(i)
+		case 112:
+			n += NoInline112(i)
+		case 113:
+			n += NoInline113(i)
+		case 114:
+			n += NoInline114(i)
+		case 115:
+			n += NoInline115(i)
+		case 116:
+			n += NoInline116(i)
+		case 117:
+			n += NoInline117(i)
+		case 118:
+			n += NoInline118(i)
+		case 119:
+			n += NoInline119(i)
+		case 120:
+			n += NoInline120(i)
+		case 121:
+			n += NoInline121(i)
+		case 122:
+			n += NoInline122(i)
+		case 123:
+			n += NoInline123(i)
+		case 124:
+			n += NoInline124(i)
+		case 125:
+			n += NoInline125(i)
+		case 126:
+			n += NoInline126(i)
+		case 127:
+			n += NoInline127(i)
+		case 128:
+			n += NoInline128(i)
+		case 129:
+			n += NoInline129(i)
+		case 130:
+			n += NoInline130(i)
+		case 131:
+			n += NoInline131(i)
+		case 132:
+			n += NoInline132(i)
+		case 133:
+			n += NoInline133(i)
+		case 134:
+			n += NoInline134(i)
+		case 135:
+			n += NoInline135(i)
+		case 136:
+			n += NoInline136(i)
+		case 137:
+			n += NoInline137(i)
+		case 138:
+			n += NoInline138(i)
+		case 139:
+			n += NoInline139(i)
+		case 140:
+			n += NoInline140(i)
+		case 141:
+			n += NoInline141(i)
+		case 142:
+			n += NoInline142(i)
+		case 143:
+			n += NoInline143(i)
+		case 144:
+			n += NoInline144(i)
+		case 145:
+			n += NoInline145(i)
+		case 146:
+			n += NoInline146(i)
+		case 147:
+			n += NoInline147(i)
+		case 148:
+			n += NoInline148(i)
+		case 149:
+			n += NoInline149(i)
+		case 150:
+			n += NoInline150(i)
+		case 151:
+			n += NoInline151(i)
+		case 152:
+			n += NoInline152(i)
+		case 153:
+			n += NoInline153(i)
+		case 154:
+			n += NoInline154(i)
+		case 155:
+			n += NoInline155(i)
+		case 156:
+			n += NoInline156(i)
+		case 157:
+			n += NoInline157(i)
+		case 158:
+			n += NoInline158(i)
+		case 159:
+			n += NoInline159(i)
+		case 160:
+			n += NoInline160(i)
+		case 161:
+			n += NoInline161(i)
+		case 162:
+			n += NoInline162(i)
+		case 163:
+			n += NoInline163(i)
+		case 164:
+			n += NoInline164(i)
+		case 165:
+			n += NoInline165(i)
+		case 166:
+			n += NoInline166(i)
+		case 167:
+			n += NoInline167(i)
+		case 168:
+			n += NoInline168(i)
+		case 169:
+			n += NoInline169(i)
+		case 170:
+			n += NoInline170(i)
+		case 171:
+			n += NoInline171(i)
+		case 172:
+			n += NoInline172(i)
+		case 173:
+			n += NoInline173(i)
+		case 174:
+			n += NoInline174(i)
+		case 175:
+			n += NoInline175(i)
+		case 176:
+			n += NoInline176(i)
+		case 177:
+			n += NoInline177(i)
+		case 178:
+			n += NoInline178(i)
+		case 179:
+			n += NoInline179(i)
+		case 180:
+			n += NoInline180(i)
+		case 181:
+			n += NoInline181(i)
+		case 182:
+			n += NoInline182(i)
+		case 183:
+			n += NoInline183(i)
+		case 184:
+			n += NoInline184(i)
+		case 185:
+			n += NoInline185(i)
+		case 186:
+			n += NoInline186(i)
+		case 187:
+			n += NoInline187(i)
+		case 188:
+			n += NoInline188(i)
+		case 189:
+			n += NoInline189(i)
+		case 190:
+			n += NoInline190(i)
+		case 191:
+			n += NoInline191(i)
+		case 192:
+			n += NoInline192(i)
+		case 193:
+			n += NoInline193(i)
+		case 194:
+			n += NoInline194(i)
+		case 195:
+			n += NoInline195(i)
+		case 196:
+			n += NoInline196(i)
+		case 197:
+			n += NoInline197(i)
+		case 198:
+			n += NoInline198(i)
+		case 199:
+			n += NoInline199(i)
+		case 200:
+			n += NoInline200(i)
+		case 201:
+			n += NoInline201(i)
+		case 202:
+			n += NoInline202(i)
+		case 203:
+			n += NoInline203(i)
+		case 204:
+			n += NoInline204(i)
+		case 205:
+			n += NoInline205(i)
+		case 206:
+			n += NoInline206(i)
+		case 207:
+			n += NoInline207(i)
+		case 208:
+			n += NoInline208(i)
+		case 209:
+			n += NoInline209(i)
+		case 210:
+			n += NoInline210(i)
+		case 211:
+			n += NoInline211(i)
+		case 212:
+			n += NoInline212(i)
+		case 213:
+			n += NoInline213(i)
+		case 214:
+			n += NoInline214(i)
+		case 215:
+			n += NoInline215(i)
+		case 216:
+			n += NoInline216(i)
+		case 217:
+			n += NoInline217(i)
+		case 218:
+			n += NoInline218(i)
+		case 219:
+			n += NoInline219(i)
+		case 220:
+			n += NoInline220(i)
+		case 221:
+			n += NoInline221(i)
+		case 222:
+			n += NoInline222(i)
+		case 223:
+			n += NoInline223(i)
+		case 224:
+			n += NoInline224(i)
+		case 225:
+			n += NoInline225(i)
+		case 226:
+			n += NoInline226(i)
+		case 227:
+			n += NoInline227(i)
+		case 228:
+			n += NoInline228(i)
+		case 229:
+			n += NoInline229(i)
+		case 230:
+			n += NoInline230(i)
+		case 231:
+			n += NoInline231(i)
+		case 232:
+			n += NoInline232(i)
+		case 233:
+			n += NoInline233(i)
+		case 234:
+			n += NoInline234(i)
+		case 235:
+			n += NoInline235(i)
+		case 236:
+			n += NoInline236(i)
+		case 237:
+			n += NoInline237(i)
+		case 238:
+			n += NoInline238(i)
+		case 239:
+			n += NoInline239(i)
+		case 240:
+			n += NoInline240(i)
+		case 241:
+			n += NoInline241(i)
+		case 242:
+			n += NoInline242(i)
+		case 243:
+			n += NoInline243(i)
+		case 244:
+			n += NoInline244(i)
+		case 245:
+			n += NoInline245(i)
+		case 246:
+			n += NoInline246(i)
+		case 247:
+			n += NoInline247(i)
+		case 248:
+			n += NoInline248(i)
+		case 249:
+			n += NoInline249(i)
+		case 250:
+			n += NoInline250(i)
+		case 251:
+			n += NoInline251(i)
+		case 252:
+			n += NoInline252(i)
+		case 253:
+			n += NoInline253(i)
+		case 254:
+			n += NoInline254(i)
+		case 255:
+			n += NoInline255(i)
+		case 256:
+			n += NoInline256(i)
+		case 257:
+			n += NoInline257(i)
+		case 258:
+			n += NoInline258(i)
+		case 259:
+			n += NoInline259(i)
+		case 260:
+			n += NoInline260(i)
+		case 261:
+			n += NoInline261(i)
+		case 262:
+			n += NoInline262(i)
+		case 263:
+			n += NoInline263(i)
+		case 264:
+			n += NoInline264(i)
+		case 265:
+			n += NoInline265(i)
+		case 266:
+			n += NoInline266(i)
+		case 267:
+			n += NoInline267(i)
+		case 268:
+			n += NoInline268(i)
+		case 269:
+			n += NoInline269(i)
+		case 270:
+			n += NoInline270(i)
+		case 271:
+			n += NoInline271(i)
+		case 272:
+			n += NoInline272(i)
+		case 273:
+			n += NoInline273(i)
+		case 274:
+			n += NoInline274(i)
+		case 275:
+			n += NoInline275(i)
+		case 276:
+			n += NoInline276(i)
+		case 277:
+			n += NoInline277(i)
+		case 278:
+			n += NoInline278(i)
+		case 279:
+			n += NoInline279(i)
+		case 280:
+			n += NoInline280(i)
+		case 281:
+			n += NoInline281(i)
+		case 282:
+			n += NoInline282(i)
+		case 283:
+			n += NoInline283(i)
+		case 284:
+			n += NoInline284(i)
+		case 285:
+			n += NoInline285(i)
+		case 286:
+			n += NoInline286(i)
+		case 287:
+			n += NoInline287(i)
+		case 288:
+			n += NoInline288(i)
+		case 289:
+			n += NoInline289(i)
+		case 290:
+			n += NoInline290(i)
+		case 291:
+			n += NoInline291(i)
+		case 292:
+			n += NoInline292(i)
+		case 293:
+			n += NoInline293(i)
+		case 294:
+			n += NoInline294(i)
+		case 295:
+			n += NoInline295(i)
+		case 296:
+			n += NoInline296(i)
+		case 297:
+			n += NoInline297(i)
+		case 298:
+			n += NoInline298(i)
+		case 299:
+			n += NoInline299(i)
+		case 300:
+			n += NoInline300(i)
+		case 301:
+			n += NoInline301(i)
+		case 302:
+			n += NoInline302(i)
+		case 303:
+			n += NoInline303(i)
+		case 304:
+			n += NoInline304(i)
+		case 305:
+			n += NoInline305(i)
+		case 306:
+			n += NoInline306(i)
+		case 307:
+			n += NoInline307(i)
+		case 308:
+			n += NoInline308(i)
+		case 309:
+			n += NoInline309(i)
+		case 310:
+			n += NoInline310(i)
+		case 311:
+			n += NoInline311(i)
+		case 312:
+			n += NoInline312(i)
+		case 313:
+			n += NoInline313(i)
+		case 314:
+			n += NoInline314(i)
+		case 315:
+			n += NoInline315(i)
+		case 316:
+			n += NoInline316(i)
+		case 317:
+			n += NoInline317(i)
+		case 318:
+			n += NoInline318(i)
+		case 319:
+			n += NoInline319(i)
+		case 320:
+			n += NoInline320(i)
+		case 321:
+			n += NoInline321(i)
+		case 322:
+			n += NoInline322(i)
+		case 323:
+			n += NoInline323(i)
+		case 324:
+			n += NoInline324(i)
+		case 325:
+			n += NoInline325(i)
+		case 326:
+			n += NoInline326(i)
+		case 327:
+			n += NoInline327(i)
+		case 328:
+			n += NoInline328(i)
+		case 329:
+			n += NoInline329(i)
+		case 330:
+			n += NoInline330(i)
+		case 331:
+			n += NoInline331(i)
+		case 332:
+			n += NoInline332(i)
+		case 333:
+			n += NoInline333(i)
+		case 334:
+			n += NoInline334(i)
+		case 335:
+			n += NoInline335(i)
+		case 336:
+			n += NoInline336(i)
+		case 337:
+			n += NoInline337(i)
+		case 338:
+			n += NoInline338(i)
+		case 339:
+			n += NoInline339(i)
+		case 340:
+			n += NoInline340(i)
+		case 341:
+			n += NoInline341(i)
+		case 342:
+			n += NoInline342(i)
+		case 343:
+			n += NoInline343(i)
+		case 344:
+			n += NoInline344(i)
+		case 345:
+			n += NoInline345(i)
+		case 346:
+			n += NoInline346(i)
+		case 347:
+			n += NoInline347(i)
+		case 348:
+			n += NoInline348(i)
+		case 349:
+			n += NoInline349(i)
+		case 350:
+			n += NoInline350(i)
+		case 351:
+			n += NoInline351(i)
+		case 352:
+			n += NoInline352(i)
+		case 353:
+			n += NoInline353(i)
+		case 354:
+			n += NoInline354(i)
+		case 355:
+			n += NoInline355(i)
+		case 356:
+			n += NoInline356(i)
+		case 357:
+			n += NoInline357(i)
+		case 358:
+			n += NoInline358(i)
+		case 359:
+			n += NoInline359(i)
+		case 360:
+			n += NoInline360(i)
+		case 361:
+			n += NoInline361(i)
+		case 362:
+			n += NoInline362(i)
+		case 363:
+			n += NoInline363(i)
+		case 364:
+			n += NoInline364(i)
+		case 365:
+			n += NoInline365(i)
+		case 366:
+			n += NoInline366(i)
+		case 367:
+			n += NoInline367(i)
+		case 368:
+			n += NoInline368(i)
+		case 369:
+			n += NoInline369(i)
+		case 370:
+			n += NoInline370(i)
+		case 371:
+			n += NoInline371(i)
+		case 372:
+			n += NoInline372(i)
+		case 373:
+			n += NoInline373(i)
+		case 374:
+			n += NoInline374(i)
+		case 375:
+			n += NoInline375(i)
+		case 376:
+			n += NoInline376(i)
+		case 377:
+			n += NoInline377(i)
+		case 378:
+			n += NoInline378(i)
+		case 379:
+			n += NoInline379(i)
+		case 380:
+			n += NoInline380(i)
+		case 381:
+			n += NoInline381(i)
+		case 382:
+			n += NoInline382(i)
+		case 383:
+			n += NoInline383(i)
+		case 384:
+			n += NoInline384(i)
+		case 385:
+			n += NoInline385(i)
+		case 386:
+			n += NoInline386(i)
+		case 387:
+			n += NoInline387(i)
+		case 388:
+			n += NoInline388(i)
+		case 389:
+			n += NoInline389(i)
+		case 390:
+			n += NoInline390(i)
+		case 391:
+			n += NoInline391(i)
+		case 392:
+			n += NoInline392(i)
+		case 393:
+			n += NoInline393(i)
+		case 394:
+			n += NoInline394(i)
+		case 395:
+			n += NoInline395(i)
+		case 396:
+			n += NoInline396(i)
+		case 397:
+			n += NoInline397(i)
+		case 398:
+			n += NoInline398(i)
+		case 399:
+			n += NoInline399(i)
+		case 400:
+			n += NoInline400(i)
+		case 401:
+			n += NoInline401(i)
+		case 402:
+			n += NoInline402(i)
+		case 403:
+			n += NoInline403(i)
+		case 404:
+			n += NoInline404(i)
+		case 405:
+			n += NoInline405(i)
+		case 406:
+			n += NoInline406(i)
+		case 407:
+			n += NoInline407(i)
+		case 408:
+			n += NoInline408(i)
+		case 409:
+			n += NoInline409(i)
+		case 410:
+			n += NoInline410(i)
+		case 411:
+			n += NoInline411(i)
+		case 412:
+			n += NoInline412(i)
+		case 413:
+			n += NoInline413(i)
+		case 414:
+			n += NoInline414(i)
+		case 415:
+			n += NoInline415(i)
+		case 416:
+			n += NoInline416(i)
+		case 417:
+			n += NoInline417(i)
+		case 418:
+			n += NoInline418(i)
+		case 419:
+			n += NoInline419(i)
+		case 420:
+			n += NoInline420(i)
+		case 421:
+			n += NoInline421(i)
+		case 422:
+			n += NoInline422(i)
+		case 423:
+			n += NoInline423(i)
+		case 424:
+			n += NoInline424(i)
+		case 425:
+			n += NoInline425(i)
+		case 426:
+			n += NoInline426(i)
+		case 427:
+			n += NoInline427(i)
+		case 428:
+			n += NoInline428(i)
+		case 429:
+			n += NoInline429(i)
+		case 430:
+			n += NoInline430(i)
+		case 431:
+			n += NoInline431(i)
+		case 432:
+			n += NoInline432(i)
+		case 433:
+			n += NoInline433(i)
+		case 434:
+			n += NoInline434(i)
+		case 435:
+			n += NoInline435(i)
+		case 436:
+			n += NoInline436(i)
+		case 437:
+			n += NoInline437(i)
+		case 438:
+			n += NoInline438(i)
+		case 439:
+			n += NoInline439(i)
+		case 440:
+			n += NoInline440(i)
+		case 441:
+			n += NoInline441(i)
+		case 442:
+			n += NoInline442(i)
+		case 443:
+			n += NoInline443(i)
+		case 444:
+			n += NoInline444(i)
+		case 445:
+			n += NoInline445(i)
+		case 446:
+			n += NoInline446(i)
+		case 447:
+			n += NoInline447(i)
+		case 448:
+			n += NoInline448(i)
+		case 449:
+			n += NoInline449(i)
+		case 450:
+			n += NoInline450(i)
+		case 451:
+			n += NoInline451(i)
+		case 452:
+			n += NoInline452(i)
+		case 453:
+			n += NoInline453(i)
+		case 454:
+			n += NoInline454(i)
+		case 455:
+			n += NoInline455(i)
+		case 456:
+			n += NoInline456(i)
+		case 457:
+			n += NoInline457(i)
+		case 458:
+			n += NoInline458(i)
+		case 459:
+			n += NoInline459(i)
+		case 460:
+			n += NoInline460(i)
+		case 461:
+			n += NoInline461(i)
+		case 462:
+			n += NoInline462(i)
+		case 463:
+			n += NoInline463(i)
+		case 464:
+			n += NoInline464(i)
+		case 465:
+			n += NoInline465(i)
+		case 466:
+			n += NoInline466(i)
+		case 467:
+			n += NoInline467(i)
+		case 468:
+			n += NoInline468(i)
+		case 469:
+			n += NoInline469(i)
+		case 470:
+			n += NoInline470(i)
+		case 471:
+			n += NoInline471(i)
+		case 472:
+			n += NoInline472(i)
+		case 473:
+			n += NoInline473(i)
+		case 474:
+			n += NoInline474(i)
+		case 475:
+			n += NoInline475(i)
+		case 476:
+			n += NoInline476(i)
+		case 477:
+			n += NoInline477(i)
+		case 478:
+			n += NoInline478(i)
+		case 479:
+			n += NoInline479(i)
+		case 480:
+			n += NoInline480(i)
+		case 481:
+			n += NoInline481(i)
+		case 482:
+			n += NoInline482(i)
+		case 483:
+			n += NoInline483(i)
+		case 484:
+			n += NoInline484(i)
+		case 485:
+			n += NoInline485(i)
+		case 486:
+			n += NoInline486(i)
+		case 487:
+			n += NoInline487(i)
+		case 488:
+			n += NoInline488(i)
+		case 489:
+			n += NoInline489(i)
+		case 490:
+			n += NoInline490(i)
+		case 491:
+			n += NoInline491(i)
+		case 492:
+			n += NoInline492(i)
+		case 493:
+			n += NoInline493(i)
+		case 494:
+			n += NoInline494(i)
+		case 495:
+			n += NoInline495(i)
+		case 496:
+			n += NoInline496(i)
+		case 497:
+			n += NoInline497(i)
+		case 498:
+			n += NoInline498(i)
+		case 499:
+			n += NoInline499(i)
+		case 500:
+			n += NoInline500(i)
+		case 501:
+			n += NoInline501(i)
+		case 502:
+			n += NoInline502(i)
+		case 503:
+			n += NoInline503(i)
+		case 504:
+			n += NoInline504(i)
+		case 505:
+			n += NoInline505(i)
+		case 506:
+			n += NoInline506(i)
+		case 507:
+			n += NoInline507(i)
+		case 508:
+			n += NoInline508(i)
+		case 509:
+			n += NoInline509(i)
+		case 510:
+			n += NoInline510(i)
+		case 511:
+			n += NoInline511(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchNoInlineStridedComputed4(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysStrided[i%4] {
+		case 0:
+			n += NoInline0(i)
+		case 16:
+			n += NoInline1(i)
+		case 32:
+			n += NoInline2(i)
+		case 48:
+			n += NoInline3(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchNoInlineStridedLookupMod4(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysStrided[inputs[i%len(inputs)]] {
+		case 0:
+			n += NoInline0(i)
+		case 16:
+			n += NoInline1(i)
+		case 32:
+			n += NoInline2(i)
+		case 48:
+			n += NoInline3(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchNoInlineStridedComputed8(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysStrided[i%8] {
+		case 0:
+			n += NoInline0(i)
+		case 16:
+			n += NoInline1(i)
+		case 32:
+			n += NoInline2(i)
+		case 48:
+			n += NoInline3(i)
+		case 64:
+			n += NoInline4(i)
+		case 80:
+			n += NoInline5(i)
+		case 96:
+			n += NoInline6(i)
+		case 112:
+			n += NoInline7(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchNoInlineStridedLookupMod8(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysStrided[inputs[i%len(inputs)]] {
+		case 0:
+			n += NoInline0(i)
+		case 16:
+			n += NoInline1(i)
+		case 32:
+			n += NoInline2(i)
+		case 48:
+			n += NoInline3(i)
+		case 64:
+			n += NoInline4(i)
+		case 80:
+			n += NoInline5(i)
+		case 96:
+			n += NoInline6(i)
+		case 112:
+			n += NoInline7(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchNoInlineStridedComputed16(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysStrided[i%16] {
+		case 0:
+			n += NoInline0(i)
+		case 16:
+			n += NoInline1(i)
+		case 32:
+			n += NoInline2(i)
+		case 48:
+			n += NoInline3(i)
+		case 64:
+			n += NoInline4(i)
+		case 80:
+			n += NoInline5(i)
+		case 96:
+			n += NoInline6(i)
+		case 112:
+			n += NoInline7(i)
+		case 128:
+			n += NoInline8(i)
+		case 144:
+			n += NoInline9(i)
+		case 160:
+			n += NoInline10(i)
+		case 176:
+			n += NoInline11(i)
+		case 192:
+			n += NoInline12(i)
+		case 208:
+			n += NoInline13(i)
+		case 224:
+			n += NoInline14(i)
+		case 240:
+			n += NoInline15(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchNoInlineStridedLookupMod16(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysStrided[inputs[i%len(inputs)]] {
+		case 0:
+			n += NoInline0(i)
+		case 16:
+			n += NoInline1(i)
+		case 32:
+			n += NoInline2(i)
+		case 48:
+			n += NoInline3(i)
+		case 64:
+			n += NoInline4(i)
+		case 80:
+			n += NoInline5(i)
+		case 96:
+			n += NoInline6(i)
+		case 112:
+			n += NoInline7(i)
+		case 128:
+			n += NoInline8(i)
+		case 144:
+			n += NoInline9(i)
+		case 160:
+			n += NoInline10(i)
+		case 176:
+			n += NoInline11(i)
+		case 192:
+			n += NoInline12(i)
+		case 208:
+			n += NoInline13(i)
+		case 224:
+			n += NoInline14(i)
+		case 240:
+			n += NoInline15(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchNoInlineStridedComputed32(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysStrided[i%32] {
+		case 0:
+			n += NoInline0(i)
+		case 16:
+			n += NoInline1(i)
+		case 32:
+			n += NoInline2(i)
+		case 48:
+			n += NoInline3(i)
+		case 64:
+			n += NoInline4(i)
+		case 80:
+			n += NoInline5(i)
+		case 96:
+			n += NoInline6(i)
+		case 112:
+			n += NoInline7(i)
+		case 128:
+			n += NoInline8(i)
+		case 144:
+			n += NoInline9(i)
+		case 160:
+			n += NoInline10(i)
+		case 176:
+			n += NoInline11(i)
+		case 192:
+			n += NoInline12(i)
+		case 208:
+			n += NoInline13(i)
+		case 224:
+			n += NoInline14(i)
+		case 240:
+			n += NoInline15(i)
+		case 256:
+			n += NoInline16(i)
+		case 272:
+			n += NoInline17(i)
+		case 288:
+			n += NoInline18(i)
+		case 304:
+			n += NoInline19(i)
+		case 320:
+			n += NoInline20(i)
+		case 336:
+			n += NoInline21(i)
+		case 352:
+			n += NoInline22(i)
+		case 368:
+			n += NoInline23(i)
+		case 384:
+			n += NoInline24(i)
+		case 400:
+			n += NoInline25(i)
+		case 416:
+			n += NoInline26(i)
+		case 432:
+			n += NoInline27(i)
+		case 448:
+			n += NoInline28(i)
+		case 464:
+			n += NoInline29(i)
+		case 480:
+			n += NoInline30(i)
+		case 496:
+			n += NoInline31(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchNoInlineStridedLookupMod32(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysStrided[inputs[i%len(inputs)]] {
+		case 0:
+			n += NoInline0(i)
+		case 16:
+			n += NoInline1(i)
+		case 32:
+			n += NoInline2(i)
+		case 48:
+			n += NoInline3(i)
+		case 64:
+			n += NoInline4(i)
+		case 80:
+			n += NoInline5(i)
+		case 96:
+			n += NoInline6(i)
+		case 112:
+			n += NoInline7(i)
+		case 128:
+			n += NoInline8(i)
+		case 144:
+			n += NoInline9(i)
+		case 160:
+			n += NoInline10(i)
+		case 176:
+			n += NoInline11(i)
+		case 192:
+			n += NoInline12(i)
+		case 208:
+			n += NoInline13(i)
+		case 224:
+			n += NoInline14(i)
+		case 240:
+			n += NoInline15(i)
+		case 256:
+			n += NoInline16(i)
+		case 272:
+			n += NoInline17(i)
+		case 288:
+			n += NoInline18(i)
+		case 304:
+			n += NoInline19(i)
+		case 320:
+			n += NoInline20(i)
+		case 336:
+			n += NoInline21(i)
+		case 352:
+			n += NoInline22(i)
+		case 368:
+			n += NoInline23(i)
+		case 384:
+			n += NoInline24(i)
+		case 400:
+			n += NoInline25(i)
+		case 416:
+			n += NoInline26(i)
+		case 432:
+			n += NoInline27(i)
+		case 448:
+			n += NoInline28(i)
+		case 464:
+			n += NoInline29(i)
+		case 480:
+			n += NoInline30(i)
+		case 496:
+			n += NoInline31(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchNoInlineStridedComputed64(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysStrided[i%64] {
+		case 0:
+			n += NoInline0(i)
+		case 16:
+			n += NoInline1(i)
+		case 32:
+			n += NoInline2(i)
+		case 48:
+			n += NoInline3(i)
+		case 64:
+			n += NoInline4(i)
+		case 80:
+			n += NoInline5(i)
+		case 96:
+			n += NoInline6(i)
+		case 112:
+			n += NoInline7(i)
+		case 128:
+			n += NoInline8(i)
+		case 144:
+			n += NoInline9(i)
+		case 160:
+			n += NoInline10(i)
+		case 176:
+			n += NoInline11(i)
+		case 192:
+			n += NoInline12(i)
+		case 208:
+			n += NoInline13(i)
+		case 224:
+			n += NoInline14(i)
+		case 240:
+			n += NoInline15(i)
+		case 256:
+			n += NoInline16(i)
+		case 272:
+			n += NoInline17(i)
+		case 288:
+			n += NoInline18(i)
+		case 304:
+			n += NoInline19(i)
+		case 320:
+			n += NoInline20(i)
+		case 336:
+			n += NoInline21(i)
+		case 352:
+			n += NoInline22(i)
+		case 368:
+			n += NoInline23(i)
+		case 384:
+			n += NoInline24(i)
+		case 400:
+			n += NoInline25(i)
+		case 416:
+			n += NoInline26(i)
+		case 432:
+			n += NoInline27(i)
+		case 448:
+			n += NoInline28(i)
+		case 464:
+			n += NoInline29(i)
+		case 480:
+			n += NoInline30(i)
+		case 496:
+			n += NoInline31(i)
+		case 512:
+			n += NoInline32(i)
+		case 528:
+			n += NoInline33(i)
+		case 544:
+			n += NoInline34(i)
+		case 560:
+			n += NoInline35(i)
+		case 576:
+			n += NoInline36(i)
+		case 592:
+			n += NoInline37(i)
+		case 608:
+			n += NoInline38(i)
+		case 624:
+			n += NoInline39(i)
+		case 640:
+			n += NoInline40(i)
+		case 656:
+			n += NoInline41(i)
+		case 672:
+			n += NoInline42(i)
+		case 688:
+			n += NoInline43(i)
+		case 704:
+			n += NoInline44(i)
+		case 720:
+			n += NoInline45(i)
+		case 736:
+			n += NoInline46(i)
+		case 752:
+			n += NoInline47(i)
+		case 768:
+			n += NoInline48(i)
+		case 784:
+			n += NoInline49(i)
+		case 800:
+			n += NoInline50(i)
+		case 816:
+			n += NoInline51(i)
+		case 832:
+			n += NoInline52(i)
+		case 848:
+			n += NoInline53(i)
+		case 864:
+			n += NoInline54(i)
+		case 880:
+			n += NoInline55(i)
+		case 896:
+			n += NoInline56(i)
+		case 912:
+			n += NoInline57(i)
+		case 928:
+			n += NoInline58(i)
+		case 944:
+			n += NoInline59(i)
+		case 960:
+			n += NoInline60(i)
+		case 976:
+			n += NoInline61(i)
+		case 992:
+			n += NoInline62(i)
+		case 1008:
+			n += NoInline63(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchNoInlineStridedLookupMod64(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysStrided[inputs[i%len(inputs)]] {
+		case 0:
+			n += NoInline0(i)
+		case 16:
+			n += NoInline1(i)
+		case 32:
+			n += NoInline2(i)
+		case 48:
+			n += NoInline3(i)
+		case 64:
+			n += NoInline4(i)
+		case 80:
+			n += NoInline5(i)
+		case 96:
+			n += NoInline6(i)
+		case 112:
+			n += NoInline7(i)
+		case 128:
+			n += NoInline8(i)
+		case 144:
+			n += NoInline9(i)
+		case 160:
+			n += NoInline10(i)
+		case 176:
+			n += NoInline11(i)
+		case 192:
+			n += NoInline12(i)
+		case 208:
+			n += NoInline13(i)
+		case 224:
+			n += NoInline14(i)
+		case 240:
+			n += NoInline15(i)
+		case 256:
+			n += NoInline16(i)
+		case 272:
+			n += NoInline17(i)
+		case 288:
+			n += NoInline18(i)
+		case 304:
+			n += NoInline19(i)
+		case 320:
+			n += NoInline20(i)
+		case 336:
+			n += NoInline21(i)
+		case 352:
+			n += NoInline22(i)
+		case 368:
+			n += NoInline23(i)
+		case 384:
+			n += NoInline24(i)
+		case 400:
+			n += NoInline25(i)
+		case 416:
+			n += NoInline26(i)
+		case 432:
+			n += NoInline27(i)
+		case 448:
+			n += NoInline28(i)
+		case 464:
+			n += NoInline29(i)
+		case 480:
+			n += NoInline30(i)
+		case 496:
+			n += NoInline31(i)
+		case 512:
+			n += NoInline32(i)
+		case 528:
+			n += NoInline33(i)
+		case 544:
+			n += NoInline34(i)
+		case 560:
+			n += NoInline35(i)
+		case 576:
+			n += NoInline36(i)
+		case 592:
+			n += NoInline37(i)
+		case 608:
+			n += NoInline38(i)
+		case 624:
+			n += NoInline39(i)
+		case 640:
+			n += NoInline40(i)
+		case 656:
+			n += NoInline41(i)
+		case 672:
+			n += NoInline42(i)
+		case 688:
+			n += NoInline43(i)
+		case 704:
+			n += NoInline44(i)
+		case 720:
+			n += NoInline45(i)
+		case 736:
+			n += NoInline46(i)
+		case 752:
+			n += NoInline47(i)
+		case 768:
+			n += NoInline48(i)
+		case 784:
+			n += NoInline49(i)
+		case 800:
+			n += NoInline50(i)
+		case 816:
+			n += NoInline51(i)
+		case 832:
+			n += NoInline52(i)
+		case 848:
+			n += NoInline53(i)
+		case 864:
+			n += NoInline54(i)
+		case 880:
+			n += NoInline55(i)
+		case 896:
+			n += NoInline56(i)
+		case 912:
+			n += NoInline57(i)
+		case 928:
+			n += NoInline58(i)
+		case 944:
+			n += NoInline59(i)
+		case 960:
+			n += NoInline60(i)
+		case 976:
+			n += NoInline61(i)
+		case 992:
+			n += NoInline62(i)
+		case 1008:
+			n += NoInline63(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchNoInlineStridedComputed128(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysStrided[i%128] {
+		case 0:
+			n += NoInline0(i)
+		case 16:
+			n += NoInline1(i)
+		case 32:
+			n += NoInline2(i)
+		case 48:
+			n += NoInline3(i)
+		case 64:
+			n += NoInline4(i)
+		case 80:
+			n += NoInline5(i)
+		case 96:
+			n += NoInline6(i)
+		case 112:
+			n += NoInline7(i)
+		case 128:
+			n += NoInline8(i)
+		case 144:
+			n += NoInline9(i)
+		case 160:
+			n += NoInline10(i)
+		case 176:
+			n += NoInline11(i)
+		case 192:
+			n += NoInline12(i)
+		case 208:
+			n += NoInline13(i)
+		case 224:
+			n += NoInline14(i)
+		case 240:
+			n += NoInline15(i)
+		case 256:
+			n += NoInline16(i)
+		case 272:
+			n += NoInline17(i)
+		case 288:
+			n += NoInline18(i)
+		case 304:
+			n += NoInline19(i)
+		case 320:
+			n += NoInline20(i)
+		case 336:
+			n += NoInline21(i)
+		case 352:
+			n += NoInline22(i)
+		case 368:
+			n += NoInline23(i)
+		case 384:
+			n += NoInline24(i)
+		case 400:
+			n += NoInline25(i)
+		case 416:
+			n += NoInline26(i)
+		case 432:
+			n += NoInline27(i)
+		case 448:
+			n += NoInline28(i)
+		case 464:
+			n += NoInline29(i)
+		case 480:
+			n += NoInline30(i)
+		case 496:
+			n += NoInline31(i)
+		case 512:
+			n += NoInline32(i)
+		case 528:
+			n += NoInline33(i)
+		case 544:
+			n += NoInline34(i)
+		case 560:
+			n += NoInline35(i)
+		case 576:
+			n += NoInline36(i)
+		case 592:
+			n += NoInline37(i)
+		case 608:
+			n += NoInline38(i)
+		case 624:
+			n += NoInline39(i)
+		case 640:
+			n += NoInline40(i)
+		case 656:
+			n += NoInline41(i)
+		case 672:
+			n += NoInline42(i)
+		case 688:
+			n += NoInline43(i)
+		case 704:
+			n += NoInline44(i)
+		case 720:
+			n += NoInline45(i)
+		case 736:
+			n += NoInline46(i)
+		case 752:
+			n += NoInline47(i)
+		case 768:
+			n += NoInline48(i)
+		case 784:
+			n += NoInline49(i)
+		case 800:
+			n += NoInline50(i)
+		case 816:
+			n += NoInline51(i)
+		case 832:
+			n += NoInline52(i)
+		case 848:
+			n += NoInline53(i)
+		case 864:
+			n += NoInline54(i)
+		case 880:
+			n += NoInline55(i)
+		case 896:
+			n += NoInline56(i)
+		case 912:
+			n += NoInline57(i)
+		case 928:
+			n += NoInline58(i)
+		case 944:
+			n += NoInline59(i)
+		case 960:
+			n += NoInline60(i)
+		case 976:
+			n += NoInline61(i)
+		case 992:
+			n += NoInline62(i)
+		case 1008:
+			n += NoInline63(i)
+		case 1024:
+			n += NoInline64(i)
+		case 1040:
+			n += NoInline65(i)
+		case 1056:
+			n += NoInline66(i)
+		case 1072:
+			n += NoInline67(i)
+		case 1088:
+			n += NoInline68(i)
+		case 1104:
+			n += NoInline69(i)
+		case 1120:
+			n += NoInline70(i)
+		case 1136:
+			n += NoInline71(i)
+		case 1152:
+			n += NoInline72(i)
+		case 1168:
+			n += NoInline73(i)
+		case 1184:
+			n += NoInline74(i)
+		case 1200:
+			n += NoInline75(i)
+		case 1216:
+			n += NoInline76(i)
+		case 1232:
+			n += NoInline77(i)
+		case 1248:
+			n += NoInline78(i)
+		case 1264:
+			n += NoInline79(i)
+		case 1280:
+			n += NoInline80(i)
+		case 1296:
+			n += NoInline81(i)
+		case 1312:
+			n += NoInline82(i)
+		case 1328:
+			n += NoInline83(i)
+		case 1344:
+			n += NoInline84(i)
+		case 1360:
+			n += NoInline85(i)
+		case 1376:
+			n += NoInline86(i)
+		case 1392:
+			n += NoInline87(i)
+		case 1408:
+			n += NoInline88(i)
+		case 1424:
+			n += NoInline89(i)
+		case 1440:
+			n += NoInline90(i)
+		case 1456:
+			n += NoInline91(i)
+		case 1472:
+			n += NoInline92(i)
+		case 1488:
+			n += NoInline93(i)
+		case 1504:
+			n += NoInline94(i)
+		case 1520:
+			n += NoInline95(i)
+		case 1536:
+			n += NoInline96(i)
+		case 1552:
+			n += NoInline97(i)
+		case 1568:
+			n += NoInline98(i)
+		case 1584:
+			n += NoInline99(i)
+		case 1600:
+			n += NoInline100(i)
+		case 1616:
+			n += NoInline101(i)
+		case 1632:
+			n += NoInline102(i)
+		case 1648:
+			n += NoInline103(i)
+		case 1664:
+			n += NoInline104(i)
+		case 1680:
+			n += NoInline105(i)
+		case 1696:
+			n += NoInline106(i)
+		case 1712:
+			n += NoInline107(i)
+		case 1728:
+			n += NoInline108(i)
+		case 1744:
+			n += NoInline109(i)
+		case 1760:
+			n += NoInline110(i)
+		case 1776:
+			n += NoInline111(i)
+		case 1792:
+			n += NoInline112(i)
+		case 1808:
+			n += NoInline113(i)
+		case 1824:
+			n += NoInline114(i)
+		case 1840:
+			n += NoInline115(i)
+		case 1856:
+			n += NoInline116(i)
+		case 1872:
+			n += NoInline117(i)
+		case 1888:
+			n += NoInline118(i)
+		case 1904:
+			n += NoInline119(i)
+		case 1920:
+			n += NoInline120(i)
+		case 1936:
+			n += NoInline121(i)
+		case 1952:
+			n += NoInline122(i)
+		case 1968:
+			n += NoInline123(i)
+		case 1984:
+			n += NoInline124(i)
+		case 2000:
+			n += NoInline125(i)
+		case 2016:
+			n += NoInline126(i)
+		case 2032:
+			n += NoInline127(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchNoInlineStridedLookupMod128(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysStrided[inputs[i%len(inputs)]] {
+		case 0:
+			n += NoInline0(i)
+		case 16:
+			n += NoInline1(i)
+		case 32:
+			n += NoInline2(i)
+		case 48:
+			n += NoInline3(i)
+		case 64:
+			n += NoInline4(i)
+		case 80:
+			n += NoInline5(i)
+		case 96:
+			n += NoInline6(i)
+		case 112:
+			n += NoInline7(i)
+		case 128:
+			n += NoInline8(i)
+		case 144:
+			n += NoInline9(i)
+		case 160:
+			n += NoInline10(i)
+		case 176:
+			n += NoInline11(i)
+		case 192:
+			n += NoInline12(i)
+		case 208:
+			n += NoInline13(i)
+		case 224:
+			n += NoInline14(i)
+		case 240:
+			n += NoInline15(i)
+		case 256:
+			n += NoInline16(i)
+		case 272:
+			n += NoInline17(i)
+		case 288:
+			n += NoInline18(i)
+		case 304:
+			n += NoInline19(i)
+		case 320:
+			n += NoInline20(i)
+		case 336:
+			n += NoInline21(i)
+		case 352:
+			n += NoInline22(i)
+		case 368:
+			n += NoInline23(i)
+		case 384:
+			n += NoInline24(i)
+		case 400:
+			n += NoInline25(i)
+		case 416:
+			n += NoInline26(i)
+		case 432:
+			n += NoInline27(i)
+		case 448:
+			n += NoInline28(i)
+		case 464:
+			n += NoInline29(i)
+		case 480:
+			n += NoInline30(i)
+		case 496:
+			n += NoInline31(i)
+		case 512:
+			n += NoInline32(i)
+		case 528:
+			n += NoInline33(i)
+		case 544:
+			n += NoInline34(i)
+		case 560:
+			n += NoInline35(i)
+		case 576:
+			n += NoInline36(i)
+		case 592:
+			n += NoInline37(i)
+		case 608:
+			n += NoInline38(i)
+		case 624:
+			n += NoInline39(i)
+		case 640:
+			n += NoInline40(i)
+		case 656:
+			n += NoInline41(i)
+		case 672:
+			n += NoInline42(i)
+		case 688:
+			n += NoInline43(i)
+		case 704:
+			n += NoInline44(i)
+		case 720:
+			n += NoInline45(i)
+		case 736:
+			n += NoInline46(i)
+		case 752:
+			n += NoInline47(i)
+		case 768:
+			n += NoInline48(i)
+		case 784:
+			n += NoInline49(i)
+		case 800:
+			n += NoInline50(i)
+		case 816:
+			n += NoInline51(i)
+		case 832:
+			n += NoInline52(i)
+		case 848:
+			n += NoInline53(i)
+		case 864:
+			n += NoInline54(i)
+		case 880:
+			n += NoInline55(i)
+		case 896:
+			n += NoInline56(i)
+		case 912:
+			n += NoInline57(i)
+		case 928:
+			n += NoInline58(i)
+		case 944:
+			n += NoInline59(i)
+		case 960:
+			n += NoInline60(i)
+		case 976:
+			n += NoInline61(i)
+		case 992:
+			n += NoInline62(i)
+		case 1008:
+			n += NoInline63(i)
+		case 1024:
+			n += NoInline64(i)
+		case 1040:
+			n += NoInline65(i)
+		case 1056:
+			n += NoInline66(i)
+		case 1072:
+			n += NoInline67(i)
+		case 1088:
+			n += NoInline68(i)
+		case 1104:
+			n += NoInline69(i)
+		case 1120:
+			n += NoInline70(i)
+		case 1136:
+			n += NoInline71(i)
+		case 1152:
+			n += NoInline72(i)
+		case 1168:
+			n += NoInline73(i)
+		case 1184:
+			n += NoInline74(i)
+		case 1200:
+			n += NoInline75(i)
+		case 1216:
+			n += NoInline76(i)
+		case 1232:
+			n += NoInline77(i)
+		case 1248:
+			n += NoInline78(i)
+		case 1264:
+			n += NoInline79(i)
+		case 1280:
+			n += NoInline80(i)
+		case 1296:
+			n += NoInline81(i)
+		case 1312:
+			n += NoInline82(i)
+		case 1328:
+			n += NoInline83(i)
+		case 1344:
+			n += NoInline84(i)
+		case 1360:
+			n += NoInline85(i)
+		case 1376:
+			n += NoInline86(i)
+		case 1392:
+			n += NoInline87(i)
+		case 1408:
+			n += NoInline88(i)
+		case 1424:
+			n += NoInline89(i)
+		case 1440:
+			n += NoInline90(i)
+		case 1456:
+			n += NoInline91(i)
+		case 1472:
+			n += NoInline92(i)
+		case 1488:
+			n += NoInline93(i)
+		case 1504:
+			n += NoInline94(i)
+		case 1520:
+			n += NoInline95(i)
+		case 1536:
+			n += NoInline96(i)
+		case 1552:
+			n += NoInline97(i)
+		case 1568:
+			n += NoInline98(i)
+		case 1584:
+			n += NoInline99(i)
+		case 1600:
+			n += NoInline100(i)
+		case 1616:
+			n += NoInline101(i)
+		case 1632:
+			n += NoInline102(i)
+		case 1648:
+			n += NoInline103(i)
+		case 1664:
+			n += NoInline104(i)
+		case 1680:
+			n += NoInline105(i)
+		case 1696:
+			n += NoInline106(i)
+		case 1712:
+			n += NoInline107(i)
+		case 1728:
+			n += NoInline108(i)
+		case 1744:
+			n += NoInline109(i)
+		case 1760:
+			n += NoInline110(i)
+		case 1776:
+			n += NoInline111(i)
+		case 1792:
+			n += NoInline112(i)
+		case 1808:
+			n += NoInline113(i)
+		case 1824:
+			n += NoInline114(i)
+		case 1840:
+			n += NoInline115(i)
+		case 1856:
+			n += NoInline116(i)
+		case 1872:
+			n += NoInline117(i)
+		case 1888:
+			n += NoInline118(i)
+		case 1904:
+			n += NoInline119(i)
+		case 1920:
+			n += NoInline120(i)
+		case 1936:
+			n += NoInline121(i)
+		case 1952:
+			n += NoInline122(i)
+		case 1968:
+			n += NoInline123(i)
+		case 1984:
+			n += NoInline124(i)
+		case 2000:
+			n += NoInline125(i)
+		case 2016:
+			n += NoInline126(i)
+		case 2032:
+			n += NoInline127(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchNoInlineStridedComputed256(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysStrided[i%256] {
+		case 0:
+			n += NoInline0(i)
+		case 16:
+			n += NoInline1(i)
+		case 32:
+			n += NoInline2(i)
+		case 48:
+			n += NoInline3(i)
+		case 64:
+			n += NoInline4(i)
+		case 80:
+			n += NoInline5(i)
+		case 96:
+			n += NoInline6(i)
+		case 112:
+			n += NoInline7(i)
+		case 128:
+			n += NoInline8(i)
+		case 144:
+			n += NoInline9(i)
+		case 160:
+			n += NoInline10(i)
+		case 176:
+			n += NoInline11(i)
+		case 192:
+			n += NoInline12(i)
+		case 208:
+			n += NoInline13(i)
+		case 224:
+			n += NoInline14(i)
+		case 240:
+			n += NoInline15(i)
+		case 256:
+			n += NoInline16(i)
+		case 272:
+			n += NoInline17(i)
+		case 288:
+			n += NoInline18(i)
+		case 304:
+			n += NoInline19(i)
+		case 320:
+			n += NoInline20(i)
+		case 336:
+			n += NoInline21(i)
+		case 352:
+			n += NoInline22(i)
+		case 368:
+			n += NoInline23(i)
+		case 384:
+			n += NoInline24(i)
+		case 400:
+			n += NoInline25(i)
+		case 416:
+			n += NoInline26(i)
+		case 432:
+			n += NoInline27(i)
+		case 448:
+			n += NoInline28(i)
+		case 464:
+			n += NoInline29(i)
+		case 480:
+			n += NoInline30(i)
+		case 496:
+			n += NoInline31(i)
+		case 512:
+			n += NoInline32(i)
+		case 528:
+			n += NoInline33(i)
+		case 544:
+			n += NoInline34(i)
+		case 560:
+			n += NoInline35(i)
+		case 576:
+			n += NoInline36(i)
+		case 592:
+			n += NoInline37(i)
+		case 608:
+			n += NoInline38(i)
+		case 624:
+			n += NoInline39(i)
+		case 640:
+			n += NoInline40(i)
+		case 656:
+			n += NoInline41(i)
+		case 672:
+			n += NoInline42(i)
+		case 688:
+			n += NoInline43(i)
+		case 704:
+			n += NoInline44(i)
+		case 720:
+			n += NoInline45(i)
+		case 736:
+			n += NoInline46(i)
+		case 752:
+			n += NoInline47(i)
+		case 768:
+			n += NoInline48(i)
+		case 784:
+			n += NoInline49(i)
+		case 800:
+			n += NoInline50(i)
+		case 816:
+			n += NoInline51(i)
+		case 832:
+			n += NoInline52(i)
+		case 848:
+			n += NoInline53(i)
+		case 864:
+			n += NoInline54(i)
+		case 880:
+			n += NoInline55(i)
+		case 896:
+			n += NoInline56(i)
+		case 912:
+			n += NoInline57(i)
+		case 928:
+			n += NoInline58(i)
+		case 944:
+			n += NoInline59(i)
+		case 960:
+			n += NoInline60(i)
+		case 976:
+			n += NoInline61(i)
+		case 992:
+			n += NoInline62(i)
+		case 1008:
+			n += NoInline63(i)
+		case 1024:
+			n += NoInline64(i)
+		case 1040:
+			n += NoInline65(i)
+		case 1056:
+			n += NoInline66(i)
+		case 1072:
+			n += NoInline67(i)
+		case 1088:
+			n += NoInline68(i)
+		case 1104:
+			n += NoInline69(i)
+		case 1120:
+			n += NoInline70(i)
+		case 1136:
+			n += NoInline71(i)
+		case 1152:
+			n += NoInline72(i)
+		case 1168:
+			n += NoInline73(i)
+		case 1184:
+			n += NoInline74(i)
+		case 1200:
+			n += NoInline75(i)
+		case 1216:
+			n += NoInline76(i)
+		case 1232:
+			n += NoInline77(i)
+		case 1248:
+			n += NoInline78(i)
+		case 1264:
+			n += NoInline79(i)
+		case 1280:
+			n += NoInline80(i)
+		case 1296:
+			n += NoInline81(i)
+		case 1312:
+			n += NoInline82(i)
+		case 1328:
+			n += NoInline83(i)
+		case 1344:
+			n += NoInline84(i)
+		case 1360:
+			n += NoInline85(i)
+		case 1376:
+			n += NoInline86(i)
+		case 1392:
+			n += NoInline87(i)
+		case 1408:
+			n += NoInline88(i)
+		case 1424:
+			n += NoInline89(i)
+		case 1440:
+			n += NoInline90(i)
+		case 1456:
+			n += NoInline91(i)
+		case 1472:
+			n += NoInline92(i)
+		case 1488:
+			n += NoInline93(i)
+		case 1504:
+			n += NoInline94(i)
+		case 1520:
+			n += NoInline95(i)
+		case 1536:
+			n += NoInline96(i)
+		case 1552:
+			n += NoInline97(i)
+		case 1568:
+			n += NoInline98(i)
+		case 1584:
+			n += NoInline99(i)
+		case 1600:
+			n += NoInline100(i)
+		case 1616:
+			n += NoInline101(i)
+		case 1632:
+			n += NoInline102(i)
+		case 1648:
+			n += NoInline103(i)
+		case 1664:
+			n += NoInline104(i)
+		case 1680:
+			n += NoInline105(i)
+		case 1696:
+			n += NoInline106(i)
+		case 1712:
+			n += NoInline107(i)
+		case 1728:
+			n += NoInline108(i)
+		case 1744:
+			n += NoInline109(i)
+		case 1760:
+			n += NoInline110(i)
+		case 1776:
+			n += NoInline111(i)
+		case 1792:
+			n += NoInline112(i)
+		case 1808:
+			n += NoInline113(i)
+		case 1824:
+			n += NoInline114(i)
+		case 1840:
+			n += NoInline115(i)
+		case 1856:
+			n += NoInline116(i)
+		case 1872:
+			n += NoInline117(i)
+		case 1888:
+			n += NoInline118(i)
+		case 1904:
+			n += NoInline119(i)
+		case 1920:
+			n += NoInline120(i)
+		case 1936:
+			n += NoInline121(i)
+		case 1952:
+			n += NoInline122(i)
+		case 1968:
+			n += NoInline123(i)
+		case 1984:
+			n += NoInline124(i)
+		case 2000:
+			n += NoInline125(i)
+		case 2016:
+			n += NoInline126(i)
+		case 2032:
+			n += NoInline127(i)
+		case 2048:
+			n += NoInline128(i)
+		case 2064:
+			n += NoInline129(i)
+		case 2080:
+			n += NoInline130(i)
+		case 2096:
+			n += NoInline131(i)
+		case 2112:
+			n += NoInline132(i)
+		case 2128:
+			n += NoInline133(i)
+		case 2144:
+			n += NoInline134(i)
+		case 2160:
+			n += NoInline135(i)
+		case 2176:
+			n += NoInline136(i)
+		case 2192:
+			n += NoInline137(i)
+		case 2208:
+			n += NoInline138(i)
+		case 2224:
+			n += NoInline139(i)
+		case 2240:
+			n += NoInline140(i)
+		case 2256:
+			n += NoInline141(i)
+		case 2272:
+			n += NoInline142(i)
+		case 2288:
+			n += NoInline143(i)
+		case 2304:
+			n += NoInline144(i)
+		case 2320:
+			n += NoInline145(i)
+		case 2336:
+			n += NoInline146(i)
+		case 2352:
+			n += NoInline147(i)
+		case 2368:
+			n += NoInline148(i)
+		case 2384:
+			n += NoInline149(i)
+		case 2400:
+			n += NoInline150(i)
+		case 2416:
+			n += NoInline151(i)
+		case 2432:
+			n += NoInline152(i)
+		case 2448:
+			n += NoInline153(i)
+		case 2464:
+			n += NoInline154(i)
+		case 2480:
+			n += NoInline155(i)
+		case 2496:
+			n += NoInline156(i)
+		case 2512:
+			n += NoInline157(i)
+		case 2528:
+			n += NoInline158(i)
+		case 2544:
+			n += NoInline159(i)
+		case 2560:
+			n += NoInline160(i)
+		case 2576:
+			n += NoInline161(i)
+		case 2592:
+			n += NoInline162(i)
+		case 2608:
+			n += NoInline163(i)
+		case 2624:
+			n += NoInline164(i)
+		case 2640:
+			n += NoInline165(i)
+		case 2656:
+			n += NoInline166(i)
+		case 2672:
+			n += NoInline167(i)
+		case 2688:
+			n += NoInline168(i)
+		case 2704:
+			n += NoInline169(i)
+		case 2720:
+			n += NoInline170(i)
+		case 2736:
+			n += NoInline171(i)
+		case 2752:
+			n += NoInline172(i)
+		case 2768:
+			n += NoInline173(i)
+		case 2784:
+			n += NoInline174(i)
+		case 2800:
+			n += NoInline175(i)
+		case 2816:
+			n += NoInline176(i)
+		case 2832:
+			n += NoInline177(i)
+		case 2848:
+			n += NoInline178(i)
+		case 2864:
+			n += NoInline179(i)
+		case 2880:
+			n += NoInline180(i)
+		case 2896:
+			n += NoInline181(i)
+		case 2912:
+			n += NoInline182(i)
+		case 2928:
+			n += NoInline183(i)
+		case 2944:
+			n += NoInline184(i)
+		case 2960:
+			n += NoInline185(i)
+		case 2976:
+			n += NoInline186(i)
+		case 2992:
+			n += NoInline187(i)
+		case 3008:
+			n += NoInline188(i)
+		case 3024:
+			n += NoInline189(i)
+		case 3040:
+			n += NoInline190(i)
+		case 3056:
+			n += NoInline191(i)
+		case 3072:
+			n += NoInline192(i)
+		case 3088:
+			n += NoInline193(i)
+		case 3104:
+			n += NoInline194(i)
+		case 3120:
+			n += NoInline195(i)
+		case 3136:
+			n += NoInline196(i)
+		case 3152:
+			n += NoInline197(i)
+		case 3168:
+			n += NoInline198(i)
+		case 3184:
+			n += NoInline199(i)
+		case 3200:
+			n += NoInline200(i)
+		case 3216:
+			n += NoInline201(i)
+		case 3232:
+			n += NoInline202(i)
+		case 3248:
+			n += NoInline203(i)
+		case 3264:
+			n += NoInline204(i)
+		case 3280:
+			n += NoInline205(i)
+		case 3296:
+			n += NoInline206(i)
+		case 3312:
+			n += NoInline207(i)
+		case 3328:
+			n += NoInline208(i)
+		case 3344:
+			n += NoInline209(i)
+		case 3360:
+			n += NoInline210(i)
+		case 3376:
+			n += NoInline211(i)
+		case 3392:
+			n += NoInline212(i)
+		case 3408:
+			n += NoInline213(i)
+		case 3424:
+			n += NoInline214(i)
+		case 3440:
+			n += NoInline215(i)
+		case 3456:
+			n += NoInline216(i)
+		case 3472:
+			n += NoInline217(i)
+		case 3488:
+			n += NoInline218(i)
+		case 3504:
+			n += NoInline219(i)
+		case 3520:
+			n += NoInline220(i)
+		case 3536:
+			n += NoInline221(i)
+		case 3552:
+			n += NoInline222(i)
+		case 3568:
+			n += NoInline223(i)
+		case 3584:
+			n += NoInline224(i)
+		case 3600:
+			n += NoInline225(i)
+		case 3616:
+			n += NoInline226(i)
+		case 3632:
+			n += NoInline227(i)
+		case 3648:
+			n += NoInline228(i)
+		case 3664:
+			n += NoInline229(i)
+		case 3680:
+			n += NoInline230(i)
+		case 3696:
+			n += NoInline231(i)
+		case 3712:
+			n += NoInline232(i)
+		case 3728:
+			n += NoInline233(i)
+		case 3744:
+			n += NoInline234(i)
+		case 3760:
+			n += NoInline235(i)
+		case 3776:
+			n += NoInline236(i)
+		case 3792:
+			n += NoInline237(i)
+		case 3808:
+			n += NoInline238(i)
+		case 3824:
+			n += NoInline239(i)
+		case 3840:
+			n += NoInline240(i)
+		case 3856:
+			n += NoInline241(i)
+		case 3872:
+			n += NoInline242(i)
+		case 3888:
+			n += NoInline243(i)
+		case 3904:
+			n += NoInline244(i)
+		case 3920:
+			n += NoInline245(i)
+		case 3936:
+			n += NoInline246(i)
+		case 3952:
+			n += NoInline247(i)
+		case 3968:
+			n += NoInline248(i)
+		case 3984:
+			n += NoInline249(i)
+		case 4000:
+			n += NoInline250(i)
+		case 4016:
+			n += NoInline251(i)
+		case 4032:
+			n += NoInline252(i)
+		case 4048:
+			n += NoInline253(i)
+		case 4064:
+			n += NoInline254(i)
+		case 4080:
+			n += NoInline255(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchNoInlineStridedLookupMod256(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysStrided[inputs[i%len(inputs)]] {
+		case 0:
+			n += NoInline0(i)
+		case 16:
+			n += NoInline1(i)
+		case 32:
+			n += NoInline2(i)
+		case 48:
+			n += NoInline3(i)
+		case 64:
+			n += NoInline4(i)
+		case 80:
+			n += NoInline5(i)
+		case 96:
+			n += NoInline6(i)
+		case 112:
+			n += NoInline7(i)
+		case 128:
+			n += NoInline8(i)
+		case 144:
+			n += NoInline9(i)
+		case 160:
+			n += NoInline10(i)
+		case 176:
+			n += NoInline11(i)
+		case 192:
+			n += NoInline12(i)
+		case 208:
+			n += NoInline13(i)
+		case 224:
+			n += NoInline14(i)
+		case 240:
+			n += NoInline15(i)
+		case 256:
+			n += NoInline16(i)
+		case 272:
+			n += NoInline17(i)
+		case 288:
+			n += NoInline18(i)
+		case 304:
+			n += NoInline19(i)
+		case 320:
+			n += NoInline20(i)
+		case 336:
+			n += NoInline21(i)
+		case 352:
+			n += NoInline22(i)
+		case 368:
+			n += NoInline23(i)
+		case 384:
+			n += NoInline24(i)
+		case 400:
+			n += NoInline25(i)
+		case 416:
+			n += NoInline26(i)
+		case 432:
+			n += NoInline27(i)
+		case 448:
+			n += NoInline28(i)
+		case 464:
+			n += NoInline29(i)
+		case 480:
+			n += NoInline30(i)
+		case 496:
+			n += NoInline31(i)
+		case 512:
+			n += NoInline32(i)
+		case 528:
+			n += NoInline33(i)
+		case 544:
+			n += NoInline34(i)
+		case 560:
+			n += NoInline35(i)
+		case 576:
+			n += NoInline36(i)
+		case 592:
+			n += NoInline37(i)
+		case 608:
+			n += NoInline38(i)
+		case 624:
+			n += NoInline39(i)
+		case 640:
+			n += NoInline40(i)
+		case 656:
+			n += NoInline41(i)
+		case 672:
+			n += NoInline42(i)
+		case 688:
+			n += NoInline43(i)
+		case 704:
+			n += NoInline44(i)
+		case 720:
+			n += NoInline45(i)
+		case 736:
+			n += NoInline46(i)
+		case 752:
+			n += NoInline47(i)
+		case 768:
+			n += NoInline48(i)
+		case 784:
+			n += NoInline49(i)
+		case 800:
+			n += NoInline50(i)
+		case 816:
+			n += NoInline51(i)
+		case 832:
+			n += NoInline52(i)
+		case 848:
+			n += NoInline53(i)
+		case 864:
+			n += NoInline54(i)
+		case 880:
+			n += NoInline55(i)
+		case 896:
+			n += NoInline56(i)
+		case 912:
+			n += NoInline57(i)
+		case 928:
+			n += NoInline58(i)
+		case 944:
+			n += NoInline59(i)
+		case 960:
+			n += NoInline60(i)
+		case 976:
+			n += NoInline61(i)
+		case 992:
+			n += NoInline62(i)
+		case 1008:
+			n += NoInline63(i)
+		case 1024:
+			n += NoInline64(i)
+		case 1040:
+			n += NoInline65(i)
+		case 1056:
+			n += NoInline66(i)
+		case 1072:
+			n += NoInline67(i)
+		case 1088:
+			n += NoInline68(i)
+		case 1104:
+			n += NoInline69(i)
+		case 1120:
+			n += NoInline70(i)
+		case 1136:
+			n += NoInline71(i)
+		case 1152:
+			n += NoInline72(i)
+		case 1168:
+			n += NoInline73(i)
+		case 1184:
+			n += NoInline74(i)
+		case 1200:
+			n += NoInline75(i)
+		case 1216:
+			n += NoInline76(i)
+		case 1232:
+			n += NoInline77(i)
+		case 1248:
+			n += NoInline78(i)
+		case 1264:
+			n += NoInline79(i)
+		case 1280:
+			n += NoInline80(i)
+		case 1296:
+			n += NoInline81(i)
+		case 1312:
+			n += NoInline82(i)
+		case 1328:
+			n += NoInline83(i)
+		case 1344:
+			n += NoInline84(i)
+		case 1360:
+			n += NoInline85(i)
+		case 1376:
+			n += NoInline86(i)
+		case 1392:
+			n += NoInline87(i)
+		case 1408:
+			n += NoInline88(i)
+		case 1424:
+			n += NoInline89(i)
+		case 1440:
+			n += NoInline90(i)
+		case 1456:
+			n += NoInline91(i)
+		case 1472:
+			n += NoInline92(i)
+		case 1488:
+			n += NoInline93(i)
+		case 1504:
+			n += NoInline94(i)
+		case 1520:
+			n += NoInline95(i)
+		case 1536:
+			n += NoInline96(i)
+		case 1552:
+			n += NoInline97(i)
+		case 1568:
+			n += NoInline98(i)
+		case 1584:
+			n += NoInline99(i)
+		case 1600:
+			n += NoInline100(i)
+		case 1616:
+			n += NoInline101(i)
+		case 1632:
+			n += NoInline102(i)
+		case 1648:
+			n += NoInline103(i)
+		case 1664:
+			n += NoInline104(i)
+		case 1680:
+			n += NoInline105(i)
+		case 1696:
+			n += NoInline106(i)
+		case 1712:
+			n += NoInline107(i)
+		case 1728:
+			n += NoInline108(i)
+		case 1744:
+			n += NoInline109(i)
+		case 1760:
+			n += NoInline110(i)
+		case 1776:
+			n += NoInline111(i)
+		case 1792:
+			n += NoInline112(i)
+		case 1808:
+			n += NoInline113(i)
+		case 1824:
+			n += NoInline114(i)
+		case 1840:
+			n += NoInline115(i)
+		case 1856:
+			n += NoInline116(i)
+		case 1872:
+			n += NoInline117(i)
+		case 1888:
+			n += NoInline118(i)
+		case 1904:
+			n += NoInline119(i)
+		case 1920:
+			n += NoInline120(i)
+		case 1936:
+			n += NoInline121(i)
+		case 1952:
+			n += NoInline122(i)
+		case 1968:
+			n += NoInline123(i)
+		case 1984:
+			n += NoInline124(i)
+		case 2000:
+			n += NoInline125(i)
+		case 2016:
+			n += NoInline126(i)
+		case 2032:
+			n += NoInline127(i)
+		case 2048:
+			n += NoInline128(i)
+		case 2064:
+			n += NoInline129(i)
+		case 2080:
+			n += NoInline130(i)
+		case 2096:
+			n += NoInline131(i)
+		case 2112:
+			n += NoInline132(i)
+		case 2128:
+			n += NoInline133(i)
+		case 2144:
+			n += NoInline134(i)
+		case 2160:
+			n += NoInline135(i)
+		case 2176:
+			n += NoInline136(i)
+		case 2192:
+			n += NoInline137(i)
+		case 2208:
+			n += NoInline138(i)
+		case 2224:
+			n += NoInline139(i)
+		case 2240:
+			n += NoInline140(i)
+		case 2256:
+			n += NoInline141(i)
+		case 2272:
+			n += NoInline142(i)
+		case 2288:
+			n += NoInline143(i)
+		case 2304:
+			n += NoInline144(i)
+		case 2320:
+			n += NoInline145(i)
+		case 2336:
+			n += NoInline146(i)
+		case 2352:
+			n += NoInline147(i)
+		case 2368:
+			n += NoInline148(i)
+		case 2384:
+			n += NoInline149(i)
+		case 2400:
+			n += NoInline150(i)
+		case 2416:
+			n += NoInline151(i)
+		case 2432:
+			n += NoInline152(i)
+		case 2448:
+			n += NoInline153(i)
+		case 2464:
+			n += NoInline154(i)
+		case 2480:
+			n += NoInline155(i)
+		case 2496:
+			n += NoInline156(i)
+		case 2512:
+			n += NoInline157(i)
+		case 2528:
+			n += NoInline158(i)
+		case 2544:
+			n += NoInline159(i)
+		case 2560:
+			n += NoInline160(i)
+		case 2576:
+			n += NoInline161(i)
+		case 2592:
+			n += NoInline162(i)
+		case 2608:
+			n += NoInline163(i)
+		case 2624:
+			n += NoInline164(i)
+		case 2640:
+			n += NoInline165(i)
+		case 2656:
+			n += NoInline166(i)
+		case 2672:
+			n += NoInline167(i)
+		case 2688:
+			n += NoInline168(i)
+		case 2704:
+			n += NoInline169(i)
+		case 2720:
+			n += NoInline170(i)
+		case 2736:
+			n += NoInline171(i)
+		case 2752:
+			n += NoInline172(i)
+		case 2768:
+			n += NoInline173(i)
+		case 2784:
+			n += NoInline174(i)
+		case 2800:
+			n += NoInline175(i)
+		case 2816:
+			n += NoInline176(i)
+		case 2832:
+			n += NoInline177(i)
+		case 2848:
+			n += NoInline178(i)
+		case 2864:
+			n += NoInline179(i)
+		case 2880:
+			n += NoInline180(i)
+		case 2896:
+			n += NoInline181(i)
+		case 2912:
+			n += NoInline182(i)
+		case 2928:
+			n += NoInline183(i)
+		case 2944:
+			n += NoInline184(i)
+		case 2960:
+			n += NoInline185(i)
+		case 2976:
+			n += NoInline186(i)
+		case 2992:
+			n += NoInline187(i)
+		case 3008:
+			n += NoInline188(i)
+		case 3024:
+			n += NoInline189(i)
+		case 3040:
+			n += NoInline190(i)
+		case 3056:
+			n += NoInline191(i)
+		case 3072:
+			n += NoInline192(i)
+		case 3088:
+			n += NoInline193(i)
+		case 3104:
+			n += NoInline194(i)
+		case 3120:
+			n += NoInline195(i)
+		case 3136:
+			n += NoInline196(i)
+		case 3152:
+			n += NoInline197(i)
+		case 3168:
+			n += NoInline198(i)
+		case 3184:
+			n += NoInline199(i)
+		case 3200:
+			n += NoInline200(i)
+		case 3216:
+			n += NoInline201(i)
+		case 3232:
+			n += NoInline202(i)
+		case 3248:
+			n += NoInline203(i)
+		case 3264:
+			n += NoInline204(i)
+		case 3280:
+			n += NoInline205(i)
+		case 3296:
+			n += NoInline206(i)
+		case 3312:
+			n += NoInline207(i)
+		case 3328:
+			n += NoInline208(i)
+		case 3344:
+			n += NoInline209(i)
+		case 3360:
+			n += NoInline210(i)
+		case 3376:
+			n += NoInline211(i)
+		case 3392:
+			n += NoInline212(i)
+		case 3408:
+			n += NoInline213(i)
+		case 3424:
+			n += NoInline214(i)
+		case 3440:
+			n += NoInline215(i)
+		case 3456:
+			n += NoInline216(i)
+		case 3472:
+			n += NoInline217(i)
+		case 3488:
+			n += NoInline218(i)
+		case 3504:
+			n += NoInline219(i)
+		case 3520:
+			n += NoInline220(i)
+		case 3536:
+			n += NoInline221(i)
+		case 3552:
+			n += NoInline222(i)
+		case 3568:
+			n += NoInline223(i)
+		case 3584:
+			n += NoInline224(i)
+		case 3600:
+			n += NoInline225(i)
+		case 3616:
+			n += NoInline226(i)
+		case 3632:
+			n += NoInline227(i)
+		case 3648:
+			n += NoInline228(i)
+		case 3664:
+			n += NoInline229(i)
+		case 3680:
+			n += NoInline230(i)
+		case 3696:
+			n += NoInline231(i)
+		case 3712:
+			n += NoInline232(i)
+		case 3728:
+			n += NoInline233(i)
+		case 3744:
+			n += NoInline234(i)
+		case 3760:
+			n += NoInline235(i)
+		case 3776:
+			n += NoInline236(i)
+		case 3792:
+			n += NoInline237(i)
+		case 3808:
+			n += NoInline238(i)
+		case 3824:
+			n += NoInline239(i)
+		case 3840:
+			n += NoInline240(i)
+		case 3856:
+			n += NoInline241(i)
+		case 3872:
+			n += NoInline242(i)
+		case 3888:
+			n += NoInline243(i)
+		case 3904:
+			n += NoInline244(i)
+		case 3920:
+			n += NoInline245(i)
+		case 3936:
+			n += NoInline246(i)
+		case 3952:
+			n += NoInline247(i)
+		case 3968:
+			n += NoInline248(i)
+		case 3984:
+			n += NoInline249(i)
+		case 4000:
+			n += NoInline250(i)
+		case 4016:
+			n += NoInline251(i)
+		case 4032:
+			n += NoInline252(i)
+		case 4048:
+			n += NoInline253(i)
+		case 4064:
+			n += NoInline254(i)
+		case 4080:
+			n += NoInline255(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchNoInlineStridedComputed512(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysStrided[i%512] {
+		case 0:
+			n += NoInline0(i)
+		case 16:
+			n += NoInline1(i)
+		case 32:
+			n += NoInline2(i)
+		case 48:
+			n += NoInline3(i)
+		case 64:
+			n += NoInline4(i)
+		case 80:
+			n += NoInline5(i)
+		case 96:
+			n += NoInline6(i)
+		case 112:
+			n += NoInline7(i)
+		case 128:
+			n += NoInline8(i)
+		case 144:
+			n += NoInline9(i)
+		case 160:
+			n += NoInline10(i)
+		case 176:
+			n += NoInline11(i)
+		case 192:
+			n += NoInline12(i)
+		case 208:
+			n += NoInline13(i)
+		case 224:
+			n += NoInline14(i)
+		case 240:
+			n += NoInline15(i)
+		case 256:
+			n += NoInline16(i)
+		case 272:
+			n += NoInline17(i)
+		case 288:
+			n += NoInline18(i)
+		case 304:
+			n += NoInline19(i)
+		case 320:
+			n += NoInline20(i)
+		case 336:
+			n += NoInline21(i)
+		case 352:
+			n += NoInline22(i)
+		case 368:
+			n += NoInline23(i)
+		case 384:
+			n += NoInline24(i)
+		case 400:
+			n += NoInline25(i)
+		case 416:
+			n += NoInline26(i)
+		case 432:
+			n += NoInline27(i)
+		case 448:
+			n += NoInline28(i)
+		case 464:
+			n += NoInline29(i)
+		case 480:
+			n += NoInline30(i)
+		case 496:
+			n += NoInline31(i)
+		case 512:
+			n += NoInline32(i)
+		case 528:
+			n += NoInline33(i)
+		case 544:
+			n += NoInline34(i)
+		case 560:
+			n += NoInline35(i)
+		case 576:
+			n += NoInline36(i)
+		case 592:
+			n += NoInline37(i)
+		case 608:
+			n += NoInline38(i)
+		case 624:
+			n += NoInline39(i)
+		case 640:
+			n += NoInline40(i)
+		case 656:
+			n += NoInline41(i)
+		case 672:
+			n += NoInline42(i)
+		case 688:
+			n += NoInline43(i)
+		case 704:
+			n += NoInline44(i)
+		case 720:
+			n += NoInline45(i)
+		case 736:
+			n += NoInline46(i)
+		case 752:
+			n += NoInline47(i)
+		case 768:
+			n += NoInline48(i)
+		case 784:
+			n += NoInline49(i)
+		case 800:
+			n += NoInline50(i)
+		case 816:
+			n += NoInline51(i)
+		case 832:
+			n += NoInline52(i)
+		case 848:
+			n += NoInline53(i)
+		case 864:
+			n += NoInline54(i)
+		case 880:
+			n += NoInline55(i)
+		case 896:
+			n += NoInline56(i)
+		case 912:
+			n += NoInline57(i)
+		case 928:
+			n += NoInline58(i)
+		case 944:
+			n += NoInline59(i)
+		case 960:
+			n += NoInline60(i)
+		case 976:
+			n += NoInline61(i)
+		case 992:
+			n += NoInline62(i)
+		case 1008:
+			n += NoInline63(i)
+		case 1024:
+			n += NoInline64(i)
+		case 1040:
+			n += NoInline65(i)
+		case 1056:
+			n += NoInline66(i)
+		case 1072:
+			n += NoInline67(i)
+		case 1088:
+			n += NoInline68(i)
+		case 1104:
+			n += NoInline69(i)
+		case 1120:
+			n += NoInline70(i)
+		case 1136:
+			n += NoInline71(i)
+		case 1152:
+			n += NoInline72(i)
+		case 1168:
+			n += NoInline73(i)
+		case 1184:
+			n += NoInline74(i)
+		case 1200:
+			n += NoInline75(i)
+		case 1216:
+			n += NoInline76(i)
+		case 1232:
+			n += NoInline77(i)
+		case 1248:
+			n += NoInline78(i)
+		case 1264:
+			n += NoInline79(i)
+		case 1280:
+			n += NoInline80(i)
+		case 1296:
+			n += NoInline81(i)
+		case 1312:
+			n += NoInline82(i)
+		case 1328:
+			n += NoInline83(i)
+		case 1344:
+			n += NoInline84(i)
+		case 1360:
+			n += NoInline85(i)
+		case 1376:
+			n += NoInline86(i)
+		case 1392:
+			n += NoInline87(i)
+		case 1408:
+			n += NoInline88(i)
+		case 1424:
+			n += NoInline89(i)
+		case 1440:
+			n += NoInline90(i)
+		case 1456:
+			n += NoInline91(i)
+		case 1472:
+			n += NoInline92(i)
+		case 1488:
+			n += NoInline93(i)
+		case 1504:
+			n += NoInline94(i)
+		case 1520:
+			n += NoInline95(i)
+		case 1536:
+			n += NoInline96(i)
+		case 1552:
+			n += NoInline97(i)
+		case 1568:
+			n += NoInline98(i)
+		case 1584:
+			n += NoInline99(i)
+		case 1600:
+			n += NoInline100(i)
+		case 1616:
+			n += NoInline101(i)
+		case 1632:
+			n += NoInline102(i)
+		case 1648:
+			n += NoInline103(i)
+		case 1664:
+			n += NoInline104(i)
+		case 1680:
+			n += NoInline105(i)
+		case 1696:
+			n += NoInline106(i)
+		case 1712:
+			n += NoInline107(i)
+		case 1728:
+			n += NoInline108(i)
+		case 1744:
+			n += NoInline109(i)
+		case 1760:
+			n += NoInline110(i)
+		case 1776:
+			n += NoInline111(i)
+		case 1792:
+			n += NoInline112(i)
+		case 1808:
+			n += NoInline113(i)
+		case 1824:
+			n += NoInline114(i)
+		case 1840:
+			n += NoInline115(i)
+		case 1856:
+			n += NoInline116(i)
+		case 1872:
+			n += NoInline117(i)
+		case 1888:
+			n += NoInline118(i)
+		case 1904:
+			n += NoInline119(i)
+		case 1920:
+			n += NoInline120(i)
+		case 1936:
+			n += NoInline121(i)
+		case 1952:
+			n += NoInline122(i)
+		case 1968:
+			n += NoInline123(i)
+		case 1984:
+			n += NoInline124(i)
+		case 2000:
+			n += NoInline125(i)
+		case 2016:
+			n += NoInline126(i)
+		case 2032:
+			n += NoInline127(i)
+		case 2048:
+			n += NoInline128(i)
+		case 2064:
+			n += NoInline129(i)
+		case 2080:
+			n += NoInline130(i)
+		case 2096:
+			n += NoInline131(i)
+		case 2112:
+			n += NoInline132(i)
+		case 2128:
+			n += NoInline133(i)
+		case 2144:
+			n += NoInline134(i)
+		case 2160:
+			n += NoInline135(i)
+		case 2176:
+			n += NoInline136(i)
+		case 2192:
+			n += NoInline137(i)
+		case 2208:
+			n += NoInline138(i)
+		case 2224:
+			n += NoInline139(i)
+		case 2240:
+			n += NoInline140(i)
+		case 2256:
+			n += NoInline141(i)
+		case 2272:
+			n += NoInline142(i)
+		case 2288:
+			n += NoInline143(i)
+		case 2304:
+			n += NoInline144(i)
+		case 2320:
+			n += NoInline145(i)
+		case 2336:
+			n += NoInline146(i)
+		case 2352:
+			n += NoInline147(i)
+		case 2368:
+			n += NoInline148(i)
+		case 2384:
+			n += NoInline149(i)
+		case 2400:
+			n += NoInline150(i)
+		case 2416:
+			n += NoInline151(i)
+		case 2432:
+			n += NoInline152(i)
+		case 2448:
+			n += NoInline153(i)
+		case 2464:
+			n += NoInline154(i)
+		case 2480:
+			n += NoInline155(i)
+		case 2496:
+			n += NoInline156(i)
+		case 2512:
+			n += NoInline157(i)
+		case 2528:
+			n += NoInline158(i)
+		case 2544:
+			n += NoInline159(i)
+		case 2560:
+			n += NoInline160(i)
+		case 2576:
+			n += NoInline161(i)
+		case 2592:
+			n += NoInline162(i)
+		case 2608:
+			n += NoInline163(i)
+		case 2624:
+			n += NoInline164(i)
+		case 2640:
+			n += NoInline165(i)
+		case 2656:
+			n += NoInline166(i)
+		case 2672:
+			n += NoInline167(i)
+		case 2688:
+			n += NoInline168(i)
+		case 2704:
+			n += NoInline169(i)
+		case 2720:
+			n += NoInline170(i)
+		case 2736:
+			n += NoInline171(i)
+		case 2752:
+			n += NoInline172(i)
+		case 2768:
+			n += NoInline173(i)
+		case 2784:
+			n += NoInline174(i)
+		case 2800:
+			n += NoInline175(i)
+		case 2816:
+			n += NoInline176(i)
+		case 2832:
+			n += NoInline177(i)
+		case 2848:
+			n += NoInline178(i)
+		case 2864:
+			n += NoInline179(i)
+		case 2880:
+			n += NoInline180(i)
+		case 2896:
+			n += NoInline181(i)
+		case 2912:
+			n += NoInline182(i)
+		case 2928:
+			n += NoInline183(i)
+		case 2944:
+			n += NoInline184(i)
+		case 2960:
+			n += NoInline185(i)
+		case 2976:
+			n += NoInline186(i)
+		case 2992:
+			n += NoInline187(i)
+		case 3008:
+			n += NoInline188(i)
+		case 3024:
+			n += NoInline189(i)
+		case 3040:
+			n += NoInline190(i)
+		case 3056:
+			n += NoInline191(i)
+		case 3072:
+			n += NoInline192(i)
+		case 3088:
+			n += NoInline193(i)
+		case 3104:
+			n += NoInline194(i)
+		case 3120:
+			n += NoInline195(i)
+		case 3136:
+			n += NoInline196(i)
+		case 3152:
+			n += NoInline197(i)
+		case 3168:
+			n += NoInline198(i)
+		case 3184:
+			n += NoInline199(i)
+		case 3200:
+			n += NoInline200(i)
+		case 3216:
+			n += NoInline201(i)
+		case 3232:
+			n += NoInline202(i)
+		case 3248:
+			n += NoInline203(i)
+		case 3264:
+			n += NoInline204(i)
+		case 3280:
+			n += NoInline205(i)
+		case 3296:
+			n += NoInline206(i)
+		case 3312:
+			n += NoInline207(i)
+		case 3328:
+			n += NoInline208(i)
+		case 3344:
+			n += NoInline209(i)
+		case 3360:
+			n += NoInline210(i)
+		case 3376:
+			n += NoInline211(i)
+		case 3392:
+			n += NoInline212(i)
+		case 3408:
+			n += NoInline213(i)
+		case 3424:
+			n += NoInline214(i)
+		case 3440:
+			n += NoInline215(i)
+		case 3456:
+			n += NoInline216(i)
+		case 3472:
+			n += NoInline217(i)
+		case 3488:
+			n += NoInline218(i)
+		case 3504:
+			n += NoInline219(i)
+		case 3520:
+			n += NoInline220(i)
+		case 3536:
+			n += NoInline221(i)
+		case 3552:
+			n += NoInline222(i)
+		case 3568:
+			n += NoInline223(i)
+		case 3584:
+			n += NoInline224(i)
+		case 3600:
+			n += NoInline225(i)
+		case 3616:
+			n += NoInline226(i)
+		case 3632:
+			n += NoInline227(i)
+		case 3648:
+			n += NoInline228(i)
+		case 3664:
+			n += NoInline229(i)
+		case 3680:
+			n += NoInline230(i)
+		case 3696:
+			n += NoInline231(i)
+		case 3712:
+			n += NoInline232(i)
+		case 3728:
+			n += NoInline233(i)
+		case 3744:
+			n += NoInline234(i)
+		case 3760:
+			n += NoInline235(i)
+		case 3776:
+			n += NoInline236(i)
+		case 3792:
+			n += NoInline237(i)
+		case 3808:
+			n += NoInline238(i)
+		case 3824:
+			n += NoInline239(i)
+		case 3840:
+			n += NoInline240(i)
+		case 3856:
+			n += NoInline241(i)
+		case 3872:
+			n += NoInline242(i)
+		case 3888:
+			n += NoInline243(i)
+		case 3904:
+			n += NoInline244(i)
+		case 3920:
+			n += NoInline245(i)
+		case 3936:
+			n += NoInline246(i)
+		case 3952:
+			n += NoInline247(i)
+		case 3968:
+			n += NoInline248(i)
+		case 3984:
+			n += NoInline249(i)
+		case 4000:
+			n += NoInline250(i)
+		case 4016:
+			n += NoInline251(i)
+		case 4032:
+			n += NoInline252(i)
+		case 4048:
+			n += NoInline253(i)
+		case 4064:
+			n += NoInline254(i)
+		case 4080:
+			n += NoInline255(i)
+		case 4096:
+			n += NoInline256(i)
+		case 4112:
+			n += NoInline257(i)
+		case 4128:
+			n += NoInline258(i)
+		case 4144:
+			n += NoInline259(i)
+		case 4160:
+			n += NoInline260(i)
+		case 4176:
+			n += NoInline261(i)
+		case 4192:
+			n += NoInline262(i)
+		case 4208:
+			n += NoInline263(i)
+		case 4224:
+			n += NoInline264(i)
+		case 4240:
+			n += NoInline265(i)
+		case 4256:
+			n += NoInline266(i)
+		case 4272:
+			n += NoInline267(i)
+		case 4288:
+			n += NoInline268(i)
+		case 4304:
+			n += NoInline269(i)
+		case 4320:
+			n += NoInline270(i)
+		case 4336:
+			n += NoInline271(i)
+		case 4352:
+			n += NoInline272(i)
+		case 4368:
+			n += NoInline273(i)
+		case 4384:
+			n += NoInline274(i)
+		case 4400:
+			n += NoInline275(i)
+		case 4416:
+			n += NoInline276(i)
+		case 4432:
+			n += NoInline277(i)
+		case 4448:
+			n += NoInline278(i)
+		case 4464:
+			n += NoInline279(i)
+		case 4480:
+			n += NoInline280(i)
+		case 4496:
+			n += NoInline281(i)
+		case 4512:
+			n += NoInline282(i)
+		case 4528:
+			n += NoInline283(i)
+		case 4544:
+			n += NoInline284(i)
+		case 4560:
+			n += NoInline285(i)
+		case 4576:
+			n += NoInline286(i)
+		case 4592:
+			n += NoInline287(i)
+		case 4608:
+			n += NoInline288(i)
+		case 4624:
+			n += NoInline289(i)
+		case 4640:
+			n += NoInline290(i)
+		case 4656:
+			n += NoInline291(i)
+		case 4672:
+			n += NoInline292(i)
+		case 4688:
+			n += NoInline293(i)
+		case 4704:
+			n += NoInline294(i)
+		case 4720:
+			n += NoInline295(i)
+		case 4736:
+			n += NoInline296(i)
+		case 4752:
+			n += NoInline297(i)
+		case 4768:
+			n += NoInline298(i)
+		case 4784:
+			n += NoInline299(i)
+		case 4800:
+			n += NoInline300(i)
+		case 4816:
+			n += NoInline301(i)
+		case 4832:
+			n += NoInline302(i)
+		case 4848:
+			n += NoInline303(i)
+		case 4864:
+			n += NoInline304(i)
+		case 4880:
+			n += NoInline305(i)
+		case 4896:
+			n += NoInline306(i)
+		case 4912:
+			n += NoInline307(i)
+		case 4928:
+			n += NoInline308(i)
+		case 4944:
+			n += NoInline309(i)
+		case 4960:
+			n += NoInline310(i)
+		case 4976:
+			n += NoInline311(i)
+		case 4992:
+			n += NoInline312(i)
+		case 5008:
+			n += NoInline313(i)
+		case 5024:
+			n += NoInline314(i)
+		case 5040:
+			n += NoInline315(i)
+		case 5056:
+			n += NoInline316(i)
+		case 5072:
+			n += NoInline317(i)
+		case 5088:
+			n += NoInline318(i)
+		case 5104:
+			n += NoInline319(i)
+		case 5120:
+			n += NoInline320(i)
+		case 5136:
+			n += NoInline321(i)
+		case 5152:
+			n += NoInline322(i)
+		case 5168:
+			n += NoInline323(i)
+		case 5184:
+			n += NoInline324(i)
+		case 5200:
+			n += NoInline325(i)
+		case 5216:
+			n += NoInline326(i)
+		case 5232:
+			n += NoInline327(i)
+		case 5248:
+			n += NoInline328(i)
+		case 5264:
+			n += NoInline329(i)
+		case 5280:
+			n += NoInline330(i)
+		case 5296:
+			n += NoInline331(i)
+		case 5312:
+			n += NoInline332(i)
+		case 5328:
+			n += NoInline333(i)
+		case 5344:
+			n += NoInline334(i)
+		case 5360:
+			n += NoInline335(i)
+		case 5376:
+			n += NoInline336(i)
+		case 5392:
+			n += NoInline337(i)
+		case 5408:
+			n += NoInline338(i)
+		case 5424:
+			n += NoInline339(i)
+		case 5440:
+			n += NoInline340(i)
+		case 5456:
+			n += NoInline341(i)
+		case 5472:
+			n += NoInline342(i)
+		case 5488:
+			n += NoInline343(i)
+		case 5504:
+			n += NoInline344(i)
+		case 5520:
+			n += NoInline345(i)
+		case 5536:
+			n += NoInline346(i)
+		case 5552:
+			n += NoInline347(i)
+		case 5568:
+			n += NoInline348(i)
+		case 5584:
+			n += NoInline349(i)
+		case 5600:
+			n += NoInline350(i)
+		case 5616:
+			n += NoInline351(i)
+		case 5632:
+			n += NoInline352(i)
+		case 5648:
+			n += NoInline353(i)
+		case 5664:
+			n += NoInline354(i)
+		case 5680:
+			n += NoInline355(i)
+		case 5696:
+			n += NoInline356(i)
+		case 5712:
+			n += NoInline357(i)
+		case 5728:
+			n += NoInline358(i)
+		case 5744:
+			n += NoInline359(i)
+		case 5760:
+			n += NoInline360(i)
+		case 5776:
+			n += NoInline361(i)
+		case 5792:
+			n += NoInline362(i)
+		case 5808:
+			n += NoInline363(i)
+		case 5824:
+			n += NoInline364(i)
+		case 5840:
+			n += NoInline365(i)
+		case 5856:
+			n += NoInline366(i)
+		case 5872:
+			n += NoInline367(i)
+		case 5888:
+			n += NoInline368(i)
+		case 5904:
+			n += NoInline369(i)
+		case 5920:
+			n += NoInline370(i)
+		case 5936:
+			n += NoInline371(i)
+		case 5952:
+			n += NoInline372(i)
+		case 5968:
+			n += NoInline373(i)
+		case 5984:
+			n += NoInline374(i)
+		case 6000:
+			n += NoInline375(i)
+		case 6016:
+			n += NoInline376(i)
+		case 6032:
+			n += NoInline377(i)
+		case 6048:
+			n += NoInline378(i)
+		case 6064:
+			n += NoInline379(i)
+		case 6080:
+			n += NoInline380(i)
+		case 6096:
+			n += NoInline381(i)
+		case 6112:
+			n += NoInline382(i)
+		case 6128:
+			n += NoInline383(i)
+		case 6144:
+			n += NoInline384(i)
+		case 6160:
+			n += NoInline385(i)
+		case 6176:
+			n += NoInline386(i)
+		case 6192:
+			n += NoInline387(i)
+		case 6208:
+			n += NoInline388(i)
+		case 6224:
+			n += NoInline389(i)
+		case 6240:
+			n += NoInline390(i)
+		case 6256:
+			n += NoInline391(i)
+		case 6272:
+			n += NoInline392(i)
+		case 6288:
+			n += NoInline393(i)
+		case 6304:
+			n += NoInline394(i)
+		case 6320:
+			n += NoInline395(i)
+		case 6336:
+			n += NoInline396(i)
+		case 6352:
+			n += NoInline397(i)
+		case 6368:
+			n += NoInline398(i)
+		case 6384:
+			n += NoInline399(i)
+		case 6400:
+			n += NoInline400(i)
+		case 6416:
+			n += NoInline401(i)
+		case 6432:
+			n += NoInline402(i)
+		case 6448:
+			n += NoInline403(i)
+		case 6464:
+			n += NoInline404(i)
+		case 6480:
+			n += NoInline405(i)
+		case 6496:
+			n += NoInline406(i)
+		case 6512:
+			n += NoInline407(i)
+		case 6528:
+			n += NoInline408(i)
+		case 6544:
+			n += NoInline409(i)
+		case 6560:
+			n += NoInline410(i)
+		case 6576:
+			n += NoInline411(i)
+		case 6592:
+			n += NoInline412(i)
+		case 6608:
+			n += NoInline413(i)
+		case 6624:
+			n += NoInline414(i)
+		case 6640:
+			n += NoInline415(i)
+		case 6656:
+			n += NoInline416(i)
+		case 6672:
+			n += NoInline417(i)
+		case 6688:
+			n += NoInline418(i)
+		case 6704:
+			n += NoInline419(i)
+		case 6720:
+			n += NoInline420(i)
+		case 6736:
+			n += NoInline421(i)
+		case 6752:
+			n += NoInline422(i)
+		case 6768:
+			n += NoInline423(i)
+		case 6784:
+			n += NoInline424(i)
+		case 6800:
+			n += NoInline425(i)
+		case 6816:
+			n += NoInline426(i)
+		case 6832:
+			n += NoInline427(i)
+		case 6848:
+			n += NoInline428(i)
+		case 6864:
+			n += NoInline429(i)
+		case 6880:
+			n += NoInline430(i)
+		case 6896:
+			n += NoInline431(i)
+		case 6912:
+			n += NoInline432(i)
+		case 6928:
+			n += NoInline433(i)
+		case 6944:
+			n += NoInline434(i)
+		case 6960:
+			n += NoInline435(i)
+		case 6976:
+			n += NoInline436(i)
+		case 6992:
+			n += NoInline437(i)
+		case 7008:
+			n += NoInline438(i)
+		case 7024:
+			n += NoInline439(i)
+		case 7040:
+			n += NoInline440(i)
+		case 7056:
+			n += NoInline441(i)
+		case 7072:
+			n += NoInline442(i)
+		case 7088:
+			n += NoInline443(i)
+		case 7104:
+			n += NoInline444(i)
+		case 7120:
+			n += NoInline445(i)
+		case 7136:
+			n += NoInline446(i)
+		case 7152:
+			n += NoInline447(i)
+		case 7168:
+			n += NoInline448(i)
+		case 7184:
+			n += NoInline449(i)
+		case 7200:
+			n += NoInline450(i)
+		case 7216:
+			n += NoInline451(i)
+		case 7232:
+			n += NoInline452(i)
+		case 7248:
+			n += NoInline453(i)
+		case 7264:
+			n += NoInline454(i)
+		case 7280:
+			n += NoInline455(i)
+		case 7296:
+			n += NoInline456(i)
+		case 7312:
+			n += NoInline457(i)
+		case 7328:
+			n += NoInline458(i)
+		case 7344:
+			n += NoInline459(i)
+		case 7360:
+			n += NoInline460(i)
+		case 7376:
+			n += NoInline461(i)
+		case 7392:
+			n += NoInline462(i)
+		case 7408:
+			n += NoInline463(i)
+		case 7424:
+			n += NoInline464(i)
+		case 7440:
+			n += NoInline465(i)
+		case 7456:
+			n += NoInline466(i)
+		case 7472:
+			n += NoInline467(i)
+		case 7488:
+			n += NoInline468(i)
+		case 7504:
+			n += NoInline469(i)
+		case 7520:
+			n += NoInline470(i)
+		case 7536:
+			n += NoInline471(i)
+		case 7552:
+			n += NoInline472(i)
+		case 7568:
+			n += NoInline473(i)
+		case 7584:
+			n += NoInline474(i)
+		case 7600:
+			n += NoInline475(i)
+		case 7616:
+			n += NoInline476(i)
+		case 7632:
+			n += NoInline477(i)
+		case 7648:
+			n += NoInline478(i)
+		case 7664:
+			n += NoInline479(i)
+		case 7680:
+			n += NoInline480(i)
+		case 7696:
+			n += NoInline481(i)
+		case 7712:
+			n += NoInline482(i)
+		case 7728:
+			n += NoInline483(i)
+		case 7744:
+			n += NoInline484(i)
+		case 7760:
+			n += NoInline485(i)
+		case 7776:
+			n += NoInline486(i)
+		case 7792:
+			n += NoInline487(i)
+		case 7808:
+			n += NoInline488(i)
+		case 7824:
+			n += NoInline489(i)
+		case 7840:
+			n += NoInline490(i)
+		case 7856:
+			n += NoInline491(i)
+		case 7872:
+			n += NoInline492(i)
+		case 7888:
+			n += NoInline493(i)
+		case 7904:
+			n += NoInline494(i)
+		case 7920:
+			n += NoInline495(i)
+		case 7936:
+			n += NoInline496(i)
+		case 7952:
+			n += NoInline497(i)
+		case 7968:
+			n += NoInline498(i)
+		case 7984:
+			n += NoInline499(i)
+		case 8000:
+			n += NoInline500(i)
+		case 8016:
+			n += NoInline501(i)
+		case 8032:
+			n += NoInline502(i)
+		case 8048:
+			n += NoInline503(i)
+		case 8064:
+			n += NoInline504(i)
+		case 8080:
+			n += NoInline505(i)
+		case 8096:
+			n += NoInline506(i)
+		case 8112:
+			n += NoInline507(i)
+		case 8128:
+			n += NoInline508(i)
+		case 8144:
+			n += NoInline509(i)
+		case 8160:
+			n += NoInline510(i)
+		case 8176:
+			n += NoInline511(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchNoInlineStridedLookupMod512(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysStrided[inputs[i%len(inputs)]] {
+		case 0:
+			n += NoInline0(i)
+		case 16:
+			n += NoInline1(i)
+		case 32:
+			n += NoInline2(i)
+		case 48:
+			n += NoInline3(i)
+		case 64:
+			n += NoInline4(i)
+		case 80:
+			n += NoInline5(i)
+		case 96:
+			n += NoInline6(i)
+		case 112:
+			n += NoInline7(i)
+		case 128:
+			n += NoInline8(i)
+		case 144:
+			n += NoInline9(i)
+		case 160:
+			n += NoInline10(i)
+		case 176:
+			n += NoInline11(i)
+		case 192:
+			n += NoInline12(i)
+		case 208:
+			n += NoInline13(i)
+		case 224:
+			n += NoInline14(i)
+		case 240:
+			n += NoInline15(i)
+		case 256:
+			n += NoInline16(i)
+		case 272:
+			n += NoInline17(i)
+		case 288:
+			n += NoInline18(i)
+		case 304:
+			n += NoInline19(i)
+		case 320:
+			n += NoInline20(i)
+		case 336:
+			n += NoInline21(i)
+		case 352:
+			n += NoInline22(i)
+		case 368:
+			n += NoInline23(i)
+		case 384:
+			n += NoInline24(i)
+		case 400:
+			n += NoInline25(i)
+		case 416:
+			n += NoInline26(i)
+		case 432:
+			n += NoInline27(i)
+		case 448:
+			n += NoInline28(i)
+		case 464:
+			n += NoInline29(i)
+		case 480:
+			n += NoInline30(i)
+		case 496:
+			n += NoInline31(i)
+		case 512:
+			n += NoInline32(i)
+		case 528:
+			n += NoInline33(i)
+		case 544:
+			n += NoInline34(i)
+		case 560:
+			n += NoInline35(i)
+		case 576:
+			n += NoInline36(i)
+		case 592:
+			n += NoInline37(i)
+		case 608:
+			n += NoInline38(i)
+		case 624:
+			n += NoInline39(i)
+		case 640:
+			n += NoInline40(i)
+		case 656:
+			n += NoInline41(i)
+		case 672:
+			n += NoInline42(i)
+		case 688:
+			n += NoInline43(i)
+		case 704:
+			n += NoInline44(i)
+		case 720:
+			n += NoInline45(i)
+		case 736:
+			n += NoInline46(i)
+		case 752:
+			n += NoInline47(i)
+		case 768:
+			n += NoInline48(i)
+		case 784:
+			n += NoInline49(i)
+		case 800:
+			n += NoInline50(i)
+		case 816:
+			n += NoInline51(i)
+		case 832:
+			n += NoInline52(i)
+		case 848:
+			n += NoInline53(i)
+		case 864:
+			n += NoInline54(i)
+		case 880:
+			n += NoInline55(i)
+		case 896:
+			n += NoInline56(i)
+		case 912:
+			n += NoInline57(i)
+		case 928:
+			n += NoInline58(i)
+		case 944:
+			n += NoInline59(i)
+		case 960:
+			n += NoInline60(i)
+		case 976:
+			n += NoInline61(i)
+		case 992:
+			n += NoInline62(i)
+		case 1008:
+			n += NoInline63(i)
+		case 1024:
+			n += NoInline64(i)
+		case 1040:
+			n += NoInline65(i)
+		case 1056:
+			n += NoInline66(i)
+		case 1072:
+			n += NoInline67(i)
+		case 1088:
+			n += NoInline68(i)
+		case 1104:
+			n += NoInline69(i)
+		case 1120:
+			n += NoInline70(i)
+		case 1136:
+			n += NoInline71(i)
+		case 1152:
+			n += NoInline72(i)
+		case 1168:
+			n += NoInline73(i)
+		case 1184:
+			n += NoInline74(i)
+		case 1200:
+			n += NoInline75(i)
+		case 1216:
+			n += NoInline76(i)
+		case 1232:
+			n += NoInline77(i)
+		case 1248:
+			n += NoInline78(i)
+		case 1264:
+			n += NoInline79(i)
+		case 1280:
+			n += NoInline80(i)
+		case 1296:
+			n += NoInline81(i)
+		case 1312:
+			n += NoInline82(i)
+		case 1328:
+			n += NoInline83(i)
+		case 1344:
+			n += NoInline84(i)
+		case 1360:
+			n += NoInline85(i)
+		case 1376:
+			n += NoInline86(i)
+		case 1392:
+			n += NoInline87(i)
+		case 1408:
+			n += NoInline88(i)
+		case 1424:
+			n += NoInline89(i)
+		case 1440:
+			n += NoInline90(i)
+		case 1456:
+			n += NoInline91(i)
+		case 1472:
+			n += NoInline92(i)
+		case 1488:
+			n += NoInline93(i)
+		case 1504:
+			n += NoInline94(i)
+		case 1520:
+			n += NoInline95(i)
+		case 1536:
+			n += NoInline96(i)
+		case 1552:
+			n += NoInline97(i)
+		case 1568:
+			n += NoInline98(i)
+		case 1584:
+			n += NoInline99(i)
+		case 1600:
+			n += NoInline100(i)
+		case 1616:
+			n += NoInline101(i)
+		case 1632:
+			n += NoInline102(i)
+		case 1648:
+			n += NoInline103(i)
+		case 1664:
+			n += NoInline104(i)
+		case 1680:
+			n += NoInline105(i)
+		case 1696:
+			n += NoInline106(i)
+		case 1712:
+			n += NoInline107(i)
+		case 1728:
+			n += NoInline108(i)
+		case 1744:
+			n += NoInline109(i)
+		case 1760:
+			n += NoInline110(i)
+		case 1776:
+			n += NoInline111(i)
+		case 1792:
+			n += NoInline112(i)
+		case 1808:
+			n += NoInline113(i)
+		case 1824:
+			n += NoInline114(i)
+		case 1840:
+			n += NoInline115(i)
+		case 1856:
+			n += NoInline116(i)
+		case 1872:
+			n += NoInline117(i)
+		case 1888:
+			n += NoInline118(i)
+		case 1904:
+			n += NoInline119(i)
+		case 1920:
+			n += NoInline120(i)
+		case 1936:
+			n += NoInline121(i)
+		case 1952:
+			n += NoInline122(i)
+		case 1968:
+			n += NoInline123(i)
+		case 1984:
+			n += NoInline124(i)
+		case 2000:
+			n += NoInline125(i)
+		case 2016:
+			n += NoInline126(i)
+		case 2032:
+			n += NoInline127(i)
+		case 2048:
+			n += NoInline128(i)
+		case 2064:
+			n += NoInline129(i)
+		case 2080:
+			n += NoInline130(i)
+		case 2096:
+			n += NoInline131(i)
+		case 2112:
+			n += NoInline132(i)
+		case 2128:
+			n += NoInline133(i)
+		case 2144:
+			n += NoInline134(i)
+		case 2160:
+			n += NoInline135(i)
+		case 2176:
+			n += NoInline136(i)
+		case 2192:
+			n += NoInline137(i)
+		case 2208:
+			n += NoInline138(i)
+		case 2224:
+			n += NoInline139(i)
+		case 2240:
+			n += NoInline140(i)
+		case 2256:
+			n += NoInline141(i)
+		case 2272:
+			n += NoInline142(i)
+		case 2288:
+			n += NoInline143(i)
+		case 2304:
+			n += NoInline144(i)
+		case 2320:
+			n += NoInline145(i)
+		case 2336:
+			n += NoInline146(i)
+		case 2352:
+			n += NoInline147(i)
+		case 2368:
+			n += NoInline148(i)
+		case 2384:
+			n += NoInline149(i)
+		case 2400:
+			n += NoInline150(i)
+		case 2416:
+			n += NoInline151(i)
+		case 2432:
+			n += NoInline152(i)
+		case 2448:
+			n += NoInline153(i)
+		case 2464:
+			n += NoInline154(i)
+		case 2480:
+			n += NoInline155(i)
+		case 2496:
+			n += NoInline156(i)
+		case 2512:
+			n += NoInline157(i)
+		case 2528:
+			n += NoInline158(i)
+		case 2544:
+			n += NoInline159(i)
+		case 2560:
+			n += NoInline160(i)
+		case 2576:
+			n += NoInline161(i)
+		case 2592:
+			n += NoInline162(i)
+		case 2608:
+			n += NoInline163(i)
+		case 2624:
+			n += NoInline164(i)
+		case 2640:
+			n += NoInline165(i)
+		case 2656:
+			n += NoInline166(i)
+		case 2672:
+			n += NoInline167(i)
+		case 2688:
+			n += NoInline168(i)
+		case 2704:
+			n += NoInline169(i)
+		case 2720:
+			n += NoInline170(i)
+		case 2736:
+			n += NoInline171(i)
+		case 2752:
+			n += NoInline172(i)
+		case 2768:
+			n += NoInline173(i)
+		case 2784:
+			n += NoInline174(i)
+		case 2800:
+			n += NoInline175(i)
+		case 2816:
+			n += NoInline176(i)
+		case 2832:
+			n += NoInline177(i)
+		case 2848:
+			n += NoInline178(i)
+		case 2864:
+			n += NoInline179(i)
+		case 2880:
+			n += NoInline180(i)
+		case 2896:
+			n += NoInline181(i)
+		case 2912:
+			n += NoInline182(i)
+		case 2928:
+			n += NoInline183(i)
+		case 2944:
+			n += NoInline184(i)
+		case 2960:
+			n += NoInline185(i)
+		case 2976:
+			n += NoInline186(i)
+		case 2992:
+			n += NoInline187(i)
+		case 3008:
+			n += NoInline188(i)
+		case 3024:
+			n += NoInline189(i)
+		case 3040:
+			n += NoInline190(i)
+		case 3056:
+			n += NoInline191(i)
+		case 3072:
+			n += NoInline192(i)
+		case 3088:
+			n += NoInline193(i)
+		case 3104:
+			n += NoInline194(i)
+		case 3120:
+			n += NoInline195(i)
+		case 3136:
+			n += NoInline196(i)
+		case 3152:
+			n += NoInline197(i)
+		case 3168:
+			n += NoInline198(i)
+		case 3184:
+			n += NoInline199(i)
+		case 3200:
+			n += NoInline200(i)
+		case 3216:
+			n += NoInline201(i)
+		case 3232:
+			n += NoInline202(i)
+		case 3248:
+			n += NoInline203(i)
+		case 3264:
+			n += NoInline204(i)
+		case 3280:
+			n += NoInline205(i)
+		case 3296:
+			n += NoInline206(i)
+		case 3312:
+			n += NoInline207(i)
+		case 3328:
+			n += NoInline208(i)
+		case 3344:
+			n += NoInline209(i)
+		case 3360:
+			n += NoInline210(i)
+		case 3376:
+			n += NoInline211(i)
+		case 3392:
+			n += NoInline212(i)
+		case 3408:
+			n += NoInline213(i)
+		case 3424:
+			n += NoInline214(i)
+		case 3440:
+			n += NoInline215(i)
+		case 3456:
+			n += NoInline216(i)
+		case 3472:
+			n += NoInline217(i)
+		case 3488:
+			n += NoInline218(i)
+		case 3504:
+			n += NoInline219(i)
+		case 3520:
+			n += NoInline220(i)
+		case 3536:
+			n += NoInline221(i)
+		case 3552:
+			n += NoInline222(i)
+		case 3568:
+			n += NoInline223(i)
+		case 3584:
+			n += NoInline224(i)
+		case 3600:
+			n += NoInline225(i)
+		case 3616:
+			n += NoInline226(i)
+		case 3632:
+			n += NoInline227(i)
+		case 3648:
+			n += NoInline228(i)
+		case 3664:
+			n += NoInline229(i)
+		case 3680:
+			n += NoInline230(i)
+		case 3696:
+			n += NoInline231(i)
+		case 3712:
+			n += NoInline232(i)
+		case 3728:
+			n += NoInline233(i)
+		case 3744:
+			n += NoInline234(i)
+		case 3760:
+			n += NoInline235(i)
+		case 3776:
+			n += NoInline236(i)
+		case 3792:
+			n += NoInline237(i)
+		case 3808:
+			n += NoInline238(i)
+		case 3824:
+			n += NoInline239(i)
+		case 3840:
+			n += NoInline240(i)
+		case 3856:
+			n += NoInline241(i)
+		case 3872:
+			n += NoInline242(i)
+		case 3888:
+			n += NoInline243(i)
+		case 3904:
+			n += NoInline244(i)
+		case 3920:
+			n += NoInline245(i)
+		case 3936:
+			n += NoInline246(i)
+		case 3952:
+			n += NoInline247(i)
+		case 3968:
+			n += NoInline248(i)
+		case 3984:
+			n += NoInline249(i)
+		case 4000:
+			n += NoInline250(i)
+		case 4016:
+			n += NoInline251(i)
+		case 4032:
+			n += NoInline252(i)
+		case 4048:
+			n += NoInline253(i)
+		case 4064:
+			n += NoInline254(i)
+		case 4080:
+			n += NoInline255(i)
+		case 4096:
+			n += NoInline256(i)
+		case 4112:
+			n += NoInline257(i)
+		case 4128:
+			n += NoInline258(i)
+		case 4144:
+			n += NoInline259(i)
+		case 4160:
+			n += NoInline260(i)
+		case 4176:
+			n += NoInline261(i)
+		case 4192:
+			n += NoInline262(i)
+		case 4208:
+			n += NoInline263(i)
+		case 4224:
+			n += NoInline264(i)
+		case 4240:
+			n += NoInline265(i)
+		case 4256:
+			n += NoInline266(i)
+		case 4272:
+			n += NoInline267(i)
+		case 4288:
+			n += NoInline268(i)
+		case 4304:
+			n += NoInline269(i)
+		case 4320:
+			n += NoInline270(i)
+		case 4336:
+			n += NoInline271(i)
+		case 4352:
+			n += NoInline272(i)
+		case 4368:
+			n += NoInline273(i)
+		case 4384:
+			n += NoInline274(i)
+		case 4400:
+			n += NoInline275(i)
+		case 4416:
+			n += NoInline276(i)
+		case 4432:
+			n += NoInline277(i)
+		case 4448:
+			n += NoInline278(i)
+		case 4464:
+			n += NoInline279(i)
+		case 4480:
+			n += NoInline280(i)
+		case 4496:
+			n += NoInline281(i)
+		case 4512:
+			n += NoInline282(i)
+		case 4528:
+			n += NoInline283(i)
+		case 4544:
+			n += NoInline284(i)
+		case 4560:
+			n += NoInline285(i)
+		case 4576:
+			n += NoInline286(i)
+		case 4592:
+			n += NoInline287(i)
+		case 4608:
+			n += NoInline288(i)
+		case 4624:
+			n += NoInline289(i)
+		case 4640:
+			n += NoInline290(i)
+		case 4656:
+			n += NoInline291(i)
+		case 4672:
+			n += NoInline292(i)
+		case 4688:
+			n += NoInline293(i)
+		case 4704:
+			n += NoInline294(i)
+		case 4720:
+			n += NoInline295(i)
+		case 4736:
+			n += NoInline296(i)
+		case 4752:
+			n += NoInline297(i)
+		case 4768:
+			n += NoInline298(i)
+		case 4784:
+			n += NoInline299(i)
+		case 4800:
+			n += NoInline300(i)
+		case 4816:
+			n += NoInline301(i)
+		case 4832:
+			n += NoInline302(i)
+		case 4848:
+			n += NoInline303(i)
+		case 4864:
+			n += NoInline304(i)
+		case 4880:
+			n += NoInline305(i)
+		case 4896:
+			n += NoInline306(i)
+		case 4912:
+			n += NoInline307(i)
+		case 4928:
+			n += NoInline308(i)
+		case 4944:
+			n += NoInline309(i)
+		case 4960:
+			n += NoInline310(i)
+		case 4976:
+			n += NoInline311(i)
+		case 4992:
+			n += NoInline312(i)
+		case 5008:
+			n += NoInline313(i)
+		case 5024:
+			n += NoInline314(i)
+		case 5040:
+			n += NoInline315(i)
+		case 5056:
+			n += NoInline316(i)
+		case 5072:
+			n += NoInline317(i)
+		case 5088:
+			n += NoInline318(i)
+		case 5104:
+			n += NoInline319(i)
+		case 5120:
+			n += NoInline320(i)
+		case 5136:
+			n += NoInline321(i)
+		case 5152:
+			n += NoInline322(i)
+		case 5168:
+			n += NoInline323(i)
+		case 5184:
+			n += NoInline324(i)
+		case 5200:
+			n += NoInline325(i)
+		case 5216:
+			n += NoInline326(i)
+		case 5232:
+			n += NoInline327(i)
+		case 5248:
+			n += NoInline328(i)
+		case 5264:
+			n += NoInline329(i)
+		case 5280:
+			n += NoInline330(i)
+		case 5296:
+			n += NoInline331(i)
+		case 5312:
+			n += NoInline332(i)
+		case 5328:
+			n += NoInline333(i)
+		case 5344:
+			n += NoInline334(i)
+		case 5360:
+			n += NoInline335(i)
+		case 5376:
+			n += NoInline336(i)
+		case 5392:
+			n += NoInline337(i)
+		case 5408:
+			n += NoInline338(i)
+		case 5424:
+			n += NoInline339(i)
+		case 5440:
+			n += NoInline340(i)
+		case 5456:
+			n += NoInline341(i)
+		case 5472:
+			n += NoInline342(i)
+		case 5488:
+			n += NoInline343(i)
+		case 5504:
+			n += NoInline344(i)
+		case 5520:
+			n += NoInline345(i)
+		case 5536:
+			n += NoInline346(i)
+		case 5552:
+			n += NoInline347(i)
+		case 5568:
+			n += NoInline348(i)
+		case 5584:
+			n += NoInline349(i)
+		case 5600:
+			n += NoInline350(i)
+		case 5616:
+			n += NoInline351(i)
+		case 5632:
+			n += NoInline352(i)
+		case 5648:
+			n += NoInline353(i)
+		case 5664:
+			n += NoInline354(i)
+		case 5680:
+			n += NoInline355(i)
+		case 5696:
+			n += NoInline356(i)
+		case 5712:
+			n += NoInline357(i)
+		case 5728:
+			n += NoInline358(i)
+		case 5744:
+			n += NoInline359(i)
+		case 5760:
+			n += NoInline360(i)
+		case 5776:
+			n += NoInline361(i)
+		case 5792:
+			n += NoInline362(i)
+		case 5808:
+			n += NoInline363(i)
+		case 5824:
+			n += NoInline364(i)
+		case 5840:
+			n += NoInline365(i)
+		case 5856:
+			n += NoInline366(i)
+		case 5872:
+			n += NoInline367(i)
+		case 5888:
+			n += NoInline368(i)
+		case 5904:
+			n += NoInline369(i)
+		case 5920:
+			n += NoInline370(i)
+		case 5936:
+			n += NoInline371(i)
+		case 5952:
+			n += NoInline372(i)
+		case 5968:
+			n += NoInline373(i)
+		case 5984:
+			n += NoInline374(i)
+		case 6000:
+			n += NoInline375(i)
+		case 6016:
+			n += NoInline376(i)
+		case 6032:
+			n += NoInline377(i)
+		case 6048:
+			n += NoInline378(i)
+		case 6064:
+			n += NoInline379(i)
+		case 6080:
+			n += NoInline380(i)
+		case 6096:
+			n += NoInline381(i)
+		case 6112:
+			n += NoInline382(i)
+		case 6128:
+			n += NoInline383(i)
+		case 6144:
+			n += NoInline384(i)
+		case 6160:
+			n += NoInline385(i)
+		case 6176:
+			n += NoInline386(i)
+		case 6192:
+			n += NoInline387(i)
+		case 6208:
+			n += NoInline388(i)
+		case 6224:
+			n += NoInline389(i)
+		case 6240:
+			n += NoInline390(i)
+		case 6256:
+			n += NoInline391(i)
+		case 6272:
+			n += NoInline392(i)
+		case 6288:
+			n += NoInline393(i)
+		case 6304:
+			n += NoInline394(i)
+		case 6320:
+			n += NoInline395(i)
+		case 6336:
+			n += NoInline396(i)
+		case 6352:
+			n += NoInline397(i)
+		case 6368:
+			n += NoInline398(i)
+		case 6384:
+			n += NoInline399(i)
+		case 6400:
+			n += NoInline400(i)
+		case 6416:
+			n += NoInline401(i)
+		case 6432:
+			n += NoInline402(i)
+		case 6448:
+			n += NoInline403(i)
+		case 6464:
+			n += NoInline404(i)
+		case 6480:
+			n += NoInline405(i)
+		case 6496:
+			n += NoInline406(i)
+		case 6512:
+			n += NoInline407(i)
+		case 6528:
+			n += NoInline408(i)
+		case 6544:
+			n += NoInline409(i)
+		case 6560:
+			n += NoInline410(i)
+		case 6576:
+			n += NoInline411(i)
+		case 6592:
+			n += NoInline412(i)
+		case 6608:
+			n += NoInline413(i)
+		case 6624:
+			n += NoInline414(i)
+		case 6640:
+			n += NoInline415(i)
+		case 6656:
+			n += NoInline416(i)
+		case 6672:
+			n += NoInline417(i)
+		case 6688:
+			n += NoInline418(i)
+		case 6704:
+			n += NoInline419(i)
+		case 6720:
+			n += NoInline420(i)
+		case 6736:
+			n += NoInline421(i)
+		case 6752:
+			n += NoInline422(i)
+		case 6768:
+			n += NoInline423(i)
+		case 6784:
+			n += NoInline424(i)
+		case 6800:
+			n += NoInline425(i)
+		case 6816:
+			n += NoInline426(i)
+		case 6832:
+			n += NoInline427(i)
+		case 6848:
+			n += NoInline428(i)
+		case 6864:
+			n += NoInline429(i)
+		case 6880:
+			n += NoInline430(i)
+		case 6896:
+			n += NoInline431(i)
+		case 6912:
+			n += NoInline432(i)
+		case 6928:
+			n += NoInline433(i)
+		case 6944:
+			n += NoInline434(i)
+		case 6960:
+			n += NoInline435(i)
+		case 6976:
+			n += NoInline436(i)
+		case 6992:
+			n += NoInline437(i)
+		case 7008:
+			n += NoInline438(i)
+		case 7024:
+			n += NoInline439(i)
+		case 7040:
+			n += NoInline440(i)
+		case 7056:
+			n += NoInline441(i)
+		case 7072:
+			n += NoInline442(i)
+		case 7088:
+			n += NoInline443(i)
+		case 7104:
+			n += NoInline444(i)
+		case 7120:
+			n += NoInline445(i)
+		case 7136:
+			n += NoInline446(i)
+		case 7152:
+			n += NoInline447(i)
+		case 7168:
+			n += NoInline448(i)
+		case 7184:
+			n += NoInline449(i)
+		case 7200:
+			n += NoInline450(i)
+		case 7216:
+			n += NoInline451(i)
+		case 7232:
+			n += NoInline452(i)
+		case 7248:
+			n += NoInline453(i)
+		case 7264:
+			n += NoInline454(i)
+		case 7280:
+			n += NoInline455(i)
+		case 7296:
+			n += NoInline456(i)
+		case 7312:
+			n += NoInline457(i)
+		case 7328:
+			n += NoInline458(i)
+		case 7344:
+			n += NoInline459(i)
+		case 7360:
+			n += NoInline460(i)
+		case 7376:
+			n += NoInline461(i)
+		case 7392:
+			n += NoInline462(i)
+		case 7408:
+			n += NoInline463(i)
+		case 7424:
+			n += NoInline464(i)
+		case 7440:
+			n += NoInline465(i)
+		case 7456:
+			n += NoInline466(i)
+		case 7472:
+			n += NoInline467(i)
+		case 7488:
+			n += NoInline468(i)
+		case 7504:
+			n += NoInline469(i)
+		case 7520:
+			n += NoInline470(i)
+		case 7536:
+			n += NoInline471(i)
+		case 7552:
+			n += NoInline472(i)
+		case 7568:
+			n += NoInline473(i)
+		case 7584:
+			n += NoInline474(i)
+		case 7600:
+			n += NoInline475(i)
+		case 7616:
+			n += NoInline476(i)
+		case 7632:
+			n += NoInline477(i)
+		case 7648:
+			n += NoInline478(i)
+		case 7664:
+			n += NoInline479(i)
+		case 7680:
+			n += NoInline480(i)
+		case 7696:
+			n += NoInline481(i)
+		case 7712:
+			n += NoInline482(i)
+		case 7728:
+			n += NoInline483(i)
+		case 7744:
+			n += NoInline484(i)
+		case 7760:
+			n += NoInline485(i)
+		case 7776:
+			n += NoInline486(i)
+		case 7792:
+			n += NoInline487(i)
+		case 7808:
+			n += NoInline488(i)
+		case 7824:
+			n += NoInline489(i)
+		case 7840:
+			n += NoInline490(i)
+		case 7856:
+			n += NoInline491(i)
+		case 7872:
+			n += NoInline492(i)
+		case 7888:
+			n += NoInline493(i)
+		case 7904:
+			n += NoInline494(i)
+		case 7920:
+			n += NoInline495(i)
+		case 7936:
+			n += NoInline496(i)
+		case 7952:
+			n += NoInline497(i)
+		case 7968:
+			n += NoInline498(i)
+		case 7984:
+			n += NoInline499(i)
+		case 8000:
+			n += NoInline500(i)
+		case 8016:
+			n += NoInline501(i)
+		case 8032:
+			n += NoInline502(i)
+		case 8048:
+			n += NoInline503(i)
+		case 8064:
+			n += NoInline504(i)
+		case 8080:
+			n += NoInline505(i)
+		case 8096:
+			n += NoInline506(i)
+		case 8112:
+			n += NoInline507(i)
+		case 8128:
+			n += NoInline508(i)
+		case 8144:
+			n += NoInline509(i)
+		case 8160:
+			n += NoInline510(i)
+		case 8176:
+			n += NoInline511(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchNoInlineSparseComputed4(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysSparse[i%4] {
+		case -1697971134:
+			n += NoInline0(i)
+		case -255511522:
+			n += NoInline1(i)
+		case -1440703602:
+			n += NoInline2(i)
+		case 1879968118:
+			n += NoInline3(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchNoInlineSparseLookupMod4(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysSparse[inputs[i%len(inputs)]] {
+		case -1697971134:
+			n += NoInline0(i)
+		case -255511522:
+			n += NoInline1(i)
+		case -1440703602:
+			n += NoInline2(i)
+		case 1879968118:
+			n += NoInline3(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchNoInlineSparseComputed8(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysSparse[i%8] {
+		case -1697971134:
+			n += NoInline0(i)
+		case -255511522:
+			n += NoInline1(i)
+		case -1440703602:
+			n += NoInline2(i)
+		case 1879968118:
+			n += NoInline3(i)
+		case 1823804162:
+			n += NoInline4(i)
+		case -1345084660:
+			n += NoInline5(i)
+		case 281908850:
+			n += NoInline6(i)
+		case 672245080:
+			n += NoInline7(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchNoInlineSparseLookupMod8(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysSparse[inputs[i%len(inputs)]] {
+		case -1697971134:
+			n += NoInline0(i)
+		case -255511522:
+			n += NoInline1(i)
+		case -1440703602:
+			n += NoInline2(i)
+		case 1879968118:
+			n += NoInline3(i)
+		case 1823804162:
+			n += NoInline4(i)
+		case -1345084660:
+			n += NoInline5(i)
+		case 281908850:
+			n += NoInline6(i)
+		case 672245080:
+			n += NoInline7(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchNoInlineSparseComputed16(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysSparse[i%16] {
+		case -1697971134:
+			n += NoInline0(i)
+		case -255511522:
+			n += NoInline1(i)
+		case -1440703602:
+			n += NoInline2(i)
+		case 1879968118:
+			n += NoInline3(i)
+		case 1823804162:
+			n += NoInline4(i)
+		case -1345084660:
+			n += NoInline5(i)
+		case 281908850:
+			n += NoInline6(i)
+		case 672245080:
+			n += NoInline7(i)
+		case 416480912:
+			n += NoInline8(i)
+		case 1292406600:
+			n += NoInline9(i)
+		case -2082145907:
+			n += NoInline10(i)
+		case -800410273:
+			n += NoInline11(i)
+		case 920256325:
+			n += NoInline12(i)
+		case 1634910179:
+			n += NoInline13(i)
+		case 1366049456:
+			n += NoInline14(i)
+		case 2013866549:
+			n += NoInline15(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchNoInlineSparseLookupMod16(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysSparse[inputs[i%len(inputs)]] {
+		case -1697971134:
+			n += NoInline0(i)
+		case -255511522:
+			n += NoInline1(i)
+		case -1440703602:
+			n += NoInline2(i)
+		case 1879968118:
+			n += NoInline3(i)
+		case 1823804162:
+			n += NoInline4(i)
+		case -1345084660:
+			n += NoInline5(i)
+		case 281908850:
+			n += NoInline6(i)
+		case 672245080:
+			n += NoInline7(i)
+		case 416480912:
+			n += NoInline8(i)
+		case 1292406600:
+			n += NoInline9(i)
+		case -2082145907:
+			n += NoInline10(i)
+		case -800410273:
+			n += NoInline11(i)
+		case 920256325:
+			n += NoInline12(i)
+		case 1634910179:
+			n += NoInline13(i)
+		case 1366049456:
+			n += NoInline14(i)
+		case 2013866549:
+			n += NoInline15(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchNoInlineSparseComputed32(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysSparse[i%32] {
+		case -1697971134:
+			n += NoInline0(i)
+		case -255511522:
+			n += NoInline1(i)
+		case -1440703602:
+			n += NoInline2(i)
+		case 1879968118:
+			n += NoInline3(i)
+		case 1823804162:
+			n += NoInline4(i)
+		case -1345084660:
+			n += NoInline5(i)
+		case 281908850:
+			n += NoInline6(i)
+		case 672245080:
+			n += NoInline7(i)
+		case 416480912:
+			n += NoInline8(i)
+		case 1292406600:
+			n += NoInline9(i)
+		case -2082145907:
+			n += NoInline10(i)
+		case -800410273:
+			n += NoInline11(i)
+		case 920256325:
+			n += NoInline12(i)
+		case 1634910179:
+			n += NoInline13(i)
+		case 1366049456:
+			n += NoInline14(i)
+		case 2013866549:
+			n += NoInline15(i)
+		case 1215622422:
+			n += NoInline16(i)
+		case 1258862891:
+			n += NoInline17(i)
+		case -1378320822:
+			n += NoInline18(i)
+		case 938678213:
+			n += NoInline19(i)
+		case 872680990:
+			n += NoInline20(i)
+		case 1549930933:
+			n += NoInline21(i)
+		case -1843944239:
+			n += NoInline22(i)
+		case -590594780:
+			n += NoInline23(i)
+		case 1258916094:
+			n += NoInline24(i)
+		case 1275959894:
+			n += NoInline25(i)
+		case -1062690721:
+			n += NoInline26(i)
+		case 887265776:
+			n += NoInline27(i)
+		case -578381716:
+			n += NoInline28(i)
+		case -1302581265:
+			n += NoInline29(i)
+		case -2045176213:
+			n += NoInline30(i)
+		case 121560817:
+			n += NoInline31(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchNoInlineSparseLookupMod32(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysSparse[inputs[i%len(inputs)]] {
+		case -1697971134:
+			n += NoInline0(i)
+		case -255511522:
+			n += NoInline1(i)
+		case -1440703602:
+			n += NoInline2(i)
+		case 1879968118:
+			n += NoInline3(i)
+		case 1823804162:
+			n += NoInline4(i)
+		case -1345084660:
+			n += NoInline5(i)
+		case 281908850:
+			n += NoInline6(i)
+		case 672245080:
+			n += NoInline7(i)
+		case 416480912:
+			n += NoInline8(i)
+		case 1292406600:
+			n += NoInline9(i)
+		case -2082145907:
+			n += NoInline10(i)
+		case -800410273:
+			n += NoInline11(i)
+		case 920256325:
+			n += NoInline12(i)
+		case 1634910179:
+			n += NoInline13(i)
+		case 1366049456:
+			n += NoInline14(i)
+		case 2013866549:
+			n += NoInline15(i)
+		case 1215622422:
+			n += NoInline16(i)
+		case 1258862891:
+			n += NoInline17(i)
+		case -1378320822:
+			n += NoInline18(i)
+		case 938678213:
+			n += NoInline19(i)
+		case 872680990:
+			n += NoInline20(i)
+		case 1549930933:
+			n += NoInline21(i)
+		case -1843944239:
+			n += NoInline22(i)
+		case -590594780:
+			n += NoInline23(i)
+		case 1258916094:
+			n += NoInline24(i)
+		case 1275959894:
+			n += NoInline25(i)
+		case -1062690721:
+			n += NoInline26(i)
+		case 887265776:
+			n += NoInline27(i)
+		case -578381716:
+			n += NoInline28(i)
+		case -1302581265:
+			n += NoInline29(i)
+		case -2045176213:
+			n += NoInline30(i)
+		case 121560817:
+			n += NoInline31(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchNoInlineSparseComputed64(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysSparse[i%64] {
+		case -1697971134:
+			n += NoInline0(i)
+		case -255511522:
+			n += NoInline1(i)
+		case -1440703602:
+			n += NoInline2(i)
+		case 1879968118:
+			n += NoInline3(i)
+		case 1823804162:
+			n += NoInline4(i)
+		case -1345084660:
+			n += NoInline5(i)
+		case 281908850:
+			n += NoInline6(i)
+		case 672245080:
+			n += NoInline7(i)
+		case 416480912:
+			n += NoInline8(i)
+		case 1292406600:
+			n += NoInline9(i)
+		case -2082145907:
+			n += NoInline10(i)
+		case -800410273:
+			n += NoInline11(i)
+		case 920256325:
+			n += NoInline12(i)
+		case 1634910179:
+			n += NoInline13(i)
+		case 1366049456:
+			n += NoInline14(i)
+		case 2013866549:
+			n += NoInline15(i)
+		case 1215622422:
+			n += NoInline16(i)
+		case 1258862891:
+			n += NoInline17(i)
+		case -1378320822:
+			n += NoInline18(i)
+		case 938678213:
+			n += NoInline19(i)
+		case 872680990:
+			n += NoInline20(i)
+		case 1549930933:
+			n += NoInline21(i)
+		case -1843944239:
+			n += NoInline22(i)
+		case -590594780:
+			n += NoInline23(i)
+		case 1258916094:
+			n += NoInline24(i)
+		case 1275959894:
+			n += NoInline25(i)
+		case -1062690721:
+			n += NoInline26(i)
+		case 887265776:
+			n += NoInline27(i)
+		case -578381716:
+			n += NoInline28(i)
+		case -1302581265:
+			n += NoInline29(i)
+		case -2045176213:
+			n += NoInline30(i)
+		case 121560817:
+			n += NoInline31(i)
+		case 680014774:
+			n += NoInline32(i)
+		case -1686833633:
+			n += NoInline33(i)
+		case -106336438:
+			n += NoInline34(i)
+		case 341250713:
+			n += NoInline35(i)
+		case -1740283822:
+			n += NoInline36(i)
+		case 253921263:
+			n += NoInline37(i)
+		case -1322744326:
+			n += NoInline38(i)
+		case 1295030053:
+			n += NoInline39(i)
+		case 744172826:
+			n += NoInline40(i)
+		case -1970961115:
+			n += NoInline41(i)
+		case -1957836907:
+			n += NoInline42(i)
+		case 1196181127:
+			n += NoInline43(i)
+		case 1817424866:
+			n += NoInline44(i)
+		case -2016119001:
+			n += NoInline45(i)
+		case 1088948157:
+			n += NoInline46(i)
+		case 1211528648:
+			n += NoInline47(i)
+		case -907934977:
+			n += NoInline48(i)
+		case 1553942706:
+			n += NoInline49(i)
+		case -513063382:
+			n += NoInline50(i)
+		case 1276087442:
+			n += NoInline51(i)
+		case -453712918:
+			n += NoInline52(i)
+		case 418564398:
+			n += NoInline53(i)
+		case -99141295:
+			n += NoInline54(i)
+		case 319077410:
+			n += NoInline55(i)
+		case 954725776:
+			n += NoInline56(i)
+		case -1369758219:
+			n += NoInline57(i)
+		case 1037299406:
+			n += NoInline58(i)
+		case 1337978710:
+			n += NoInline59(i)
+		case -288422394:
+			n += NoInline60(i)
+		case -1108750275:
+			n += NoInline61(i)
+		case -854462086:
+			n += NoInline62(i)
+		case -1158646983:
+			n += NoInline63(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchNoInlineSparseLookupMod64(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysSparse[inputs[i%len(inputs)]] {
+		case -1697971134:
+			n += NoInline0(i)
+		case -255511522:
+			n += NoInline1(i)
+		case -1440703602:
+			n += NoInline2(i)
+		case 1879968118:
+			n += NoInline3(i)
+		case 1823804162:
+			n += NoInline4(i)
+		case -1345084660:
+			n += NoInline5(i)
+		case 281908850:
+			n += NoInline6(i)
+		case 672245080:
+			n += NoInline7(i)
+		case 416480912:
+			n += NoInline8(i)
+		case 1292406600:
+			n += NoInline9(i)
+		case -2082145907:
+			n += NoInline10(i)
+		case -800410273:
+			n += NoInline11(i)
+		case 920256325:
+			n += NoInline12(i)
+		case 1634910179:
+			n += NoInline13(i)
+		case 1366049456:
+			n += NoInline14(i)
+		case 2013866549:
+			n += NoInline15(i)
+		case 1215622422:
+			n += NoInline16(i)
+		case 1258862891:
+			n += NoInline17(i)
+		case -1378320822:
+			n += NoInline18(i)
+		case 938678213:
+			n += NoInline19(i)
+		case 872680990:
+			n += NoInline20(i)
+		case 1549930933:
+			n += NoInline21(i)
+		case -1843944239:
+			n += NoInline22(i)
+		case -590594780:
+			n += NoInline23(i)
+		case 1258916094:
+			n += NoInline24(i)
+		case 1275959894:
+			n += NoInline25(i)
+		case -1062690721:
+			n += NoInline26(i)
+		case 887265776:
+			n += NoInline27(i)
+		case -578381716:
+			n += NoInline28(i)
+		case -1302581265:
+			n += NoInline29(i)
+		case -2045176213:
+			n += NoInline30(i)
+		case 121560817:
+			n += NoInline31(i)
+		case 680014774:
+			n += NoInline32(i)
+		case -1686833633:
+			n += NoInline33(i)
+		case -106336438:
+			n += NoInline34(i)
+		case 341250713:
+			n += NoInline35(i)
+		case -1740283822:
+			n += NoInline36(i)
+		case 253921263:
+			n += NoInline37(i)
+		case -1322744326:
+			n += NoInline38(i)
+		case 1295030053:
+			n += NoInline39(i)
+		case 744172826:
+			n += NoInline40(i)
+		case -1970961115:
+			n += NoInline41(i)
+		case -1957836907:
+			n += NoInline42(i)
+		case 1196181127:
+			n += NoInline43(i)
+		case 1817424866:
+			n += NoInline44(i)
+		case -2016119001:
+			n += NoInline45(i)
+		case 1088948157:
+			n += NoInline46(i)
+		case 1211528648:
+			n += NoInline47(i)
+		case -907934977:
+			n += NoInline48(i)
+		case 1553942706:
+			n += NoInline49(i)
+		case -513063382:
+			n += NoInline50(i)
+		case 1276087442:
+			n += NoInline51(i)
+		case -453712918:
+			n += NoInline52(i)
+		case 418564398:
+			n += NoInline53(i)
+		case -99141295:
+			n += NoInline54(i)
+		case 319077410:
+			n += NoInline55(i)
+		case 954725776:
+			n += NoInline56(i)
+		case -1369758219:
+			n += NoInline57(i)
+		case 1037299406:
+			n += NoInline58(i)
+		case 1337978710:
+			n += NoInline59(i)
+		case -288422394:
+			n += NoInline60(i)
+		case -1108750275:
+			n += NoInline61(i)
+		case -854462086:
+			n += NoInline62(i)
+		case -1158646983:
+			n += NoInline63(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchNoInlineSparseComputed128(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysSparse[i%128] {
+		case -1697971134:
+			n += NoInline0(i)
+		case -255511522:
+			n += NoInline1(i)
+		case -1440703602:
+			n += NoInline2(i)
+		case 1879968118:
+			n += NoInline3(i)
+		case 1823804162:
+			n += NoInline4(i)
+		case -1345084660:
+			n += NoInline5(i)
+		case 281908850:
+			n += NoInline6(i)
+		case 672245080:
+			n += NoInline7(i)
+		case 416480912:
+			n += NoInline8(i)
+		case 1292406600:
+			n += NoInline9(i)
+		case -2082145907:
+			n += NoInline10(i)
+		case -800410273:
+			n += NoInline11(i)
+		case 920256325:
+			n += NoInline12(i)
+		case 1634910179:
+			n += NoInline13(i)
+		case 1366049456:
+			n += NoInline14(i)
+		case 2013866549:
+			n += NoInline15(i)
+		case 1215622422:
+			n += NoInline16(i)
+		case 1258862891:
+			n += NoInline17(i)
+		case -1378320822:
+			n += NoInline18(i)
+		case 938678213:
+			n += NoInline19(i)
+		case 872680990:
+			n += NoInline20(i)
+		case 1549930933:
+			n += NoInline21(i)
+		case -1843944239:
+			n += NoInline22(i)
+		case -590594780:
+			n += NoInline23(i)
+		case 1258916094:
+			n += NoInline24(i)
+		case 1275959894:
+			n += NoInline25(i)
+		case -1062690721:
+			n += NoInline26(i)
+		case 887265776:
+			n += NoInline27(i)
+		case -578381716:
+			n += NoInline28(i)
+		case -1302581265:
+			n += NoInline29(i)
+		case -2045176213:
+			n += NoInline30(i)
+		case 121560817:
+			n += NoInline31(i)
+		case 680014774:
+			n += NoInline32(i)
+		case -1686833633:
+			n += NoInline33(i)
+		case -106336438:
+			n += NoInline34(i)
+		case 341250713:
+			n += NoInline35(i)
+		case -1740283822:
+			n += NoInline36(i)
+		case 253921263:
+			n += NoInline37(i)
+		case -1322744326:
+			n += NoInline38(i)
+		case 1295030053:
+			n += NoInline39(i)
+		case 744172826:
+			n += NoInline40(i)
+		case -1970961115:
+			n += NoInline41(i)
+		case -1957836907:
+			n += NoInline42(i)
+		case 1196181127:
+			n += NoInline43(i)
+		case 1817424866:
+			n += NoInline44(i)
+		case -2016119001:
+			n += NoInline45(i)
+		case 1088948157:
+			n += NoInline46(i)
+		case 1211528648:
+			n += NoInline47(i)
+		case -907934977:
+			n += NoInline48(i)
+		case 1553942706:
+			n += NoInline49(i)
+		case -513063382:
+			n += NoInline50(i)
+		case 1276087442:
+			n += NoInline51(i)
+		case -453712918:
+			n += NoInline52(i)
+		case 418564398:
+			n += NoInline53(i)
+		case -99141295:
+			n += NoInline54(i)
+		case 319077410:
+			n += NoInline55(i)
+		case 954725776:
+			n += NoInline56(i)
+		case -1369758219:
+			n += NoInline57(i)
+		case 1037299406:
+			n += NoInline58(i)
+		case 1337978710:
+			n += NoInline59(i)
+		case -288422394:
+			n += NoInline60(i)
+		case -1108750275:
+			n += NoInline61(i)
+		case -854462086:
+			n += NoInline62(i)
+		case -1158646983:
+			n += NoInline63(i)
+		case 785656533:
+			n += NoInline64(i)
+		case 1839779657:
+			n += NoInline65(i)
+		case -442416174:
+			n += NoInline66(i)
+		case -1362992891:
+			n += NoInline67(i)
+		case -90497729:
+			n += NoInline68(i)
+		case -334095804:
+			n += NoInline69(i)
+		case 390143126:
+			n += NoInline70(i)
+		case 2118028752:
+			n += NoInline71(i)
+		case -313589291:
+			n += NoInline72(i)
+		case -193507860:
+			n += NoInline73(i)
+		case 1494450894:
+			n += NoInline74(i)
+		case -1327837108:
+			n += NoInline75(i)
+		case -1241644142:
+			n += NoInline76(i)
+		case -1873552370:
+			n += NoInline77(i)
+		case -1505431304:
+			n += NoInline78(i)
+		case -1925154456:
+			n += NoInline79(i)
+		case -1048730050:
+			n += NoInline80(i)
+		case 1734321906:
+			n += NoInline81(i)
+		case 561142274:
+			n += NoInline82(i)
+		case -60281029:
+			n += NoInline83(i)
+		case -445208814:
+			n += NoInline84(i)
+		case 1383340119:
+			n += NoInline85(i)
+		case -1197661229:
+			n += NoInline86(i)
+		case -1526690010:
+			n += NoInline87(i)
+		case 367307783:
+			n += NoInline88(i)
+		case -1419163291:
+			n += NoInline89(i)
+		case -1620369539:
+			n += NoInline90(i)
+		case 1587818672:
+			n += NoInline91(i)
+		case 1017145093:
+			n += NoInline92(i)
+		case -1995949082:
+			n += NoInline93(i)
+		case 804215881:
+			n += NoInline94(i)
+		case 1025813007:
+			n += NoInline95(i)
+		case -1597306192:
+			n += NoInline96(i)
+		case 544399686:
+			n += NoInline97(i)
+		case 1208304411:
+			n += NoInline98(i)
+		case 1762323197:
+			n += NoInline99(i)
+		case 1867934851:
+			n += NoInline100(i)
+		case -1610204593:
+			n += NoInline101(i)
+		case -1932104265:
+			n += NoInline102(i)
+		case -1616587781:
+			n += NoInline103(i)
+		case -1163159922:
+			n += NoInline104(i)
+		case -727851276:
+			n += NoInline105(i)
+		case 2206820:
+			n += NoInline106(i)
+		case -1133576725:
+			n += NoInline107(i)
+		case 1717917180:
+			n += NoInline108(i)
+		case 2138327264:
+			n += NoInline109(i)
+		case -1700901100:
+			n += NoInline110(i)
+		case 1759297107:
+			n += NoInline111(i)
+		case 127437182:
+			n += NoInline112(i)
+		case 8177164:
+			n += NoInline113(i)
+		case 12210768:
+			n += NoInline114(i)
+		case -361544701:
+			n += NoInline115(i)
+		case -1761648762:
+			n += NoInline116(i)
+		case -1892395022:
+			n += NoInline117(i)
+		case -792828754:
+			n += NoInline118(i)
+		case -523935508:
+			n += NoInline119(i)
+		case 1968995452:
+			n += NoInline120(i)
+		case -1717275692:
+			n += NoInline121(i)
+		case 112807962:
+			n += NoInline122(i)
+		case -662143137:
+			n += NoInline123(i)
+		case 1072424133:
+			n += NoInline124(i)
+		case -1538524756:
+			n += NoInline125(i)
+		case 1062860987:
+			n += NoInline126(i)
+		case 745846173:
+			n += NoInline127(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchNoInlineSparseLookupMod128(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysSparse[inputs[i%len(inputs)]] {
+		case -1697971134:
+			n += NoInline0(i)
+		case -255511522:
+			n += NoInline1(i)
+		case -1440703602:
+			n += NoInline2(i)
+		case 1879968118:
+			n += NoInline3(i)
+		case 1823804162:
+			n += NoInline4(i)
+		case -1345084660:
+			n += NoInline5(i)
+		case 281908850:
+			n += NoInline6(i)
+		case 672245080:
+			n += NoInline7(i)
+		case 416480912:
+			n += NoInline8(i)
+		case 1292406600:
+			n += NoInline9(i)
+		case -2082145907:
+			n += NoInline10(i)
+		case -800410273:
+			n += NoInline11(i)
+		case 920256325:
+			n += NoInline12(i)
+		case 1634910179:
+			n += NoInline13(i)
+		case 1366049456:
+			n += NoInline14(i)
+		case 2013866549:
+			n += NoInline15(i)
+		case 1215622422:
+			n += NoInline16(i)
+		case 1258862891:
+			n += NoInline17(i)
+		case -1378320822:
+			n += NoInline18(i)
+		case 938678213:
+			n += NoInline19(i)
+		case 872680990:
+			n += NoInline20(i)
+		case 1549930933:
+			n += NoInline21(i)
+		case -1843944239:
+			n += NoInline22(i)
+		case -590594780:
+			n += NoInline23(i)
+		case 1258916094:
+			n += NoInline24(i)
+		case 1275959894:
+			n += NoInline25(i)
+		case -1062690721:
+			n += NoInline26(i)
+		case 887265776:
+			n += NoInline27(i)
+		case -578381716:
+			n += NoInline28(i)
+		case -1302581265:
+			n += NoInline29(i)
+		case -2045176213:
+			n += NoInline30(i)
+		case 121560817:
+			n += NoInline31(i)
+		case 680014774:
+			n += NoInline32(i)
+		case -1686833633:
+			n += NoInline33(i)
+		case -106336438:
+			n += NoInline34(i)
+		case 341250713:
+			n += NoInline35(i)
+		case -1740283822:
+			n += NoInline36(i)
+		case 253921263:
+			n += NoInline37(i)
+		case -1322744326:
+			n += NoInline38(i)
+		case 1295030053:
+			n += NoInline39(i)
+		case 744172826:
+			n += NoInline40(i)
+		case -1970961115:
+			n += NoInline41(i)
+		case -1957836907:
+			n += NoInline42(i)
+		case 1196181127:
+			n += NoInline43(i)
+		case 1817424866:
+			n += NoInline44(i)
+		case -2016119001:
+			n += NoInline45(i)
+		case 1088948157:
+			n += NoInline46(i)
+		case 1211528648:
+			n += NoInline47(i)
+		case -907934977:
+			n += NoInline48(i)
+		case 1553942706:
+			n += NoInline49(i)
+		case -513063382:
+			n += NoInline50(i)
+		case 1276087442:
+			n += NoInline51(i)
+		case -453712918:
+			n += NoInline52(i)
+		case 418564398:
+			n += NoInline53(i)
+		case -99141295:
+			n += NoInline54(i)
+		case 319077410:
+			n += NoInline55(i)
+		case 954725776:
+			n += NoInline56(i)
+		case -1369758219:
+			n += NoInline57(i)
+		case 1037299406:
+			n += NoInline58(i)
+		case 1337978710:
+			n += NoInline59(i)
+		case -288422394:
+			n += NoInline60(i)
+		case -1108750275:
+			n += NoInline61(i)
+		case -854462086:
+			n += NoInline62(i)
+		case -1158646983:
+			n += NoInline63(i)
+		case 785656533:
+			n += NoInline64(i)
+		case 1839779657:
+			n += NoInline65(i)
+		case -442416174:
+			n += NoInline66(i)
+		case -1362992891:
+			n += NoInline67(i)
+		case -90497729:
+			n += NoInline68(i)
+		case -334095804:
+			n += NoInline69(i)
+		case 390143126:
+			n += NoInline70(i)
+		case 2118028752:
+			n += NoInline71(i)
+		case -313589291:
+			n += NoInline72(i)
+		case -193507860:
+			n += NoInline73(i)
+		case 1494450894:
+			n += NoInline74(i)
+		case -1327837108:
+			n += NoInline75(i)
+		case -1241644142:
+			n += NoInline76(i)
+		case -1873552370:
+			n += NoInline77(i)
+		case -1505431304:
+			n += NoInline78(i)
+		case -1925154456:
+			n += NoInline79(i)
+		case -1048730050:
+			n += NoInline80(i)
+		case 1734321906:
+			n += NoInline81(i)
+		case 561142274:
+			n += NoInline82(i)
+		case -60281029:
+			n += NoInline83(i)
+		case -445208814:
+			n += NoInline84(i)
+		case 1383340119:
+			n += NoInline85(i)
+		case -1197661229:
+			n += NoInline86(i)
+		case -1526690010:
+			n += NoInline87(i)
+		case 367307783:
+			n += NoInline88(i)
+		case -1419163291:
+			n += NoInline89(i)
+		case -1620369539:
+			n += NoInline90(i)
+		case 1587818672:
+			n += NoInline91(i)
+		case 1017145093:
+			n += NoInline92(i)
+		case -1995949082:
+			n += NoInline93(i)
+		case 804215881:
+			n += NoInline94(i)
+		case 1025813007:
+			n += NoInline95(i)
+		case -1597306192:
+			n += NoInline96(i)
+		case 544399686:
+			n += NoInline97(i)
+		case 1208304411:
+			n += NoInline98(i)
+		case 1762323197:
+			n += NoInline99(i)
+		case 1867934851:
+			n += NoInline100(i)
+		case -1610204593:
+			n += NoInline101(i)
+		case -1932104265:
+			n += NoInline102(i)
+		case -1616587781:
+			n += NoInline103(i)
+		case -1163159922:
+			n += NoInline104(i)
+		case -727851276:
+			n += NoInline105(i)
+		case 2206820:
+			n += NoInline106(i)
+		case -1133576725:
+			n += NoInline107(i)
+		case 1717917180:
+			n += NoInline108(i)
+		case 2138327264:
+			n += NoInline109(i)
+		case -1700901100:
+			n += NoInline110(i)
+		case 1759297107:
+			n += NoInline111(i)
+		case 127437182:
+			n += NoInline112(i)
+		case 8177164:
+			n += NoInline113(i)
+		case 12210768:
+			n += NoInline114(i)
+		case -361544701:
+			n += NoInline115(i)
+		case -1761648762:
+			n += NoInline116(i)
+		case -1892395022:
+			n += NoInline117(i)
+		case -792828754:
+			n += NoInline118(i)
+		case -523935508:
+			n += NoInline119(i)
+		case 1968995452:
+			n += NoInline120(i)
+		case -1717275692:
+			n += NoInline121(i)
+		case 112807962:
+			n += NoInline122(i)
+		case -662143137:
+			n += NoInline123(i)
+		case 1072424133:
+			n += NoInline124(i)
+		case -1538524756:
+			n += NoInline125(i)
+		case 1062860987:
+			n += NoInline126(i)
+		case 745846173:
+			n += NoInline127(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchNoInlineSparseComputed256(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysSparse[i%256] {
+		case -1697971134:
+			n += NoInline0(i)
+		case -255511522:
+			n += NoInline1(i)
+		case -1440703602:
+			n += NoInline2(i)
+		case 1879968118:
+			n += NoInline3(i)
+		case 1823804162:
+			n += NoInline4(i)
+		case -1345084660:
+			n += NoInline5(i)
+		case 281908850:
+			n += NoInline6(i)
+		case 672245080:
+			n += NoInline7(i)
+		case 416480912:
+			n += NoInline8(i)
+		case 1292406600:
+			n += NoInline9(i)
+		case -2082145907:
+			n += NoInline10(i)
+		case -800410273:
+			n += NoInline11(i)
+		case 920256325:
+			n += NoInline12(i)
+		case 1634910179:
+			n += NoInline13(i)
+		case 1366049456:
+			n += NoInline14(i)
+		case 2013866549:
+			n += NoInline15(i)
+		case 1215622422:
+			n += NoInline16(i)
+		case 1258862891:
+			n += NoInline17(i)
+		case -1378320822:
+			n += NoInline18(i)
+		case 938678213:
+			n += NoInline19(i)
+		case 872680990:
+			n += NoInline20(i)
+		case 1549930933:
+			n += NoInline21(i)
+		case -1843944239:
+			n += NoInline22(i)
+		case -590594780:
+			n += NoInline23(i)
+		case 1258916094:
+			n += NoInline24(i)
+		case 1275959894:
+			n += NoInline25(i)
+		case -1062690721:
+			n += NoInline26(i)
+		case 887265776:
+			n += NoInline27(i)
+		case -578381716:
+			n += NoInline28(i)
+		case -1302581265:
+			n += NoInline29(i)
+		case -2045176213:
+			n += NoInline30(i)
+		case 121560817:
+			n += NoInline31(i)
+		case 680014774:
+			n += NoInline32(i)
+		case -1686833633:
+			n += NoInline33(i)
+		case -106336438:
+			n += NoInline34(i)
+		case 341250713:
+			n += NoInline35(i)
+		case -1740283822:
+			n += NoInline36(i)
+		case 253921263:
+			n += NoInline37(i)
+		case -1322744326:
+			n += NoInline38(i)
+		case 1295030053:
+			n += NoInline39(i)
+		case 744172826:
+			n += NoInline40(i)
+		case -1970961115:
+			n += NoInline41(i)
+		case -1957836907:
+			n += NoInline42(i)
+		case 1196181127:
+			n += NoInline43(i)
+		case 1817424866:
+			n += NoInline44(i)
+		case -2016119001:
+			n += NoInline45(i)
+		case 1088948157:
+			n += NoInline46(i)
+		case 1211528648:
+			n += NoInline47(i)
+		case -907934977:
+			n += NoInline48(i)
+		case 1553942706:
+			n += NoInline49(i)
+		case -513063382:
+			n += NoInline50(i)
+		case 1276087442:
+			n += NoInline51(i)
+		case -453712918:
+			n += NoInline52(i)
+		case 418564398:
+			n += NoInline53(i)
+		case -99141295:
+			n += NoInline54(i)
+		case 319077410:
+			n += NoInline55(i)
+		case 954725776:
+			n += NoInline56(i)
+		case -1369758219:
+			n += NoInline57(i)
+		case 1037299406:
+			n += NoInline58(i)
+		case 1337978710:
+			n += NoInline59(i)
+		case -288422394:
+			n += NoInline60(i)
+		case -1108750275:
+			n += NoInline61(i)
+		case -854462086:
+			n += NoInline62(i)
+		case -1158646983:
+			n += NoInline63(i)
+		case 785656533:
+			n += NoInline64(i)
+		case 1839779657:
+			n += NoInline65(i)
+		case -442416174:
+			n += NoInline66(i)
+		case -1362992891:
+			n += NoInline67(i)
+		case -90497729:
+			n += NoInline68(i)
+		case -334095804:
+			n += NoInline69(i)
+		case 390143126:
+			n += NoInline70(i)
+		case 2118028752:
+			n += NoInline71(i)
+		case -313589291:
+			n += NoInline72(i)
+		case -193507860:
+			n += NoInline73(i)
+		case 1494450894:
+			n += NoInline74(i)
+		case -1327837108:
+			n += NoInline75(i)
+		case -1241644142:
+			n += NoInline76(i)
+		case -1873552370:
+			n += NoInline77(i)
+		case -1505431304:
+			n += NoInline78(i)
+		case -1925154456:
+			n += NoInline79(i)
+		case -1048730050:
+			n += NoInline80(i)
+		case 1734321906:
+			n += NoInline81(i)
+		case 561142274:
+			n += NoInline82(i)
+		case -60281029:
+			n += NoInline83(i)
+		case -445208814:
+			n += NoInline84(i)
+		case 1383340119:
+			n += NoInline85(i)
+		case -1197661229:
+			n += NoInline86(i)
+		case -1526690010:
+			n += NoInline87(i)
+		case 367307783:
+			n += NoInline88(i)
+		case -1419163291:
+			n += NoInline89(i)
+		case -1620369539:
+			n += NoInline90(i)
+		case 1587818672:
+			n += NoInline91(i)
+		case 1017145093:
+			n += NoInline92(i)
+		case -1995949082:
+			n += NoInline93(i)
+		case 804215881:
+			n += NoInline94(i)
+		case 1025813007:
+			n += NoInline95(i)
+		case -1597306192:
+			n += NoInline96(i)
+		case 544399686:
+			n += NoInline97(i)
+		case 1208304411:
+			n += NoInline98(i)
+		case 1762323197:
+			n += NoInline99(i)
+		case 1867934851:
+			n += NoInline100(i)
+		case -1610204593:
+			n += NoInline101(i)
+		case -1932104265:
+			n += NoInline102(i)
+		case -1616587781:
+			n += NoInline103(i)
+		case -1163159922:
+			n += NoInline104(i)
+		case -727851276:
+			n += NoInline105(i)
+		case 2206820:
+			n += NoInline106(i)
+		case -1133576725:
+			n += NoInline107(i)
+		case 1717917180:
+			n += NoInline108(i)
+		case 2138327264:
+			n += NoInline109(i)
+		case -1700901100:
+			n += NoInline110(i)
+		case 1759297107:
+			n += NoInline111(i)
+		case 127437182:
+			n += NoInline112(i)
+		case 8177164:
+			n += NoInline113(i)
+		case 12210768:
+			n += NoInline114(i)
+		case -361544701:
+			n += NoInline115(i)
+		case -1761648762:
+			n += NoInline116(i)
+		case -1892395022:
+			n += NoInline117(i)
+		case -792828754:
+			n += NoInline118(i)
+		case -523935508:
+			n += NoInline119(i)
+		case 1968995452:
+			n += NoInline120(i)
+		case -1717275692:
+			n += NoInline121(i)
+		case 112807962:
+			n += NoInline122(i)
+		case -662143137:
+			n += NoInline123(i)
+		case 1072424133:
+			n += NoInline124(i)
+		case -1538524756:
+			n += NoInline125(i)
+		case 1062860987:
+			n += NoInline126(i)
+		case 745846173:
+			n += NoInline127(i)
+		case -1749667658:
+			n += NoInline128(i)
+		case -797169334:
+			n += NoInline129(i)
+		case -1314955191:
+			n += NoInline130(i)
+		case 130234351:
+			n += NoInline131(i)
+		case -1979077526:
+			n += NoInline132(i)
+		case -104475875:
+			n += NoInline133(i)
+		case -1070464522:
+			n += NoInline134(i)
+		case 1262747498:
+			n += NoInline135(i)
+		case -1060164240:
+			n += NoInline136(i)
+		case 648385636:
+			n += NoInline137(i)
+		case 1528008769:
+			n += NoInline138(i)
+		case -721851490:
+			n += NoInline139(i)
+		case 995702448:
+			n += NoInline140(i)
+		case -1598438201:
+			n += NoInline141(i)
+		case 2140587224:
+			n += NoInline142(i)
+		case 385843065:
+			n += NoInline143(i)
+		case 108207233:
+			n += NoInline144(i)
+		case 1684555679:
+			n += NoInline145(i)
+		case -1763586216:
+			n += NoInline146(i)
+		case -302315724:
+			n += NoInline147(i)
+		case -1837873194:
+			n += NoInline148(i)
+		case -1767051143:
+			n += NoInline149(i)
+		case 1768507280:
+			n += NoInline150(i)
+		case -1921652594:
+			n += NoInline151(i)
+		case 2111437688:
+			n += NoInline152(i)
+		case -180586567:
+			n += NoInline153(i)
+		case -870982685:
+			n += NoInline154(i)
+		case 461198367:
+			n += NoInline155(i)
+		case -931857694:
+			n += NoInline156(i)
+		case 1689000180:
+			n += NoInline157(i)
+		case 560123204:
+			n += NoInline158(i)
+		case 816184516:
+			n += NoInline159(i)
+		case -1117439762:
+			n += NoInline160(i)
+		case -1485880834:
+			n += NoInline161(i)
+		case 422555156:
+			n += NoInline162(i)
+		case -2059950988:
+			n += NoInline163(i)
+		case 428335644:
+			n += NoInline164(i)
+		case 652162446:
+			n += NoInline165(i)
+		case 327234684:
+			n += NoInline166(i)
+		case 1353808417:
+			n += NoInline167(i)
+		case 685695486:
+			n += NoInline168(i)
+		case 591863937:
+			n += NoInline169(i)
+		case 1385602332:
+			n += NoInline170(i)
+		case -1979659876:
+			n += NoInline171(i)
+		case -1843178226:
+			n += NoInline172(i)
+		case -2092586415:
+			n += NoInline173(i)
+		case -1356457488:
+			n += NoInline174(i)
+		case -1490180972:
+			n += NoInline175(i)
+		case -2042257982:
+			n += NoInline176(i)
+		case -1484898466:
+			n += NoInline177(i)
+		case -1218188554:
+			n += NoInline178(i)
+		case -1560601218:
+			n += NoInline179(i)
+		case 55086860:
+			n += NoInline180(i)
+		case 131779027:
+			n += NoInline181(i)
+		case 421039401:
+			n += NoInline182(i)
+		case 1585322719:
+			n += NoInline183(i)
+		case -745373855:
+			n += NoInline184(i)
+		case 1493281567:
+			n += NoInline185(i)
+		case 1478821740:
+			n += NoInline186(i)
+		case 1086625968:
+			n += NoInline187(i)
+		case 929736495:
+			n += NoInline188(i)
+		case -1911251275:
+			n += NoInline189(i)
+		case 1726881131:
+			n += NoInline190(i)
+		case -2119578972:
+			n += NoInline191(i)
+		case 724473658:
+			n += NoInline192(i)
+		case 1423215840:
+			n += NoInline193(i)
+		case -739043200:
+			n += NoInline194(i)
+		case -1287253784:
+			n += NoInline195(i)
+		case 248791390:
+			n += NoInline196(i)
+		case -3609963:
+			n += NoInline197(i)
+		case 1767552401:
+			n += NoInline198(i)
+		case 479638912:
+			n += NoInline199(i)
+		case -941654037:
+			n += NoInline200(i)
+		case 395642184:
+			n += NoInline201(i)
+		case 229757662:
+			n += NoInline202(i)
+		case -1225372141:
+			n += NoInline203(i)
+		case 1077015772:
+			n += NoInline204(i)
+		case -650116655:
+			n += NoInline205(i)
+		case -112176497:
+			n += NoInline206(i)
+		case 912942324:
+			n += NoInline207(i)
+		case 92486895:
+			n += NoInline208(i)
+		case -235386712:
+			n += NoInline209(i)
+		case 399303777:
+			n += NoInline210(i)
+		case -1521134074:
+			n += NoInline211(i)
+		case 1339538206:
+			n += NoInline212(i)
+		case 1926139776:
+			n += NoInline213(i)
+		case 2092676637:
+			n += NoInline214(i)
+		case 354247513:
+			n += NoInline215(i)
+		case -1409483257:
+			n += NoInline216(i)
+		case 1718795615:
+			n += NoInline217(i)
+		case -428314982:
+			n += NoInline218(i)
+		case -215249991:
+			n += NoInline219(i)
+		case 1371517350:
+			n += NoInline220(i)
+		case 2144844363:
+			n += NoInline221(i)
+		case 1719843706:
+			n += NoInline222(i)
+		case 85077591:
+			n += NoInline223(i)
+		case -1524546462:
+			n += NoInline224(i)
+		case 1841202787:
+			n += NoInline225(i)
+		case 1458556941:
+			n += NoInline226(i)
+		case -483409303:
+			n += NoInline227(i)
+		case 1015018773:
+			n += NoInline228(i)
+		case -1009282032:
+			n += NoInline229(i)
+		case 153565041:
+			n += NoInline230(i)
+		case -1170046776:
+			n += NoInline231(i)
+		case -1607019451:
+			n += NoInline232(i)
+		case -2091273238:
+			n += NoInline233(i)
+		case 311163323:
+			n += NoInline234(i)
+		case -1184427176:
+			n += NoInline235(i)
+		case -516062456:
+			n += NoInline236(i)
+		case -95505138:
+			n += NoInline237(i)
+		case -654981387:
+			n += NoInline238(i)
+		case -720704368:
+			n += NoInline239(i)
+		case 1064484121:
+			n += NoInline240(i)
+		case -371948193:
+			n += NoInline241(i)
+		case 322282363:
+			n += NoInline242(i)
+		case -708223782:
+			n += NoInline243(i)
+		case -1592008263:
+			n += NoInline244(i)
+		case -1066266095:
+			n += NoInline245(i)
+		case -1580533217:
+			n += NoInline246(i)
+		case 416329275:
+			n += NoInline247(i)
+		case 63683067:
+			n += NoInline248(i)
+		case -1787416174:
+			n += NoInline249(i)
+		case 295305609:
+			n += NoInline250(i)
+		case -7413926:
+			n += NoInline251(i)
+		case -1506724278:
+			n += NoInline252(i)
+		case -62424865:
+			n += NoInline253(i)
+		case -709503858:
+			n += NoInline254(i)
+		case 1426170028:
+			n += NoInline255(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchNoInlineSparseLookupMod256(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysSparse[inputs[i%len(inputs)]] {
+		case -1697971134:
+			n += NoInline0(i)
+		case -255511522:
+			n += NoInline1(i)
+		case -1440703602:
+			n += NoInline2(i)
+		case 1879968118:
+			n += NoInline3(i)
+		case 1823804162:
+			n += NoInline4(i)
+		case -1345084660:
+			n += NoInline5(i)
+		case 281908850:
+			n += NoInline6(i)
+		case 672245080:
+			n += NoInline7(i)
+		case 416480912:
+			n += NoInline8(i)
+		case 1292406600:
+			n += NoInline9(i)
+		case -2082145907:
+			n += NoInline10(i)
+		case -800410273:
+			n += NoInline11(i)
+		case 920256325:
+			n += NoInline12(i)
+		case 1634910179:
+			n += NoInline13(i)
+		case 1366049456:
+			n += NoInline14(i)
+		case 2013866549:
+			n += NoInline15(i)
+		case 1215622422:
+			n += NoInline16(i)
+		case 1258862891:
+			n += NoInline17(i)
+		case -1378320822:
+			n += NoInline18(i)
+		case 938678213:
+			n += NoInline19(i)
+		case 872680990:
+			n += NoInline20(i)
+		case 1549930933:
+			n += NoInline21(i)
+		case -1843944239:
+			n += NoInline22(i)
+		case -590594780:
+			n += NoInline23(i)
+		case 1258916094:
+			n += NoInline24(i)
+		case 1275959894:
+			n += NoInline25(i)
+		case -1062690721:
+			n += NoInline26(i)
+		case 887265776:
+			n += NoInline27(i)
+		case -578381716:
+			n += NoInline28(i)
+		case -1302581265:
+			n += NoInline29(i)
+		case -2045176213:
+			n += NoInline30(i)
+		case 121560817:
+			n += NoInline31(i)
+		case 680014774:
+			n += NoInline32(i)
+		case -1686833633:
+			n += NoInline33(i)
+		case -106336438:
+			n += NoInline34(i)
+		case 341250713:
+			n += NoInline35(i)
+		case -1740283822:
+			n += NoInline36(i)
+		case 253921263:
+			n += NoInline37(i)
+		case -1322744326:
+			n += NoInline38(i)
+		case 1295030053:
+			n += NoInline39(i)
+		case 744172826:
+			n += NoInline40(i)
+		case -1970961115:
+			n += NoInline41(i)
+		case -1957836907:
+			n += NoInline42(i)
+		case 1196181127:
+			n += NoInline43(i)
+		case 1817424866:
+			n += NoInline44(i)
+		case -2016119001:
+			n += NoInline45(i)
+		case 1088948157:
+			n += NoInline46(i)
+		case 1211528648:
+			n += NoInline47(i)
+		case -907934977:
+			n += NoInline48(i)
+		case 1553942706:
+			n += NoInline49(i)
+		case -513063382:
+			n += NoInline50(i)
+		case 1276087442:
+			n += NoInline51(i)
+		case -453712918:
+			n += NoInline52(i)
+		case 418564398:
+			n += NoInline53(i)
+		case -99141295:
+			n += NoInline54(i)
+		case 319077410:
+			n += NoInline55(i)
+		case 954725776:
+			n += NoInline56(i)
+		case -1369758219:
+			n += NoInline57(i)
+		case 1037299406:
+			n += NoInline58(i)
+		case 1337978710:
+			n += NoInline59(i)
+		case -288422394:
+			n += NoInline60(i)
+		case -1108750275:
+			n += NoInline61(i)
+		case -854462086:
+			n += NoInline62(i)
+		case -1158646983:
+			n += NoInline63(i)
+		case 785656533:
+			n += NoInline64(i)
+		case 1839779657:
+			n += NoInline65(i)
+		case -442416174:
+			n += NoInline66(i)
+		case -1362992891:
+			n += NoInline67(i)
+		case -90497729:
+			n += NoInline68(i)
+		case -334095804:
+			n += NoInline69(i)
+		case 390143126:
+			n += NoInline70(i)
+		case 2118028752:
+			n += NoInline71(i)
+		case -313589291:
+			n += NoInline72(i)
+		case -193507860:
+			n += NoInline73(i)
+		case 1494450894:
+			n += NoInline74(i)
+		case -1327837108:
+			n += NoInline75(i)
+		case -1241644142:
+			n += NoInline76(i)
+		case -1873552370:
+			n += NoInline77(i)
+		case -1505431304:
+			n += NoInline78(i)
+		case -1925154456:
+			n += NoInline79(i)
+		case -1048730050:
+			n += NoInline80(i)
+		case 1734321906:
+			n += NoInline81(i)
+		case 561142274:
+			n += NoInline82(i)
+		case -60281029:
+			n += NoInline83(i)
+		case -445208814:
+			n += NoInline84(i)
+		case 1383340119:
+			n += NoInline85(i)
+		case -1197661229:
+			n += NoInline86(i)
+		case -1526690010:
+			n += NoInline87(i)
+		case 367307783:
+			n += NoInline88(i)
+		case -1419163291:
+			n += NoInline89(i)
+		case -1620369539:
+			n += NoInline90(i)
+		case 1587818672:
+			n += NoInline91(i)
+		case 1017145093:
+			n += NoInline92(i)
+		case -1995949082:
+			n += NoInline93(i)
+		case 804215881:
+			n += NoInline94(i)
+		case 1025813007:
+			n += NoInline95(i)
+		case -1597306192:
+			n += NoInline96(i)
+		case 544399686:
+			n += NoInline97(i)
+		case 1208304411:
+			n += NoInline98(i)
+		case 1762323197:
+			n += NoInline99(i)
+		case 1867934851:
+			n += NoInline100(i)
+		case -1610204593:
+			n += NoInline101(i)
+		case -1932104265:
+			n += NoInline102(i)
+		case -1616587781:
+			n += NoInline103(i)
+		case -1163159922:
+			n += NoInline104(i)
+		case -727851276:
+			n += NoInline105(i)
+		case 2206820:
+			n += NoInline106(i)
+		case -1133576725:
+			n += NoInline107(i)
+		case 1717917180:
+			n += NoInline108(i)
+		case 2138327264:
+			n += NoInline109(i)
+		case -1700901100:
+			n += NoInline110(i)
+		case 1759297107:
+			n += NoInline111(i)
+		case 127437182:
+			n += NoInline112(i)
+		case 8177164:
+			n += NoInline113(i)
+		case 12210768:
+			n += NoInline114(i)
+		case -361544701:
+			n += NoInline115(i)
+		case -1761648762:
+			n += NoInline116(i)
+		case -1892395022:
+			n += NoInline117(i)
+		case -792828754:
+			n += NoInline118(i)
+		case -523935508:
+			n += NoInline119(i)
+		case 1968995452:
+			n += NoInline120(i)
+		case -1717275692:
+			n += NoInline121(i)
+		case 112807962:
+			n += NoInline122(i)
+		case -662143137:
+			n += NoInline123(i)
+		case 1072424133:
+			n += NoInline124(i)
+		case -1538524756:
+			n += NoInline125(i)
+		case 1062860987:
+			n += NoInline126(i)
+		case 745846173:
+			n += NoInline127(i)
+		case -1749667658:
+			n += NoInline128(i)
+		case -797169334:
+			n += NoInline129(i)
+		case -1314955191:
+			n += NoInline130(i)
+		case 130234351:
+			n += NoInline131(i)
+		case -1979077526:
+			n += NoInline132(i)
+		case -104475875:
+			n += NoInline133(i)
+		case -1070464522:
+			n += NoInline134(i)
+		case 1262747498:
+			n += NoInline135(i)
+		case -1060164240:
+			n += NoInline136(i)
+		case 648385636:
+			n += NoInline137(i)
+		case 1528008769:
+			n += NoInline138(i)
+		case -721851490:
+			n += NoInline139(i)
+		case 995702448:
+			n += NoInline140(i)
+		case -1598438201:
+			n += NoInline141(i)
+		case 2140587224:
+			n += NoInline142(i)
+		case 385843065:
+			n += NoInline143(i)
+		case 108207233:
+			n += NoInline144(i)
+		case 1684555679:
+			n += NoInline145(i)
+		case -1763586216:
+			n += NoInline146(i)
+		case -302315724:
+			n += NoInline147(i)
+		case -1837873194:
+			n += NoInline148(i)
+		case -1767051143:
+			n += NoInline149(i)
+		case 1768507280:
+			n += NoInline150(i)
+		case -1921652594:
+			n += NoInline151(i)
+		case 2111437688:
+			n += NoInline152(i)
+		case -180586567:
+			n += NoInline153(i)
+		case -870982685:
+			n += NoInline154(i)
+		case 461198367:
+			n += NoInline155(i)
+		case -931857694:
+			n += NoInline156(i)
+		case 1689000180:
+			n += NoInline157(i)
+		case 560123204:
+			n += NoInline158(i)
+		case 816184516:
+			n += NoInline159(i)
+		case -1117439762:
+			n += NoInline160(i)
+		case -1485880834:
+			n += NoInline161(i)
+		case 422555156:
+			n += NoInline162(i)
+		case -2059950988:
+			n += NoInline163(i)
+		case 428335644:
+			n += NoInline164(i)
+		case 652162446:
+			n += NoInline165(i)
+		case 327234684:
+			n += NoInline166(i)
+		case 1353808417:
+			n += NoInline167(i)
+		case 685695486:
+			n += NoInline168(i)
+		case 591863937:
+			n += NoInline169(i)
+		case 1385602332:
+			n += NoInline170(i)
+		case -1979659876:
+			n += NoInline171(i)
+		case -1843178226:
+			n += NoInline172(i)
+		case -2092586415:
+			n += NoInline173(i)
+		case -1356457488:
+			n += NoInline174(i)
+		case -1490180972:
+			n += NoInline175(i)
+		case -2042257982:
+			n += NoInline176(i)
+		case -1484898466:
+			n += NoInline177(i)
+		case -1218188554:
+			n += NoInline178(i)
+		case -1560601218:
+			n += NoInline179(i)
+		case 55086860:
+			n += NoInline180(i)
+		case 131779027:
+			n += NoInline181(i)
+		case 421039401:
+			n += NoInline182(i)
+		case 1585322719:
+			n += NoInline183(i)
+		case -745373855:
+			n += NoInline184(i)
+		case 1493281567:
+			n += NoInline185(i)
+		case 1478821740:
+			n += NoInline186(i)
+		case 1086625968:
+			n += NoInline187(i)
+		case 929736495:
+			n += NoInline188(i)
+		case -1911251275:
+			n += NoInline189(i)
+		case 1726881131:
+			n += NoInline190(i)
+		case -2119578972:
+			n += NoInline191(i)
+		case 724473658:
+			n += NoInline192(i)
+		case 1423215840:
+			n += NoInline193(i)
+		case -739043200:
+			n += NoInline194(i)
+		case -1287253784:
+			n += NoInline195(i)
+		case 248791390:
+			n += NoInline196(i)
+		case -3609963:
+			n += NoInline197(i)
+		case 1767552401:
+			n += NoInline198(i)
+		case 479638912:
+			n += NoInline199(i)
+		case -941654037:
+			n += NoInline200(i)
+		case 395642184:
+			n += NoInline201(i)
+		case 229757662:
+			n += NoInline202(i)
+		case -1225372141:
+			n += NoInline203(i)
+		case 1077015772:
+			n += NoInline204(i)
+		case -650116655:
+			n += NoInline205(i)
+		case -112176497:
+			n += NoInline206(i)
+		case 912942324:
+			n += NoInline207(i)
+		case 92486895:
+			n += NoInline208(i)
+		case -235386712:
+			n += NoInline209(i)
+		case 399303777:
+			n += NoInline210(i)
+		case -1521134074:
+			n += NoInline211(i)
+		case 1339538206:
+			n += NoInline212(i)
+		case 1926139776:
+			n += NoInline213(i)
+		case 2092676637:
+			n += NoInline214(i)
+		case 354247513:
+			n += NoInline215(i)
+		case -1409483257:
+			n += NoInline216(i)
+		case 1718795615:
+			n += NoInline217(i)
+		case -428314982:
+			n += NoInline218(i)
+		case -215249991:
+			n += NoInline219(i)
+		case 1371517350:
+			n += NoInline220(i)
+		case 2144844363:
+			n += NoInline221(i)
+		case 1719843706:
+			n += NoInline222(i)
+		case 85077591:
+			n += NoInline223(i)
+		case -1524546462:
+			n += NoInline224(i)
+		case 1841202787:
+			n += NoInline225(i)
+		case 1458556941:
+			n += NoInline226(i)
+		case -483409303:
+			n += NoInline227(i)
+		case 1015018773:
+			n += NoInline228(i)
+		case -1009282032:
+			n += NoInline229(i)
+		case 153565041:
+			n += NoInline230(i)
+		case -1170046776:
+			n += NoInline231(i)
+		case -1607019451:
+			n += NoInline232(i)
+		case -2091273238:
+			n += NoInline233(i)
+		case 311163323:
+			n += NoInline234(i)
+		case -1184427176:
+			n += NoInline235(i)
+		case -516062456:
+			n += NoInline236(i)
+		case -95505138:
+			n += NoInline237(i)
+		case -654981387:
+			n += NoInline238(i)
+		case -720704368:
+			n += NoInline239(i)
+		case 1064484121:
+			n += NoInline240(i)
+		case -371948193:
+			n += NoInline241(i)
+		case 322282363:
+			n += NoInline242(i)
+		case -708223782:
+			n += NoInline243(i)
+		case -1592008263:
+			n += NoInline244(i)
+		case -1066266095:
+			n += NoInline245(i)
+		case -1580533217:
+			n += NoInline246(i)
+		case 416329275:
+			n += NoInline247(i)
+		case 63683067:
+			n += NoInline248(i)
+		case -1787416174:
+			n += NoInline249(i)
+		case 295305609:
+			n += NoInline250(i)
+		case -7413926:
+			n += NoInline251(i)
+		case -1506724278:
+			n += NoInline252(i)
+		case -62424865:
+			n += NoInline253(i)
+		case -709503858:
+			n += NoInline254(i)
+		case 1426170028:
+			n += NoInline255(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchNoInlineSparseComputed512(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysSparse[i%512] {
+		case -1697971134:
+			n += NoInline0(i)
+		case -255511522:
+			n += NoInline1(i)
+		case -1440703602:
+			n += NoInline2(i)
+		case 1879968118:
+			n += NoInline3(i)
+		case 1823804162:
+			n += NoInline4(i)
+		case -1345084660:
+			n += NoInline5(i)
+		case 281908850:
+			n += NoInline6(i)
+		case 672245080:
+			n += NoInline7(i)
+		case 416480912:
+			n += NoInline8(i)
+		case 1292406600:
+			n += NoInline9(i)
+		case -2082145907:
+			n += NoInline10(i)
+		case -800410273:
+			n += NoInline11(i)
+		case 920256325:
+			n += NoInline12(i)
+		case 1634910179:
+			n += NoInline13(i)
+		case 1366049456:
+			n += NoInline14(i)
+		case 2013866549:
+			n += NoInline15(i)
+		case 1215622422:
+			n += NoInline16(i)
+		case 1258862891:
+			n += NoInline17(i)
+		case -1378320822:
+			n += NoInline18(i)
+		case 938678213:
+			n += NoInline19(i)
+		case 872680990:
+			n += NoInline20(i)
+		case 1549930933:
+			n += NoInline21(i)
+		case -1843944239:
+			n += NoInline22(i)
+		case -590594780:
+			n += NoInline23(i)
+		case 1258916094:
+			n += NoInline24(i)
+		case 1275959894:
+			n += NoInline25(i)
+		case -1062690721:
+			n += NoInline26(i)
+		case 887265776:
+			n += NoInline27(i)
+		case -578381716:
+			n += NoInline28(i)
+		case -1302581265:
+			n += NoInline29(i)
+		case -2045176213:
+			n += NoInline30(i)
+		case 121560817:
+			n += NoInline31(i)
+		case 680014774:
+			n += NoInline32(i)
+		case -1686833633:
+			n += NoInline33(i)
+		case -106336438:
+			n += NoInline34(i)
+		case 341250713:
+			n += NoInline35(i)
+		case -1740283822:
+			n += NoInline36(i)
+		case 253921263:
+			n += NoInline37(i)
+		case -1322744326:
+			n += NoInline38(i)
+		case 1295030053:
+			n += NoInline39(i)
+		case 744172826:
+			n += NoInline40(i)
+		case -1970961115:
+			n += NoInline41(i)
+		case -1957836907:
+			n += NoInline42(i)
+		case 1196181127:
+			n += NoInline43(i)
+		case 1817424866:
+			n += NoInline44(i)
+		case -2016119001:
+			n += NoInline45(i)
+		case 1088948157:
+			n += NoInline46(i)
+		case 1211528648:
+			n += NoInline47(i)
+		case -907934977:
+			n += NoInline48(i)
+		case 1553942706:
+			n += NoInline49(i)
+		case -513063382:
+			n += NoInline50(i)
+		case 1276087442:
+			n += NoInline51(i)
+		case -453712918:
+			n += NoInline52(i)
+		case 418564398:
+			n += NoInline53(i)
+		case -99141295:
+			n += NoInline54(i)
+		case 319077410:
+			n += NoInline55(i)
+		case 954725776:
+			n += NoInline56(i)
+		case -1369758219:
+			n += NoInline57(i)
+		case 1037299406:
+			n += NoInline58(i)
+		case 1337978710:
+			n += NoInline59(i)
+		case -288422394:
+			n += NoInline60(i)
+		case -1108750275:
+			n += NoInline61(i)
+		case -854462086:
+			n += NoInline62(i)
+		case -1158646983:
+			n += NoInline63(i)
+		case 785656533:
+			n += NoInline64(i)
+		case 1839779657:
+			n += NoInline65(i)
+		case -442416174:
+			n += NoInline66(i)
+		case -1362992891:
+			n += NoInline67(i)
+		case -90497729:
+			n += NoInline68(i)
+		case -334095804:
+			n += NoInline69(i)
+		case 390143126:
+			n += NoInline70(i)
+		case 2118028752:
+			n += NoInline71(i)
+		case -313589291:
+			n += NoInline72(i)
+		case -193507860:
+			n += NoInline73(i)
+		case 1494450894:
+			n += NoInline74(i)
+		case -1327837108:
+			n += NoInline75(i)
+		case -1241644142:
+			n += NoInline76(i)
+		case -1873552370:
+			n += NoInline77(i)
+		case -1505431304:
+			n += NoInline78(i)
+		case -1925154456:
+			n += NoInline79(i)
+		case -1048730050:
+			n += NoInline80(i)
+		case 1734321906:
+			n += NoInline81(i)
+		case 561142274:
+			n += NoInline82(i)
+		case -60281029:
+			n += NoInline83(i)
+		case -445208814:
+			n += NoInline84(i)
+		case 1383340119:
+			n += NoInline85(i)
+		case -1197661229:
+			n += NoInline86(i)
+		case -1526690010:
+			n += NoInline87(i)
+		case 367307783:
+			n += NoInline88(i)
+		case -1419163291:
+			n += NoInline89(i)
+		case -1620369539:
+			n += NoInline90(i)
+		case 1587818672:
+			n += NoInline91(i)
+		case 1017145093:
+			n += NoInline92(i)
+		case -1995949082:
+			n += NoInline93(i)
+		case 804215881:
+			n += NoInline94(i)
+		case 1025813007:
+			n += NoInline95(i)
+		case -1597306192:
+			n += NoInline96(i)
+		case 544399686:
+			n += NoInline97(i)
+		case 1208304411:
+			n += NoInline98(i)
+		case 1762323197:
+			n += NoInline99(i)
+		case 1867934851:
+			n += NoInline100(i)
+		case -1610204593:
+			n += NoInline101(i)
+		case -1932104265:
+			n += NoInline102(i)
+		case -1616587781:
+			n += NoInline103(i)
+		case -1163159922:
+			n += NoInline104(i)
+		case -727851276:
+			n += NoInline105(i)
+		case 2206820:
+			n += NoInline106(i)
+		case -1133576725:
+			n += NoInline107(i)
+		case 1717917180:
+			n += NoInline108(i)
+		case 2138327264:
+			n += NoInline109(i)
+		case -1700901100:
+			n += NoInline110(i)
+		case 1759297107:
+			n += NoInline111(i)
+		case 127437182:
+			n += NoInline112(i)
+		case 8177164:
+			n += NoInline113(i)
+		case 12210768:
+			n += NoInline114(i)
+		case -361544701:
+			n += NoInline115(i)
+		case -1761648762:
+			n += NoInline116(i)
+		case -1892395022:
+			n += NoInline117(i)
+		case -792828754:
+			n += NoInline118(i)
+		case -523935508:
+			n += NoInline119(i)
+		case 1968995452:
+			n += NoInline120(i)
+		case -1717275692:
+			n += NoInline121(i)
+		case 112807962:
+			n += NoInline122(i)
+		case -662143137:
+			n += NoInline123(i)
+		case 1072424133:
+			n += NoInline124(i)
+		case -1538524756:
+			n += NoInline125(i)
+		case 1062860987:
+			n += NoInline126(i)
+		case 745846173:
+			n += NoInline127(i)
+		case -1749667658:
+			n += NoInline128(i)
+		case -797169334:
+			n += NoInline129(i)
+		case -1314955191:
+			n += NoInline130(i)
+		case 130234351:
+			n += NoInline131(i)
+		case -1979077526:
+			n += NoInline132(i)
+		case -104475875:
+			n += NoInline133(i)
+		case -1070464522:
+			n += NoInline134(i)
+		case 1262747498:
+			n += NoInline135(i)
+		case -1060164240:
+			n += NoInline136(i)
+		case 648385636:
+			n += NoInline137(i)
+		case 1528008769:
+			n += NoInline138(i)
+		case -721851490:
+			n += NoInline139(i)
+		case 995702448:
+			n += NoInline140(i)
+		case -1598438201:
+			n += NoInline141(i)
+		case 2140587224:
+			n += NoInline142(i)
+		case 385843065:
+			n += NoInline143(i)
+		case 108207233:
+			n += NoInline144(i)
+		case 1684555679:
+			n += NoInline145(i)
+		case -1763586216:
+			n += NoInline146(i)
+		case -302315724:
+			n += NoInline147(i)
+		case -1837873194:
+			n += NoInline148(i)
+		case -1767051143:
+			n += NoInline149(i)
+		case 1768507280:
+			n += NoInline150(i)
+		case -1921652594:
+			n += NoInline151(i)
+		case 2111437688:
+			n += NoInline152(i)
+		case -180586567:
+			n += NoInline153(i)
+		case -870982685:
+			n += NoInline154(i)
+		case 461198367:
+			n += NoInline155(i)
+		case -931857694:
+			n += NoInline156(i)
+		case 1689000180:
+			n += NoInline157(i)
+		case 560123204:
+			n += NoInline158(i)
+		case 816184516:
+			n += NoInline159(i)
+		case -1117439762:
+			n += NoInline160(i)
+		case -1485880834:
+			n += NoInline161(i)
+		case 422555156:
+			n += NoInline162(i)
+		case -2059950988:
+			n += NoInline163(i)
+		case 428335644:
+			n += NoInline164(i)
+		case 652162446:
+			n += NoInline165(i)
+		case 327234684:
+			n += NoInline166(i)
+		case 1353808417:
+			n += NoInline167(i)
+		case 685695486:
+			n += NoInline168(i)
+		case 591863937:
+			n += NoInline169(i)
+		case 1385602332:
+			n += NoInline170(i)
+		case -1979659876:
+			n += NoInline171(i)
+		case -1843178226:
+			n += NoInline172(i)
+		case -2092586415:
+			n += NoInline173(i)
+		case -1356457488:
+			n += NoInline174(i)
+		case -1490180972:
+			n += NoInline175(i)
+		case -2042257982:
+			n += NoInline176(i)
+		case -1484898466:
+			n += NoInline177(i)
+		case -1218188554:
+			n += NoInline178(i)
+		case -1560601218:
+			n += NoInline179(i)
+		case 55086860:
+			n += NoInline180(i)
+		case 131779027:
+			n += NoInline181(i)
+		case 421039401:
+			n += NoInline182(i)
+		case 1585322719:
+			n += NoInline183(i)
+		case -745373855:
+			n += NoInline184(i)
+		case 1493281567:
+			n += NoInline185(i)
+		case 1478821740:
+			n += NoInline186(i)
+		case 1086625968:
+			n += NoInline187(i)
+		case 929736495:
+			n += NoInline188(i)
+		case -1911251275:
+			n += NoInline189(i)
+		case 1726881131:
+			n += NoInline190(i)
+		case -2119578972:
+			n += NoInline191(i)
+		case 724473658:
+			n += NoInline192(i)
+		case 1423215840:
+			n += NoInline193(i)
+		case -739043200:
+			n += NoInline194(i)
+		case -1287253784:
+			n += NoInline195(i)
+		case 248791390:
+			n += NoInline196(i)
+		case -3609963:
+			n += NoInline197(i)
+		case 1767552401:
+			n += NoInline198(i)
+		case 479638912:
+			n += NoInline199(i)
+		case -941654037:
+			n += NoInline200(i)
+		case 395642184:
+			n += NoInline201(i)
+		case 229757662:
+			n += NoInline202(i)
+		case -1225372141:
+			n += NoInline203(i)
+		case 1077015772:
+			n += NoInline204(i)
+		case -650116655:
+			n += NoInline205(i)
+		case -112176497:
+			n += NoInline206(i)
+		case 912942324:
+			n += NoInline207(i)
+		case 92486895:
+			n += NoInline208(i)
+		case -235386712:
+			n += NoInline209(i)
+		case 399303777:
+			n += NoInline210(i)
+		case -1521134074:
+			n += NoInline211(i)
+		case 1339538206:
+			n += NoInline212(i)
+		case 1926139776:
+			n += NoInline213(i)
+		case 2092676637:
+			n += NoInline214(i)
+		case 354247513:
+			n += NoInline215(i)
+		case -1409483257:
+			n += NoInline216(i)
+		case 1718795615:
+			n += NoInline217(i)
+		case -428314982:
+			n += NoInline218(i)
+		case -215249991:
+			n += NoInline219(i)
+		case 1371517350:
+			n += NoInline220(i)
+		case 2144844363:
+			n += NoInline221(i)
+		case 1719843706:
+			n += NoInline222(i)
+		case 85077591:
+			n += NoInline223(i)
+		case -1524546462:
+			n += NoInline224(i)
+		case 1841202787:
+			n += NoInline225(i)
+		case 1458556941:
+			n += NoInline226(i)
+		case -483409303:
+			n += NoInline227(i)
+		case 1015018773:
+			n += NoInline228(i)
+		case -1009282032:
+			n += NoInline229(i)
+		case 153565041:
+			n += NoInline230(i)
+		case -1170046776:
+			n += NoInline231(i)
+		case -1607019451:
+			n += NoInline232(i)
+		case -2091273238:
+			n += NoInline233(i)
+		case 311163323:
+			n += NoInline234(i)
+		case -1184427176:
+			n += NoInline235(i)
+		case -516062456:
+			n += NoInline236(i)
+		case -95505138:
+			n += NoInline237(i)
+		case -654981387:
+			n += NoInline238(i)
+		case -720704368:
+			n += NoInline239(i)
+		case 1064484121:
+			n += NoInline240(i)
+		case -371948193:
+			n += NoInline241(i)
+		case 322282363:
+			n += NoInline242(i)
+		case -708223782:
+			n += NoInline243(i)
+		case -1592008263:
+			n += NoInline244(i)
+		case -1066266095:
+			n += NoInline245(i)
+		case -1580533217:
+			n += NoInline246(i)
+		case 416329275:
+			n += NoInline247(i)
+		case 63683067:
+			n += NoInline248(i)
+		case -1787416174:
+			n += NoInline249(i)
+		case 295305609:
+			n += NoInline250(i)
+		case -7413926:
+			n += NoInline251(i)
+		case -1506724278:
+			n += NoInline252(i)
+		case -62424865:
+			n += NoInline253(i)
+		case -709503858:
+			n += NoInline254(i)
+		case 1426170028:
+			n += NoInline255(i)
+		case -1454305216:
+			n += NoInline256(i)
+		case -188889971:
+			n += NoInline257(i)
+		case 1333631481:
+			n += NoInline258(i)
+		case 791952000:
+			n += NoInline259(i)
+		case -141328728:
+			n += NoInline260(i)
+		case -716220949:
+			n += NoInline261(i)
+		case 1329500471:
+			n += NoInline262(i)
+		case -833774410:
+			n += NoInline263(i)
+		case 1792400843:
+			n += NoInline264(i)
+		case -1208902515:
+			n += NoInline265(i)
+		case 1746921148:
+			n += NoInline266(i)
+		case -447521558:
+			n += NoInline267(i)
+		case -179631155:
+			n += NoInline268(i)
+		case 80372672:
+			n += NoInline269(i)
+		case -894760620:
+			n += NoInline270(i)
+		case 1819146944:
+			n += NoInline271(i)
+		case 65203089:
+			n += NoInline272(i)
+		case 1858424790:
+			n += NoInline273(i)
+		case -408982948:
+			n += NoInline274(i)
+		case -619744821:
+			n += NoInline275(i)
+		case 184347049:
+			n += NoInline276(i)
+		case -1464452710:
+			n += NoInline277(i)
+		case 1494043213:
+			n += NoInline278(i)
+		case -2132507999:
+			n += NoInline279(i)
+		case -687420592:
+			n += NoInline280(i)
+		case 99254840:
+			n += NoInline281(i)
+		case 534137245:
+			n += NoInline282(i)
+		case 1121740742:
+			n += NoInline283(i)
+		case -708895062:
+			n += NoInline284(i)
+		case 1352076303:
+			n += NoInline285(i)
+		case 32990530:
+			n += NoInline286(i)
+		case -430569932:
+			n += NoInline287(i)
+		case 1590286959:
+			n += NoInline288(i)
+		case 430353185:
+			n += NoInline289(i)
+		case -1532427038:
+			n += NoInline290(i)
+		case -988318833:
+			n += NoInline291(i)
+		case -897109853:
+			n += NoInline292(i)
+		case 1126921711:
+			n += NoInline293(i)
+		case 1489769022:
+			n += NoInline294(i)
+		case 921930687:
+			n += NoInline295(i)
+		case -764105189:
+			n += NoInline296(i)
+		case 1508110333:
+			n += NoInline297(i)
+		case -1721447578:
+			n += NoInline298(i)
+		case -1810967562:
+			n += NoInline299(i)
+		case 1776316817:
+			n += NoInline300(i)
+		case 514754016:
+			n += NoInline301(i)
+		case -379616239:
+			n += NoInline302(i)
+		case 231007307:
+			n += NoInline303(i)
+		case 983193548:
+			n += NoInline304(i)
+		case 1392316569:
+			n += NoInline305(i)
+		case 1506524751:
+			n += NoInline306(i)
+		case 1500183748:
+			n += NoInline307(i)
+		case 1304820212:
+			n += NoInline308(i)
+		case -134234221:
+			n += NoInline309(i)
+		case -1410782724:
+			n += NoInline310(i)
+		case 893108935:
+			n += NoInline311(i)
+		case -158315067:
+			n += NoInline312(i)
+		case 1297949317:
+			n += NoInline313(i)
+		case -824886777:
+			n += NoInline314(i)
+		case 575887093:
+			n += NoInline315(i)
+		case -224367850:
+			n += NoInline316(i)
+		case -1542473848:
+			n += NoInline317(i)
+		case -200752122:
+			n += NoInline318(i)
+		case -816583982:
+			n += NoInline319(i)
+		case 779926748:
+			n += NoInline320(i)
+		case -245855218:
+			n += NoInline321(i)
+		case -724814234:
+			n += NoInline322(i)
+		case 2124636293:
+			n += NoInline323(i)
+		case -621437330:
+			n += NoInline324(i)
+		case -1242345439:
+			n += NoInline325(i)
+		case 1174651033:
+			n += NoInline326(i)
+		case 1750769853:
+			n += NoInline327(i)
+		case -387572341:
+			n += NoInline328(i)
+		case -238812471:
+			n += NoInline329(i)
+		case 2141610073:
+			n += NoInline330(i)
+		case 1239692102:
+			n += NoInline331(i)
+		case -103529086:
+			n += NoInline332(i)
+		case 1943835524:
+			n += NoInline333(i)
+		case 193233579:
+			n += NoInline334(i)
+		case 1354469397:
+			n += NoInline335(i)
+		case -206561521:
+			n += NoInline336(i)
+		case -1067028436:
+			n += NoInline337(i)
+		case -1993762478:
+			n += NoInline338(i)
+		case -1418565040:
+			n += NoInline339(i)
+		case -579068977:
+			n += NoInline340(i)
+		case 1970893722:
+			n += NoInline341(i)
+		case -1810110091:
+			n += NoInline342(i)
+		case 2068154836:
+			n += NoInline343(i)
+		case -1930090606:
+			n += NoInline344(i)
+		case -212071554:
+			n += NoInline345(i)
+		case -2105111990:
+			n += NoInline346(i)
+		case -1105890816:
+			n += NoInline347(i)
+		case 2107944240:
+			n += NoInline348(i)
+		case 284118163:
+			n += NoInline349(i)
+		case 1127388811:
+			n += NoInline350(i)
+		case -320112743:
+			n += NoInline351(i)
+		case 1595523019:
+			n += NoInline352(i)
+		case 1758442933:
+			n += NoInline353(i)
+		case 1785641113:
+			n += NoInline354(i)
+		case 417735390:
+			n += NoInline355(i)
+		case -422506136:
+			n += NoInline356(i)
+		case 19089668:
+			n += NoInline357(i)
+		case 1176496955:
+			n += NoInline358(i)
+		case 469468534:
+			n += NoInline359(i)
+		case -620844340:
+			n += NoInline360(i)
+		case 1104044350:
+			n += NoInline361(i)
+		case -46677310:
+			n += NoInline362(i)
+		case -316060535:
+			n += NoInline363(i)
+		case 734207617:
+			n += NoInline364(i)
+		case 1305185263:
+			n += NoInline365(i)
+		case -2003810766:
+			n += NoInline366(i)
+		case 758017117:
+			n += NoInline367(i)
+		case -800621527:
+			n += NoInline368(i)
+		case -1266426411:
+			n += NoInline369(i)
+		case 1104698047:
+			n += NoInline370(i)
+		case 1075326325:
+			n += NoInline371(i)
+		case 1439219346:
+			n += NoInline372(i)
+		case -1068413352:
+			n += NoInline373(i)
+		case 20958506:
+			n += NoInline374(i)
+		case -682928979:
+			n += NoInline375(i)
+		case 986011055:
+			n += NoInline376(i)
+		case 57060992:
+			n += NoInline377(i)
+		case -215017199:
+			n += NoInline378(i)
+		case -432196269:
+			n += NoInline379(i)
+		case -160527834:
+			n += NoInline380(i)
+		case 184685148:
+			n += NoInline381(i)
+		case -1234104684:
+			n += NoInline382(i)
+		case 219447481:
+			n += NoInline383(i)
+		case 1750337062:
+			n += NoInline384(i)
+		case 2043104664:
+			n += NoInline385(i)
+		case 1492365354:
+			n += NoInline386(i)
+		case 174890805:
+			n += NoInline387(i)
+		case -1728439988:
+			n += NoInline388(i)
+		case 1117226966:
+			n += NoInline389(i)
+		case -717878635:
+			n += NoInline390(i)
+		case -169661923:
+			n += NoInline391(i)
+		case -271838922:
+			n += NoInline392(i)
+		case 984922924:
+			n += NoInline393(i)
+		case -1201246088:
+			n += NoInline394(i)
+		case -1045900454:
+			n += NoInline395(i)
+		case 1933396386:
+			n += NoInline396(i)
+		case 1455896797:
+			n += NoInline397(i)
+		case 2029337912:
+			n += NoInline398(i)
+		case -60153766:
+			n += NoInline399(i)
+		case 1556201844:
+			n += NoInline400(i)
+		case 1072260091:
+			n += NoInline401(i)
+		case 205592989:
+			n += NoInline402(i)
+		case -903157683:
+			n += NoInline403(i)
+		case -1047069298:
+			n += NoInline404(i)
+		case -1471159248:
+			n += NoInline405(i)
+		case 949182841:
+			n += NoInline406(i)
+		case -1471003208:
+			n += NoInline407(i)
+		case 1678655519:
+			n += NoInline408(i)
+		case -346799282:
+			n += NoInline409(i)
+		case 642894248:
+			n += NoInline410(i)
+		case -2059900581:
+			n += NoInline411(i)
+		case -1006789590:
+			n += NoInline412(i)
+		case 1065935549:
+			n += NoInline413(i)
+		case 1956116951:
+			n += NoInline414(i)
+		case -1289395816:
+			n += NoInline415(i)
+		case 352056179:
+			n += NoInline416(i)
+		case -2094775668:
+			n += NoInline417(i)
+		case 1144101090:
+			n += NoInline418(i)
+		case -1623490618:
+			n += NoInline419(i)
+		case 425503163:
+			n += NoInline420(i)
+		case 603766263:
+			n += NoInline421(i)
+		case 683774103:
+			n += NoInline422(i)
+		case -240453325:
+			n += NoInline423(i)
+		case -1582796037:
+			n += NoInline424(i)
+		case 1055668406:
+			n += NoInline425(i)
+		case -139563906:
+			n += NoInline426(i)
+		case -1988653112:
+			n += NoInline427(i)
+		case 1452476744:
+			n += NoInline428(i)
+		case -1410176746:
+			n += NoInline429(i)
+		case -698078541:
+			n += NoInline430(i)
+		case -1143084771:
+			n += NoInline431(i)
+		case -2137945349:
+			n += NoInline432(i)
+		case 1818806034:
+			n += NoInline433(i)
+		case 1988739519:
+			n += NoInline434(i)
+		case -201671681:
+			n += NoInline435(i)
+		case -1099199736:
+			n += NoInline436(i)
+		case 1955024410:
+			n += NoInline437(i)
+		case 1066942813:
+			n += NoInline438(i)
+		case -1203082347:
+			n += NoInline439(i)
+		case 1819787089:
+			n += NoInline440(i)
+		case -1105563850:
+			n += NoInline441(i)
+		case -1473825507:
+			n += NoInline442(i)
+		case -1363559451:
+			n += NoInline443(i)
+		case -1559971230:
+			n += NoInline444(i)
+		case 1067655148:
+			n += NoInline445(i)
+		case 1661557221:
+			n += NoInline446(i)
+		case -987967235:
+			n += NoInline447(i)
+		case 542730790:
+			n += NoInline448(i)
+		case 1231099828:
+			n += NoInline449(i)
+		case -2098782249:
+			n += NoInline450(i)
+		case 852815300:
+			n += NoInline451(i)
+		case -23165478:
+			n += NoInline452(i)
+		case -1563568742:
+			n += NoInline453(i)
+		case -1171156006:
+			n += NoInline454(i)
+		case 1770881343:
+			n += NoInline455(i)
+		case -2087252879:
+			n += NoInline456(i)
+		case 563456396:
+			n += NoInline457(i)
+		case -1951621827:
+			n += NoInline458(i)
+		case -665569537:
+			n += NoInline459(i)
+		case 1259646708:
+			n += NoInline460(i)
+		case -952177854:
+			n += NoInline461(i)
+		case 608935175:
+			n += NoInline462(i)
+		case -1484428644:
+			n += NoInline463(i)
+		case -1583347276:
+			n += NoInline464(i)
+		case 1692067842:
+			n += NoInline465(i)
+		case 6062535:
+			n += NoInline466(i)
+		case -1864588694:
+			n += NoInline467(i)
+		case -481929598:
+			n += NoInline468(i)
+		case 1943398976:
+			n += NoInline469(i)
+		case -1734399456:
+			n += NoInline470(i)
+		case -1534010547:
+			n += NoInline471(i)
+		case -730838493:
+			n += NoInline472(i)
+		case -814411016:
+			n += NoInline473(i)
+		case 283187946:
+			n += NoInline474(i)
+		case 349931193:
+			n += NoInline475(i)
+		case 1441038932:
+			n += NoInline476(i)
+		case 2107260151:
+			n += NoInline477(i)
+		case -1636255076:
+			n += NoInline478(i)
+		case -1599193552:
+			n += NoInline479(i)
+		case 1394335827:
+			n += NoInline480(i)
+		case -888136867:
+			n += NoInline481(i)
+		case 361579919:
+			n += NoInline482(i)
+		case 1092320650:
+			n += NoInline483(i)
+		case -1341884404:
+			n += NoInline484(i)
+		case 1804819842:
+			n += NoInline485(i)
+		case 95052488:
+			n += NoInline486(i)
+		case 931994127:
+			n += NoInline487(i)
+		case 336062872:
+			n += NoInline488(i)
+		case -1873274321:
+			n += NoInline489(i)
+		case 981722977:
+			n += NoInline490(i)
+		case 1578716447:
+			n += NoInline491(i)
+		case 1168423850:
+			n += NoInline492(i)
+		case -428209243:
+			n += NoInline493(i)
+		case -1452428858:
+			n += NoInline494(i)
+		case 1911818401:
+			n += NoInline495(i)
+		case -134241068:
+			n += NoInline496(i)
+		case -1427589835:
+			n += NoInline497(i)
+		case 1565086353:
+			n += NoInline498(i)
+		case 28462600:
+			n += NoInline499(i)
+		case 885516103:
+			n += NoInline500(i)
+		case -934348428:
+			n += NoInline501(i)
+		case -1003170058:
+			n += NoInline502(i)
+		case -823595963:
+			n += NoInline503(i)
+		case 1236561826:
+			n += NoInline504(i)
+		case -1675253206:
+			n += NoInline505(i)
+		case 1942201082:
+			n += NoInline506(i)
+		case 972851284:
+			n += NoInline507(i)
+		case 1670984478:
+			n += NoInline508(i)
+		case 1060275388:
+			n += NoInline509(i)
+		case 32334443:
+			n += NoInline510(i)
+		case 659173149:
+			n += NoInline511(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchNoInlineSparseLookupMod512(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysSparse[inputs[i%len(inputs)]] {
+		case -1697971134:
+			n += NoInline0(i)
+		case -255511522:
+			n += NoInline1(i)
+		case -1440703602:
+			n += NoInline2(i)
+		case 1879968118:
+			n += NoInline3(i)
+		case 1823804162:
+			n += NoInline4(i)
+		case -1345084660:
+			n += NoInline5(i)
+		case 281908850:
+			n += NoInline6(i)
+		case 672245080:
+			n += NoInline7(i)
+		case 416480912:
+			n += NoInline8(i)
+		case 1292406600:
+			n += NoInline9(i)
+		case -2082145907:
+			n += NoInline10(i)
+		case -800410273:
+			n += NoInline11(i)
+		case 920256325:
+			n += NoInline12(i)
+		case 1634910179:
+			n += NoInline13(i)
+		case 1366049456:
+			n += NoInline14(i)
+		case 2013866549:
+			n += NoInline15(i)
+		case 1215622422:
+			n += NoInline16(i)
+		case 1258862891:
+			n += NoInline17(i)
+		case -1378320822:
+			n += NoInline18(i)
+		case 938678213:
+			n += NoInline19(i)
+		case 872680990:
+			n += NoInline20(i)
+		case 1549930933:
+			n += NoInline21(i)
+		case -1843944239:
+			n += NoInline22(i)
+		case -590594780:
+			n += NoInline23(i)
+		case 1258916094:
+			n += NoInline24(i)
+		case 1275959894:
+			n += NoInline25(i)
+		case -1062690721:
+			n += NoInline26(i)
+		case 887265776:
+			n += NoInline27(i)
+		case -578381716:
+			n += NoInline28(i)
+		case -1302581265:
+			n += NoInline29(i)
+		case -2045176213:
+			n += NoInline30(i)
+		case 121560817:
+			n += NoInline31(i)
+		case 680014774:
+			n += NoInline32(i)
+		case -1686833633:
+			n += NoInline33(i)
+		case -106336438:
+			n += NoInline34(i)
+		case 341250713:
+			n += NoInline35(i)
+		case -1740283822:
+			n += NoInline36(i)
+		case 253921263:
+			n += NoInline37(i)
+		case -1322744326:
+			n += NoInline38(i)
+		case 1295030053:
+			n += NoInline39(i)
+		case 744172826:
+			n += NoInline40(i)
+		case -1970961115:
+			n += NoInline41(i)
+		case -1957836907:
+			n += NoInline42(i)
+		case 1196181127:
+			n += NoInline43(i)
+		case 1817424866:
+			n += NoInline44(i)
+		case -2016119001:
+			n += NoInline45(i)
+		case 1088948157:
+			n += NoInline46(i)
+		case 1211528648:
+			n += NoInline47(i)
+		case -907934977:
+			n += NoInline48(i)
+		case 1553942706:
+			n += NoInline49(i)
+		case -513063382:
+			n += NoInline50(i)
+		case 1276087442:
+			n += NoInline51(i)
+		case -453712918:
+			n += NoInline52(i)
+		case 418564398:
+			n += NoInline53(i)
+		case -99141295:
+			n += NoInline54(i)
+		case 319077410:
+			n += NoInline55(i)
+		case 954725776:
+			n += NoInline56(i)
+		case -1369758219:
+			n += NoInline57(i)
+		case 1037299406:
+			n += NoInline58(i)
+		case 1337978710:
+			n += NoInline59(i)
+		case -288422394:
+			n += NoInline60(i)
+		case -1108750275:
+			n += NoInline61(i)
+		case -854462086:
+			n += NoInline62(i)
+		case -1158646983:
+			n += NoInline63(i)
+		case 785656533:
+			n += NoInline64(i)
+		case 1839779657:
+			n += NoInline65(i)
+		case -442416174:
+			n += NoInline66(i)
+		case -1362992891:
+			n += NoInline67(i)
+		case -90497729:
+			n += NoInline68(i)
+		case -334095804:
+			n += NoInline69(i)
+		case 390143126:
+			n += NoInline70(i)
+		case 2118028752:
+			n += NoInline71(i)
+		case -313589291:
+			n += NoInline72(i)
+		case -193507860:
+			n += NoInline73(i)
+		case 1494450894:
+			n += NoInline74(i)
+		case -1327837108:
+			n += NoInline75(i)
+		case -1241644142:
+			n += NoInline76(i)
+		case -1873552370:
+			n += NoInline77(i)
+		case -1505431304:
+			n += NoInline78(i)
+		case -1925154456:
+			n += NoInline79(i)
+		case -1048730050:
+			n += NoInline80(i)
+		case 1734321906:
+			n += NoInline81(i)
+		case 561142274:
+			n += NoInline82(i)
+		case -60281029:
+			n += NoInline83(i)
+		case -445208814:
+			n += NoInline84(i)
+		case 1383340119:
+			n += NoInline85(i)
+		case -1197661229:
+			n += NoInline86(i)
+		case -1526690010:
+			n += NoInline87(i)
+		case 367307783:
+			n += NoInline88(i)
+		case -1419163291:
+			n += NoInline89(i)
+		case -1620369539:
+			n += NoInline90(i)
+		case 1587818672:
+			n += NoInline91(i)
+		case 1017145093:
+			n += NoInline92(i)
+		case -1995949082:
+			n += NoInline93(i)
+		case 804215881:
+			n += NoInline94(i)
+		case 1025813007:
+			n += NoInline95(i)
+		case -1597306192:
+			n += NoInline96(i)
+		case 544399686:
+			n += NoInline97(i)
+		case 1208304411:
+			n += NoInline98(i)
+		case 1762323197:
+			n += NoInline99(i)
+		case 1867934851:
+			n += NoInline100(i)
+		case -1610204593:
+			n += NoInline101(i)
+		case -1932104265:
+			n += NoInline102(i)
+		case -1616587781:
+			n += NoInline103(i)
+		case -1163159922:
+			n += NoInline104(i)
+		case -727851276:
+			n += NoInline105(i)
+		case 2206820:
+			n += NoInline106(i)
+		case -1133576725:
+			n += NoInline107(i)
+		case 1717917180:
+			n += NoInline108(i)
+		case 2138327264:
+			n += NoInline109(i)
+		case -1700901100:
+			n += NoInline110(i)
+		case 1759297107:
+			n += NoInline111(i)
+		case 127437182:
+			n += NoInline112(i)
+		case 8177164:
+			n += NoInline113(i)
+		case 12210768:
+			n += NoInline114(i)
+		case -361544701:
+			n += NoInline115(i)
+		case -1761648762:
+			n += NoInline116(i)
+		case -1892395022:
+			n += NoInline117(i)
+		case -792828754:
+			n += NoInline118(i)
+		case -523935508:
+			n += NoInline119(i)
+		case 1968995452:
+			n += NoInline120(i)
+		case -1717275692:
+			n += NoInline121(i)
+		case 112807962:
+			n += NoInline122(i)
+		case -662143137:
+			n += NoInline123(i)
+		case 1072424133:
+			n += NoInline124(i)
+		case -1538524756:
+			n += NoInline125(i)
+		case 1062860987:
+			n += NoInline126(i)
+		case 745846173:
+			n += NoInline127(i)
+		case -1749667658:
+			n += NoInline128(i)
+		case -797169334:
+			n += NoInline129(i)
+		case -1314955191:
+			n += NoInline130(i)
+		case 130234351:
+			n += NoInline131(i)
+		case -1979077526:
+			n += NoInline132(i)
+		case -104475875:
+			n += NoInline133(i)
+		case -1070464522:
+			n += NoInline134(i)
+		case 1262747498:
+			n += NoInline135(i)
+		case -1060164240:
+			n += NoInline136(i)
+		case 648385636:
+			n += NoInline137(i)
+		case 1528008769:
+			n += NoInline138(i)
+		case -721851490:
+			n += NoInline139(i)
+		case 995702448:
+			n += NoInline140(i)
+		case -1598438201:
+			n += NoInline141(i)
+		case 2140587224:
+			n += NoInline142(i)
+		case 385843065:
+			n += NoInline143(i)
+		case 108207233:
+			n += NoInline144(i)
+		case 1684555679:
+			n += NoInline145(i)
+		case -1763586216:
+			n += NoInline146(i)
+		case -302315724:
+			n += NoInline147(i)
+		case -1837873194:
+			n += NoInline148(i)
+		case -1767051143:
+			n += NoInline149(i)
+		case 1768507280:
+			n += NoInline150(i)
+		case -1921652594:
+			n += NoInline151(i)
+		case 2111437688:
+			n += NoInline152(i)
+		case -180586567:
+			n += NoInline153(i)
+		case -870982685:
+			n += NoInline154(i)
+		case 461198367:
+			n += NoInline155(i)
+		case -931857694:
+			n += NoInline156(i)
+		case 1689000180:
+			n += NoInline157(i)
+		case 560123204:
+			n += NoInline158(i)
+		case 816184516:
+			n += NoInline159(i)
+		case -1117439762:
+			n += NoInline160(i)
+		case -1485880834:
+			n += NoInline161(i)
+		case 422555156:
+			n += NoInline162(i)
+		case -2059950988:
+			n += NoInline163(i)
+		case 428335644:
+			n += NoInline164(i)
+		case 652162446:
+			n += NoInline165(i)
+		case 327234684:
+			n += NoInline166(i)
+		case 1353808417:
+			n += NoInline167(i)
+		case 685695486:
+			n += NoInline168(i)
+		case 591863937:
+			n += NoInline169(i)
+		case 1385602332:
+			n += NoInline170(i)
+		case -1979659876:
+			n += NoInline171(i)
+		case -1843178226:
+			n += NoInline172(i)
+		case -2092586415:
+			n += NoInline173(i)
+		case -1356457488:
+			n += NoInline174(i)
+		case -1490180972:
+			n += NoInline175(i)
+		case -2042257982:
+			n += NoInline176(i)
+		case -1484898466:
+			n += NoInline177(i)
+		case -1218188554:
+			n += NoInline178(i)
+		case -1560601218:
+			n += NoInline179(i)
+		case 55086860:
+			n += NoInline180(i)
+		case 131779027:
+			n += NoInline181(i)
+		case 421039401:
+			n += NoInline182(i)
+		case 1585322719:
+			n += NoInline183(i)
+		case -745373855:
+			n += NoInline184(i)
+		case 1493281567:
+			n += NoInline185(i)
+		case 1478821740:
+			n += NoInline186(i)
+		case 1086625968:
+			n += NoInline187(i)
+		case 929736495:
+			n += NoInline188(i)
+		case -1911251275:
+			n += NoInline189(i)
+		case 1726881131:
+			n += NoInline190(i)
+		case -2119578972:
+			n += NoInline191(i)
+		case 724473658:
+			n += NoInline192(i)
+		case 1423215840:
+			n += NoInline193(i)
+		case -739043200:
+			n += NoInline194(i)
+		case -1287253784:
+			n += NoInline195(i)
+		case 248791390:
+			n += NoInline196(i)
+		case -3609963:
+			n += NoInline197(i)
+		case 1767552401:
+			n += NoInline198(i)
+		case 479638912:
+			n += NoInline199(i)
+		case -941654037:
+			n += NoInline200(i)
+		case 395642184:
+			n += NoInline201(i)
+		case 229757662:
+			n += NoInline202(i)
+		case -1225372141:
+			n += NoInline203(i)
+		case 1077015772:
+			n += NoInline204(i)
+		case -650116655:
+			n += NoInline205(i)
+		case -112176497:
+			n += NoInline206(i)
+		case 912942324:
+			n += NoInline207(i)
+		case 92486895:
+			n += NoInline208(i)
+		case -235386712:
+			n += NoInline209(i)
+		case 399303777:
+			n += NoInline210(i)
+		case -1521134074:
+			n += NoInline211(i)
+		case 1339538206:
+			n += NoInline212(i)
+		case 1926139776:
+			n += NoInline213(i)
+		case 2092676637:
+			n += NoInline214(i)
+		case 354247513:
+			n += NoInline215(i)
+		case -1409483257:
+			n += NoInline216(i)
+		case 1718795615:
+			n += NoInline217(i)
+		case -428314982:
+			n += NoInline218(i)
+		case -215249991:
+			n += NoInline219(i)
+		case 1371517350:
+			n += NoInline220(i)
+		case 2144844363:
+			n += NoInline221(i)
+		case 1719843706:
+			n += NoInline222(i)
+		case 85077591:
+			n += NoInline223(i)
+		case -1524546462:
+			n += NoInline224(i)
+		case 1841202787:
+			n += NoInline225(i)
+		case 1458556941:
+			n += NoInline226(i)
+		case -483409303:
+			n += NoInline227(i)
+		case 1015018773:
+			n += NoInline228(i)
+		case -1009282032:
+			n += NoInline229(i)
+		case 153565041:
+			n += NoInline230(i)
+		case -1170046776:
+			n += NoInline231(i)
+		case -1607019451:
+			n += NoInline232(i)
+		case -2091273238:
+			n += NoInline233(i)
+		case 311163323:
+			n += NoInline234(i)
+		case -1184427176:
+			n += NoInline235(i)
+		case -516062456:
+			n += NoInline236(i)
+		case -95505138:
+			n += NoInline237(i)
+		case -654981387:
+			n += NoInline238(i)
+		case -720704368:
+			n += NoInline239(i)
+		case 1064484121:
+			n += NoInline240(i)
+		case -371948193:
+			n += NoInline241(i)
+		case 322282363:
+			n += NoInline242(i)
+		case -708223782:
+			n += NoInline243(i)
+		case -1592008263:
+			n += NoInline244(i)
+		case -1066266095:
+			n += NoInline245(i)
+		case -1580533217:
+			n += NoInline246(i)
+		case 416329275:
+			n += NoInline247(i)
+		case 63683067:
+			n += NoInline248(i)
+		case -1787416174:
+			n += NoInline249(i)
+		case 295305609:
+			n += NoInline250(i)
+		case -7413926:
+			n += NoInline251(i)
+		case -1506724278:
+			n += NoInline252(i)
+		case -62424865:
+			n += NoInline253(i)
+		case -709503858:
+			n += NoInline254(i)
+		case 1426170028:
+			n += NoInline255(i)
+		case -1454305216:
+			n += NoInline256(i)
+		case -188889971:
+			n += NoInline257(i)
+		case 1333631481:
+			n += NoInline258(i)
+		case 791952000:
+			n += NoInline259(i)
+		case -141328728:
+			n += NoInline260(i)
+		case -716220949:
+			n += NoInline261(i)
+		case 1329500471:
+			n += NoInline262(i)
+		case -833774410:
+			n += NoInline263(i)
+		case 1792400843:
+			n += NoInline264(i)
+		case -1208902515:
+			n += NoInline265(i)
+		case 1746921148:
+			n += NoInline266(i)
+		case -447521558:
+			n += NoInline267(i)
+		case -179631155:
+			n += NoInline268(i)
+		case 80372672:
+			n += NoInline269(i)
+		case -894760620:
+			n += NoInline270(i)
+		case 1819146944:
+			n += NoInline271(i)
+		case 65203089:
+			n += NoInline272(i)
+		case 1858424790:
+			n += NoInline273(i)
+		case -408982948:
+			n += NoInline274(i)
+		case -619744821:
+			n += NoInline275(i)
+		case 184347049:
+			n += NoInline276(i)
+		case -1464452710:
+			n += NoInline277(i)
+		case 1494043213:
+			n += NoInline278(i)
+		case -2132507999:
+			n += NoInline279(i)
+		case -687420592:
+			n += NoInline280(i)
+		case 99254840:
+			n += NoInline281(i)
+		case 534137245:
+			n += NoInline282(i)
+		case 1121740742:
+			n += NoInline283(i)
+		case -708895062:
+			n += NoInline284(i)
+		case 1352076303:
+			n += NoInline285(i)
+		case 32990530:
+			n += NoInline286(i)
+		case -430569932:
+			n += NoInline287(i)
+		case 1590286959:
+			n += NoInline288(i)
+		case 430353185:
+			n += NoInline289(i)
+		case -1532427038:
+			n += NoInline290(i)
+		case -988318833:
+			n += NoInline291(i)
+		case -897109853:
+			n += NoInline292(i)
+		case 1126921711:
+			n += NoInline293(i)
+		case 1489769022:
+			n += NoInline294(i)
+		case 921930687:
+			n += NoInline295(i)
+		case -764105189:
+			n += NoInline296(i)
+		case 1508110333:
+			n += NoInline297(i)
+		case -1721447578:
+			n += NoInline298(i)
+		case -1810967562:
+			n += NoInline299(i)
+		case 1776316817:
+			n += NoInline300(i)
+		case 514754016:
+			n += NoInline301(i)
+		case -379616239:
+			n += NoInline302(i)
+		case 231007307:
+			n += NoInline303(i)
+		case 983193548:
+			n += NoInline304(i)
+		case 1392316569:
+			n += NoInline305(i)
+		case 1506524751:
+			n += NoInline306(i)
+		case 1500183748:
+			n += NoInline307(i)
+		case 1304820212:
+			n += NoInline308(i)
+		case -134234221:
+			n += NoInline309(i)
+		case -1410782724:
+			n += NoInline310(i)
+		case 893108935:
+			n += NoInline311(i)
+		case -158315067:
+			n += NoInline312(i)
+		case 1297949317:
+			n += NoInline313(i)
+		case -824886777:
+			n += NoInline314(i)
+		case 575887093:
+			n += NoInline315(i)
+		case -224367850:
+			n += NoInline316(i)
+		case -1542473848:
+			n += NoInline317(i)
+		case -200752122:
+			n += NoInline318(i)
+		case -816583982:
+			n += NoInline319(i)
+		case 779926748:
+			n += NoInline320(i)
+		case -245855218:
+			n += NoInline321(i)
+		case -724814234:
+			n += NoInline322(i)
+		case 2124636293:
+			n += NoInline323(i)
+		case -621437330:
+			n += NoInline324(i)
+		case -1242345439:
+			n += NoInline325(i)
+		case 1174651033:
+			n += NoInline326(i)
+		case 1750769853:
+			n += NoInline327(i)
+		case -387572341:
+			n += NoInline328(i)
+		case -238812471:
+			n += NoInline329(i)
+		case 2141610073:
+			n += NoInline330(i)
+		case 1239692102:
+			n += NoInline331(i)
+		case -103529086:
+			n += NoInline332(i)
+		case 1943835524:
+			n += NoInline333(i)
+		case 193233579:
+			n += NoInline334(i)
+		case 1354469397:
+			n += NoInline335(i)
+		case -206561521:
+			n += NoInline336(i)
+		case -1067028436:
+			n += NoInline337(i)
+		case -1993762478:
+			n += NoInline338(i)
+		case -1418565040:
+			n += NoInline339(i)
+		case -579068977:
+			n += NoInline340(i)
+		case 1970893722:
+			n += NoInline341(i)
+		case -1810110091:
+			n += NoInline342(i)
+		case 2068154836:
+			n += NoInline343(i)
+		case -1930090606:
+			n += NoInline344(i)
+		case -212071554:
+			n += NoInline345(i)
+		case -2105111990:
+			n += NoInline346(i)
+		case -1105890816:
+			n += NoInline347(i)
+		case 2107944240:
+			n += NoInline348(i)
+		case 284118163:
+			n += NoInline349(i)
+		case 1127388811:
+			n += NoInline350(i)
+		case -320112743:
+			n += NoInline351(i)
+		case 1595523019:
+			n += NoInline352(i)
+		case 1758442933:
+			n += NoInline353(i)
+		case 1785641113:
+			n += NoInline354(i)
+		case 417735390:
+			n += NoInline355(i)
+		case -422506136:
+			n += NoInline356(i)
+		case 19089668:
+			n += NoInline357(i)
+		case 1176496955:
+			n += NoInline358(i)
+		case 469468534:
+			n += NoInline359(i)
+		case -620844340:
+			n += NoInline360(i)
+		case 1104044350:
+			n += NoInline361(i)
+		case -46677310:
+			n += NoInline362(i)
+		case -316060535:
+			n += NoInline363(i)
+		case 734207617:
+			n += NoInline364(i)
+		case 1305185263:
+			n += NoInline365(i)
+		case -2003810766:
+			n += NoInline366(i)
+		case 758017117:
+			n += NoInline367(i)
+		case -800621527:
+			n += NoInline368(i)
+		case -1266426411:
+			n += NoInline369(i)
+		case 1104698047:
+			n += NoInline370(i)
+		case 1075326325:
+			n += NoInline371(i)
+		case 1439219346:
+			n += NoInline372(i)
+		case -1068413352:
+			n += NoInline373(i)
+		case 20958506:
+			n += NoInline374(i)
+		case -682928979:
+			n += NoInline375(i)
+		case 986011055:
+			n += NoInline376(i)
+		case 57060992:
+			n += NoInline377(i)
+		case -215017199:
+			n += NoInline378(i)
+		case -432196269:
+			n += NoInline379(i)
+		case -160527834:
+			n += NoInline380(i)
+		case 184685148:
+			n += NoInline381(i)
+		case -1234104684:
+			n += NoInline382(i)
+		case 219447481:
+			n += NoInline383(i)
+		case 1750337062:
+			n += NoInline384(i)
+		case 2043104664:
+			n += NoInline385(i)
+		case 1492365354:
+			n += NoInline386(i)
+		case 174890805:
+			n += NoInline387(i)
+		case -1728439988:
+			n += NoInline388(i)
+		case 1117226966:
+			n += NoInline389(i)
+		case -717878635:
+			n += NoInline390(i)
+		case -169661923:
+			n += NoInline391(i)
+		case -271838922:
+			n += NoInline392(i)
+		case 984922924:
+			n += NoInline393(i)
+		case -1201246088:
+			n += NoInline394(i)
+		case -1045900454:
+			n += NoInline395(i)
+		case 1933396386:
+			n += NoInline396(i)
+		case 1455896797:
+			n += NoInline397(i)
+		case 2029337912:
+			n += NoInline398(i)
+		case -60153766:
+			n += NoInline399(i)
+		case 1556201844:
+			n += NoInline400(i)
+		case 1072260091:
+			n += NoInline401(i)
+		case 205592989:
+			n += NoInline402(i)
+		case -903157683:
+			n += NoInline403(i)
+		case -1047069298:
+			n += NoInline404(i)
+		case -1471159248:
+			n += NoInline405(i)
+		case 949182841:
+			n += NoInline406(i)
+		case -1471003208:
+			n += NoInline407(i)
+		case 1678655519:
+			n += NoInline408(i)
+		case -346799282:
+			n += NoInline409(i)
+		case 642894248:
+			n += NoInline410(i)
+		case -2059900581:
+			n += NoInline411(i)
+		case -1006789590:
+			n += NoInline412(i)
+		case 1065935549:
+			n += NoInline413(i)
+		case 1956116951:
+			n += NoInline414(i)
+		case -1289395816:
+			n += NoInline415(i)
+		case 352056179:
+			n += NoInline416(i)
+		case -2094775668:
+			n += NoInline417(i)
+		case 1144101090:
+			n += NoInline418(i)
+		case -1623490618:
+			n += NoInline419(i)
+		case 425503163:
+			n += NoInline420(i)
+		case 603766263:
+			n += NoInline421(i)
+		case 683774103:
+			n += NoInline422(i)
+		case -240453325:
+			n += NoInline423(i)
+		case -1582796037:
+			n += NoInline424(i)
+		case 1055668406:
+			n += NoInline425(i)
+		case -139563906:
+			n += NoInline426(i)
+		case -1988653112:
+			n += NoInline427(i)
+		case 1452476744:
+			n += NoInline428(i)
+		case -1410176746:
+			n += NoInline429(i)
+		case -698078541:
+			n += NoInline430(i)
+		case -1143084771:
+			n += NoInline431(i)
+		case -2137945349:
+			n += NoInline432(i)
+		case 1818806034:
+			n += NoInline433(i)
+		case 1988739519:
+			n += NoInline434(i)
+		case -201671681:
+			n += NoInline435(i)
+		case -1099199736:
+			n += NoInline436(i)
+		case 1955024410:
+			n += NoInline437(i)
+		case 1066942813:
+			n += NoInline438(i)
+		case -1203082347:
+			n += NoInline439(i)
+		case 1819787089:
+			n += NoInline440(i)
+		case -1105563850:
+			n += NoInline441(i)
+		case -1473825507:
+			n += NoInline442(i)
+		case -1363559451:
+			n += NoInline443(i)
+		case -1559971230:
+			n += NoInline444(i)
+		case 1067655148:
+			n += NoInline445(i)
+		case 1661557221:
+			n += NoInline446(i)
+		case -987967235:
+			n += NoInline447(i)
+		case 542730790:
+			n += NoInline448(i)
+		case 1231099828:
+			n += NoInline449(i)
+		case -2098782249:
+			n += NoInline450(i)
+		case 852815300:
+			n += NoInline451(i)
+		case -23165478:
+			n += NoInline452(i)
+		case -1563568742:
+			n += NoInline453(i)
+		case -1171156006:
+			n += NoInline454(i)
+		case 1770881343:
+			n += NoInline455(i)
+		case -2087252879:
+			n += NoInline456(i)
+		case 563456396:
+			n += NoInline457(i)
+		case -1951621827:
+			n += NoInline458(i)
+		case -665569537:
+			n += NoInline459(i)
+		case 1259646708:
+			n += NoInline460(i)
+		case -952177854:
+			n += NoInline461(i)
+		case 608935175:
+			n += NoInline462(i)
+		case -1484428644:
+			n += NoInline463(i)
+		case -1583347276:
+			n += NoInline464(i)
+		case 1692067842:
+			n += NoInline465(i)
+		case 6062535:
+			n += NoInline466(i)
+		case -1864588694:
+			n += NoInline467(i)
+		case -481929598:
+			n += NoInline468(i)
+		case 1943398976:
+			n += NoInline469(i)
+		case -1734399456:
+			n += NoInline470(i)
+		case -1534010547:
+			n += NoInline471(i)
+		case -730838493:
+			n += NoInline472(i)
+		case -814411016:
+			n += NoInline473(i)
+		case 283187946:
+			n += NoInline474(i)
+		case 349931193:
+			n += NoInline475(i)
+		case 1441038932:
+			n += NoInline476(i)
+		case 2107260151:
+			n += NoInline477(i)
+		case -1636255076:
+			n += NoInline478(i)
+		case -1599193552:
+			n += NoInline479(i)
+		case 1394335827:
+			n += NoInline480(i)
+		case -888136867:
+			n += NoInline481(i)
+		case 361579919:
+			n += NoInline482(i)
+		case 1092320650:
+			n += NoInline483(i)
+		case -1341884404:
+			n += NoInline484(i)
+		case 1804819842:
+			n += NoInline485(i)
+		case 95052488:
+			n += NoInline486(i)
+		case 931994127:
+			n += NoInline487(i)
+		case 336062872:
+			n += NoInline488(i)
+		case -1873274321:
+			n += NoInline489(i)
+		case 981722977:
+			n += NoInline490(i)
+		case 1578716447:
+			n += NoInline491(i)
+		case 1168423850:
+			n += NoInline492(i)
+		case -428209243:
+			n += NoInline493(i)
+		case -1452428858:
+			n += NoInline494(i)
+		case 1911818401:
+			n += NoInline495(i)
+		case -134241068:
+			n += NoInline496(i)
+		case -1427589835:
+			n += NoInline497(i)
+		case 1565086353:
+			n += NoInline498(i)
+		case 28462600:
+			n += NoInline499(i)
+		case 885516103:
+			n += NoInline500(i)
+		case -934348428:
+			n += NoInline501(i)
+		case -1003170058:
+			n += NoInline502(i)
+		case -823595963:
+			n += NoInline503(i)
+		case 1236561826:
+			n += NoInline504(i)
+		case -1675253206:
+			n += NoInline505(i)
+		case 1942201082:
+			n += NoInline506(i)
+		case 972851284:
+			n += NoInline507(i)
+		case 1670984478:
+			n += NoInline508(i)
+		case 1060275388:
+			n += NoInline509(i)
+		case 32334443:
+			n += NoInline510(i)
+		case 659173149:
+			n += NoInline511(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchNoInlineClusteredComputed4(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysClustered[i%4] {
+		case 0:
+			n += NoInline0(i)
+		case 1:
+			n += NoInline1(i)
+		case 2:
+			n += NoInline2(i)
+		case 3:
+			n += NoInline3(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchNoInlineClusteredLookupMod4(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysClustered[inputs[i%len(inputs)]] {
+		case 0:
+			n += NoInline0(i)
+		case 1:
+			n += NoInline1(i)
+		case 2:
+			n += NoInline2(i)
+		case 3:
+			n += NoInline3(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchNoInlineClusteredComputed8(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysClustered[i%8] {
+		case 0:
+			n += NoInline0(i)
+		case 1:
+			n += NoInline1(i)
+		case 2:
+			n += NoInline2(i)
+		case 3:
+			n += NoInline3(i)
+		case 4:
+			n += NoInline4(i)
+		case 5:
+			n += NoInline5(i)
+		case 6:
+			n += NoInline6(i)
+		case 7:
+			n += NoInline7(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchNoInlineClusteredLookupMod8(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysClustered[inputs[i%len(inputs)]] {
+		case 0:
+			n += NoInline0(i)
+		case 1:
+			n += NoInline1(i)
+		case 2:
+			n += NoInline2(i)
+		case 3:
+			n += NoInline3(i)
+		case 4:
+			n += NoInline4(i)
+		case 5:
+			n += NoInline5(i)
+		case 6:
+			n += NoInline6(i)
+		case 7:
+			n += NoInline7(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchNoInlineClusteredComputed16(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysClustered[i%16] {
+		case 0:
+			n += NoInline0(i)
+		case 1:
+			n += NoInline1(i)
+		case 2:
+			n += NoInline2(i)
+		case 3:
+			n += NoInline3(i)
+		case 4:
+			n += NoInline4(i)
+		case 5:
+			n += NoInline5(i)
+		case 6:
+			n += NoInline6(i)
+		case 7:
+			n += NoInline7(i)
+		case 65536:
+			n += NoInline8(i)
+		case 65537:
+			n += NoInline9(i)
+		case 65538:
+			n += NoInline10(i)
+		case 65539:
+			n += NoInline11(i)
+		case 65540:
+			n += NoInline12(i)
+		case 65541:
+			n += NoInline13(i)
+		case 65542:
+			n += NoInline14(i)
+		case 65543:
+			n += NoInline15(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchNoInlineClusteredLookupMod16(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysClustered[inputs[i%len(inputs)]] {
+		case 0:
+			n += NoInline0(i)
+		case 1:
+			n += NoInline1(i)
+		case 2:
+			n += NoInline2(i)
+		case 3:
+			n += NoInline3(i)
+		case 4:
+			n += NoInline4(i)
+		case 5:
+			n += NoInline5(i)
+		case 6:
+			n += NoInline6(i)
+		case 7:
+			n += NoInline7(i)
+		case 65536:
+			n += NoInline8(i)
+		case 65537:
+			n += NoInline9(i)
+		case 65538:
+			n += NoInline10(i)
+		case 65539:
+			n += NoInline11(i)
+		case 65540:
+			n += NoInline12(i)
+		case 65541:
+			n += NoInline13(i)
+		case 65542:
+			n += NoInline14(i)
+		case 65543:
+			n += NoInline15(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchNoInlineClusteredComputed32(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysClustered[i%32] {
+		case 0:
+			n += NoInline0(i)
+		case 1:
+			n += NoInline1(i)
+		case 2:
+			n += NoInline2(i)
+		case 3:
+			n += NoInline3(i)
+		case 4:
+			n += NoInline4(i)
+		case 5:
+			n += NoInline5(i)
+		case 6:
+			n += NoInline6(i)
+		case 7:
+			n += NoInline7(i)
+		case 65536:
+			n += NoInline8(i)
+		case 65537:
+			n += NoInline9(i)
+		case 65538:
+			n += NoInline10(i)
+		case 65539:
+			n += NoInline11(i)
+		case 65540:
+			n += NoInline12(i)
+		case 65541:
+			n += NoInline13(i)
+		case 65542:
+			n += NoInline14(i)
+		case 65543:
+			n += NoInline15(i)
+		case 131072:
+			n += NoInline16(i)
+		case 131073:
+			n += NoInline17(i)
+		case 131074:
+			n += NoInline18(i)
+		case 131075:
+			n += NoInline19(i)
+		case 131076:
+			n += NoInline20(i)
+		case 131077:
+			n += NoInline21(i)
+		case 131078:
+			n += NoInline22(i)
+		case 131079:
+			n += NoInline23(i)
+		case 196608:
+			n += NoInline24(i)
+		case 196609:
+			n += NoInline25(i)
+		case 196610:
+			n += NoInline26(i)
+		case 196611:
+			n += NoInline27(i)
+		case 196612:
+			n += NoInline28(i)
+		case 196613:
+			n += NoInline29(i)
+		case 196614:
+			n += NoInline30(i)
+		case 196615:
+			n += NoInline31(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchNoInlineClusteredLookupMod32(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysClustered[inputs[i%len(inputs)]] {
+		case 0:
+			n += NoInline0(i)
+		case 1:
+			n += NoInline1(i)
+		case 2:
+			n += NoInline2(i)
+		case 3:
+			n += NoInline3(i)
+		case 4:
+			n += NoInline4(i)
+		case 5:
+			n += NoInline5(i)
+		case 6:
+			n += NoInline6(i)
+		case 7:
+			n += NoInline7(i)
+		case 65536:
+			n += NoInline8(i)
+		case 65537:
+			n += NoInline9(i)
+		case 65538:
+			n += NoInline10(i)
+		case 65539:
+			n += NoInline11(i)
+		case 65540:
+			n += NoInline12(i)
+		case 65541:
+			n += NoInline13(i)
+		case 65542:
+			n += NoInline14(i)
+		case 65543:
+			n += NoInline15(i)
+		case 131072:
+			n += NoInline16(i)
+		case 131073:
+			n += NoInline17(i)
+		case 131074:
+			n += NoInline18(i)
+		case 131075:
+			n += NoInline19(i)
+		case 131076:
+			n += NoInline20(i)
+		case 131077:
+			n += NoInline21(i)
+		case 131078:
+			n += NoInline22(i)
+		case 131079:
+			n += NoInline23(i)
+		case 196608:
+			n += NoInline24(i)
+		case 196609:
+			n += NoInline25(i)
+		case 196610:
+			n += NoInline26(i)
+		case 196611:
+			n += NoInline27(i)
+		case 196612:
+			n += NoInline28(i)
+		case 196613:
+			n += NoInline29(i)
+		case 196614:
+			n += NoInline30(i)
+		case 196615:
+			n += NoInline31(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchNoInlineClusteredComputed64(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysClustered[i%64] {
+		case 0:
+			n += NoInline0(i)
+		case 1:
+			n += NoInline1(i)
+		case 2:
+			n += NoInline2(i)
+		case 3:
+			n += NoInline3(i)
+		case 4:
+			n += NoInline4(i)
+		case 5:
+			n += NoInline5(i)
+		case 6:
+			n += NoInline6(i)
+		case 7:
+			n += NoInline7(i)
+		case 65536:
+			n += NoInline8(i)
+		case 65537:
+			n += NoInline9(i)
+		case 65538:
+			n += NoInline10(i)
+		case 65539:
+			n += NoInline11(i)
+		case 65540:
+			n += NoInline12(i)
+		case 65541:
+			n += NoInline13(i)
+		case 65542:
+			n += NoInline14(i)
+		case 65543:
+			n += NoInline15(i)
+		case 131072:
+			n += NoInline16(i)
+		case 131073:
+			n += NoInline17(i)
+		case 131074:
+			n += NoInline18(i)
+		case 131075:
+			n += NoInline19(i)
+		case 131076:
+			n += NoInline20(i)
+		case 131077:
+			n += NoInline21(i)
+		case 131078:
+			n += NoInline22(i)
+		case 131079:
+			n += NoInline23(i)
+		case 196608:
+			n += NoInline24(i)
+		case 196609:
+			n += NoInline25(i)
+		case 196610:
+			n += NoInline26(i)
+		case 196611:
+			n += NoInline27(i)
+		case 196612:
+			n += NoInline28(i)
+		case 196613:
+			n += NoInline29(i)
+		case 196614:
+			n += NoInline30(i)
+		case 196615:
+			n += NoInline31(i)
+		case 262144:
+			n += NoInline32(i)
+		case 262145:
+			n += NoInline33(i)
+		case 262146:
+			n += NoInline34(i)
+		case 262147:
+			n += NoInline35(i)
+		case 262148:
+			n += NoInline36(i)
+		case 262149:
+			n += NoInline37(i)
+		case 262150:
+			n += NoInline38(i)
+		case 262151:
+			n += NoInline39(i)
+		case 327680:
+			n += NoInline40(i)
+		case 327681:
+			n += NoInline41(i)
+		case 327682:
+			n += NoInline42(i)
+		case 327683:
+			n += NoInline43(i)
+		case 327684:
+			n += NoInline44(i)
+		case 327685:
+			n += NoInline45(i)
+		case 327686:
+			n += NoInline46(i)
+		case 327687:
+			n += NoInline47(i)
+		case 393216:
+			n += NoInline48(i)
+		case 393217:
+			n += NoInline49(i)
+		case 393218:
+			n += NoInline50(i)
+		case 393219:
+			n += NoInline51(i)
+		case 393220:
+			n += NoInline52(i)
+		case 393221:
+			n += NoInline53(i)
+		case 393222:
+			n += NoInline54(i)
+		case 393223:
+			n += NoInline55(i)
+		case 458752:
+			n += NoInline56(i)
+		case 458753:
+			n += NoInline57(i)
+		case 458754:
+			n += NoInline58(i)
+		case 458755:
+			n += NoInline59(i)
+		case 458756:
+			n += NoInline60(i)
+		case 458757:
+			n += NoInline61(i)
+		case 458758:
+			n += NoInline62(i)
+		case 458759:
+			n += NoInline63(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchNoInlineClusteredLookupMod64(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysClustered[inputs[i%len(inputs)]] {
+		case 0:
+			n += NoInline0(i)
+		case 1:
+			n += NoInline1(i)
+		case 2:
+			n += NoInline2(i)
+		case 3:
+			n += NoInline3(i)
+		case 4:
+			n += NoInline4(i)
+		case 5:
+			n += NoInline5(i)
+		case 6:
+			n += NoInline6(i)
+		case 7:
+			n += NoInline7(i)
+		case 65536:
+			n += NoInline8(i)
+		case 65537:
+			n += NoInline9(i)
+		case 65538:
+			n += NoInline10(i)
+		case 65539:
+			n += NoInline11(i)
+		case 65540:
+			n += NoInline12(i)
+		case 65541:
+			n += NoInline13(i)
+		case 65542:
+			n += NoInline14(i)
+		case 65543:
+			n += NoInline15(i)
+		case 131072:
+			n += NoInline16(i)
+		case 131073:
+			n += NoInline17(i)
+		case 131074:
+			n += NoInline18(i)
+		case 131075:
+			n += NoInline19(i)
+		case 131076:
+			n += NoInline20(i)
+		case 131077:
+			n += NoInline21(i)
+		case 131078:
+			n += NoInline22(i)
+		case 131079:
+			n += NoInline23(i)
+		case 196608:
+			n += NoInline24(i)
+		case 196609:
+			n += NoInline25(i)
+		case 196610:
+			n += NoInline26(i)
+		case 196611:
+			n += NoInline27(i)
+		case 196612:
+			n += NoInline28(i)
+		case 196613:
+			n += NoInline29(i)
+		case 196614:
+			n += NoInline30(i)
+		case 196615:
+			n += NoInline31(i)
+		case 262144:
+			n += NoInline32(i)
+		case 262145:
+			n += NoInline33(i)
+		case 262146:
+			n += NoInline34(i)
+		case 262147:
+			n += NoInline35(i)
+		case 262148:
+			n += NoInline36(i)
+		case 262149:
+			n += NoInline37(i)
+		case 262150:
+			n += NoInline38(i)
+		case 262151:
+			n += NoInline39(i)
+		case 327680:
+			n += NoInline40(i)
+		case 327681:
+			n += NoInline41(i)
+		case 327682:
+			n += NoInline42(i)
+		case 327683:
+			n += NoInline43(i)
+		case 327684:
+			n += NoInline44(i)
+		case 327685:
+			n += NoInline45(i)
+		case 327686:
+			n += NoInline46(i)
+		case 327687:
+			n += NoInline47(i)
+		case 393216:
+			n += NoInline48(i)
+		case 393217:
+			n += NoInline49(i)
+		case 393218:
+			n += NoInline50(i)
+		case 393219:
+			n += NoInline51(i)
+		case 393220:
+			n += NoInline52(i)
+		case 393221:
+			n += NoInline53(i)
+		case 393222:
+			n += NoInline54(i)
+		case 393223:
+			n += NoInline55(i)
+		case 458752:
+			n += NoInline56(i)
+		case 458753:
+			n += NoInline57(i)
+		case 458754:
+			n += NoInline58(i)
+		case 458755:
+			n += NoInline59(i)
+		case 458756:
+			n += NoInline60(i)
+		case 458757:
+			n += NoInline61(i)
+		case 458758:
+			n += NoInline62(i)
+		case 458759:
+			n += NoInline63(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchNoInlineClusteredComputed128(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysClustered[i%128] {
+		case 0:
+			n += NoInline0(i)
+		case 1:
+			n += NoInline1(i)
+		case 2:
+			n += NoInline2(i)
+		case 3:
+			n += NoInline3(i)
+		case 4:
+			n += NoInline4(i)
+		case 5:
+			n += NoInline5(i)
+		case 6:
+			n += NoInline6(i)
+		case 7:
+			n += NoInline7(i)
+		case 65536:
+			n += NoInline8(i)
+		case 65537:
+			n += NoInline9(i)
+		case 65538:
+			n += NoInline10(i)
+		case 65539:
+			n += NoInline11(i)
+		case 65540:
+			n += NoInline12(i)
+		case 65541:
+			n += NoInline13(i)
+		case 65542:
+			n += NoInline14(i)
+		case 65543:
+			n += NoInline15(i)
+		case 131072:
+			n += NoInline16(i)
+		case 131073:
+			n += NoInline17(i)
+		case 131074:
+			n += NoInline18(i)
+		case 131075:
+			n += NoInline19(i)
+		case 131076:
+			n += NoInline20(i)
+		case 131077:
+			n += NoInline21(i)
+		case 131078:
+			n += NoInline22(i)
+		case 131079:
+			n += NoInline23(i)
+		case 196608:
+			n += NoInline24(i)
+		case 196609:
+			n += NoInline25(i)
+		case 196610:
+			n += NoInline26(i)
+		case 196611:
+			n += NoInline27(i)
+		case 196612:
+			n += NoInline28(i)
+		case 196613:
+			n += NoInline29(i)
+		case 196614:
+			n += NoInline30(i)
+		case 196615:
+			n += NoInline31(i)
+		case 262144:
+			n += NoInline32(i)
+		case 262145:
+			n += NoInline33(i)
+		case 262146:
+			n += NoInline34(i)
+		case 262147:
+			n += NoInline35(i)
+		case 262148:
+			n += NoInline36(i)
+		case 262149:
+			n += NoInline37(i)
+		case 262150:
+			n += NoInline38(i)
+		case 262151:
+			n += NoInline39(i)
+		case 327680:
+			n += NoInline40(i)
+		case 327681:
+			n += NoInline41(i)
+		case 327682:
+			n += NoInline42(i)
+		case 327683:
+			n += NoInline43(i)
+		case 327684:
+			n += NoInline44(i)
+		case 327685:
+			n += NoInline45(i)
+		case 327686:
+			n += NoInline46(i)
+		case 327687:
+			n += NoInline47(i)
+		case 393216:
+			n += NoInline48(i)
+		case 393217:
+			n += NoInline49(i)
+		case 393218:
+			n += NoInline50(i)
+		case 393219:
+			n += NoInline51(i)
+		case 393220:
+			n += NoInline52(i)
+		case 393221:
+			n += NoInline53(i)
+		case 393222:
+			n += NoInline54(i)
+		case 393223:
+			n += NoInline55(i)
+		case 458752:
+			n += NoInline56(i)
+		case 458753:
+			n += NoInline57(i)
+		case 458754:
+			n += NoInline58(i)
+		case 458755:
+			n += NoInline59(i)
+		case 458756:
+			n += NoInline60(i)
+		case 458757:
+			n += NoInline61(i)
+		case 458758:
+			n += NoInline62(i)
+		case 458759:
+			n += NoInline63(i)
+		case 524288:
+			n += NoInline64(i)
+		case 524289:
+			n += NoInline65(i)
+		case 524290:
+			n += NoInline66(i)
+		case 524291:
+			n += NoInline67(i)
+		case 524292:
+			n += NoInline68(i)
+		case 524293:
+			n += NoInline69(i)
+		case 524294:
+			n += NoInline70(i)
+		case 524295:
+			n += NoInline71(i)
+		case 589824:
+			n += NoInline72(i)
+		case 589825:
+			n += NoInline73(i)
+		case 589826:
+			n += NoInline74(i)
+		case 589827:
+			n += NoInline75(i)
+		case 589828:
+			n += NoInline76(i)
+		case 589829:
+			n += NoInline77(i)
+		case 589830:
+			n += NoInline78(i)
+		case 589831:
+			n += NoInline79(i)
+		case 655360:
+			n += NoInline80(i)
+		case 655361:
+			n += NoInline81(i)
+		case 655362:
+			n += NoInline82(i)
+		case 655363:
+			n += NoInline83(i)
+		case 655364:
+			n += NoInline84(i)
+		case 655365:
+			n += NoInline85(i)
+		case 655366:
+			n += NoInline86(i)
+		case 655367:
+			n += NoInline87(i)
+		case 720896:
+			n += NoInline88(i)
+		case 720897:
+			n += NoInline89(i)
+		case 720898:
+			n += NoInline90(i)
+		case 720899:
+			n += NoInline91(i)
+		case 720900:
+			n += NoInline92(i)
+		case 720901:
+			n += NoInline93(i)
+		case 720902:
+			n += NoInline94(i)
+		case 720903:
+			n += NoInline95(i)
+		case 786432:
+			n += NoInline96(i)
+		case 786433:
+			n += NoInline97(i)
+		case 786434:
+			n += NoInline98(i)
+		case 786435:
+			n += NoInline99(i)
+		case 786436:
+			n += NoInline100(i)
+		case 786437:
+			n += NoInline101(i)
+		case 786438:
+			n += NoInline102(i)
+		case 786439:
+			n += NoInline103(i)
+		case 851968:
+			n += NoInline104(i)
+		case 851969:
+			n += NoInline105(i)
+		case 851970:
+			n += NoInline106(i)
+		case 851971:
+			n += NoInline107(i)
+		case 851972:
+			n += NoInline108(i)
+		case 851973:
+			n += NoInline109(i)
+		case 851974:
+			n += NoInline110(i)
+		case 851975:
+			n += NoInline111(i)
+		case 917504:
+			n += NoInline112(i)
+		case 917505:
+			n += NoInline113(i)
+		case 917506:
+			n += NoInline114(i)
+		case 917507:
+			n += NoInline115(i)
+		case 917508:
+			n += NoInline116(i)
+		case 917509:
+			n += NoInline117(i)
+		case 917510:
+			n += NoInline118(i)
+		case 917511:
+			n += NoInline119(i)
+		case 983040:
+			n += NoInline120(i)
+		case 983041:
+			n += NoInline121(i)
+		case 983042:
+			n += NoInline122(i)
+		case 983043:
+			n += NoInline123(i)
+		case 983044:
+			n += NoInline124(i)
+		case 983045:
+			n += NoInline125(i)
+		case 983046:
+			n += NoInline126(i)
+		case 983047:
+			n += NoInline127(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchNoInlineClusteredLookupMod128(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysClustered[inputs[i%len(inputs)]] {
+		case 0:
+			n += NoInline0(i)
+		case 1:
+			n += NoInline1(i)
+		case 2:
+			n += NoInline2(i)
+		case 3:
+			n += NoInline3(i)
+		case 4:
+			n += NoInline4(i)
+		case 5:
+			n += NoInline5(i)
+		case 6:
+			n += NoInline6(i)
+		case 7:
+			n += NoInline7(i)
+		case 65536:
+			n += NoInline8(i)
+		case 65537:
+			n += NoInline9(i)
+		case 65538:
+			n += NoInline10(i)
+		case 65539:
+			n += NoInline11(i)
+		case 65540:
+			n += NoInline12(i)
+		case 65541:
+			n += NoInline13(i)
+		case 65542:
+			n += NoInline14(i)
+		case 65543:
+			n += NoInline15(i)
+		case 131072:
+			n += NoInline16(i)
+		case 131073:
+			n += NoInline17(i)
+		case 131074:
+			n += NoInline18(i)
+		case 131075:
+			n += NoInline19(i)
+		case 131076:
+			n += NoInline20(i)
+		case 131077:
+			n += NoInline21(i)
+		case 131078:
+			n += NoInline22(i)
+		case 131079:
+			n += NoInline23(i)
+		case 196608:
+			n += NoInline24(i)
+		case 196609:
+			n += NoInline25(i)
+		case 196610:
+			n += NoInline26(i)
+		case 196611:
+			n += NoInline27(i)
+		case 196612:
+			n += NoInline28(i)
+		case 196613:
+			n += NoInline29(i)
+		case 196614:
+			n += NoInline30(i)
+		case 196615:
+			n += NoInline31(i)
+		case 262144:
+			n += NoInline32(i)
+		case 262145:
+			n += NoInline33(i)
+		case 262146:
+			n += NoInline34(i)
+		case 262147:
+			n += NoInline35(i)
+		case 262148:
+			n += NoInline36(i)
+		case 262149:
+			n += NoInline37(i)
+		case 262150:
+			n += NoInline38(i)
+		case 262151:
+			n += NoInline39(i)
+		case 327680:
+			n += NoInline40(i)
+		case 327681:
+			n += NoInline41(i)
+		case 327682:
+			n += NoInline42(i)
+		case 327683:
+			n += NoInline43(i)
+		case 327684:
+			n += NoInline44(i)
+		case 327685:
+			n += NoInline45(i)
+		case 327686:
+			n += NoInline46(i)
+		case 327687:
+			n += NoInline47(i)
+		case 393216:
+			n += NoInline48(i)
+		case 393217:
+			n += NoInline49(i)
+		case 393218:
+			n += NoInline50(i)
+		case 393219:
+			n += NoInline51(i)
+		case 393220:
+			n += NoInline52(i)
+		case 393221:
+			n += NoInline53(i)
+		case 393222:
+			n += NoInline54(i)
+		case 393223:
+			n += NoInline55(i)
+		case 458752:
+			n += NoInline56(i)
+		case 458753:
+			n += NoInline57(i)
+		case 458754:
+			n += NoInline58(i)
+		case 458755:
+			n += NoInline59(i)
+		case 458756:
+			n += NoInline60(i)
+		case 458757:
+			n += NoInline61(i)
+		case 458758:
+			n += NoInline62(i)
+		case 458759:
+			n += NoInline63(i)
+		case 524288:
+			n += NoInline64(i)
+		case 524289:
+			n += NoInline65(i)
+		case 524290:
+			n += NoInline66(i)
+		case 524291:
+			n += NoInline67(i)
+		case 524292:
+			n += NoInline68(i)
+		case 524293:
+			n += NoInline69(i)
+		case 524294:
+			n += NoInline70(i)
+		case 524295:
+			n += NoInline71(i)
+		case 589824:
+			n += NoInline72(i)
+		case 589825:
+			n += NoInline73(i)
+		case 589826:
+			n += NoInline74(i)
+		case 589827:
+			n += NoInline75(i)
+		case 589828:
+			n += NoInline76(i)
+		case 589829:
+			n += NoInline77(i)
+		case 589830:
+			n += NoInline78(i)
+		case 589831:
+			n += NoInline79(i)
+		case 655360:
+			n += NoInline80(i)
+		case 655361:
+			n += NoInline81(i)
+		case 655362:
+			n += NoInline82(i)
+		case 655363:
+			n += NoInline83(i)
+		case 655364:
+			n += NoInline84(i)
+		case 655365:
+			n += NoInline85(i)
+		case 655366:
+			n += NoInline86(i)
+		case 655367:
+			n += NoInline87(i)
+		case 720896:
+			n += NoInline88(i)
+		case 720897:
+			n += NoInline89(i)
+		case 720898:
+			n += NoInline90(i)
+		case 720899:
+			n += NoInline91(i)
+		case 720900:
+			n += NoInline92(i)
+		case 720901:
+			n += NoInline93(i)
+		case 720902:
+			n += NoInline94(i)
+		case 720903:
+			n += NoInline95(i)
+		case 786432:
+			n += NoInline96(i)
+		case 786433:
+			n += NoInline97(i)
+		case 786434:
+			n += NoInline98(i)
+		case 786435:
+			n += NoInline99(i)
+		case 786436:
+			n += NoInline100(i)
+		case 786437:
+			n += NoInline101(i)
+		case 786438:
+			n += NoInline102(i)
+		case 786439:
+			n += NoInline103(i)
+		case 851968:
+			n += NoInline104(i)
+		case 851969:
+			n += NoInline105(i)
+		case 851970:
+			n += NoInline106(i)
+		case 851971:
+			n += NoInline107(i)
+		case 851972:
+			n += NoInline108(i)
+		case 851973:
+			n += NoInline109(i)
+		case 851974:
+			n += NoInline110(i)
+		case 851975:
+			n += NoInline111(i)
+		case 917504:
+			n += NoInline112(i)
+		case 917505:
+			n += NoInline113(i)
+		case 917506:
+			n += NoInline114(i)
+		case 917507:
+			n += NoInline115(i)
+		case 917508:
+			n += NoInline116(i)
+		case 917509:
+			n += NoInline117(i)
+		case 917510:
+			n += NoInline118(i)
+		case 917511:
+			n += NoInline119(i)
+		case 983040:
+			n += NoInline120(i)
+		case 983041:
+			n += NoInline121(i)
+		case 983042:
+			n += NoInline122(i)
+		case 983043:
+			n += NoInline123(i)
+		case 983044:
+			n += NoInline124(i)
+		case 983045:
+			n += NoInline125(i)
+		case 983046:
+			n += NoInline126(i)
+		case 983047:
+			n += NoInline127(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchNoInlineClusteredComputed256(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysClustered[i%256] {
+		case 0:
+			n += NoInline0(i)
+		case 1:
+			n += NoInline1(i)
+		case 2:
+			n += NoInline2(i)
+		case 3:
+			n += NoInline3(i)
+		case 4:
+			n += NoInline4(i)
+		case 5:
+			n += NoInline5(i)
+		case 6:
+			n += NoInline6(i)
+		case 7:
+			n += NoInline7(i)
+		case 65536:
+			n += NoInline8(i)
+		case 65537:
+			n += NoInline9(i)
+		case 65538:
+			n += NoInline10(i)
+		case 65539:
+			n += NoInline11(i)
+		case 65540:
+			n += NoInline12(i)
+		case 65541:
+			n += NoInline13(i)
+		case 65542:
+			n += NoInline14(i)
+		case 65543:
+			n += NoInline15(i)
+		case 131072:
+			n += NoInline16(i)
+		case 131073:
+			n += NoInline17(i)
+		case 131074:
+			n += NoInline18(i)
+		case 131075:
+			n += NoInline19(i)
+		case 131076:
+			n += NoInline20(i)
+		case 131077:
+			n += NoInline21(i)
+		case 131078:
+			n += NoInline22(i)
+		case 131079:
+			n += NoInline23(i)
+		case 196608:
+			n += NoInline24(i)
+		case 196609:
+			n += NoInline25(i)
+		case 196610:
+			n += NoInline26(i)
+		case 196611:
+			n += NoInline27(i)
+		case 196612:
+			n += NoInline28(i)
+		case 196613:
+			n += NoInline29(i)
+		case 196614:
+			n += NoInline30(i)
+		case 196615:
+			n += NoInline31(i)
+		case 262144:
+			n += NoInline32(i)
+		case 262145:
+			n += NoInline33(i)
+		case 262146:
+			n += NoInline34(i)
+		case 262147:
+			n += NoInline35(i)
+		case 262148:
+			n += NoInline36(i)
+		case 262149:
+			n += NoInline37(i)
+		case 262150:
+			n += NoInline38(i)
+		case 262151:
+			n += NoInline39(i)
+		case 327680:
+			n += NoInline40(i)
+		case 327681:
+			n += NoInline41(i)
+		case 327682:
+			n += NoInline42(i)
+		case 327683:
+			n += NoInline43(i)
+		case 327684:
+			n += NoInline44(i)
+		case 327685:
+			n += NoInline45(i)
+		case 327686:
+			n += NoInline46(i)
+		case 327687:
+			n += NoInline47(i)
+		case 393216:
+			n += NoInline48(i)
+		case 393217:
+			n += NoInline49(i)
+		case 393218:
+			n += NoInline50(i)
+		case 393219:
+			n += NoInline51(i)
+		case 393220:
+			n += NoInline52(i)
+		case 393221:
+			n += NoInline53(i)
+		case 393222:
+			n += NoInline54(i)
+		case 393223:
+			n += NoInline55(i)
+		case 458752:
+			n += NoInline56(i)
+		case 458753:
+			n += NoInline57(i)
+		case 458754:
+			n += NoInline58(i)
+		case 458755:
+			n += NoInline59(i)
+		case 458756:
+			n += NoInline60(i)
+		case 458757:
+			n += NoInline61(i)
+		case 458758:
+			n += NoInline62(i)
+		case 458759:
+			n += NoInline63(i)
+		case 524288:
+			n += NoInline64(i)
+		case 524289:
+			n += NoInline65(i)
+		case 524290:
+			n += NoInline66(i)
+		case 524291:
+			n += NoInline67(i)
+		case 524292:
+			n += NoInline68(i)
+		case 524293:
+			n += NoInline69(i)
+		case 524294:
+			n += NoInline70(i)
+		case 524295:
+			n += NoInline71(i)
+		case 589824:
+			n += NoInline72(i)
+		case 589825:
+			n += NoInline73(i)
+		case 589826:
+			n += NoInline74(i)
+		case 589827:
+			n += NoInline75(i)
+		case 589828:
+			n += NoInline76(i)
+		case 589829:
+			n += NoInline77(i)
+		case 589830:
+			n += NoInline78(i)
+		case 589831:
+			n += NoInline79(i)
+		case 655360:
+			n += NoInline80(i)
+		case 655361:
+			n += NoInline81(i)
+		case 655362:
+			n += NoInline82(i)
+		case 655363:
+			n += NoInline83(i)
+		case 655364:
+			n += NoInline84(i)
+		case 655365:
+			n += NoInline85(i)
+		case 655366:
+			n += NoInline86(i)
+		case 655367:
+			n += NoInline87(i)
+		case 720896:
+			n += NoInline88(i)
+		case 720897:
+			n += NoInline89(i)
+		case 720898:
+			n += NoInline90(i)
+		case 720899:
+			n += NoInline91(i)
+		case 720900:
+			n += NoInline92(i)
+		case 720901:
+			n += NoInline93(i)
+		case 720902:
+			n += NoInline94(i)
+		case 720903:
+			n += NoInline95(i)
+		case 786432:
+			n += NoInline96(i)
+		case 786433:
+			n += NoInline97(i)
+		case 786434:
+			n += NoInline98(i)
+		case 786435:
+			n += NoInline99(i)
+		case 786436:
+			n += NoInline100(i)
+		case 786437:
+			n += NoInline101(i)
+		case 786438:
+			n += NoInline102(i)
+		case 786439:
+			n += NoInline103(i)
+		case 851968:
+			n += NoInline104(i)
+		case 851969:
+			n += NoInline105(i)
+		case 851970:
+			n += NoInline106(i)
+		case 851971:
+			n += NoInline107(i)
+		case 851972:
+			n += NoInline108(i)
+		case 851973:
+			n += NoInline109(i)
+		case 851974:
+			n += NoInline110(i)
+		case 851975:
+			n += NoInline111(i)
+		case 917504:
+			n += NoInline112(i)
+		case 917505:
+			n += NoInline113(i)
+		case 917506:
+			n += NoInline114(i)
+		case 917507:
+			n += NoInline115(i)
+		case 917508:
+			n += NoInline116(i)
+		case 917509:
+			n += NoInline117(i)
+		case 917510:
+			n += NoInline118(i)
+		case 917511:
+			n += NoInline119(i)
+		case 983040:
+			n += NoInline120(i)
+		case 983041:
+			n += NoInline121(i)
+		case 983042:
+			n += NoInline122(i)
+		case 983043:
+			n += NoInline123(i)
+		case 983044:
+			n += NoInline124(i)
+		case 983045:
+			n += NoInline125(i)
+		case 983046:
+			n += NoInline126(i)
+		case 983047:
+			n += NoInline127(i)
+		case 1048576:
+			n += NoInline128(i)
+		case 1048577:
+			n += NoInline129(i)
+		case 1048578:
+			n += NoInline130(i)
+		case 1048579:
+			n += NoInline131(i)
+		case 1048580:
+			n += NoInline132(i)
+		case 1048581:
+			n += NoInline133(i)
+		case 1048582:
+			n += NoInline134(i)
+		case 1048583:
+			n += NoInline135(i)
+		case 1114112:
+			n += NoInline136(i)
+		case 1114113:
+			n += NoInline137(i)
+		case 1114114:
+			n += NoInline138(i)
+		case 1114115:
+			n += NoInline139(i)
+		case 1114116:
+			n += NoInline140(i)
+		case 1114117:
+			n += NoInline141(i)
+		case 1114118:
+			n += NoInline142(i)
+		case 1114119:
+			n += NoInline143(i)
+		case 1179648:
+			n += NoInline144(i)
+		case 1179649:
+			n += NoInline145(i)
+		case 1179650:
+			n += NoInline146(i)
+		case 1179651:
+			n += NoInline147(i)
+		case 1179652:
+			n += NoInline148(i)
+		case 1179653:
+			n += NoInline149(i)
+		case 1179654:
+			n += NoInline150(i)
+		case 1179655:
+			n += NoInline151(i)
+		case 1245184:
+			n += NoInline152(i)
+		case 1245185:
+			n += NoInline153(i)
+		case 1245186:
+			n += NoInline154(i)
+		case 1245187:
+			n += NoInline155(i)
+		case 1245188:
+			n += NoInline156(i)
+		case 1245189:
+			n += NoInline157(i)
+		case 1245190:
+			n += NoInline158(i)
+		case 1245191:
+			n += NoInline159(i)
+		case 1310720:
+			n += NoInline160(i)
+		case 1310721:
+			n += NoInline161(i)
+		case 1310722:
+			n += NoInline162(i)
+		case 1310723:
+			n += NoInline163(i)
+		case 1310724:
+			n += NoInline164(i)
+		case 1310725:
+			n += NoInline165(i)
+		case 1310726:
+			n += NoInline166(i)
+		case 1310727:
+			n += NoInline167(i)
+		case 1376256:
+			n += NoInline168(i)
+		case 1376257:
+			n += NoInline169(i)
+		case 1376258:
+			n += NoInline170(i)
+		case 1376259:
+			n += NoInline171(i)
+		case 1376260:
+			n += NoInline172(i)
+		case 1376261:
+			n += NoInline173(i)
+		case 1376262:
+			n += NoInline174(i)
+		case 1376263:
+			n += NoInline175(i)
+		case 1441792:
+			n += NoInline176(i)
+		case 1441793:
+			n += NoInline177(i)
+		case 1441794:
+			n += NoInline178(i)
+		case 1441795:
+			n += NoInline179(i)
+		case 1441796:
+			n += NoInline180(i)
+		case 1441797:
+			n += NoInline181(i)
+		case 1441798:
+			n += NoInline182(i)
+		case 1441799:
+			n += NoInline183(i)
+		case 1507328:
+			n += NoInline184(i)
+		case 1507329:
+			n += NoInline185(i)
+		case 1507330:
+			n += NoInline186(i)
+		case 1507331:
+			n += NoInline187(i)
+		case 1507332:
+			n += NoInline188(i)
+		case 1507333:
+			n += NoInline189(i)
+		case 1507334:
+			n += NoInline190(i)
+		case 1507335:
+			n += NoInline191(i)
+		case 1572864:
+			n += NoInline192(i)
+		case 1572865:
+			n += NoInline193(i)
+		case 1572866:
+			n += NoInline194(i)
+		case 1572867:
+			n += NoInline195(i)
+		case 1572868:
+			n += NoInline196(i)
+		case 1572869:
+			n += NoInline197(i)
+		case 1572870:
+			n += NoInline198(i)
+		case 1572871:
+			n += NoInline199(i)
+		case 1638400:
+			n += NoInline200(i)
+		case 1638401:
+			n += NoInline201(i)
+		case 1638402:
+			n += NoInline202(i)
+		case 1638403:
+			n += NoInline203(i)
+		case 1638404:
+			n += NoInline204(i)
+		case 1638405:
+			n += NoInline205(i)
+		case 1638406:
+			n += NoInline206(i)
+		case 1638407:
+			n += NoInline207(i)
+		case 1703936:
+			n += NoInline208(i)
+		case 1703937:
+			n += NoInline209(i)
+		case 1703938:
+			n += NoInline210(i)
+		case 1703939:
+			n += NoInline211(i)
+		case 1703940:
+			n += NoInline212(i)
+		case 1703941:
+			n += NoInline213(i)
+		case 1703942:
+			n += NoInline214(i)
+		case 1703943:
+			n += NoInline215(i)
+		case 1769472:
+			n += NoInline216(i)
+		case 1769473:
+			n += NoInline217(i)
+		case 1769474:
+			n += NoInline218(i)
+		case 1769475:
+			n += NoInline219(i)
+		case 1769476:
+			n += NoInline220(i)
+		case 1769477:
+			n += NoInline221(i)
+		case 1769478:
+			n += NoInline222(i)
+		case 1769479:
+			n += NoInline223(i)
+		case 1835008:
+			n += NoInline224(i)
+		case 1835009:
+			n += NoInline225(i)
+		case 1835010:
+			n += NoInline226(i)
+		case 1835011:
+			n += NoInline227(i)
+		case 1835012:
+			n += NoInline228(i)
+		case 1835013:
+			n += NoInline229(i)
+		case 1835014:
+			n += NoInline230(i)
+		case 1835015:
+			n += NoInline231(i)
+		case 1900544:
+			n += NoInline232(i)
+		case 1900545:
+			n += NoInline233(i)
+		case 1900546:
+			n += NoInline234(i)
+		case 1900547:
+			n += NoInline235(i)
+		case 1900548:
+			n += NoInline236(i)
+		case 1900549:
+			n += NoInline237(i)
+		case 1900550:
+			n += NoInline238(i)
+		case 1900551:
+			n += NoInline239(i)
+		case 1966080:
+			n += NoInline240(i)
+		case 1966081:
+			n += NoInline241(i)
+		case 1966082:
+			n += NoInline242(i)
+		case 1966083:
+			n += NoInline243(i)
+		case 1966084:
+			n += NoInline244(i)
+		case 1966085:
+			n += NoInline245(i)
+		case 1966086:
+			n += NoInline246(i)
+		case 1966087:
+			n += NoInline247(i)
+		case 2031616:
+			n += NoInline248(i)
+		case 2031617:
+			n += NoInline249(i)
+		case 2031618:
+			n += NoInline250(i)
+		case 2031619:
+			n += NoInline251(i)
+		case 2031620:
+			n += NoInline252(i)
+		case 2031621:
+			n += NoInline253(i)
+		case 2031622:
+			n += NoInline254(i)
+		case 2031623:
+			n += NoInline255(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchNoInlineClusteredLookupMod256(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysClustered[inputs[i%len(inputs)]] {
+		case 0:
+			n += NoInline0(i)
+		case 1:
+			n += NoInline1(i)
+		case 2:
+			n += NoInline2(i)
+		case 3:
+			n += NoInline3(i)
+		case 4:
+			n += NoInline4(i)
+		case 5:
+			n += NoInline5(i)
+		case 6:
+			n += NoInline6(i)
+		case 7:
+			n += NoInline7(i)
+		case 65536:
+			n += NoInline8(i)
+		case 65537:
+			n += NoInline9(i)
+		case 65538:
+			n += NoInline10(i)
+		case 65539:
+			n += NoInline11(i)
+		case 65540:
+			n += NoInline12(i)
+		case 65541:
+			n += NoInline13(i)
+		case 65542:
+			n += NoInline14(i)
+		case 65543:
+			n += NoInline15(i)
+		case 131072:
+			n += NoInline16(i)
+		case 131073:
+			n += NoInline17(i)
+		case 131074:
+			n += NoInline18(i)
+		case 131075:
+			n += NoInline19(i)
+		case 131076:
+			n += NoInline20(i)
+		case 131077:
+			n += NoInline21(i)
+		case 131078:
+			n += NoInline22(i)
+		case 131079:
+			n += NoInline23(i)
+		case 196608:
+			n += NoInline24(i)
+		case 196609:
+			n += NoInline25(i)
+		case 196610:
+			n += NoInline26(i)
+		case 196611:
+			n += NoInline27(i)
+		case 196612:
+			n += NoInline28(i)
+		case 196613:
+			n += NoInline29(i)
+		case 196614:
+			n += NoInline30(i)
+		case 196615:
+			n += NoInline31(i)
+		case 262144:
+			n += NoInline32(i)
+		case 262145:
+			n += NoInline33(i)
+		case 262146:
+			n += NoInline34(i)
+		case 262147:
+			n += NoInline35(i)
+		case 262148:
+			n += NoInline36(i)
+		case 262149:
+			n += NoInline37(i)
+		case 262150:
+			n += NoInline38(i)
+		case 262151:
+			n += NoInline39(i)
+		case 327680:
+			n += NoInline40(i)
+		case 327681:
+			n += NoInline41(i)
+		case 327682:
+			n += NoInline42(i)
+		case 327683:
+			n += NoInline43(i)
+		case 327684:
+			n += NoInline44(i)
+		case 327685:
+			n += NoInline45(i)
+		case 327686:
+			n += NoInline46(i)
+		case 327687:
+			n += NoInline47(i)
+		case 393216:
+			n += NoInline48(i)
+		case 393217:
+			n += NoInline49(i)
+		case 393218:
+			n += NoInline50(i)
+		case 393219:
+			n += NoInline51(i)
+		case 393220:
+			n += NoInline52(i)
+		case 393221:
+			n += NoInline53(i)
+		case 393222:
+			n += NoInline54(i)
+		case 393223:
+			n += NoInline55(i)
+		case 458752:
+			n += NoInline56(i)
+		case 458753:
+			n += NoInline57(i)
+		case 458754:
+			n += NoInline58(i)
+		case 458755:
+			n += NoInline59(i)
+		case 458756:
+			n += NoInline60(i)
+		case 458757:
+			n += NoInline61(i)
+		case 458758:
+			n += NoInline62(i)
+		case 458759:
+			n += NoInline63(i)
+		case 524288:
+			n += NoInline64(i)
+		case 524289:
+			n += NoInline65(i)
+		case 524290:
+			n += NoInline66(i)
+		case 524291:
+			n += NoInline67(i)
+		case 524292:
+			n += NoInline68(i)
+		case 524293:
+			n += NoInline69(i)
+		case 524294:
+			n += NoInline70(i)
+		case 524295:
+			n += NoInline71(i)
+		case 589824:
+			n += NoInline72(i)
+		case 589825:
+			n += NoInline73(i)
+		case 589826:
+			n += NoInline74(i)
+		case 589827:
+			n += NoInline75(i)
+		case 589828:
+			n += NoInline76(i)
+		case 589829:
+			n += NoInline77(i)
+		case 589830:
+			n += NoInline78(i)
+		case 589831:
+			n += NoInline79(i)
+		case 655360:
+			n += NoInline80(i)
+		case 655361:
+			n += NoInline81(i)
+		case 655362:
+			n += NoInline82(i)
+		case 655363:
+			n += NoInline83(i)
+		case 655364:
+			n += NoInline84(i)
+		case 655365:
+			n += NoInline85(i)
+		case 655366:
+			n += NoInline86(i)
+		case 655367:
+			n += NoInline87(i)
+		case 720896:
+			n += NoInline88(i)
+		case 720897:
+			n += NoInline89(i)
+		case 720898:
+			n += NoInline90(i)
+		case 720899:
+			n += NoInline91(i)
+		case 720900:
+			n += NoInline92(i)
+		case 720901:
+			n += NoInline93(i)
+		case 720902:
+			n += NoInline94(i)
+		case 720903:
+			n += NoInline95(i)
+		case 786432:
+			n += NoInline96(i)
+		case 786433:
+			n += NoInline97(i)
+		case 786434:
+			n += NoInline98(i)
+		case 786435:
+			n += NoInline99(i)
+		case 786436:
+			n += NoInline100(i)
+		case 786437:
+			n += NoInline101(i)
+		case 786438:
+			n += NoInline102(i)
+		case 786439:
+			n += NoInline103(i)
+		case 851968:
+			n += NoInline104(i)
+		case 851969:
+			n += NoInline105(i)
+		case 851970:
+			n += NoInline106(i)
+		case 851971:
+			n += NoInline107(i)
+		case 851972:
+			n += NoInline108(i)
+		case 851973:
+			n += NoInline109(i)
+		case 851974:
+			n += NoInline110(i)
+		case 851975:
+			n += NoInline111(i)
+		case 917504:
+			n += NoInline112(i)
+		case 917505:
+			n += NoInline113(i)
+		case 917506:
+			n += NoInline114(i)
+		case 917507:
+			n += NoInline115(i)
+		case 917508:
+			n += NoInline116(i)
+		case 917509:
+			n += NoInline117(i)
+		case 917510:
+			n += NoInline118(i)
+		case 917511:
+			n += NoInline119(i)
+		case 983040:
+			n += NoInline120(i)
+		case 983041:
+			n += NoInline121(i)
+		case 983042:
+			n += NoInline122(i)
+		case 983043:
+			n += NoInline123(i)
+		case 983044:
+			n += NoInline124(i)
+		case 983045:
+			n += NoInline125(i)
+		case 983046:
+			n += NoInline126(i)
+		case 983047:
+			n += NoInline127(i)
+		case 1048576:
+			n += NoInline128(i)
+		case 1048577:
+			n += NoInline129(i)
+		case 1048578:
+			n += NoInline130(i)
+		case 1048579:
+			n += NoInline131(i)
+		case 1048580:
+			n += NoInline132(i)
+		case 1048581:
+			n += NoInline133(i)
+		case 1048582:
+			n += NoInline134(i)
+		case 1048583:
+			n += NoInline135(i)
+		case 1114112:
+			n += NoInline136(i)
+		case 1114113:
+			n += NoInline137(i)
+		case 1114114:
+			n += NoInline138(i)
+		case 1114115:
+			n += NoInline139(i)
+		case 1114116:
+			n += NoInline140(i)
+		case 1114117:
+			n += NoInline141(i)
+		case 1114118:
+			n += NoInline142(i)
+		case 1114119:
+			n += NoInline143(i)
+		case 1179648:
+			n += NoInline144(i)
+		case 1179649:
+			n += NoInline145(i)
+		case 1179650:
+			n += NoInline146(i)
+		case 1179651:
+			n += NoInline147(i)
+		case 1179652:
+			n += NoInline148(i)
+		case 1179653:
+			n += NoInline149(i)
+		case 1179654:
+			n += NoInline150(i)
+		case 1179655:
+			n += NoInline151(i)
+		case 1245184:
+			n += NoInline152(i)
+		case 1245185:
+			n += NoInline153(i)
+		case 1245186:
+			n += NoInline154(i)
+		case 1245187:
+			n += NoInline155(i)
+		case 1245188:
+			n += NoInline156(i)
+		case 1245189:
+			n += NoInline157(i)
+		case 1245190:
+			n += NoInline158(i)
+		case 1245191:
+			n += NoInline159(i)
+		case 1310720:
+			n += NoInline160(i)
+		case 1310721:
+			n += NoInline161(i)
+		case 1310722:
+			n += NoInline162(i)
+		case 1310723:
+			n += NoInline163(i)
+		case 1310724:
+			n += NoInline164(i)
+		case 1310725:
+			n += NoInline165(i)
+		case 1310726:
+			n += NoInline166(i)
+		case 1310727:
+			n += NoInline167(i)
+		case 1376256:
+			n += NoInline168(i)
+		case 1376257:
+			n += NoInline169(i)
+		case 1376258:
+			n += NoInline170(i)
+		case 1376259:
+			n += NoInline171(i)
+		case 1376260:
+			n += NoInline172(i)
+		case 1376261:
+			n += NoInline173(i)
+		case 1376262:
+			n += NoInline174(i)
+		case 1376263:
+			n += NoInline175(i)
+		case 1441792:
+			n += NoInline176(i)
+		case 1441793:
+			n += NoInline177(i)
+		case 1441794:
+			n += NoInline178(i)
+		case 1441795:
+			n += NoInline179(i)
+		case 1441796:
+			n += NoInline180(i)
+		case 1441797:
+			n += NoInline181(i)
+		case 1441798:
+			n += NoInline182(i)
+		case 1441799:
+			n += NoInline183(i)
+		case 1507328:
+			n += NoInline184(i)
+		case 1507329:
+			n += NoInline185(i)
+		case 1507330:
+			n += NoInline186(i)
+		case 1507331:
+			n += NoInline187(i)
+		case 1507332:
+			n += NoInline188(i)
+		case 1507333:
+			n += NoInline189(i)
+		case 1507334:
+			n += NoInline190(i)
+		case 1507335:
+			n += NoInline191(i)
+		case 1572864:
+			n += NoInline192(i)
+		case 1572865:
+			n += NoInline193(i)
+		case 1572866:
+			n += NoInline194(i)
+		case 1572867:
+			n += NoInline195(i)
+		case 1572868:
+			n += NoInline196(i)
+		case 1572869:
+			n += NoInline197(i)
+		case 1572870:
+			n += NoInline198(i)
+		case 1572871:
+			n += NoInline199(i)
+		case 1638400:
+			n += NoInline200(i)
+		case 1638401:
+			n += NoInline201(i)
+		case 1638402:
+			n += NoInline202(i)
+		case 1638403:
+			n += NoInline203(i)
+		case 1638404:
+			n += NoInline204(i)
+		case 1638405:
+			n += NoInline205(i)
+		case 1638406:
+			n += NoInline206(i)
+		case 1638407:
+			n += NoInline207(i)
+		case 1703936:
+			n += NoInline208(i)
+		case 1703937:
+			n += NoInline209(i)
+		case 1703938:
+			n += NoInline210(i)
+		case 1703939:
+			n += NoInline211(i)
+		case 1703940:
+			n += NoInline212(i)
+		case 1703941:
+			n += NoInline213(i)
+		case 1703942:
+			n += NoInline214(i)
+		case 1703943:
+			n += NoInline215(i)
+		case 1769472:
+			n += NoInline216(i)
+		case 1769473:
+			n += NoInline217(i)
+		case 1769474:
+			n += NoInline218(i)
+		case 1769475:
+			n += NoInline219(i)
+		case 1769476:
+			n += NoInline220(i)
+		case 1769477:
+			n += NoInline221(i)
+		case 1769478:
+			n += NoInline222(i)
+		case 1769479:
+			n += NoInline223(i)
+		case 1835008:
+			n += NoInline224(i)
+		case 1835009:
+			n += NoInline225(i)
+		case 1835010:
+			n += NoInline226(i)
+		case 1835011:
+			n += NoInline227(i)
+		case 1835012:
+			n += NoInline228(i)
+		case 1835013:
+			n += NoInline229(i)
+		case 1835014:
+			n += NoInline230(i)
+		case 1835015:
+			n += NoInline231(i)
+		case 1900544:
+			n += NoInline232(i)
+		case 1900545:
+			n += NoInline233(i)
+		case 1900546:
+			n += NoInline234(i)
+		case 1900547:
+			n += NoInline235(i)
+		case 1900548:
+			n += NoInline236(i)
+		case 1900549:
+			n += NoInline237(i)
+		case 1900550:
+			n += NoInline238(i)
+		case 1900551:
+			n += NoInline239(i)
+		case 1966080:
+			n += NoInline240(i)
+		case 1966081:
+			n += NoInline241(i)
+		case 1966082:
+			n += NoInline242(i)
+		case 1966083:
+			n += NoInline243(i)
+		case 1966084:
+			n += NoInline244(i)
+		case 1966085:
+			n += NoInline245(i)
+		case 1966086:
+			n += NoInline246(i)
+		case 1966087:
+			n += NoInline247(i)
+		case 2031616:
+			n += NoInline248(i)
+		case 2031617:
+			n += NoInline249(i)
+		case 2031618:
+			n += NoInline250(i)
+		case 2031619:
+			n += NoInline251(i)
+		case 2031620:
+			n += NoInline252(i)
+		case 2031621:
+			n += NoInline253(i)
+		case 2031622:
+			n += NoInline254(i)
+		case 2031623:
+			n += NoInline255(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchNoInlineClusteredComputed512(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysClustered[i%512] {
+		case 0:
+			n += NoInline0(i)
+		case 1:
+			n += NoInline1(i)
+		case 2:
+			n += NoInline2(i)
+		case 3:
+			n += NoInline3(i)
+		case 4:
+			n += NoInline4(i)
+		case 5:
+			n += NoInline5(i)
+		case 6:
+			n += NoInline6(i)
+		case 7:
+			n += NoInline7(i)
+		case 65536:
+			n += NoInline8(i)
+		case 65537:
+			n += NoInline9(i)
+		case 65538:
+			n += NoInline10(i)
+		case 65539:
+			n += NoInline11(i)
+		case 65540:
+			n += NoInline12(i)
+		case 65541:
+			n += NoInline13(i)
+		case 65542:
+			n += NoInline14(i)
+		case 65543:
+			n += NoInline15(i)
+		case 131072:
+			n += NoInline16(i)
+		case 131073:
+			n += NoInline17(i)
+		case 131074:
+			n += NoInline18(i)
+		case 131075:
+			n += NoInline19(i)
+		case 131076:
+			n += NoInline20(i)
+		case 131077:
+			n += NoInline21(i)
+		case 131078:
+			n += NoInline22(i)
+		case 131079:
+			n += NoInline23(i)
+		case 196608:
+			n += NoInline24(i)
+		case 196609:
+			n += NoInline25(i)
+		case 196610:
+			n += NoInline26(i)
+		case 196611:
+			n += NoInline27(i)
+		case 196612:
+			n += NoInline28(i)
+		case 196613:
+			n += NoInline29(i)
+		case 196614:
+			n += NoInline30(i)
+		case 196615:
+			n += NoInline31(i)
+		case 262144:
+			n += NoInline32(i)
+		case 262145:
+			n += NoInline33(i)
+		case 262146:
+			n += NoInline34(i)
+		case 262147:
+			n += NoInline35(i)
+		case 262148:
+			n += NoInline36(i)
+		case 262149:
+			n += NoInline37(i)
+		case 262150:
+			n += NoInline38(i)
+		case 262151:
+			n += NoInline39(i)
+		case 327680:
+			n += NoInline40(i)
+		case 327681:
+			n += NoInline41(i)
+		case 327682:
+			n += NoInline42(i)
+		case 327683:
+			n += NoInline43(i)
+		case 327684:
+			n += NoInline44(i)
+		case 327685:
+			n += NoInline45(i)
+		case 327686:
+			n += NoInline46(i)
+		case 327687:
+			n += NoInline47(i)
+		case 393216:
+			n += NoInline48(i)
+		case 393217:
+			n += NoInline49(i)
+		case 393218:
+			n += NoInline50(i)
+		case 393219:
+			n += NoInline51(i)
+		case 393220:
+			n += NoInline52(i)
+		case 393221:
+			n += NoInline53(i)
+		case 393222:
+			n += NoInline54(i)
+		case 393223:
+			n += NoInline55(i)
+		case 458752:
+			n += NoInline56(i)
+		case 458753:
+			n += NoInline57(i)
+		case 458754:
+			n += NoInline58(i)
+		case 458755:
+			n += NoInline59(i)
+		case 458756:
+			n += NoInline60(i)
+		case 458757:
+			n += NoInline61(i)
+		case 458758:
+			n += NoInline62(i)
+		case 458759:
+			n += NoInline63(i)
+		case 524288:
+			n += NoInline64(i)
+		case 524289:
+			n += NoInline65(i)
+		case 524290:
+			n += NoInline66(i)
+		case 524291:
+			n += NoInline67(i)
+		case 524292:
+			n += NoInline68(i)
+		case 524293:
+			n += NoInline69(i)
+		case 524294:
+			n += NoInline70(i)
+		case 524295:
+			n += NoInline71(i)
+		case 589824:
+			n += NoInline72(i)
+		case 589825:
+			n += NoInline73(i)
+		case 589826:
+			n += NoInline74(i)
+		case 589827:
+			n += NoInline75(i)
+		case 589828:
+			n += NoInline76(i)
+		case 589829:
+			n += NoInline77(i)
+		case 589830:
+			n += NoInline78(i)
+		case 589831:
+			n += NoInline79(i)
+		case 655360:
+			n += NoInline80(i)
+		case 655361:
+			n += NoInline81(i)
+		case 655362:
+			n += NoInline82(i)
+		case 655363:
+			n += NoInline83(i)
+		case 655364:
+			n += NoInline84(i)
+		case 655365:
+			n += NoInline85(i)
+		case 655366:
+			n += NoInline86(i)
+		case 655367:
+			n += NoInline87(i)
+		case 720896:
+			n += NoInline88(i)
+		case 720897:
+			n += NoInline89(i)
+		case 720898:
+			n += NoInline90(i)
+		case 720899:
+			n += NoInline91(i)
+		case 720900:
+			n += NoInline92(i)
+		case 720901:
+			n += NoInline93(i)
+		case 720902:
+			n += NoInline94(i)
+		case 720903:
+			n += NoInline95(i)
+		case 786432:
+			n += NoInline96(i)
+		case 786433:
+			n += NoInline97(i)
+		case 786434:
+			n += NoInline98(i)
+		case 786435:
+			n += NoInline99(i)
+		case 786436:
+			n += NoInline100(i)
+		case 786437:
+			n += NoInline101(i)
+		case 786438:
+			n += NoInline102(i)
+		case 786439:
+			n += NoInline103(i)
+		case 851968:
+			n += NoInline104(i)
+		case 851969:
+			n += NoInline105(i)
+		case 851970:
+			n += NoInline106(i)
+		case 851971:
+			n += NoInline107(i)
+		case 851972:
+			n += NoInline108(i)
+		case 851973:
+			n += NoInline109(i)
+		case 851974:
+			n += NoInline110(i)
+		case 851975:
+			n += NoInline111(i)
+		case 917504:
+			n += NoInline112(i)
+		case 917505:
+			n += NoInline113(i)
+		case 917506:
+			n += NoInline114(i)
+		case 917507:
+			n += NoInline115(i)
+		case 917508:
+			n += NoInline116(i)
+		case 917509:
+			n += NoInline117(i)
+		case 917510:
+			n += NoInline118(i)
+		case 917511:
+			n += NoInline119(i)
+		case 983040:
+			n += NoInline120(i)
+		case 983041:
+			n += NoInline121(i)
+		case 983042:
+			n += NoInline122(i)
+		case 983043:
+			n += NoInline123(i)
+		case 983044:
+			n += NoInline124(i)
+		case 983045:
+			n += NoInline125(i)
+		case 983046:
+			n += NoInline126(i)
+		case 983047:
+			n += NoInline127(i)
+		case 1048576:
+			n += NoInline128(i)
+		case 1048577:
+			n += NoInline129(i)
+		case 1048578:
+			n += NoInline130(i)
+		case 1048579:
+			n += NoInline131(i)
+		case 1048580:
+			n += NoInline132(i)
+		case 1048581:
+			n += NoInline133(i)
+		case 1048582:
+			n += NoInline134(i)
+		case 1048583:
+			n += NoInline135(i)
+		case 1114112:
+			n += NoInline136(i)
+		case 1114113:
+			n += NoInline137(i)
+		case 1114114:
+			n += NoInline138(i)
+		case 1114115:
+			n += NoInline139(i)
+		case 1114116:
+			n += NoInline140(i)
+		case 1114117:
+			n += NoInline141(i)
+		case 1114118:
+			n += NoInline142(i)
+		case 1114119:
+			n += NoInline143(i)
+		case 1179648:
+			n += NoInline144(i)
+		case 1179649:
+			n += NoInline145(i)
+		case 1179650:
+			n += NoInline146(i)
+		case 1179651:
+			n += NoInline147(i)
+		case 1179652:
+			n += NoInline148(i)
+		case 1179653:
+			n += NoInline149(i)
+		case 1179654:
+			n += NoInline150(i)
+		case 1179655:
+			n += NoInline151(i)
+		case 1245184:
+			n += NoInline152(i)
+		case 1245185:
+			n += NoInline153(i)
+		case 1245186:
+			n += NoInline154(i)
+		case 1245187:
+			n += NoInline155(i)
+		case 1245188:
+			n += NoInline156(i)
+		case 1245189:
+			n += NoInline157(i)
+		case 1245190:
+			n += NoInline158(i)
+		case 1245191:
+			n += NoInline159(i)
+		case 1310720:
+			n += NoInline160(i)
+		case 1310721:
+			n += NoInline161(i)
+		case 1310722:
+			n += NoInline162(i)
+		case 1310723:
+			n += NoInline163(i)
+		case 1310724:
+			n += NoInline164(i)
+		case 1310725:
+			n += NoInline165(i)
+		case 1310726:
+			n += NoInline166(i)
+		case 1310727:
+			n += NoInline167(i)
+		case 1376256:
+			n += NoInline168(i)
+		case 1376257:
+			n += NoInline169(i)
+		case 1376258:
+			n += NoInline170(i)
+		case 1376259:
+			n += NoInline171(i)
+		case 1376260:
+			n += NoInline172(i)
+		case 1376261:
+			n += NoInline173(i)
+		case 1376262:
+			n += NoInline174(i)
+		case 1376263:
+			n += NoInline175(i)
+		case 1441792:
+			n += NoInline176(i)
+		case 1441793:
+			n += NoInline177(i)
+		case 1441794:
+			n += NoInline178(i)
+		case 1441795:
+			n += NoInline179(i)
+		case 1441796:
+			n += NoInline180(i)
+		case 1441797:
+			n += NoInline181(i)
+		case 1441798:
+			n += NoInline182(i)
+		case 1441799:
+			n += NoInline183(i)
+		case 1507328:
+			n += NoInline184(i)
+		case 1507329:
+			n += NoInline185(i)
+		case 1507330:
+			n += NoInline186(i)
+		case 1507331:
+			n += NoInline187(i)
+		case 1507332:
+			n += NoInline188(i)
+		case 1507333:
+			n += NoInline189(i)
+		case 1507334:
+			n += NoInline190(i)
+		case 1507335:
+			n += NoInline191(i)
+		case 1572864:
+			n += NoInline192(i)
+		case 1572865:
+			n += NoInline193(i)
+		case 1572866:
+			n += NoInline194(i)
+		case 1572867:
+			n += NoInline195(i)
+		case 1572868:
+			n += NoInline196(i)
+		case 1572869:
+			n += NoInline197(i)
+		case 1572870:
+			n += NoInline198(i)
+		case 1572871:
+			n += NoInline199(i)
+		case 1638400:
+			n += NoInline200(i)
+		case 1638401:
+			n += NoInline201(i)
+		case 1638402:
+			n += NoInline202(i)
+		case 1638403:
+			n += NoInline203(i)
+		case 1638404:
+			n += NoInline204(i)
+		case 1638405:
+			n += NoInline205(i)
+		case 1638406:
+			n += NoInline206(i)
+		case 1638407:
+			n += NoInline207(i)
+		case 1703936:
+			n += NoInline208(i)
+		case 1703937:
+			n += NoInline209(i)
+		case 1703938:
+			n += NoInline210(i)
+		case 1703939:
+			n += NoInline211(i)
+		case 1703940:
+			n += NoInline212(i)
+		case 1703941:
+			n += NoInline213(i)
+		case 1703942:
+			n += NoInline214(i)
+		case 1703943:
+			n += NoInline215(i)
+		case 1769472:
+			n += NoInline216(i)
+		case 1769473:
+			n += NoInline217(i)
+		case 1769474:
+			n += NoInline218(i)
+		case 1769475:
+			n += NoInline219(i)
+		case 1769476:
+			n += NoInline220(i)
+		case 1769477:
+			n += NoInline221(i)
+		case 1769478:
+			n += NoInline222(i)
+		case 1769479:
+			n += NoInline223(i)
+		case 1835008:
+			n += NoInline224(i)
+		case 1835009:
+			n += NoInline225(i)
+		case 1835010:
+			n += NoInline226(i)
+		case 1835011:
+			n += NoInline227(i)
+		case 1835012:
+			n += NoInline228(i)
+		case 1835013:
+			n += NoInline229(i)
+		case 1835014:
+			n += NoInline230(i)
+		case 1835015:
+			n += NoInline231(i)
+		case 1900544:
+			n += NoInline232(i)
+		case 1900545:
+			n += NoInline233(i)
+		case 1900546:
+			n += NoInline234(i)
+		case 1900547:
+			n += NoInline235(i)
+		case 1900548:
+			n += NoInline236(i)
+		case 1900549:
+			n += NoInline237(i)
+		case 1900550:
+			n += NoInline238(i)
+		case 1900551:
+			n += NoInline239(i)
+		case 1966080:
+			n += NoInline240(i)
+		case 1966081:
+			n += NoInline241(i)
+		case 1966082:
+			n += NoInline242(i)
+		case 1966083:
+			n += NoInline243(i)
+		case 1966084:
+			n += NoInline244(i)
+		case 1966085:
+			n += NoInline245(i)
+		case 1966086:
+			n += NoInline246(i)
+		case 1966087:
+			n += NoInline247(i)
+		case 2031616:
+			n += NoInline248(i)
+		case 2031617:
+			n += NoInline249(i)
+		case 2031618:
+			n += NoInline250(i)
+		case 2031619:
+			n += NoInline251(i)
+		case 2031620:
+			n += NoInline252(i)
+		case 2031621:
+			n += NoInline253(i)
+		case 2031622:
+			n += NoInline254(i)
+		case 2031623:
+			n += NoInline255(i)
+		case 2097152:
+			n += NoInline256(i)
+		case 2097153:
+			n += NoInline257(i)
+		case 2097154:
+			n += NoInline258(i)
+		case 2097155:
+			n += NoInline259(i)
+		case 2097156:
+			n += NoInline260(i)
+		case 2097157:
+			n += NoInline261(i)
+		case 2097158:
+			n += NoInline262(i)
+		case 2097159:
+			n += NoInline263(i)
+		case 2162688:
+			n += NoInline264(i)
+		case 2162689:
+			n += NoInline265(i)
+		case 2162690:
+			n += NoInline266(i)
+		case 2162691:
+			n += NoInline267(i)
+		case 2162692:
+			n += NoInline268(i)
+		case 2162693:
+			n += NoInline269(i)
+		case 2162694:
+			n += NoInline270(i)
+		case 2162695:
+			n += NoInline271(i)
+		case 2228224:
+			n += NoInline272(i)
+		case 2228225:
+			n += NoInline273(i)
+		case 2228226:
+			n += NoInline274(i)
+		case 2228227:
+			n += NoInline275(i)
+		case 2228228:
+			n += NoInline276(i)
+		case 2228229:
+			n += NoInline277(i)
+		case 2228230:
+			n += NoInline278(i)
+		case 2228231:
+			n += NoInline279(i)
+		case 2293760:
+			n += NoInline280(i)
+		case 2293761:
+			n += NoInline281(i)
+		case 2293762:
+			n += NoInline282(i)
+		case 2293763:
+			n += NoInline283(i)
+		case 2293764:
+			n += NoInline284(i)
+		case 2293765:
+			n += NoInline285(i)
+		case 2293766:
+			n += NoInline286(i)
+		case 2293767:
+			n += NoInline287(i)
+		case 2359296:
+			n += NoInline288(i)
+		case 2359297:
+			n += NoInline289(i)
+		case 2359298:
+			n += NoInline290(i)
+		case 2359299:
+			n += NoInline291(i)
+		case 2359300:
+			n += NoInline292(i)
+		case 2359301:
+			n += NoInline293(i)
+		case 2359302:
+			n += NoInline294(i)
+		case 2359303:
+			n += NoInline295(i)
+		case 2424832:
+			n += NoInline296(i)
+		case 2424833:
+			n += NoInline297(i)
+		case 2424834:
+			n += NoInline298(i)
+		case 2424835:
+			n += NoInline299(i)
+		case 2424836:
+			n += NoInline300(i)
+		case 2424837:
+			n += NoInline301(i)
+		case 2424838:
+			n += NoInline302(i)
+		case 2424839:
+			n += NoInline303(i)
+		case 2490368:
+			n += NoInline304(i)
+		case 2490369:
+			n += NoInline305(i)
+		case 2490370:
+			n += NoInline306(i)
+		case 2490371:
+			n += NoInline307(i)
+		case 2490372:
+			n += NoInline308(i)
+		case 2490373:
+			n += NoInline309(i)
+		case 2490374:
+			n += NoInline310(i)
+		case 2490375:
+			n += NoInline311(i)
+		case 2555904:
+			n += NoInline312(i)
+		case 2555905:
+			n += NoInline313(i)
+		case 2555906:
+			n += NoInline314(i)
+		case 2555907:
+			n += NoInline315(i)
+		case 2555908:
+			n += NoInline316(i)
+		case 2555909:
+			n += NoInline317(i)
+		case 2555910:
+			n += NoInline318(i)
+		case 2555911:
+			n += NoInline319(i)
+		case 2621440:
+			n += NoInline320(i)
+		case 2621441:
+			n += NoInline321(i)
+		case 2621442:
+			n += NoInline322(i)
+		case 2621443:
+			n += NoInline323(i)
+		case 2621444:
+			n += NoInline324(i)
+		case 2621445:
+			n += NoInline325(i)
+		case 2621446:
+			n += NoInline326(i)
+		case 2621447:
+			n += NoInline327(i)
+		case 2686976:
+			n += NoInline328(i)
+		case 2686977:
+			n += NoInline329(i)
+		case 2686978:
+			n += NoInline330(i)
+		case 2686979:
+			n += NoInline331(i)
+		case 2686980:
+			n += NoInline332(i)
+		case 2686981:
+			n += NoInline333(i)
+		case 2686982:
+			n += NoInline334(i)
+		case 2686983:
+			n += NoInline335(i)
+		case 2752512:
+			n += NoInline336(i)
+		case 2752513:
+			n += NoInline337(i)
+		case 2752514:
+			n += NoInline338(i)
+		case 2752515:
+			n += NoInline339(i)
+		case 2752516:
+			n += NoInline340(i)
+		case 2752517:
+			n += NoInline341(i)
+		case 2752518:
+			n += NoInline342(i)
+		case 2752519:
+			n += NoInline343(i)
+		case 2818048:
+			n += NoInline344(i)
+		case 2818049:
+			n += NoInline345(i)
+		case 2818050:
+			n += NoInline346(i)
+		case 2818051:
+			n += NoInline347(i)
+		case 2818052:
+			n += NoInline348(i)
+		case 2818053:
+			n += NoInline349(i)
+		case 2818054:
+			n += NoInline350(i)
+		case 2818055:
+			n += NoInline351(i)
+		case 2883584:
+			n += NoInline352(i)
+		case 2883585:
+			n += NoInline353(i)
+		case 2883586:
+			n += NoInline354(i)
+		case 2883587:
+			n += NoInline355(i)
+		case 2883588:
+			n += NoInline356(i)
+		case 2883589:
+			n += NoInline357(i)
+		case 2883590:
+			n += NoInline358(i)
+		case 2883591:
+			n += NoInline359(i)
+		case 2949120:
+			n += NoInline360(i)
+		case 2949121:
+			n += NoInline361(i)
+		case 2949122:
+			n += NoInline362(i)
+		case 2949123:
+			n += NoInline363(i)
+		case 2949124:
+			n += NoInline364(i)
+		case 2949125:
+			n += NoInline365(i)
+		case 2949126:
+			n += NoInline366(i)
+		case 2949127:
+			n += NoInline367(i)
+		case 3014656:
+			n += NoInline368(i)
+		case 3014657:
+			n += NoInline369(i)
+		case 3014658:
+			n += NoInline370(i)
+		case 3014659:
+			n += NoInline371(i)
+		case 3014660:
+			n += NoInline372(i)
+		case 3014661:
+			n += NoInline373(i)
+		case 3014662:
+			n += NoInline374(i)
+		case 3014663:
+			n += NoInline375(i)
+		case 3080192:
+			n += NoInline376(i)
+		case 3080193:
+			n += NoInline377(i)
+		case 3080194:
+			n += NoInline378(i)
+		case 3080195:
+			n += NoInline379(i)
+		case 3080196:
+			n += NoInline380(i)
+		case 3080197:
+			n += NoInline381(i)
+		case 3080198:
+			n += NoInline382(i)
+		case 3080199:
+			n += NoInline383(i)
+		case 3145728:
+			n += NoInline384(i)
+		case 3145729:
+			n += NoInline385(i)
+		case 3145730:
+			n += NoInline386(i)
+		case 3145731:
+			n += NoInline387(i)
+		case 3145732:
+			n += NoInline388(i)
+		case 3145733:
+			n += NoInline389(i)
+		case 3145734:
+			n += NoInline390(i)
+		case 3145735:
+			n += NoInline391(i)
+		case 3211264:
+			n += NoInline392(i)
+		case 3211265:
+			n += NoInline393(i)
+		case 3211266:
+			n += NoInline394(i)
+		case 3211267:
+			n += NoInline395(i)
+		case 3211268:
+			n += NoInline396(i)
+		case 3211269:
+			n += NoInline397(i)
+		case 3211270:
+			n += NoInline398(i)
+		case 3211271:
+			n += NoInline399(i)
+		case 3276800:
+			n += NoInline400(i)
+		case 3276801:
+			n += NoInline401(i)
+		case 3276802:
+			n += NoInline402(i)
+		case 3276803:
+			n += NoInline403(i)
+		case 3276804:
+			n += NoInline404(i)
+		case 3276805:
+			n += NoInline405(i)
+		case 3276806:
+			n += NoInline406(i)
+		case 3276807:
+			n += NoInline407(i)
+		case 3342336:
+			n += NoInline408(i)
+		case 3342337:
+			n += NoInline409(i)
+		case 3342338:
+			n += NoInline410(i)
+		case 3342339:
+			n += NoInline411(i)
+		case 3342340:
+			n += NoInline412(i)
+		case 3342341:
+			n += NoInline413(i)
+		case 3342342:
+			n += NoInline414(i)
+		case 3342343:
+			n += NoInline415(i)
+		case 3407872:
+			n += NoInline416(i)
+		case 3407873:
+			n += NoInline417(i)
+		case 3407874:
+			n += NoInline418(i)
+		case 3407875:
+			n += NoInline419(i)
+		case 3407876:
+			n += NoInline420(i)
+		case 3407877:
+			n += NoInline421(i)
+		case 3407878:
+			n += NoInline422(i)
+		case 3407879:
+			n += NoInline423(i)
+		case 3473408:
+			n += NoInline424(i)
+		case 3473409:
+			n += NoInline425(i)
+		case 3473410:
+			n += NoInline426(i)
+		case 3473411:
+			n += NoInline427(i)
+		case 3473412:
+			n += NoInline428(i)
+		case 3473413:
+			n += NoInline429(i)
+		case 3473414:
+			n += NoInline430(i)
+		case 3473415:
+			n += NoInline431(i)
+		case 3538944:
+			n += NoInline432(i)
+		case 3538945:
+			n += NoInline433(i)
+		case 3538946:
+			n += NoInline434(i)
+		case 3538947:
+			n += NoInline435(i)
+		case 3538948:
+			n += NoInline436(i)
+		case 3538949:
+			n += NoInline437(i)
+		case 3538950:
+			n += NoInline438(i)
+		case 3538951:
+			n += NoInline439(i)
+		case 3604480:
+			n += NoInline440(i)
+		case 3604481:
+			n += NoInline441(i)
+		case 3604482:
+			n += NoInline442(i)
+		case 3604483:
+			n += NoInline443(i)
+		case 3604484:
+			n += NoInline444(i)
+		case 3604485:
+			n += NoInline445(i)
+		case 3604486:
+			n += NoInline446(i)
+		case 3604487:
+			n += NoInline447(i)
+		case 3670016:
+			n += NoInline448(i)
+		case 3670017:
+			n += NoInline449(i)
+		case 3670018:
+			n += NoInline450(i)
+		case 3670019:
+			n += NoInline451(i)
+		case 3670020:
+			n += NoInline452(i)
+		case 3670021:
+			n += NoInline453(i)
+		case 3670022:
+			n += NoInline454(i)
+		case 3670023:
+			n += NoInline455(i)
+		case 3735552:
+			n += NoInline456(i)
+		case 3735553:
+			n += NoInline457(i)
+		case 3735554:
+			n += NoInline458(i)
+		case 3735555:
+			n += NoInline459(i)
+		case 3735556:
+			n += NoInline460(i)
+		case 3735557:
+			n += NoInline461(i)
+		case 3735558:
+			n += NoInline462(i)
+		case 3735559:
+			n += NoInline463(i)
+		case 3801088:
+			n += NoInline464(i)
+		case 3801089:
+			n += NoInline465(i)
+		case 3801090:
+			n += NoInline466(i)
+		case 3801091:
+			n += NoInline467(i)
+		case 3801092:
+			n += NoInline468(i)
+		case 3801093:
+			n += NoInline469(i)
+		case 3801094:
+			n += NoInline470(i)
+		case 3801095:
+			n += NoInline471(i)
+		case 3866624:
+			n += NoInline472(i)
+		case 3866625:
+			n += NoInline473(i)
+		case 3866626:
+			n += NoInline474(i)
+		case 3866627:
+			n += NoInline475(i)
+		case 3866628:
+			n += NoInline476(i)
+		case 3866629:
+			n += NoInline477(i)
+		case 3866630:
+			n += NoInline478(i)
+		case 3866631:
+			n += NoInline479(i)
+		case 3932160:
+			n += NoInline480(i)
+		case 3932161:
+			n += NoInline481(i)
+		case 3932162:
+			n += NoInline482(i)
+		case 3932163:
+			n += NoInline483(i)
+		case 3932164:
+			n += NoInline484(i)
+		case 3932165:
+			n += NoInline485(i)
+		case 3932166:
+			n += NoInline486(i)
+		case 3932167:
+			n += NoInline487(i)
+		case 3997696:
+			n += NoInline488(i)
+		case 3997697:
+			n += NoInline489(i)
+		case 3997698:
+			n += NoInline490(i)
+		case 3997699:
+			n += NoInline491(i)
+		case 3997700:
+			n += NoInline492(i)
+		case 3997701:
+			n += NoInline493(i)
+		case 3997702:
+			n += NoInline494(i)
+		case 3997703:
+			n += NoInline495(i)
+		case 4063232:
+			n += NoInline496(i)
+		case 4063233:
+			n += NoInline497(i)
+		case 4063234:
+			n += NoInline498(i)
+		case 4063235:
+			n += NoInline499(i)
+		case 4063236:
+			n += NoInline500(i)
+		case 4063237:
+			n += NoInline501(i)
+		case 4063238:
+			n += NoInline502(i)
+		case 4063239:
+			n += NoInline503(i)
+		case 4128768:
+			n += NoInline504(i)
+		case 4128769:
+			n += NoInline505(i)
+		case 4128770:
+			n += NoInline506(i)
+		case 4128771:
+			n += NoInline507(i)
+		case 4128772:
+			n += NoInline508(i)
+		case 4128773:
+			n += NoInline509(i)
+		case 4128774:
+			n += NoInline510(i)
+		case 4128775:
+			n += NoInline511(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutSwitchNoInlineClusteredLookupMod512(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		switch LayoutKeysClustered[inputs[i%len(inputs)]] {
+		case 0:
+			n += NoInline0(i)
+		case 1:
+			n += NoInline1(i)
+		case 2:
+			n += NoInline2(i)
+		case 3:
+			n += NoInline3(i)
+		case 4:
+			n += NoInline4(i)
+		case 5:
+			n += NoInline5(i)
+		case 6:
+			n += NoInline6(i)
+		case 7:
+			n += NoInline7(i)
+		case 65536:
+			n += NoInline8(i)
+		case 65537:
+			n += NoInline9(i)
+		case 65538:
+			n += NoInline10(i)
+		case 65539:
+			n += NoInline11(i)
+		case 65540:
+			n += NoInline12(i)
+		case 65541:
+			n += NoInline13(i)
+		case 65542:
+			n += NoInline14(i)
+		case 65543:
+			n += NoInline15(i)
+		case 131072:
+			n += NoInline16(i)
+		case 131073:
+			n += NoInline17(i)
+		case 131074:
+			n += NoInline18(i)
+		case 131075:
+			n += NoInline19(i)
+		case 131076:
+			n += NoInline20(i)
+		case 131077:
+			n += NoInline21(i)
+		case 131078:
+			n += NoInline22(i)
+		case 131079:
+			n += NoInline23(i)
+		case 196608:
+			n += NoInline24(i)
+		case 196609:
+			n += NoInline25(i)
+		case 196610:
+			n += NoInline26(i)
+		case 196611:
+			n += NoInline27(i)
+		case 196612:
+			n += NoInline28(i)
+		case 196613:
+			n += NoInline29(i)
+		case 196614:
+			n += NoInline30(i)
+		case 196615:
+			n += NoInline31(i)
+		case 262144:
+			n += NoInline32(i)
+		case 262145:
+			n += NoInline33(i)
+		case 262146:
+			n += NoInline34(i)
+		case 262147:
+			n += NoInline35(i)
+		case 262148:
+			n += NoInline36(i)
+		case 262149:
+			n += NoInline37(i)
+		case 262150:
+			n += NoInline38(i)
+		case 262151:
+			n += NoInline39(i)
+		case 327680:
+			n += NoInline40(i)
+		case 327681:
+			n += NoInline41(i)
+		case 327682:
+			n += NoInline42(i)
+		case 327683:
+			n += NoInline43(i)
+		case 327684:
+			n += NoInline44(i)
+		case 327685:
+			n += NoInline45(i)
+		case 327686:
+			n += NoInline46(i)
+		case 327687:
+			n += NoInline47(i)
+		case 393216:
+			n += NoInline48(i)
+		case 393217:
+			n += NoInline49(i)
+		case 393218:
+			n += NoInline50(i)
+		case 393219:
+			n += NoInline51(i)
+		case 393220:
+			n += NoInline52(i)
+		case 393221:
+			n += NoInline53(i)
+		case 393222:
+			n += NoInline54(i)
+		case 393223:
+			n += NoInline55(i)
+		case 458752:
+			n += NoInline56(i)
+		case 458753:
+			n += NoInline57(i)
+		case 458754:
+			n += NoInline58(i)
+		case 458755:
+			n += NoInline59(i)
+		case 458756:
+			n += NoInline60(i)
+		case 458757:
+			n += NoInline61(i)
+		case 458758:
+			n += NoInline62(i)
+		case 458759:
+			n += NoInline63(i)
+		case 524288:
+			n += NoInline64(i)
+		case 524289:
+			n += NoInline65(i)
+		case 524290:
+			n += NoInline66(i)
+		case 524291:
+			n += NoInline67(i)
+		case 524292:
+			n += NoInline68(i)
+		case 524293:
+			n += NoInline69(i)
+		case 524294:
+			n += NoInline70(i)
+		case 524295:
+			n += NoInline71(i)
+		case 589824:
+			n += NoInline72(i)
+		case 589825:
+			n += NoInline73(i)
+		case 589826:
+			n += NoInline74(i)
+		case 589827:
+			n += NoInline75(i)
+		case 589828:
+			n += NoInline76(i)
+		case 589829:
+			n += NoInline77(i)
+		case 589830:
+			n += NoInline78(i)
+		case 589831:
+			n += NoInline79(i)
+		case 655360:
+			n += NoInline80(i)
+		case 655361:
+			n += NoInline81(i)
+		case 655362:
+			n += NoInline82(i)
+		case 655363:
+			n += NoInline83(i)
+		case 655364:
+			n += NoInline84(i)
+		case 655365:
+			n += NoInline85(i)
+		case 655366:
+			n += NoInline86(i)
+		case 655367:
+			n += NoInline87(i)
+		case 720896:
+			n += NoInline88(i)
+		case 720897:
+			n += NoInline89(i)
+		case 720898:
+			n += NoInline90(i)
+		case 720899:
+			n += NoInline91(i)
+		case 720900:
+			n += NoInline92(i)
+		case 720901:
+			n += NoInline93(i)
+		case 720902:
+			n += NoInline94(i)
+		case 720903:
+			n += NoInline95(i)
+		case 786432:
+			n += NoInline96(i)
+		case 786433:
+			n += NoInline97(i)
+		case 786434:
+			n += NoInline98(i)
+		case 786435:
+			n += NoInline99(i)
+		case 786436:
+			n += NoInline100(i)
+		case 786437:
+			n += NoInline101(i)
+		case 786438:
+			n += NoInline102(i)
+		case 786439:
+			n += NoInline103(i)
+		case 851968:
+			n += NoInline104(i)
+		case 851969:
+			n += NoInline105(i)
+		case 851970:
+			n += NoInline106(i)
+		case 851971:
+			n += NoInline107(i)
+		case 851972:
+			n += NoInline108(i)
+		case 851973:
+			n += NoInline109(i)
+		case 851974:
+			n += NoInline110(i)
+		case 851975:
+			n += NoInline111(i)
+		case 917504:
+			n += NoInline112(i)
+		case 917505:
+			n += NoInline113(i)
+		case 917506:
+			n += NoInline114(i)
+		case 917507:
+			n += NoInline115(i)
+		case 917508:
+			n += NoInline116(i)
+		case 917509:
+			n += NoInline117(i)
+		case 917510:
+			n += NoInline118(i)
+		case 917511:
+			n += NoInline119(i)
+		case 983040:
+			n += NoInline120(i)
+		case 983041:
+			n += NoInline121(i)
+		case 983042:
+			n += NoInline122(i)
+		case 983043:
+			n += NoInline123(i)
+		case 983044:
+			n += NoInline124(i)
+		case 983045:
+			n += NoInline125(i)
+		case 983046:
+			n += NoInline126(i)
+		case 983047:
+			n += NoInline127(i)
+		case 1048576:
+			n += NoInline128(i)
+		case 1048577:
+			n += NoInline129(i)
+		case 1048578:
+			n += NoInline130(i)
+		case 1048579:
+			n += NoInline131(i)
+		case 1048580:
+			n += NoInline132(i)
+		case 1048581:
+			n += NoInline133(i)
+		case 1048582:
+			n += NoInline134(i)
+		case 1048583:
+			n += NoInline135(i)
+		case 1114112:
+			n += NoInline136(i)
+		case 1114113:
+			n += NoInline137(i)
+		case 1114114:
+			n += NoInline138(i)
+		case 1114115:
+			n += NoInline139(i)
+		case 1114116:
+			n += NoInline140(i)
+		case 1114117:
+			n += NoInline141(i)
+		case 1114118:
+			n += NoInline142(i)
+		case 1114119:
+			n += NoInline143(i)
+		case 1179648:
+			n += NoInline144(i)
+		case 1179649:
+			n += NoInline145(i)
+		case 1179650:
+			n += NoInline146(i)
+		case 1179651:
+			n += NoInline147(i)
+		case 1179652:
+			n += NoInline148(i)
+		case 1179653:
+			n += NoInline149(i)
+		case 1179654:
+			n += NoInline150(i)
+		case 1179655:
+			n += NoInline151(i)
+		case 1245184:
+			n += NoInline152(i)
+		case 1245185:
+			n += NoInline153(i)
+		case 1245186:
+			n += NoInline154(i)
+		case 1245187:
+			n += NoInline155(i)
+		case 1245188:
+			n += NoInline156(i)
+		case 1245189:
+			n += NoInline157(i)
+		case 1245190:
+			n += NoInline158(i)
+		case 1245191:
+			n += NoInline159(i)
+		case 1310720:
+			n += NoInline160(i)
+		case 1310721:
+			n += NoInline161(i)
+		case 1310722:
+			n += NoInline162(i)
+		case 1310723:
+			n += NoInline163(i)
+		case 1310724:
+			n += NoInline164(i)
+		case 1310725:
+			n += NoInline165(i)
+		case 1310726:
+			n += NoInline166(i)
+		case 1310727:
+			n += NoInline167(i)
+		case 1376256:
+			n += NoInline168(i)
+		case 1376257:
+			n += NoInline169(i)
+		case 1376258:
+			n += NoInline170(i)
+		case 1376259:
+			n += NoInline171(i)
+		case 1376260:
+			n += NoInline172(i)
+		case 1376261:
+			n += NoInline173(i)
+		case 1376262:
+			n += NoInline174(i)
+		case 1376263:
+			n += NoInline175(i)
+		case 1441792:
+			n += NoInline176(i)
+		case 1441793:
+			n += NoInline177(i)
+		case 1441794:
+			n += NoInline178(i)
+		case 1441795:
+			n += NoInline179(i)
+		case 1441796:
+			n += NoInline180(i)
+		case 1441797:
+			n += NoInline181(i)
+		case 1441798:
+			n += NoInline182(i)
+		case 1441799:
+			n += NoInline183(i)
+		case 1507328:
+			n += NoInline184(i)
+		case 1507329:
+			n += NoInline185(i)
+		case 1507330:
+			n += NoInline186(i)
+		case 1507331:
+			n += NoInline187(i)
+		case 1507332:
+			n += NoInline188(i)
+		case 1507333:
+			n += NoInline189(i)
+		case 1507334:
+			n += NoInline190(i)
+		case 1507335:
+			n += NoInline191(i)
+		case 1572864:
+			n += NoInline192(i)
+		case 1572865:
+			n += NoInline193(i)
+		case 1572866:
+			n += NoInline194(i)
+		case 1572867:
+			n += NoInline195(i)
+		case 1572868:
+			n += NoInline196(i)
+		case 1572869:
+			n += NoInline197(i)
+		case 1572870:
+			n += NoInline198(i)
+		case 1572871:
+			n += NoInline199(i)
+		case 1638400:
+			n += NoInline200(i)
+		case 1638401:
+			n += NoInline201(i)
+		case 1638402:
+			n += NoInline202(i)
+		case 1638403:
+			n += NoInline203(i)
+		case 1638404:
+			n += NoInline204(i)
+		case 1638405:
+			n += NoInline205(i)
+		case 1638406:
+			n += NoInline206(i)
+		case 1638407:
+			n += NoInline207(i)
+		case 1703936:
+			n += NoInline208(i)
+		case 1703937:
+			n += NoInline209(i)
+		case 1703938:
+			n += NoInline210(i)
+		case 1703939:
+			n += NoInline211(i)
+		case 1703940:
+			n += NoInline212(i)
+		case 1703941:
+			n += NoInline213(i)
+		case 1703942:
+			n += NoInline214(i)
+		case 1703943:
+			n += NoInline215(i)
+		case 1769472:
+			n += NoInline216(i)
+		case 1769473:
+			n += NoInline217(i)
+		case 1769474:
+			n += NoInline218(i)
+		case 1769475:
+			n += NoInline219(i)
+		case 1769476:
+			n += NoInline220(i)
+		case 1769477:
+			n += NoInline221(i)
+		case 1769478:
+			n += NoInline222(i)
+		case 1769479:
+			n += NoInline223(i)
+		case 1835008:
+			n += NoInline224(i)
+		case 1835009:
+			n += NoInline225(i)
+		case 1835010:
+			n += NoInline226(i)
+		case 1835011:
+			n += NoInline227(i)
+		case 1835012:
+			n += NoInline228(i)
+		case 1835013:
+			n += NoInline229(i)
+		case 1835014:
+			n += NoInline230(i)
+		case 1835015:
+			n += NoInline231(i)
+		case 1900544:
+			n += NoInline232(i)
+		case 1900545:
+			n += NoInline233(i)
+		case 1900546:
+			n += NoInline234(i)
+		case 1900547:
+			n += NoInline235(i)
+		case 1900548:
+			n += NoInline236(i)
+		case 1900549:
+			n += NoInline237(i)
+		case 1900550:
+			n += NoInline238(i)
+		case 1900551:
+			n += NoInline239(i)
+		case 1966080:
+			n += NoInline240(i)
+		case 1966081:
+			n += NoInline241(i)
+		case 1966082:
+			n += NoInline242(i)
+		case 1966083:
+			n += NoInline243(i)
+		case 1966084:
+			n += NoInline244(i)
+		case 1966085:
+			n += NoInline245(i)
+		case 1966086:
+			n += NoInline246(i)
+		case 1966087:
+			n += NoInline247(i)
+		case 2031616:
+			n += NoInline248(i)
+		case 2031617:
+			n += NoInline249(i)
+		case 2031618:
+			n += NoInline250(i)
+		case 2031619:
+			n += NoInline251(i)
+		case 2031620:
+			n += NoInline252(i)
+		case 2031621:
+			n += NoInline253(i)
+		case 2031622:
+			n += NoInline254(i)
+		case 2031623:
+			n += NoInline255(i)
+		case 2097152:
+			n += NoInline256(i)
+		case 2097153:
+			n += NoInline257(i)
+		case 2097154:
+			n += NoInline258(i)
+		case 2097155:
+			n += NoInline259(i)
+		case 2097156:
+			n += NoInline260(i)
+		case 2097157:
+			n += NoInline261(i)
+		case 2097158:
+			n += NoInline262(i)
+		case 2097159:
+			n += NoInline263(i)
+		case 2162688:
+			n += NoInline264(i)
+		case 2162689:
+			n += NoInline265(i)
+		case 2162690:
+			n += NoInline266(i)
+		case 2162691:
+			n += NoInline267(i)
+		case 2162692:
+			n += NoInline268(i)
+		case 2162693:
+			n += NoInline269(i)
+		case 2162694:
+			n += NoInline270(i)
+		case 2162695:
+			n += NoInline271(i)
+		case 2228224:
+			n += NoInline272(i)
+		case 2228225:
+			n += NoInline273(i)
+		case 2228226:
+			n += NoInline274(i)
+		case 2228227:
+			n += NoInline275(i)
+		case 2228228:
+			n += NoInline276(i)
+		case 2228229:
+			n += NoInline277(i)
+		case 2228230:
+			n += NoInline278(i)
+		case 2228231:
+			n += NoInline279(i)
+		case 2293760:
+			n += NoInline280(i)
+		case 2293761:
+			n += NoInline281(i)
+		case 2293762:
+			n += NoInline282(i)
+		case 2293763:
+			n += NoInline283(i)
+		case 2293764:
+			n += NoInline284(i)
+		case 2293765:
+			n += NoInline285(i)
+		case 2293766:
+			n += NoInline286(i)
+		case 2293767:
+			n += NoInline287(i)
+		case 2359296:
+			n += NoInline288(i)
+		case 2359297:
+			n += NoInline289(i)
+		case 2359298:
+			n += NoInline290(i)
+		case 2359299:
+			n += NoInline291(i)
+		case 2359300:
+			n += NoInline292(i)
+		case 2359301:
+			n += NoInline293(i)
+		case 2359302:
+			n += NoInline294(i)
+		case 2359303:
+			n += NoInline295(i)
+		case 2424832:
+			n += NoInline296(i)
+		case 2424833:
+			n += NoInline297(i)
+		case 2424834:
+			n += NoInline298(i)
+		case 2424835:
+			n += NoInline299(i)
+		case 2424836:
+			n += NoInline300(i)
+		case 2424837:
+			n += NoInline301(i)
+		case 2424838:
+			n += NoInline302(i)
+		case 2424839:
+			n += NoInline303(i)
+		case 2490368:
+			n += NoInline304(i)
+		case 2490369:
+			n += NoInline305(i)
+		case 2490370:
+			n += NoInline306(i)
+		case 2490371:
+			n += NoInline307(i)
+		case 2490372:
+			n += NoInline308(i)
+		case 2490373:
+			n += NoInline309(i)
+		case 2490374:
+			n += NoInline310(i)
+		case 2490375:
+			n += NoInline311(i)
+		case 2555904:
+			n += NoInline312(i)
+		case 2555905:
+			n += NoInline313(i)
+		case 2555906:
+			n += NoInline314(i)
+		case 2555907:
+			n += NoInline315(i)
+		case 2555908:
+			n += NoInline316(i)
+		case 2555909:
+			n += NoInline317(i)
+		case 2555910:
+			n += NoInline318(i)
+		case 2555911:
+			n += NoInline319(i)
+		case 2621440:
+			n += NoInline320(i)
+		case 2621441:
+			n += NoInline321(i)
+		case 2621442:
+			n += NoInline322(i)
+		case 2621443:
+			n += NoInline323(i)
+		case 2621444:
+			n += NoInline324(i)
+		case 2621445:
+			n += NoInline325(i)
+		case 2621446:
+			n += NoInline326(i)
+		case 2621447:
+			n += NoInline327(i)
+		case 2686976:
+			n += NoInline328(i)
+		case 2686977:
+			n += NoInline329(i)
+		case 2686978:
+			n += NoInline330(i)
+		case 2686979:
+			n += NoInline331(i)
+		case 2686980:
+			n += NoInline332(i)
+		case 2686981:
+			n += NoInline333(i)
+		case 2686982:
+			n += NoInline334(i)
+		case 2686983:
+			n += NoInline335(i)
+		case 2752512:
+			n += NoInline336(i)
+		case 2752513:
+			n += NoInline337(i)
+		case 2752514:
+			n += NoInline338(i)
+		case 2752515:
+			n += NoInline339(i)
+		case 2752516:
+			n += NoInline340(i)
+		case 2752517:
+			n += NoInline341(i)
+		case 2752518:
+			n += NoInline342(i)
+		case 2752519:
+			n += NoInline343(i)
+		case 2818048:
+			n += NoInline344(i)
+		case 2818049:
+			n += NoInline345(i)
+		case 2818050:
+			n += NoInline346(i)
+		case 2818051:
+			n += NoInline347(i)
+		case 2818052:
+			n += NoInline348(i)
+		case 2818053:
+			n += NoInline349(i)
+		case 2818054:
+			n += NoInline350(i)
+		case 2818055:
+			n += NoInline351(i)
+		case 2883584:
+			n += NoInline352(i)
+		case 2883585:
+			n += NoInline353(i)
+		case 2883586:
+			n += NoInline354(i)
+		case 2883587:
+			n += NoInline355(i)
+		case 2883588:
+			n += NoInline356(i)
+		case 2883589:
+			n += NoInline357(i)
+		case 2883590:
+			n += NoInline358(i)
+		case 2883591:
+			n += NoInline359(i)
+		case 2949120:
+			n += NoInline360(i)
+		case 2949121:
+			n += NoInline361(i)
+		case 2949122:
+			n += NoInline362(i)
+		case 2949123:
+			n += NoInline363(i)
+		case 2949124:
+			n += NoInline364(i)
+		case 2949125:
+			n += NoInline365(i)
+		case 2949126:
+			n += NoInline366(i)
+		case 2949127:
+			n += NoInline367(i)
+		case 3014656:
+			n += NoInline368(i)
+		case 3014657:
+			n += NoInline369(i)
+		case 3014658:
+			n += NoInline370(i)
+		case 3014659:
+			n += NoInline371(i)
+		case 3014660:
+			n += NoInline372(i)
+		case 3014661:
+			n += NoInline373(i)
+		case 3014662:
+			n += NoInline374(i)
+		case 3014663:
+			n += NoInline375(i)
+		case 3080192:
+			n += NoInline376(i)
+		case 3080193:
+			n += NoInline377(i)
+		case 3080194:
+			n += NoInline378(i)
+		case 3080195:
+			n += NoInline379(i)
+		case 3080196:
+			n += NoInline380(i)
+		case 3080197:
+			n += NoInline381(i)
+		case 3080198:
+			n += NoInline382(i)
+		case 3080199:
+			n += NoInline383(i)
+		case 3145728:
+			n += NoInline384(i)
+		case 3145729:
+			n += NoInline385(i)
+		case 3145730:
+			n += NoInline386(i)
+		case 3145731:
+			n += NoInline387(i)
+		case 3145732:
+			n += NoInline388(i)
+		case 3145733:
+			n += NoInline389(i)
+		case 3145734:
+			n += NoInline390(i)
+		case 3145735:
+			n += NoInline391(i)
+		case 3211264:
+			n += NoInline392(i)
+		case 3211265:
+			n += NoInline393(i)
+		case 3211266:
+			n += NoInline394(i)
+		case 3211267:
+			n += NoInline395(i)
+		case 3211268:
+			n += NoInline396(i)
+		case 3211269:
+			n += NoInline397(i)
+		case 3211270:
+			n += NoInline398(i)
+		case 3211271:
+			n += NoInline399(i)
+		case 3276800:
+			n += NoInline400(i)
+		case 3276801:
+			n += NoInline401(i)
+		case 3276802:
+			n += NoInline402(i)
+		case 3276803:
+			n += NoInline403(i)
+		case 3276804:
+			n += NoInline404(i)
+		case 3276805:
+			n += NoInline405(i)
+		case 3276806:
+			n += NoInline406(i)
+		case 3276807:
+			n += NoInline407(i)
+		case 3342336:
+			n += NoInline408(i)
+		case 3342337:
+			n += NoInline409(i)
+		case 3342338:
+			n += NoInline410(i)
+		case 3342339:
+			n += NoInline411(i)
+		case 3342340:
+			n += NoInline412(i)
+		case 3342341:
+			n += NoInline413(i)
+		case 3342342:
+			n += NoInline414(i)
+		case 3342343:
+			n += NoInline415(i)
+		case 3407872:
+			n += NoInline416(i)
+		case 3407873:
+			n += NoInline417(i)
+		case 3407874:
+			n += NoInline418(i)
+		case 3407875:
+			n += NoInline419(i)
+		case 3407876:
+			n += NoInline420(i)
+		case 3407877:
+			n += NoInline421(i)
+		case 3407878:
+			n += NoInline422(i)
+		case 3407879:
+			n += NoInline423(i)
+		case 3473408:
+			n += NoInline424(i)
+		case 3473409:
+			n += NoInline425(i)
+		case 3473410:
+			n += NoInline426(i)
+		case 3473411:
+			n += NoInline427(i)
+		case 3473412:
+			n += NoInline428(i)
+		case 3473413:
+			n += NoInline429(i)
+		case 3473414:
+			n += NoInline430(i)
+		case 3473415:
+			n += NoInline431(i)
+		case 3538944:
+			n += NoInline432(i)
+		case 3538945:
+			n += NoInline433(i)
+		case 3538946:
+			n += NoInline434(i)
+		case 3538947:
+			n += NoInline435(i)
+		case 3538948:
+			n += NoInline436(i)
+		case 3538949:
+			n += NoInline437(i)
+		case 3538950:
+			n += NoInline438(i)
+		case 3538951:
+			n += NoInline439(i)
+		case 3604480:
+			n += NoInline440(i)
+		case 3604481:
+			n += NoInline441(i)
+		case 3604482:
+			n += NoInline442(i)
+		case 3604483:
+			n += NoInline443(i)
+		case 3604484:
+			n += NoInline444(i)
+		case 3604485:
+			n += NoInline445(i)
+		case 3604486:
+			n += NoInline446(i)
+		case 3604487:
+			n += NoInline447(i)
+		case 3670016:
+			n += NoInline448(i)
+		case 3670017:
+			n += NoInline449(i)
+		case 3670018:
+			n += NoInline450(i)
+		case 3670019:
+			n += NoInline451(i)
+		case 3670020:
+			n += NoInline452(i)
+		case 3670021:
+			n += NoInline453(i)
+		case 3670022:
+			n += NoInline454(i)
+		case 3670023:
+			n += NoInline455(i)
+		case 3735552:
+			n += NoInline456(i)
+		case 3735553:
+			n += NoInline457(i)
+		case 3735554:
+			n += NoInline458(i)
+		case 3735555:
+			n += NoInline459(i)
+		case 3735556:
+			n += NoInline460(i)
+		case 3735557:
+			n += NoInline461(i)
+		case 3735558:
+			n += NoInline462(i)
+		case 3735559:
+			n += NoInline463(i)
+		case 3801088:
+			n += NoInline464(i)
+		case 3801089:
+			n += NoInline465(i)
+		case 3801090:
+			n += NoInline466(i)
+		case 3801091:
+			n += NoInline467(i)
+		case 3801092:
+			n += NoInline468(i)
+		case 3801093:
+			n += NoInline469(i)
+		case 3801094:
+			n += NoInline470(i)
+		case 3801095:
+			n += NoInline471(i)
+		case 3866624:
+			n += NoInline472(i)
+		case 3866625:
+			n += NoInline473(i)
+		case 3866626:
+			n += NoInline474(i)
+		case 3866627:
+			n += NoInline475(i)
+		case 3866628:
+			n += NoInline476(i)
+		case 3866629:
+			n += NoInline477(i)
+		case 3866630:
+			n += NoInline478(i)
+		case 3866631:
+			n += NoInline479(i)
+		case 3932160:
+			n += NoInline480(i)
+		case 3932161:
+			n += NoInline481(i)
+		case 3932162:
+			n += NoInline482(i)
+		case 3932163:
+			n += NoInline483(i)
+		case 3932164:
+			n += NoInline484(i)
+		case 3932165:
+			n += NoInline485(i)
+		case 3932166:
+			n += NoInline486(i)
+		case 3932167:
+			n += NoInline487(i)
+		case 3997696:
+			n += NoInline488(i)
+		case 3997697:
+			n += NoInline489(i)
+		case 3997698:
+			n += NoInline490(i)
+		case 3997699:
+			n += NoInline491(i)
+		case 3997700:
+			n += NoInline492(i)
+		case 3997701:
+			n += NoInline493(i)
+		case 3997702:
+			n += NoInline494(i)
+		case 3997703:
+			n += NoInline495(i)
+		case 4063232:
+			n += NoInline496(i)
+		case 4063233:
+			n += NoInline497(i)
+		case 4063234:
+			n += NoInline498(i)
+		case 4063235:
+			n += NoInline499(i)
+		case 4063236:
+			n += NoInline500(i)
+		case 4063237:
+			n += NoInline501(i)
+		case 4063238:
+			n += NoInline502(i)
+		case 4063239:
+			n += NoInline503(i)
+		case 4128768:
+			n += NoInline504(i)
+		case 4128769:
+			n += NoInline505(i)
+		case 4128770:
+			n += NoInline506(i)
+		case 4128771:
+			n += NoInline507(i)
+		case 4128772:
+			n += NoInline508(i)
+		case 4128773:
+			n += NoInline509(i)
+		case 4128774:
+			n += NoInline510(i)
+		case 4128775:
+			n += NoInline511(i)
+		}
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func BenchmarkLayoutMap(b *testing.B) {
+	b.Run("inline=true", func(b *testing.B) {
+		b.Run("layout=dense", func(b *testing.B) {
+			b.Run("pattern=computed", func(b *testing.B) {
+				b.Run("n=4", benchLayoutMapInlineDenseComputed4)
+				b.Run("n=8", benchLayoutMapInlineDenseComputed8)
+				b.Run("n=16", benchLayoutMapInlineDenseComputed16)
+				b.Run("n=32", benchLayoutMapInlineDenseComputed32)
+				b.Run("n=64", benchLayoutMapInlineDenseComputed64)
+				b.Run("n=128", benchLayoutMapInlineDenseComputed128)
+				b.Run("n=256", benchLayoutMapInlineDenseComputed256)
+				b.Run("n=512", benchLayoutMapInlineDenseComputed512)
+			})
+			b.Run("pattern=sequential", func(b *testing.B) {
+				runLookups(b, "sequential", lookupsLayoutMapInlineDense)
+			})
+			b.Run("pattern=random", func(b *testing.B) {
+				runLookups(b, "uniform", lookupsLayoutMapInlineDense)
+			})
+		})
+		b.Run("layout=strided", func(b *testing.B) {
+			b.Run("pattern=computed", func(b *testing.B) {
+				b.Run("n=4", benchLayoutMapInlineStridedComputed4)
+				b.Run("n=8", benchLayoutMapInlineStridedComputed8)
+				b.Run("n=16", benchLayoutMapInlineStridedComputed16)
+				b.Run("n=32", benchLayoutMapInlineStridedComputed32)
+				b.Run("n=64", benchLayoutMapInlineStridedComputed64)
+				b.Run("n=128", benchLayoutMapInlineStridedComputed128)
+				b.Run("n=256", benchLayoutMapInlineStridedComputed256)
+				b.Run("n=512", benchLayoutMapInlineStridedComputed512)
+			})
+			b.Run("pattern=sequential", func(b *testing.B) {
+				runLookups(b, "sequential", lookupsLayoutMapInlineStrided)
+			})
+			b.Run("pattern=random", func(b *testing.B) {
+				runLookups(b, "uniform", lookupsLayoutMapInlineStrided)
+			})
+		})
+		b.Run("layout=sparse", func(b *testing.B) {
+			b.Run("pattern=computed", func(b *testing.B) {
+				b.Run("n=4", benchLayoutMapInlineSparseComputed4)
+				b.Run("n=8", benchLayoutMapInlineSparseComputed8)
+				b.Run("n=16", benchLayoutMapInlineSparseComputed16)
+				b.Run("n=32", benchLayoutMapInlineSparseComputed32)
+				b.Run("n=64", benchLayoutMapInlineSparseComputed64)
+				b.Run("n=128", benchLayoutMapInlineSparseComputed128)
+				b.Run("n=256", benchLayoutMapInlineSparseComputed256)
+				b.Run("n=512", benchLayoutMapInlineSparseComputed512)
+			})
+			b.Run("pattern=sequential", func(b *testing.B) {
+				runLookups(b, "sequential", lookupsLayoutMapInlineSparse)
+			})
+			b.Run("pattern=random", func(b *testing.B) {
+				runLookups(b, "uniform", lookupsLayoutMapInlineSparse)
+			})
+		})
+		b.Run("layout=clustered", func(b *testing.B) {
+			b.Run("pattern=computed", func(b *testing.B) {
+				b.Run("n=4", benchLayoutMapInlineClusteredComputed4)
+				b.Run("n=8", benchLayoutMapInlineClusteredComputed8)
+				b.Run("n=16", benchLayoutMapInlineClusteredComputed16)
+				b.Run("n=32", benchLayoutMapInlineClusteredComputed32)
+				b.Run("n=64", benchLayoutMapInlineClusteredComputed64)
+				b.Run("n=128", benchLayoutMapInlineClusteredComputed128)
+				b.Run("n=256", benchLayoutMapInlineClusteredComputed256)
+				b.Run("n=512", benchLayoutMapInlineClusteredComputed512)
+			})
+			b.Run("pattern=sequential", func(b *testing.B) {
+				runLookups(b, "sequential", lookupsLayoutMapInlineClustered)
+			})
+			b.Run("pattern=random", func(b *testing.B) {
+				runLookups(b, "uniform", lookupsLayoutMapInlineClustered)
+			})
+		})
+	})
+	b.Run("inline=false", func(b *testing.B) {
+		b.Run("layout=dense", func(b *testing.B) {
+			b.Run("pattern=computed", func(b *testing.B) {
+				b.Run("n=4", benchLayoutMapNoInlineDenseComputed4)
+				b.Run("n=8", benchLayoutMapNoInlineDenseComputed8)
+				b.Run("n=16", benchLayoutMapNoInlineDenseComputed16)
+				b.Run("n=32", benchLayoutMapNoInlineDenseComputed32)
+				b.Run("n=64", benchLayoutMapNoInlineDenseComputed64)
+				b.Run("n=128", benchLayoutMapNoInlineDenseComputed128)
+				b.Run("n=256", benchLayoutMapNoInlineDenseComputed256)
+				b.Run("n=512", benchLayoutMapNoInlineDenseComputed512)
+			})
+			b.Run("pattern=sequential", func(b *testing.B) {
+				runLookups(b, "sequential", lookupsLayoutMapNoInlineDense)
+			})
+			b.Run("pattern=random", func(b *testing.B) {
+				runLookups(b, "uniform", lookupsLayoutMapNoInlineDense)
+			})
+		})
+		b.Run("layout=strided", func(b *testing.B) {
+			b.Run("pattern=computed", func(b *testing.B) {
+				b.Run("n=4", benchLayoutMapNoInlineStridedComputed4)
+				b.Run("n=8", benchLayoutMapNoInlineStridedComputed8)
+				b.Run("n=16", benchLayoutMapNoInlineStridedComputed16)
+				b.Run("n=32", benchLayoutMapNoInlineStridedComputed32)
+				b.Run("n=64", benchLayoutMapNoInlineStridedComputed64)
+				b.Run("n=128", benchLayoutMapNoInlineStridedComputed128)
+				b.Run("n=256", benchLayoutMapNoInlineStridedComputed256)
+				b.Run("n=512", benchLayoutMapNoInlineStridedComputed512)
+			})
+			b.Run("pattern=sequential", func(b *testing.B) {
+				runLookups(b, "sequential", lookupsLayoutMapNoInlineStrided)
+			})
+			b.Run("pattern=random", func(b *testing.B) {
+				runLookups(b, "uniform", lookupsLayoutMapNoInlineStrided)
+			})
+		})
+		b.Run("layout=sparse", func(b *testing.B) {
+			b.Run("pattern=computed", func(b *testing.B) {
+				b.Run("n=4", benchLayoutMapNoInlineSparseComputed4)
+				b.Run("n=8", benchLayoutMapNoInlineSparseComputed8)
+				b.Run("n=16", benchLayoutMapNoInlineSparseComputed16)
+				b.Run("n=32", benchLayoutMapNoInlineSparseComputed32)
+				b.Run("n=64", benchLayoutMapNoInlineSparseComputed64)
+				b.Run("n=128", benchLayoutMapNoInlineSparseComputed128)
+				b.Run("n=256", benchLayoutMapNoInlineSparseComputed256)
+				b.Run("n=512", benchLayoutMapNoInlineSparseComputed512)
+			})
+			b.Run("pattern=sequential", func(b *testing.B) {
+				runLookups(b, "sequential", lookupsLayoutMapNoInlineSparse)
+			})
+			b.Run("pattern=random", func(b *testing.B) {
+				runLookups(b, "uniform", lookupsLayoutMapNoInlineSparse)
+			})
+		})
+		b.Run("layout=clustered", func(b *testing.B) {
+			b.Run("pattern=computed", func(b *testing.B) {
+				b.Run("n=4", benchLayoutMapNoInlineClusteredComputed4)
+				b.Run("n=8", benchLayoutMapNoInlineClusteredComputed8)
+				b.Run("n=16", benchLayoutMapNoInlineClusteredComputed16)
+				b.Run("n=32", benchLayoutMapNoInlineClusteredComputed32)
+				b.Run("n=64", benchLayoutMapNoInlineClusteredComputed64)
+				b.Run("n=128", benchLayoutMapNoInlineClusteredComputed128)
+				b.Run("n=256", benchLayoutMapNoInlineClusteredComputed256)
+				b.Run("n=512", benchLayoutMapNoInlineClusteredComputed512)
+			})
+			b.Run("pattern=sequential", func(b *testing.B) {
+				runLookups(b, "sequential", lookupsLayoutMapNoInlineClustered)
+			})
+			b.Run("pattern=random", func(b *testing.B) {
+				runLookups(b, "uniform", lookupsLayoutMapNoInlineClustered)
+			})
+		})
+	})
+}
+
+func benchLayoutMapInlineDenseComputed4(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		n += InlineLayoutMapDenseN4[LayoutKeysDense[i%4]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapInlineDenseLookupMod4(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		n += InlineLayoutMapDenseN4[LayoutKeysDense[inputs[i%len(inputs)]]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapInlineDenseComputed8(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		n += InlineLayoutMapDenseN8[LayoutKeysDense[i%8]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapInlineDenseLookupMod8(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		n += InlineLayoutMapDenseN8[LayoutKeysDense[inputs[i%len(inputs)]]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapInlineDenseComputed16(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		n += InlineLayoutMapDenseN16[LayoutKeysDense[i%16]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapInlineDenseLookupMod16(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		n += InlineLayoutMapDenseN16[LayoutKeysDense[inputs[i%len(inputs)]]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapInlineDenseComputed32(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		n += InlineLayoutMapDenseN32[LayoutKeysDense[i%32]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapInlineDenseLookupMod32(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		n += InlineLayoutMapDenseN32[LayoutKeysDense[inputs[i%len(inputs)]]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapInlineDenseComputed64(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		n += InlineLayoutMapDenseN64[LayoutKeysDense[i%64]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapInlineDenseLookupMod64(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		n += InlineLayoutMapDenseN64[LayoutKeysDense[inputs[i%len(inputs)]]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapInlineDenseComputed128(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		n += InlineLayoutMapDenseN128[LayoutKeysDense[i%128]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapInlineDenseLookupMod128(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		n += InlineLayoutMapDenseN128[LayoutKeysDense[inputs[i%len(inputs)]]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapInlineDenseComputed256(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		n += InlineLayoutMapDenseN256[LayoutKeysDense[i%256]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapInlineDenseLookupMod256(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		n += InlineLayoutMapDenseN256[LayoutKeysDense[inputs[i%len(inputs)]]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapInlineDenseComputed512(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		n += InlineLayoutMapDenseN512[LayoutKeysDense[i%512]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapInlineDenseLookupMod512(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		n += InlineLayoutMapDenseN512[LayoutKeysDense[inputs[i%len(inputs)]]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapInlineStridedComputed4(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		n += InlineLayoutMapStridedN4[LayoutKeysStrided[i%4]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapInlineStridedLookupMod4(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		n += InlineLayoutMapStridedN4[LayoutKeysStrided[inputs[i%len(inputs)]]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapInlineStridedComputed8(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		n += InlineLayoutMapStridedN8[LayoutKeysStrided[i%8]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapInlineStridedLookupMod8(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		n += InlineLayoutMapStridedN8[LayoutKeysStrided[inputs[i%len(inputs)]]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapInlineStridedComputed16(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		n += InlineLayoutMapStridedN16[LayoutKeysStrided[i%16]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapInlineStridedLookupMod16(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		n += InlineLayoutMapStridedN16[LayoutKeysStrided[inputs[i%len(inputs)]]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapInlineStridedComputed32(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		n += InlineLayoutMapStridedN32[LayoutKeysStrided[i%32]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapInlineStridedLookupMod32(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		n += InlineLayoutMapStridedN32[LayoutKeysStrided[inputs[i%len(inputs)]]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapInlineStridedComputed64(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		n += InlineLayoutMapStridedN64[LayoutKeysStrided[i%64]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapInlineStridedLookupMod64(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		n += InlineLayoutMapStridedN64[LayoutKeysStrided[inputs[i%len(inputs)]]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapInlineStridedComputed128(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		n += InlineLayoutMapStridedN128[LayoutKeysStrided[i%128]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapInlineStridedLookupMod128(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		n += InlineLayoutMapStridedN128[LayoutKeysStrided[inputs[i%len(inputs)]]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapInlineStridedComputed256(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		n += InlineLayoutMapStridedN256[LayoutKeysStrided[i%256]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapInlineStridedLookupMod256(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		n += InlineLayoutMapStridedN256[LayoutKeysStrided[inputs[i%len(inputs)]]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapInlineStridedComputed512(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		n += InlineLayoutMapStridedN512[LayoutKeysStrided[i%512]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapInlineStridedLookupMod512(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		n += InlineLayoutMapStridedN512[LayoutKeysStrided[inputs[i%len(inputs)]]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapInlineSparseComputed4(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		n += InlineLayoutMapSparseN4[LayoutKeysSparse[i%4]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapInlineSparseLookupMod4(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		n += InlineLayoutMapSparseN4[LayoutKeysSparse[inputs[i%len(inputs)]]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapInlineSparseComputed8(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		n += InlineLayoutMapSparseN8[LayoutKeysSparse[i%8]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapInlineSparseLookupMod8(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		n += InlineLayoutMapSparseN8[LayoutKeysSparse[inputs[i%len(inputs)]]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapInlineSparseComputed16(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		n += InlineLayoutMapSparseN16[LayoutKeysSparse[i%16]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapInlineSparseLookupMod16(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		n += InlineLayoutMapSparseN16[LayoutKeysSparse[inputs[i%len(inputs)]]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapInlineSparseComputed32(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		n += InlineLayoutMapSparseN32[LayoutKeysSparse[i%32]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapInlineSparseLookupMod32(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		n += InlineLayoutMapSparseN32[LayoutKeysSparse[inputs[i%len(inputs)]]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapInlineSparseComputed64(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		n += InlineLayoutMapSparseN64[LayoutKeysSparse[i%64]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapInlineSparseLookupMod64(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		n += InlineLayoutMapSparseN64[LayoutKeysSparse[inputs[i%len(inputs)]]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapInlineSparseComputed128(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		n += InlineLayoutMapSparseN128[LayoutKeysSparse[i%128]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapInlineSparseLookupMod128(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		n += InlineLayoutMapSparseN128[LayoutKeysSparse[inputs[i%len(inputs)]]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapInlineSparseComputed256(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		n += InlineLayoutMapSparseN256[LayoutKeysSparse[i%256]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapInlineSparseLookupMod256(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		n += InlineLayoutMapSparseN256[LayoutKeysSparse[inputs[i%len(inputs)]]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapInlineSparseComputed512(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		n += InlineLayoutMapSparseN512[LayoutKeysSparse[i%512]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapInlineSparseLookupMod512(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		n += InlineLayoutMapSparseN512[LayoutKeysSparse[inputs[i%len(inputs)]]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapInlineClusteredComputed4(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		n += InlineLayoutMapClusteredN4[LayoutKeysClustered[i%4]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapInlineClusteredLookupMod4(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		n += InlineLayoutMapClusteredN4[LayoutKeysClustered[inputs[i%len(inputs)]]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapInlineClusteredComputed8(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		n += InlineLayoutMapClusteredN8[LayoutKeysClustered[i%8]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapInlineClusteredLookupMod8(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		n += InlineLayoutMapClusteredN8[LayoutKeysClustered[inputs[i%len(inputs)]]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapInlineClusteredComputed16(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		n += InlineLayoutMapClusteredN16[LayoutKeysClustered[i%16]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapInlineClusteredLookupMod16(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		n += InlineLayoutMapClusteredN16[LayoutKeysClustered[inputs[i%len(inputs)]]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapInlineClusteredComputed32(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		n += InlineLayoutMapClusteredN32[LayoutKeysClustered[i%32]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapInlineClusteredLookupMod32(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		n += InlineLayoutMapClusteredN32[LayoutKeysClustered[inputs[i%len(inputs)]]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapInlineClusteredComputed64(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		n += InlineLayoutMapClusteredN64[LayoutKeysClustered[i%64]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapInlineClusteredLookupMod64(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		n += InlineLayoutMapClusteredN64[LayoutKeysClustered[inputs[i%len(inputs)]]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapInlineClusteredComputed128(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		n += InlineLayoutMapClusteredN128[LayoutKeysClustered[i%128]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapInlineClusteredLookupMod128(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		n += InlineLayoutMapClusteredN128[LayoutKeysClustered[inputs[i%len(inputs)]]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapInlineClusteredComputed256(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		n += InlineLayoutMapClusteredN256[LayoutKeysClustered[i%256]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapInlineClusteredLookupMod256(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		n += InlineLayoutMapClusteredN256[LayoutKeysClustered[inputs[i%len(inputs)]]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapInlineClusteredComputed512(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		n += InlineLayoutMapClusteredN512[LayoutKeysClustered[i%512]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapInlineClusteredLookupMod512(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		n += InlineLayoutMapClusteredN512[LayoutKeysClustered[inputs[i%len(inputs)]]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapNoInlineDenseComputed4(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		n += NoInlineLayoutMapDenseN4[LayoutKeysDense[i%4]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapNoInlineDenseLookupMod4(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		n += NoInlineLayoutMapDenseN4[LayoutKeysDense[inputs[i%len(inputs)]]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapNoInlineDenseComputed8(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		n += NoInlineLayoutMapDenseN8[LayoutKeysDense[i%8]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapNoInlineDenseLookupMod8(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		n += NoInlineLayoutMapDenseN8[LayoutKeysDense[inputs[i%len(inputs)]]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapNoInlineDenseComputed16(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		n += NoInlineLayoutMapDenseN16[LayoutKeysDense[i%16]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapNoInlineDenseLookupMod16(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		n += NoInlineLayoutMapDenseN16[LayoutKeysDense[inputs[i%len(inputs)]]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapNoInlineDenseComputed32(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		n += NoInlineLayoutMapDenseN32[LayoutKeysDense[i%32]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapNoInlineDenseLookupMod32(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		n += NoInlineLayoutMapDenseN32[LayoutKeysDense[inputs[i%len(inputs)]]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapNoInlineDenseComputed64(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		n += NoInlineLayoutMapDenseN64[LayoutKeysDense[i%64]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapNoInlineDenseLookupMod64(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		n += NoInlineLayoutMapDenseN64[LayoutKeysDense[inputs[i%len(inputs)]]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapNoInlineDenseComputed128(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		n += NoInlineLayoutMapDenseN128[LayoutKeysDense[i%128]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapNoInlineDenseLookupMod128(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		n += NoInlineLayoutMapDenseN128[LayoutKeysDense[inputs[i%len(inputs)]]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapNoInlineDenseComputed256(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		n += NoInlineLayoutMapDenseN256[LayoutKeysDense[i%256]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapNoInlineDenseLookupMod256(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		n += NoInlineLayoutMapDenseN256[LayoutKeysDense[inputs[i%len(inputs)]]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapNoInlineDenseComputed512(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		n += NoInlineLayoutMapDenseN512[LayoutKeysDense[i%512]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapNoInlineDenseLookupMod512(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		n += NoInlineLayoutMapDenseN512[LayoutKeysDense[inputs[i%len(inputs)]]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapNoInlineStridedComputed4(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		n += NoInlineLayoutMapStridedN4[LayoutKeysStrided[i%4]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapNoInlineStridedLookupMod4(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		n += NoInlineLayoutMapStridedN4[LayoutKeysStrided[inputs[i%len(inputs)]]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapNoInlineStridedComputed8(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		n += NoInlineLayoutMapStridedN8[LayoutKeysStrided[i%8]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapNoInlineStridedLookupMod8(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		n += NoInlineLayoutMapStridedN8[LayoutKeysStrided[inputs[i%len(inputs)]]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapNoInlineStridedComputed16(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		n += NoInlineLayoutMapStridedN16[LayoutKeysStrided[i%16]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapNoInlineStridedLookupMod16(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		n += NoInlineLayoutMapStridedN16[LayoutKeysStrided[inputs[i%len(inputs)]]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapNoInlineStridedComputed32(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		n += NoInlineLayoutMapStridedN32[LayoutKeysStrided[i%32]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapNoInlineStridedLookupMod32(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		n += NoInlineLayoutMapStridedN32[LayoutKeysStrided[inputs[i%len(inputs)]]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapNoInlineStridedComputed64(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		n += NoInlineLayoutMapStridedN64[LayoutKeysStrided[i%64]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapNoInlineStridedLookupMod64(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		n += NoInlineLayoutMapStridedN64[LayoutKeysStrided[inputs[i%len(inputs)]]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapNoInlineStridedComputed128(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		n += NoInlineLayoutMapStridedN128[LayoutKeysStrided[i%128]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapNoInlineStridedLookupMod128(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		n += NoInlineLayoutMapStridedN128[LayoutKeysStrided[inputs[i%len(inputs)]]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapNoInlineStridedComputed256(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		n += NoInlineLayoutMapStridedN256[LayoutKeysStrided[i%256]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapNoInlineStridedLookupMod256(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		n += NoInlineLayoutMapStridedN256[LayoutKeysStrided[inputs[i%len(inputs)]]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapNoInlineStridedComputed512(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		n += NoInlineLayoutMapStridedN512[LayoutKeysStrided[i%512]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapNoInlineStridedLookupMod512(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		n += NoInlineLayoutMapStridedN512[LayoutKeysStrided[inputs[i%len(inputs)]]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapNoInlineSparseComputed4(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		n += NoInlineLayoutMapSparseN4[LayoutKeysSparse[i%4]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapNoInlineSparseLookupMod4(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		n += NoInlineLayoutMapSparseN4[LayoutKeysSparse[inputs[i%len(inputs)]]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapNoInlineSparseComputed8(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		n += NoInlineLayoutMapSparseN8[LayoutKeysSparse[i%8]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapNoInlineSparseLookupMod8(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		n += NoInlineLayoutMapSparseN8[LayoutKeysSparse[inputs[i%len(inputs)]]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapNoInlineSparseComputed16(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		n += NoInlineLayoutMapSparseN16[LayoutKeysSparse[i%16]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapNoInlineSparseLookupMod16(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		n += NoInlineLayoutMapSparseN16[LayoutKeysSparse[inputs[i%len(inputs)]]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapNoInlineSparseComputed32(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		n += NoInlineLayoutMapSparseN32[LayoutKeysSparse[i%32]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapNoInlineSparseLookupMod32(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		n += NoInlineLayoutMapSparseN32[LayoutKeysSparse[inputs[i%len(inputs)]]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapNoInlineSparseComputed64(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		n += NoInlineLayoutMapSparseN64[LayoutKeysSparse[i%64]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapNoInlineSparseLookupMod64(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		n += NoInlineLayoutMapSparseN64[LayoutKeysSparse[inputs[i%len(inputs)]]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapNoInlineSparseComputed128(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		n += NoInlineLayoutMapSparseN128[LayoutKeysSparse[i%128]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapNoInlineSparseLookupMod128(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		n += NoInlineLayoutMapSparseN128[LayoutKeysSparse[inputs[i%len(inputs)]]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapNoInlineSparseComputed256(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		n += NoInlineLayoutMapSparseN256[LayoutKeysSparse[i%256]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapNoInlineSparseLookupMod256(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		n += NoInlineLayoutMapSparseN256[LayoutKeysSparse[inputs[i%len(inputs)]]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapNoInlineSparseComputed512(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		n += NoInlineLayoutMapSparseN512[LayoutKeysSparse[i%512]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapNoInlineSparseLookupMod512(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		n += NoInlineLayoutMapSparseN512[LayoutKeysSparse[inputs[i%len(inputs)]]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapNoInlineClusteredComputed4(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		n += NoInlineLayoutMapClusteredN4[LayoutKeysClustered[i%4]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapNoInlineClusteredLookupMod4(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		n += NoInlineLayoutMapClusteredN4[LayoutKeysClustered[inputs[i%len(inputs)]]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapNoInlineClusteredComputed8(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		n += NoInlineLayoutMapClusteredN8[LayoutKeysClustered[i%8]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapNoInlineClusteredLookupMod8(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		n += NoInlineLayoutMapClusteredN8[LayoutKeysClustered[inputs[i%len(inputs)]]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapNoInlineClusteredComputed16(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		n += NoInlineLayoutMapClusteredN16[LayoutKeysClustered[i%16]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapNoInlineClusteredLookupMod16(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		n += NoInlineLayoutMapClusteredN16[LayoutKeysClustered[inputs[i%len(inputs)]]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapNoInlineClusteredComputed32(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		n += NoInlineLayoutMapClusteredN32[LayoutKeysClustered[i%32]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapNoInlineClusteredLookupMod32(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		n += NoInlineLayoutMapClusteredN32[LayoutKeysClustered[inputs[i%len(inputs)]]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapNoInlineClusteredComputed64(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		n += NoInlineLayoutMapClusteredN64[LayoutKeysClustered[i%64]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapNoInlineClusteredLookupMod64(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		n += NoInlineLayoutMapClusteredN64[LayoutKeysClustered[inputs[i%len(inputs)]]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapNoInlineClusteredComputed128(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		n += NoInlineLayoutMapClusteredN128[LayoutKeysClustered[i%128]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapNoInlineClusteredLookupMod128(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		n += NoInlineLayoutMapClusteredN128[LayoutKeysClustered[inputs[i%len(inputs)]]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapNoInlineClusteredComputed256(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		n += NoInlineLayoutMapClusteredN256[LayoutKeysClustered[i%256]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapNoInlineClusteredLookupMod256(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		n += NoInlineLayoutMapClusteredN256[LayoutKeysClustered[inputs[i%len(inputs)]]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapNoInlineClusteredComputed512(b *testing.B) {
+	var n int
+
+	for i := 0; i < b.N; i++ {
+		n += NoInlineLayoutMapClusteredN512[LayoutKeysClustered[i%512]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
+
+func benchLayoutMapNoInlineClusteredLookupMod512(b *testing.B, inputs []int) {
+	var n int
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		n += NoInlineLayoutMapClusteredN512[LayoutKeysClustered[inputs[i%len(inputs)]]](i)
+	}
+
+	// n will never be < 0, but checking n should ensure that the entire benchmark loop can't be optimized away.
+	if n < 0 {
+		b.Fatal("can't happen")
+	}
+}
@@ -0,0 +1,1968 @@
+// Code generated by genbench from matrix.json. DO NOT EDIT.
+
+package go_map_vs_switch
+
+import (
+	"sort"
+)
+
+// StringTable maps sorted string keys to functions by binary search.
+type StringTable struct {
+	Keys  []string
+	Funcs []func(int) int
+}
+
+// Lookup returns the function for key, which must be one of t.Keys.
+func (t *StringTable) Lookup(key string) func(int) int {
+	return t.Funcs[sort.Search(len(t.Keys), func(i int) bool { return t.Keys[i] >= key })]
+}
+
+// newStringTable returns a table of keys and funcs, where funcs[i] is the
+// function for keys[i].
+func newStringTable(keys []string, funcs []func(int) int) StringTable {
+	t := StringTable{Keys: append([]string(nil), keys...), Funcs: make([]func(int) int, len(keys))}
+	sort.Strings(t.Keys)
+	for i, k := range keys {
+		t.Funcs[sort.SearchStrings(t.Keys, k)] = funcs[i]
+	}
+	return t
+}
+
+// StringKeys8P0 are the keys of each branch, 8 bytes long with a shared
+// prefix of 0 bytes.
+var StringKeys8P0 = []string{
+	"aacdefgh",
+	"badefghi",
+	"caefghij",
+	"dafghijk",
+	"eaghijkl",
+	"fahijklm",
+	"gaijklmn",
+	"hajklmno",
+	"iaklmnop",
+	"jalmnopq",
+	"kamnopqr",
+	"lanopqrs",
+	"maopqrst",
+	"napqrstu",
+	"oaqrstuv",
+	"parstuvw",
+	"qastuvwx",
+	"ratuvwxy",
+	"sauvwxyz",
+	"tavwxyza",
+	"uawxyzab",
+	"vaxyzabc",
+	"wayzabcd",
+	"xazabcde",
+	"yaabcdef",
+	"zabcdefg",
+	"abcdefgh",
+	"bbdefghi",
+	"cbefghij",
+	"dbfghijk",
+	"ebghijkl",
+	"fbhijklm",
+	"gbijklmn",
+	"hbjklmno",
+	"ibklmnop",
+	"jblmnopq",
+	"kbmnopqr",
+	"lbnopqrs",
+	"mbopqrst",
+	"nbpqrstu",
+	"obqrstuv",
+	"pbrstuvw",
+	"qbstuvwx",
+	"rbtuvwxy",
+	"sbuvwxyz",
+	"tbvwxyza",
+	"ubwxyzab",
+	"vbxyzabc",
+	"wbyzabcd",
+	"xbzabcde",
+	"ybabcdef",
+	"zbbcdefg",
+	"accdefgh",
+	"bcdefghi",
+	"ccefghij",
+	"dcfghijk",
+	"ecghijkl",
+	"fchijklm",
+	"gcijklmn",
+	"hcjklmno",
+	"icklmnop",
+	"jclmnopq",
+	"kcmnopqr",
+	"lcnopqrs",
+	"mcopqrst",
+	"ncpqrstu",
+	"ocqrstuv",
+	"pcrstuvw",
+	"qcstuvwx",
+	"rctuvwxy",
+	"scuvwxyz",
+	"tcvwxyza",
+	"ucwxyzab",
+	"vcxyzabc",
+	"wcyzabcd",
+	"xczabcde",
+	"ycabcdef",
+	"zcbcdefg",
+	"adcdefgh",
+	"bddefghi",
+	"cdefghij",
+	"ddfghijk",
+	"edghijkl",
+	"fdhijklm",
+	"gdijklmn",
+	"hdjklmno",
+	"idklmnop",
+	"jdlmnopq",
+	"kdmnopqr",
+	"ldnopqrs",
+	"mdopqrst",
+	"ndpqrstu",
+	"odqrstuv",
+	"pdrstuvw",
+	"qdstuvwx",
+	"rdtuvwxy",
+	"sduvwxyz",
+	"tdvwxyza",
+	"udwxyzab",
+	"vdxyzabc",
+	"wdyzabcd",
+	"xdzabcde",
+	"ydabcdef",
+	"zdbcdefg",
+	"aecdefgh",
+	"bedefghi",
+	"ceefghij",
+	"defghijk",
+	"eeghijkl",
+	"fehijklm",
+	"geijklmn",
+	"hejklmno",
+	"ieklmnop",
+	"jelmnopq",
+	"kemnopqr",
+	"lenopqrs",
+	"meopqrst",
+	"nepqrstu",
+	"oeqrstuv",
+	"perstuvw",
+	"qestuvwx",
+	"retuvwxy",
+	"seuvwxyz",
+	"tevwxyza",
+	"uewxyzab",
+	"vexyzabc",
+	"weyzabcd",
+	"xezabcde",
+	"yeabcdef",
+	"zebcdefg",
+	"afcdefgh",
+	"bfdefghi",
+	"cfefghij",
+	"dffghijk",
+	"efghijkl",
+	"ffhijklm",
+	"gfijklmn",
+	"hfjklmno",
+	"ifklmnop",
+	"jflmnopq",
+	"kfmnopqr",
+	"lfnopqrs",
+	"mfopqrst",
+	"nfpqrstu",
+	"ofqrstuv",
+	"pfrstuvw",
+	"qfstuvwx",
+	"rftuvwxy",
+	"sfuvwxyz",
+	"tfvwxyza",
+	"ufwxyzab",
+	"vfxyzabc",
+	"wfyzabcd",
+	"xfzabcde",
+	"yfabcdef",
+	"zfbcdefg",
+	"agcdefgh",
+	"bgdefghi",
+	"cgefghij",
+	"dgfghijk",
+	"egghijkl",
+	"fghijklm",
+	"ggijklmn",
+	"hgjklmno",
+	"igklmnop",
+	"jglmnopq",
+	"kgmnopqr",
+	"lgnopqrs",
+	"mgopqrst",
+	"ngpqrstu",
+	"ogqrstuv",
+	"pgrstuvw",
+	"qgstuvwx",
+	"rgtuvwxy",
+	"sguvwxyz",
+	"tgvwxyza",
+	"ugwxyzab",
+	"vgxyzabc",
+	"wgyzabcd",
+	"xgzabcde",
+	"ygabcdef",
+	"zgbcdefg",
+	"ahcdefgh",
+	"bhdefghi",
+	"chefghij",
+	"dhfghijk",
+	"ehghijkl",
+	"fhhijklm",
+	"ghijklmn",
+	"hhjklmno",
+	"ihklmnop",
+	"jhlmnopq",
+	"khmnopqr",
+	"lhnopqrs",
+	"mhopqrst",
+	"nhpqrstu",
+	"ohqrstuv",
+	"phrstuvw",
+	"qhstuvwx",
+	"rhtuvwxy",
+	"shuvwxyz",
+	"thvwxyza",
+	"uhwxyzab",
+	"vhxyzabc",
+	"whyzabcd",
+	"xhzabcde",
+	"yhabcdef",
+	"zhbcdefg",
+	"aicdefgh",
+	"bidefghi",
+	"ciefghij",
+	"difghijk",
+	"eighijkl",
+	"fihijklm",
+	"giijklmn",
+	"hijklmno",
+	"iiklmnop",
+	"jilmnopq",
+	"kimnopqr",
+	"linopqrs",
+	"miopqrst",
+	"nipqrstu",
+	"oiqrstuv",
+	"pirstuvw",
+	"qistuvwx",
+	"rituvwxy",
+	"siuvwxyz",
+	"tivwxyza",
+	"uiwxyzab",
+	"vixyzabc",
+	"wiyzabcd",
+	"xizabcde",
+	"yiabcdef",
+	"zibcdefg",
+	"ajcdefgh",
+	"bjdefghi",
+	"cjefghij",
+	"djfghijk",
+	"ejghijkl",
+	"fjhijklm",
+	"gjijklmn",
+	"hjjklmno",
+	"ijklmnop",
+	"jjlmnopq",
+	"kjmnopqr",
+	"ljnopqrs",
+	"mjopqrst",
+	"njpqrstu",
+	"ojqrstuv",
+	"pjrstuvw",
+	"qjstuvwx",
+	"rjtuvwxy",
+	"sjuvwxyz",
+	"tjvwxyza",
+	"ujwxyzab",
+	"vjxyzabc",
+	"wjyzabcd",
+	"xjzabcde",
+	"yjabcdef",
+	"zjbcdefg",
+	"akcdefgh",
+	"bkdefghi",
+	"ckefghij",
+	"dkfghijk",
+	"ekghijkl",
+	"fkhijklm",
+	"gkijklmn",
+	"hkjklmno",
+	"ikklmnop",
+	"jklmnopq",
+	"kkmnopqr",
+	"lknopqrs",
+	"mkopqrst",
+	"nkpqrstu",
+	"okqrstuv",
+	"pkrstuvw",
+	"qkstuvwx",
+	"rktuvwxy",
+	"skuvwxyz",
+	"tkvwxyza",
+	"ukwxyzab",
+	"vkxyzabc",
+	"wkyzabcd",
+	"xkzabcde",
+	"ykabcdef",
+	"zkbcdefg",
+	"alcdefgh",
+	"bldefghi",
+	"clefghij",
+	"dlfghijk",
+	"elghijkl",
+	"flhijklm",
+	"glijklmn",
+	"hljklmno",
+	"ilklmnop",
+	"jllmnopq",
+	"klmnopqr",
+	"llnopqrs",
+	"mlopqrst",
+	"nlpqrstu",
+	"olqrstuv",
+	"plrstuvw",
+	"qlstuvwx",
+	"rltuvwxy",
+	"sluvwxyz",
+	"tlvwxyza",
+	"ulwxyzab",
+	"vlxyzabc",
+	"wlyzabcd",
+	"xlzabcde",
+	"ylabcdef",
+	"zlbcdefg",
+	"amcdefgh",
+	"bmdefghi",
+	"cmefghij",
+	"dmfghijk",
+	"emghijkl",
+	"fmhijklm",
+	"gmijklmn",
+	"hmjklmno",
+	"imklmnop",
+	"jmlmnopq",
+	"kmmnopqr",
+	"lmnopqrs",
+	"mmopqrst",
+	"nmpqrstu",
+	"omqrstuv",
+	"pmrstuvw",
+	"qmstuvwx",
+	"rmtuvwxy",
+	"smuvwxyz",
+	"tmvwxyza",
+	"umwxyzab",
+	"vmxyzabc",
+	"wmyzabcd",
+	"xmzabcde",
+	"ymabcdef",
+	"zmbcdefg",
+	"ancdefgh",
+	"bndefghi",
+	"cnefghij",
+	"dnfghijk",
+	"enghijkl",
+	"fnhijklm",
+	"gnijklmn",
+	"hnjklmno",
+	"inklmnop",
+	"jnlmnopq",
+	"knmnopqr",
+	"lnnopqrs",
+	"mnopqrst",
+	"nnpqrstu",
+	"onqrstuv",
+	"pnrstuvw",
+	"qnstuvwx",
+	"rntuvwxy",
+	"snuvwxyz",
+	"tnvwxyza",
+	"unwxyzab",
+	"vnxyzabc",
+	"wnyzabcd",
+	"xnzabcde",
+	"ynabcdef",
+	"znbcdefg",
+	"aocdefgh",
+	"bodefghi",
+	"coefghij",
+	"dofghijk",
+	"eoghijkl",
+	"fohijklm",
+	"goijklmn",
+	"hojklmno",
+	"ioklmnop",
+	"jolmnopq",
+	"komnopqr",
+	"lonopqrs",
+	"moopqrst",
+	"nopqrstu",
+	"ooqrstuv",
+	"porstuvw",
+	"qostuvwx",
+	"rotuvwxy",
+	"souvwxyz",
+	"tovwxyza",
+	"uowxyzab",
+	"voxyzabc",
+	"woyzabcd",
+	"xozabcde",
+	"yoabcdef",
+	"zobcdefg",
+	"apcdefgh",
+	"bpdefghi",
+	"cpefghij",
+	"dpfghijk",
+	"epghijkl",
+	"fphijklm",
+	"gpijklmn",
+	"hpjklmno",
+	"ipklmnop",
+	"jplmnopq",
+	"kpmnopqr",
+	"lpnopqrs",
+	"mpopqrst",
+	"nppqrstu",
+	"opqrstuv",
+	"pprstuvw",
+	"qpstuvwx",
+	"rptuvwxy",
+	"spuvwxyz",
+	"tpvwxyza",
+	"upwxyzab",
+	"vpxyzabc",
+	"wpyzabcd",
+	"xpzabcde",
+	"ypabcdef",
+	"zpbcdefg",
+	"aqcdefgh",
+	"bqdefghi",
+	"cqefghij",
+	"dqfghijk",
+	"eqghijkl",
+	"fqhijklm",
+	"gqijklmn",
+	"hqjklmno",
+	"iqklmnop",
+	"jqlmnopq",
+	"kqmnopqr",
+	"lqnopqrs",
+	"mqopqrst",
+	"nqpqrstu",
+	"oqqrstuv",
+	"pqrstuvw",
+	"qqstuvwx",
+	"rqtuvwxy",
+	"squvwxyz",
+	"tqvwxyza",
+	"uqwxyzab",
+	"vqxyzabc",
+	"wqyzabcd",
+	"xqzabcde",
+	"yqabcdef",
+	"zqbcdefg",
+	"arcdefgh",
+	"brdefghi",
+	"crefghij",
+	"drfghijk",
+	"erghijkl",
+	"frhijklm",
+	"grijklmn",
+	"hrjklmno",
+	"irklmnop",
+	"jrlmnopq",
+	"krmnopqr",
+	"lrnopqrs",
+	"mropqrst",
+	"nrpqrstu",
+	"orqrstuv",
+	"prrstuvw",
+	"qrstuvwx",
+	"rrtuvwxy",
+	"sruvwxyz",
+	"trvwxyza",
+	"urwxyzab",
+	"vrxyzabc",
+	"wryzabcd",
+	"xrzabcde",
+	"yrabcdef",
+	"zrbcdefg",
+	"ascdefgh",
+	"bsdefghi",
+	"csefghij",
+	"dsfghijk",
+	"esghijkl",
+	"fshijklm",
+	"gsijklmn",
+	"hsjklmno",
+	"isklmnop",
+	"jslmnopq",
+	"ksmnopqr",
+	"lsnopqrs",
+	"msopqrst",
+	"nspqrstu",
+	"osqrstuv",
+	"psrstuvw",
+	"qsstuvwx",
+	"rstuvwxy",
+	"ssuvwxyz",
+	"tsvwxyza",
+	"uswxyzab",
+	"vsxyzabc",
+	"wsyzabcd",
+	"xszabcde",
+	"ysabcdef",
+	"zsbcdefg",
+	"atcdefgh",
+	"btdefghi",
+	"ctefghij",
+	"dtfghijk",
+	"etghijkl",
+	"fthijklm",
+	"gtijklmn",
+	"htjklmno",
+	"itklmnop",
+	"jtlmnopq",
+	"ktmnopqr",
+	"ltnopqrs",
+	"mtopqrst",
+	"ntpqrstu",
+	"otqrstuv",
+	"ptrstuvw",
+	"qtstuvwx",
+	"rttuvwxy",
+}
+
+// StringKeys32P0 are the keys of each branch, 32 bytes long with a shared
+// prefix of 0 bytes.
+var StringKeys32P0 = []string{
+	"aacdefghijklmnopqrstuvwxyzabcdef",
+	"badefghijklmnopqrstuvwxyzabcdefg",
+	"caefghijklmnopqrstuvwxyzabcdefgh",
+	"dafghijklmnopqrstuvwxyzabcdefghi",
+	"eaghijklmnopqrstuvwxyzabcdefghij",
+	"fahijklmnopqrstuvwxyzabcdefghijk",
+	"gaijklmnopqrstuvwxyzabcdefghijkl",
+	"hajklmnopqrstuvwxyzabcdefghijklm",
+	"iaklmnopqrstuvwxyzabcdefghijklmn",
+	"jalmnopqrstuvwxyzabcdefghijklmno",
+	"kamnopqrstuvwxyzabcdefghijklmnop",
+	"lanopqrstuvwxyzabcdefghijklmnopq",
+	"maopqrstuvwxyzabcdefghijklmnopqr",
+	"napqrstuvwxyzabcdefghijklmnopqrs",
+	"oaqrstuvwxyzabcdefghijklmnopqrst",
+	"parstuvwxyzabcdefghijklmnopqrstu",
+	"qastuvwxyzabcdefghijklmnopqrstuv",
+	"ratuvwxyzabcdefghijklmnopqrstuvw",
+	"sauvwxyzabcdefghijklmnopqrstuvwx",
+	"tavwxyzabcdefghijklmnopqrstuvwxy",
+	"uawxyzabcdefghijklmnopqrstuvwxyz",
+	"vaxyzabcdefghijklmnopqrstuvwxyza",
+	"wayzabcdefghijklmnopqrstuvwxyzab",
+	"xazabcdefghijklmnopqrstuvwxyzabc",
+	"yaabcdefghijklmnopqrstuvwxyzabcd",
+	"zabcdefghijklmnopqrstuvwxyzabcde",
+	"abcdefghijklmnopqrstuvwxyzabcdef",
+	"bbdefghijklmnopqrstuvwxyzabcdefg",
+	"cbefghijklmnopqrstuvwxyzabcdefgh",
+	"dbfghijklmnopqrstuvwxyzabcdefghi",
+	"ebghijklmnopqrstuvwxyzabcdefghij",
+	"fbhijklmnopqrstuvwxyzabcdefghijk",
+	"gbijklmnopqrstuvwxyzabcdefghijkl",
+	"hbjklmnopqrstuvwxyzabcdefghijklm",
+	"ibklmnopqrstuvwxyzabcdefghijklmn",
+	"jblmnopqrstuvwxyzabcdefghijklmno",
+	"kbmnopqrstuvwxyzabcdefghijklmnop",
+	"lbnopqrstuvwxyzabcdefghijklmnopq",
+	"mbopqrstuvwxyzabcdefghijklmnopqr",
+	"nbpqrstuvwxyzabcdefghijklmnopqrs",
+	"obqrstuvwxyzabcdefghijklmnopqrst",
+	"pbrstuvwxyzabcdefghijklmnopqrstu",
+	"qbstuvwxyzabcdefghijklmnopqrstuv",
+	"rbtuvwxyzabcdefghijklmnopqrstuvw",
+	"sbuvwxyzabcdefghijklmnopqrstuvwx",
+	"tbvwxyzabcdefghijklmnopqrstuvwxy",
+	"ubwxyzabcdefghijklmnopqrstuvwxyz",
+	"vbxyzabcdefghijklmnopqrstuvwxyza",
+	"wbyzabcdefghijklmnopqrstuvwxyzab",
+	"xbzabcdefghijklmnopqrstuvwxyzabc",
+	"ybabcdefghijklmnopqrstuvwxyzabcd",
+	"zbbcdefghijklmnopqrstuvwxyzabcde",
+	"accdefghijklmnopqrstuvwxyzabcdef",
+	"bcdefghijklmnopqrstuvwxyzabcdefg",
+	"ccefghijklmnopqrstuvwxyzabcdefgh",
+	"dcfghijklmnopqrstuvwxyzabcdefghi",
+	"ecghijklmnopqrstuvwxyzabcdefghij",
+	"fchijklmnopqrstuvwxyzabcdefghijk",
+	"gcijklmnopqrstuvwxyzabcdefghijkl",
+	"hcjklmnopqrstuvwxyzabcdefghijklm",
+	"icklmnopqrstuvwxyzabcdefghijklmn",
+	"jclmnopqrstuvwxyzabcdefghijklmno",
+	"kcmnopqrstuvwxyzabcdefghijklmnop",
+	"lcnopqrstuvwxyzabcdefghijklmnopq",
+	"mcopqrstuvwxyzabcdefghijklmnopqr",
+	"ncpqrstuvwxyzabcdefghijklmnopqrs",
+	"ocqrstuvwxyzabcdefghijklmnopqrst",
+	"pcrstuvwxyzabcdefghijklmnopqrstu",
+	"qcstuvwxyzabcdefghijklmnopqrstuv",
+	"rctuvwxyzabcdefghijklmnopqrstuvw",
+	"scuvwxyzabcdefghijklmnopqrstuvwx",
+	"tcvwxyzabcdefghijklmnopqrstuvwxy",
+	"ucwxyzabcdefghijklmnopqrstuvwxyz",
+	"vcxyzabcdefghijklmnopqrstuvwxyza",
+	"wcyzabcdefghijklmnopqrstuvwxyzab",
+	"xczabcdefghijklmnopqrstuvwxyzabc",
+	"ycabcdefghijklmnopqrstuvwxyzabcd",
+	"zcbcdefghijklmnopqrstuvwxyzabcde",
+	"adcdefghijklmnopqrstuvwxyzabcdef",
+	"bddefghijklmnopqrstuvwxyzabcdefg",
+	"cdefghijklmnopqrstuvwxyzabcdefgh",
+	"ddfghijklmnopqrstuvwxyzabcdefghi",
+	"edghijklmnopqrstuvwxyzabcdefghij",
+	"fdhijklmnopqrstuvwxyzabcdefghijk",
+	"gdijklmnopqrstuvwxyzabcdefghijkl",
+	"hdjklmnopqrstuvwxyzabcdefghijklm",
+	"idklmnopqrstuvwxyzabcdefghijklmn",
+	"jdlmnopqrstuvwxyzabcdefghijklmno",
+	"kdmnopqrstuvwxyzabcdefghijklmnop",
+	"ldnopqrstuvwxyzabcdefghijklmnopq",
+	"mdopqrstuvwxyzabcdefghijklmnopqr",
+	"ndpqrstuvwxyzabcdefghijklmnopqrs",
+	"odqrstuvwxyzabcdefghijklmnopqrst",
+	"pdrstuvwxyzabcdefghijklmnopqrstu",
+	"qdstuvwxyzabcdefghijklmnopqrstuv",
+	"rdtuvwxyzabcdefghijklmnopqrstuvw",
+	"sduvwxyzabcdefghijklmnopqrstuvwx",
+	"tdvwxyzabcdefghijklmnopqrstuvwxy",
+	"udwxyzabcdefghijklmnopqrstuvwxyz",
+	"vdxyzabcdefghijklmnopqrstuvwxyza",
+	"wdyzabcdefghijklmnopqrstuvwxyzab",
+	"xdzabcdefghijklmnopqrstuvwxyzabc",
+	"ydabcdefghijklmnopqrstuvwxyzabcd",
+	"zdbcdefghijklmnopqrstuvwxyzabcde",
+	"aecdefghijklmnopqrstuvwxyzabcdef",
+	"bedefghijklmnopqrstuvwxyzabcdefg",
+	"ceefghijklmnopqrstuvwxyzabcdefgh",
+	"defghijklmnopqrstuvwxyzabcdefghi",
+	"eeghijklmnopqrstuvwxyzabcdefghij",
+	"fehijklmnopqrstuvwxyzabcdefghijk",
+	"geijklmnopqrstuvwxyzabcdefghijkl",
+	"hejklmnopqrstuvwxyzabcdefghijklm",
+	"ieklmnopqrstuvwxyzabcdefghijklmn",
+	"jelmnopqrstuvwxyzabcdefghijklmno",
+	"kemnopqrstuvwxyzabcdefghijklmnop",
+	"lenopqrstuvwxyzabcdefghijklmnopq",
+	"meopqrstuvwxyzabcdefghijklmnopqr",
+	"nepqrstuvwxyzabcdefghijklmnopqrs",
+	"oeqrstuvwxyzabcdefghijklmnopqrst",
+	"perstuvwxyzabcdefghijklmnopqrstu",
+	"qestuvwxyzabcdefghijklmnopqrstuv",
+	"retuvwxyzabcdefghijklmnopqrstuvw",
+	"seuvwxyzabcdefghijklmnopqrstuvwx",
+	"tevwxyzabcdefghijklmnopqrstuvwxy",
+	"uewxyzabcdefghijklmnopqrstuvwxyz",
+	"vexyzabcdefghijklmnopqrstuvwxyza",
+	"weyzabcdefghijklmnopqrstuvwxyzab",
+	"xezabcdefghijklmnopqrstuvwxyzabc",
+	"yeabcdefghijklmnopqrstuvwxyzabcd",
+	"zebcdefghijklmnopqrstuvwxyzabcde",
+	"afcdefghijklmnopqrstuvwxyzabcdef",
+	"bfdefghijklmnopqrstuvwxyzabcdefg",
+	"cfefghijklmnopqrstuvwxyzabcdefgh",
+	"dffghijklmnopqrstuvwxyzabcdefghi",
+	"efghijklmnopqrstuvwxyzabcdefghij",
+	"ffhijklmnopqrstuvwxyzabcdefghijk",
+	"gfijklmnopqrstuvwxyzabcdefghijkl",
+	"hfjklmnopqrstuvwxyzabcdefghijklm",
+	"ifklmnopqrstuvwxyzabcdefghijklmn",
+	"jflmnopqrstuvwxyzabcdefghijklmno",
+	"kfmnopqrstuvwxyzabcdefghijklmnop",
+	"lfnopqrstuvwxyzabcdefghijklmnopq",
+	"mfopqrstuvwxyzabcdefghijklmnopqr",
+	"nfpqrstuvwxyzabcdefghijklmnopqrs",
+	"ofqrstuvwxyzabcdefghijklmnopqrst",
+	"pfrstuvwxyzabcdefghijklmnopqrstu",
+	"qfstuvwxyzabcdefghijklmnopqrstuv",
+	"rftuvwxyzabcdefghijklmnopqrstuvw",
+	"sfuvwxyzabcdefghijklmnopqrstuvwx",
+	"tfvwxyzabcdefghijklmnopqrstuvwxy",
+	"ufwxyzabcdefghijklmnopqrstuvwxyz",
+	"vfxyzabcdefghijklmnopqrstuvwxyza",
+	"wfyzabcdefghijklmnopqrstuvwxyzab",
+	"xfzabcdefghijklmnopqrstuvwxyzabc",
+	"yfabcdefghijklmnopqrstuvwxyzabcd",
+	"zfbcdefghijklmnopqrstuvwxyzabcde",
+	"agcdefghijklmnopqrstuvwxyzabcdef",
+	"bgdefghijklmnopqrstuvwxyzabcdefg",
+	"cgefghijklmnopqrstuvwxyzabcdefgh",
+	"dgfghijklmnopqrstuvwxyzabcdefghi",
+	"egghijklmnopqrstuvwxyzabcdefghij",
+	"fghijklmnopqrstuvwxyzabcdefghijk",
+	"ggijklmnopqrstuvwxyzabcdefghijkl",
+	"hgjklmnopqrstuvwxyzabcdefghijklm",
+	"igklmnopqrstuvwxyzabcdefghijklmn",
+	"jglmnopqrstuvwxyzabcdefghijklmno",
+	"kgmnopqrstuvwxyzabcdefghijklmnop",
+	"lgnopqrstuvwxyzabcdefghijklmnopq",
+	"mgopqrstuvwxyzabcdefghijklmnopqr",
+	"ngpqrstuvwxyzabcdefghijklmnopqrs",
+	"ogqrstuvwxyzabcdefghijklmnopqrst",
+	"pgrstuvwxyzabcdefghijklmnopqrstu",
+	"qgstuvwxyzabcdefghijklmnopqrstuv",
+	"rgtuvwxyzabcdefghijklmnopqrstuvw",
+	"sguvwxyzabcdefghijklmnopqrstuvwx",
+	"tgvwxyzabcdefghijklmnopqrstuvwxy",
+	"ugwxyzabcdefghijklmnopqrstuvwxyz",
+	"vgxyzabcdefghijklmnopqrstuvwxyza",
+	"wgyzabcdefghijklmnopqrstuvwxyzab",
+	"xgzabcdefghijklmnopqrstuvwxyzabc",
+	"ygabcdefghijklmnopqrstuvwxyzabcd",
+	"zgbcdefghijklmnopqrstuvwxyzabcde",
+	"ahcdefghijklmnopqrstuvwxyzabcdef",
+	"bhdefghijklmnopqrstuvwxyzabcdefg",
+	"chefghijklmnopqrstuvwxyzabcdefgh",
+	"dhfghijklmnopqrstuvwxyzabcdefghi",
+	"ehghijklmnopqrstuvwxyzabcdefghij",
+	"fhhijklmnopqrstuvwxyzabcdefghijk",
+	"ghijklmnopqrstuvwxyzabcdefghijkl",
+	"hhjklmnopqrstuvwxyzabcdefghijklm",
+	"ihklmnopqrstuvwxyzabcdefghijklmn",
+	"jhlmnopqrstuvwxyzabcdefghijklmno",
+	"khmnopqrstuvwxyzabcdefghijklmnop",
+	"lhnopqrstuvwxyzabcdefghijklmnopq",
+	"mhopqrstuvwxyzabcdefghijklmnopqr",
+	"nhpqrstuvwxyzabcdefghijklmnopqrs",
+	"ohqrstuvwxyzabcdefghijklmnopqrst",
+	"phrstuvwxyzabcdefghijklmnopqrstu",
+	"qhstuvwxyzabcdefghijklmnopqrstuv",
+	"rhtuvwxyzabcdefghijklmnopqrstuvw",
+	"shuvwxyzabcdefghijklmnopqrstuvwx",
+	"thvwxyzabcdefghijklmnopqrstuvwxy",
+	"uhwxyzabcdefghijklmnopqrstuvwxyz",
+	"vhxyzabcdefghijklmnopqrstuvwxyza",
+	"whyzabcdefghijklmnopqrstuvwxyzab",
+	"xhzabcdefghijklmnopqrstuvwxyzabc",
+	"yhabcdefghijklmnopqrstuvwxyzabcd",
+	"zhbcdefghijklmnopqrstuvwxyzabcde",
+	"aicdefghijklmnopqrstuvwxyzabcdef",
+	"bidefghijklmnopqrstuvwxyzabcdefg",
+	"ciefghijklmnopqrstuvwxyzabcdefgh",
+	"difghijklmnopqrstuvwxyzabcdefghi",
+	"eighijklmnopqrstuvwxyzabcdefghij",
+	"fihijklmnopqrstuvwxyzabcdefghijk",
+	"giijklmnopqrstuvwxyzabcdefghijkl",
+	"hijklmnopqrstuvwxyzabcdefghijklm",
+	"iiklmnopqrstuvwxyzabcdefghijklmn",
+	"jilmnopqrstuvwxyzabcdefghijklmno",
+	"kimnopqrstuvwxyzabcdefghijklmnop",
+	"linopqrstuvwxyzabcdefghijklmnopq",
+	"miopqrstuvwxyzabcdefghijklmnopqr",
+	"nipqrstuvwxyzabcdefghijklmnopqrs",
+	"oiqrstuvwxyzabcdefghijklmnopqrst",
+	"pirstuvwxyzabcdefghijklmnopqrstu",
+	"qistuvwxyzabcdefghijklmnopqrstuv",
+	"rituvwxyzabcdefghijklmnopqrstuvw",
+	"siuvwxyzabcdefghijklmnopqrstuvwx",
+	"tivwxyzabcdefghijklmnopqrstuvwxy",
+	"uiwxyzabcdefghijklmnopqrstuvwxyz",
+	"vixyzabcdefghijklmnopqrstuvwxyza",
+	"wiyzabcdefghijklmnopqrstuvwxyzab",
+	"xizabcdefghijklmnopqrstuvwxyzabc",
+	"yiabcdefghijklmnopqrstuvwxyzabcd",
+	"zibcdefghijklmnopqrstuvwxyzabcde",
+	"ajcdefghijklmnopqrstuvwxyzabcdef",
+	"bjdefghijklmnopqrstuvwxyzabcdefg",
+	"cjefghijklmnopqrstuvwxyzabcdefgh",
+	"djfghijklmnopqrstuvwxyzabcdefghi",
+	"ejghijklmnopqrstuvwxyzabcdefghij",
+	"fjhijklmnopqrstuvwxyzabcdefghijk",
+	"gjijklmnopqrstuvwxyzabcdefghijkl",
+	"hjjklmnopqrstuvwxyzabcdefghijklm",
+	"ijklmnopqrstuvwxyzabcdefghijklmn",
+	"jjlmnopqrstuvwxyzabcdefghijklmno",
+	"kjmnopqrstuvwxyzabcdefghijklmnop",
+	"ljnopqrstuvwxyzabcdefghijklmnopq",
+	"mjopqrstuvwxyzabcdefghijklmnopqr",
+	"njpqrstuvwxyzabcdefghijklmnopqrs",
+	"ojqrstuvwxyzabcdefghijklmnopqrst",
+	"pjrstuvwxyzabcdefghijklmnopqrstu",
+	"qjstuvwxyzabcdefghijklmnopqrstuv",
+	"rjtuvwxyzabcdefghijklmnopqrstuvw",
+	"sjuvwxyzabcdefghijklmnopqrstuvwx",
+	"tjvwxyzabcdefghijklmnopqrstuvwxy",
+	"ujwxyzabcdefghijklmnopqrstuvwxyz",
+	"vjxyzabcdefghijklmnopqrstuvwxyza",
+	"wjyzabcdefghijklmnopqrstuvwxyzab",
+	"xjzabcdefghijklmnopqrstuvwxyzabc",
+	"yjabcdefghijklmnopqrstuvwxyzabcd",
+	"zjbcdefghijklmnopqrstuvwxyzabcde",
+	"akcdefghijklmnopqrstuvwxyzabcdef",
+	"bkdefghijklmnopqrstuvwxyzabcdefg",
+	"ckefghijklmnopqrstuvwxyzabcdefgh",
+	"dkfghijklmnopqrstuvwxyzabcdefghi",
+	"ekghijklmnopqrstuvwxyzabcdefghij",
+	"fkhijklmnopqrstuvwxyzabcdefghijk",
+	"gkijklmnopqrstuvwxyzabcdefghijkl",
+	"hkjklmnopqrstuvwxyzabcdefghijklm",
+	"ikklmnopqrstuvwxyzabcdefghijklmn",
+	"jklmnopqrstuvwxyzabcdefghijklmno",
+	"kkmnopqrstuvwxyzabcdefghijklmnop",
+	"lknopqrstuvwxyzabcdefghijklmnopq",
+	"mkopqrstuvwxyzabcdefghijklmnopqr",
+	"nkpqrstuvwxyzabcdefghijklmnopqrs",
+	"okqrstuvwxyzabcdefghijklmnopqrst",
+	"pkrstuvwxyzabcdefghijklmnopqrstu",
+	"qkstuvwxyzabcdefghijklmnopqrstuv",
+	"rktuvwxyzabcdefghijklmnopqrstuvw",
+	"skuvwxyzabcdefghijklmnopqrstuvwx",
+	"tkvwxyzabcdefghijklmnopqrstuvwxy",
+	"ukwxyzabcdefghijklmnopqrstuvwxyz",
+	"vkxyzabcdefghijklmnopqrstuvwxyza",
+	"wkyzabcdefghijklmnopqrstuvwxyzab",
+	"xkzabcdefghijklmnopqrstuvwxyzabc",
+	"ykabcdefghijklmnopqrstuvwxyzabcd",
+	"zkbcdefghijklmnopqrstuvwxyzabcde",
+	"alcdefghijklmnopqrstuvwxyzabcdef",
+	"bldefghijklmnopqrstuvwxyzabcdefg",
+	"clefghijklmnopqrstuvwxyzabcdefgh",
+	"dlfghijklmnopqrstuvwxyzabcdefghi",
+	"elghijklmnopqrstuvwxyzabcdefghij",
+	"flhijklmnopqrstuvwxyzabcdefghijk",
+	"glijklmnopqrstuvwxyzabcdefghijkl",
+	"hljklmnopqrstuvwxyzabcdefghijklm",
+	"ilklmnopqrstuvwxyzabcdefghijklmn",
+	"jllmnopqrstuvwxyzabcdefghijklmno",
+	"klmnopqrstuvwxyzabcdefghijklmnop",
+	"llnopqrstuvwxyzabcdefghijklmnopq",
+	"mlopqrstuvwxyzabcdefghijklmnopqr",
+	"nlpqrstuvwxyzabcdefghijklmnopqrs",
+	"olqrstuvwxyzabcdefghijklmnopqrst",
+	"plrstuvwxyzabcdefghijklmnopqrstu",
+	"qlstuvwxyzabcdefghijklmnopqrstuv",
+	"rltuvwxyzabcdefghijklmnopqrstuvw",
+	"sluvwxyzabcdefghijklmnopqrstuvwx",
+	"tlvwxyzabcdefghijklmnopqrstuvwxy",
+	"ulwxyzabcdefghijklmnopqrstuvwxyz",
+	"vlxyzabcdefghijklmnopqrstuvwxyza",
+	"wlyzabcdefghijklmnopqrstuvwxyzab",
+	"xlzabcdefghijklmnopqrstuvwxyzabc",
+	"ylabcdefghijklmnopqrstuvwxyzabcd",
+	"zlbcdefghijklmnopqrstuvwxyzabcde",
+	"amcdefghijklmnopqrstuvwxyzabcdef",
+	"bmdefghijklmnopqrstuvwxyzabcdefg",
+	"cmefghijklmnopqrstuvwxyzabcdefgh",
+	"dmfghijklmnopqrstuvwxyzabcdefghi",
+	"emghijklmnopqrstuvwxyzabcdefghij",
+	"fmhijklmnopqrstuvwxyzabcdefghijk",
+	"gmijklmnopqrstuvwxyzabcdefghijkl",
+	"hmjklmnopqrstuvwxyzabcdefghijklm",
+	"imklmnopqrstuvwxyzabcdefghijklmn",
+	"jmlmnopqrstuvwxyzabcdefghijklmno",
+	"kmmnopqrstuvwxyzabcdefghijklmnop",
+	"lmnopqrstuvwxyzabcdefghijklmnopq",
+	"mmopqrstuvwxyzabcdefghijklmnopqr",
+	"nmpqrstuvwxyzabcdefghijklmnopqrs",
+	"omqrstuvwxyzabcdefghijklmnopqrst",
+	"pmrstuvwxyzabcdefghijklmnopqrstu",
+	"qmstuvwxyzabcdefghijklmnopqrstuv",
+	"rmtuvwxyzabcdefghijklmnopqrstuvw",
+	"smuvwxyzabcdefghijklmnopqrstuvwx",
+	"tmvwxyzabcdefghijklmnopqrstuvwxy",
+	"umwxyzabcdefghijklmnopqrstuvwxyz",
+	"vmxyzabcdefghijklmnopqrstuvwxyza",
+	"wmyzabcdefghijklmnopqrstuvwxyzab",
+	"xmzabcdefghijklmnopqrstuvwxyzabc",
+	"ymabcdefghijklmnopqrstuvwxyzabcd",
+	"zmbcdefghijklmnopqrstuvwxyzabcde",
+	"ancdefghijklmnopqrstuvwxyzabcdef",
+	"bndefghijklmnopqrstuvwxyzabcdefg",
+	"cnefghijklmnopqrstuvwxyzabcdefgh",
+	"dnfghijklmnopqrstuvwxyzabcdefghi",
+	"enghijklmnopqrstuvwxyzabcdefghij",
+	"fnhijklmnopqrstuvwxyzabcdefghijk",
+	"gnijklmnopqrstuvwxyzabcdefghijkl",
+	"hnjklmnopqrstuvwxyzabcdefghijklm",
+	"inklmnopqrstuvwxyzabcdefghijklmn",
+	"jnlmnopqrstuvwxyzabcdefghijklmno",
+	"knmnopqrstuvwxyzabcdefghijklmnop",
+	"lnnopqrstuvwxyzabcdefghijklmnopq",
+	"mnopqrstuvwxyzabcdefghijklmnopqr",
+	"nnpqrstuvwxyzabcdefghijklmnopqrs",
+	"onqrstuvwxyzabcdefghijklmnopqrst",
+	"pnrstuvwxyzabcdefghijklmnopqrstu",
+	"qnstuvwxyzabcdefghijklmnopqrstuv",
+	"rntuvwxyzabcdefghijklmnopqrstuvw",
+	"snuvwxyzabcdefghijklmnopqrstuvwx",
+	"tnvwxyzabcdefghijklmnopqrstuvwxy",
+	"unwxyzabcdefghijklmnopqrstuvwxyz",
+	"vnxyzabcdefghijklmnopqrstuvwxyza",
+	"wnyzabcdefghijklmnopqrstuvwxyzab",
+	"xnzabcdefghijklmnopqrstuvwxyzabc",
+	"ynabcdefghijklmnopqrstuvwxyzabcd",
+	"znbcdefghijklmnopqrstuvwxyzabcde",
+	"aocdefghijklmnopqrstuvwxyzabcdef",
+	"bodefghijklmnopqrstuvwxyzabcdefg",
+	"coefghijklmnopqrstuvwxyzabcdefgh",
+	"dofghijklmnopqrstuvwxyzabcdefghi",
+	"eoghijklmnopqrstuvwxyzabcdefghij",
+	"fohijklmnopqrstuvwxyzabcdefghijk",
+	"goijklmnopqrstuvwxyzabcdefghijkl",
+	"hojklmnopqrstuvwxyzabcdefghijklm",
+	"ioklmnopqrstuvwxyzabcdefghijklmn",
+	"jolmnopqrstuvwxyzabcdefghijklmno",
+	"komnopqrstuvwxyzabcdefghijklmnop",
+	"lonopqrstuvwxyzabcdefghijklmnopq",
+	"moopqrstuvwxyzabcdefghijklmnopqr",
+	"nopqrstuvwxyzabcdefghijklmnopqrs",
+	"ooqrstuvwxyzabcdefghijklmnopqrst",
+	"porstuvwxyzabcdefghijklmnopqrstu",
+	"qostuvwxyzabcdefghijklmnopqrstuv",
+	"rotuvwxyzabcdefghijklmnopqrstuvw",
+	"souvwxyzabcdefghijklmnopqrstuvwx",
+	"tovwxyzabcdefghijklmnopqrstuvwxy",
+	"uowxyzabcdefghijklmnopqrstuvwxyz",
+	"voxyzabcdefghijklmnopqrstuvwxyza",
+	"woyzabcdefghijklmnopqrstuvwxyzab",
+	"xozabcdefghijklmnopqrstuvwxyzabc",
+	"yoabcdefghijklmnopqrstuvwxyzabcd",
+	"zobcdefghijklmnopqrstuvwxyzabcde",
+	"apcdefghijklmnopqrstuvwxyzabcdef",
+	"bpdefghijklmnopqrstuvwxyzabcdefg",
+	"cpefghijklmnopqrstuvwxyzabcdefgh",
+	"dpfghijklmnopqrstuvwxyzabcdefghi",
+	"epghijklmnopqrstuvwxyzabcdefghij",
+	"fphijklmnopqrstuvwxyzabcdefghijk",
+	"gpijklmnopqrstuvwxyzabcdefghijkl",
+	"hpjklmnopqrstuvwxyzabcdefghijklm",
+	"ipklmnopqrstuvwxyzabcdefghijklmn",
+	"jplmnopqrstuvwxyzabcdefghijklmno",
+	"kpmnopqrstuvwxyzabcdefghijklmnop",
+	"lpnopqrstuvwxyzabcdefghijklmnopq",
+	"mpopqrstuvwxyzabcdefghijklmnopqr",
+	"nppqrstuvwxyzabcdefghijklmnopqrs",
+	"opqrstuvwxyzabcdefghijklmnopqrst",
+	"pprstuvwxyzabcdefghijklmnopqrstu",
+	"qpstuvwxyzabcdefghijklmnopqrstuv",
+	"rptuvwxyzabcdefghijklmnopqrstuvw",
+	"spuvwxyzabcdefghijklmnopqrstuvwx",
+	"tpvwxyzabcdefghijklmnopqrstuvwxy",
+	"upwxyzabcdefghijklmnopqrstuvwxyz",
+	"vpxyzabcdefghijklmnopqrstuvwxyza",
+	"wpyzabcdefghijklmnopqrstuvwxyzab",
+	"xpzabcdefghijklmnopqrstuvwxyzabc",
+	"ypabcdefghijklmnopqrstuvwxyzabcd",
+	"zpbcdefghijklmnopqrstuvwxyzabcde",
+	"aqcdefghijklmnopqrstuvwxyzabcdef",
+	"bqdefghijklmnopqrstuvwxyzabcdefg",
+	"cqefghijklmnopqrstuvwxyzabcdefgh",
+	"dqfghijklmnopqrstuvwxyzabcdefghi",
+	"eqghijklmnopqrstuvwxyzabcdefghij",
+	"fqhijklmnopqrstuvwxyzabcdefghijk",
+	"gqijklmnopqrstuvwxyzabcdefghijkl",
+	"hqjklmnopqrstuvwxyzabcdefghijklm",
+	"iqklmnopqrstuvwxyzabcdefghijklmn",
+	"jqlmnopqrstuvwxyzabcdefghijklmno",
+	"kqmnopqrstuvwxyzabcdefghijklmnop",
+	"lqnopqrstuvwxyzabcdefghijklmnopq",
+	"mqopqrstuvwxyzabcdefghijklmnopqr",
+	"nqpqrstuvwxyzabcdefghijklmnopqrs",
+	"oqqrstuvwxyzabcdefghijklmnopqrst",
+	"pqrstuvwxyzabcdefghijklmnopqrstu",
+	"qqstuvwxyzabcdefghijklmnopqrstuv",
+	"rqtuvwxyzabcdefghijklmnopqrstuvw",
+	"squvwxyzabcdefghijklmnopqrstuvwx",
+	"tqvwxyzabcdefghijklmnopqrstuvwxy",
+	"uqwxyzabcdefghijklmnopqrstuvwxyz",
+	"vqxyzabcdefghijklmnopqrstuvwxyza",
+	"wqyzabcdefghijklmnopqrstuvwxyzab",
+	"xqzabcdefghijklmnopqrstuvwxyzabc",
+	"yqabcdefghijklmnopqrstuvwxyzabcd",
+	"zqbcdefghijklmnopqrstuvwxyzabcde",
+	"arcdefghijklmnopqrstuvwxyzabcdef",
+	"brdefghijklmnopqrstuvwxyzabcdefg",
+	"crefghijklmnopqrstuvwxyzabcdefgh",
+	"drfghijklmnopqrstuvwxyzabcdefghi",
+	"erghijklmnopqrstuvwxyzabcdefghij",
+	"frhijklmnopqrstuvwxyzabcdefghijk",
+	"grijklmnopqrstuvwxyzabcdefghijkl",
+	"hrjklmnopqrstuvwxyzabcdefghijklm",
+	"irklmnopqrstuvwxyzabcdefghijklmn",
+	"jrlmnopqrstuvwxyzabcdefghijklmno",
+	"krmnopqrstuvwxyzabcdefghijklmnop",
+	"lrnopqrstuvwxyzabcdefghijklmnopq",
+	"mropqrstuvwxyzabcdefghijklmnopqr",
+	"nrpqrstuvwxyzabcdefghijklmnopqrs",
+	"orqrstuvwxyzabcdefghijklmnopqrst",
+	"prrstuvwxyzabcdefghijklmnopqrstu",
+	"qrstuvwxyzabcdefghijklmnopqrstuv",
+	"rrtuvwxyzabcdefghijklmnopqrstuvw",
+	"sruvwxyzabcdefghijklmnopqrstuvwx",
+	"trvwxyzabcdefghijklmnopqrstuvwxy",
+	"urwxyzabcdefghijklmnopqrstuvwxyz",
+	"vrxyzabcdefghijklmnopqrstuvwxyza",
+	"wryzabcdefghijklmnopqrstuvwxyzab",
+	"xrzabcdefghijklmnopqrstuvwxyzabc",
+	"yrabcdefghijklmnopqrstuvwxyzabcd",
+	"zrbcdefghijklmnopqrstuvwxyzabcde",
+	"ascdefghijklmnopqrstuvwxyzabcdef",
+	"bsdefghijklmnopqrstuvwxyzabcdefg",
+	"csefghijklmnopqrstuvwxyzabcdefgh",
+	"dsfghijklmnopqrstuvwxyzabcdefghi",
+	"esghijklmnopqrstuvwxyzabcdefghij",
+	"fshijklmnopqrstuvwxyzabcdefghijk",
+	"gsijklmnopqrstuvwxyzabcdefghijkl",
+	"hsjklmnopqrstuvwxyzabcdefghijklm",
+	"isklmnopqrstuvwxyzabcdefghijklmn",
+	"jslmnopqrstuvwxyzabcdefghijklmno",
+	"ksmnopqrstuvwxyzabcdefghijklmnop",
+	"lsnopqrstuvwxyzabcdefghijklmnopq",
+	"msopqrstuvwxyzabcdefghijklmnopqr",
+	"nspqrstuvwxyzabcdefghijklmnopqrs",
+	"osqrstuvwxyzabcdefghijklmnopqrst",
+	"psrstuvwxyzabcdefghijklmnopqrstu",
+	"qsstuvwxyzabcdefghijklmnopqrstuv",
+	"rstuvwxyzabcdefghijklmnopqrstuvw",
+	"ssuvwxyzabcdefghijklmnopqrstuvwx",
+	"tsvwxyzabcdefghijklmnopqrstuvwxy",
+	"uswxyzabcdefghijklmnopqrstuvwxyz",
+	"vsxyzabcdefghijklmnopqrstuvwxyza",
+	"wsyzabcdefghijklmnopqrstuvwxyzab",
+	"xszabcdefghijklmnopqrstuvwxyzabc",
+	"ysabcdefghijklmnopqrstuvwxyzabcd",
+	"zsbcdefghijklmnopqrstuvwxyzabcde",
+	"atcdefghijklmnopqrstuvwxyzabcdef",
+	"btdefghijklmnopqrstuvwxyzabcdefg",
+	"ctefghijklmnopqrstuvwxyzabcdefgh",
+	"dtfghijklmnopqrstuvwxyzabcdefghi",
+	"etghijklmnopqrstuvwxyzabcdefghij",
+	"fthijklmnopqrstuvwxyzabcdefghijk",
+	"gtijklmnopqrstuvwxyzabcdefghijkl",
+	"htjklmnopqrstuvwxyzabcdefghijklm",
+	"itklmnopqrstuvwxyzabcdefghijklmn",
+	"jtlmnopqrstuvwxyzabcdefghijklmno",
+	"ktmnopqrstuvwxyzabcdefghijklmnop",
+	"ltnopqrstuvwxyzabcdefghijklmnopq",
+	"mtopqrstuvwxyzabcdefghijklmnopqr",
+	"ntpqrstuvwxyzabcdefghijklmnopqrs",
+	"otqrstuvwxyzabcdefghijklmnopqrst",
+	"ptrstuvwxyzabcdefghijklmnopqrstu",
+	"qtstuvwxyzabcdefghijklmnopqrstuv",
+	"rttuvwxyzabcdefghijklmnopqrstuvw",
+}
+
+// StringKeys32P24 are the keys of each branch, 32 bytes long with a shared
+// prefix of 24 bytes.
+var StringKeys32P24 = []string{
+	"abcdefghijklmnopqrstuvwxaaabcdef",
+	"abcdefghijklmnopqrstuvwxbabcdefg",
+	"abcdefghijklmnopqrstuvwxcacdefgh",
+	"abcdefghijklmnopqrstuvwxdadefghi",
+	"abcdefghijklmnopqrstuvwxeaefghij",
+	"abcdefghijklmnopqrstuvwxfafghijk",
+	"abcdefghijklmnopqrstuvwxgaghijkl",
+	"abcdefghijklmnopqrstuvwxhahijklm",
+	"abcdefghijklmnopqrstuvwxiaijklmn",
+	"abcdefghijklmnopqrstuvwxjajklmno",
+	"abcdefghijklmnopqrstuvwxkaklmnop",
+	"abcdefghijklmnopqrstuvwxlalmnopq",
+	"abcdefghijklmnopqrstuvwxmamnopqr",
+	"abcdefghijklmnopqrstuvwxnanopqrs",
+	"abcdefghijklmnopqrstuvwxoaopqrst",
+	"abcdefghijklmnopqrstuvwxpapqrstu",
+	"abcdefghijklmnopqrstuvwxqaqrstuv",
+	"abcdefghijklmnopqrstuvwxrarstuvw",
+	"abcdefghijklmnopqrstuvwxsastuvwx",
+	"abcdefghijklmnopqrstuvwxtatuvwxy",
+	"abcdefghijklmnopqrstuvwxuauvwxyz",
+	"abcdefghijklmnopqrstuvwxvavwxyza",
+	"abcdefghijklmnopqrstuvwxwawxyzab",
+	"abcdefghijklmnopqrstuvwxxaxyzabc",
+	"abcdefghijklmnopqrstuvwxyayzabcd",
+	"abcdefghijklmnopqrstuvwxzazabcde",
+	"abcdefghijklmnopqrstuvwxababcdef",
+	"abcdefghijklmnopqrstuvwxbbbcdefg",
+	"abcdefghijklmnopqrstuvwxcbcdefgh",
+	"abcdefghijklmnopqrstuvwxdbdefghi",
+	"abcdefghijklmnopqrstuvwxebefghij",
+	"abcdefghijklmnopqrstuvwxfbfghijk",
+	"abcdefghijklmnopqrstuvwxgbghijkl",
+	"abcdefghijklmnopqrstuvwxhbhijklm",
+	"abcdefghijklmnopqrstuvwxibijklmn",
+	"abcdefghijklmnopqrstuvwxjbjklmno",
+	"abcdefghijklmnopqrstuvwxkbklmnop",
+	"abcdefghijklmnopqrstuvwxlblmnopq",
+	"abcdefghijklmnopqrstuvwxmbmnopqr",
+	"abcdefghijklmnopqrstuvwxnbnopqrs",
+	"abcdefghijklmnopqrstuvwxobopqrst",
+	"abcdefghijklmnopqrstuvwxpbpqrstu",
+	"abcdefghijklmnopqrstuvwxqbqrstuv",
+	"abcdefghijklmnopqrstuvwxrbrstuvw",
+	"abcdefghijklmnopqrstuvwxsbstuvwx",
+	"abcdefghijklmnopqrstuvwxtbtuvwxy",
+	"abcdefghijklmnopqrstuvwxubuvwxyz",
+	"abcdefghijklmnopqrstuvwxvbvwxyza",
+	"abcdefghijklmnopqrstuvwxwbwxyzab",
+	"abcdefghijklmnopqrstuvwxxbxyzabc",
+	"abcdefghijklmnopqrstuvwxybyzabcd",
+	"abcdefghijklmnopqrstuvwxzbzabcde",
+	"abcdefghijklmnopqrstuvwxacabcdef",
+	"abcdefghijklmnopqrstuvwxbcbcdefg",
+	"abcdefghijklmnopqrstuvwxcccdefgh",
+	"abcdefghijklmnopqrstuvwxdcdefghi",
+	"abcdefghijklmnopqrstuvwxecefghij",
+	"abcdefghijklmnopqrstuvwxfcfghijk",
+	"abcdefghijklmnopqrstuvwxgcghijkl",
+	"abcdefghijklmnopqrstuvwxhchijklm",
+	"abcdefghijklmnopqrstuvwxicijklmn",
+	"abcdefghijklmnopqrstuvwxjcjklmno",
+	"abcdefghijklmnopqrstuvwxkcklmnop",
+	"abcdefghijklmnopqrstuvwxlclmnopq",
+	"abcdefghijklmnopqrstuvwxmcmnopqr",
+	"abcdefghijklmnopqrstuvwxncnopqrs",
+	"abcdefghijklmnopqrstuvwxocopqrst",
+	"abcdefghijklmnopqrstuvwxpcpqrstu",
+	"abcdefghijklmnopqrstuvwxqcqrstuv",
+	"abcdefghijklmnopqrstuvwxrcrstuvw",
+	"abcdefghijklmnopqrstuvwxscstuvwx",
+	"abcdefghijklmnopqrstuvwxtctuvwxy",
+	"abcdefghijklmnopqrstuvwxucuvwxyz",
+	"abcdefghijklmnopqrstuvwxvcvwxyza",
+	"abcdefghijklmnopqrstuvwxwcwxyzab",
+	"abcdefghijklmnopqrstuvwxxcxyzabc",
+	"abcdefghijklmnopqrstuvwxycyzabcd",
+	"abcdefghijklmnopqrstuvwxzczabcde",
+	"abcdefghijklmnopqrstuvwxadabcdef",
+	"abcdefghijklmnopqrstuvwxbdbcdefg",
+	"abcdefghijklmnopqrstuvwxcdcdefgh",
+	"abcdefghijklmnopqrstuvwxdddefghi",
+	"abcdefghijklmnopqrstuvwxedefghij",
+	"abcdefghijklmnopqrstuvwxfdfghijk",
+	"abcdefghijklmnopqrstuvwxgdghijkl",
+	"abcdefghijklmnopqrstuvwxhdhijklm",
+	"abcdefghijklmnopqrstuvwxidijklmn",
+	"abcdefghijklmnopqrstuvwxjdjklmno",
+	"abcdefghijklmnopqrstuvwxkdklmnop",
+	"abcdefghijklmnopqrstuvwxldlmnopq",
+	"abcdefghijklmnopqrstuvwxmdmnopqr",
+	"abcdefghijklmnopqrstuvwxndnopqrs",
+	"abcdefghijklmnopqrstuvwxodopqrst",
+	"abcdefghijklmnopqrstuvwxpdpqrstu",
+	"abcdefghijklmnopqrstuvwxqdqrstuv",
+	"abcdefghijklmnopqrstuvwxrdrstuvw",
+	"abcdefghijklmnopqrstuvwxsdstuvwx",
+	"abcdefghijklmnopqrstuvwxtdtuvwxy",
+	"abcdefghijklmnopqrstuvwxuduvwxyz",
+	"abcdefghijklmnopqrstuvwxvdvwxyza",
+	"abcdefghijklmnopqrstuvwxwdwxyzab",
+	"abcdefghijklmnopqrstuvwxxdxyzabc",
+	"abcdefghijklmnopqrstuvwxydyzabcd",
+	"abcdefghijklmnopqrstuvwxzdzabcde",
+	"abcdefghijklmnopqrstuvwxaeabcdef",
+	"abcdefghijklmnopqrstuvwxbebcdefg",
+	"abcdefghijklmnopqrstuvwxcecdefgh",
+	"abcdefghijklmnopqrstuvwxdedefghi",
+	"abcdefghijklmnopqrstuvwxeeefghij",
+	"abcdefghijklmnopqrstuvwxfefghijk",
+	"abcdefghijklmnopqrstuvwxgeghijkl",
+	"abcdefghijklmnopqrstuvwxhehijklm",
+	"abcdefghijklmnopqrstuvwxieijklmn",
+	"abcdefghijklmnopqrstuvwxjejklmno",
+	"abcdefghijklmnopqrstuvwxkeklmnop",
+	"abcdefghijklmnopqrstuvwxlelmnopq",
+	"abcdefghijklmnopqrstuvwxmemnopqr",
+	"abcdefghijklmnopqrstuvwxnenopqrs",
+	"abcdefghijklmnopqrstuvwxoeopqrst",
+	"abcdefghijklmnopqrstuvwxpepqrstu",
+	"abcdefghijklmnopqrstuvwxqeqrstuv",
+	"abcdefghijklmnopqrstuvwxrerstuvw",
+	"abcdefghijklmnopqrstuvwxsestuvwx",
+	"abcdefghijklmnopqrstuvwxtetuvwxy",
+	"abcdefghijklmnopqrstuvwxueuvwxyz",
+	"abcdefghijklmnopqrstuvwxvevwxyza",
+	"abcdefghijklmnopqrstuvwxwewxyzab",
+	"abcdefghijklmnopqrstuvwxxexyzabc",
+	"abcdefghijklmnopqrstuvwxyeyzabcd",
+	"abcdefghijklmnopqrstuvwxzezabcde",
+	"abcdefghijklmnopqrstuvwxafabcdef",
+	"abcdefghijklmnopqrstuvwxbfbcdefg",
+	"abcdefghijklmnopqrstuvwxcfcdefgh",
+	"abcdefghijklmnopqrstuvwxdfdefghi",
+	"abcdefghijklmnopqrstuvwxefefghij",
+	"abcdefghijklmnopqrstuvwxfffghijk",
+	"abcdefghijklmnopqrstuvwxgfghijkl",
+	"abcdefghijklmnopqrstuvwxhfhijklm",
+	"abcdefghijklmnopqrstuvwxifijklmn",
+	"abcdefghijklmnopqrstuvwxjfjklmno",
+	"abcdefghijklmnopqrstuvwxkfklmnop",
+	"abcdefghijklmnopqrstuvwxlflmnopq",
+	"abcdefghijklmnopqrstuvwxmfmnopqr",
+	"abcdefghijklmnopqrstuvwxnfnopqrs",
+	"abcdefghijklmnopqrstuvwxofopqrst",
+	"abcdefghijklmnopqrstuvwxpfpqrstu",
+	"abcdefghijklmnopqrstuvwxqfqrstuv",
+	"abcdefghijklmnopqrstuvwxrfrstuvw",
+	"abcdefghijklmnopqrstuvwxsfstuvwx",
+	"abcdefghijklmnopqrstuvwxtftuvwxy",
+	"abcdefghijklmnopqrstuvwxufuvwxyz",
+	"abcdefghijklmnopqrstuvwxvfvwxyza",
+	"abcdefghijklmnopqrstuvwxwfwxyzab",
+	"abcdefghijklmnopqrstuvwxxfxyzabc",
+	"abcdefghijklmnopqrstuvwxyfyzabcd",
+	"abcdefghijklmnopqrstuvwxzfzabcde",
+	"abcdefghijklmnopqrstuvwxagabcdef",
+	"abcdefghijklmnopqrstuvwxbgbcdefg",
+	"abcdefghijklmnopqrstuvwxcgcdefgh",
+	"abcdefghijklmnopqrstuvwxdgdefghi",
+	"abcdefghijklmnopqrstuvwxegefghij",
+	"abcdefghijklmnopqrstuvwxfgfghijk",
+	"abcdefghijklmnopqrstuvwxggghijkl",
+	"abcdefghijklmnopqrstuvwxhghijklm",
+	"abcdefghijklmnopqrstuvwxigijklmn",
+	"abcdefghijklmnopqrstuvwxjgjklmno",
+	"abcdefghijklmnopqrstuvwxkgklmnop",
+	"abcdefghijklmnopqrstuvwxlglmnopq",
+	"abcdefghijklmnopqrstuvwxmgmnopqr",
+	"abcdefghijklmnopqrstuvwxngnopqrs",
+	"abcdefghijklmnopqrstuvwxogopqrst",
+	"abcdefghijklmnopqrstuvwxpgpqrstu",
+	"abcdefghijklmnopqrstuvwxqgqrstuv",
+	"abcdefghijklmnopqrstuvwxrgrstuvw",
+	"abcdefghijklmnopqrstuvwxsgstuvwx",
+	"abcdefghijklmnopqrstuvwxtgtuvwxy",
+	"abcdefghijklmnopqrstuvwxuguvwxyz",
+	"abcdefghijklmnopqrstuvwxvgvwxyza",
+	"abcdefghijklmnopqrstuvwxwgwxyzab",
+	"abcdefghijklmnopqrstuvwxxgxyzabc",
+	"abcdefghijklmnopqrstuvwxygyzabcd",
+	"abcdefghijklmnopqrstuvwxzgzabcde",
+	"abcdefghijklmnopqrstuvwxahabcdef",
+	"abcdefghijklmnopqrstuvwxbhbcdefg",
+	"abcdefghijklmnopqrstuvwxchcdefgh",
+	"abcdefghijklmnopqrstuvwxdhdefghi",
+	"abcdefghijklmnopqrstuvwxehefghij",
+	"abcdefghijklmnopqrstuvwxfhfghijk",
+	"abcdefghijklmnopqrstuvwxghghijkl",
+	"abcdefghijklmnopqrstuvwxhhhijklm",
+	"abcdefghijklmnopqrstuvwxihijklmn",
+	"abcdefghijklmnopqrstuvwxjhjklmno",
+	"abcdefghijklmnopqrstuvwxkhklmnop",
+	"abcdefghijklmnopqrstuvwxlhlmnopq",
+	"abcdefghijklmnopqrstuvwxmhmnopqr",
+	"abcdefghijklmnopqrstuvwxnhnopqrs",
+	"abcdefghijklmnopqrstuvwxohopqrst",
+	"abcdefghijklmnopqrstuvwxphpqrstu",
+	"abcdefghijklmnopqrstuvwxqhqrstuv",
+	"abcdefghijklmnopqrstuvwxrhrstuvw",
+	"abcdefghijklmnopqrstuvwxshstuvwx",
+	"abcdefghijklmnopqrstuvwxthtuvwxy",
+	"abcdefghijklmnopqrstuvwxuhuvwxyz",
+	"abcdefghijklmnopqrstuvwxvhvwxyza",
+	"abcdefghijklmnopqrstuvwxwhwxyzab",
+	"abcdefghijklmnopqrstuvwxxhxyzabc",
+	"abcdefghijklmnopqrstuvwxyhyzabcd",
+	"abcdefghijklmnopqrstuvwxzhzabcde",
+	"abcdefghijklmnopqrstuvwxaiabcdef",
+	"abcdefghijklmnopqrstuvwxbibcdefg",
+	"abcdefghijklmnopqrstuvwxcicdefgh",
+	"abcdefghijklmnopqrstuvwxdidefghi",
+	"abcdefghijklmnopqrstuvwxeiefghij",
+	"abcdefghijklmnopqrstuvwxfifghijk",
+	"abcdefghijklmnopqrstuvwxgighijkl",
+	"abcdefghijklmnopqrstuvwxhihijklm",
+	"abcdefghijklmnopqrstuvwxiiijklmn",
+	"abcdefghijklmnopqrstuvwxjijklmno",
+	"abcdefghijklmnopqrstuvwxkiklmnop",
+	"abcdefghijklmnopqrstuvwxlilmnopq",
+	"abcdefghijklmnopqrstuvwxmimnopqr",
+	"abcdefghijklmnopqrstuvwxninopqrs",
+	"abcdefghijklmnopqrstuvwxoiopqrst",
+	"abcdefghijklmnopqrstuvwxpipqrstu",
+	"abcdefghijklmnopqrstuvwxqiqrstuv",
+	"abcdefghijklmnopqrstuvwxrirstuvw",
+	"abcdefghijklmnopqrstuvwxsistuvwx",
+	"abcdefghijklmnopqrstuvwxtituvwxy",
+	"abcdefghijklmnopqrstuvwxuiuvwxyz",
+	"abcdefghijklmnopqrstuvwxvivwxyza",
+	"abcdefghijklmnopqrstuvwxwiwxyzab",
+	"abcdefghijklmnopqrstuvwxxixyzabc",
+	"abcdefghijklmnopqrstuvwxyiyzabcd",
+	"abcdefghijklmnopqrstuvwxzizabcde",
+	"abcdefghijklmnopqrstuvwxajabcdef",
+	"abcdefghijklmnopqrstuvwxbjbcdefg",
+	"abcdefghijklmnopqrstuvwxcjcdefgh",
+	"abcdefghijklmnopqrstuvwxdjdefghi",
+	"abcdefghijklmnopqrstuvwxejefghij",
+	"abcdefghijklmnopqrstuvwxfjfghijk",
+	"abcdefghijklmnopqrstuvwxgjghijkl",
+	"abcdefghijklmnopqrstuvwxhjhijklm",
+	"abcdefghijklmnopqrstuvwxijijklmn",
+	"abcdefghijklmnopqrstuvwxjjjklmno",
+	"abcdefghijklmnopqrstuvwxkjklmnop",
+	"abcdefghijklmnopqrstuvwxljlmnopq",
+	"abcdefghijklmnopqrstuvwxmjmnopqr",
+	"abcdefghijklmnopqrstuvwxnjnopqrs",
+	"abcdefghijklmnopqrstuvwxojopqrst",
+	"abcdefghijklmnopqrstuvwxpjpqrstu",
+	"abcdefghijklmnopqrstuvwxqjqrstuv",
+	"abcdefghijklmnopqrstuvwxrjrstuvw",
+	"abcdefghijklmnopqrstuvwxsjstuvwx",
+	"abcdefghijklmnopqrstuvwxtjtuvwxy",
+	"abcdefghijklmnopqrstuvwxujuvwxyz",
+	"abcdefghijklmnopqrstuvwxvjvwxyza",
+	"abcdefghijklmnopqrstuvwxwjwxyzab",
+	"abcdefghijklmnopqrstuvwxxjxyzabc",
+	"abcdefghijklmnopqrstuvwxyjyzabcd",
+	"abcdefghijklmnopqrstuvwxzjzabcde",
+	"abcdefghijklmnopqrstuvwxakabcdef",
+	"abcdefghijklmnopqrstuvwxbkbcdefg",
+	"abcdefghijklmnopqrstuvwxckcdefgh",
+	"abcdefghijklmnopqrstuvwxdkdefghi",
+	"abcdefghijklmnopqrstuvwxekefghij",
+	"abcdefghijklmnopqrstuvwxfkfghijk",
+	"abcdefghijklmnopqrstuvwxgkghijkl",
+	"abcdefghijklmnopqrstuvwxhkhijklm",
+	"abcdefghijklmnopqrstuvwxikijklmn",
+	"abcdefghijklmnopqrstuvwxjkjklmno",
+	"abcdefghijklmnopqrstuvwxkkklmnop",
+	"abcdefghijklmnopqrstuvwxlklmnopq",
+	"abcdefghijklmnopqrstuvwxmkmnopqr",
+	"abcdefghijklmnopqrstuvwxnknopqrs",
+	"abcdefghijklmnopqrstuvwxokopqrst",
+	"abcdefghijklmnopqrstuvwxpkpqrstu",
+	"abcdefghijklmnopqrstuvwxqkqrstuv",
+	"abcdefghijklmnopqrstuvwxrkrstuvw",
+	"abcdefghijklmnopqrstuvwxskstuvwx",
+	"abcdefghijklmnopqrstuvwxtktuvwxy",
+	"abcdefghijklmnopqrstuvwxukuvwxyz",
+	"abcdefghijklmnopqrstuvwxvkvwxyza",
+	"abcdefghijklmnopqrstuvwxwkwxyzab",
+	"abcdefghijklmnopqrstuvwxxkxyzabc",
+	"abcdefghijklmnopqrstuvwxykyzabcd",
+	"abcdefghijklmnopqrstuvwxzkzabcde",
+	"abcdefghijklmnopqrstuvwxalabcdef",
+	"abcdefghijklmnopqrstuvwxblbcdefg",
+	"abcdefghijklmnopqrstuvwxclcdefgh",
+	"abcdefghijklmnopqrstuvwxdldefghi",
+	"abcdefghijklmnopqrstuvwxelefghij",
+	"abcdefghijklmnopqrstuvwxflfghijk",
+	"abcdefghijklmnopqrstuvwxglghijkl",
+	"abcdefghijklmnopqrstuvwxhlhijklm",
+	"abcdefghijklmnopqrstuvwxilijklmn",
+	"abcdefghijklmnopqrstuvwxjljklmno",
+	"abcdefghijklmnopqrstuvwxklklmnop",
+	"abcdefghijklmnopqrstuvwxlllmnopq",
+	"abcdefghijklmnopqrstuvwxmlmnopqr",
+	"abcdefghijklmnopqrstuvwxnlnopqrs",
+	"abcdefghijklmnopqrstuvwxolopqrst",
+	"abcdefghijklmnopqrstuvwxplpqrstu",
+	"abcdefghijklmnopqrstuvwxqlqrstuv",
+	"abcdefghijklmnopqrstuvwxrlrstuvw",
+	"abcdefghijklmnopqrstuvwxslstuvwx",
+	"abcdefghijklmnopqrstuvwxtltuvwxy",
+	"abcdefghijklmnopqrstuvwxuluvwxyz",
+	"abcdefghijklmnopqrstuvwxvlvwxyza",
+	"abcdefghijklmnopqrstuvwxwlwxyzab",
+	"abcdefghijklmnopqrstuvwxxlxyzabc",
+	"abcdefghijklmnopqrstuvwxylyzabcd",
+	"abcdefghijklmnopqrstuvwxzlzabcde",
+	"abcdefghijklmnopqrstuvwxamabcdef",
+	"abcdefghijklmnopqrstuvwxbmbcdefg",
+	"abcdefghijklmnopqrstuvwxcmcdefgh",
+	"abcdefghijklmnopqrstuvwxdmdefghi",
+	"abcdefghijklmnopqrstuvwxemefghij",
+	"abcdefghijklmnopqrstuvwxfmfghijk",
+	"abcdefghijklmnopqrstuvwxgmghijkl",
+	"abcdefghijklmnopqrstuvwxhmhijklm",
+	"abcdefghijklmnopqrstuvwximijklmn",
+	"abcdefghijklmnopqrstuvwxjmjklmno",
+	"abcdefghijklmnopqrstuvwxkmklmnop",
+	"abcdefghijklmnopqrstuvwxlmlmnopq",
+	"abcdefghijklmnopqrstuvwxmmmnopqr",
+	"abcdefghijklmnopqrstuvwxnmnopqrs",
+	"abcdefghijklmnopqrstuvwxomopqrst",
+	"abcdefghijklmnopqrstuvwxpmpqrstu",
+	"abcdefghijklmnopqrstuvwxqmqrstuv",
+	"abcdefghijklmnopqrstuvwxrmrstuvw",
+	"abcdefghijklmnopqrstuvwxsmstuvwx",
+	"abcdefghijklmnopqrstuvwxtmtuvwxy",
+	"abcdefghijklmnopqrstuvwxumuvwxyz",
+	"abcdefghijklmnopqrstuvwxvmvwxyza",
+	"abcdefghijklmnopqrstuvwxwmwxyzab",
+	"abcdefghijklmnopqrstuvwxxmxyzabc",
+	"abcdefghijklmnopqrstuvwxymyzabcd",
+	"abcdefghijklmnopqrstuvwxzmzabcde",
+	"abcdefghijklmnopqrstuvwxanabcdef",
+	"abcdefghijklmnopqrstuvwxbnbcdefg",
+	"abcdefghijklmnopqrstuvwxcncdefgh",
+	"abcdefghijklmnopqrstuvwxdndefghi",
+	"abcdefghijklmnopqrstuvwxenefghij",
+	"abcdefghijklmnopqrstuvwxfnfghijk",
+	"abcdefghijklmnopqrstuvwxgnghijkl",
+	"abcdefghijklmnopqrstuvwxhnhijklm",
+	"abcdefghijklmnopqrstuvwxinijklmn",
+	"abcdefghijklmnopqrstuvwxjnjklmno",
+	"abcdefghijklmnopqrstuvwxknklmnop",
+	"abcdefghijklmnopqrstuvwxlnlmnopq",
+	"abcdefghijklmnopqrstuvwxmnmnopqr",
+	"abcdefghijklmnopqrstuvwxnnnopqrs",
+	"abcdefghijklmnopqrstuvwxonopqrst",
+	"abcdefghijklmnopqrstuvwxpnpqrstu",
+	"abcdefghijklmnopqrstuvwxqnqrstuv",
+	"abcdefghijklmnopqrstuvwxrnrstuvw",
+	"abcdefghijklmnopqrstuvwxsnstuvwx",
+	"abcdefghijklmnopqrstuvwxtntuvwxy",
+	"abcdefghijklmnopqrstuvwxunuvwxyz",
+	"abcdefghijklmnopqrstuvwxvnvwxyza",
+	"abcdefghijklmnopqrstuvwxwnwxyzab",
+	"abcdefghijklmnopqrstuvwxxnxyzabc",
+	"abcdefghijklmnopqrstuvwxynyzabcd",
+	"abcdefghijklmnopqrstuvwxznzabcde",
+	"abcdefghijklmnopqrstuvwxaoabcdef",
+	"abcdefghijklmnopqrstuvwxbobcdefg",
+	"abcdefghijklmnopqrstuvwxcocdefgh",
+	"abcdefghijklmnopqrstuvwxdodefghi",
+	"abcdefghijklmnopqrstuvwxeoefghij",
+	"abcdefghijklmnopqrstuvwxfofghijk",
+	"abcdefghijklmnopqrstuvwxgoghijkl",
+	"abcdefghijklmnopqrstuvwxhohijklm",
+	"abcdefghijklmnopqrstuvwxioijklmn",
+	"abcdefghijklmnopqrstuvwxjojklmno",
+	"abcdefghijklmnopqrstuvwxkoklmnop",
+	"abcdefghijklmnopqrstuvwxlolmnopq",
+	"abcdefghijklmnopqrstuvwxmomnopqr",
+	"abcdefghijklmnopqrstuvwxnonopqrs",
+	"abcdefghijklmnopqrstuvwxooopqrst",
+	"abcdefghijklmnopqrstuvwxpopqrstu",
+	"abcdefghijklmnopqrstuvwxqoqrstuv",
+	"abcdefghijklmnopqrstuvwxrorstuvw",
+	"abcdefghijklmnopqrstuvwxsostuvwx",
+	"abcdefghijklmnopqrstuvwxtotuvwxy",
+	"abcdefghijklmnopqrstuvwxuouvwxyz",
+	"abcdefghijklmnopqrstuvwxvovwxyza",
+	"abcdefghijklmnopqrstuvwxwowxyzab",
+	"abcdefghijklmnopqrstuvwxxoxyzabc",
+	"abcdefghijklmnopqrstuvwxyoyzabcd",
+	"abcdefghijklmnopqrstuvwxzozabcde",
+	"abcdefghijklmnopqrstuvwxapabcdef",
+	"abcdefghijklmnopqrstuvwxbpbcdefg",
+	"abcdefghijklmnopqrstuvwxcpcdefgh",
+	"abcdefghijklmnopqrstuvwxdpdefghi",
+	"abcdefghijklmnopqrstuvwxepefghij",
+	"abcdefghijklmnopqrstuvwxfpfghijk",
+	"abcdefghijklmnopqrstuvwxgpghijkl",
+	"abcdefghijklmnopqrstuvwxhphijklm",
+	"abcdefghijklmnopqrstuvwxipijklmn",
+	"abcdefghijklmnopqrstuvwxjpjklmno",
+	"abcdefghijklmnopqrstuvwxkpklmnop",
+	"abcdefghijklmnopqrstuvwxlplmnopq",
+	"abcdefghijklmnopqrstuvwxmpmnopqr",
+	"abcdefghijklmnopqrstuvwxnpnopqrs",
+	"abcdefghijklmnopqrstuvwxopopqrst",
+	"abcdefghijklmnopqrstuvwxpppqrstu",
+	"abcdefghijklmnopqrstuvwxqpqrstuv",
+	"abcdefghijklmnopqrstuvwxrprstuvw",
+	"abcdefghijklmnopqrstuvwxspstuvwx",
+	"abcdefghijklmnopqrstuvwxtptuvwxy",
+	"abcdefghijklmnopqrstuvwxupuvwxyz",
+	"abcdefghijklmnopqrstuvwxvpvwxyza",
+	"abcdefghijklmnopqrstuvwxwpwxyzab",
+	"abcdefghijklmnopqrstuvwxxpxyzabc",
+	"abcdefghijklmnopqrstuvwxypyzabcd",
+	"abcdefghijklmnopqrstuvwxzpzabcde",
+	"abcdefghijklmnopqrstuvwxaqabcdef",
+	"abcdefghijklmnopqrstuvwxbqbcdefg",
+	"abcdefghijklmnopqrstuvwxcqcdefgh",
+	"abcdefghijklmnopqrstuvwxdqdefghi",
+	"abcdefghijklmnopqrstuvwxeqefghij",
+	"abcdefghijklmnopqrstuvwxfqfghijk",
+	"abcdefghijklmnopqrstuvwxgqghijkl",
+	"abcdefghijklmnopqrstuvwxhqhijklm",
+	"abcdefghijklmnopqrstuvwxiqijklmn",
+	"abcdefghijklmnopqrstuvwxjqjklmno",
+	"abcdefghijklmnopqrstuvwxkqklmnop",
+	"abcdefghijklmnopqrstuvwxlqlmnopq",
+	"abcdefghijklmnopqrstuvwxmqmnopqr",
+	"abcdefghijklmnopqrstuvwxnqnopqrs",
+	"abcdefghijklmnopqrstuvwxoqopqrst",
+	"abcdefghijklmnopqrstuvwxpqpqrstu",
+	"abcdefghijklmnopqrstuvwxqqqrstuv",
+	"abcdefghijklmnopqrstuvwxrqrstuvw",
+	"abcdefghijklmnopqrstuvwxsqstuvwx",
+	"abcdefghijklmnopqrstuvwxtqtuvwxy",
+	"abcdefghijklmnopqrstuvwxuquvwxyz",
+	"abcdefghijklmnopqrstuvwxvqvwxyza",
+	"abcdefghijklmnopqrstuvwxwqwxyzab",
+	"abcdefghijklmnopqrstuvwxxqxyzabc",
+	"abcdefghijklmnopqrstuvwxyqyzabcd",
+	"abcdefghijklmnopqrstuvwxzqzabcde",
+	"abcdefghijklmnopqrstuvwxarabcdef",
+	"abcdefghijklmnopqrstuvwxbrbcdefg",
+	"abcdefghijklmnopqrstuvwxcrcdefgh",
+	"abcdefghijklmnopqrstuvwxdrdefghi",
+	"abcdefghijklmnopqrstuvwxerefghij",
+	"abcdefghijklmnopqrstuvwxfrfghijk",
+	"abcdefghijklmnopqrstuvwxgrghijkl",
+	"abcdefghijklmnopqrstuvwxhrhijklm",
+	"abcdefghijklmnopqrstuvwxirijklmn",
+	"abcdefghijklmnopqrstuvwxjrjklmno",
+	"abcdefghijklmnopqrstuvwxkrklmnop",
+	"abcdefghijklmnopqrstuvwxlrlmnopq",
+	"abcdefghijklmnopqrstuvwxmrmnopqr",
+	"abcdefghijklmnopqrstuvwxnrnopqrs",
+	"abcdefghijklmnopqrstuvwxoropqrst",
+	"abcdefghijklmnopqrstuvwxprpqrstu",
+	"abcdefghijklmnopqrstuvwxqrqrstuv",
+	"abcdefghijklmnopqrstuvwxrrrstuvw",
+	"abcdefghijklmnopqrstuvwxsrstuvwx",
+	"abcdefghijklmnopqrstuvwxtrtuvwxy",
+	"abcdefghijklmnopqrstuvwxuruvwxyz",
+	"abcdefghijklmnopqrstuvwxvrvwxyza",
+	"abcdefghijklmnopqrstuvwxwrwxyzab",
+	"abcdefghijklmnopqrstuvwxxrxyzabc",
+	"abcdefghijklmnopqrstuvwxyryzabcd",
+	"abcdefghijklmnopqrstuvwxzrzabcde",
+	"abcdefghijklmnopqrstuvwxasabcdef",
+	"abcdefghijklmnopqrstuvwxbsbcdefg",
+	"abcdefghijklmnopqrstuvwxcscdefgh",
+	"abcdefghijklmnopqrstuvwxdsdefghi",
+	"abcdefghijklmnopqrstuvwxesefghij",
+	"abcdefghijklmnopqrstuvwxfsfghijk",
+	"abcdefghijklmnopqrstuvwxgsghijkl",
+	"abcdefghijklmnopqrstuvwxhshijklm",
+	"abcdefghijklmnopqrstuvwxisijklmn",
+	"abcdefghijklmnopqrstuvwxjsjklmno",
+	"abcdefghijklmnopqrstuvwxksklmnop",
+	"abcdefghijklmnopqrstuvwxlslmnopq",
+	"abcdefghijklmnopqrstuvwxmsmnopqr",
+	"abcdefghijklmnopqrstuvwxnsnopqrs",
+	"abcdefghijklmnopqrstuvwxosopqrst",
+	"abcdefghijklmnopqrstuvwxpspqrstu",
+	"abcdefghijklmnopqrstuvwxqsqrstuv",
+	"abcdefghijklmnopqrstuvwxrsrstuvw",
+	"abcdefghijklmnopqrstuvwxssstuvwx",
+	"abcdefghijklmnopqrstuvwxtstuvwxy",
+	"abcdefghijklmnopqrstuvwxusuvwxyz",
+	"abcdefghijklmnopqrstuvwxvsvwxyza",
+	"abcdefghijklmnopqrstuvwxwswxyzab",
+	"abcdefghijklmnopqrstuvwxxsxyzabc",
+	"abcdefghijklmnopqrstuvwxysyzabcd",
+	"abcdefghijklmnopqrstuvwxzszabcde",
+	"abcdefghijklmnopqrstuvwxatabcdef",
+	"abcdefghijklmnopqrstuvwxbtbcdefg",
+	"abcdefghijklmnopqrstuvwxctcdefgh",
+	"abcdefghijklmnopqrstuvwxdtdefghi",
+	"abcdefghijklmnopqrstuvwxetefghij",
+	"abcdefghijklmnopqrstuvwxftfghijk",
+	"abcdefghijklmnopqrstuvwxgtghijkl",
+	"abcdefghijklmnopqrstuvwxhthijklm",
+	"abcdefghijklmnopqrstuvwxitijklmn",
+	"abcdefghijklmnopqrstuvwxjtjklmno",
+	"abcdefghijklmnopqrstuvwxktklmnop",
+	"abcdefghijklmnopqrstuvwxltlmnopq",
+	"abcdefghijklmnopqrstuvwxmtmnopqr",
+	"abcdefghijklmnopqrstuvwxntnopqrs",
+	"abcdefghijklmnopqrstuvwxotopqrst",
+	"abcdefghijklmnopqrstuvwxptpqrstu",
+	"abcdefghijklmnopqrstuvwxqtqrstuv",
+	"abcdefghijklmnopqrstuvwxrtrstuvw",
+}
+
+var InlineStringMap8P0N4 map[string]func(int) int
+var InlineStringTable8P0N4 StringTable
+var InlineStringMap8P0N8 map[string]func(int) int
+var InlineStringTable8P0N8 StringTable
+var InlineStringMap8P0N16 map[string]func(int) int
+var InlineStringTable8P0N16 StringTable
+var InlineStringMap8P0N32 map[string]func(int) int
+var InlineStringTable8P0N32 StringTable
+var InlineStringMap8P0N64 map[string]func(int) int
+var InlineStringTable8P0N64 StringTable
+var InlineStringMap8P0N128 map[string]func(int) int
+var InlineStringTable8P0N128 StringTable
+var InlineStringMap8P0N256 map[string]func(int) int
+var InlineStringTable8P0N256 StringTable
+var InlineStringMap8P0N512 map[string]func(int) int
+var InlineStringTable8P0N512 StringTable
+var InlineStringMap32P0N4 map[string]func(int) int
+var InlineStringTable32P0N4 StringTable
+var InlineStringMap32P0N8 map[string]func(int) int
+var InlineStringTable32P0N8 StringTable
+var InlineStringMap32P0N16 map[string]func(int) int
+var InlineStringTable32P0N16 StringTable
+var InlineStringMap32P0N32 map[string]func(int) int
+var InlineStringTable32P0N32 StringTable
+var InlineStringMap32P0N64 map[string]func(int) int
+var InlineStringTable32P0N64 StringTable
+var InlineStringMap32P0N128 map[string]func(int) int
+var InlineStringTable32P0N128 StringTable
+var InlineStringMap32P0N256 map[string]func(int) int
+var InlineStringTable32P0N256 StringTable
+var InlineStringMap32P0N512 map[string]func(int) int
+var InlineStringTable32P0N512 StringTable
+var InlineStringMap32P24N4 map[string]func(int) int
+var InlineStringTable32P24N4 StringTable
+var InlineStringMap32P24N8 map[string]func(int) int
+var InlineStringTable32P24N8 StringTable
+var InlineStringMap32P24N16 map[string]func(int) int
+var InlineStringTable32P24N16 StringTable
+var InlineStringMap32P24N32 map[string]func(int) int
+var InlineStringTable32P24N32 StringTable
+var InlineStringMap32P24N64 map[string]func(int) int
+var InlineStringTable32P24N64 StringTable
+var InlineStringMap32P24N128 map[string]func(int) int
+var InlineStringTable32P24N128 StringTable
+var InlineStringMap32P24N256 map[string]func(int) int
+var InlineStringTable32P24N256 StringTable
+var InlineStringMap32P24N512 map[string]func(int) int
+var InlineStringTable32P24N512 StringTable
+var NoInlineStringMap8P0N4 map[string]func(int) int
+var NoInlineStringTable8P0N4 StringTable
+var NoInlineStringMap8P0N8 map[string]func(int) int
+var NoInlineStringTable8P0N8 StringTable
+var NoInlineStringMap8P0N16 map[string]func(int) int
+var NoInlineStringTable8P0N16 StringTable
+var NoInlineStringMap8P0N32 map[string]func(int) int
+var NoInlineStringTable8P0N32 StringTable
+var NoInlineStringMap8P0N64 map[string]func(int) int
+var NoInlineStringTable8P0N64 StringTable
+var NoInlineStringMap8P0N128 map[string]func(int) int
+var NoInlineStringTable8P0N128 StringTable
+var NoInlineStringMap8P0N256 map[string]func(int) int
+var NoInlineStringTable8P0N256 StringTable
+var NoInlineStringMap8P0N512 map[string]func(int) int
+var NoInlineStringTable8P0N512 StringTable
+var NoInlineStringMap32P0N4 map[string]func(int) int
+var NoInlineStringTable32P0N4 StringTable
+var NoInlineStringMap32P0N8 map[string]func(int) int
+var NoInlineStringTable32P0N8 StringTable
+var NoInlineStringMap32P0N16 map[string]func(int) int
+var NoInlineStringTable32P0N16 StringTable
+var NoInlineStringMap32P0N32 map[string]func(int) int
+var NoInlineStringTable32P0N32 StringTable
+var NoInlineStringMap32P0N64 map[string]func(int) int
+var NoInlineStringTable32P0N64 StringTable
+var NoInlineStringMap32P0N128 map[string]func(int) int
+var NoInlineStringTable32P0N128 StringTable
+var NoInlineStringMap32P0N256 map[string]func(int) int
+var NoInlineStringTable32P0N256 StringTable
+var NoInlineStringMap32P0N512 map[string]func(int) int
+var NoInlineStringTable32P0N512 StringTable
+var NoInlineStringMap32P24N4 map[string]func(int) int
+var NoInlineStringTable32P24N4 StringTable
+var NoInlineStringMap32P24N8 map[string]func(int) int
+var NoInlineStringTable32P24N8 StringTable
+var NoInlineStringMap32P24N16 map[string]func(int) int
+var NoInlineStringTable32P24N16 StringTable
+var NoInlineStringMap32P24N32 map[string]func(int) int
+var NoInlineStringTable32P24N32 StringTable
+var NoInlineStringMap32P24N64 map[string]func(int) int
+var NoInlineStringTable32P24N64 StringTable
+var NoInlineStringMap32P24N128 map[string]func(int) int
+var NoInlineStringTable32P24N128 StringTable
+var NoInlineStringMap32P24N256 map[string]func(int) int
+var NoInlineStringTable32P24N256 StringTable
+var NoInlineStringMap32P24N512 map[string]func(int) int
+var NoInlineStringTable32P24N512 StringTable
+
+func init() {
+
+	InlineStringMap8P0N4 = make(map[string]func(int) int, 4)
+	for i, k := range StringKeys8P0[:4] {
+		InlineStringMap8P0N4[k] = InlineFuncs[i]
+	}
+	InlineStringTable8P0N4 = newStringTable(StringKeys8P0[:4], InlineFuncs)
+
+	InlineStringMap8P0N8 = make(map[string]func(int) int, 8)
+	for i, k := range StringKeys8P0[:8] {
+		InlineStringMap8P0N8[k] = InlineFuncs[i]
+	}
+	InlineStringTable8P0N8 = newStringTable(StringKeys8P0[:8], InlineFuncs)
+
+	InlineStringMap8P0N16 = make(map[string]func(int) int, 16)
+	for i, k := range StringKeys8P0[:16] {
+		InlineStringMap8P0N16[k] = InlineFuncs[i]
+	}
+	InlineStringTable8P0N16 = newStringTable(StringKeys8P0[:16], InlineFuncs)
+
+	InlineStringMap8P0N32 = make(map[string]func(int) int, 32)
+	for i, k := range StringKeys8P0[:32] {
+		InlineStringMap8P0N32[k] = InlineFuncs[i]
+	}
+	InlineStringTable8P0N32 = newStringTable(StringKeys8P0[:32], InlineFuncs)
+
+	InlineStringMap8P0N64 = make(map[string]func(int) int, 64)
+	for i, k := range StringKeys8P0[:64] {
+		InlineStringMap8P0N64[k] = InlineFuncs[i]
+	}
+	InlineStringTable8P0N64 = newStringTable(StringKeys8P0[:64], InlineFuncs)
+
+	InlineStringMap8P0N128 = make(map[string]func(int) int, 128)
+	for i, k := range StringKeys8P0[:128] {
+		InlineStringMap8P0N128[k] = InlineFuncs[i]
+	}
+	InlineStringTable8P0N128 = newStringTable(StringKeys8P0[:128], InlineFuncs)
+
+	InlineStringMap8P0N256 = make(map[string]func(int) int, 256)
+	for i, k := range StringKeys8P0[:256] {
+		InlineStringMap8P0N256[k] = InlineFuncs[i]
+	}
+	InlineStringTable8P0N256 = newStringTable(StringKeys8P0[:256], InlineFuncs)
+
+	InlineStringMap8P0N512 = make(map[string]func(int) int, 512)
+	for i, k := range StringKeys8P0[:512] {
+		InlineStringMap8P0N512[k] = InlineFuncs[i]
+	}
+	InlineStringTable8P0N512 = newStringTable(StringKeys8P0[:512], InlineFuncs)
+
+	InlineStringMap32P0N4 = make(map[string]func(int) int, 4)
+	for i, k := range StringKeys32P0[:4] {
+		InlineStringMap32P0N4[k] = InlineFuncs[i]
+	}
+	InlineStringTable32P0N4 = newStringTable(StringKeys32P0[:4], InlineFuncs)
+
+	InlineStringMap32P0N8 = make(map[string]func(int) int, 8)
+	for i, k := range StringKeys32P0[:8] {
+		InlineStringMap32P0N8[k] = InlineFuncs[i]
+	}
+	InlineStringTable32P0N8 = newStringTable(StringKeys32P0[:8], InlineFuncs)
+
+	InlineStringMap32P0N16 = make(map[string]func(int) int, 16)
+	for i, k := range StringKeys32P0[:16] {
+		InlineStringMap32P0N16[k] = InlineFuncs[i]
+	}
+	InlineStringTable32P0N16 = newStringTable(StringKeys32P0[:16], InlineFuncs)
+
+	InlineStringMap32P0N32 = make(map[string]func(int) int, 32)
+	for i, k := range StringKeys32P0[:32] {
+		InlineStringMap32P0N32[k] = InlineFuncs[i]
+	}
+	InlineStringTable32P0N32 = newStringTable(StringKeys32P0[:32], InlineFuncs)
+
+	InlineStringMap32P0N64 = make(map[string]func(int) int, 64)
+	for i, k := range StringKeys32P0[:64] {
+		InlineStringMap32P0N64[k] = InlineFuncs[i]
+	}
+	InlineStringTable32P0N64 = newStringTable(StringKeys32P0[:64], InlineFuncs)
+
+	InlineStringMap32P0N128 = make(map[string]func(int) int, 128)
+	for i, k := range StringKeys32P0[:128] {
+		InlineStringMap32P0N128[k] = InlineFuncs[i]
+	}
+	InlineStringTable32P0N128 = newStringTable(StringKeys32P0[:128], InlineFuncs)
+
+	InlineStringMap32P0N256 = make(map[string]func(int) int, 256)
+	for i, k := range StringKeys32P0[:256] {
+		InlineStringMap32P0N256[k] = InlineFuncs[i]
+	}
+	InlineStringTable32P0N256 = newStringTable(StringKeys32P0[:256], InlineFuncs)
+
+	InlineStringMap32P0N512 = make(map[string]func(int) int, 512)
+	for i, k := range StringKeys32P0[:512] {
+		InlineStringMap32P0N512[k] = InlineFuncs[i]
+	}
+	InlineStringTable32P0N512 = newStringTable(StringKeys32P0[:512], InlineFuncs)
+
+	InlineStringMap32P24N4 = make(map[string]func(int) int, 4)
+	for i, k := range StringKeys32P24[:4] {
+		InlineStringMap32P24N4[k] = InlineFuncs[i]
+	}
+	InlineStringTable32P24N4 = newStringTable(StringKeys32P24[:4], InlineFuncs)
+
+	InlineStringMap32P24N8 = make(map[string]func(int) int, 8)
+	for i, k := range StringKeys32P24[:8] {
+		InlineStringMap32P24N8[k] = InlineFuncs[i]
+	}
+	InlineStringTable32P24N8 = newStringTable(StringKeys32P24[:8], InlineFuncs)
+
+	InlineStringMap32P24N16 = make(map[string]func(int) int, 16)
+	for i, k := range StringKeys32P24[:16] {
+		InlineStringMap32P24N16[k] = InlineFuncs[i]
+	}
+	InlineStringTable32P24N16 = newStringTable(StringKeys32P24[:16], InlineFuncs)
+
+	InlineStringMap32P24N32 = make(map[string]func(int) int, 32)
+	for i, k := range StringKeys32P24[:32] {
+		InlineStringMap32P24N32[k] = InlineFuncs[i]
+	}
+	InlineStringTable32P24N32 = newStringTable(StringKeys32P24[:32], InlineFuncs)
+
+	InlineStringMap32P24N64 = make(map[string]func(int) int, 64)
+	for i, k := range StringKeys32P24[:64] {
+		InlineStringMap32P24N64[k] = InlineFuncs[i]
+	}
+	InlineStringTable32P24N64 = newStringTable(StringKeys32P24[:64], InlineFuncs)
+
+	InlineStringMap32P24N128 = make(map[string]func(int) int, 128)
+	for i, k := range StringKeys32P24[:128] {
+		InlineStringMap32P24N128[k] = InlineFuncs[i]
+	}
+	InlineStringTable32P24N128 = newStringTable(StringKeys32P24[:128], InlineFuncs)
+
+	InlineStringMap32P24N256 = make(map[string]func(int) int, 256)
+	for i, k := range StringKeys32P24[:256] {
+		InlineStringMap32P24N256[k] = InlineFuncs[i]
+	}
+	InlineStringTable32P24N256 = newStringTable(StringKeys32P24[:256], InlineFuncs)
+
+	InlineStringMap32P24N512 = make(map[string]func(int) int, 512)
+	for i, k := range StringKeys32P24[:512] {
+		InlineStringMap32P24N512[k] = InlineFuncs[i]
+	}
+	InlineStringTable32P24N512 = newStringTable(StringKeys32P24[:512], InlineFuncs)
+
+	NoInlineStringMap8P0N4 = make(map[string]func(int) int, 4)
+	for i, k := range StringKeys8P0[:4] {
+		NoInlineStringMap8P0N4[k] = NoInlineFuncs[i]
+	}
+	NoInlineStringTable8P0N4 = newStringTable(StringKeys8P0[:4], NoInlineFuncs)
+
+	NoInlineStringMap8P0N8 = make(map[string]func(int) int, 8)
+	for i, k := range StringKeys8P0[:8] {
+		NoInlineStringMap8P0N8[k] = NoInlineFuncs[i]
+	}
+	NoInlineStringTable8P0N8 = newStringTable(StringKeys8P0[:8], NoInlineFuncs)
+
+	NoInlineStringMap8P0N16 = make(map[string]func(int) int, 16)
+	for i, k := range StringKeys8P0[:16] {
+		NoInlineStringMap8P0N16[k] = NoInlineFuncs[i]
+	}
+	NoInlineStringTable8P0N16 = newStringTable(StringKeys8P0[:16], NoInlineFuncs)
+
+	NoInlineStringMap8P0N32 = make(map[string]func(int) int, 32)
+	for i, k := range StringKeys8P0[:32] {
+		NoInlineStringMap8P0N32[k] = NoInlineFuncs[i]
+	}
+	NoInlineStringTable8P0N32 = newStringTable(StringKeys8P0[:32], NoInlineFuncs)
+
+	NoInlineStringMap8P0N64 = make(map[string]func(int) int, 64)
+	for i, k := range StringKeys8P0[:64] {
+		NoInlineStringMap8P0N64[k] = NoInlineFuncs[i]
+	}
+	NoInlineStringTable8P0N64 = newStringTable(StringKeys8P0[:64], NoInlineFuncs)
+
+	NoInlineStringMap8P0N128 = make(map[string]func(int) int, 128)
+	for i, k := range StringKeys8P0[:128] {
+		NoInlineStringMap8P0N128[k] = NoInlineFuncs[i]
+	}
+	NoInlineStringTable8P0N128 = newStringTable(StringKeys8P0[:128], NoInlineFuncs)
+
+	NoInlineStringMap8P0N256 = make(map[string]func(int) int, 256)
+	for i, k := range StringKeys8P0[:256] {
+		NoInlineStringMap8P0N256[k] = NoInlineFuncs[i]
+	}
+	NoInlineStringTable8P0N256 = newStringTable(StringKeys8P0[:256], NoInlineFuncs)
+
+	NoInlineStringMap8P0N512 = make(map[string]func(int) int, 512)
+	for i, k := range StringKeys8P0[:512] {
+		NoInlineStringMap8P0N512[k] = NoInlineFuncs[i]
+	}
+	NoInlineStringTable8P0N512 = newStringTable(StringKeys8P0[:512], NoInlineFuncs)
+
+	NoInlineStringMap32P0N4 = make(map[string]func(int) int, 4)
+	for i, k := range StringKeys32P0[:4] {
+		NoInlineStringMap32P0N4[k] = NoInlineFuncs[i]
+	}
+	NoInlineStringTable32P0N4 = newStringTable(StringKeys32P0[:4], NoInlineFuncs)
+
+	NoInlineStringMap32P0N8 = make(map[string]func(int) int, 8)
+	for i, k := range StringKeys32P0[:8] {
+		NoInlineStringMap32P0N8[k] = NoInlineFuncs[i]
+	}
+	NoInlineStringTable32P0N8 = newStringTable(StringKeys32P0[:8], NoInlineFuncs)
+
+	NoInlineStringMap32P0N16 = make(map[string]func(int) int, 16)
+	for i, k := range StringKeys32P0[:16] {
+		NoInlineStringMap32P0N16[k] = NoInlineFuncs[i]
+	}
+	NoInlineStringTable32P0N16 = newStringTable(StringKeys32P0[:16], NoInlineFuncs)
+
+	NoInlineStringMap32P0N32 = make(map[string]func(int) int, 32)
+	for i, k := range StringKeys32P0[:32] {
+		NoInlineStringMap32P0N32[k] = NoInlineFuncs[i]
+	}
+	NoInlineStringTable32P0N32 = newStringTable(StringKeys32P0[:32], NoInlineFuncs)
+
+	NoInlineStringMap32P0N64 = make(map[string]func(int) int, 64)
+	for i, k := range StringKeys32P0[:64] {
+		NoInlineStringMap32P0N64[k] = NoInlineFuncs[i]
+	}
+	NoInlineStringTable32P0N64 = newStringTable(StringKeys32P0[:64], NoInlineFuncs)
+
+	NoInlineStringMap32P0N128 = make(map[string]func(int) int, 128)
+	for i, k := range StringKeys32P0[:128] {
+		NoInlineStringMap32P0N128[k] = NoInlineFuncs[i]
+	}
+	NoInlineStringTable32P0N128 = newStringTable(StringKeys32P0[:128], NoInlineFuncs)
+
+	NoInlineStringMap32P0N256 = make(map[string]func(int) int, 256)
+	for i, k := range StringKeys32P0[:256] {
+		NoInlineStringMap32P0N256[k] = NoInlineFuncs[i]
+	}
+	NoInlineStringTable32P0N256 = newStringTable(StringKeys32P0[:256], NoInlineFuncs)
+
+	NoInlineStringMap32P0N512 = make(map[string]func(int) int, 512)
+	for i, k := range StringKeys32P0[:512] {
+		NoInlineStringMap32P0N512[k] = NoInlineFuncs[i]
+	}
+	NoInlineStringTable32P0N512 = newStringTable(StringKeys32P0[:512], NoInlineFuncs)
+
+	NoInlineStringMap32P24N4 = make(map[string]func(int) int, 4)
+	for i, k := range StringKeys32P24[:4] {
+		NoInlineStringMap32P24N4[k] = NoInlineFuncs[i]
+	}
+	NoInlineStringTable32P24N4 = newStringTable(StringKeys32P24[:4], NoInlineFuncs)
+
+	NoInlineStringMap32P24N8 = make(map[string]func(int) int, 8)
+	for i, k := range StringKeys32P24[:8] {
+		NoInlineStringMap32P24N8[k] = NoInlineFuncs[i]
+	}
+	NoInlineStringTable32P24N8 = newStringTable(StringKeys32P24[:8], NoInlineFuncs)
+
+	NoInlineStringMap32P24N16 = make(map[string]func(int) int, 16)
+	for i, k := range StringKeys32P24[:16] {
+		NoInlineStringMap32P24N16[k] = NoInlineFuncs[i]
+	}
+	NoInlineStringTable32P24N16 = newStringTable(StringKeys32P24[:16], NoInlineFuncs)
+
+	NoInlineStringMap32P24N32 = make(map[string]func(int) int, 32)
+	for i, k := range StringKeys32P24[:32] {
+		NoInlineStringMap32P24N32[k] = NoInlineFuncs[i]
+	}
+	NoInlineStringTable32P24N32 = newStringTable(StringKeys32P24[:32], NoInlineFuncs)
+
+	NoInlineStringMap32P24N64 = make(map[string]func(int) int, 64)
+	for i, k := range StringKeys32P24[:64] {
+		NoInlineStringMap32P24N64[k] = NoInlineFuncs[i]
+	}
+	NoInlineStringTable32P24N64 = newStringTable(StringKeys32P24[:64], NoInlineFuncs)
+
+	NoInlineStringMap32P24N128 = make(map[string]func(int) int, 128)
+	for i, k := range StringKeys32P24[:128] {
+		NoInlineStringMap32P24N128[k] = NoInlineFuncs[i]
+	}
+	NoInlineStringTable32P24N128 = newStringTable(StringKeys32P24[:128], NoInlineFuncs)
+
+	NoInlineStringMap32P24N256 = make(map[string]func(int) int, 256)
+	for i, k := range StringKeys32P24[:256] {
+		NoInlineStringMap32P24N256[k] = NoInlineFuncs[i]
+	}
+	NoInlineStringTable32P24N256 = newStringTable(StringKeys32P24[:256], NoInlineFuncs)
+
+	NoInlineStringMap32P24N512 = make(map[string]func(int) int, 512)
+	for i, k := range StringKeys32P24[:512] {
+		NoInlineStringMap32P24N512[k] = NoInlineFuncs[i]
+	}
+	NoInlineStringTable32P24N512 = newStringTable(StringKeys32P24[:512], NoInlineFuncs)
+}